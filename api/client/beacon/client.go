@@ -35,6 +35,13 @@ const (
 	getStatePath             = "/eth/v2/debug/beacon/states"
 	getNodeVersionPath       = "/eth/v1/node/version"
 	changeBLStoExecutionPath = "/eth/v1/beacon/pool/bls_to_execution_changes"
+	submitVoluntaryExitPath  = "/eth/v1/beacon/pool/voluntary_exits"
+	getDepositSnapshotPath   = "/eth/v1/beacon/deposit_snapshot"
+	getValidatorsPath        = "/eth/v1/beacon/states/{{.Id}}/validators"
+
+	getPendingDepositsPath           = "/prysm/v1/beacon/states/{{.Id}}/pending_deposits"
+	getPendingPartialWithdrawalsPath = "/prysm/v1/beacon/states/{{.Id}}/pending_partial_withdrawals"
+	getPendingConsolidationsPath     = "/prysm/v1/beacon/states/{{.Id}}/pending_consolidations"
 )
 
 // StateOrBlockId represents the block_id / state_id parameters that several of the Eth Beacon API methods accept.
@@ -246,6 +253,19 @@ func (c *Client) GetState(ctx context.Context, stateId StateOrBlockId) ([]byte,
 	return b, nil
 }
 
+// GetDepositSnapshot retrieves the EIP-4881 deposit tree snapshot of the finalized deposits known to the node.
+func (c *Client) GetDepositSnapshot(ctx context.Context) (*ethpb.DepositSnapshot, error) {
+	b, err := c.Get(ctx, getDepositSnapshotPath, client.WithSSZEncoding())
+	if err != nil {
+		return nil, errors.Wrap(err, "error requesting deposit snapshot")
+	}
+	snapshot := &ethpb.DepositSnapshot{}
+	if err := snapshot.UnmarshalSSZ(b); err != nil {
+		return nil, errors.Wrap(err, "error decoding ssz response in GetDepositSnapshot")
+	}
+	return snapshot, nil
+}
+
 // GetWeakSubjectivity calls a proposed API endpoint that is unique to prysm
 // This api method does the following:
 // - computes weak subjectivity epoch
@@ -319,6 +339,34 @@ func (c *Client) SubmitChangeBLStoExecution(ctx context.Context, request []*stru
 	return nil
 }
 
+// SubmitVoluntaryExit calls a beacon API endpoint to add the signed voluntary exit to the operation pool of the
+// beacon node, which broadcasts it.
+func (c *Client) SubmitVoluntaryExit(ctx context.Context, exit *structs.SignedVoluntaryExit) error {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: submitVoluntaryExitPath})
+	body, err := json.Marshal(exit)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal JSON")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return client.Non200Err(resp)
+	}
+	return nil
+}
+
 // GetBLStoExecutionChanges gets all the set withdrawal messages in the node's operation pool.
 // Returns a struct representation of json response.
 func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToExecutionChangesPoolResponse, error) {
@@ -334,6 +382,119 @@ func (c *Client) GetBLStoExecutionChanges(ctx context.Context) (*structs.BLSToEx
 	return poolResponse, nil
 }
 
+var getValidatorsTpl = idTemplate(getValidatorsPath)
+
+// GetValidators retrieves the validators of the state identified by stateId which match the given ids, where an id is
+// either a validator index or a hex encoded public key. The ids are sent in the request body, so that large batches of
+// validators can be requested at once.
+func (c *Client) GetValidators(ctx context.Context, stateId StateOrBlockId, ids []string) (*structs.GetValidatorsResponse, error) {
+	u := c.BaseURL().ResolveReference(&url.URL{Path: getValidatorsTpl(stateId)})
+	body, err := json.Marshal(&structs.GetValidatorsRequest{Ids: ids})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal JSON")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid format, failed to create new POST request object")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, client.Non200Err(resp)
+	}
+	validators := &structs.GetValidatorsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(validators); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetValidators")
+	}
+	return validators, nil
+}
+
+var getPendingDepositsTpl = idTemplate(getPendingDepositsPath)
+
+// GetPendingDeposits retrieves the pending deposits queue of the state identified by stateId, filtered to the deposits
+// of the given public key, along with the position of each deposit in the queue.
+func (c *Client) GetPendingDeposits(ctx context.Context, stateId StateOrBlockId, pubkey []byte) (*structs.GetPendingDepositsResponse, error) {
+	query := url.Values{"pubkey": []string{hexutil.Encode(pubkey)}}
+	body, err := c.Get(ctx, getPendingDepositsTpl(stateId), client.WithQuery(query))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error requesting pending deposits by state id = %s", stateId)
+	}
+	resp := &structs.GetPendingDepositsResponse{}
+	if err := json.Unmarshal(body, resp); err != nil {
+		return nil, errors.Wrap(err, "error decoding json response in GetPendingDeposits")
+	}
+	return resp, nil
+}
+
+var getPendingPartialWithdrawalsTpl = idTemplate(getPendingPartialWithdrawalsPath)
+
+// GetPendingPartialWithdrawals retrieves the pending partial withdrawals queue of the state identified by stateId,
+// filtered to the given validator index, along with the position of each withdrawal in the queue. The pages of the
+// response are requested until the last one.
+func (c *Client) GetPendingPartialWithdrawals(ctx context.Context, stateId StateOrBlockId, index primitives.ValidatorIndex) (*structs.GetPendingPartialWithdrawalsResponse, error) {
+	resp := &structs.GetPendingPartialWithdrawalsResponse{}
+	pageToken := ""
+	for {
+		body, err := c.Get(ctx, getPendingPartialWithdrawalsTpl(stateId), client.WithQuery(pendingQueueQuery(index, pageToken)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error requesting pending partial withdrawals by state id = %s", stateId)
+		}
+		page := &structs.GetPendingPartialWithdrawalsResponse{}
+		if err := json.Unmarshal(body, page); err != nil {
+			return nil, errors.Wrap(err, "error decoding json response in GetPendingPartialWithdrawals")
+		}
+		page.Data = append(resp.Data, page.Data...)
+		resp = page
+		if page.NextPageToken == "" {
+			return resp, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+var getPendingConsolidationsTpl = idTemplate(getPendingConsolidationsPath)
+
+// GetPendingConsolidations retrieves the pending consolidations queue of the state identified by stateId, filtered to
+// consolidations where the given validator index is either the source or the target, along with the position of each
+// consolidation in the queue. The pages of the response are requested until the last one.
+func (c *Client) GetPendingConsolidations(ctx context.Context, stateId StateOrBlockId, index primitives.ValidatorIndex) (*structs.GetPendingConsolidationsResponse, error) {
+	resp := &structs.GetPendingConsolidationsResponse{}
+	pageToken := ""
+	for {
+		body, err := c.Get(ctx, getPendingConsolidationsTpl(stateId), client.WithQuery(pendingQueueQuery(index, pageToken)))
+		if err != nil {
+			return nil, errors.Wrapf(err, "error requesting pending consolidations by state id = %s", stateId)
+		}
+		page := &structs.GetPendingConsolidationsResponse{}
+		if err := json.Unmarshal(body, page); err != nil {
+			return nil, errors.Wrap(err, "error decoding json response in GetPendingConsolidations")
+		}
+		page.Data = append(resp.Data, page.Data...)
+		resp = page
+		if page.NextPageToken == "" {
+			return resp, nil
+		}
+		pageToken = page.NextPageToken
+	}
+}
+
+// pendingQueueQuery returns the query of a page of a pending queue filtered to the given validator index.
+func pendingQueueQuery(index primitives.ValidatorIndex, pageToken string) url.Values {
+	query := url.Values{"validator_index": []string{strconv.FormatUint(uint64(index), 10)}}
+	if pageToken != "" {
+		query.Set("page_token", pageToken)
+	}
+	return query
+}
+
 type forkScheduleResponse struct {
 	Data []structs.Fork
 }
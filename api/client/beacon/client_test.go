@@ -1,10 +1,16 @@
 package beacon
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
 	"net/url"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api/client"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
 
@@ -137,3 +143,35 @@ func TestValidHostname(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPendingConsolidations_Pages(t *testing.T) {
+	pages := map[string]*structs.GetPendingConsolidationsResponse{
+		"": {
+			Data:          []*structs.QueuedPendingConsolidation{{Position: "3"}},
+			NextPageToken: "1",
+			TotalSize:     "2",
+		},
+		"1": {
+			Data:      []*structs.QueuedPendingConsolidation{{Position: "700"}},
+			TotalSize: "2",
+		},
+	}
+	trans := &testRT{rt: func(req *http.Request) (*http.Response, error) {
+		require.Equal(t, "/prysm/v1/beacon/states/head/pending_consolidations", req.URL.Path)
+		require.Equal(t, "5", req.URL.Query().Get("validator_index"))
+		page, ok := pages[req.URL.Query().Get("page_token")]
+		require.Equal(t, true, ok)
+		body, err := json.Marshal(page)
+		require.NoError(t, err)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewBuffer(body)), Request: req}, nil
+	}}
+	c, err := NewClient("http://localhost:3500", client.WithRoundTripper(trans))
+	require.NoError(t, err)
+
+	resp, err := c.GetPendingConsolidations(context.Background(), IdHead, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	require.Equal(t, "3", resp.Data[0].Position)
+	require.Equal(t, "700", resp.Data[1].Position)
+	require.Equal(t, "", resp.NextPageToken)
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/golang/snappy"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// SnappyEncoding is the content encoding of responses compressed with the snappy framing format.
+	SnappyEncoding = "snappy"
+	// GzipEncoding is the content encoding of responses compressed with gzip.
+	GzipEncoding = "gzip"
+)
+
+// CompressionHandler compresses responses with snappy or gzip, as negotiated through the Accept-Encoding header of
+// the request, snappy being preferred as it is cheaper to compute. Responses are compressed as they are written and
+// sent with chunked transfer encoding, so that large responses are never held compressed in memory.
+func CompressionHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(r.Header.Get("Accept-Encoding"))
+		if encoding == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		cw := &compressedResponseWriter{ResponseWriter: w, encoding: encoding}
+		defer func() {
+			if err := cw.Close(); err != nil {
+				log.WithError(err).Debug("Could not finish compressed response")
+			}
+		}()
+		next.ServeHTTP(cw, r)
+	})
+}
+
+// negotiateEncoding returns the preferred content encoding accepted by the client, or an empty string when the
+// client accepts neither snappy nor gzip.
+func negotiateEncoding(acceptEncoding string) string {
+	var snappyAccepted, gzipAccepted bool
+	for _, e := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(e), ";")
+		if !encodingAllowed(params) {
+			continue
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case SnappyEncoding:
+			snappyAccepted = true
+		case GzipEncoding:
+			gzipAccepted = true
+		}
+	}
+	switch {
+	case snappyAccepted:
+		return SnappyEncoding
+	case gzipAccepted:
+		return GzipEncoding
+	default:
+		return ""
+	}
+}
+
+// encodingAllowed returns false for encodings given a quality value of zero, which the client refuses.
+func encodingAllowed(params string) bool {
+	for _, p := range strings.Split(params, ";") {
+		k, v, ok := strings.Cut(strings.TrimSpace(p), "=")
+		if !ok || strings.TrimSpace(k) != "q" {
+			continue
+		}
+		q, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return err != nil || q > 0
+	}
+	return true
+}
+
+// compressedResponseWriter compresses the body written by a handler. The compressor is created with the first
+// write of the body.
+type compressedResponseWriter struct {
+	http.ResponseWriter
+	encoding    string
+	compressor  io.WriteCloser
+	wroteHeader bool
+}
+
+func (w *compressedResponseWriter) WriteHeader(status int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	h := w.Header()
+	// The length of the compressed body is not known in advance.
+	h.Del("Content-Length")
+	h.Set("Content-Encoding", w.encoding)
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *compressedResponseWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.compressor == nil {
+		switch w.encoding {
+		case SnappyEncoding:
+			w.compressor = snappy.NewBufferedWriter(w.ResponseWriter)
+		default:
+			w.compressor = gzip.NewWriter(w.ResponseWriter)
+		}
+	}
+	return w.compressor.Write(b)
+}
+
+// Flush sends the data compressed so far to the client.
+func (w *compressedResponseWriter) Flush() {
+	if f, ok := w.compressor.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			log.WithError(err).Debug("Could not flush compressed response")
+		}
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Close writes the end of the compressed stream.
+func (w *compressedResponseWriter) Close() error {
+	if w.compressor == nil {
+		return nil
+	}
+	return w.compressor.Close()
+}
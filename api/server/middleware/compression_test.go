@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNegotiateEncoding(t *testing.T) {
+	tests := []struct {
+		acceptEncoding string
+		want           string
+	}{
+		{acceptEncoding: "", want: ""},
+		{acceptEncoding: "br, deflate", want: ""},
+		{acceptEncoding: "gzip", want: GzipEncoding},
+		{acceptEncoding: "gzip, snappy", want: SnappyEncoding},
+		{acceptEncoding: "GZIP;q=0.5", want: GzipEncoding},
+		{acceptEncoding: "snappy;q=0, gzip", want: GzipEncoding},
+		{acceptEncoding: "gzip;q=0", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.acceptEncoding, func(t *testing.T) {
+			assert.Equal(t, tt.want, negotiateEncoding(tt.acceptEncoding))
+		})
+	}
+}
+
+func TestCompressionHandler(t *testing.T) {
+	body := bytes.Repeat([]byte("compressed response "), 10000)
+	handler := CompressionHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		_, err := w.Write(body)
+		require.NoError(t, err)
+	}))
+
+	t.Run("gzip", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/foo", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, GzipEncoding, rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, "", rr.Header().Get("Content-Length"))
+		assert.Equal(t, "Accept-Encoding", rr.Header().Get("Vary"))
+		assert.Equal(t, true, rr.Body.Len() < len(body))
+		gr, err := gzip.NewReader(rr.Body)
+		require.NoError(t, err)
+		decoded, err := io.ReadAll(gr)
+		require.NoError(t, err)
+		assert.DeepEqual(t, body, decoded)
+	})
+	t.Run("snappy", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/foo", nil)
+		req.Header.Set("Accept-Encoding", "gzip, snappy")
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, SnappyEncoding, rr.Header().Get("Content-Encoding"))
+		decoded, err := io.ReadAll(snappy.NewReader(rr.Body))
+		require.NoError(t, err)
+		assert.DeepEqual(t, body, decoded)
+	})
+	t.Run("not accepted", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "http://example.com/foo", nil)
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+		require.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "", rr.Header().Get("Content-Encoding"))
+		assert.Equal(t, strconv.Itoa(len(body)), rr.Header().Get("Content-Length"))
+		assert.DeepEqual(t, body, rr.Body.Bytes())
+	})
+}
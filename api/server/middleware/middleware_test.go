@@ -1,8 +1,10 @@
 package middleware
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/api"
@@ -202,3 +204,58 @@ func TestAcceptHeaderHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestBearerTokenHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		code   int
+	}{
+		{name: "valid token", token: "secret", header: "Bearer secret", code: http.StatusOK},
+		{name: "wrong token", token: "secret", header: "Bearer other", code: http.StatusUnauthorized},
+		{name: "not a bearer token", token: "secret", header: "secret", code: http.StatusUnauthorized},
+		{name: "missing header", token: "secret", code: http.StatusUnauthorized},
+		{name: "no token configured", header: "Bearer ", code: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/test", http.NoBody)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			rr := httptest.NewRecorder()
+			BearerTokenHandler(tt.token)(next).ServeHTTP(rr, req)
+			require.Equal(t, tt.code, rr.Code)
+		})
+	}
+}
+
+func TestMaxBodySizeHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := io.ReadAll(r.Body); err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := MaxBodySizeHandler(4)(next)
+
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader("abcd")))
+	require.Equal(t, http.StatusOK, rr.Code)
+
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader("abcde")))
+	require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+
+	// The body is limited when its length is not known upfront.
+	req := httptest.NewRequest(http.MethodPost, "/test", io.NopCloser(strings.NewReader("abcde")))
+	req.ContentLength = -1
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	require.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+}
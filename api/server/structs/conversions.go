@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
@@ -505,6 +506,41 @@ func CheckpointFromConsensus(c *eth.Checkpoint) *Checkpoint {
 	}
 }
 
+func ForkChoiceDumpFromConsensus(dump *forkchoice.Dump) *GetForkChoiceDumpResponse {
+	nodes := make([]*ForkChoiceNode, len(dump.ForkChoiceNodes))
+	for i, n := range dump.ForkChoiceNodes {
+		nodes[i] = &ForkChoiceNode{
+			Slot:               fmt.Sprintf("%d", n.Slot),
+			BlockRoot:          hexutil.Encode(n.BlockRoot),
+			ParentRoot:         hexutil.Encode(n.ParentRoot),
+			JustifiedEpoch:     fmt.Sprintf("%d", n.JustifiedEpoch),
+			FinalizedEpoch:     fmt.Sprintf("%d", n.FinalizedEpoch),
+			Weight:             fmt.Sprintf("%d", n.Weight),
+			ExecutionBlockHash: hexutil.Encode(n.ExecutionBlockHash),
+			Validity:           n.Validity.String(),
+			ExtraData: &ForkChoiceNodeExtraData{
+				UnrealizedJustifiedEpoch: fmt.Sprintf("%d", n.UnrealizedJustifiedEpoch),
+				UnrealizedFinalizedEpoch: fmt.Sprintf("%d", n.UnrealizedFinalizedEpoch),
+				Balance:                  fmt.Sprintf("%d", n.Balance),
+				ExecutionOptimistic:      n.ExecutionOptimistic,
+				TimeStamp:                fmt.Sprintf("%d", n.Timestamp),
+			},
+		}
+	}
+	return &GetForkChoiceDumpResponse{
+		JustifiedCheckpoint: CheckpointFromConsensus(dump.JustifiedCheckpoint),
+		FinalizedCheckpoint: CheckpointFromConsensus(dump.FinalizedCheckpoint),
+		ForkChoiceNodes:     nodes,
+		ExtraData: &ForkChoiceDumpExtraData{
+			UnrealizedJustifiedCheckpoint: CheckpointFromConsensus(dump.UnrealizedJustifiedCheckpoint),
+			UnrealizedFinalizedCheckpoint: CheckpointFromConsensus(dump.UnrealizedFinalizedCheckpoint),
+			ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
+			PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
+			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
+		},
+	}
+}
+
 func (s *SyncCommitteeSubscription) ToConsensus() (*validator.SyncCommitteeSubscription, error) {
 	index, err := strconv.ParseUint(s.ValidatorIndex, 10, 64)
 	if err != nil {
@@ -1513,6 +1549,33 @@ func PendingConsolidationsFromConsensus(cs []*eth.PendingConsolidation) []*Pendi
 	return consolidations
 }
 
+func CommitteeAssignmentsLookaheadFromConsensus(l *eth.CommitteeAssignmentsLookahead) *CommitteeAssignmentsLookahead {
+	return &CommitteeAssignmentsLookahead{
+		CurrentEpoch: CommitteeAssignmentsFromConsensus(l.CurrentEpoch),
+		NextEpoch:    CommitteeAssignmentsFromConsensus(l.NextEpoch),
+	}
+}
+
+func CommitteeAssignmentsFromConsensus(a *eth.CommitteeAssignments) *CommitteeAssignments {
+	committees := make([]*CommitteeAssignment, len(a.Committees))
+	for i, c := range a.Committees {
+		validators := make([]string, len(c.Validators))
+		for j, v := range c.Validators {
+			validators[j] = fmt.Sprintf("%d", v)
+		}
+		committees[i] = &CommitteeAssignment{
+			Slot:           fmt.Sprintf("%d", c.Slot),
+			CommitteeIndex: fmt.Sprintf("%d", c.CommitteeIndex),
+			Subnet:         fmt.Sprintf("%d", c.Subnet),
+			Validators:     validators,
+		}
+	}
+	return &CommitteeAssignments{
+		Epoch:      fmt.Sprintf("%d", a.Epoch),
+		Committees: committees,
+	}
+}
+
 func HeadEventFromV1(event *ethv1.EventHead) *HeadEvent {
 	return &HeadEvent{
 		Slot:                      fmt.Sprintf("%d", event.Slot),
@@ -194,6 +194,121 @@ type WeakSubjectivityData struct {
 	StateRoot    string      `json:"state_root"`
 }
 
+type GetPendingDepositsResponse struct {
+	ExecutionOptimistic bool                    `json:"execution_optimistic"`
+	Finalized           bool                    `json:"finalized"`
+	Data                []*QueuedPendingDeposit `json:"data"`
+	NextPageToken       string                  `json:"next_page_token"`
+	TotalSize           string                  `json:"total_size"`
+}
+
+type QueuedPendingDeposit struct {
+	Position string          `json:"position"`
+	Deposit  *PendingDeposit `json:"deposit"`
+}
+
+type GetPendingPartialWithdrawalsResponse struct {
+	ExecutionOptimistic bool                              `json:"execution_optimistic"`
+	Finalized           bool                              `json:"finalized"`
+	Data                []*QueuedPendingPartialWithdrawal `json:"data"`
+	NextPageToken       string                            `json:"next_page_token"`
+	TotalSize           string                            `json:"total_size"`
+}
+
+type QueuedPendingPartialWithdrawal struct {
+	Position   string                    `json:"position"`
+	Withdrawal *PendingPartialWithdrawal `json:"withdrawal"`
+}
+
+type GetPendingConsolidationsResponse struct {
+	ExecutionOptimistic bool                          `json:"execution_optimistic"`
+	Finalized           bool                          `json:"finalized"`
+	Data                []*QueuedPendingConsolidation `json:"data"`
+	NextPageToken       string                        `json:"next_page_token"`
+	TotalSize           string                        `json:"total_size"`
+}
+
+type QueuedPendingConsolidation struct {
+	Position      string                `json:"position"`
+	Consolidation *PendingConsolidation `json:"consolidation"`
+}
+
+type GetQueueETAResponse struct {
+	ExecutionOptimistic bool      `json:"execution_optimistic"`
+	Finalized           bool      `json:"finalized"`
+	Data                *QueueETA `json:"data"`
+}
+
+type QueueETA struct {
+	Epoch          string              `json:"epoch"`
+	Deposits       []*DepositETA       `json:"deposits"`
+	Activation     *ActivationETA      `json:"activation,omitempty"`
+	Consolidations []*ConsolidationETA `json:"consolidations"`
+}
+
+type DepositETA struct {
+	Position string `json:"position"`
+	Amount   string `json:"amount"`
+	Slot     string `json:"slot"`
+	New      bool   `json:"new"`
+	Epoch    string `json:"epoch,omitempty"`
+	Time     string `json:"time,omitempty"`
+}
+
+type ActivationETA struct {
+	ValidatorIndex   string `json:"validator_index,omitempty"`
+	EligibilityEpoch string `json:"eligibility_epoch"`
+	ActivationEpoch  string `json:"activation_epoch"`
+	ActivationTime   string `json:"activation_time"`
+}
+
+type ConsolidationETA struct {
+	Position    string `json:"position"`
+	SourceIndex string `json:"source_index"`
+	TargetIndex string `json:"target_index"`
+	Epoch       string `json:"epoch"`
+	Time        string `json:"time"`
+}
+
+type GetStateReplaysResponse struct {
+	Data *StateReplays `json:"data"`
+}
+
+type StateReplays struct {
+	Active []*StateReplay `json:"active"`
+	Queued []*StateReplay `json:"queued"`
+}
+
+type StateReplay struct {
+	Priority string `json:"priority"`
+	Slot     string `json:"slot"`
+	Since    string `json:"since"`
+}
+
+type GetBlockTreeResponse struct {
+	Data *BlockTree `json:"data"`
+}
+
+type BlockTree struct {
+	JustifiedCheckpoint *Checkpoint      `json:"justified_checkpoint"`
+	FinalizedCheckpoint *Checkpoint      `json:"finalized_checkpoint"`
+	HeadRoot            string           `json:"head_root"`
+	ProposerBoostRoot   string           `json:"proposer_boost_root"`
+	UpdatedAt           string           `json:"updated_at"`
+	Nodes               []*BlockTreeNode `json:"nodes"`
+}
+
+type BlockTreeNode struct {
+	Root                string `json:"root"`
+	ParentRoot          string `json:"parent_root"`
+	Slot                string `json:"slot"`
+	Weight              string `json:"weight"`
+	Canonical           bool   `json:"canonical"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+	Validity            string `json:"validity"`
+	Timestamp           string `json:"timestamp"`
+}
+
 type GetDepositSnapshotResponse struct {
 	Data *DepositSnapshot `json:"data"`
 }
@@ -250,3 +365,25 @@ type ChainHead struct {
 	PreviousJustifiedBlockRoot string `json:"previous_justified_block_root"`
 	OptimisticStatus           bool   `json:"optimistic_status"`
 }
+
+type GetCommitteeAssignmentsResponse struct {
+	ExecutionOptimistic bool                           `json:"execution_optimistic"`
+	Data                *CommitteeAssignmentsLookahead `json:"data"`
+}
+
+type CommitteeAssignmentsLookahead struct {
+	CurrentEpoch *CommitteeAssignments `json:"current_epoch"`
+	NextEpoch    *CommitteeAssignments `json:"next_epoch"`
+}
+
+type CommitteeAssignments struct {
+	Epoch      string                 `json:"epoch"`
+	Committees []*CommitteeAssignment `json:"committees"`
+}
+
+type CommitteeAssignment struct {
+	Slot           string   `json:"slot"`
+	CommitteeIndex string   `json:"committee_index"`
+	Subnet         string   `json:"subnet"`
+	Validators     []string `json:"validators"`
+}
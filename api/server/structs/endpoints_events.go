@@ -48,6 +48,14 @@ type ChainReorgEvent struct {
 	ExecutionOptimistic bool   `json:"execution_optimistic"`
 }
 
+type FinalityStallEvent struct {
+	Stalled             bool   `json:"stalled"`
+	InactivityLeak      bool   `json:"inactivity_leak"`
+	CurrentEpoch        string `json:"current_epoch"`
+	FinalizedEpoch      string `json:"finalized_epoch"`
+	EpochsSinceFinality string `json:"epochs_since_finality"`
+}
+
 type PayloadAttributesEvent struct {
 	Version string          `json:"version"`
 	Data    json.RawMessage `json:"data"`
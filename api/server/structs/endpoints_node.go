@@ -71,3 +71,139 @@ type AddrRequest struct {
 type PeersResponse struct {
 	Peers []*Peer `json:"peers"`
 }
+
+type FinalityStatusResponse struct {
+	Data *FinalityStatus `json:"data"`
+}
+
+type FinalityStatus struct {
+	Stalled             bool                       `json:"stalled"`
+	InactivityLeak      bool                       `json:"inactivity_leak"`
+	CurrentEpoch        string                     `json:"current_epoch"`
+	FinalizedEpoch      string                     `json:"finalized_epoch"`
+	EpochsSinceFinality string                     `json:"epochs_since_finality"`
+	StallEpochs         string                     `json:"stall_epochs"`
+	Validators          []*ValidatorInactivityLeak `json:"validators"`
+}
+
+type ValidatorInactivityLeak struct {
+	Index            string `json:"index"`
+	EffectiveBalance string `json:"effective_balance"`
+	InactivityScore  string `json:"inactivity_score"`
+	EstimatedPenalty string `json:"estimated_penalty"`
+}
+
+type EngineCapabilitiesResponse struct {
+	Data *EngineCapabilities `json:"data"`
+}
+
+type EngineCapabilities struct {
+	Methods     []string             `json:"methods"`
+	ExchangedAt string               `json:"exchanged_at"`
+	CurrentFork *EngineForkReadiness `json:"current_fork"`
+	NextFork    *EngineForkReadiness `json:"next_fork"`
+}
+
+type EngineForkReadiness struct {
+	Fork           string   `json:"fork"`
+	Epoch          string   `json:"epoch"`
+	Ready          bool     `json:"ready"`
+	MissingMethods []string `json:"missing_methods"`
+}
+
+type ForkReadinessResponse struct {
+	Data *ForkReadiness `json:"data"`
+}
+
+type ForkReadiness struct {
+	CurrentEpoch           string               `json:"current_epoch"`
+	CurrentForkVersion     string               `json:"current_fork_version"`
+	CurrentForkDigest      string               `json:"current_fork_digest"`
+	NextFork               *NextFork            `json:"next_fork"`
+	ConfigDigest           string               `json:"config_digest"`
+	Engine                 *EngineForkReadiness `json:"engine"`
+	BlobParameters         *BlobParameters      `json:"blob_parameters"`
+	ConnectedPeers         string               `json:"connected_peers"`
+	PeersWithForkId        string               `json:"peers_with_fork_id"`
+	CompatiblePeers        string               `json:"compatible_peers"`
+	CompatiblePeersPercent string               `json:"compatible_peers_percent"`
+	PeersOnNextFork        string               `json:"peers_on_next_fork"`
+}
+
+type NextFork struct {
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+	Epoch           string `json:"epoch"`
+	Digest          string `json:"digest"`
+	EpochsUntilFork string `json:"epochs_until_fork"`
+}
+
+type BlobParameters struct {
+	MaxBlobsPerBlock                 string `json:"max_blobs_per_block"`
+	BlobSidecarSubnetCount           string `json:"blob_sidecar_subnet_count"`
+	MaxRequestBlobSidecars           string `json:"max_request_blob_sidecars"`
+	MinEpochsForBlobSidecarsRequests string `json:"min_epochs_for_blob_sidecars_requests"`
+}
+
+type PeerScoresResponse struct {
+	Data []*PeerScore `json:"data"`
+}
+
+type PeerScore struct {
+	PeerId             string        `json:"peer_id"`
+	Score              string        `json:"score"`
+	AppSpecificScore   string        `json:"app_specific_score"`
+	IpColocationFactor string        `json:"ip_colocation_factor"`
+	BehaviourPenalty   string        `json:"behaviour_penalty"`
+	Topics             []*TopicScore `json:"topics"`
+}
+
+type TopicScore struct {
+	Topic                    string `json:"topic"`
+	TimeInMeshMs             string `json:"time_in_mesh_ms"`
+	FirstMessageDeliveries   string `json:"first_message_deliveries"`
+	MeshMessageDeliveries    string `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries string `json:"invalid_message_deliveries"`
+}
+
+type ReachabilityResponse struct {
+	Data *Reachability `json:"data"`
+}
+
+type Reachability struct {
+	Reachability        string         `json:"reachability"`
+	AutonatReachability string         `json:"autonat_reachability"`
+	AdvertisedAddresses []string       `json:"advertised_addresses"`
+	ObservedAddresses   []string       `json:"observed_addresses"`
+	PortMappings        []*PortMapping `json:"port_mappings"`
+	InboundPeers        string         `json:"inbound_peers"`
+}
+
+type PortMapping struct {
+	Protocol        string `json:"protocol"`
+	InternalPort    string `json:"internal_port"`
+	ExternalAddress string `json:"external_address"`
+}
+
+type DiagnosticsBundleResponse struct {
+	Data *DiagnosticsBundle `json:"data"`
+}
+
+type DiagnosticsBundle struct {
+	Path string `json:"path"`
+}
+
+type SlotTimingsResponse struct {
+	Data []*SlotTiming `json:"data"`
+}
+
+type SlotTiming struct {
+	Slot                      string `json:"slot"`
+	BlockRoot                 string `json:"block_root"`
+	BlockFirstSeenMs          string `json:"block_first_seen_ms"`
+	BlobsCompleteMs           string `json:"blobs_complete_ms"`
+	NewPayloadMs              string `json:"new_payload_ms"`
+	ForkchoiceUpdatedMs       string `json:"forkchoice_updated_ms"`
+	HeadUpdatedMs             string `json:"head_updated_ms"`
+	AttestationCutoffMarginMs string `json:"attestation_cutoff_margin_ms"`
+}
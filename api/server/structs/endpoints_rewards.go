@@ -52,3 +52,20 @@ type SyncCommitteeReward struct {
 	ValidatorIndex string `json:"validator_index"`
 	Reward         string `json:"reward"`
 }
+
+type ValidatorIncomeResponse struct {
+	Data                []ValidatorIncome `json:"data"`
+	ExecutionOptimistic bool              `json:"execution_optimistic"`
+	Finalized           bool              `json:"finalized"`
+}
+
+type ValidatorIncome struct {
+	ValidatorIndex string `json:"validator_index"`
+	Head           string `json:"head"`
+	Target         string `json:"target"`
+	Source         string `json:"source"`
+	Inactivity     string `json:"inactivity"`
+	SyncCommittee  string `json:"sync_committee"`
+	Proposals      string `json:"proposals"`
+	Total          string `json:"total"`
+}
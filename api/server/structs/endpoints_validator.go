@@ -139,6 +139,84 @@ type ValidatorParticipation struct {
 	PreviousEpochHeadAttestingGwei   string `json:"previous_epoch_head_attesting_gwei"`
 }
 
+type GetValidatorBalanceHistoryResponse struct {
+	Epochs    []string                   `json:"epochs"`
+	Data      []*ValidatorBalanceHistory `json:"data"`
+	Finalized bool                       `json:"finalized"`
+}
+
+type ValidatorBalanceHistory struct {
+	Index    string   `json:"index"`
+	Balances []string `json:"balances"`
+}
+
+type GetTrackedProposersResponse struct {
+	Data []*TrackedProposer `json:"data"`
+}
+
+type TrackedProposer struct {
+	ValidatorIndex string `json:"validator_index"`
+	FeeRecipient   string `json:"fee_recipient"`
+	Active         bool   `json:"active"`
+	Source         string `json:"source"`
+}
+
+type GetSyncCommitteePerformanceResponse struct {
+	Data []*SyncCommitteePerformance `json:"data"`
+}
+
+type SyncCommitteePerformance struct {
+	ValidatorIndex string `json:"validator_index"`
+	Period         string `json:"period"`
+	Expected       string `json:"expected"`
+	Included       string `json:"included"`
+	SuccessRate    string `json:"success_rate"`
+	LastMissedSlot string `json:"last_missed_slot"`
+}
+
+type GetProposalPreflightResponse struct {
+	Data *ProposalPreflight `json:"data"`
+}
+
+type ProposalPreflight struct {
+	Ready  bool                      `json:"ready"`
+	Checks []*ProposalPreflightCheck `json:"checks"`
+}
+
+type ProposalPreflightCheck struct {
+	Name          string `json:"name"`
+	Ok            bool   `json:"ok"`
+	Informational bool   `json:"informational,omitempty"`
+	Message       string `json:"message,omitempty"`
+}
+
+type GetPayloadValuesResponse struct {
+	Data []*ProposalPayloadValues `json:"data"`
+}
+
+type ProposalPayloadValues struct {
+	Slot              string              `json:"slot"`
+	ProposerIndex     string              `json:"proposer_index"`
+	Chosen            string              `json:"chosen"`
+	Local             *PayloadAlternative `json:"local"`
+	Builder           *PayloadAlternative `json:"builder,omitempty"`
+	PreferredGasLimit string              `json:"preferred_gas_limit,omitempty"`
+}
+
+type PayloadAlternative struct {
+	Value         string `json:"value"`
+	GasLimit      string `json:"gas_limit"`
+	BlobCount     string `json:"blob_count"`
+	BlobGasUsed   string `json:"blob_gas_used"`
+	ExcessBlobGas string `json:"excess_blob_gas"`
+	BlobBaseFee   string `json:"blob_base_fee,omitempty"`
+}
+
+type ValidatorGasLimit struct {
+	ValidatorIndex string `json:"validator_index"`
+	GasLimit       string `json:"gas_limit"`
+}
+
 type ActiveSetChanges struct {
 	Epoch               string   `json:"epoch"`
 	ActivatedPublicKeys []string `json:"activated_public_keys"`
@@ -0,0 +1,55 @@
+package blockchain
+
+import (
+	"sync"
+	"time"
+)
+
+// importDrainTimeout is the time the service waits for the block imports in flight when stopping.
+const importDrainTimeout = 10 * time.Second
+
+// blockImports tracks the block imports in flight, so that the service stops once they are complete rather than
+// in the middle of an import.
+type blockImports struct {
+	lock    sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// start registers a block import, and returns false once the imports are stopped.
+func (b *blockImports) start() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.stopped {
+		return false
+	}
+	b.wg.Add(1)
+	return true
+}
+
+// done marks an import registered with start as complete.
+func (b *blockImports) done() {
+	b.wg.Done()
+}
+
+// stop refuses new imports and waits for the imports in flight for up to the given timeout. It returns false when
+// imports are still in flight after the timeout.
+func (b *blockImports) stop(timeout time.Duration) bool {
+	b.lock.Lock()
+	b.stopped = true
+	b.lock.Unlock()
+
+	complete := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(complete)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-complete:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
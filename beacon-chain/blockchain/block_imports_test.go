@@ -0,0 +1,41 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestBlockImports_StopWaitsForImports(t *testing.T) {
+	var b blockImports
+	require.Equal(t, true, b.start())
+
+	stopped := make(chan bool)
+	go func() {
+		stopped <- b.stop(time.Second)
+	}()
+	select {
+	case <-stopped:
+		t.Fatal("stop returned while an import is in flight")
+	case <-time.After(50 * time.Millisecond):
+	}
+	b.done()
+	require.Equal(t, true, <-stopped)
+	require.Equal(t, false, b.start())
+}
+
+func TestBlockImports_StopTimeout(t *testing.T) {
+	var b blockImports
+	require.Equal(t, true, b.start())
+	require.Equal(t, false, b.stop(10*time.Millisecond))
+	b.done()
+}
+
+func TestService_ReceiveBlockAfterStop(t *testing.T) {
+	s := &Service{}
+	require.Equal(t, true, s.blockImports.stop(time.Second))
+	require.ErrorIs(t, s.ReceiveBlock(context.Background(), nil, [32]byte{}, nil), errServiceStopping)
+	require.ErrorIs(t, s.ReceiveBlockBatch(context.Background(), nil, nil), errServiceStopping)
+}
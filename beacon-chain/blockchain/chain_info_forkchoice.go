@@ -23,6 +23,26 @@ func (s *Service) GetProposerHead() [32]byte {
 	return s.cfg.ForkChoiceStore.GetProposerHead()
 }
 
+// ShouldOverrideFCU returns whether the forkchoice update of the head is withheld from the engine, in order for the
+// tracked proposer of the slot following the head to reorg the head.
+func (s *Service) ShouldOverrideFCU(ctx context.Context) (bool, error) {
+	headRoot, err := s.HeadRoot(ctx)
+	if err != nil {
+		return false, err
+	}
+	headState, err := s.HeadStateReadOnly(ctx)
+	if err != nil {
+		return false, err
+	}
+	proposingSlot := s.HeadSlot() + 1
+	if _, ok := s.trackedProposer(headState, proposingSlot); !ok {
+		return false, nil
+	}
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.shouldOverrideFCU([32]byte(headRoot), proposingSlot), nil
+}
+
 // SetForkChoiceGenesisTime sets the genesis time in Forkchoice
 func (s *Service) SetForkChoiceGenesisTime(timestamp uint64) {
 	s.cfg.ForkChoiceStore.Lock()
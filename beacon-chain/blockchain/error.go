@@ -19,12 +19,16 @@ var (
 	errBlockDoesNotExist = errors.New("could not find block in DB")
 	// errBlockNotFoundInCacheOrDB is returned when a block is not found in the cache or DB.
 	errBlockNotFoundInCacheOrDB = errors.New("block not found in cache or db")
+	// errServiceStopping is returned when a block is received while the service is stopping.
+	errServiceStopping = errors.New("blockchain service is stopping")
 	// errWSBlockNotFound is returned when a block is not found in the WS cache or DB.
 	errWSBlockNotFound = errors.New("weak subjectivity root not found in db")
 	// errWSBlockNotFoundInEpoch is returned when a block is not found in the WS cache or DB within epoch.
 	errWSBlockNotFoundInEpoch = errors.New("weak subjectivity root not found in db within epoch")
 	// ErrNotDescendantOfFinalized is returned when a block is not a descendant of the finalized checkpoint
 	ErrNotDescendantOfFinalized = invalidBlock{error: errors.New("not descendant of finalized checkpoint")}
+	// ErrNotDescendantOfWeakSubjectivity is returned when a block is not a descendant of the weak subjectivity checkpoint
+	ErrNotDescendantOfWeakSubjectivity = invalidBlock{error: errors.New("not descendant of weak subjectivity checkpoint")}
 	// ErrNotCheckpoint is returned when a given checkpoint is not a
 	// checkpoint in any chain known to forkchoice
 	ErrNotCheckpoint = errors.New("not a checkpoint in forkchoice")
@@ -3,13 +3,15 @@ package blockchain
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/electra"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
+	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -22,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/sirupsen/logrus"
@@ -69,7 +72,9 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *fcuConfig) (*
 	if arg.attributes == nil {
 		arg.attributes = payloadattribute.EmptyWithVersion(headBlk.Version())
 	}
+	fcuStart := time.Now()
 	payloadID, lastValidHash, err := s.cfg.ExecutionEngineCaller.ForkchoiceUpdated(ctx, fcs, arg.attributes)
+	s.cfg.SlotTimings.SetForkchoiceUpdated(headBlk.Slot(), time.Since(fcuStart))
 	if err != nil {
 		switch {
 		case errors.Is(err, execution.ErrAcceptedSyncingPayloadStatus):
@@ -100,7 +105,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *fcuConfig) (*
 			if err != nil {
 				log.WithFields(logrus.Fields{
 					"slot":                 headBlk.Slot(),
-					"blockRoot":            fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+					"blockRoot":            logging.Root(headRoot),
 					"invalidChildrenCount": len(invalidRoots),
 				}).Warn("Pruned invalid blocks, could not update head root")
 				return nil, invalidBlock{error: ErrInvalidPayload, root: arg.headRoot, invalidAncestorRoots: invalidRoots}
@@ -131,7 +136,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *fcuConfig) (*
 
 			log.WithFields(logrus.Fields{
 				"slot":                 headBlk.Slot(),
-				"blockRoot":            fmt.Sprintf("%#x", bytesutil.Trunc(headRoot[:])),
+				"blockRoot":            logging.Root(headRoot),
 				"invalidChildrenCount": len(invalidRoots),
 				"newHeadRoot":          fmt.Sprintf("%#x", bytesutil.Trunc(r[:])),
 			}).Warn("Pruned invalid blocks")
@@ -153,7 +158,7 @@ func (s *Service) notifyForkchoiceUpdate(ctx context.Context, arg *fcuConfig) (*
 		var pId [8]byte
 		copy(pId[:], payloadID[:])
 		log.WithFields(logrus.Fields{
-			"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(arg.headRoot[:])),
+			"blockRoot": logging.Root(arg.headRoot),
 			"headSlot":  headBlk.Slot(),
 			"payloadID": fmt.Sprintf("%#x", bytesutil.Trunc(payloadID[:])),
 		}).Info("Forkchoice updated with payload attributes for proposal")
@@ -232,8 +237,13 @@ func (s *Service) notifyNewPayload(ctx context.Context, preStateVersion int,
 		if err != nil {
 			return false, errors.Wrap(err, "could not get execution requests")
 		}
+		if err := electra.ValidateExecutionRequests(requests); err != nil {
+			return false, errors.Wrap(invalidBlock{error: err}, "invalid execution requests")
+		}
 	}
+	newPayloadStart := time.Now()
 	lastValidHash, err = s.cfg.ExecutionEngineCaller.NewPayload(ctx, payload, versionedHashes, parentRoot, requests)
+	s.cfg.SlotTimings.SetNewPayload(blk.Block().Slot(), time.Since(newPayloadStart))
 
 	switch {
 	case err == nil:
@@ -268,7 +278,7 @@ func (s *Service) pruneInvalidBlock(ctx context.Context, root, parentRoot, lvh [
 		return err
 	}
 	log.WithFields(logrus.Fields{
-		"blockRoot":            fmt.Sprintf("%#x", root),
+		"blockRoot":            logging.Root(root),
 		"invalidChildrenCount": len(invalidRoots),
 	}).Warn("Pruned invalid blocks")
 	return invalidBlock{
@@ -313,7 +323,7 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 		}
 	}
 	// Get previous randao.
-	prevRando, err := helpers.RandaoMix(st, time.CurrentEpoch(st))
+	prevRando, err := helpers.RandaoMix(st, coreTime.CurrentEpoch(st))
 	if err != nil {
 		log.WithError(err).Error("Could not get randao mix to get payload attribute")
 		return emptyAttri
@@ -376,11 +386,32 @@ func (s *Service) getPayloadAttribute(ctx context.Context, st state.BeaconState,
 		return emptyAttri
 	}
 
+	s.setGasLimitTarget(ctx, st, slot)
 	return attr
 }
 
-// removeInvalidBlockAndState removes the invalid block, blob and its corresponding state from the cache and DB.
+// setGasLimitTarget sets the gas limit preferred by the proposer of the slot as the target of the execution client,
+// before the execution client is asked to build the payload of the proposal. The default target is restored for a
+// proposer without preference, so that it does not inherit the preference of a previous proposer.
+func (s *Service) setGasLimitTarget(ctx context.Context, st state.BeaconState, slot primitives.Slot) {
+	proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, slot)
+	if err != nil {
+		return
+	}
+	// A gas limit of 0 restores the default target.
+	gasLimit, _ := s.cfg.TrackedValidatorsCache.GasLimit(proposer)
+	if err := s.cfg.ExecutionEngineCaller.SetGasLimitTarget(ctx, gasLimit); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"validatorIndex": proposer,
+			"gasLimit":       gasLimit,
+		}).Warn("Could not set the gas limit target of the execution client")
+	}
+}
+
+// removeInvalidBlockAndState removes the invalid block, blob and its corresponding state from the cache and DB. The
+// operations of the removed blocks are inserted back into the operation pools.
 func (s *Service) removeInvalidBlockAndState(ctx context.Context, blkRoots [][32]byte) error {
+	orphaned := s.orphanedBlocks(ctx, blkRoots)
 	for _, root := range blkRoots {
 		if err := s.cfg.StateGen.DeleteStateFromCaches(ctx, root); err != nil {
 			return err
@@ -396,6 +427,7 @@ func (s *Service) removeInvalidBlockAndState(ctx context.Context, blkRoots [][32
 			log.WithError(err).Debug("Could not remove blob from blob storage")
 		}
 	}
+	s.reinsertOrphanedOperations(ctx, orphaned)
 	return nil
 }
 
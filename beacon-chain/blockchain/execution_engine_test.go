@@ -809,6 +809,27 @@ func Test_GetPayloadAttribute(t *testing.T) {
 	require.Equal(t, suggestedAddr, common.BytesToAddress(attr.SuggestedFeeRecipient()))
 }
 
+func Test_GetPayloadAttribute_GasLimitTarget(t *testing.T) {
+	engine := &mockExecution.EngineClient{}
+	service, tr := minimalTestService(t, WithPayloadIDCache(cache.NewPayloadIDCache()), WithExecutionEngineCaller(engine))
+	ctx := tr.ctx
+
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	service.cfg.TrackedValidatorsCache.Set(cache.TrackedValidator{Active: true, Index: 0})
+	attr := service.getPayloadAttribute(ctx, st, 1, params.BeaconConfig().ZeroHash[:])
+	require.Equal(t, false, attr.IsEmpty())
+
+	service.cfg.TrackedValidatorsCache.SetGasLimit(0, 36_000_000)
+	attr = service.getPayloadAttribute(ctx, st, 1, params.BeaconConfig().ZeroHash[:])
+	require.Equal(t, false, attr.IsEmpty())
+
+	// The default target is restored once the preference is removed.
+	service.cfg.TrackedValidatorsCache.SetGasLimit(0, 0)
+	attr = service.getPayloadAttribute(ctx, st, 1, params.BeaconConfig().ZeroHash[:])
+	require.Equal(t, false, attr.IsEmpty())
+	require.DeepEqual(t, []uint64{0, 36_000_000, 0}, engine.GasLimitTargets)
+}
+
 func Test_GetPayloadAttribute_PrepareAllPayloads(t *testing.T) {
 	resetCfg := features.InitWithReset(&features.Flags{
 		PrepareAllPayloads: true,
@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -189,3 +190,49 @@ func TestShouldOverrideFCU(t *testing.T) {
 	require.Equal(t, false, service.shouldOverrideFCU(parentRoot, 3))
 	require.LogsContain(t, hook, "10 seconds")
 }
+
+func TestService_ShouldOverrideFCU(t *testing.T) {
+	resetCfg := features.InitWithReset(&features.Flags{
+		PrepareAllPayloads: true,
+	})
+	defer resetCfg()
+
+	service, tr := minimalTestService(t)
+	ctx, fcs := tr.ctx, tr.fcs
+
+	// The head of slot 2 arrives late, during slot 3.
+	genesis := time.Now().Add(-time.Duration(3*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	service.SetGenesisTime(genesis)
+	fcs.SetGenesisTime(uint64(genesis.Unix()))
+	headRoot := [32]byte{'b'}
+	parentRoot := [32]byte{'a'}
+	ojc := &ethpb.Checkpoint{}
+	st, root, err := prepareForkchoiceState(ctx, 1, parentRoot, [32]byte{}, [32]byte{}, ojc, ojc)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+	st, root, err = prepareForkchoiceState(ctx, 2, headRoot, parentRoot, [32]byte{}, ojc, ojc)
+	require.NoError(t, err)
+	require.NoError(t, fcs.InsertNode(ctx, st, root))
+	headRootFC, err := fcs.Head(ctx)
+	require.NoError(t, err)
+	require.Equal(t, headRoot, headRootFC)
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 2
+	headBlock, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	service.head = &head{root: headRoot, block: headBlock, state: st, slot: 2}
+
+	// The proposer of slot 3, the slot following the head, reorgs the late head.
+	require.Equal(t, primitives.Slot(3), service.CurrentSlot())
+	override, err := service.ShouldOverrideFCU(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, override)
+
+	// After a skipped slot the head is no longer subject to a reorg.
+	genesis = genesis.Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	service.SetGenesisTime(genesis)
+	fcs.SetGenesisTime(uint64(genesis.Unix()))
+	override, err = service.ShouldOverrideFCU(ctx)
+	require.NoError(t, err)
+	require.Equal(t, false, override)
+}
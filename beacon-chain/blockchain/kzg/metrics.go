@@ -0,0 +1,20 @@
+package kzg
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	kzgVerificationTime = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "blob_kzg_verification_milliseconds",
+			Help:    "Captures the time to verify the KZG proofs of a set of blob sidecars in a milliseconds distribution.",
+			Buckets: []float64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000},
+		},
+	)
+	kzgVerifiedBlobs = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "blob_kzg_verified_total",
+		Help: "The number of blob sidecars of which the KZG proof was verified.",
+	})
+)
@@ -1,21 +1,51 @@
 package kzg
 
 import (
+	"runtime"
+	"time"
+
 	GoKZG "github.com/crate-crypto/go-kzg-4844"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"golang.org/x/sync/errgroup"
 )
 
+// minBlobsPerWorker is the least number of blobs a worker verifies in a batch, as the fixed cost of a batch verification
+// outweighs the gain of spreading few blobs over more workers.
+const minBlobsPerWorker = 4
+
 // Verify performs single or batch verification of commitments depending on the number of given BlobSidecars.
+// Large batches, such as the sidecars of several blocks during sync, are split into batches verified in parallel.
 func Verify(sidecars ...blocks.ROBlob) error {
 	if len(sidecars) == 0 {
 		return nil
 	}
+	start := time.Now()
+	defer func() {
+		kzgVerificationTime.Observe(float64(time.Since(start).Milliseconds()))
+		kzgVerifiedBlobs.Add(float64(len(sidecars)))
+	}()
 	if len(sidecars) == 1 {
 		return kzgContext.VerifyBlobKZGProof(
 			bytesToBlob(sidecars[0].Blob),
 			bytesToCommitment(sidecars[0].KzgCommitment),
 			bytesToKZGProof(sidecars[0].KzgProof))
 	}
+	workers := min(runtime.GOMAXPROCS(0), (len(sidecars)+minBlobsPerWorker-1)/minBlobsPerWorker)
+	if workers <= 1 {
+		return verifyBatch(sidecars)
+	}
+	size := (len(sidecars) + workers - 1) / workers
+	var g errgroup.Group
+	for i := 0; i < len(sidecars); i += size {
+		chunk := sidecars[i:min(i+size, len(sidecars))]
+		g.Go(func() error {
+			return verifyBatch(chunk)
+		})
+	}
+	return g.Wait()
+}
+
+func verifyBatch(sidecars []blocks.ROBlob) error {
 	blobs := make([]GoKZG.Blob, len(sidecars))
 	cmts := make([]GoKZG.KZGCommitment, len(sidecars))
 	proofs := make([]GoKZG.KZGProof, len(sidecars))
@@ -9,6 +9,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	GoKZG "github.com/crate-crypto/go-kzg-4844"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/sirupsen/logrus"
 )
@@ -63,6 +64,26 @@ func TestVerify(t *testing.T) {
 	require.NoError(t, Verify(sidecars...))
 }
 
+func TestVerify_Parallel(t *testing.T) {
+	require.NoError(t, Start())
+	sidecars := make([]blocks.ROBlob, 3*minBlobsPerWorker)
+	for i := range sidecars {
+		blob := GetRandBlob(int64(i))
+		commitment, proof, err := GenerateCommitmentAndProof(blob)
+		require.NoError(t, err)
+		sidecars[i] = blocks.ROBlob{BlobSidecar: &ethpb.BlobSidecar{
+			Blob:          blob[:],
+			KzgCommitment: commitment[:],
+			KzgProof:      proof[:],
+		}}
+	}
+	require.NoError(t, Verify(sidecars...))
+
+	// An invalid proof in any of the batches verified in parallel fails the verification.
+	sidecars[len(sidecars)-1].KzgProof = sidecars[0].KzgProof
+	require.NotNil(t, Verify(sidecars...))
+}
+
 func TestBytesToAny(t *testing.T) {
 	bytes := []byte{0x01, 0x02}
 	blob := GoKZG.Blob{0x01, 0x02}
@@ -1,7 +1,6 @@
 package blockchain
 
 import (
-	"encoding/hex"
 	"fmt"
 	"time"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -78,19 +78,18 @@ func logBlockSyncStatus(block interfaces.ReadOnlyBeaconBlock, blockRoot [32]byte
 	if err != nil {
 		return err
 	}
-	level := log.Logger.GetLevel()
-	if level >= logrus.DebugLevel {
+	if logging.IsLevelEnabled(log, logrus.DebugLevel) {
 		parentRoot := block.ParentRoot()
 		lf := logrus.Fields{
 			"slot":                       block.Slot(),
 			"slotInEpoch":                block.Slot() % params.BeaconConfig().SlotsPerEpoch,
-			"block":                      fmt.Sprintf("0x%s...", hex.EncodeToString(blockRoot[:])[:8]),
+			"blockRoot":                  logging.Root(blockRoot),
 			"epoch":                      slots.ToEpoch(block.Slot()),
 			"justifiedEpoch":             justified.Epoch,
-			"justifiedRoot":              fmt.Sprintf("0x%s...", hex.EncodeToString(justified.Root)[:8]),
+			"justifiedRoot":              logging.Root(bytesutil.ToBytes32(justified.Root)),
 			"finalizedEpoch":             finalized.Epoch,
-			"finalizedRoot":              fmt.Sprintf("0x%s...", hex.EncodeToString(finalized.Root)[:8]),
-			"parentRoot":                 fmt.Sprintf("0x%s...", hex.EncodeToString(parentRoot[:])[:8]),
+			"finalizedRoot":              logging.Root(bytesutil.ToBytes32(finalized.Root)),
+			"parentRoot":                 logging.Root(parentRoot),
 			"version":                    version.String(block.Version()),
 			"sinceSlotStartTime":         prysmTime.Now().Sub(startTime),
 			"chainServiceProcessedTime":  prysmTime.Now().Sub(receivedTime) - daWaitedTime,
@@ -101,9 +100,9 @@ func logBlockSyncStatus(block interfaces.ReadOnlyBeaconBlock, blockRoot [32]byte
 	} else {
 		log.WithFields(logrus.Fields{
 			"slot":           block.Slot(),
-			"block":          fmt.Sprintf("0x%s...", hex.EncodeToString(blockRoot[:])[:8]),
+			"blockRoot":      logging.Root(blockRoot),
 			"finalizedEpoch": finalized.Epoch,
-			"finalizedRoot":  fmt.Sprintf("0x%s...", hex.EncodeToString(finalized.Root)[:8]),
+			"finalizedRoot":  logging.Root(bytesutil.ToBytes32(finalized.Root)),
 			"epoch":          slots.ToEpoch(block.Slot()),
 		}).Info("Synced new block")
 	}
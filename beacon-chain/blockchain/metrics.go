@@ -186,6 +186,21 @@ var (
 		Name: "da_waited_time_milliseconds",
 		Help: "Total time spent waiting for a data availability check in ReceiveBlock()",
 	})
+	slotTaskLateness = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "slot_task_lateness_milliseconds",
+			Help:    "Captures the time slot tasks start after their scheduled time in milliseconds",
+			Buckets: []float64{1, 5, 20, 100, 500, 1000, 4000},
+		},
+		[]string{"task"},
+	)
+	slotTasksSkipped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "slot_tasks_skipped_total",
+			Help: "Number of slot task occurrences skipped because the previous occurrence of the task was still running",
+		},
+		[]string{"task"},
+	)
 	processAttsElapsedTime = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "process_attestations_milliseconds",
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -17,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/slotprofile"
 )
 
 type Option func(s *Service) error
@@ -85,6 +87,14 @@ func WithTrackedValidatorsCache(c *cache.TrackedValidatorsCache) Option {
 	}
 }
 
+// WithSlotTimingsCache for recording the timings of the blocks of the recent slots.
+func WithSlotTimingsCache(c *cache.SlotTimingsCache) Option {
+	return func(s *Service) error {
+		s.cfg.SlotTimings = c
+		return nil
+	}
+}
+
 // WithAttestationPool for attestation lifecycle after chain inclusion.
 func WithAttestationPool(p attestations.Pool) Option {
 	return func(s *Service) error {
@@ -205,3 +215,25 @@ func WithSyncChecker(checker Checker) Option {
 		return nil
 	}
 }
+
+// WithSlotTaskTimings overrides the times of the given slot tasks.
+func WithSlotTaskTimings(timings map[string]SlotTaskTiming) Option {
+	return func(s *Service) error {
+		defaults := defaultSlotTaskTimings()
+		for name := range timings {
+			if _, ok := defaults[name]; !ok {
+				return errors.Errorf("unknown slot task %s", name)
+			}
+		}
+		s.cfg.SlotTaskTimings = timings
+		return nil
+	}
+}
+
+// WithSlotProfiler for profiling the imports of blocks slower than its threshold.
+func WithSlotProfiler(p *slotprofile.Profiler) Option {
+	return func(s *Service) error {
+		s.cfg.SlotProfiler = p
+		return nil
+	}
+}
@@ -0,0 +1,227 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+)
+
+// orphanedBlocks returns the blocks of the roots which are in the DB, before they are removed as invalid.
+func (s *Service) orphanedBlocks(ctx context.Context, roots [][32]byte) []interfaces.ReadOnlySignedBeaconBlock {
+	orphaned := make([]interfaces.ReadOnlySignedBeaconBlock, 0, len(roots))
+	for _, root := range roots {
+		blk, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil {
+			log.WithError(err).WithField("blockRoot", bytesutil.Trunc(root[:])).Debug("Could not get orphaned block")
+			continue
+		}
+		if blocks.BeaconBlockIsNil(blk) != nil {
+			continue
+		}
+		orphaned = append(orphaned, blk)
+	}
+	return orphaned
+}
+
+// reinsertOrphanedOperations inserts the operations of the orphaned blocks back into the operation pools, so that
+// operations which were only included in the orphaned blocks are included in a later canonical block. Attestations
+// already included by the canonical chain are skipped, and slashings are verified against the state of the valid
+// ancestor of the orphaned blocks, which drops the slashings the canonical chain already included. The other
+// operations are verified against the head state when a block is proposed.
+func (s *Service) reinsertOrphanedOperations(ctx context.Context, orphaned []interfaces.ReadOnlySignedBeaconBlock) {
+	ancestor, err := validAncestor(orphaned)
+	if err != nil {
+		log.WithError(err).Debug("Could not get valid ancestor of orphaned blocks")
+		return
+	}
+	canonical := s.canonicalAttestations(ctx, ancestor, orphaned)
+
+	var atts, exits, blsChanges int
+	for _, blk := range orphaned {
+		body := blk.Block().Body()
+		for _, att := range body.Attestations() {
+			if err := s.reinsertOrphanedAttestation(att, canonical); err != nil {
+				log.WithError(err).Debug("Could not reinsert attestation of orphaned block")
+				continue
+			}
+			atts++
+		}
+		for _, e := range body.VoluntaryExits() {
+			s.cfg.ExitPool.InsertVoluntaryExit(e)
+			exits++
+		}
+		if blk.Version() >= version.Capella {
+			changes, err := body.BLSToExecutionChanges()
+			if err != nil {
+				log.WithError(err).Debug("Could not get BLS to execution changes of orphaned block")
+			}
+			for _, c := range changes {
+				s.cfg.BLSToExecPool.InsertBLSToExecChange(c)
+				blsChanges++
+			}
+		}
+	}
+	slashings := s.reinsertOrphanedSlashings(ctx, ancestor, orphaned)
+
+	if atts+exits+blsChanges+slashings > 0 {
+		log.WithFields(logrus.Fields{
+			"orphanedBlocks": len(orphaned),
+			"attestations":   atts,
+			"voluntaryExits": exits,
+			"blsChanges":     blsChanges,
+			"slashings":      slashings,
+		}).Info("Reinserted operations of orphaned blocks into the pools")
+	}
+}
+
+// validAncestor returns the root of the parent of the orphaned blocks which is not orphaned itself.
+func validAncestor(orphaned []interfaces.ReadOnlySignedBeaconBlock) ([32]byte, error) {
+	roots := make(map[[32]byte]bool, len(orphaned))
+	for _, blk := range orphaned {
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return [32]byte{}, errors.Wrap(err, "could not compute root of orphaned block")
+		}
+		roots[root] = true
+	}
+	for _, blk := range orphaned {
+		if parent := blk.Block().ParentRoot(); !roots[parent] {
+			return parent, nil
+		}
+	}
+	return [32]byte{}, errors.New("orphaned blocks have no valid ancestor")
+}
+
+// canonicalAttestations returns the attestations included by the canonical chain of the valid ancestor, by root of
+// their data, in the blocks which could include the attestations of the orphaned blocks.
+func (s *Service) canonicalAttestations(
+	ctx context.Context,
+	ancestor [32]byte,
+	orphaned []interfaces.ReadOnlySignedBeaconBlock,
+) map[[32]byte][]ethpb.Att {
+	minSlot := primitives.Slot(math.MaxUint64)
+	for _, blk := range orphaned {
+		for _, att := range blk.Block().Body().Attestations() {
+			minSlot = min(minSlot, att.GetData().Slot)
+		}
+	}
+	canonical := make(map[[32]byte][]ethpb.Att)
+	root := ancestor
+	for root != params.BeaconConfig().ZeroHash {
+		blk, err := s.cfg.BeaconDB.Block(ctx, root)
+		if err != nil || blocks.BeaconBlockIsNil(blk) != nil {
+			break
+		}
+		// Attestations are included in blocks after their slot.
+		if blk.Block().Slot() <= minSlot {
+			break
+		}
+		for _, att := range blk.Block().Body().Attestations() {
+			dataRoot, err := att.GetData().HashTreeRoot()
+			if err != nil {
+				continue
+			}
+			canonical[dataRoot] = append(canonical[dataRoot], att)
+		}
+		root = blk.Block().ParentRoot()
+	}
+	return canonical
+}
+
+// includedIn returns whether one of the canonical attestations with the data of the attestation includes all of its
+// attesters.
+func includedIn(att ethpb.Att, canonical map[[32]byte][]ethpb.Att) bool {
+	dataRoot, err := att.GetData().HashTreeRoot()
+	if err != nil {
+		return false
+	}
+	for _, c := range canonical[dataRoot] {
+		if c.Version() != att.Version() {
+			continue
+		}
+		if att.Version() >= version.Electra && !bytes.Equal(c.CommitteeBitsVal().Bytes(), att.CommitteeBitsVal().Bytes()) {
+			continue
+		}
+		if c.GetAggregationBits().Len() != att.GetAggregationBits().Len() {
+			continue
+		}
+		if ok, err := c.GetAggregationBits().Contains(att.GetAggregationBits()); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) reinsertOrphanedAttestation(att ethpb.Att, canonical map[[32]byte][]ethpb.Att) error {
+	// The aggregation bits of an on chain aggregate span the committees of its committee bits, and its signature
+	// cannot be split back into the aggregates of each committee kept by the pool.
+	if att.Version() >= version.Electra && len(att.CommitteeBitsVal().BitIndices()) != 1 {
+		return errors.New("attestation aggregates several committees")
+	}
+	if includedIn(att, canonical) {
+		return errors.New("attestation is included in the canonical chain")
+	}
+	// The attestation was marked as seen when it was pruned from the pool for the orphaned block.
+	if err := s.cfg.AttPool.DeleteSeenBits(att); err != nil {
+		return err
+	}
+	if helpers.IsAggregated(att) {
+		return s.cfg.AttPool.SaveAggregatedAttestation(att)
+	}
+	return s.cfg.AttPool.SaveUnaggregatedAttestation(att)
+}
+
+// reinsertOrphanedSlashings inserts the slashings of the orphaned blocks back into the slashing pool, verified against
+// the state of their valid ancestor, and returns the number of inserted slashings.
+func (s *Service) reinsertOrphanedSlashings(
+	ctx context.Context,
+	ancestor [32]byte,
+	orphaned []interfaces.ReadOnlySignedBeaconBlock,
+) int {
+	hasSlashings := false
+	for _, blk := range orphaned {
+		body := blk.Block().Body()
+		hasSlashings = hasSlashings || len(body.ProposerSlashings()) > 0 || len(body.AttesterSlashings()) > 0
+	}
+	if !hasSlashings {
+		return 0
+	}
+	st, err := s.cfg.StateGen.StateByRoot(ctx, ancestor)
+	if err != nil {
+		log.WithError(err).Debug("Could not get state of valid ancestor of orphaned blocks")
+		return 0
+	}
+
+	n := 0
+	for _, blk := range orphaned {
+		body := blk.Block().Body()
+		for _, ps := range body.ProposerSlashings() {
+			s.cfg.SlashingPool.MarkOrphanedProposerSlashing(ps)
+			if err := s.cfg.SlashingPool.InsertProposerSlashing(ctx, st, ps); err != nil {
+				log.WithError(err).Debug("Could not reinsert proposer slashing of orphaned block")
+				continue
+			}
+			n++
+		}
+		for _, as := range body.AttesterSlashings() {
+			s.cfg.SlashingPool.MarkOrphanedAttesterSlashing(as)
+			if err := s.cfg.SlashingPool.InsertAttesterSlashing(ctx, st, as); err != nil {
+				log.WithError(err).Debug("Could not reinsert attester slashing of orphaned block")
+				continue
+			}
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,151 @@
+package blockchain
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestService_removeInvalidBlockAndState_ReinsertsOperations(t *testing.T) {
+	service, tr := minimalTestService(t,
+		WithExitPool(voluntaryexits.NewPool()),
+		WithSlashingPool(slashings.NewPool()),
+	)
+	ctx := tr.ctx
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	parentRoot := [32]byte{'p'}
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, st, parentRoot))
+
+	ps, err := util.GenerateProposerSlashingForValidator(st, keys[3], 3)
+	require.NoError(t, err)
+	exit := &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{ValidatorIndex: 5}, Signature: make([]byte, 96)}
+	att := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}})
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 1
+	b.Block.ParentRoot = parentRoot[:]
+	b.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{ps}
+	b.Block.Body.VoluntaryExits = []*ethpb.SignedVoluntaryExit{exit}
+	b.Block.Body.Attestations = []*ethpb.Attestation{att}
+	blk := util.SaveBlock(t, ctx, service.cfg.BeaconDB, b)
+	r, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// The operations of the block were pruned from the pools when it became the head.
+	require.NoError(t, service.cfg.SlashingPool.InsertProposerSlashing(ctx, st, ps))
+	service.cfg.SlashingPool.MarkIncludedProposerSlashing(ps)
+	service.cfg.ExitPool.InsertVoluntaryExit(exit)
+	service.cfg.ExitPool.MarkIncluded(exit)
+	require.NoError(t, service.cfg.AttPool.SaveAggregatedAttestation(att))
+	require.NoError(t, service.pruneAttsFromPool(blk))
+	require.Equal(t, 0, service.cfg.AttPool.AggregatedAttestationCount())
+
+	require.NoError(t, service.removeInvalidBlockAndState(ctx, [][32]byte{r}))
+
+	require.Equal(t, false, service.hasBlock(ctx, r))
+	require.Equal(t, 1, len(service.cfg.SlashingPool.PendingProposerSlashings(ctx, st, true)))
+	exits, err := service.cfg.ExitPool.PendingExits()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exits))
+	require.Equal(t, primitives.ValidatorIndex(5), exits[0].Exit.ValidatorIndex)
+	require.Equal(t, 1, service.cfg.AttPool.AggregatedAttestationCount())
+}
+
+func TestService_reinsertOrphanedOperations_SlashedInAncestor(t *testing.T) {
+	service, tr := minimalTestService(t, WithSlashingPool(slashings.NewPool()))
+	ctx := tr.ctx
+
+	st, keys := util.DeterministicGenesisState(t, 64)
+	ps, err := util.GenerateProposerSlashingForValidator(st, keys[3], 3)
+	require.NoError(t, err)
+	// The canonical chain already slashed the validator.
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	val.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(3, val))
+	parentRoot := [32]byte{'p'}
+	require.NoError(t, service.cfg.BeaconDB.SaveState(ctx, st, parentRoot))
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 1
+	b.Block.ParentRoot = parentRoot[:]
+	b.Block.Body.ProposerSlashings = []*ethpb.ProposerSlashing{ps}
+	blk := util.SaveBlock(t, ctx, service.cfg.BeaconDB, b)
+	r, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, service.removeInvalidBlockAndState(ctx, [][32]byte{r}))
+	require.Equal(t, 0, len(service.cfg.SlashingPool.PendingProposerSlashings(ctx, st, true)))
+}
+
+func TestService_reinsertOrphanedOperations_IncludedInCanonicalChain(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	included := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}})
+	other := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}})
+	other.Data.BeaconBlockRoot = bytesutil.PadTo([]byte{'o'}, 32)
+
+	// The canonical chain includes the attestation in an aggregate of more attesters.
+	c := util.NewBeaconBlock()
+	c.Block.Slot = 2
+	c.Block.Body.Attestations = []*ethpb.Attestation{
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1111}}),
+	}
+	canonical := util.SaveBlock(t, ctx, service.cfg.BeaconDB, c)
+	canonicalRoot, err := canonical.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 3
+	b.Block.ParentRoot = canonicalRoot[:]
+	b.Block.Body.Attestations = []*ethpb.Attestation{included, other}
+	blk := util.SaveBlock(t, ctx, service.cfg.BeaconDB, b)
+	r, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	require.NoError(t, service.removeInvalidBlockAndState(ctx, [][32]byte{r}))
+	atts := service.cfg.AttPool.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	require.DeepEqual(t, other.Data, atts[0].GetData())
+}
+
+func TestService_reinsertOrphanedOperations_Electra(t *testing.T) {
+	service, tr := minimalTestService(t)
+	ctx := tr.ctx
+
+	single := util.HydrateAttestationElectra(&ethpb.AttestationElectra{
+		AggregationBits: bitfield.Bitlist{0b1011},
+		CommitteeBits:   primitives.NewAttestationCommitteeBits(),
+	})
+	single.CommitteeBits.SetBitAt(1, true)
+	multiple := util.HydrateAttestationElectra(&ethpb.AttestationElectra{
+		AggregationBits: bitfield.Bitlist{0b101011},
+		CommitteeBits:   primitives.NewAttestationCommitteeBits(),
+	})
+	multiple.Data.BeaconBlockRoot = bytesutil.PadTo([]byte{'m'}, 32)
+	multiple.CommitteeBits.SetBitAt(0, true)
+	multiple.CommitteeBits.SetBitAt(2, true)
+
+	b := util.NewBeaconBlockElectra()
+	b.Block.Slot = 1
+	b.Block.ParentRoot = bytesutil.PadTo([]byte{'p'}, 32)
+	b.Block.Body.Attestations = []*ethpb.AttestationElectra{single, multiple}
+	blk := util.SaveBlock(t, ctx, service.cfg.BeaconDB, b)
+	r, err := blk.Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// Only the aggregate of a single committee is reinserted, with its committee bits.
+	require.NoError(t, service.removeInvalidBlockAndState(ctx, [][32]byte{r}))
+	atts := service.cfg.AttPool.AggregatedAttestations()
+	require.Equal(t, 1, len(atts))
+	require.DeepEqual(t, single.CommitteeBits, atts[0].CommitteeBitsVal())
+}
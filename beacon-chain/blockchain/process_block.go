@@ -159,6 +159,9 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	if err := s.fillInForkChoiceMissingBlocks(ctx, blks[0], preState.CurrentJustifiedCheckpoint(), preState.FinalizedCheckpoint()); err != nil {
 		return errors.Wrap(err, "could not fill in missing blocks to forkchoice")
 	}
+	if err := s.verifyBatchWeakSubjectivity(ctx, blks); err != nil {
+		return err
+	}
 
 	jCheckpoints := make([]*ethpb.Checkpoint, len(blks))
 	fCheckpoints := make([]*ethpb.Checkpoint, len(blks))
@@ -301,6 +304,21 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []consensusblocks.ROBlo
 	return s.saveHeadNoDB(ctx, lastB, lastBR, preState, !isValidPayload)
 }
 
+// verifyBatchWeakSubjectivity refuses a linear batch of blocks on a chain that does not include the weak
+// subjectivity checkpoint. The caller must hold the forkchoice lock.
+func (s *Service) verifyBatchWeakSubjectivity(ctx context.Context, blks []consensusblocks.ROBlock) error {
+	if s.wsVerifier == nil {
+		return nil
+	}
+	for _, b := range blks {
+		if b.Root() == s.wsVerifier.root {
+			return nil
+		}
+	}
+	last := blks[len(blks)-1].Block().Slot()
+	return s.wsVerifier.VerifyAncestry(ctx, s.cfg.ForkChoiceStore, blks[0].Block().ParentRoot(), last)
+}
+
 func (s *Service) updateEpochBoundaryCaches(ctx context.Context, st state.BeaconState) error {
 	e := coreTime.CurrentEpoch(st)
 	if err := helpers.UpdateCommitteeCache(ctx, st, e); err != nil {
@@ -470,27 +488,6 @@ func (s *Service) validateMergeTransitionBlock(ctx context.Context, stateVersion
 	return s.validateMergeBlock(ctx, blk)
 }
 
-// This routine checks if there is a cached proposer payload ID available for the next slot proposer.
-// If there is not, it will call forkchoice updated with the correct payload attribute then cache the payload ID.
-func (s *Service) runLateBlockTasks() {
-	if err := s.waitForSync(); err != nil {
-		log.WithError(err).Error("failed to wait for initial sync")
-		return
-	}
-
-	attThreshold := params.BeaconConfig().SecondsPerSlot / 3
-	ticker := slots.NewSlotTickerWithOffset(s.genesisTime, time.Duration(attThreshold)*time.Second, params.BeaconConfig().SecondsPerSlot)
-	for {
-		select {
-		case <-ticker.C():
-			s.lateBlockTasks(s.ctx)
-		case <-s.ctx.Done():
-			log.Debug("Context closed, exiting routine")
-			return
-		}
-	}
-}
-
 // missingIndices uses the expected commitments from the block to determine
 // which BlobSidecar indices would need to be in the database for DA success.
 // It returns a map where each key represents a missing BlobSidecar index.
@@ -558,6 +555,7 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 	}
 	// If there are no missing indices, all BlobSidecars are available.
 	if len(missing) == 0 {
+		s.cfg.SlotTimings.SetBlobsComplete(block.Slot(), time.Since(slots.BeginsAt(block.Slot(), s.genesisTime)))
 		return nil
 	}
 
@@ -589,6 +587,7 @@ func (s *Service) isDataAvailable(ctx context.Context, root [32]byte, signed int
 			}
 			// Once all sidecars have been observed, clean up the notification channel.
 			s.blobNotifiers.delete(root)
+			s.cfg.SlotTimings.SetBlobsComplete(block.Slot(), time.Since(slots.BeginsAt(block.Slot(), s.genesisTime)))
 			return nil
 		case <-ctx.Done():
 			return errors.Wrapf(ctx.Err(), "context deadline waiting for blob sidecars slot: %d, BlockRoot: %#x", block.Slot(), root)
@@ -637,6 +636,7 @@ func (s *Service) lateBlockTasks(ctx context.Context) {
 	if err := transition.UpdateNextSlotCache(ctx, lastRoot, lastState); err != nil {
 		log.WithError(err).Debug("could not update next slot state cache")
 	}
+	s.advanceNextSlotCache(currentSlot + 1)
 	if err := s.handleEpochBoundary(ctx, currentSlot, headState, headRoot[:]); err != nil {
 		log.WithError(err).Error("lateBlockTasks: could not update epoch boundary caches")
 	}
@@ -257,12 +257,24 @@ func (s *Service) updateCachesPostBlockProcessing(cfg *postBlockProcessConfig) e
 	if err := transition.UpdateNextSlotCache(cfg.ctx, root[:], cfg.postState); err != nil {
 		return errors.Wrap(err, "could not update next slot state cache")
 	}
+	s.advanceNextSlotCache(slot + 1)
 	if !slots.IsEpochEnd(slot) {
 		return nil
 	}
 	return s.handleEpochBoundary(cfg.ctx, slot, cfg.postState, root[:])
 }
 
+// advanceNextSlotCache advances the cached next slot states of both the head and its parent to the
+// given slot in the background, so that a proposal on the parent of a late head block does not
+// process slots on the critical path.
+func (s *Service) advanceNextSlotCache(slot primitives.Slot) {
+	go func() {
+		if err := transition.AdvanceNextSlotCache(s.ctx, slot); err != nil {
+			log.WithError(err).Debug("Could not advance next slot state cache")
+		}
+	}()
+}
+
 // handleSecondFCUCall handles a second call to FCU when syncing a new block.
 // This is useful when proposing in the next block and we want to defer the
 // computation of the next slot shuffling.
@@ -63,16 +63,17 @@ func (s *Service) VerifyLmdFfgConsistency(ctx context.Context, a ethpb.Att) erro
 	return nil
 }
 
-// This routine processes fork choice attestations from the pool to account for validator votes and fork choice.
-func (s *Service) spawnProcessAttestationsRoutine() {
+// This routine runs the tasks of every slot: it processes fork choice attestations from the pool to account for
+// validator votes and fork choice, and runs the late block tasks.
+func (s *Service) spawnSlotTasksRoutine() {
 	go func() {
 		_, err := s.clockWaiter.WaitForClock(s.ctx)
 		if err != nil {
-			log.WithError(err).Error("spawnProcessAttestationsRoutine failed to receive genesis data")
+			log.WithError(err).Error("spawnSlotTasksRoutine failed to receive genesis data")
 			return
 		}
 		if s.genesisTime.IsZero() {
-			log.Warn("ProcessAttestations routine waiting for genesis time")
+			log.Warn("Slot tasks routine waiting for genesis time")
 			for s.genesisTime.IsZero() {
 				if err := s.ctx.Err(); err != nil {
 					log.WithError(err).Error("Giving up waiting for genesis time")
@@ -80,7 +81,7 @@ func (s *Service) spawnProcessAttestationsRoutine() {
 				}
 				time.Sleep(1 * time.Second)
 			}
-			log.Warn("Genesis time received, now available to process attestations")
+			log.Warn("Genesis time received, now available to run slot tasks")
 		}
 		// Wait for node to be synced before running the routine.
 		if err := s.waitForSync(); err != nil {
@@ -88,31 +89,65 @@ func (s *Service) spawnProcessAttestationsRoutine() {
 			return
 		}
 
-		reorgInterval := time.Second*time.Duration(params.BeaconConfig().SecondsPerSlot) - reorgLateBlockCountAttestations
-		ticker := slots.NewSlotTickerWithIntervals(s.genesisTime, []time.Duration{0, reorgInterval})
-		for {
-			select {
-			case <-s.ctx.Done():
-				return
-			case slotInterval := <-ticker.C():
-				if slotInterval.Interval > 0 {
-					if s.validating() {
-						s.UpdateHead(s.ctx, slotInterval.Slot+1)
-					}
-				} else {
-					s.cfg.ForkChoiceStore.Lock()
-					if err := s.cfg.ForkChoiceStore.NewSlot(s.ctx, slotInterval.Slot); err != nil {
-						log.WithError(err).Error("could not process new slot")
-					}
-					s.cfg.ForkChoiceStore.Unlock()
-
-					s.UpdateHead(s.ctx, slotInterval.Slot)
-				}
-			}
+		scheduler, err := newSlotScheduler(s.genesisTime, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, s.slotTasks()...)
+		if err != nil {
+			log.WithError(err).Error("Could not schedule slot tasks")
+			return
 		}
+		scheduler.run(s.ctx)
 	}()
 }
 
+// defaultSlotTaskTimings returns the default times of the slot tasks.
+func defaultSlotTaskTimings() map[string]SlotTaskTiming {
+	return map[string]SlotTaskTiming{
+		SlotTaskNewSlot:      {},
+		SlotTaskLateBlock:    {Offset: time.Duration(params.BeaconConfig().SecondsPerSlot/3) * time.Second},
+		SlotTaskProposerHead: {Offset: -reorgLateBlockCountAttestations},
+	}
+}
+
+func (s *Service) slotTasks() []*slotTask {
+	timings := defaultSlotTaskTimings()
+	for name, t := range s.cfg.SlotTaskTimings {
+		timings[name] = t
+	}
+	return []*slotTask{
+		{
+			name:     SlotTaskNewSlot,
+			timing:   timings[SlotTaskNewSlot],
+			priority: 0,
+			run: func(ctx context.Context, slot primitives.Slot) {
+				s.cfg.ForkChoiceStore.Lock()
+				if err := s.cfg.ForkChoiceStore.NewSlot(ctx, slot); err != nil {
+					log.WithError(err).Error("could not process new slot")
+				}
+				s.cfg.ForkChoiceStore.Unlock()
+
+				s.UpdateHead(ctx, slot)
+			},
+		},
+		{
+			name:     SlotTaskProposerHead,
+			timing:   timings[SlotTaskProposerHead],
+			priority: 1,
+			run: func(ctx context.Context, slot primitives.Slot) {
+				if s.validating() {
+					s.UpdateHead(ctx, slot+1)
+				}
+			},
+		},
+		{
+			name:     SlotTaskLateBlock,
+			timing:   timings[SlotTaskLateBlock],
+			priority: 2,
+			run: func(ctx context.Context, _ primitives.Slot) {
+				s.lateBlockTasks(ctx)
+			},
+		},
+	}
+}
+
 // UpdateHead updates the canonical head of the chain based on information from fork-choice attestations and votes.
 // The caller of this function MUST hold a lock in forkchoice
 func (s *Service) UpdateHead(ctx context.Context, proposingSlot primitives.Slot) {
@@ -27,6 +27,7 @@ import (
 	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+	"github.com/prysmaticlabs/prysm/v5/runtime/slotprofile"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"golang.org/x/sync/errgroup"
@@ -66,11 +67,17 @@ type SlashingReceiver interface {
 func (s *Service) ReceiveBlock(ctx context.Context, block interfaces.ReadOnlySignedBeaconBlock, blockRoot [32]byte, avs das.AvailabilityStore) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlock")
 	defer span.End()
+	if !s.blockImports.start() {
+		return errServiceStopping
+	}
+	defer s.blockImports.done()
 	// Return early if the block has been synced
 	if s.InForkchoice(blockRoot) {
 		log.WithField("blockRoot", fmt.Sprintf("%#x", blockRoot)).Debug("Ignoring already synced block")
 		return nil
 	}
+	ctx, endProfile := s.cfg.SlotProfiler.Start(ctx, block.Block().Slot(), slotprofile.BlockImportPath)
+	defer endProfile()
 	receivedTime := time.Now()
 	s.blockBeingSynced.set(blockRoot)
 	defer s.blockBeingSynced.unset(blockRoot)
@@ -293,6 +300,10 @@ func (s *Service) executePostFinalizationTasks(ctx context.Context, finalizedSta
 func (s *Service) ReceiveBlockBatch(ctx context.Context, blocks []blocks.ROBlock, avs das.AvailabilityStore) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlockBatch")
 	defer span.End()
+	if !s.blockImports.start() {
+		return errServiceStopping
+	}
+	defer s.blockImports.done()
 
 	s.cfg.ForkChoiceStore.Lock()
 	defer s.cfg.ForkChoiceStore.Unlock()
@@ -467,6 +478,9 @@ func (s *Service) validateStateTransition(ctx context.Context, preState state.Be
 	if !s.InForkchoice(parentRoot) {
 		return nil, ErrNotDescendantOfFinalized
 	}
+	if err := s.verifyWeakSubjectivityAncestry(ctx, parentRoot, b.Slot()); err != nil {
+		return nil, err
+	}
 	stateTransitionStartTime := time.Now()
 	postState, err := transition.ExecuteStateTransition(ctx, preState, signed)
 	if err != nil {
@@ -476,6 +490,13 @@ func (s *Service) validateStateTransition(ctx context.Context, preState state.Be
 	return postState, nil
 }
 
+// verifyWeakSubjectivityAncestry refuses blocks on a chain that does not include the weak subjectivity checkpoint.
+func (s *Service) verifyWeakSubjectivityAncestry(ctx context.Context, parentRoot [32]byte, slot primitives.Slot) error {
+	s.cfg.ForkChoiceStore.RLock()
+	defer s.cfg.ForkChoiceStore.RUnlock()
+	return s.wsVerifier.VerifyAncestry(ctx, s.cfg.ForkChoiceStore, parentRoot, slot)
+}
+
 // updateJustificationOnBlock updates the justified checkpoint on DB if the
 // incoming block has updated it on forkchoice.
 func (s *Service) updateJustificationOnBlock(ctx context.Context, preState, postState state.BeaconState, preJustifiedEpoch primitives.Epoch) error {
@@ -42,6 +42,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/slotprofile"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
@@ -68,6 +69,7 @@ type Service struct {
 	blockBeingSynced              *currentlySyncingBlock
 	blobStorage                   *filesystem.BlobStorage
 	lastPublishedLightClientEpoch primitives.Epoch
+	blockImports                  blockImports
 }
 
 // config options for the service.
@@ -78,6 +80,7 @@ type config struct {
 	DepositCache            cache.DepositCache
 	PayloadIDCache          *cache.PayloadIDCache
 	TrackedValidatorsCache  *cache.TrackedValidatorsCache
+	SlotTimings             *cache.SlotTimingsCache
 	AttPool                 attestations.Pool
 	ExitPool                voluntaryexits.PoolManager
 	SlashingPool            slashings.PoolManager
@@ -94,6 +97,8 @@ type config struct {
 	FinalizedStateAtStartUp state.BeaconState
 	ExecutionEngineCaller   execution.EngineCaller
 	SyncChecker             Checker
+	SlotTaskTimings         map[string]SlotTaskTiming
+	SlotProfiler            *slotprofile.Profiler
 }
 
 // Checker is an interface used to determine if a node is in initial sync
@@ -210,14 +215,19 @@ func (s *Service) Start() {
 			log.Fatal(err)
 		}
 	}
-	s.spawnProcessAttestationsRoutine()
-	go s.runLateBlockTasks()
+	s.spawnSlotTasksRoutine()
 }
 
 // Stop the blockchain service's main event loop and associated goroutines.
 func (s *Service) Stop() error {
 	defer s.cancel()
 
+	// Let the blocks being imported complete before saving the finalized state, so that the database is not closed
+	// in the middle of an import.
+	if !s.blockImports.stop(importDrainTimeout) {
+		log.WithField("timeout", importDrainTimeout).Warn("Stopping before the blocks being imported are complete")
+	}
+
 	// lock before accessing s.head, s.head.state, s.head.state.FinalizedCheckpoint().Root
 	s.headLock.RLock()
 	if s.cfg.StateGen != nil && s.head != nil && s.head.state != nil {
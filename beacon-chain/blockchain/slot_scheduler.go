@@ -0,0 +1,166 @@
+package blockchain
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SlotTaskNewSlot is the task starting a new slot in forkchoice and updating the head at the start of the slot.
+	SlotTaskNewSlot = "new_slot"
+	// SlotTaskLateBlock is the task running the late block tasks, among which the preparation of the payload
+	// attributes of the next proposer, once the block of the slot is late.
+	SlotTaskLateBlock = "late_block"
+	// SlotTaskProposerHead is the task updating the head before the next slot, for its proposer to build on it.
+	SlotTaskProposerHead = "proposer_head"
+)
+
+// SlotTaskTiming is the time of a slot task. The offset is counted from the start of the slot, or back from the
+// start of the next slot when negative. The task is delayed by a random duration of up to the jitter.
+type SlotTaskTiming struct {
+	Offset time.Duration
+	Jitter time.Duration
+}
+
+// slotTask is a task run by the slot scheduler at a given time of every slot.
+type slotTask struct {
+	name   string
+	timing SlotTaskTiming
+	// priority orders the tasks due at the same time, lower priorities running first.
+	priority int
+	run      func(ctx context.Context, slot primitives.Slot)
+}
+
+type scheduledTask struct {
+	task *slotTask
+	slot primitives.Slot
+	at   time.Time
+}
+
+// slotScheduler runs tasks at set times of every slot. Each task runs in its own goroutine, so that a slow task does
+// not delay the others, and an occurrence of a task which is due while its previous occurrence still runs is skipped.
+// The lateness and skipped occurrences of the tasks are exported as metrics.
+type slotScheduler struct {
+	genesis      time.Time
+	slotDuration time.Duration
+	tasks        []*slotTask
+}
+
+func newSlotScheduler(genesis time.Time, slotDuration time.Duration, tasks ...*slotTask) (*slotScheduler, error) {
+	for _, t := range tasks {
+		if t.timing.Offset >= slotDuration || -t.timing.Offset > slotDuration {
+			return nil, errors.Errorf("offset %s of slot task %s is longer than a slot", t.timing.Offset, t.name)
+		}
+		if t.timing.Jitter < 0 {
+			return nil, errors.Errorf("negative jitter %s of slot task %s", t.timing.Jitter, t.name)
+		}
+	}
+	return &slotScheduler{genesis: genesis, slotDuration: slotDuration, tasks: tasks}, nil
+}
+
+// offset returns the offset of the task from the start of the slot.
+func (s *slotScheduler) offset(t *slotTask) time.Duration {
+	if t.timing.Offset < 0 {
+		return s.slotDuration + t.timing.Offset
+	}
+	return t.timing.Offset
+}
+
+// schedule returns the first occurrence of the task which is due at or after the given time, in the given slot or
+// a later one.
+func (s *slotScheduler) schedule(t *slotTask, minSlot primitives.Slot, now time.Time) scheduledTask {
+	slot := primitives.Slot(0)
+	if elapsed := now.Sub(s.genesis) - s.offset(t); elapsed > 0 {
+		slot = primitives.Slot((elapsed + s.slotDuration - 1) / s.slotDuration)
+	}
+	if slot < minSlot {
+		slot = minSlot
+	}
+	at := s.genesis.Add(time.Duration(slot)*s.slotDuration + s.offset(t))
+	if t.timing.Jitter > 0 {
+		at = at.Add(time.Duration(rand.Int63n(int64(t.timing.Jitter)))) // #nosec G404 -- jitter does not need a secure source.
+	}
+	return scheduledTask{task: t, slot: slot, at: at}
+}
+
+// run runs the tasks until the context is canceled, and returns once the running tasks returned.
+func (s *slotScheduler) run(ctx context.Context) {
+	if len(s.tasks) == 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	running := make([]atomic.Bool, len(s.tasks))
+	now := prysmTime.Now()
+	next := make([]scheduledTask, len(s.tasks))
+	for i, t := range s.tasks {
+		next[i] = s.schedule(t, 0, now)
+	}
+	for {
+		due := 0
+		for i := range next {
+			if next[i].at.Before(next[due].at) {
+				due = i
+			}
+		}
+		timer := time.NewTimer(prysmTime.Until(next[due].at))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+		// The tasks due at the same time run one after the other in the order of their priorities.
+		var group []int
+		var tasks []scheduledTask
+		for _, i := range s.byPriority(next, next[due].at) {
+			st := next[i]
+			next[i] = s.schedule(st.task, st.slot+1, prysmTime.Now())
+			if !running[i].CompareAndSwap(false, true) {
+				slotTasksSkipped.WithLabelValues(st.task.name).Inc()
+				log.WithFields(logrus.Fields{
+					"task": st.task.name,
+					"slot": st.slot,
+				}).Warn("Skipping slot task as its previous occurrence is still running")
+				continue
+			}
+			group = append(group, i)
+			tasks = append(tasks, st)
+		}
+		if len(group) == 0 {
+			continue
+		}
+		wg.Add(1)
+		go func(group []int, tasks []scheduledTask) {
+			defer wg.Done()
+			for j, st := range tasks {
+				slotTaskLateness.WithLabelValues(st.task.name).Observe(float64(prysmTime.Since(st.at).Milliseconds()))
+				st.task.run(ctx, st.slot)
+				running[group[j]].Store(false)
+			}
+		}(group, tasks)
+	}
+}
+
+// byPriority returns the indices of the tasks scheduled at the given time, ordered by priority.
+func (*slotScheduler) byPriority(next []scheduledTask, at time.Time) []int {
+	var due []int
+	for i := range next {
+		if next[i].at.Equal(at) {
+			due = append(due, i)
+		}
+	}
+	sort.SliceStable(due, func(a, b int) bool {
+		return next[due[a]].task.priority < next[due[b]].task.priority
+	})
+	return due
+}
@@ -0,0 +1,118 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSlotScheduler_Schedule(t *testing.T) {
+	genesis := time.Unix(1000, 0)
+	s, err := newSlotScheduler(genesis, 12*time.Second)
+	require.NoError(t, err)
+	start := &slotTask{name: "start"}
+	late := &slotTask{name: "late", timing: SlotTaskTiming{Offset: -2 * time.Second}}
+
+	st := s.schedule(start, 0, genesis.Add(24*time.Second))
+	assert.Equal(t, primitives.Slot(2), st.slot)
+	assert.Equal(t, genesis.Add(24*time.Second), st.at)
+	st = s.schedule(start, 0, genesis.Add(25*time.Second))
+	assert.Equal(t, primitives.Slot(3), st.slot)
+
+	st = s.schedule(late, 0, genesis.Add(25*time.Second))
+	assert.Equal(t, primitives.Slot(2), st.slot)
+	assert.Equal(t, genesis.Add(34*time.Second), st.at)
+	// A task is not run twice for a slot.
+	st = s.schedule(late, 3, genesis.Add(34*time.Second))
+	assert.Equal(t, primitives.Slot(3), st.slot)
+	assert.Equal(t, genesis.Add(46*time.Second), st.at)
+
+	jittered := &slotTask{name: "jittered", timing: SlotTaskTiming{Offset: time.Second, Jitter: time.Second}}
+	st = s.schedule(jittered, 0, genesis)
+	assert.Equal(t, true, !st.at.Before(genesis.Add(time.Second)) && st.at.Before(genesis.Add(2*time.Second)))
+
+	_, err = newSlotScheduler(genesis, 12*time.Second, &slotTask{name: "long", timing: SlotTaskTiming{Offset: 12 * time.Second}})
+	require.ErrorContains(t, "longer than a slot", err)
+	_, err = newSlotScheduler(genesis, 12*time.Second, &slotTask{name: "jitter", timing: SlotTaskTiming{Jitter: -time.Second}})
+	require.ErrorContains(t, "negative jitter", err)
+}
+
+func TestSlotScheduler_Run(t *testing.T) {
+	type run struct {
+		name string
+		slot primitives.Slot
+	}
+	runs := make(chan run, 10)
+	task := func(name string, offset time.Duration, priority int) *slotTask {
+		return &slotTask{name: name, timing: SlotTaskTiming{Offset: offset}, priority: priority,
+			run: func(_ context.Context, slot primitives.Slot) {
+				runs <- run{name: name, slot: slot}
+			}}
+	}
+	s, err := newSlotScheduler(time.Now().Add(50*time.Millisecond), 100*time.Millisecond,
+		task("late", -30*time.Millisecond, 0),
+		task("second", 0, 1),
+		task("first", 0, 0),
+	)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.run(ctx)
+
+	want := []run{{"first", 0}, {"second", 0}, {"late", 0}, {"first", 1}, {"second", 1}, {"late", 1}}
+	for _, w := range want {
+		select {
+		case got := <-runs:
+			assert.Equal(t, w, got)
+		case <-time.After(time.Second):
+			t.Fatalf("slot task %s of slot %d did not run", w.name, w.slot)
+		}
+	}
+}
+
+func TestSlotScheduler_SlowTask(t *testing.T) {
+	release := make(chan struct{})
+	slow := make(chan primitives.Slot, 10)
+	fast := make(chan primitives.Slot, 10)
+	s, err := newSlotScheduler(time.Now().Add(50*time.Millisecond), 100*time.Millisecond,
+		&slotTask{name: "slow", run: func(_ context.Context, slot primitives.Slot) {
+			slow <- slot
+			<-release
+		}},
+		&slotTask{name: "fast", timing: SlotTaskTiming{Offset: 30 * time.Millisecond}, run: func(_ context.Context, slot primitives.Slot) {
+			fast <- slot
+		}},
+	)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.run(ctx)
+		close(done)
+	}()
+
+	// The fast task keeps running while the slow task blocks.
+	for want := primitives.Slot(0); want < 3; want++ {
+		select {
+		case got := <-fast:
+			assert.Equal(t, want, got)
+		case <-time.After(time.Second):
+			t.Fatalf("fast task of slot %d did not run", want)
+		}
+	}
+	// The occurrences of the slow task due while it blocks are skipped.
+	close(release)
+	assert.Equal(t, primitives.Slot(0), <-slow)
+	select {
+	case got := <-slow:
+		assert.Equal(t, true, got >= 3)
+	case <-time.After(time.Second):
+		t.Fatal("slow task did not run again")
+	}
+	cancel()
+	<-done
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -18,6 +19,13 @@ type weakSubjectivityDB interface {
 	BlockRoots(ctx context.Context, f *filters.QueryFilter) ([][32]byte, error)
 }
 
+type weakSubjectivityForkchoice interface {
+	FinalizedCheckpoint() *forkchoicetypes.Checkpoint
+	HasNode([32]byte) bool
+	Slot([32]byte) (primitives.Slot, error)
+	AncestorRoot(ctx context.Context, root [32]byte, slot primitives.Slot) ([32]byte, error)
+}
+
 type WeakSubjectivityVerifier struct {
 	enabled  bool
 	verified bool
@@ -90,3 +98,36 @@ func (v *WeakSubjectivityVerifier) VerifyWeakSubjectivity(ctx context.Context, f
 	}
 	return errors.Wrap(errWSBlockNotFoundInEpoch, fmt.Sprintf("root=%#x, epoch=%d", v.root, v.epoch))
 }
+
+// VerifyAncestry returns an error when a block at the given slot and with the given parent root is on a chain
+// that does not include the weak subjectivity root. Only blocks past the weak subjectivity epoch are checked,
+// and only while that epoch is not finalized, as VerifyWeakSubjectivity checks the finalized chain.
+// The caller must hold the forkchoice lock.
+func (v *WeakSubjectivityVerifier) VerifyAncestry(ctx context.Context, fc weakSubjectivityForkchoice, parentRoot [32]byte, slot primitives.Slot) error {
+	if v == nil || v.verified || !v.enabled {
+		return nil
+	}
+	if slot < v.slot+params.BeaconConfig().SlotsPerEpoch || parentRoot == v.root {
+		return nil
+	}
+	if fc.FinalizedCheckpoint().Epoch >= v.epoch {
+		return nil
+	}
+	// Every block after the finalized checkpoint is in forkchoice, so a chain past the weak subjectivity
+	// epoch can only include the root if forkchoice has it.
+	if !fc.HasNode(v.root) {
+		return errors.Wrapf(ErrNotDescendantOfWeakSubjectivity, "root %#x is not in forkchoice", v.root)
+	}
+	rootSlot, err := fc.Slot(v.root)
+	if err != nil {
+		return errors.Wrap(err, "could not get weak subjectivity root slot")
+	}
+	ancestor, err := fc.AncestorRoot(ctx, parentRoot, rootSlot)
+	if err != nil {
+		return errors.Wrap(err, "could not get block ancestor at weak subjectivity root slot")
+	}
+	if ancestor != v.root {
+		return errors.Wrapf(ErrNotDescendantOfWeakSubjectivity, "ancestor at slot %d is %#x, not %#x", rootSlot, ancestor, v.root)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -88,3 +89,68 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+type mockWSForkchoice struct {
+	finalized primitives.Epoch
+	slots     map[[32]byte]primitives.Slot
+	parents   map[[32]byte][32]byte
+}
+
+func (f *mockWSForkchoice) FinalizedCheckpoint() *forkchoicetypes.Checkpoint {
+	return &forkchoicetypes.Checkpoint{Epoch: f.finalized}
+}
+
+func (f *mockWSForkchoice) HasNode(r [32]byte) bool {
+	_, ok := f.slots[r]
+	return ok
+}
+
+func (f *mockWSForkchoice) Slot(r [32]byte) (primitives.Slot, error) {
+	return f.slots[r], nil
+}
+
+func (f *mockWSForkchoice) AncestorRoot(_ context.Context, r [32]byte, slot primitives.Slot) ([32]byte, error) {
+	for f.slots[r] > slot {
+		r = f.parents[r]
+	}
+	return r, nil
+}
+
+func TestWeakSubjectivityVerifier_VerifyAncestry(t *testing.T) {
+	ctx := context.Background()
+	epoch := primitives.Epoch(10)
+	start, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	past := start + params.BeaconConfig().SlotsPerEpoch
+
+	// ws is the checkpoint block, canonical descends from it and fork branches off before it.
+	base, ws, canonical, fork := [32]byte{'b'}, [32]byte{'w'}, [32]byte{'c'}, [32]byte{'f'}
+	fc := &mockWSForkchoice{
+		finalized: epoch - 2,
+		slots:     map[[32]byte]primitives.Slot{base: start - 10, ws: start, canonical: start + 5, fork: start + 5},
+		parents:   map[[32]byte][32]byte{ws: base, canonical: ws, fork: base},
+	}
+	v, err := NewWeakSubjectivityVerifier(&ethpb.Checkpoint{Root: ws[:], Epoch: epoch}, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, v.VerifyAncestry(ctx, fc, canonical, past))
+	require.NoError(t, v.VerifyAncestry(ctx, fc, ws, past))
+	err = v.VerifyAncestry(ctx, fc, fork, past)
+	require.ErrorContains(t, ErrNotDescendantOfWeakSubjectivity.Error(), err)
+	require.Equal(t, true, IsInvalidBlock(err))
+	// Blocks within the weak subjectivity epoch are not checked yet.
+	require.NoError(t, v.VerifyAncestry(ctx, fc, fork, past-1))
+
+	// A chain without the root in forkchoice can not include it.
+	delete(fc.slots, ws)
+	require.ErrorContains(t, ErrNotDescendantOfWeakSubjectivity.Error(), v.VerifyAncestry(ctx, fc, fork, past))
+
+	// The finalized chain is checked against the database instead.
+	fc.finalized = epoch
+	require.NoError(t, v.VerifyAncestry(ctx, fc, fork, past))
+
+	disabled, err := NewWeakSubjectivityVerifier(nil, nil)
+	require.NoError(t, err)
+	fc.finalized = 0
+	require.NoError(t, disabled.VerifyAncestry(ctx, fc, fork, past))
+}
@@ -33,9 +33,10 @@ type CheckpointStateCache struct {
 }
 
 // NewCheckpointStateCache creates a new checkpoint state cache for storing/accessing processed state.
+// It holds the configured checkpoint state cache size of entries.
 func NewCheckpointStateCache() *CheckpointStateCache {
 	return &CheckpointStateCache{
-		cache: lruwrpr.New(maxCheckpointStateSize),
+		cache: lruwrpr.New(ConfiguredSizes().CheckpointState),
 	}
 }
 
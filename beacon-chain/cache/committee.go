@@ -48,6 +48,7 @@ type CommitteeCache struct {
 	lock           sync.RWMutex
 	inProgress     map[string]bool
 	size           int
+	baseSize       int
 }
 
 // committeeKeyFn takes the seed as the key to retrieve shuffled indices of a committee in a given epoch.
@@ -66,13 +67,14 @@ func NewCommitteesCache() *CommitteeCache {
 	return cc
 }
 
-// Clear resets the CommitteeCache to its initial state
+// Clear resets the CommitteeCache to its initial state, sized by the configured committee cache size.
 func (c *CommitteeCache) Clear() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	c.CommitteeCache = lruwrpr.New(maxCommitteesCacheSize)
+	c.baseSize = ConfiguredSizes().Committees
+	c.CommitteeCache = lruwrpr.New(c.baseSize)
 	c.inProgress = make(map[string]bool)
-	c.size = maxCommitteesCacheSize
+	c.size = c.baseSize
 }
 
 // ExpandCommitteeCache expands the size of the committee cache.
@@ -80,12 +82,13 @@ func (c *CommitteeCache) ExpandCommitteeCache() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.size == expandedCommitteeCacheSize {
+	expanded := c.expandedSize()
+	if c.size == expanded {
 		return
 	}
-	c.CommitteeCache.Resize(expandedCommitteeCacheSize)
-	c.size = expandedCommitteeCacheSize
-	log.Warnf("Expanding committee cache size from %d to %d", maxCommitteesCacheSize, expandedCommitteeCacheSize)
+	c.CommitteeCache.Resize(expanded)
+	c.size = expanded
+	log.Warnf("Expanding committee cache size from %d to %d", c.baseSize, expanded)
 }
 
 // CompressCommitteeCache compresses the size of the committee cache.
@@ -93,12 +96,21 @@ func (c *CommitteeCache) CompressCommitteeCache() {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if c.size == maxCommitteesCacheSize {
+	if c.size == c.baseSize {
 		return
 	}
-	c.CommitteeCache.Resize(maxCommitteesCacheSize)
-	c.size = maxCommitteesCacheSize
-	log.Warnf("Reducing committee cache size from %d to %d", expandedCommitteeCacheSize, maxCommitteesCacheSize)
+	c.CommitteeCache.Resize(c.baseSize)
+	c.size = c.baseSize
+	log.Warnf("Reducing committee cache size from %d to %d", c.expandedSize(), c.baseSize)
+}
+
+// expandedSize is the size of the cache without finality. A configured size above
+// expandedCommitteeCacheSize is never reduced by the expansion.
+func (c *CommitteeCache) expandedSize() int {
+	if c.baseSize > expandedCommitteeCacheSize {
+		return c.baseSize
+	}
+	return expandedCommitteeCacheSize
 }
 
 // Committee fetches the shuffled indices by slot and committee index. Every list of indices
@@ -136,7 +148,9 @@ func (c *CommitteeCache) Committee(ctx context.Context, slot primitives.Slot, se
 		return nil, errors.New("requested index out of bound")
 	}
 
-	return item.ShuffledIndices[start:end], nil
+	// The committee shares the array of the cached shuffle. Its capacity is capped so that appending
+	// to it copies the committee instead of overwriting the next one.
+	return item.ShuffledIndices[start:end:end], nil
 }
 
 // AddCommitteeShuffledList adds Committee shuffled list object to the cache. T
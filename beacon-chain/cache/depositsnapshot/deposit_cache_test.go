@@ -1116,6 +1116,74 @@ func TestDepositMap_WorksCorrectly(t *testing.T) {
 	assert.DeepEqual(t, nilDep, dep)
 }
 
+func TestInsertFinalizedSnapshot(t *testing.T) {
+	ctx := context.Background()
+	deposits := make([]*ethpb.Deposit, 6)
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: makeDepositProof(),
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+	}
+
+	// Reference cache holding every deposit from genesis.
+	full, err := New()
+	require.NoError(t, err)
+	for i, d := range deposits {
+		require.NoError(t, full.InsertDeposit(ctx, d, uint64(10+i), int64(i), [32]byte{byte(i)}))
+	}
+	require.NoError(t, full.InsertFinalizedDeposits(ctx, 2, [32]byte{'a'}, 12))
+	fd, err := full.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	snapshot, err := fd.Deposits().(*DepositTree).ToProto()
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), snapshot.DepositCount)
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertFinalizedSnapshot(ctx, snapshot))
+
+	// Deposits covered by the snapshot are reported without being held by the cache.
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(12))
+	assert.Equal(t, uint64(3), count)
+	assert.DeepEqual(t, bytesutil.ToBytes32(snapshot.DepositRoot), root)
+	count, _ = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(11))
+	assert.Equal(t, uint64(0), count)
+
+	require.ErrorContains(t, "wanted deposit with index 3", dc.InsertDeposit(ctx, deposits[0], 10, 0, [32]byte{}))
+	for i := 3; i < len(deposits); i++ {
+		require.NoError(t, dc.InsertDeposit(ctx, deposits[i], uint64(10+i), int64(i), [32]byte{byte(i)}))
+	}
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(14))
+	assert.Equal(t, uint64(5), count)
+	assert.Equal(t, [32]byte{4}, root)
+
+	// Finalizing further deposits yields the same tree as the reference cache.
+	require.NoError(t, full.InsertFinalizedDeposits(ctx, 4, [32]byte{'b'}, 14))
+	require.NoError(t, dc.InsertFinalizedDeposits(ctx, 4, [32]byte{'b'}, 14))
+	want, err := full.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	got, err := dc.FinalizedDeposits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, want.MerkleTrieIndex(), got.MerkleTrieIndex())
+	wantRoot, err := want.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	gotRoot, err := got.Deposits().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantRoot, gotRoot)
+
+	require.NoError(t, dc.PruneProofs(ctx, 4))
+	assert.Equal(t, true, dc.deposits[1].Deposit.Proof == nil)
+	assert.Equal(t, true, dc.deposits[2].Deposit.Proof != nil)
+
+	// A snapshot can not be applied on top of deposits it covers.
+	require.ErrorContains(t, "cannot initialize from snapshot", full.InsertFinalizedSnapshot(ctx, snapshot))
+}
+
 func makeDepositProof() [][]byte {
 	proof := make([][]byte, int(params.BeaconConfig().DepositContractTreeDepth)+1)
 	for i := range proof {
@@ -31,6 +31,18 @@ type Cache struct {
 	finalizedDeposits finalizedDepositsContainer
 	depositsByKey     map[[fieldparams.BLSPubkeyLength]byte][]*ethpb.DepositContainer
 	depositsLock      sync.RWMutex
+	// depositsOffset is the index of the first deposit held in deposits. It is non-zero
+	// when the cache was initialized from a deposit snapshot rather than from genesis.
+	depositsOffset int64
+	snapshot       *snapshotCheckpoint
+}
+
+// snapshotCheckpoint records the deposit count and root at the execution block
+// of the snapshot the cache was initialized from.
+type snapshotCheckpoint struct {
+	depositCount uint64
+	depositRoot  [32]byte
+	blockHeight  uint64
 }
 
 // finalizedDepositsContainer stores the trie of deposits that have been included
@@ -134,9 +146,13 @@ func (c *Cache) DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		// Deposits covered by a snapshot are not held in the cache, fall back to the snapshot itself.
+		if c.snapshot != nil && c.snapshot.blockHeight <= blockHeight.Uint64() {
+			return c.snapshot.depositCount, c.snapshot.depositRoot
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
+	return uint64(c.depositsOffset) + uint64(heightIdx), bytesutil.ToBytes32(c.deposits[heightIdx-1].DepositRoot)
 }
 
 // FinalizedDeposits returns the finalized deposits trie.
@@ -185,6 +201,8 @@ func (c *Cache) PruneProofs(ctx context.Context, untilDepositIndex int64) error
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
+	// Translate the deposit index into a position within the cached deposits.
+	untilDepositIndex -= c.depositsOffset
 	if untilDepositIndex >= int64(len(c.deposits)) {
 		untilDepositIndex = int64(len(c.deposits) - 1)
 	}
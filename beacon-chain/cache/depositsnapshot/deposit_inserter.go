@@ -43,8 +43,8 @@ func (c *Cache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNum ui
 	c.depositsLock.Lock()
 	defer c.depositsLock.Unlock()
 
-	if int(index) != len(c.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(c.deposits), index)
+	if wantedIndex := c.depositsOffset + int64(len(c.deposits)); index != wantedIndex {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wantedIndex, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(c.deposits), func(i int) bool { return c.deposits[i].Index >= index })
@@ -74,6 +74,9 @@ func (c *Cache) InsertDepositContainers(ctx context.Context, ctrs []*ethpb.Depos
 	}
 	sort.SliceStable(ctrs, func(i int, j int) bool { return ctrs[i].Index < ctrs[j].Index })
 	c.deposits = ctrs
+	if len(ctrs) > 0 {
+		c.depositsOffset = ctrs[0].Index
+	}
 	for _, ctr := range ctrs {
 		// Use a new value, as the reference
 		// changes in the next iteration.
@@ -106,8 +109,8 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	// In the event we have less deposits than we need to
 	// finalize we finalize till the index on which we do have it.
-	if len(c.deposits) <= int(eth1DepositIndex) {
-		eth1DepositIndex = int64(len(c.deposits)) - 1
+	if lastIndex := c.depositsOffset + int64(len(c.deposits)) - 1; lastIndex < eth1DepositIndex {
+		eth1DepositIndex = lastIndex
 	}
 	// If we finalize to some lower deposit index, we
 	// ignore it.
@@ -139,3 +142,35 @@ func (c *Cache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex in
 	}
 	return nil
 }
+
+// InsertFinalizedSnapshot initializes the finalized deposits cache from an EIP-4881 deposit snapshot. Deposits
+// covered by the snapshot are not required to be held by the cache, so subsequent deposits can be inserted
+// starting from the snapshot's deposit count.
+func (c *Cache) InsertFinalizedSnapshot(ctx context.Context, snapshot *ethpb.DepositSnapshot) error {
+	_, span := trace.StartSpan(ctx, "Cache.InsertFinalizedSnapshot")
+	defer span.End()
+	if snapshot == nil {
+		return errors.New("nil deposit snapshot")
+	}
+	tree, err := DepositTreeFromSnapshotProto(snapshot)
+	if err != nil {
+		return errors.Wrap(err, "could not create deposit tree from snapshot")
+	}
+	c.depositsLock.Lock()
+	defer c.depositsLock.Unlock()
+
+	if len(c.deposits) > 0 && c.deposits[0].Index < int64(snapshot.DepositCount) {
+		return errors.Errorf("deposit cache already holds deposits from index %d, cannot initialize from snapshot with %d deposits",
+			c.deposits[0].Index, snapshot.DepositCount)
+	}
+	if len(c.deposits) == 0 {
+		c.depositsOffset = int64(snapshot.DepositCount) // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	}
+	c.finalizedDeposits = toFinalizedDepositsContainer(tree, int64(snapshot.DepositCount)-1) // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+	c.snapshot = &snapshotCheckpoint{
+		depositCount: snapshot.DepositCount,
+		depositRoot:  bytesutil.ToBytes32(snapshot.DepositRoot),
+		blockHeight:  snapshot.ExecutionDepth,
+	}
+	return nil
+}
@@ -3,60 +3,95 @@ package cache
 import (
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 )
 
-// RootToPayloadIDMap is a map with keys the head root and values the
-// corresponding PayloadID
-type RootToPayloadIDMap map[[32]byte]primitives.PayloadID
+// payloadIDExpirySlots is the default number of slots an entry is kept for after
+// the slot it was prepared for, so that a late reorg around the proposal slot does
+// not drop the payload ID of the head we end up proposing on.
+const payloadIDExpirySlots = primitives.Slot(4)
 
-// PayloadIDCache is a cache that keeps track of the prepared payload ID for the
-// given slot and with the given head root.
+var (
+	payloadIDCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "payload_id_cache_lookup_hit_total",
+		Help: "The number of payload ID lookups that are present in the cache.",
+	})
+	payloadIDCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "payload_id_cache_lookup_miss_total",
+		Help: "The number of payload ID lookups that aren't present in the cache.",
+	})
+	payloadIDCacheEvict = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "payload_id_cache_evicted_total",
+		Help: "The number of payload IDs removed from the cache after expiring.",
+	})
+)
+
+// PayloadIDCache is a cache that keeps track of the prepared payload IDs for the
+// given slot. A slot holds one entry per head root, so that payloads prepared on
+// competing heads coexist until they expire.
 type PayloadIDCache struct {
-	slotToPayloadID map[primitives.Slot]RootToPayloadIDMap
+	slotToPayloadID map[primitives.Slot]map[[32]byte]primitives.PayloadID
+	expirySlots     primitives.Slot
 	sync.Mutex
 }
 
-// NewPayloadIDCache returns a new payload ID cache
+// NewPayloadIDCache returns a new payload ID cache, keeping entries for the
+// configured number of payload ID slots.
 func NewPayloadIDCache() *PayloadIDCache {
-	return &PayloadIDCache{slotToPayloadID: make(map[primitives.Slot]RootToPayloadIDMap)}
+	return &PayloadIDCache{
+		slotToPayloadID: make(map[primitives.Slot]map[[32]byte]primitives.PayloadID),
+		expirySlots:     primitives.Slot(ConfiguredSizes().PayloadIDSlots),
+	}
 }
 
 // PayloadID returns the payload ID for the given slot and parent block root
 func (p *PayloadIDCache) PayloadID(slot primitives.Slot, root [32]byte) (primitives.PayloadID, bool) {
 	p.Lock()
 	defer p.Unlock()
-	inner, ok := p.slotToPayloadID[slot]
-	if !ok {
-		return primitives.PayloadID{}, false
-	}
-	pid, ok := inner[root]
+	pid, ok := p.slotToPayloadID[slot][root]
 	if !ok {
+		payloadIDCacheMiss.Inc()
 		return primitives.PayloadID{}, false
 	}
+	payloadIDCacheHit.Inc()
 	return pid, true
 }
 
-// SetPayloadID updates the payload ID for the given slot and head root
-// it also prunes older entries in the cache
+// Set updates the payload ID for the given slot and head root. Entries of other
+// head roots for the same slot are kept, and entries older than the expiry
+// slots of the cache before the slot are pruned.
 func (p *PayloadIDCache) Set(slot primitives.Slot, root [32]byte, pid primitives.PayloadID) {
 	p.Lock()
 	defer p.Unlock()
-	if slot > 1 {
-		p.prune(slot - 2)
+	if slot > p.expirySlots {
+		p.prune(slot - p.expirySlots)
 	}
 	inner, ok := p.slotToPayloadID[slot]
 	if !ok {
-		inner = make(RootToPayloadIDMap)
+		inner = make(map[[32]byte]primitives.PayloadID)
 		p.slotToPayloadID[slot] = inner
 	}
 	inner[root] = pid
 }
 
+// Len returns the number of payload IDs in the cache.
+func (p *PayloadIDCache) Len() int {
+	p.Lock()
+	defer p.Unlock()
+	n := 0
+	for _, inner := range p.slotToPayloadID {
+		n += len(inner)
+	}
+	return n
+}
+
 // Prune prunes old payload IDs. Requires a Lock in the cache
 func (p *PayloadIDCache) prune(slot primitives.Slot) {
-	for key := range p.slotToPayloadID {
+	for key, inner := range p.slotToPayloadID {
 		if key < slot {
+			payloadIDCacheEvict.Add(float64(len(inner)))
 			delete(p.slotToPayloadID, key)
 		}
 	}
@@ -59,3 +59,27 @@ func TestValidatorPayloadIDsCache_GetAndSaveValidatorPayloadIDs(t *testing.T) {
 	require.Equal(t, false, ok)
 	require.Equal(t, primitives.PayloadID{}, p)
 }
+
+func TestPayloadIDCache_KeepsCompetingHeads(t *testing.T) {
+	cache := NewPayloadIDCache()
+	slot := primitives.Slot(100)
+	r1, r2 := [32]byte{1}, [32]byte{2}
+	pid1, pid2 := primitives.PayloadID{1}, primitives.PayloadID{2}
+	cache.Set(slot, r1, pid1)
+	// A late reorg prepares a payload on another head for the same slot.
+	cache.Set(slot, r2, pid2)
+	// Preparing the next slots does not evict the entries before they expire.
+	cache.Set(slot+payloadIDExpirySlots, r2, pid2)
+	p, ok := cache.PayloadID(slot, r1)
+	require.Equal(t, true, ok)
+	require.Equal(t, pid1, p)
+	p, ok = cache.PayloadID(slot, r2)
+	require.Equal(t, true, ok)
+	require.Equal(t, pid2, p)
+	require.Equal(t, 3, cache.Len())
+
+	cache.Set(slot+payloadIDExpirySlots+1, r1, pid1)
+	_, ok = cache.PayloadID(slot, r1)
+	require.Equal(t, false, ok)
+	require.Equal(t, 2, cache.Len())
+}
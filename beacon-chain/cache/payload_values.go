@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// PayloadValuesSize is the number of recent proposals the payload values are kept for.
+const PayloadValuesSize = 64
+
+// Sources of the execution payload of a proposal.
+const (
+	LocalPayloadSource   = "local"
+	BuilderPayloadSource = "builder"
+)
+
+var (
+	payloadValueGwei = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "proposal_payload_value_gwei",
+		Help:    "Value of the execution payloads offered for the proposals of the node, by source and whether they were chosen.",
+		Buckets: prometheus.ExponentialBuckets(1e5, 2, 16),
+	}, []string{"source", "chosen"})
+	payloadChosenCount = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "proposal_payload_chosen_total",
+		Help: "Number of proposals of the node by source of the chosen execution payload.",
+	}, []string{"source"})
+	payloadBlobCount = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "proposal_payload_blobs",
+		Help:    "Number of blobs of the execution payloads offered for the proposals of the node, by source.",
+		Buckets: prometheus.LinearBuckets(0, 1, 10),
+	}, []string{"source"})
+	payloadBlobBaseFee = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposal_payload_blob_base_fee_wei",
+		Help: "Blob base fee of the execution payload of the last proposal of the node.",
+	})
+	payloadGasLimit = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "proposal_payload_gas_limit",
+		Help: "Gas limit of the execution payloads offered for the last proposal of the node, by source.",
+	}, []string{"source"})
+	preferredGasLimit = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "proposal_preferred_gas_limit",
+		Help: "Gas limit preferred by the proposer of the last proposal of the node, 0 when it stated no preference.",
+	})
+)
+
+// PayloadAlternative describes an execution payload offered for a proposal.
+type PayloadAlternative struct {
+	// Value is the block value reported by the execution client, or the value of the builder bid.
+	Value         primitives.Wei
+	GasLimit      uint64
+	BlobCount     uint64
+	BlobGasUsed   uint64
+	ExcessBlobGas uint64
+	// BlobBaseFee is nil before Deneb.
+	BlobBaseFee *big.Int
+}
+
+// PayloadValues holds the execution payloads offered for a proposal, and the source of the chosen one.
+type PayloadValues struct {
+	Slot          primitives.Slot
+	ProposerIndex primitives.ValidatorIndex
+	Chosen        string
+	Local         *PayloadAlternative
+	// Builder is nil when no bid was received from the builder.
+	Builder *PayloadAlternative
+	// PreferredGasLimit is 0 when the proposer stated no gas limit preference.
+	PreferredGasLimit uint64
+}
+
+// PayloadValuesCache records the execution payloads offered for the recent proposals of the node, for comparing the
+// value of builder and local payloads over time. Every record is also observed in metrics. A nil cache records
+// nothing.
+type PayloadValuesCache struct {
+	sync.Mutex
+	values []PayloadValues
+}
+
+// NewPayloadValuesCache creates a new cache of payload values.
+func NewPayloadValuesCache() *PayloadValuesCache {
+	return &PayloadValuesCache{}
+}
+
+// Add records the payloads offered for a proposal.
+func (c *PayloadValuesCache) Add(v PayloadValues) {
+	if c == nil {
+		return
+	}
+	observePayloadAlternative(LocalPayloadSource, v.Chosen == LocalPayloadSource, v.Local)
+	observePayloadAlternative(BuilderPayloadSource, v.Chosen == BuilderPayloadSource, v.Builder)
+	payloadChosenCount.WithLabelValues(v.Chosen).Inc()
+	preferredGasLimit.Set(float64(v.PreferredGasLimit))
+	chosen := v.Local
+	if v.Chosen == BuilderPayloadSource {
+		chosen = v.Builder
+	}
+	if chosen != nil && chosen.BlobBaseFee != nil {
+		f, _ := new(big.Float).SetInt(chosen.BlobBaseFee).Float64()
+		payloadBlobBaseFee.Set(f)
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if len(c.values) == PayloadValuesSize {
+		c.values = c.values[1:]
+	}
+	c.values = append(c.values, v)
+}
+
+// Last returns the payload values of the last n proposals, most recent first.
+func (c *PayloadValuesCache) Last(n int) []PayloadValues {
+	if c == nil {
+		return nil
+	}
+	c.Lock()
+	defer c.Unlock()
+	values := make([]PayloadValues, 0, min(n, len(c.values)))
+	for i := len(c.values) - 1; i >= 0 && len(values) < n; i-- {
+		values = append(values, c.values[i])
+	}
+	return values
+}
+
+func observePayloadAlternative(source string, chosen bool, a *PayloadAlternative) {
+	if a == nil {
+		return
+	}
+	label := "false"
+	if chosen {
+		label = "true"
+	}
+	if a.Value != nil {
+		payloadValueGwei.WithLabelValues(source, label).Observe(float64(primitives.WeiToGwei(a.Value)))
+	}
+	payloadBlobCount.WithLabelValues(source).Observe(float64(a.BlobCount))
+	payloadGasLimit.WithLabelValues(source).Set(float64(a.GasLimit))
+}
@@ -0,0 +1,40 @@
+package cache
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestPayloadValuesCache_Add(t *testing.T) {
+	c := NewPayloadValuesCache()
+	require.Equal(t, 0, len(c.Last(PayloadValuesSize)))
+
+	for i := 0; i < PayloadValuesSize+2; i++ {
+		c.Add(PayloadValues{
+			Slot:   primitives.Slot(i),
+			Chosen: BuilderPayloadSource,
+			Local:  &PayloadAlternative{Value: big.NewInt(1e9), BlobCount: 1, BlobBaseFee: big.NewInt(1)},
+			Builder: &PayloadAlternative{
+				Value:       big.NewInt(2e9),
+				BlobBaseFee: big.NewInt(1),
+			},
+		})
+	}
+	c.Add(PayloadValues{Slot: 100, Chosen: LocalPayloadSource, Local: &PayloadAlternative{Value: big.NewInt(1e9)}})
+
+	values := c.Last(PayloadValuesSize + 10)
+	require.Equal(t, PayloadValuesSize, len(values))
+	assert.Equal(t, primitives.Slot(100), values[0].Slot)
+	assert.Equal(t, (*PayloadAlternative)(nil), values[0].Builder)
+	assert.Equal(t, primitives.Slot(PayloadValuesSize+1), values[1].Slot)
+	assert.Equal(t, primitives.Slot(3), values[PayloadValuesSize-1].Slot)
+	require.Equal(t, 1, len(c.Last(1)))
+
+	var nilCache *PayloadValuesCache
+	nilCache.Add(PayloadValues{Chosen: LocalPayloadSource})
+	assert.Equal(t, 0, len(nilCache.Last(1)))
+}
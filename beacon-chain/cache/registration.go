@@ -2,26 +2,47 @@ package cache
 
 import (
 	"context"
+	"sort"
 	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
+var (
+	registrationCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "registration_cache_hit",
+		Help: "The number of validator registration requests that are present in the cache.",
+	})
+	registrationCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "registration_cache_miss",
+		Help: "The number of validator registration requests that aren't present in the cache.",
+	})
+	registrationCacheEvict = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "registration_cache_evicted_total",
+		Help: "The number of validator registrations removed from the cache to stay within its size.",
+	})
+)
+
 // RegistrationCache is used to store the cached results of an Validator Registration request.
 // beacon api /eth/v1/validator/register_validator
 type RegistrationCache struct {
 	indexToRegistration map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1
+	size                int
 	lock                sync.RWMutex
 }
 
-// NewRegistrationCache initializes the map and underlying cache.
+// NewRegistrationCache initializes the map and underlying cache. The cache holds up to the configured
+// registration cache size of entries, and is unbounded when that size is 0.
 func NewRegistrationCache() *RegistrationCache {
 	return &RegistrationCache{
 		indexToRegistration: make(map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1),
+		size:                ConfiguredSizes().Registrations,
 		lock:                sync.RWMutex{},
 	}
 }
@@ -32,9 +53,11 @@ func (regCache *RegistrationCache) RegistrationByIndex(id primitives.ValidatorIn
 	v, ok := regCache.indexToRegistration[id]
 	if !ok {
 		regCache.lock.RUnlock()
+		registrationCacheMiss.Inc()
 		return nil, errors.Wrapf(ErrNotFoundRegistration, "validator id %d", id)
 	}
 	regCache.lock.RUnlock()
+	registrationCacheHit.Inc()
 	return v, nil
 }
 
@@ -52,4 +75,25 @@ func (regCache *RegistrationCache) UpdateIndexToRegisteredMap(ctx context.Contex
 			Timestamp:    value.Timestamp,
 		}
 	}
+	regCache.trim()
+}
+
+// trim removes the registrations with the oldest timestamps until the cache fits its size.
+// Requires the write lock of the cache.
+func (regCache *RegistrationCache) trim() {
+	excess := len(regCache.indexToRegistration) - regCache.size
+	if regCache.size == 0 || excess <= 0 {
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, 0, len(regCache.indexToRegistration))
+	for i := range regCache.indexToRegistration {
+		indices = append(indices, i)
+	}
+	sort.Slice(indices, func(i, j int) bool {
+		return regCache.indexToRegistration[indices[i]].Timestamp < regCache.indexToRegistration[indices[j]].Timestamp
+	})
+	for _, i := range indices[:excess] {
+		delete(regCache.indexToRegistration, i)
+	}
+	registrationCacheEvict.Add(float64(excess))
 }
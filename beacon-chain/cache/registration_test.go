@@ -44,3 +44,24 @@ func TestRegistrationCache(t *testing.T) {
 		require.Equal(t, string(reg.Pubkey), string(pubkey))
 	})
 }
+
+func TestRegistrationCache_Trim(t *testing.T) {
+	cache := NewRegistrationCache()
+	cache.size = 2
+	m := make(map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1)
+	for i := primitives.ValidatorIndex(0); i < 3; i++ {
+		m[i] = &ethpb.ValidatorRegistrationV1{
+			FeeRecipient: []byte{},
+			GasLimit:     100,
+			Timestamp:    uint64(10 + i),
+			Pubkey:       []byte{byte(i)},
+		}
+	}
+	cache.UpdateIndexToRegisteredMap(context.Background(), m)
+	_, err := cache.RegistrationByIndex(0)
+	require.ErrorIs(t, err, ErrNotFoundRegistration)
+	_, err = cache.RegistrationByIndex(1)
+	require.NoError(t, err)
+	_, err = cache.RegistrationByIndex(2)
+	require.NoError(t, err)
+}
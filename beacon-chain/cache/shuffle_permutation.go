@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"encoding/binary"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+)
+
+// maxShufflePermutationCacheSize defines the max number of shuffle permutations the cache holds. A few
+// epochs of attester shuffles and the attestation subnet permutations fit comfortably.
+const maxShufflePermutationCacheSize = 8
+
+var (
+	shufflePermutationCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffle_permutation_cache_miss",
+		Help: "The number of shuffle permutation requests that aren't present in the cache.",
+	})
+	shufflePermutationCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "shuffle_permutation_cache_hit",
+		Help: "The number of shuffle permutation requests that are present in the cache.",
+	})
+)
+
+// ShufflePermutationCache memoizes shuffles by seed and index count. A shuffle is stored as the compact
+// permutation of list positions, where entry i is the position moved to i by the shuffle, so that any
+// list of the same length can be shuffled with a lookup per element. Permutations are shared between
+// callers and never modified once added; callers must copy them before making changes.
+type ShufflePermutationCache struct {
+	cache *lru.Cache
+}
+
+// NewShufflePermutationCache creates a new cache of shuffle permutations.
+func NewShufflePermutationCache() *ShufflePermutationCache {
+	return &ShufflePermutationCache{cache: lruwrpr.New(maxShufflePermutationCacheSize)}
+}
+
+// Permutation returns the permutation of the shuffle with the given seed and index count.
+func (c *ShufflePermutationCache) Permutation(seed [32]byte, count uint64) ([]uint32, bool) {
+	obj, ok := c.cache.Get(permutationKey(seed, count))
+	if !ok {
+		shufflePermutationCacheMiss.Inc()
+		return nil, false
+	}
+	shufflePermutationCacheHit.Inc()
+	return obj.([]uint32), true
+}
+
+// Add stores the permutation of the shuffle with the given seed, for an index count of its length.
+func (c *ShufflePermutationCache) Add(seed [32]byte, perm []uint32) {
+	c.cache.Add(permutationKey(seed, uint64(len(perm))), perm)
+}
+
+// Clear removes all permutations from the cache.
+func (c *ShufflePermutationCache) Clear() {
+	c.cache.Purge()
+}
+
+// permutationKey includes the shuffle round count of the config, so that a changed config never
+// returns the shuffle of another round count.
+func permutationKey(seed [32]byte, count uint64) string {
+	var k [48]byte
+	copy(k[:], seed[:])
+	binary.LittleEndian.PutUint64(k[32:], count)
+	binary.LittleEndian.PutUint64(k[40:], params.BeaconConfig().ShuffleRoundCount)
+	return string(k[:])
+}
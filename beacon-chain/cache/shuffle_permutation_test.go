@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestShufflePermutationCache(t *testing.T) {
+	c := NewShufflePermutationCache()
+	seed := [32]byte{1}
+	_, ok := c.Permutation(seed, 3)
+	require.Equal(t, false, ok)
+
+	perm := []uint32{2, 0, 1}
+	c.Add(seed, perm)
+	got, ok := c.Permutation(seed, 3)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, perm, got)
+	_, ok = c.Permutation(seed, 4)
+	require.Equal(t, false, ok)
+
+	// A different shuffle round count does not return the cached shuffle.
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ShuffleRoundCount++
+	params.OverrideBeaconConfig(cfg)
+	_, ok = c.Permutation(seed, 3)
+	require.Equal(t, false, ok)
+	c.Add(seed, perm)
+	_, ok = c.Permutation(seed, 3)
+	require.Equal(t, true, ok)
+
+	c.Clear()
+	_, ok = c.Permutation(seed, 3)
+	require.Equal(t, false, ok)
+}
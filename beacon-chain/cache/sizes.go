@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Sizes holds the maximum number of entries of the caches that can be tuned by the operator.
+// Caches read the configured sizes when they are created.
+type Sizes struct {
+	// StateByRoot is the number of hot states kept by block root.
+	StateByRoot int
+	// CheckpointState is the number of states kept by checkpoint.
+	CheckpointState int
+	// PayloadIDSlots is the number of slots payload IDs are kept for after the slot they were prepared for.
+	PayloadIDSlots int
+	// Committees is the number of shuffled committee lists kept by seed while the chain finalizes.
+	Committees int
+	// Registrations is the number of validator registrations kept. There is no limit when it is 0.
+	Registrations int
+}
+
+var (
+	cacheSizeLimit = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "cache_size_limit",
+		Help: "The configured maximum number of entries of a tunable cache.",
+	}, []string{"cache"})
+
+	sizesLock sync.RWMutex
+	sizes     = DefaultSizes()
+)
+
+// DefaultSizes returns the default sizes of the tunable caches.
+func DefaultSizes() Sizes {
+	return Sizes{
+		StateByRoot:     32,
+		CheckpointState: maxCheckpointStateSize,
+		PayloadIDSlots:  int(payloadIDExpirySlots),
+		Committees:      maxCommitteesCacheSize,
+		Registrations:   0,
+	}
+}
+
+// ConfigureSizes sets the sizes used by caches created from now on.
+func ConfigureSizes(s Sizes) error {
+	if s.StateByRoot <= 0 {
+		return fmt.Errorf("state by root cache size must be positive, got %d", s.StateByRoot)
+	}
+	if s.CheckpointState <= 0 {
+		return fmt.Errorf("checkpoint state cache size must be positive, got %d", s.CheckpointState)
+	}
+	if s.PayloadIDSlots <= 0 {
+		return fmt.Errorf("payload ID cache slots must be positive, got %d", s.PayloadIDSlots)
+	}
+	if s.Committees <= 0 {
+		return fmt.Errorf("committee cache size must be positive, got %d", s.Committees)
+	}
+	if s.Registrations < 0 {
+		return fmt.Errorf("registration cache size must not be negative, got %d", s.Registrations)
+	}
+
+	sizesLock.Lock()
+	defer sizesLock.Unlock()
+	sizes = s
+	cacheSizeLimit.WithLabelValues("state_by_root").Set(float64(s.StateByRoot))
+	cacheSizeLimit.WithLabelValues("checkpoint_state").Set(float64(s.CheckpointState))
+	cacheSizeLimit.WithLabelValues("payload_id_slots").Set(float64(s.PayloadIDSlots))
+	cacheSizeLimit.WithLabelValues("committees").Set(float64(s.Committees))
+	cacheSizeLimit.WithLabelValues("registrations").Set(float64(s.Registrations))
+	return nil
+}
+
+// ConfiguredSizes returns the sizes of the tunable caches.
+func ConfiguredSizes() Sizes {
+	sizesLock.RLock()
+	defer sizesLock.RUnlock()
+	return sizes
+}
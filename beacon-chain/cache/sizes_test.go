@@ -0,0 +1,38 @@
+//go:build !fuzz
+
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestConfigureSizes(t *testing.T) {
+	defer func() {
+		require.NoError(t, ConfigureSizes(DefaultSizes()))
+	}()
+
+	s := DefaultSizes()
+	s.CheckpointState = 0
+	require.ErrorContains(t, "checkpoint state cache size must be positive", ConfigureSizes(s))
+	s = DefaultSizes()
+	s.Registrations = -1
+	require.ErrorContains(t, "registration cache size must not be negative", ConfigureSizes(s))
+	assert.Equal(t, DefaultSizes(), ConfiguredSizes())
+
+	s = Sizes{StateByRoot: 64, CheckpointState: 20, PayloadIDSlots: 2, Committees: 8, Registrations: 1}
+	require.NoError(t, ConfigureSizes(s))
+	assert.Equal(t, s, ConfiguredSizes())
+
+	assert.Equal(t, primitives.Slot(2), NewPayloadIDCache().expirySlots)
+	cc := NewCommitteesCache()
+	assert.Equal(t, 8, cc.size)
+	cc.ExpandCommitteeCache()
+	assert.Equal(t, expandedCommitteeCacheSize, cc.size)
+	cc.CompressCommitteeCache()
+	assert.Equal(t, 8, cc.size)
+	assert.Equal(t, 1, NewRegistrationCache().size)
+}
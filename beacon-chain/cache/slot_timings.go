@@ -0,0 +1,179 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// SlotTimingsSize is the number of recent slots the timings are kept for.
+const SlotTimingsSize = 64
+
+var slotTimingBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4, 5, 6, 8, 12}
+
+var (
+	blockFirstSeenDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_block_first_seen_seconds",
+		Help:    "Time from the start of the slot until its block is first seen on gossip.",
+		Buckets: slotTimingBuckets,
+	})
+	blobsCompleteDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_blobs_complete_seconds",
+		Help:    "Time from the start of the slot until all the blob sidecars of its block are available.",
+		Buckets: slotTimingBuckets,
+	})
+	newPayloadDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_new_payload_seconds",
+		Help:    "Duration of the engine newPayload call of the block of a slot.",
+		Buckets: slotTimingBuckets,
+	})
+	forkchoiceUpdatedDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_forkchoice_updated_seconds",
+		Help:    "Duration of the engine forkchoiceUpdated call setting the block of a slot as head.",
+		Buckets: slotTimingBuckets,
+	})
+	headUpdatedDelay = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_head_updated_seconds",
+		Help:    "Time from the start of the slot until its block becomes the head.",
+		Buckets: slotTimingBuckets,
+	})
+	attestationCutoffMargin = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "slot_timing_attestation_cutoff_margin_seconds",
+		Help:    "Time left until the attestation deadline of the slot when its block becomes the head, negative when the block is late.",
+		Buckets: []float64{-4, -2, -1, -0.5, 0, 0.5, 1, 1.5, 2, 2.5, 3, 3.5, 4},
+	})
+)
+
+// SlotTiming holds the timings of the block of a slot. Delays are measured from the start of the slot. Timings which
+// were not recorded are zero.
+type SlotTiming struct {
+	Slot              primitives.Slot
+	BlockRoot         [32]byte
+	BlockFirstSeen    time.Duration
+	BlobsComplete     time.Duration
+	NewPayload        time.Duration
+	ForkchoiceUpdated time.Duration
+	HeadUpdated       time.Duration
+	// AttestationCutoffMargin is the time left until the attestation deadline when the block became the head. It is
+	// only set along with HeadUpdated.
+	AttestationCutoffMargin time.Duration
+}
+
+// SlotTimingsCache records the timings of the blocks of the recent slots, for diagnosing late heads. Every timing
+// is also observed in a histogram. A nil cache records nothing.
+type SlotTimingsCache struct {
+	sync.Mutex
+	timings [SlotTimingsSize]SlotTiming
+	highest primitives.Slot
+}
+
+// NewSlotTimingsCache creates a new cache of slot timings.
+func NewSlotTimingsCache() *SlotTimingsCache {
+	return &SlotTimingsCache{}
+}
+
+// SetBlockFirstSeen records the delay at which the block of the slot was first seen on gossip.
+func (c *SlotTimingsCache) SetBlockFirstSeen(slot primitives.Slot, root [32]byte, delay time.Duration) {
+	c.update(slot, delay, func(t *SlotTiming) bool {
+		if t.BlockFirstSeen != 0 {
+			return false
+		}
+		t.BlockRoot = root
+		t.BlockFirstSeen = delay
+		blockFirstSeenDelay.Observe(delay.Seconds())
+		return true
+	})
+}
+
+// SetBlobsComplete records the delay at which all the blob sidecars of the block of the slot were available.
+func (c *SlotTimingsCache) SetBlobsComplete(slot primitives.Slot, delay time.Duration) {
+	c.update(slot, delay, func(t *SlotTiming) bool {
+		if t.BlobsComplete != 0 {
+			return false
+		}
+		t.BlobsComplete = delay
+		blobsCompleteDelay.Observe(delay.Seconds())
+		return true
+	})
+}
+
+// SetNewPayload records the duration of the newPayload call of the block of the slot.
+func (c *SlotTimingsCache) SetNewPayload(slot primitives.Slot, d time.Duration) {
+	c.update(slot, 0, func(t *SlotTiming) bool {
+		t.NewPayload = d
+		newPayloadDuration.Observe(d.Seconds())
+		return true
+	})
+}
+
+// SetForkchoiceUpdated records the duration of the forkchoiceUpdated call setting the block of the slot as head.
+func (c *SlotTimingsCache) SetForkchoiceUpdated(slot primitives.Slot, d time.Duration) {
+	c.update(slot, 0, func(t *SlotTiming) bool {
+		t.ForkchoiceUpdated = d
+		forkchoiceUpdatedDuration.Observe(d.Seconds())
+		return true
+	})
+}
+
+// SetHeadUpdated records the delay at which the block of the slot first became the head, along with the margin
+// left until the attestation deadline of the slot.
+func (c *SlotTimingsCache) SetHeadUpdated(slot primitives.Slot, root [32]byte, delay time.Duration) {
+	c.update(slot, delay, func(t *SlotTiming) bool {
+		if t.HeadUpdated != 0 {
+			return false
+		}
+		cfg := params.BeaconConfig()
+		cutoff := time.Duration(cfg.SecondsPerSlot) * time.Second / time.Duration(cfg.IntervalsPerSlot)
+		t.BlockRoot = root
+		t.HeadUpdated = delay
+		t.AttestationCutoffMargin = cutoff - delay
+		headUpdatedDelay.Observe(delay.Seconds())
+		attestationCutoffMargin.Observe(t.AttestationCutoffMargin.Seconds())
+		return true
+	})
+}
+
+// Last returns the timings of the last n slots with a recorded timing, most recent first.
+func (c *SlotTimingsCache) Last(n int) []SlotTiming {
+	if c == nil {
+		return nil
+	}
+	c.Lock()
+	defer c.Unlock()
+	timings := make([]SlotTiming, 0, n)
+	for i := 0; i < SlotTimingsSize && len(timings) < n && primitives.Slot(i) <= c.highest; i++ {
+		slot := c.highest - primitives.Slot(i)
+		if t := c.timings[slot%SlotTimingsSize]; t.Slot == slot && t != (SlotTiming{Slot: slot}) {
+			timings = append(timings, t)
+		}
+	}
+	return timings
+}
+
+// update applies the timing update to the entry of the slot. Delays longer than an epoch are not recorded, as they
+// come from blocks processed during sync.
+func (c *SlotTimingsCache) update(slot primitives.Slot, delay time.Duration, f func(t *SlotTiming) bool) {
+	if c == nil {
+		return
+	}
+	cfg := params.BeaconConfig()
+	if delay < 0 || delay > time.Duration(uint64(cfg.SlotsPerEpoch)*cfg.SecondsPerSlot)*time.Second {
+		return
+	}
+	c.Lock()
+	defer c.Unlock()
+	if slot+SlotTimingsSize <= c.highest {
+		return
+	}
+	t := &c.timings[slot%SlotTimingsSize]
+	if t.Slot != slot {
+		*t = SlotTiming{Slot: slot}
+	}
+	if f(t) && slot > c.highest {
+		c.highest = slot
+	}
+}
@@ -0,0 +1,63 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSlotTimingsCache_Record(t *testing.T) {
+	c := NewSlotTimingsCache()
+	require.Equal(t, 0, len(c.Last(SlotTimingsSize)))
+
+	c.SetBlockFirstSeen(10, [32]byte{'a'}, 1500*time.Millisecond)
+	c.SetBlockFirstSeen(10, [32]byte{'b'}, 2*time.Second)
+	c.SetBlobsComplete(10, 1800*time.Millisecond)
+	c.SetNewPayload(10, 300*time.Millisecond)
+	c.SetForkchoiceUpdated(10, 50*time.Millisecond)
+	c.SetHeadUpdated(10, [32]byte{'a'}, 2500*time.Millisecond)
+	c.SetHeadUpdated(10, [32]byte{'b'}, 3*time.Second)
+	c.SetHeadUpdated(12, [32]byte{'c'}, 5*time.Second)
+
+	timings := c.Last(SlotTimingsSize)
+	require.Equal(t, 2, len(timings))
+	assert.Equal(t, primitives.Slot(12), timings[0].Slot)
+	assert.Equal(t, -time.Second, timings[0].AttestationCutoffMargin)
+	assert.Equal(t, time.Duration(0), timings[0].BlockFirstSeen)
+	assert.DeepEqual(t, SlotTiming{
+		Slot:                    10,
+		BlockRoot:               [32]byte{'a'},
+		BlockFirstSeen:          1500 * time.Millisecond,
+		BlobsComplete:           1800 * time.Millisecond,
+		NewPayload:              300 * time.Millisecond,
+		ForkchoiceUpdated:       50 * time.Millisecond,
+		HeadUpdated:             2500 * time.Millisecond,
+		AttestationCutoffMargin: 1500 * time.Millisecond,
+	}, timings[1])
+	require.Equal(t, 1, len(c.Last(1)))
+}
+
+func TestSlotTimingsCache_Window(t *testing.T) {
+	c := NewSlotTimingsCache()
+	c.SetHeadUpdated(1, [32]byte{}, time.Second)
+	// Delays of blocks processed during sync are not recorded.
+	c.SetHeadUpdated(2, [32]byte{}, time.Hour)
+	c.SetHeadUpdated(SlotTimingsSize+1, [32]byte{}, time.Second)
+	c.SetHeadUpdated(SlotTimingsSize, [32]byte{}, time.Second)
+	// Slots older than the window are dropped.
+	c.SetHeadUpdated(1, [32]byte{}, time.Second)
+
+	timings := c.Last(SlotTimingsSize)
+	require.Equal(t, 2, len(timings))
+	assert.Equal(t, primitives.Slot(SlotTimingsSize+1), timings[0].Slot)
+	assert.Equal(t, primitives.Slot(SlotTimingsSize), timings[1].Slot)
+}
+
+func TestSlotTimingsCache_Nil(t *testing.T) {
+	var c *SlotTimingsCache
+	c.SetNewPayload(1, time.Second)
+	assert.Equal(t, 0, len(c.Last(1)))
+}
@@ -1,45 +1,115 @@
 package cache
 
 import (
+	"sort"
 	"sync"
 
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 )
 
+// TrackedValidatorSource is where the beacon node learned about a tracked validator. When a
+// validator is known from several sources, the entry of the source with the lowest value is used.
+type TrackedValidatorSource uint8
+
+const (
+	// PrepareProposerSource entries come from prepare beacon proposer calls of a validator client.
+	PrepareProposerSource TrackedValidatorSource = iota
+	// BuilderRegistrationSource entries come from validator registrations submitted for the builder.
+	BuilderRegistrationSource
+	// StaticConfigSource entries come from the tracked proposers file of the beacon node.
+	StaticConfigSource
+)
+
+// String returns the name of the source.
+func (s TrackedValidatorSource) String() string {
+	switch s {
+	case PrepareProposerSource:
+		return "prepare_beacon_proposer"
+	case BuilderRegistrationSource:
+		return "builder_registration"
+	case StaticConfigSource:
+		return "static_config"
+	default:
+		return "unknown"
+	}
+}
+
 type TrackedValidator struct {
 	Active       bool
 	FeeRecipient primitives.ExecutionAddress
 	Index        primitives.ValidatorIndex
+	Source       TrackedValidatorSource
 }
 
 type TrackedValidatorsCache struct {
 	sync.Mutex
-	trackedValidators map[primitives.ValidatorIndex]TrackedValidator
+	trackedValidators map[primitives.ValidatorIndex]map[TrackedValidatorSource]TrackedValidator
+	gasLimits         map[primitives.ValidatorIndex]uint64
 }
 
 func NewTrackedValidatorsCache() *TrackedValidatorsCache {
 	return &TrackedValidatorsCache{
-		trackedValidators: make(map[primitives.ValidatorIndex]TrackedValidator),
+		trackedValidators: make(map[primitives.ValidatorIndex]map[TrackedValidatorSource]TrackedValidator),
+		gasLimits:         make(map[primitives.ValidatorIndex]uint64),
 	}
 }
 
+// Validator returns the entry of the validator from the source with the highest precedence.
 func (t *TrackedValidatorsCache) Validator(index primitives.ValidatorIndex) (TrackedValidator, bool) {
 	t.Lock()
 	defer t.Unlock()
-	val, ok := t.trackedValidators[index]
-	return val, ok
+	return preferred(t.trackedValidators[index])
 }
 
+// Set stores the entry of the validator for the source of the entry.
 func (t *TrackedValidatorsCache) Set(val TrackedValidator) {
 	t.Lock()
 	defer t.Unlock()
-	t.trackedValidators[val.Index] = val
+	bySource, ok := t.trackedValidators[val.Index]
+	if !ok {
+		bySource = make(map[TrackedValidatorSource]TrackedValidator)
+		t.trackedValidators[val.Index] = bySource
+	}
+	bySource[val.Source] = val
 }
 
+// SetGasLimit stores the gas limit the validator prefers for the payloads of its proposals, or removes its preference
+// when 0.
+func (t *TrackedValidatorsCache) SetGasLimit(index primitives.ValidatorIndex, gasLimit uint64) {
+	t.Lock()
+	defer t.Unlock()
+	if gasLimit == 0 {
+		delete(t.gasLimits, index)
+		return
+	}
+	t.gasLimits[index] = gasLimit
+}
+
+// GasLimit returns the gas limit the validator prefers for the payloads of its proposals, and false when it did not
+// state a preference.
+func (t *TrackedValidatorsCache) GasLimit(index primitives.ValidatorIndex) (uint64, bool) {
+	t.Lock()
+	defer t.Unlock()
+	gasLimit, ok := t.gasLimits[index]
+	return gasLimit, ok
+}
+
+// Prune removes the entries learned from validator clients and builder registrations, along with the gas limit
+// preferences. Entries of the static config are kept, as they are not refreshed.
 func (t *TrackedValidatorsCache) Prune() {
 	t.Lock()
 	defer t.Unlock()
-	t.trackedValidators = make(map[primitives.ValidatorIndex]TrackedValidator)
+	clear(t.gasLimits)
+	for index, bySource := range t.trackedValidators {
+		for source := range bySource {
+			if source != StaticConfigSource {
+				delete(bySource, source)
+			}
+		}
+		if len(bySource) == 0 {
+			delete(t.trackedValidators, index)
+		}
+	}
 }
 
 func (t *TrackedValidatorsCache) Validating() bool {
@@ -47,3 +117,31 @@ func (t *TrackedValidatorsCache) Validating() bool {
 	defer t.Unlock()
 	return len(t.trackedValidators) > 0
 }
+
+// Validators returns the entry of every tracked validator from the source with the highest
+// precedence, sorted by validator index.
+func (t *TrackedValidatorsCache) Validators() []TrackedValidator {
+	t.Lock()
+	defer t.Unlock()
+	vals := make([]TrackedValidator, 0, len(t.trackedValidators))
+	for _, bySource := range t.trackedValidators {
+		if val, ok := preferred(bySource); ok {
+			vals = append(vals, val)
+		}
+	}
+	sort.Slice(vals, func(i, j int) bool {
+		return vals[i].Index < vals[j].Index
+	})
+	return vals
+}
+
+func preferred(bySource map[TrackedValidatorSource]TrackedValidator) (TrackedValidator, bool) {
+	var val TrackedValidator
+	found := false
+	for source, v := range bySource {
+		if !found || source < val.Source {
+			val, found = v, true
+		}
+	}
+	return val, found
+}
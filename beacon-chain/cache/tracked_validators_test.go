@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestTrackedValidatorsCache_Precedence(t *testing.T) {
+	c := NewTrackedValidatorsCache()
+	_, ok := c.Validator(1)
+	require.Equal(t, false, ok)
+
+	c.Set(TrackedValidator{Index: 1, FeeRecipient: primitives.ExecutionAddress{3}, Source: StaticConfigSource})
+	c.Set(TrackedValidator{Index: 1, FeeRecipient: primitives.ExecutionAddress{2}, Source: BuilderRegistrationSource})
+	val, ok := c.Validator(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, BuilderRegistrationSource, val.Source)
+	assert.Equal(t, primitives.ExecutionAddress{2}, val.FeeRecipient)
+
+	c.Set(TrackedValidator{Index: 1, FeeRecipient: primitives.ExecutionAddress{1}, Source: PrepareProposerSource})
+	c.Set(TrackedValidator{Index: 0, FeeRecipient: primitives.ExecutionAddress{4}, Source: BuilderRegistrationSource})
+	vals := c.Validators()
+	require.Equal(t, 2, len(vals))
+	assert.Equal(t, primitives.ValidatorIndex(0), vals[0].Index)
+	assert.Equal(t, primitives.ExecutionAddress{1}, vals[1].FeeRecipient)
+
+	// Pruning keeps the static config.
+	c.Prune()
+	val, ok = c.Validator(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, StaticConfigSource, val.Source)
+	_, ok = c.Validator(0)
+	require.Equal(t, false, ok)
+}
+
+func TestTrackedValidatorsCache_GasLimit(t *testing.T) {
+	c := NewTrackedValidatorsCache()
+	_, ok := c.GasLimit(1)
+	require.Equal(t, false, ok)
+
+	c.SetGasLimit(1, 36_000_000)
+	gasLimit, ok := c.GasLimit(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(36_000_000), gasLimit)
+
+	c.SetGasLimit(1, 0)
+	_, ok = c.GasLimit(1)
+	require.Equal(t, false, ok)
+
+	c.SetGasLimit(1, 36_000_000)
+	c.Prune()
+	_, ok = c.GasLimit(1)
+	require.Equal(t, false, ok)
+}
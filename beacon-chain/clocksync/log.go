@@ -0,0 +1,5 @@
+package clocksync
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "clock-sync")
@@ -0,0 +1,21 @@
+package clocksync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	clockOffsetGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_offset_milliseconds",
+		Help: "The estimated offset of the local clock from NTP time, positive when the local clock is behind.",
+	})
+	clockDriftExceededGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "clock_drift_exceeded",
+		Help: "1 when the offset of the local clock exceeds the clock drift threshold, 0 otherwise.",
+	})
+	ntpQueryFailuresCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "clock_ntp_query_failures_total",
+		Help: "The number of failed queries of NTP servers.",
+	}, []string{"server"})
+)
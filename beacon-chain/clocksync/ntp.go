@@ -0,0 +1,95 @@
+package clocksync
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"time"
+
+	"github.com/pkg/errors"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+const (
+	ntpPort       = "123"
+	ntpPacketSize = 48
+	// ntpEpochOffset is the number of seconds between the NTP epoch, 1900, and the Unix epoch.
+	ntpEpochOffset = 2208988800
+	// ntpClientHeader sets no leap indicator, version 4 and the client mode.
+	ntpClientHeader = 0<<6 | 4<<3 | 3
+	ntpModeServer   = 4
+)
+
+var (
+	errInvalidNTPResponse = errors.New("invalid NTP response")
+	errNTPKissOfDeath     = errors.New("NTP server refused the query")
+)
+
+// queryOffset queries an NTP server with the simple network time protocol of RFC 4330 and returns the offset of
+// the local clock, which is the duration to add to the local time to obtain the time of the server.
+func queryOffset(ctx context.Context, server string, timeout time.Duration) (time.Duration, error) {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, ntpPort)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", server)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not dial NTP server")
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.WithError(err).Debug("Could not close NTP connection")
+		}
+	}()
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return 0, err
+		}
+	}
+
+	req := make([]byte, ntpPacketSize)
+	req[0] = ntpClientHeader
+	sent := prysmTime.Now()
+	// The transmit timestamp of the request is echoed as the originate timestamp of the response, which ties the
+	// response to the request.
+	binary.BigEndian.PutUint64(req[40:], toNTPTime(sent))
+	if _, err := conn.Write(req); err != nil {
+		return 0, errors.Wrap(err, "could not send NTP request")
+	}
+	resp := make([]byte, ntpPacketSize)
+	n, err := conn.Read(resp)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not read NTP response")
+	}
+	received := prysmTime.Now()
+	if n < ntpPacketSize {
+		return 0, errors.Wrapf(errInvalidNTPResponse, "response of %d bytes", n)
+	}
+	if resp[0]&0x7 != ntpModeServer {
+		return 0, errors.Wrapf(errInvalidNTPResponse, "mode %d", resp[0]&0x7)
+	}
+	if resp[1] == 0 {
+		return 0, errors.Wrapf(errNTPKissOfDeath, "code %q", resp[12:16])
+	}
+	if binary.BigEndian.Uint64(resp[24:]) != binary.BigEndian.Uint64(req[40:]) {
+		return 0, errors.Wrap(errInvalidNTPResponse, "originate timestamp does not match the request")
+	}
+	serverReceived := fromNTPTime(binary.BigEndian.Uint64(resp[32:]))
+	serverSent := fromNTPTime(binary.BigEndian.Uint64(resp[40:]))
+	return (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2, nil
+}
+
+// toNTPTime converts a time to an NTP timestamp, made of 32 bits of seconds since 1900 and 32 bits of fraction.
+func toNTPTime(t time.Time) uint64 {
+	secs := uint64(t.Unix() + ntpEpochOffset)
+	frac := (uint64(t.Nanosecond()) << 32) / uint64(time.Second)
+	return secs<<32 | frac
+}
+
+func fromNTPTime(ts uint64) time.Time {
+	secs := int64(ts>>32) - ntpEpochOffset
+	nanos := int64(((ts & 0xffffffff) * uint64(time.Second)) >> 32)
+	return time.Unix(secs, nanos)
+}
@@ -0,0 +1,172 @@
+// Package clocksync defines a service which estimates the offset of the local clock by querying NTP servers, and
+// warns when the clock drifts beyond a threshold. A drifting clock makes the node produce and accept messages at the
+// wrong time of the slot, a frequent cause of missed attestations which is otherwise hard to spot.
+package clocksync
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// queryInterval is the time between two estimations of the clock offset.
+	queryInterval = time.Minute
+	// queryTimeout is the time to wait for the response of an NTP server.
+	queryTimeout = 5 * time.Second
+)
+
+// ErrClockDrift is returned when the offset of the local clock exceeds the clock drift threshold.
+var ErrClockDrift = errors.New("local clock drift exceeds threshold")
+
+// Config of the clock sync service.
+type Config struct {
+	// Servers are the NTP servers queried, the service is disabled when empty.
+	Servers []string
+	// Threshold is the clock offset from which the local clock is drifting.
+	Threshold time.Duration
+	// RefuseAttestations makes CheckClock fail while the local clock is drifting, so that attestations are not
+	// published at the wrong time.
+	RefuseAttestations bool
+}
+
+// Checker checks whether the local clock allows to publish attestations.
+type Checker interface {
+	// CheckClock returns an error wrapping ErrClockDrift when the local clock drifts and attestations are refused.
+	CheckClock() error
+}
+
+// Service estimates the offset of the local clock every minute.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	lock   sync.RWMutex
+	offset time.Duration
+	// known is false until the offset is estimated.
+	known    bool
+	drifting bool
+}
+
+var _ Checker = (*Service)(nil)
+
+// NewService initializes the clock sync service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start the clock sync service in the background.
+func (s *Service) Start() {
+	if len(s.cfg.Servers) == 0 {
+		return
+	}
+	go s.run()
+}
+
+// Stop the clock sync service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the clock sync service, an error while the local clock is drifting.
+func (s *Service) Status() error {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.drifting {
+		return errors.Wrapf(ErrClockDrift, "offset %s, threshold %s", s.offset, s.cfg.Threshold)
+	}
+	return nil
+}
+
+// CheckClock returns an error while the local clock is drifting, when attestations are refused.
+func (s *Service) CheckClock() error {
+	if !s.cfg.RefuseAttestations {
+		return nil
+	}
+	return s.Status()
+}
+
+// Offset returns the last estimated offset of the local clock, and false until the offset is estimated.
+func (s *Service) Offset() (time.Duration, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.offset, s.known
+}
+
+func (s *Service) run() {
+	s.update()
+	ticker := time.NewTicker(queryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.update()
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// update estimates the offset of the local clock as the median of the offsets reported by the NTP servers.
+func (s *Service) update() {
+	offsets := make([]time.Duration, 0, len(s.cfg.Servers))
+	for _, server := range s.cfg.Servers {
+		offset, err := queryOffset(s.ctx, server, queryTimeout)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			ntpQueryFailuresCounter.WithLabelValues(server).Inc()
+			log.WithError(err).WithField("server", server).Debug("Could not query NTP server")
+			continue
+		}
+		offsets = append(offsets, offset)
+	}
+	if len(offsets) == 0 {
+		log.WithField("servers", s.cfg.Servers).Warn("Could not query any NTP server, clock offset is unknown")
+		return
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	offset := offsets[len(offsets)/2]
+	if len(offsets)%2 == 0 {
+		offset = (offsets[len(offsets)/2-1] + offset) / 2
+	}
+	s.setOffset(offset)
+}
+
+func (s *Service) setOffset(offset time.Duration) {
+	drifting := offset > s.cfg.Threshold || -offset > s.cfg.Threshold
+	s.lock.Lock()
+	wasDrifting := s.drifting
+	s.offset, s.known, s.drifting = offset, true, drifting
+	s.lock.Unlock()
+
+	clockOffsetGauge.Set(float64(offset.Milliseconds()))
+	fields := logrus.Fields{"offset": offset, "threshold": s.cfg.Threshold}
+	switch {
+	case drifting:
+		clockDriftExceededGauge.Set(1)
+		msg := "Local clock drifts from NTP time, attestations may be missed. Check the time synchronization of the host"
+		if s.cfg.RefuseAttestations {
+			msg = "Local clock drifts from NTP time, attestations are refused. Check the time synchronization of the host"
+		}
+		log.WithFields(fields).Warn(msg)
+	case wasDrifting:
+		clockDriftExceededGauge.Set(0)
+		log.WithFields(fields).Info("Local clock is back within the drift threshold")
+	default:
+		clockDriftExceededGauge.Set(0)
+		log.WithFields(fields).Debug("Estimated local clock offset")
+	}
+}
@@ -0,0 +1,154 @@
+package clocksync
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// startNTPServer starts an NTP server answering with its local time shifted by the given offset, and returns its
+// address.
+func startNTPServer(t *testing.T, offset time.Duration) string {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+	})
+	go func() {
+		req := make([]byte, ntpPacketSize)
+		for {
+			n, addr, err := conn.ReadFrom(req)
+			if err != nil {
+				return
+			}
+			if n < ntpPacketSize {
+				continue
+			}
+			resp := make([]byte, ntpPacketSize)
+			resp[0] = 4<<3 | ntpModeServer
+			resp[1] = 1
+			copy(resp[24:32], req[40:48])
+			now := toNTPTime(prysmTime.Now().Add(offset))
+			binary.BigEndian.PutUint64(resp[32:], now)
+			binary.BigEndian.PutUint64(resp[40:], now)
+			if _, err := conn.WriteTo(resp, addr); err != nil {
+				return
+			}
+		}
+	}()
+	return conn.LocalAddr().String()
+}
+
+func TestNTPTime(t *testing.T) {
+	now := time.Unix(1700000000, 123456789)
+	got := fromNTPTime(toNTPTime(now))
+	assert.Equal(t, true, got.Sub(now).Abs() < time.Microsecond, "round trip of %s gave %s", now, got)
+}
+
+func TestQueryOffset(t *testing.T) {
+	server := startNTPServer(t, 2*time.Second)
+	offset, err := queryOffset(context.Background(), server, time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, true, (offset-2*time.Second).Abs() < 100*time.Millisecond, "unexpected offset %s", offset)
+}
+
+func TestQueryOffset_Timeout(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	_, err = queryOffset(context.Background(), conn.LocalAddr().String(), 50*time.Millisecond)
+	require.ErrorContains(t, "could not read NTP response", err)
+}
+
+func TestService_Update(t *testing.T) {
+	tests := []struct {
+		name               string
+		offsets            []time.Duration
+		refuseAttestations bool
+		wantOffset         time.Duration
+		wantDrift          bool
+		wantRefused        bool
+	}{
+		{
+			name:       "in sync",
+			offsets:    []time.Duration{0},
+			wantOffset: 0,
+		},
+		{
+			name:       "drifting",
+			offsets:    []time.Duration{2 * time.Second},
+			wantOffset: 2 * time.Second,
+			wantDrift:  true,
+		},
+		{
+			name:               "drifting behind, attestations refused",
+			offsets:            []time.Duration{-2 * time.Second},
+			refuseAttestations: true,
+			wantOffset:         -2 * time.Second,
+			wantDrift:          true,
+			wantRefused:        true,
+		},
+		{
+			name:               "median ignores a wrong server",
+			offsets:            []time.Duration{0, 0, 10 * time.Second},
+			refuseAttestations: true,
+			wantOffset:         0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			servers := make([]string, len(tt.offsets))
+			for i, o := range tt.offsets {
+				servers[i] = startNTPServer(t, o)
+			}
+			s := NewService(context.Background(), &Config{
+				Servers:            servers,
+				Threshold:          500 * time.Millisecond,
+				RefuseAttestations: tt.refuseAttestations,
+			})
+			_, known := s.Offset()
+			require.Equal(t, false, known)
+			require.NoError(t, s.CheckClock())
+
+			s.update()
+			offset, known := s.Offset()
+			require.Equal(t, true, known)
+			assert.Equal(t, true, (offset-tt.wantOffset).Abs() < 100*time.Millisecond, "unexpected offset %s", offset)
+			if tt.wantDrift {
+				require.ErrorIs(t, s.Status(), ErrClockDrift)
+			} else {
+				require.NoError(t, s.Status())
+			}
+			if tt.wantRefused {
+				require.ErrorIs(t, s.CheckClock(), ErrClockDrift)
+			} else {
+				require.NoError(t, s.CheckClock())
+			}
+		})
+	}
+}
+
+func TestService_UpdateKeepsOffsetWhenServersFail(t *testing.T) {
+	s := NewService(context.Background(), &Config{Servers: []string{startNTPServer(t, 2*time.Second)}, Threshold: time.Second})
+	s.update()
+	offset, known := s.Offset()
+	require.Equal(t, true, known)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+	s.cfg.Servers = []string{conn.LocalAddr().String()}
+	s.update()
+	newOffset, known := s.Offset()
+	require.Equal(t, true, known)
+	assert.Equal(t, offset, newOffset)
+	require.ErrorIs(t, s.Status(), ErrClockDrift)
+}
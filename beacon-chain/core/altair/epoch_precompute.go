@@ -104,32 +104,41 @@ func ProcessInactivityScores(
 	recoveryRate := cfg.InactivityScoreRecoveryRate
 	prevEpoch := time.PrevEpoch(beaconState)
 	finalizedEpoch := beaconState.FinalizedCheckpointEpoch()
-	for i, v := range vals {
-		if !precompute.EligibleForRewards(v) {
-			continue
-		}
-
-		if v.IsPrevEpochTargetAttester && !v.IsSlashed {
-			// Decrease inactivity score when validator gets target correct.
-			if v.InactivityScore > 0 {
-				v.InactivityScore -= 1
+	leak := helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch)
+	err = helpers.ForEachValidatorShard(len(vals), func(start, end int) error {
+		for i := start; i < end; i++ {
+			v := vals[i]
+			if !precompute.EligibleForRewards(v) {
+				continue
 			}
-		} else {
-			v.InactivityScore, err = math.Add64(v.InactivityScore, bias)
-			if err != nil {
-				return nil, nil, err
+
+			if v.IsPrevEpochTargetAttester && !v.IsSlashed {
+				// Decrease inactivity score when validator gets target correct.
+				if v.InactivityScore > 0 {
+					v.InactivityScore -= 1
+				}
+			} else {
+				var err error
+				v.InactivityScore, err = math.Add64(v.InactivityScore, bias)
+				if err != nil {
+					return err
+				}
 			}
-		}
 
-		if !helpers.IsInInactivityLeak(prevEpoch, finalizedEpoch) {
-			score := recoveryRate
-			// Prevents underflow below 0.
-			if score > v.InactivityScore {
-				score = v.InactivityScore
+			if !leak {
+				score := recoveryRate
+				// Prevents underflow below 0.
+				if score > v.InactivityScore {
+					score = v.InactivityScore
+				}
+				v.InactivityScore -= score
 			}
-			v.InactivityScore -= score
+			inactivityScores[i] = v.InactivityScore
 		}
-		inactivityScores[i] = v.InactivityScore
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if err := beaconState.SetInactivityScores(inactivityScores); err != nil {
@@ -242,19 +251,26 @@ func ProcessRewardsAndPenaltiesPrecompute(
 	}
 
 	balances := beaconState.Balances()
-	for i := 0; i < numOfVals; i++ {
-		vals[i].BeforeEpochTransitionBalance = balances[i]
+	err = helpers.ForEachValidatorShard(numOfVals, func(start, end int) error {
+		for i := start; i < end; i++ {
+			vals[i].BeforeEpochTransitionBalance = balances[i]
+
+			// Compute the post balance of the validator after accounting for the
+			// attester and proposer rewards and penalties.
+			delta := attDeltas[i]
+			var err error
+			balances[i], err = helpers.IncreaseBalanceWithVal(balances[i], delta.HeadReward+delta.SourceReward+delta.TargetReward)
+			if err != nil {
+				return err
+			}
+			balances[i] = helpers.DecreaseBalanceWithVal(balances[i], delta.SourcePenalty+delta.TargetPenalty+delta.InactivityPenalty)
 
-		// Compute the post balance of the validator after accounting for the
-		// attester and proposer rewards and penalties.
-		delta := attDeltas[i]
-		balances[i], err = helpers.IncreaseBalanceWithVal(balances[i], delta.HeadReward+delta.SourceReward+delta.TargetReward)
-		if err != nil {
-			return nil, err
+			vals[i].AfterEpochTransitionBalance = balances[i]
 		}
-		balances[i] = helpers.DecreaseBalanceWithVal(balances[i], delta.SourcePenalty+delta.TargetPenalty+delta.InactivityPenalty)
-
-		vals[i].AfterEpochTransitionBalance = balances[i]
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	if err := beaconState.SetBalances(balances); err != nil {
@@ -285,11 +301,18 @@ func AttestationsDelta(beaconState state.BeaconState, bal *precompute.Balance, v
 	}
 	inactivityDenominator := bias * inactivityPenaltyQuotient
 
-	for i, v := range vals {
-		attDeltas[i], err = attestationDelta(bal, v, baseRewardMultiplier, inactivityDenominator, leak)
-		if err != nil {
-			return nil, err
+	err = helpers.ForEachValidatorShard(len(vals), func(start, end int) error {
+		for i := start; i < end; i++ {
+			var err error
+			attDeltas[i], err = attestationDelta(bal, vals[i], baseRewardMultiplier, inactivityDenominator, leak)
+			if err != nil {
+				return err
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return attDeltas, nil
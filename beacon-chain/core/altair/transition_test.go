@@ -2,10 +2,13 @@ package altair_test
 
 import (
 	"context"
+	"runtime"
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
@@ -73,3 +76,33 @@ func TestProcessEpoch_CanProcessBellatrix(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, params.BeaconConfig().SyncCommitteeSize, uint64(len(sc.Pubkeys)))
 }
+
+func TestProcessEpoch_ParallelMatchesSequential(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	st, _ := util.DeterministicGenesisStateAltair(t, params.BeaconConfig().MaxValidatorsPerCommittee)
+	require.NoError(t, st.SetSlot(10*params.BeaconConfig().SlotsPerEpoch))
+	// Vary the participation, the inactivity scores and the balances of the validators, for their rewards, penalties
+	// and effective balances to differ.
+	n := st.NumValidators()
+	participation := make([]byte, n)
+	scores := make([]uint64, n)
+	for i := 0; i < n; i++ {
+		participation[i] = byte(i % 8)
+		scores[i] = uint64(i % 5)
+		require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(i), params.BeaconConfig().MaxEffectiveBalance-uint64(i%3)*params.BeaconConfig().EffectiveBalanceIncrement))
+	}
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+	require.NoError(t, st.SetInactivityScores(scores))
+	parallel := st.Copy()
+
+	require.NoError(t, altair.ProcessEpoch(context.Background(), st))
+	want, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableParallelEpochProcessing: true})
+	defer resetCfg()
+	require.NoError(t, altair.ProcessEpoch(context.Background(), parallel))
+	got, err := parallel.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+}
@@ -0,0 +1,57 @@
+package electra
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+)
+
+var (
+	errNilExecutionRequests   = errors.New("nil execution requests")
+	errTooManyRequests        = errors.New("too many execution requests")
+	errNilExecutionRequest    = errors.New("nil execution request")
+	errDepositRequestsOrdered = errors.New("deposit requests are not in strictly increasing index order")
+)
+
+// ValidateExecutionRequests performs the sanity checks of the execution requests of a block which do not need the
+// execution layer: the number of requests of each type is within its limit, no request is nil, and deposit requests
+// are ordered by deposit index without duplicates. Blocks failing these checks are invalid whatever the execution
+// layer answers, so they are rejected without sending their payload to the execution layer.
+//
+// Withdrawal and consolidation requests may legitimately repeat within a block, as the request contracts accept the
+// same request several times, so duplicates of these are not rejected.
+func ValidateExecutionRequests(requests *enginev1.ExecutionRequests) error {
+	if requests == nil {
+		return errNilExecutionRequests
+	}
+	cfg := params.BeaconConfig()
+	if n := uint64(len(requests.Deposits)); n > cfg.MaxDepositRequestsPerPayload {
+		return errors.Wrapf(errTooManyRequests, "%d deposit requests, max %d", n, cfg.MaxDepositRequestsPerPayload)
+	}
+	if n := uint64(len(requests.Withdrawals)); n > cfg.MaxWithdrawalRequestsPerPayload {
+		return errors.Wrapf(errTooManyRequests, "%d withdrawal requests, max %d", n, cfg.MaxWithdrawalRequestsPerPayload)
+	}
+	if n := uint64(len(requests.Consolidations)); n > cfg.MaxConsolidationsRequestsPerPayload {
+		return errors.Wrapf(errTooManyRequests, "%d consolidation requests, max %d", n, cfg.MaxConsolidationsRequestsPerPayload)
+	}
+	for i, d := range requests.Deposits {
+		if d == nil {
+			return errors.Wrapf(errNilExecutionRequest, "deposit request %d", i)
+		}
+		if i > 0 && d.Index <= requests.Deposits[i-1].Index {
+			return errors.Wrapf(errDepositRequestsOrdered, "deposit request %d has index %d after index %d",
+				i, d.Index, requests.Deposits[i-1].Index)
+		}
+	}
+	for i, w := range requests.Withdrawals {
+		if w == nil {
+			return errors.Wrapf(errNilExecutionRequest, "withdrawal request %d", i)
+		}
+	}
+	for i, c := range requests.Consolidations {
+		if c == nil {
+			return errors.Wrapf(errNilExecutionRequest, "consolidation request %d", i)
+		}
+	}
+	return nil
+}
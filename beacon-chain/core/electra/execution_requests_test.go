@@ -0,0 +1,87 @@
+package electra_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/electra"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestValidateExecutionRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		requests *enginev1.ExecutionRequests
+		err      string
+	}{
+		{
+			name:     "empty",
+			requests: &enginev1.ExecutionRequests{},
+		},
+		{
+			name: "valid",
+			requests: &enginev1.ExecutionRequests{
+				Deposits:       []*enginev1.DepositRequest{{Index: 3}, {Index: 4}},
+				Withdrawals:    []*enginev1.WithdrawalRequest{{Amount: 1}, {Amount: 1}},
+				Consolidations: []*enginev1.ConsolidationRequest{{}},
+			},
+		},
+		{
+			name: "nil requests",
+			err:  "nil execution requests",
+		},
+		{
+			name: "too many deposits",
+			requests: &enginev1.ExecutionRequests{
+				Deposits: make([]*enginev1.DepositRequest, params.BeaconConfig().MaxDepositRequestsPerPayload+1),
+			},
+			err: "too many execution requests",
+		},
+		{
+			name: "too many withdrawals",
+			requests: &enginev1.ExecutionRequests{
+				Withdrawals: make([]*enginev1.WithdrawalRequest, params.BeaconConfig().MaxWithdrawalRequestsPerPayload+1),
+			},
+			err: "too many execution requests",
+		},
+		{
+			name: "too many consolidations",
+			requests: &enginev1.ExecutionRequests{
+				Consolidations: make([]*enginev1.ConsolidationRequest, params.BeaconConfig().MaxConsolidationsRequestsPerPayload+1),
+			},
+			err: "too many execution requests",
+		},
+		{
+			name: "nil withdrawal",
+			requests: &enginev1.ExecutionRequests{
+				Withdrawals: []*enginev1.WithdrawalRequest{{}, nil},
+			},
+			err: "withdrawal request 1: nil execution request",
+		},
+		{
+			name: "deposits out of order",
+			requests: &enginev1.ExecutionRequests{
+				Deposits: []*enginev1.DepositRequest{{Index: 4}, {Index: 3}},
+			},
+			err: "not in strictly increasing index order",
+		},
+		{
+			name: "duplicate deposit",
+			requests: &enginev1.ExecutionRequests{
+				Deposits: []*enginev1.DepositRequest{{Index: 4}, {Index: 4}},
+			},
+			err: "not in strictly increasing index order",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := electra.ValidateExecutionRequests(tt.requests)
+			if tt.err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, tt.err, err)
+		})
+	}
+}
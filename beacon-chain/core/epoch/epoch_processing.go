@@ -290,7 +290,7 @@ func ProcessEffectiveBalanceUpdates(st state.BeaconState) (state.BeaconState, er
 		return
 	}
 
-	if err := st.ApplyToEveryValidator(validatorFunc); err != nil {
+	if err := helpers.ApplyToEveryValidator(st, validatorFunc); err != nil {
 		return nil, err
 	}
 
@@ -31,6 +31,9 @@ const (
 	LightClientFinalityUpdate
 	// LightClientOptimisticUpdate event
 	LightClientOptimisticUpdate
+	// FinalityStall is sent while the chain has not finalized for longer than the configured number of epochs,
+	// and once more when it finalizes again.
+	FinalityStall
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -66,3 +69,17 @@ type InitializedData struct {
 	// GenesisValidatorsRoot represents state.validators.HashTreeRoot().
 	GenesisValidatorsRoot []byte
 }
+
+// FinalityStallData is the data sent with FinalityStall events.
+type FinalityStallData struct {
+	// Stalled is true while the chain has not finalized for longer than the configured number of epochs.
+	Stalled bool
+	// CurrentEpoch is the wall clock epoch.
+	CurrentEpoch primitives.Epoch
+	// FinalizedEpoch is the epoch of the finalized checkpoint.
+	FinalizedEpoch primitives.Epoch
+	// EpochsSinceFinality is the number of epochs between the finalized checkpoint and the current epoch.
+	EpochsSinceFinality primitives.Epoch
+	// InactivityLeak is true if validators that do not attest to the correct target are leaking balance.
+	InactivityLeak bool
+}
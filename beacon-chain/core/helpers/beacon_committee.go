@@ -355,8 +355,16 @@ func ShuffledIndices(s state.ReadOnlyBeaconState, epoch primitives.Epoch) ([]pri
 		return nil, err
 	}
 
-	// UnshuffleList is used as an optimized implementation for raw speed.
-	return UnshuffleList(indices, seed)
+	// The memoized permutation is shared with the committee computation of the same epoch.
+	perm, err := shufflePermutation(seed, uint64(len(indices)))
+	if err != nil {
+		return nil, err
+	}
+	shuffled := make([]primitives.ValidatorIndex, len(indices))
+	for i, p := range perm {
+		shuffled[i] = indices[p]
+	}
+	return shuffled, nil
 }
 
 // CommitteeIndices return beacon committee indices corresponding to bits that are set on the argument bitfield.
@@ -483,6 +491,7 @@ func CompressCommitteeCache() {
 // ClearCache clears the beacon committee cache and sync committee cache.
 func ClearCache() {
 	committeeCache.Clear()
+	shufflePermutationCache.Clear()
 	proposerIndicesCache.Prune(0)
 	syncCommitteeCache.Clear()
 	balanceCache.Clear()
@@ -516,18 +525,19 @@ func ComputeCommittee(
 		return nil, errors.New("index out of range")
 	}
 
-	// Save the shuffled indices in cache, this is only needed once per epoch or once per new committee index.
-	shuffledIndices := make([]primitives.ValidatorIndex, len(indices))
-	copy(shuffledIndices, indices)
-	// UnshuffleList is used here as it is an optimized implementation created
+	// The permutation of the shuffle is memoized, so that computing every committee of an epoch
+	// only shuffles once. It is computed with UnshuffleList, an optimized implementation created
 	// for fast computation of committees.
 	// Reference implementation: https://github.com/protolambda/eth2-shuffle
-	shuffledList, err := UnshuffleList(shuffledIndices, seed)
+	perm, err := shufflePermutation(seed, validatorCount)
 	if err != nil {
 		return nil, err
 	}
-
-	return shuffledList[start:end], nil
+	committee := make([]primitives.ValidatorIndex, end-start)
+	for i := range committee {
+		committee[i] = indices[perm[start+uint64(i)]]
+	}
+	return committee, nil
 }
 
 // PrecomputeProposerIndices computes proposer indices of the current epoch and returns a list of proposer indices,
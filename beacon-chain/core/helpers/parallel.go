@@ -0,0 +1,72 @@
+package helpers
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"golang.org/x/sync/errgroup"
+)
+
+// minValidatorsPerShard is the minimum number of validators processed by a goroutine in the parallel epoch processing,
+// below which starting the goroutine costs more than it saves.
+const minValidatorsPerShard = 256
+
+// ForEachValidatorShard calls f with the contiguous ranges [start, end) of the indices of the n validators. With
+// --enable-parallel-epoch-processing, the ranges are processed in parallel, otherwise f is called once with all the
+// validators. f must only write the values of the validators of its range, so that the result is identical either way
+// and does not depend on the order in which the ranges are processed.
+func ForEachValidatorShard(n int, f func(start, end int) error) error {
+	shards := 1
+	if features.Get().EnableParallelEpochProcessing {
+		shards = min(runtime.GOMAXPROCS(0), n/minValidatorsPerShard)
+	}
+	if shards <= 1 {
+		return f(0, n)
+	}
+	size := (n + shards - 1) / shards
+	var g errgroup.Group
+	for start := 0; start < n; start += size {
+		end := min(start+size, n)
+		g.Go(func() error {
+			return f(start, end)
+		})
+	}
+	return g.Wait()
+}
+
+// ApplyToEveryValidator applies f to every validator of the state like state.BeaconState.ApplyToEveryValidator. With
+// --enable-parallel-epoch-processing, f is called for the validators in parallel, and the validators it returns are
+// then set in the order of their indices. f must not modify the state.
+func ApplyToEveryValidator(st state.BeaconState, f func(idx int, val state.ReadOnlyValidator) (*ethpb.Validator, error)) error {
+	if !features.Get().EnableParallelEpochProcessing {
+		return st.ApplyToEveryValidator(f)
+	}
+	vals := st.ValidatorsReadOnly()
+	updated := make([]*ethpb.Validator, len(vals))
+	err := ForEachValidatorShard(len(vals), func(start, end int) error {
+		for i := start; i < end; i++ {
+			v, err := f(i, vals[i])
+			if err != nil {
+				return err
+			}
+			updated[i] = v
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for i, v := range updated {
+		if v == nil {
+			continue
+		}
+		if err := st.UpdateValidatorAtIndex(primitives.ValidatorIndex(i), v); err != nil {
+			return errors.Wrapf(err, "could not update validator at index %d", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package helpers_test
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForEachValidatorShard(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	for _, parallel := range []bool{false, true} {
+		resetCfg := features.InitWithReset(&features.Flags{EnableParallelEpochProcessing: parallel})
+		for _, n := range []int{0, 1, 255, 1000, 4097} {
+			var lock sync.Mutex
+			var calls int
+			seen := make([]int, n)
+			require.NoError(t, helpers.ForEachValidatorShard(n, func(start, end int) error {
+				lock.Lock()
+				calls++
+				lock.Unlock()
+				for i := start; i < end; i++ {
+					seen[i]++
+				}
+				return nil
+			}))
+			for i := range seen {
+				require.Equal(t, 1, seen[i], "validator %d processed %d times", i, seen[i])
+			}
+			if !parallel || n < 512 {
+				assert.Equal(t, 1, calls)
+			} else {
+				assert.Equal(t, true, calls > 1)
+			}
+		}
+
+		wantErr := errors.New("bad validator")
+		err := helpers.ForEachValidatorShard(4096, func(start, end int) error {
+			if start <= 3000 && 3000 < end {
+				return wantErr
+			}
+			return nil
+		})
+		require.ErrorIs(t, err, wantErr)
+		resetCfg()
+	}
+}
+
+func TestApplyToEveryValidator_Parallel(t *testing.T) {
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	vals := make([]*ethpb.Validator, 2048)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{EffectiveBalance: uint64(i)}
+	}
+	f := func(idx int, val state.ReadOnlyValidator) (*ethpb.Validator, error) {
+		if idx%3 != 0 {
+			return nil, nil
+		}
+		v := val.Copy()
+		v.EffectiveBalance += 1000
+		return v, nil
+	}
+
+	sequential, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{Validators: vals})
+	require.NoError(t, err)
+	require.NoError(t, helpers.ApplyToEveryValidator(sequential, f))
+
+	resetCfg := features.InitWithReset(&features.Flags{EnableParallelEpochProcessing: true})
+	defer resetCfg()
+	parallel, err := state_native.InitializeFromProtoPhase0(&ethpb.BeaconState{Validators: vals})
+	require.NoError(t, err)
+	require.NoError(t, helpers.ApplyToEveryValidator(parallel, f))
+	assert.DeepEqual(t, sequential.Validators(), parallel.Validators())
+	v, err := parallel.ValidatorAtIndexReadOnly(3)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1003), v.EffectiveBalance())
+}
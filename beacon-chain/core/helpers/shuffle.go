@@ -3,7 +3,9 @@ package helpers
 import (
 	"encoding/binary"
 	"fmt"
+	"math"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
@@ -19,6 +21,8 @@ const totalSize = seedSize + roundSize + positionWindowSize
 
 var maxShuffleListSize uint64 = 1 << 40
 
+var shufflePermutationCache = cache.NewShufflePermutationCache()
+
 // SplitIndices splits a list into n pieces.
 func SplitIndices(l []uint64, n uint64) [][]uint64 {
 	var divided [][]uint64
@@ -45,6 +49,46 @@ func UnShuffledIndex(index primitives.ValidatorIndex, indexCount uint64, seed [3
 	return ComputeShuffledIndex(index, indexCount, seed, false /* un-shuffle */)
 }
 
+// MemoizedShuffledIndex returns the same index as ShuffledIndex, looked up in the memoized permutation of
+// the seed and index count. It is cheaper than ShuffledIndex when many indices of the same shuffle are
+// requested, as the permutation is only computed once.
+func MemoizedShuffledIndex(index primitives.ValidatorIndex, indexCount uint64, seed [32]byte) (primitives.ValidatorIndex, error) {
+	if uint64(index) >= indexCount {
+		return 0, fmt.Errorf("input index %d out of bounds: %d", index, indexCount)
+	}
+	perm, err := shufflePermutation(seed, indexCount)
+	if err != nil {
+		return 0, err
+	}
+	return primitives.ValidatorIndex(perm[index]), nil
+}
+
+// shufflePermutation returns the permutation of the shuffle with the given seed and index count, where
+// entry i is the shuffled index of i. The permutation is computed once with the list shuffle and shared
+// through the cache, so it must not be modified.
+func shufflePermutation(seed [32]byte, indexCount uint64) ([]uint32, error) {
+	if perm, ok := shufflePermutationCache.Permutation(seed, indexCount); ok {
+		return perm, nil
+	}
+	if indexCount > math.MaxUint32 {
+		return nil, fmt.Errorf("list size %d out of bounds", indexCount)
+	}
+	list := make([]primitives.ValidatorIndex, indexCount)
+	for i := range list {
+		list[i] = primitives.ValidatorIndex(i)
+	}
+	shuffled, err := UnshuffleList(list, seed)
+	if err != nil {
+		return nil, err
+	}
+	perm := make([]uint32, indexCount)
+	for i, v := range shuffled {
+		perm[i] = uint32(v) // lint:ignore uintcast -- The index count is checked to fit in uint32 above.
+	}
+	shufflePermutationCache.Add(seed, perm)
+	return perm, nil
+}
+
 // ComputeShuffledIndex returns the shuffled validator index corresponding to seed and index count.
 // Spec pseudocode definition:
 //
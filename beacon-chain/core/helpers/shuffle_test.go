@@ -173,3 +173,40 @@ func TestSplitIndicesAndOffset_OK(t *testing.T) {
 		}
 	}
 }
+
+func TestMemoizedShuffledIndex(t *testing.T) {
+	ClearCache()
+	seed := [32]byte{'A'}
+	count := uint64(1000)
+	for i := primitives.ValidatorIndex(0); uint64(i) < count; i++ {
+		want, err := ShuffledIndex(i, count, seed)
+		require.NoError(t, err)
+		got, err := MemoizedShuffledIndex(i, count, seed)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := MemoizedShuffledIndex(primitives.ValidatorIndex(count), count, seed)
+	require.ErrorContains(t, "out of bounds", err)
+}
+
+func TestComputeCommittee_MatchesShuffledList(t *testing.T) {
+	ClearCache()
+	seed := [32]byte{'B'}
+	indices := make([]primitives.ValidatorIndex, 500)
+	for i := range indices {
+		indices[i] = primitives.ValidatorIndex(2 * i)
+	}
+	list := make([]primitives.ValidatorIndex, len(indices))
+	copy(list, indices)
+	shuffled, err := UnshuffleList(list, seed)
+	require.NoError(t, err)
+
+	count := uint64(16)
+	var all []primitives.ValidatorIndex
+	for i := uint64(0); i < count; i++ {
+		committee, err := ComputeCommittee(indices, seed, i, count)
+		require.NoError(t, err)
+		all = append(all, committee...)
+	}
+	assert.DeepEqual(t, shuffled, all)
+}
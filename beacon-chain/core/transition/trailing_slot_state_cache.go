@@ -13,6 +13,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 )
 
+// nextSlotCache holds the states of the two most likely proposal parents, advanced to their next
+// slot: the last updated root, usually the head, and the root updated before it, usually the parent
+// of the head, which is built on when the head block arrived late and is reorged.
 type nextSlotCache struct {
 	sync.Mutex
 	prevRoot  []byte
@@ -54,7 +57,9 @@ func NextSlotState(root []byte, wantedSlot types.Slot) state.BeaconState {
 
 // UpdateNextSlotCache updates the `nextSlotCache`. It saves the input state after advancing the state slot by 1
 // by calling `ProcessSlots`, it also saves the input root for later look up.
-// This is useful to call after successfully processing a block.
+// This is useful to call after successfully processing a block. Updating the last root again, as done
+// when the head is advanced over a skipped slot, keeps the state of the previous root if it belongs to
+// another block, so that the parent of the head remains available.
 func UpdateNextSlotCache(ctx context.Context, root []byte, state state.BeaconState) error {
 	// Advancing one slot by using a copied state.
 	copied := state.Copy()
@@ -66,13 +71,45 @@ func UpdateNextSlotCache(ctx context.Context, root []byte, state state.BeaconSta
 	nsc.Lock()
 	defer nsc.Unlock()
 
-	nsc.prevRoot = nsc.lastRoot
-	nsc.prevState = nsc.lastState
+	if !bytes.Equal(root, nsc.lastRoot) || nsc.prevState == nil || bytes.Equal(root, nsc.prevRoot) {
+		nsc.prevRoot = nsc.lastRoot
+		nsc.prevState = nsc.lastState
+	}
 	nsc.lastRoot = bytesutil.SafeCopyBytes(root)
 	nsc.lastState = copied
 	return nil
 }
 
+// AdvanceNextSlotCache advances the cached states which are behind the given slot to it, so that
+// proposing at that slot on either the head or its parent does not require processing slots on
+// the critical path. States are advanced outside of the cache lock, and only stored if their root
+// is still cached.
+func AdvanceNextSlotCache(ctx context.Context, slot types.Slot) error {
+	nsc.Lock()
+	roots := [][]byte{nsc.lastRoot, nsc.prevRoot}
+	states := []state.BeaconState{nsc.lastState, nsc.prevState}
+	nsc.Unlock()
+
+	for i, st := range states {
+		if st == nil || st.Slot() >= slot {
+			continue
+		}
+		advanced, err := ProcessSlots(ctx, st.Copy(), slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not process slots for root %#x", roots[i])
+		}
+		nsc.Lock()
+		switch {
+		case bytes.Equal(roots[i], nsc.lastRoot) && nsc.lastState == st:
+			nsc.lastState = advanced
+		case bytes.Equal(roots[i], nsc.prevRoot) && nsc.prevState == st:
+			nsc.prevState = advanced
+		}
+		nsc.Unlock()
+	}
+	return nil
+}
+
 // LastCachedState returns the last cached state and root in the cache
 func LastCachedState() ([]byte, state.BeaconState) {
 	nsc.Lock()
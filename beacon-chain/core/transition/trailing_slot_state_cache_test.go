@@ -47,3 +47,24 @@ func TestTrailingSlotState_StateAdvancedBeyondRequest(t *testing.T) {
 	s = transition.NextSlotState(r, 1)
 	require.Equal(t, nil, s)
 }
+
+func TestTrailingSlotState_KeepsAndAdvancesParent(t *testing.T) {
+	ctx := context.Background()
+	parent, head := []byte{'p'}, []byte{'h'}
+	s, _ := util.DeterministicGenesisState(t, 1)
+	require.NoError(t, transition.UpdateNextSlotCache(ctx, parent, s))
+	require.NoError(t, s.SetSlot(1))
+	require.NoError(t, transition.UpdateNextSlotCache(ctx, head, s))
+
+	// Advancing the head over a skipped slot keeps the parent.
+	_, last := transition.LastCachedState()
+	require.NoError(t, transition.UpdateNextSlotCache(ctx, head, last))
+	require.NotNil(t, transition.NextSlotState(parent, 1))
+	require.Equal(t, primitives.Slot(3), transition.NextSlotState(head, 3).Slot())
+
+	// Both proposal parents are advanced to the proposal slot.
+	require.NoError(t, transition.AdvanceNextSlotCache(ctx, 3))
+	assert.Equal(t, primitives.Slot(3), transition.NextSlotState(parent, 3).Slot())
+	assert.Equal(t, primitives.Slot(3), transition.NextSlotState(head, 3).Slot())
+	assert.Equal(t, nil, transition.NextSlotState(parent, 2))
+}
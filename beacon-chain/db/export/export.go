@@ -0,0 +1,295 @@
+// Package export streams the canonical blocks of a slot range out of the beacon database, for indexers bootstrapping
+// from a node instead of replaying the beacon API block by block.
+//
+// Blocks are written in increasing slot order, either as newline-delimited JSON objects holding the block in its beacon
+// API JSON encoding with metadata deduced from it, or as size-delimited GenericSignedBeaconBlock protobuf messages.
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// Format is the encoding of the exported blocks.
+type Format string
+
+const (
+	// JSON writes a Block JSON object per line.
+	JSON Format = "json"
+	// Protobuf writes a size-delimited GenericSignedBeaconBlock message per block.
+	Protobuf Format = "protobuf"
+)
+
+// slotsPerRead is the number of slots of the blocks read from the database at once.
+const slotsPerRead = 256
+
+// Database is the part of the beacon database the blocks are exported from.
+type Database interface {
+	iface.ReadOnlyDatabase
+	HeadBlock(ctx context.Context) (interfaces.ReadOnlySignedBeaconBlock, error)
+}
+
+// Block is a canonical block with the metadata deduced from it, as written by the JSON format.
+type Block struct {
+	Slot          string `json:"slot"`
+	Root          string `json:"root"`
+	ParentRoot    string `json:"parent_root"`
+	ProposerIndex string `json:"proposer_index"`
+	Version       string `json:"version"`
+	// Graffiti is the graffiti as text without trailing zero bytes, or as hex when it is not valid UTF-8.
+	Graffiti string `json:"graffiti"`
+	// AttestationsCount is the number of attestations of the block.
+	AttestationsCount int `json:"attestations_count"`
+	// ParticipationCount is the number of attester votes of the attestations of the block, counting a validator once
+	// per attestation which includes it.
+	ParticipationCount   int                         `json:"participation_count"`
+	ExecutionBlockNumber string                      `json:"execution_block_number,omitempty"`
+	ExecutionBlockHash   string                      `json:"execution_block_hash,omitempty"`
+	Block                structs.SignedMessageJsoner `json:"block"`
+}
+
+// Blocks writes the canonical blocks from the start slot to the end slot, both included, in the format. The end slot
+// is capped to the slot of the head block. It returns the number of written blocks.
+func Blocks(ctx context.Context, db Database, w io.Writer, format Format, start, end primitives.Slot) (int, error) {
+	if format != JSON && format != Protobuf {
+		return 0, fmt.Errorf("unknown export format %q, expected %s or %s", format, JSON, Protobuf)
+	}
+	c, err := newCanonical(ctx, db)
+	if err != nil {
+		return 0, err
+	}
+	if end > c.headSlot {
+		end = c.headSlot
+	}
+	if start > end {
+		return 0, fmt.Errorf("start slot %d is after end slot %d", start, end)
+	}
+
+	n := 0
+	for from := start; from <= end; from += slotsPerRead {
+		if ctx.Err() != nil {
+			return n, ctx.Err()
+		}
+		to := from + slotsPerRead - 1
+		if to > end || to < from {
+			to = end
+		}
+		blks, roots, err := db.Blocks(ctx, filters.NewFilter().SetStartSlot(from).SetEndSlot(to))
+		if err != nil {
+			return n, errors.Wrapf(err, "could not read blocks from slot %d to %d", from, to)
+		}
+		idx := make([]int, 0, len(blks))
+		for i := range blks {
+			if c.isCanonical(ctx, blks[i], roots[i]) {
+				idx = append(idx, i)
+			}
+		}
+		sort.Slice(idx, func(i, j int) bool {
+			return blks[idx[i]].Block().Slot() < blks[idx[j]].Block().Slot()
+		})
+		for _, i := range idx {
+			if err := write(w, format, blks[i], roots[i]); err != nil {
+				return n, errors.Wrapf(err, "could not write block at slot %d", blks[i].Block().Slot())
+			}
+			n++
+		}
+		if to == end {
+			break
+		}
+	}
+	return n, nil
+}
+
+// canonical decides whether blocks are ancestors of the head. Blocks up to the finalized checkpoint are canonical
+// when they are in the finalized index, or when they are below the checkpoint sync origin, which only has backfilled
+// canonical blocks below it. Blocks after the finalized checkpoint are canonical when they are on the chain walked
+// back from the head.
+type canonical struct {
+	headSlot      primitives.Slot
+	finalizedSlot primitives.Slot
+	originRoot    [32]byte
+	originSlot    primitives.Slot
+	recent        map[[32]byte]bool
+	db            Database
+}
+
+func newCanonical(ctx context.Context, db Database) (*canonical, error) {
+	head, err := db.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	if err := blocks.BeaconBlockIsNil(head); err != nil {
+		return nil, errors.Wrap(err, "no head block")
+	}
+	cp, err := db.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	c := &canonical{
+		headSlot: head.Block().Slot(),
+		recent:   make(map[[32]byte]bool),
+		db:       db,
+	}
+	c.finalizedSlot, err = slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	c.originRoot, err = db.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case errors.Is(err, kv.ErrNotFoundOriginBlockRoot):
+	case err != nil:
+		return nil, errors.Wrap(err, "could not get checkpoint sync origin root")
+	default:
+		origin, err := db.Block(ctx, c.originRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get checkpoint sync origin block")
+		}
+		if blocks.BeaconBlockIsNil(origin) == nil {
+			c.originSlot = origin.Block().Slot()
+		}
+	}
+
+	root, err := head.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute head block root")
+	}
+	for blk := head; blk.Block().Slot() > c.finalizedSlot; {
+		c.recent[root] = true
+		root = blk.Block().ParentRoot()
+		blk, err = db.Block(ctx, root)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get ancestor %#x of head block", root)
+		}
+		if blocks.BeaconBlockIsNil(blk) != nil {
+			break
+		}
+	}
+	return c, nil
+}
+
+func (c *canonical) isCanonical(ctx context.Context, blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte) bool {
+	slot := blk.Block().Slot()
+	if slot > c.finalizedSlot {
+		return c.recent[root]
+	}
+	return root == c.originRoot || slot < c.originSlot || c.db.IsFinalizedBlock(ctx, root)
+}
+
+func write(w io.Writer, format Format, blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte) error {
+	if format == Protobuf {
+		pb, err := genericBlock(blk)
+		if err != nil {
+			return err
+		}
+		_, err = protodelim.MarshalTo(w, pb)
+		return err
+	}
+	b, err := newBlock(blk, root)
+	if err != nil {
+		return err
+	}
+	enc, err := json.Marshal(b)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(enc, '\n'))
+	return err
+}
+
+func newBlock(blk interfaces.ReadOnlySignedBeaconBlock, root [32]byte) (*Block, error) {
+	jsoner, err := structs.SignedBeaconBlockMessageJsoner(blk)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block to JSON")
+	}
+	b := blk.Block()
+	parentRoot := b.ParentRoot()
+	graffiti := b.Body().Graffiti()
+	out := &Block{
+		Slot:          fmt.Sprintf("%d", b.Slot()),
+		Root:          hexutil.Encode(root[:]),
+		ParentRoot:    hexutil.Encode(parentRoot[:]),
+		ProposerIndex: fmt.Sprintf("%d", b.ProposerIndex()),
+		Version:       version.String(blk.Version()),
+		Graffiti:      decodeGraffiti(graffiti[:]),
+		Block:         jsoner,
+	}
+	for _, att := range b.Body().Attestations() {
+		out.AttestationsCount++
+		out.ParticipationCount += int(att.GetAggregationBits().Count())
+	}
+	if blk.Version() >= version.Bellatrix {
+		payload, err := b.Body().Execution()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get execution payload")
+		}
+		out.ExecutionBlockNumber = fmt.Sprintf("%d", payload.BlockNumber())
+		out.ExecutionBlockHash = hexutil.Encode(payload.BlockHash())
+	}
+	return out, nil
+}
+
+// decodeGraffiti returns the graffiti without its trailing zero bytes as text, or as hex when it is not valid UTF-8.
+func decodeGraffiti(graffiti []byte) string {
+	trimmed := strings.TrimRight(string(graffiti), "\x00")
+	if !utf8.ValidString(trimmed) {
+		return hexutil.Encode(graffiti)
+	}
+	return trimmed
+}
+
+// genericBlock wraps the block in the generic block message of its fork. Blocks of Deneb and later are wrapped in
+// block contents without blobs.
+func genericBlock(blk interfaces.ReadOnlySignedBeaconBlock) (*ethpb.GenericSignedBeaconBlock, error) {
+	pb, err := blk.Proto()
+	if err != nil {
+		return nil, err
+	}
+	switch b := pb.(type) {
+	case *ethpb.SignedBeaconBlock:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Phase0{Phase0: b}}, nil
+	case *ethpb.SignedBeaconBlockAltair:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Altair{Altair: b}}, nil
+	case *ethpb.SignedBeaconBlockBellatrix:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Bellatrix{Bellatrix: b}}, nil
+	case *ethpb.SignedBlindedBeaconBlockBellatrix:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedBellatrix{BlindedBellatrix: b}}, nil
+	case *ethpb.SignedBeaconBlockCapella:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Capella{Capella: b}}, nil
+	case *ethpb.SignedBlindedBeaconBlockCapella:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedCapella{BlindedCapella: b}}, nil
+	case *ethpb.SignedBeaconBlockDeneb:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Deneb{
+			Deneb: &ethpb.SignedBeaconBlockContentsDeneb{Block: b},
+		}}, nil
+	case *ethpb.SignedBlindedBeaconBlockDeneb:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedDeneb{BlindedDeneb: b}}, nil
+	case *ethpb.SignedBeaconBlockElectra:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_Electra{
+			Electra: &ethpb.SignedBeaconBlockContentsElectra{Block: b},
+		}}, nil
+	case *ethpb.SignedBlindedBeaconBlockElectra:
+		return &ethpb.GenericSignedBeaconBlock{Block: &ethpb.GenericSignedBeaconBlock_BlindedElectra{BlindedElectra: b}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported block type %T", pb)
+	}
+}
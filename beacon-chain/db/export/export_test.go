@@ -0,0 +1,149 @@
+package export
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// setupChain saves a canonical chain of blocks from genesis to slot 40, finalized at epoch 1, with fork blocks at
+// slots 2 and 36, and returns the roots of the canonical blocks by slot.
+func setupChain(t *testing.T) (db.Database, [][32]byte) {
+	ctx := context.Background()
+	d := dbtest.SetupDB(t)
+
+	save := func(slot primitives.Slot, parent [32]byte, graffiti string) [32]byte {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent[:]
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte(graffiti), 32)
+		wsb := util.SaveBlock(t, ctx, d, b)
+		root, err := wsb.Block().HashTreeRoot()
+		require.NoError(t, err)
+		return root
+	}
+	roots := [][32]byte{save(0, [32]byte{}, "")}
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, roots[0]))
+	for slot := primitives.Slot(1); slot <= 40; slot++ {
+		roots = append(roots, save(slot, roots[slot-1], "canonical"))
+	}
+	save(2, roots[1], "fork")
+	save(36, roots[35], "fork")
+
+	require.NoError(t, d.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 32, Root: roots[32][:]}))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: roots[32][:]}))
+	require.NoError(t, d.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 40, Root: roots[40][:]}))
+	require.NoError(t, d.SaveHeadBlockRoot(ctx, roots[40]))
+	return d, roots
+}
+
+func TestBlocks_JSON(t *testing.T) {
+	ctx := context.Background()
+	d, roots := setupChain(t)
+
+	var buf bytes.Buffer
+	n, err := Blocks(ctx, d, &buf, JSON, 0, 100)
+	require.NoError(t, err)
+	require.Equal(t, 41, n)
+
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(nil, 1<<20)
+	slot := 0
+	for scanner.Scan() {
+		b := &struct {
+			Root       string          `json:"root"`
+			ParentRoot string          `json:"parent_root"`
+			Version    string          `json:"version"`
+			Graffiti   string          `json:"graffiti"`
+			Block      json.RawMessage `json:"block"`
+		}{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), b))
+		require.NotEmpty(t, b.Block)
+		assert.Equal(t, hexutil.Encode(roots[slot][:]), b.Root)
+		assert.Equal(t, "phase0", b.Version)
+		if slot > 0 {
+			assert.Equal(t, "canonical", b.Graffiti)
+			assert.Equal(t, hexutil.Encode(roots[slot-1][:]), b.ParentRoot)
+		}
+		slot++
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 41, slot)
+}
+
+func TestBlocks_Protobuf(t *testing.T) {
+	ctx := context.Background()
+	d, roots := setupChain(t)
+
+	var buf bytes.Buffer
+	n, err := Blocks(ctx, d, &buf, Protobuf, 34, 37)
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	r := bufio.NewReader(&buf)
+	for slot := primitives.Slot(34); slot <= 37; slot++ {
+		b := &ethpb.GenericSignedBeaconBlock{}
+		require.NoError(t, protodelim.UnmarshalFrom(r, b))
+		root, err := b.GetPhase0().Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, roots[slot], root)
+	}
+	assert.Equal(t, io.EOF, protodelim.UnmarshalFrom(r, &ethpb.GenericSignedBeaconBlock{}))
+}
+
+func TestBlocks_InvalidRange(t *testing.T) {
+	ctx := context.Background()
+	d, _ := setupChain(t)
+
+	_, err := Blocks(ctx, d, io.Discard, JSON, 41, 50)
+	assert.ErrorContains(t, "start slot 41 is after end slot 40", err)
+	_, err = Blocks(ctx, d, io.Discard, "csv", 0, 1)
+	assert.ErrorContains(t, "unknown export format", err)
+}
+
+func TestNewBlock_Metadata(t *testing.T) {
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 3
+	b.Block.ProposerIndex = 7
+	b.Block.Body.Graffiti = bytesutil.PadTo([]byte{0xff, 0xfe}, 32)
+	b.Block.Body.Attestations = []*ethpb.Attestation{
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1011}}),
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b1100}}),
+	}
+	wsb, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	got, err := newBlock(wsb, [32]byte{'a'})
+	require.NoError(t, err)
+	assert.Equal(t, "3", got.Slot)
+	assert.Equal(t, "7", got.ProposerIndex)
+	assert.Equal(t, hexutil.Encode(b.Block.Body.Graffiti), got.Graffiti)
+	assert.Equal(t, 2, got.AttestationsCount)
+	assert.Equal(t, 3, got.ParticipationCount)
+	assert.Equal(t, "", got.ExecutionBlockNumber)
+
+	bb := util.NewBeaconBlockBellatrix()
+	bb.Block.Body.ExecutionPayload.BlockNumber = 12
+	wsb, err = blocks.NewSignedBeaconBlock(bb)
+	require.NoError(t, err)
+	got, err = newBlock(wsb, [32]byte{})
+	require.NoError(t, err)
+	assert.Equal(t, "12", got.ExecutionBlockNumber)
+	assert.Equal(t, "bellatrix", got.Version)
+	assert.Equal(t, "", got.Graffiti)
+}
@@ -0,0 +1,201 @@
+// Package backend abstracts the transactional, bucketed key-value store underneath the
+// beacon node database, so that the kv package can run on top of storage engines other than BoltDB.
+package backend
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Type identifies a storage engine implementation.
+type Type string
+
+const (
+	// Bolt is the default backend, a single BoltDB file.
+	Bolt Type = "bolt"
+	// Pebble is an experimental LSM based backend, which avoids the long write stalls and
+	// file growth that BoltDB shows for large databases.
+	Pebble Type = "pebble"
+)
+
+const (
+	// BoltFileName is the name of the BoltDB file inside the database directory.
+	BoltFileName = "beaconchain.db"
+	// PebbleDirName is the name of the Pebble directory inside the database directory.
+	PebbleDirName = "beaconchain.pebble"
+)
+
+var (
+	// ErrUnknownType is returned when a backend type is not supported.
+	ErrUnknownType = errors.New("unknown database backend")
+	// ErrTxNotWritable is returned when modifying data from a read-only transaction.
+	ErrTxNotWritable = errors.New("transaction not writable")
+	// ErrBucketNotFound is returned when deleting a bucket that does not exist.
+	ErrBucketNotFound = errors.New("bucket not found")
+)
+
+// Types lists all supported backends.
+var Types = []Type{Bolt, Pebble}
+
+// ParseType returns the backend type with the given name.
+func ParseType(name string) (Type, error) {
+	for _, t := range Types {
+		if string(t) == name {
+			return t, nil
+		}
+	}
+	return "", errors.Wrapf(ErrUnknownType, "%q, expected one of %v", name, Types)
+}
+
+// DB is a key-value store organized in buckets, with serializable read-write transactions
+// and concurrent read-only transactions, following the semantics of BoltDB.
+type DB interface {
+	// View runs fn in a read-only transaction.
+	View(fn func(Tx) error) error
+	// Update runs fn in a read-write transaction, which is committed if fn returns nil.
+	Update(fn func(Tx) error) error
+	// Batch runs fn in a read-write transaction, which may be shared with concurrent calls to Batch
+	// to reduce the number of commits. If the shared transaction fails, fn is run again on its own,
+	// so it must be idempotent. Backends which do not coalesce transactions behave like Update.
+	Batch(fn func(Tx) error) error
+	// Sync flushes commits which were not synced to disk because of WriteOptions.NoSync.
+	Sync() error
+	// SetWriteOptions changes how commits are written to disk. It must not be called concurrently
+	// with transactions.
+	SetWriteOptions(opts WriteOptions)
+	// Close releases all resources of the database.
+	Close() error
+	// Path returns the location of the database on disk.
+	Path() string
+	// Type returns the backend type.
+	Type() Type
+	// Collector returns a prometheus collector exporting the metrics of the database. Backends which
+	// report per bucket statistics skip the given buckets, as gathering them is expensive for large buckets.
+	Collector(skipBuckets ...[]byte) prometheus.Collector
+}
+
+// WriteOptions tune how read-write transactions are committed.
+type WriteOptions struct {
+	// NoSync skips syncing every commit to disk, leaving it to Sync or the operating system.
+	// With Pebble, a crash may lose the commits since the last sync, but not corrupt the database.
+	// With BoltDB, an operating system crash or power loss may corrupt the database file, as its
+	// pages are written in place; only a crash of the process itself is safe.
+	NoSync bool
+	// MaxBatchSize is the maximum number of Batch calls coalesced into one transaction.
+	// The backend default is used when zero.
+	MaxBatchSize int
+	// MaxBatchDelay is the maximum time to wait for more Batch calls before committing.
+	// The backend default is used when zero.
+	MaxBatchDelay time.Duration
+}
+
+// Tx is a transaction on a DB. Keys and values returned by a transaction are only valid
+// for the life of the transaction and must not be modified.
+type Tx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists creates the bucket with the given name if needed, and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes the bucket with the given name and all of its keys.
+	DeleteBucket(name []byte) error
+	// ForEach calls fn for every bucket in the database, in lexicographic order of their names.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a collection of key-value pairs sorted by key.
+type Bucket interface {
+	// Get returns the value of the key, or nil if it does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of the key.
+	Put(key, value []byte) error
+	// Delete removes the key, if it exists.
+	Delete(key []byte) error
+	// Cursor returns a cursor to iterate over the bucket in key order.
+	Cursor() Cursor
+	// ForEach calls fn for every key-value pair in the bucket, in key order.
+	ForEach(fn func(k, v []byte) error) error
+}
+
+// Cursor iterates over the key-value pairs of a bucket. All methods return a nil key
+// when the cursor moves past either end of the bucket.
+type Cursor interface {
+	First() (key []byte, value []byte)
+	Last() (key []byte, value []byte)
+	Next() (key []byte, value []byte)
+	Prev() (key []byte, value []byte)
+	// Seek moves the cursor to the first key which is greater than or equal to seek.
+	Seek(seek []byte) (key []byte, value []byte)
+}
+
+// DataPath returns the on-disk location of a database of the given type within dirPath.
+func DataPath(t Type, dirPath string) (string, error) {
+	switch t {
+	case Bolt:
+		return filepath.Join(dirPath, BoltFileName), nil
+	case Pebble:
+		return filepath.Join(dirPath, PebbleDirName), nil
+	default:
+		return "", errors.Wrapf(ErrUnknownType, "%q", t)
+	}
+}
+
+// Open opens, or creates, the database of the given type within dirPath.
+func Open(t Type, dirPath string) (DB, error) {
+	p, err := DataPath(t, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	switch t {
+	case Bolt:
+		return OpenBolt(p)
+	default:
+		return OpenPebble(p)
+	}
+}
+
+// Exists reports whether dirPath contains a database of the given type.
+func Exists(t Type, dirPath string) (bool, error) {
+	p, err := DataPath(t, dirPath)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(p); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Detect returns the types of all databases present in dirPath.
+func Detect(dirPath string) ([]Type, error) {
+	var found []Type
+	for _, t := range Types {
+		ok, err := Exists(t, dirPath)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			found = append(found, t)
+		}
+	}
+	return found, nil
+}
+
+// Remove deletes the database of the given type within dirPath, if it exists.
+func Remove(t Type, dirPath string) error {
+	p, err := DataPath(t, dirPath)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(p); err != nil {
+		return fmt.Errorf("could not remove %s database: %w", t, err)
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func openTestDB(t *testing.T, typ Type) DB {
+	db, err := Open(typ, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	return db
+}
+
+func TestBackends(t *testing.T) {
+	for _, typ := range Types {
+		t.Run(string(typ), func(t *testing.T) {
+			db := openTestDB(t, typ)
+			assert.Equal(t, typ, db.Type())
+			bucketA, bucketB := []byte("a"), []byte("ab")
+
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.Equal(t, true, tx.Bucket(bucketA) == nil)
+				a, err := tx.CreateBucketIfNotExists(bucketA)
+				require.NoError(t, err)
+				b, err := tx.CreateBucketIfNotExists(bucketB)
+				require.NoError(t, err)
+				for i := 0; i < 10; i++ {
+					require.NoError(t, a.Put([]byte{byte(i)}, []byte(fmt.Sprintf("a%d", i))))
+				}
+				require.NoError(t, b.Put([]byte{0}, []byte{}))
+				require.NoError(t, a.Delete([]byte{5}))
+				// Writes are visible within the transaction.
+				assert.DeepEqual(t, []byte("a3"), a.Get([]byte{3}))
+				return nil
+			}))
+
+			// A failed transaction is rolled back.
+			require.ErrorContains(t, "rollback", db.Update(func(tx Tx) error {
+				require.NoError(t, tx.Bucket(bucketA).Put([]byte{20}, []byte("x")))
+				return fmt.Errorf("rollback")
+			}))
+
+			require.NoError(t, db.View(func(tx Tx) error {
+				a := tx.Bucket(bucketA)
+				require.NotNil(t, a)
+				assert.Equal(t, true, a.Get([]byte{5}) == nil)
+				assert.Equal(t, true, a.Get([]byte{20}) == nil)
+				// Empty values are distinct from missing keys.
+				v := tx.Bucket(bucketB).Get([]byte{0})
+				assert.Equal(t, true, v != nil && len(v) == 0)
+				require.ErrorIs(t, a.Put([]byte{1}, []byte{1}), ErrTxNotWritable)
+
+				c := a.Cursor()
+				k, v := c.First()
+				assert.DeepEqual(t, []byte{0}, k)
+				assert.DeepEqual(t, []byte("a0"), v)
+				k, _ = c.Seek([]byte{5})
+				assert.DeepEqual(t, []byte{6}, k)
+				k, _ = c.Prev()
+				assert.DeepEqual(t, []byte{4}, k)
+				k, _ = c.Last()
+				assert.DeepEqual(t, []byte{9}, k)
+				k, _ = c.Next()
+				assert.Equal(t, true, k == nil)
+				k, _ = c.Seek([]byte{10})
+				assert.Equal(t, true, k == nil)
+
+				count := 0
+				require.NoError(t, a.ForEach(func(k, v []byte) error {
+					count++
+					return nil
+				}))
+				assert.Equal(t, 9, count)
+
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, _ Bucket) error {
+					names = append(names, string(name))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "ab"}, names)
+				return nil
+			}))
+
+			require.NoError(t, db.Update(func(tx Tx) error {
+				require.NoError(t, tx.DeleteBucket(bucketA))
+				require.ErrorIs(t, tx.DeleteBucket(bucketA), ErrBucketNotFound)
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.Equal(t, true, tx.Bucket(bucketA) == nil)
+				a, err := tx.CreateBucketIfNotExists(bucketA)
+				require.NoError(t, err)
+				k, _ := a.Cursor().First()
+				assert.Equal(t, true, k == nil)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestBatch(t *testing.T) {
+	for _, typ := range Types {
+		t.Run(string(typ), func(t *testing.T) {
+			db := openTestDB(t, typ)
+			db.SetWriteOptions(WriteOptions{NoSync: true, MaxBatchSize: 8, MaxBatchDelay: time.Millisecond})
+			name := []byte("batched")
+			require.NoError(t, db.Update(func(tx Tx) error {
+				_, err := tx.CreateBucketIfNotExists(name)
+				return err
+			}))
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 32)
+			for i := 0; i < 32; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					errs <- db.Batch(func(tx Tx) error {
+						return tx.Bucket(name).Put([]byte{byte(i)}, []byte{byte(i)})
+					})
+				}(i)
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				require.NoError(t, err)
+			}
+			require.NoError(t, db.Sync())
+
+			require.NoError(t, db.View(func(tx Tx) error {
+				count := 0
+				require.NoError(t, tx.Bucket(name).ForEach(func(k, v []byte) error {
+					assert.DeepEqual(t, k, v)
+					count++
+					return nil
+				}))
+				assert.Equal(t, 32, count)
+				return nil
+			}))
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	src := openTestDB(t, Bolt)
+	dst := openTestDB(t, Pebble)
+	buckets := [][]byte{[]byte("blocks"), []byte("states"), []byte("empty")}
+	require.NoError(t, src.Update(func(tx Tx) error {
+		for i, name := range buckets {
+			b, err := tx.CreateBucketIfNotExists(name)
+			require.NoError(t, err)
+			for j := 0; j < i*25; j++ {
+				require.NoError(t, b.Put([]byte{byte(j)}, []byte{byte(i), byte(j)}))
+			}
+		}
+		return nil
+	}))
+
+	copied, err := Copy(context.Background(), src, dst, 7)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(75), copied)
+	require.NoError(t, dst.View(func(tx Tx) error {
+		for i, name := range buckets {
+			b := tx.Bucket(name)
+			require.NotNil(t, b)
+			count := 0
+			require.NoError(t, b.ForEach(func(k, v []byte) error {
+				assert.DeepEqual(t, []byte{byte(i), k[0]}, v)
+				count++
+				return nil
+			}))
+			assert.Equal(t, i*25, count)
+		}
+		return nil
+	}))
+}
+
+func TestCopy_Resume(t *testing.T) {
+	src := openTestDB(t, Bolt)
+	dst := openTestDB(t, Pebble)
+	buckets := [][]byte{[]byte("blocks"), []byte("empty"), []byte("states")}
+	require.NoError(t, src.Update(func(tx Tx) error {
+		for _, name := range buckets {
+			b, err := tx.CreateBucketIfNotExists(name)
+			require.NoError(t, err)
+			if bytes.Equal(name, []byte("empty")) {
+				continue
+			}
+			for j := 0; j < 20; j++ {
+				require.NoError(t, b.Put([]byte{byte(j)}, []byte{byte(j)}))
+			}
+		}
+		return nil
+	}))
+	// An interrupted copy recorded the tenth key of the states bucket as the last one copied.
+	require.NoError(t, dst.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists(copyProgressBucket)
+		require.NoError(t, err)
+		require.NoError(t, b.Put(copyBucketKey, []byte("states")))
+		return b.Put(copyLastKey, []byte{9})
+	}))
+	inProgress, err := CopyInProgress(dst)
+	require.NoError(t, err)
+	assert.Equal(t, true, inProgress)
+
+	copied, err := Copy(context.Background(), src, dst, 7)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), copied)
+	require.NoError(t, dst.View(func(tx Tx) error {
+		assert.Equal(t, true, tx.Bucket([]byte("blocks")) == nil, "Completed bucket was copied again")
+		assert.Equal(t, true, tx.Bucket([]byte("states")).Get([]byte{9}) == nil, "Copied key was copied again")
+		assert.DeepEqual(t, []byte{10}, tx.Bucket([]byte("states")).Get([]byte{10}))
+		assert.DeepEqual(t, []byte{19}, tx.Bucket([]byte("states")).Get([]byte{19}))
+		return nil
+	}))
+	inProgress, err = CopyInProgress(dst)
+	require.NoError(t, err)
+	assert.Equal(t, false, inProgress)
+}
+
+func TestDetect(t *testing.T) {
+	dir := t.TempDir()
+	found, err := Detect(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found))
+
+	db, err := Open(Pebble, dir)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	found, err = Detect(dir)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []Type{Pebble}, found)
+
+	require.NoError(t, Remove(Pebble, dir))
+	found, err = Detect(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found))
+
+	_, err = ParseType("leveldb")
+	require.ErrorIs(t, err, ErrUnknownType)
+}
@@ -0,0 +1,158 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	boltAllocSize = 8 * 1024 * 1024
+	// Specifies the initial mmap size of bolt.
+	boltMmapSize = 536870912
+)
+
+type boltDB struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens, or creates, the BoltDB file at path.
+func OpenBolt(path string) (DB, error) {
+	db, err := bolt.Open(
+		path,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout:         1 * time.Second,
+			InitialMmapSize: boltMmapSize,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return NewBolt(db), nil
+}
+
+// NewBolt wraps an open BoltDB database.
+func NewBolt(db *bolt.DB) DB {
+	return &boltDB{db: db}
+}
+
+func (b *boltDB) View(fn func(Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx})
+	})
+}
+
+func (b *boltDB) Update(fn func(Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx})
+	})
+}
+
+func (b *boltDB) Batch(fn func(Tx) error) error {
+	return b.db.Batch(func(tx *bolt.Tx) error {
+		return fn(boltTx{tx})
+	})
+}
+
+func (b *boltDB) Sync() error {
+	return b.db.Sync()
+}
+
+func (b *boltDB) SetWriteOptions(opts WriteOptions) {
+	b.db.NoSync = opts.NoSync
+	if opts.MaxBatchSize > 0 {
+		b.db.MaxBatchSize = opts.MaxBatchSize
+	}
+	if opts.MaxBatchDelay > 0 {
+		b.db.MaxBatchDelay = opts.MaxBatchDelay
+	}
+}
+
+func (b *boltDB) Close() error {
+	return b.db.Close()
+}
+
+func (b *boltDB) Path() string {
+	return b.db.Path()
+}
+
+func (*boltDB) Type() Type {
+	return Bolt
+}
+
+func (b *boltDB) Collector(skipBuckets ...[]byte) prometheus.Collector {
+	return prombolt.New("boltDB", b.db, skipBuckets...)
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t boltTx) Bucket(name []byte) Bucket {
+	b := t.tx.Bucket(name)
+	if b == nil {
+		return nil
+	}
+	return boltBucket{b}
+}
+
+func (t boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return boltBucket{b}, nil
+}
+
+func (t boltTx) DeleteBucket(name []byte) error {
+	if err := t.tx.DeleteBucket(name); err != nil {
+		if errors.Is(err, bolt.ErrBucketNotFound) {
+			return ErrBucketNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (t boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+		return fn(name, boltBucket{b})
+	})
+}
+
+type boltBucket struct {
+	*bolt.Bucket
+}
+
+func (b boltBucket) Put(key, value []byte) error {
+	if err := b.Bucket.Put(key, value); err != nil {
+		if errors.Is(err, bolt.ErrTxNotWritable) {
+			return ErrTxNotWritable
+		}
+		return err
+	}
+	return nil
+}
+
+func (b boltBucket) Delete(key []byte) error {
+	if err := b.Bucket.Delete(key); err != nil {
+		if errors.Is(err, bolt.ErrTxNotWritable) {
+			return ErrTxNotWritable
+		}
+		return err
+	}
+	return nil
+}
+
+func (b boltBucket) Cursor() Cursor {
+	return b.Bucket.Cursor()
+}
@@ -0,0 +1,132 @@
+package backend
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// copyProgressBucket holds the progress of an interrupted copy in the target database.
+	copyProgressBucket = []byte("copy-progress")
+	copyBucketKey      = []byte("bucket")
+	copyLastKey        = []byte("last")
+)
+
+type keyValue struct {
+	key, value []byte
+}
+
+// CopyInProgress returns whether db is the target of a copy which was interrupted before completion.
+func CopyInProgress(db DB) (bool, error) {
+	inProgress := false
+	err := db.View(func(tx Tx) error {
+		inProgress = tx.Bucket(copyProgressBucket) != nil
+		return nil
+	})
+	return inProgress, err
+}
+
+// Copy copies every bucket of src, with all of its keys, into dst and returns the number of keys copied.
+// Keys are read and written in chunks of batchSize keys per transaction, which bounds memory usage and
+// avoids long-running transactions on large databases. The bucket and the last key copied are recorded
+// in dst with each chunk, so that a copy into a dst left by an interrupted copy resumes after them.
+func Copy(ctx context.Context, src, dst DB, batchSize int) (uint64, error) {
+	if batchSize <= 0 {
+		return 0, errors.New("batch size must be positive")
+	}
+	var names [][]byte
+	if err := src.View(func(tx Tx) error {
+		return tx.ForEach(func(name []byte, _ Bucket) error {
+			if !bytes.Equal(name, copyProgressBucket) {
+				names = append(names, bytes.Clone(name))
+			}
+			return nil
+		})
+	}); err != nil {
+		return 0, errors.Wrap(err, "could not list buckets")
+	}
+
+	var resumeBucket, resumeKey []byte
+	if err := dst.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists(copyProgressBucket)
+		if err != nil {
+			return err
+		}
+		resumeBucket, resumeKey = bytes.Clone(b.Get(copyBucketKey)), bytes.Clone(b.Get(copyLastKey))
+		return nil
+	}); err != nil {
+		return 0, errors.Wrap(err, "could not read copy progress")
+	}
+	if resumeBucket != nil {
+		log.WithField("bucket", string(resumeBucket)).Info("Resuming interrupted copy")
+	}
+
+	var copied uint64
+	for _, name := range names {
+		// Buckets are copied in lexicographic order, the ones before the recorded bucket are complete.
+		if resumeBucket != nil && bytes.Compare(name, resumeBucket) < 0 {
+			continue
+		}
+		if err := dst.Update(func(tx Tx) error {
+			_, err := tx.CreateBucketIfNotExists(name)
+			return err
+		}); err != nil {
+			return copied, errors.Wrapf(err, "could not create bucket %s", name)
+		}
+		var last []byte
+		if bytes.Equal(name, resumeBucket) {
+			last = resumeKey
+		}
+		for {
+			if ctx.Err() != nil {
+				return copied, ctx.Err()
+			}
+			chunk := make([]keyValue, 0, batchSize)
+			if err := src.View(func(tx Tx) error {
+				c := tx.Bucket(name).Cursor()
+				k, v := c.First()
+				if last != nil {
+					k, v = c.Seek(last)
+					if bytes.Equal(k, last) {
+						k, v = c.Next()
+					}
+				}
+				for ; k != nil && len(chunk) < batchSize; k, v = c.Next() {
+					chunk = append(chunk, keyValue{key: bytes.Clone(k), value: bytes.Clone(v)})
+				}
+				return nil
+			}); err != nil {
+				return copied, errors.Wrapf(err, "could not read bucket %s", name)
+			}
+			if len(chunk) == 0 {
+				break
+			}
+			if err := dst.Update(func(tx Tx) error {
+				b := tx.Bucket(name)
+				for _, kv := range chunk {
+					if err := b.Put(kv.key, kv.value); err != nil {
+						return err
+					}
+				}
+				progress := tx.Bucket(copyProgressBucket)
+				if err := progress.Put(copyBucketKey, name); err != nil {
+					return err
+				}
+				return progress.Put(copyLastKey, chunk[len(chunk)-1].key)
+			}); err != nil {
+				return copied, errors.Wrapf(err, "could not write bucket %s", name)
+			}
+			copied += uint64(len(chunk))
+			last = chunk[len(chunk)-1].key
+		}
+		log.WithField("bucket", string(name)).Debug("Copied bucket")
+	}
+	if err := dst.Update(func(tx Tx) error {
+		return tx.DeleteBucket(copyProgressBucket)
+	}); err != nil {
+		return copied, errors.Wrap(err, "could not remove copy progress")
+	}
+	return copied, nil
+}
@@ -0,0 +1,408 @@
+package backend
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Pebble has no notion of buckets, so buckets are emulated with key prefixes. The existence of a
+// bucket is recorded by a marker key, and the keys of a bucket are prefixed with the length of the
+// bucket name followed by the name itself, which keeps the prefixes of different buckets disjoint.
+const (
+	pebbleBucketMarkerPrefix byte = 0x00
+	pebbleBucketDataPrefix   byte = 0x01
+
+	pebbleMaxBucketNameLength = 255
+	pebbleCacheSize           = 256 * 1024 * 1024
+)
+
+var pebbleBucketMarkerEnd = []byte{pebbleBucketMarkerPrefix + 1}
+
+type pebbleDB struct {
+	db   *pebble.DB
+	path string
+	// writeLock serializes read-write transactions, to provide the same isolation as BoltDB.
+	writeLock sync.Mutex
+	noSync    bool
+	stalls    atomic.Uint64
+	stallTime atomic.Int64
+}
+
+// OpenPebble opens, or creates, the Pebble database in the directory at path.
+func OpenPebble(path string) (DB, error) {
+	p := &pebbleDB{path: path}
+	var stallStart atomic.Int64
+	cache := pebble.NewCache(pebbleCacheSize)
+	defer cache.Unref()
+	db, err := pebble.Open(path, &pebble.Options{
+		Cache: cache,
+		EventListener: &pebble.EventListener{
+			WriteStallBegin: func(pebble.WriteStallBeginInfo) {
+				p.stalls.Add(1)
+				stallStart.Store(time.Now().UnixNano())
+			},
+			WriteStallEnd: func() {
+				p.stallTime.Add(time.Now().UnixNano() - stallStart.Load())
+			},
+		},
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open pebble database")
+	}
+	p.db = db
+	return p, nil
+}
+
+func (p *pebbleDB) View(fn func(Tx) error) error {
+	snap := p.db.NewSnapshot()
+	tx := &pebbleTx{reader: snap}
+	err := tx.run(fn)
+	if cerr := snap.Close(); cerr != nil && err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func (p *pebbleDB) Update(fn func(Tx) error) error {
+	p.writeLock.Lock()
+	defer p.writeLock.Unlock()
+	batch := p.db.NewIndexedBatch()
+	defer func() {
+		if err := batch.Close(); err != nil {
+			log.WithError(err).Error("Could not close pebble batch")
+		}
+	}()
+	tx := &pebbleTx{reader: batch, batch: batch}
+	if err := tx.run(fn); err != nil {
+		return err
+	}
+	if p.noSync {
+		return batch.Commit(pebble.NoSync)
+	}
+	return batch.Commit(pebble.Sync)
+}
+
+// Batch runs fn in its own transaction, as Pebble already groups concurrent commits to its log.
+func (p *pebbleDB) Batch(fn func(Tx) error) error {
+	return p.Update(fn)
+}
+
+// Sync syncs the write-ahead log, which makes all previous commits durable.
+func (p *pebbleDB) Sync() error {
+	return p.db.LogData(nil, pebble.Sync)
+}
+
+func (p *pebbleDB) SetWriteOptions(opts WriteOptions) {
+	p.noSync = opts.NoSync
+}
+
+func (p *pebbleDB) Close() error {
+	return p.db.Close()
+}
+
+func (p *pebbleDB) Path() string {
+	return p.path
+}
+
+func (*pebbleDB) Type() Type {
+	return Pebble
+}
+
+func (p *pebbleDB) Collector(...[]byte) prometheus.Collector {
+	return &pebbleCollector{db: p}
+}
+
+type pebbleTx struct {
+	reader pebble.Reader
+	batch  *pebble.Batch
+	// buckets caches the buckets known to exist in the transaction.
+	buckets map[string]*pebbleBucket
+	iters   []*pebble.Iterator
+	// err records failures of methods which cannot return an error, such as Get, and fails the transaction.
+	err error
+}
+
+func (t *pebbleTx) run(fn func(Tx) error) error {
+	err := fn(t)
+	for _, it := range t.iters {
+		if cerr := it.Close(); cerr != nil && t.err == nil {
+			t.err = cerr
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return t.err
+}
+
+func (t *pebbleTx) fail(err error) {
+	if t.err == nil {
+		t.err = err
+	}
+}
+
+func (t *pebbleTx) get(key []byte) []byte {
+	v, closer, err := t.reader.Get(key)
+	if err != nil {
+		if !errors.Is(err, pebble.ErrNotFound) {
+			t.fail(err)
+		}
+		return nil
+	}
+	// Values are copied into a non-nil slice, so that empty values can be told apart from missing keys.
+	v = append([]byte{}, v...)
+	if err := closer.Close(); err != nil {
+		t.fail(err)
+	}
+	return v
+}
+
+func (t *pebbleTx) newIter(lower, upper []byte) *pebble.Iterator {
+	it, err := t.reader.NewIter(&pebble.IterOptions{LowerBound: lower, UpperBound: upper})
+	if err != nil {
+		t.fail(err)
+		return nil
+	}
+	t.iters = append(t.iters, it)
+	return it
+}
+
+func (t *pebbleTx) bucket(name []byte) *pebbleBucket {
+	if b, ok := t.buckets[string(name)]; ok {
+		return b
+	}
+	prefix := make([]byte, 0, len(name)+2)
+	prefix = append(prefix, pebbleBucketDataPrefix, byte(len(name)))
+	prefix = append(prefix, name...)
+	b := &pebbleBucket{tx: t, prefix: prefix, end: prefixEnd(prefix)}
+	if t.buckets == nil {
+		t.buckets = make(map[string]*pebbleBucket)
+	}
+	t.buckets[string(name)] = b
+	return b
+}
+
+func (t *pebbleTx) exists(name []byte) bool {
+	if _, ok := t.buckets[string(name)]; ok {
+		return true
+	}
+	if len(name) == 0 || len(name) > pebbleMaxBucketNameLength {
+		return false
+	}
+	return t.get(bucketMarker(name)) != nil
+}
+
+func (t *pebbleTx) Bucket(name []byte) Bucket {
+	if !t.exists(name) {
+		return nil
+	}
+	return t.bucket(name)
+}
+
+func (t *pebbleTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if t.batch == nil {
+		return nil, ErrTxNotWritable
+	}
+	if len(name) == 0 || len(name) > pebbleMaxBucketNameLength {
+		return nil, errors.Errorf("invalid bucket name length %d", len(name))
+	}
+	if !t.exists(name) {
+		if err := t.batch.Set(bucketMarker(name), []byte{}, nil); err != nil {
+			return nil, err
+		}
+	}
+	return t.bucket(name), nil
+}
+
+func (t *pebbleTx) DeleteBucket(name []byte) error {
+	if t.batch == nil {
+		return ErrTxNotWritable
+	}
+	if !t.exists(name) {
+		return ErrBucketNotFound
+	}
+	b := t.bucket(name)
+	delete(t.buckets, string(name))
+	if err := t.batch.DeleteRange(b.prefix, b.end, nil); err != nil {
+		return err
+	}
+	return t.batch.Delete(bucketMarker(name), nil)
+}
+
+func (t *pebbleTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	it := t.newIter([]byte{pebbleBucketMarkerPrefix}, pebbleBucketMarkerEnd)
+	if it == nil {
+		return t.err
+	}
+	for ok := it.First(); ok; ok = it.Next() {
+		name := bytes.Clone(it.Key()[1:])
+		if err := fn(name, t.bucket(name)); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+type pebbleBucket struct {
+	tx     *pebbleTx
+	prefix []byte
+	end    []byte
+}
+
+func (b *pebbleBucket) key(k []byte) []byte {
+	return append(append(make([]byte, 0, len(b.prefix)+len(k)), b.prefix...), k...)
+}
+
+func (b *pebbleBucket) Get(key []byte) []byte {
+	return b.tx.get(b.key(key))
+}
+
+func (b *pebbleBucket) Put(key, value []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	if len(key) == 0 {
+		return errors.New("key required")
+	}
+	return b.tx.batch.Set(b.key(key), value, nil)
+}
+
+func (b *pebbleBucket) Delete(key []byte) error {
+	if b.tx.batch == nil {
+		return ErrTxNotWritable
+	}
+	return b.tx.batch.Delete(b.key(key), nil)
+}
+
+func (b *pebbleBucket) Cursor() Cursor {
+	return &pebbleCursor{bucket: b}
+}
+
+func (b *pebbleBucket) ForEach(fn func(k, v []byte) error) error {
+	c := &pebbleCursor{bucket: b}
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pebbleCursor copies the keys and values it returns, as callers may hold on to them for the life
+// of the transaction, while the memory of a pebble iterator is reused as soon as it moves.
+type pebbleCursor struct {
+	bucket *pebbleBucket
+	it     *pebble.Iterator
+}
+
+func (c *pebbleCursor) iter() *pebble.Iterator {
+	if c.it == nil {
+		c.it = c.bucket.tx.newIter(c.bucket.prefix, c.bucket.end)
+	}
+	return c.it
+}
+
+func (c *pebbleCursor) entry(ok bool) ([]byte, []byte) {
+	if !ok {
+		if err := c.it.Error(); err != nil {
+			c.bucket.tx.fail(err)
+		}
+		return nil, nil
+	}
+	return append([]byte{}, c.it.Key()[len(c.bucket.prefix):]...), append([]byte{}, c.it.Value()...)
+}
+
+func (c *pebbleCursor) First() ([]byte, []byte) {
+	if c.iter() == nil {
+		return nil, nil
+	}
+	return c.entry(c.it.First())
+}
+
+func (c *pebbleCursor) Last() ([]byte, []byte) {
+	if c.iter() == nil {
+		return nil, nil
+	}
+	return c.entry(c.it.Last())
+}
+
+func (c *pebbleCursor) Next() ([]byte, []byte) {
+	if c.iter() == nil {
+		return nil, nil
+	}
+	return c.entry(c.it.Next())
+}
+
+func (c *pebbleCursor) Prev() ([]byte, []byte) {
+	if c.iter() == nil {
+		return nil, nil
+	}
+	return c.entry(c.it.Prev())
+}
+
+func (c *pebbleCursor) Seek(seek []byte) ([]byte, []byte) {
+	if c.iter() == nil {
+		return nil, nil
+	}
+	return c.entry(c.it.SeekGE(c.bucket.key(seek)))
+}
+
+func bucketMarker(name []byte) []byte {
+	return append([]byte{pebbleBucketMarkerPrefix}, name...)
+}
+
+// prefixEnd returns the smallest key which is greater than every key starting with prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+var (
+	pebbleDiskUsageDesc = prometheus.NewDesc(
+		"pebble_disk_usage_bytes", "Total disk space used by the pebble database.", nil, nil)
+	pebbleReadAmpDesc = prometheus.NewDesc(
+		"pebble_read_amplification", "Number of sublevels which a read may have to inspect.", nil, nil)
+	pebbleCompactionsDesc = prometheus.NewDesc(
+		"pebble_compactions_total", "Number of compactions performed by the pebble database.", nil, nil)
+	pebbleCompactionDebtDesc = prometheus.NewDesc(
+		"pebble_compaction_debt_bytes", "Estimated number of bytes which need to be compacted.", nil, nil)
+	pebbleWriteStallsDesc = prometheus.NewDesc(
+		"pebble_write_stalls_total", "Number of times writes were stalled waiting for compactions.", nil, nil)
+	pebbleWriteStallSecondsDesc = prometheus.NewDesc(
+		"pebble_write_stall_seconds_total", "Time writes spent stalled waiting for compactions.", nil, nil)
+)
+
+type pebbleCollector struct {
+	db *pebbleDB
+}
+
+func (*pebbleCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pebbleDiskUsageDesc
+	ch <- pebbleReadAmpDesc
+	ch <- pebbleCompactionsDesc
+	ch <- pebbleCompactionDebtDesc
+	ch <- pebbleWriteStallsDesc
+	ch <- pebbleWriteStallSecondsDesc
+}
+
+func (c *pebbleCollector) Collect(ch chan<- prometheus.Metric) {
+	m := c.db.db.Metrics()
+	ch <- prometheus.MustNewConstMetric(pebbleDiskUsageDesc, prometheus.GaugeValue, float64(m.DiskSpaceUsage()))
+	ch <- prometheus.MustNewConstMetric(pebbleReadAmpDesc, prometheus.GaugeValue, float64(m.ReadAmp()))
+	ch <- prometheus.MustNewConstMetric(pebbleCompactionsDesc, prometheus.CounterValue, float64(m.Compact.Count))
+	ch <- prometheus.MustNewConstMetric(pebbleCompactionDebtDesc, prometheus.GaugeValue, float64(m.Compact.EstimatedDebt))
+	ch <- prometheus.MustNewConstMetric(pebbleWriteStallsDesc, prometheus.CounterValue, float64(c.db.stalls.Load()))
+	ch <- prometheus.MustNewConstMetric(pebbleWriteStallSecondsDesc, prometheus.CounterValue, time.Duration(c.db.stallTime.Load()).Seconds())
+}
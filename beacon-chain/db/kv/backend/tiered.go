@@ -0,0 +1,337 @@
+package backend
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Tiered combines a hot database, which receives all writes, with a cold database holding data which
+// no longer changes, such as the finalized chain. The cold database can live on cheaper, slower storage.
+//
+// Reads of a cold bucket see the union of both databases, with the hot database taking precedence.
+// Data is moved to the cold database with Freeze. Deletes of keys held by the cold database are applied
+// to it after the hot transaction commits, so they are not atomic with the rest of the transaction.
+type Tiered struct {
+	hot     DB
+	cold    DB
+	buckets map[string]bool
+}
+
+var _ DB = (*Tiered)(nil)
+
+// NewTiered combines the hot and cold databases, storing the given buckets in the cold database.
+func NewTiered(hot, cold DB, coldBuckets ...[]byte) (*Tiered, error) {
+	t := &Tiered{hot: hot, cold: cold, buckets: make(map[string]bool, len(coldBuckets))}
+	for _, b := range coldBuckets {
+		t.buckets[string(b)] = true
+	}
+	if err := cold.Update(func(tx Tx) error {
+		for _, b := range coldBuckets {
+			if _, err := tx.CreateBucketIfNotExists(b); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not create buckets of cold database")
+	}
+	return t, nil
+}
+
+// Cold returns the cold database.
+func (t *Tiered) Cold() DB {
+	return t.cold
+}
+
+// View runs fn in a read-only transaction spanning both databases.
+func (t *Tiered) View(fn func(Tx) error) error {
+	return t.hot.View(func(hot Tx) error {
+		return t.cold.View(func(cold Tx) error {
+			return fn(&tieredTx{t: t, hot: hot, cold: cold})
+		})
+	})
+}
+
+// Update runs fn in a read-write transaction of the hot database. Deletes of cold keys are applied to
+// the cold database once the hot transaction has committed.
+func (t *Tiered) Update(fn func(Tx) error) error {
+	return t.write(t.hot.Update, fn)
+}
+
+// Batch runs fn in a read-write transaction of the hot database, which may be shared with concurrent
+// calls to Batch.
+func (t *Tiered) Batch(fn func(Tx) error) error {
+	return t.write(t.hot.Batch, fn)
+}
+
+func (t *Tiered) write(commit func(func(Tx) error) error, fn func(Tx) error) error {
+	var pending []func(Tx) error
+	if err := commit(func(hot Tx) error {
+		// The function may be run again if a shared transaction fails.
+		pending = pending[:0]
+		return t.cold.View(func(cold Tx) error {
+			return fn(&tieredTx{t: t, hot: hot, cold: cold, pending: &pending})
+		})
+	}); err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	return t.cold.Update(func(tx Tx) error {
+		for _, op := range pending {
+			if err := op(tx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Sync syncs both databases.
+func (t *Tiered) Sync() error {
+	if err := t.hot.Sync(); err != nil {
+		return err
+	}
+	return t.cold.Sync()
+}
+
+// SetWriteOptions sets the write options of both databases.
+func (t *Tiered) SetWriteOptions(opts WriteOptions) {
+	t.hot.SetWriteOptions(opts)
+	t.cold.SetWriteOptions(opts)
+}
+
+// Freeze moves the given keys of a cold bucket from the hot to the cold database, and returns the
+// number of keys moved. Keys which are not in the hot database are skipped.
+func (t *Tiered) Freeze(bucket []byte, keys [][]byte) (int, error) {
+	if !t.buckets[string(bucket)] {
+		return 0, errors.Errorf("bucket %s is not stored in the cold database", bucket)
+	}
+	moved := 0
+	// The copy is committed while holding the write lock of the hot database, so that keys cannot
+	// be modified between the copy and their removal from the hot database.
+	err := t.hot.Update(func(hot Tx) error {
+		hb := hot.Bucket(bucket)
+		if hb == nil {
+			return ErrBucketNotFound
+		}
+		if err := t.cold.Update(func(cold Tx) error {
+			cb := cold.Bucket(bucket)
+			for _, k := range keys {
+				v := hb.Get(k)
+				if v == nil {
+					continue
+				}
+				if err := cb.Put(k, v); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if hb.Get(k) == nil {
+				continue
+			}
+			if err := hb.Delete(k); err != nil {
+				return err
+			}
+			moved++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return moved, nil
+}
+
+// Close closes both databases.
+func (t *Tiered) Close() error {
+	hotErr := t.hot.Close()
+	if err := t.cold.Close(); err != nil {
+		return errors.Wrap(err, "could not close cold database")
+	}
+	return hotErr
+}
+
+// Path returns the location of the hot database.
+func (t *Tiered) Path() string {
+	return t.hot.Path()
+}
+
+// Type returns the backend type of the hot database.
+func (t *Tiered) Type() Type {
+	return t.hot.Type()
+}
+
+// Collector returns the collector of the hot database.
+func (t *Tiered) Collector(skipBuckets ...[]byte) prometheus.Collector {
+	return t.hot.Collector(skipBuckets...)
+}
+
+type tieredTx struct {
+	t       *Tiered
+	hot     Tx
+	cold    Tx
+	pending *[]func(Tx) error
+}
+
+func (tx *tieredTx) wrap(name []byte, hot Bucket) Bucket {
+	if hot == nil || !tx.t.buckets[string(name)] {
+		return hot
+	}
+	return &tieredBucket{tx: tx, name: name, hot: hot, cold: tx.cold.Bucket(name)}
+}
+
+func (tx *tieredTx) Bucket(name []byte) Bucket {
+	return tx.wrap(name, tx.hot.Bucket(name))
+}
+
+func (tx *tieredTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	b, err := tx.hot.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return tx.wrap(name, b), nil
+}
+
+func (tx *tieredTx) DeleteBucket(name []byte) error {
+	if err := tx.hot.DeleteBucket(name); err != nil {
+		return err
+	}
+	if tx.t.buckets[string(name)] {
+		name = bytes.Clone(name)
+		*tx.pending = append(*tx.pending, func(cold Tx) error {
+			if err := cold.DeleteBucket(name); err != nil {
+				return err
+			}
+			_, err := cold.CreateBucketIfNotExists(name)
+			return err
+		})
+	}
+	return nil
+}
+
+func (tx *tieredTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return tx.hot.ForEach(func(name []byte, b Bucket) error {
+		return fn(name, tx.wrap(name, b))
+	})
+}
+
+type tieredBucket struct {
+	tx   *tieredTx
+	name []byte
+	hot  Bucket
+	cold Bucket
+}
+
+func (b *tieredBucket) Get(key []byte) []byte {
+	if v := b.hot.Get(key); v != nil {
+		return v
+	}
+	return b.cold.Get(key)
+}
+
+func (b *tieredBucket) Put(key, value []byte) error {
+	return b.hot.Put(key, value)
+}
+
+func (b *tieredBucket) Delete(key []byte) error {
+	if err := b.hot.Delete(key); err != nil {
+		return err
+	}
+	if b.cold.Get(key) != nil {
+		name, key := b.name, bytes.Clone(key)
+		*b.tx.pending = append(*b.tx.pending, func(cold Tx) error {
+			return cold.Bucket(name).Delete(key)
+		})
+	}
+	return nil
+}
+
+func (b *tieredBucket) Cursor() Cursor {
+	return &tieredCursor{hot: b.hot.Cursor(), cold: b.cold.Cursor()}
+}
+
+func (b *tieredBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tieredCursor merges the cursors of both databases. Every move repositions both cursors relative to
+// the last returned key, which keeps the implementation simple at the cost of extra seeks.
+type tieredCursor struct {
+	hot, cold Cursor
+	last      []byte
+}
+
+func (c *tieredCursor) First() ([]byte, []byte) {
+	hk, hv := c.hot.First()
+	ck, cv := c.cold.First()
+	return c.pick(hk, hv, ck, cv, false)
+}
+
+func (c *tieredCursor) Last() ([]byte, []byte) {
+	hk, hv := c.hot.Last()
+	ck, cv := c.cold.Last()
+	return c.pick(hk, hv, ck, cv, true)
+}
+
+func (c *tieredCursor) Seek(seek []byte) ([]byte, []byte) {
+	hk, hv := c.hot.Seek(seek)
+	ck, cv := c.cold.Seek(seek)
+	return c.pick(hk, hv, ck, cv, false)
+}
+
+func (c *tieredCursor) Next() ([]byte, []byte) {
+	if c.last == nil {
+		return nil, nil
+	}
+	hk, hv := seekAfter(c.hot, c.last)
+	ck, cv := seekAfter(c.cold, c.last)
+	return c.pick(hk, hv, ck, cv, false)
+}
+
+func (c *tieredCursor) Prev() ([]byte, []byte) {
+	if c.last == nil {
+		return nil, nil
+	}
+	hk, hv := seekBefore(c.hot, c.last)
+	ck, cv := seekBefore(c.cold, c.last)
+	return c.pick(hk, hv, ck, cv, true)
+}
+
+// pick returns the smaller key, or the larger one when moving backwards, preferring the hot value.
+func (c *tieredCursor) pick(hk, hv, ck, cv []byte, backwards bool) ([]byte, []byte) {
+	k, v := hk, hv
+	if hk == nil || (ck != nil && (bytes.Compare(ck, hk) < 0) != backwards && !bytes.Equal(ck, hk)) {
+		k, v = ck, cv
+	}
+	c.last = bytes.Clone(k)
+	return k, v
+}
+
+func seekAfter(c Cursor, key []byte) ([]byte, []byte) {
+	k, v := c.Seek(key)
+	if bytes.Equal(k, key) {
+		return c.Next()
+	}
+	return k, v
+}
+
+func seekBefore(c Cursor, key []byte) ([]byte, []byte) {
+	if k, _ := c.Seek(key); k == nil {
+		return c.Last()
+	}
+	return c.Prev()
+}
@@ -0,0 +1,80 @@
+package backend
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestTiered(t *testing.T) {
+	hot, err := Open(Bolt, t.TempDir())
+	require.NoError(t, err)
+	cold, err := Open(Pebble, t.TempDir())
+	require.NoError(t, err)
+	coldBucket, hotBucket := []byte("blocks"), []byte("meta")
+	db, err := NewTiered(hot, cold, coldBucket)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	require.NoError(t, db.Update(func(tx Tx) error {
+		b, err := tx.CreateBucketIfNotExists(coldBucket)
+		require.NoError(t, err)
+		for i := byte(1); i <= 6; i++ {
+			require.NoError(t, b.Put([]byte{i}, []byte{i}))
+		}
+		_, err = tx.CreateBucketIfNotExists(hotBucket)
+		return err
+	}))
+	moved, err := db.Freeze(coldBucket, [][]byte{{2}, {4}, {6}, {7}})
+	require.NoError(t, err)
+	assert.Equal(t, 3, moved)
+	_, err = db.Freeze(hotBucket, [][]byte{{1}})
+	require.ErrorContains(t, "not stored in the cold database", err)
+
+	keys := func(tx Tx) (forward, backward []byte) {
+		c := tx.Bucket(coldBucket).Cursor()
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			forward = append(forward, k[0])
+		}
+		for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+			backward = append(backward, k[0])
+		}
+		return forward, backward
+	}
+	require.NoError(t, db.View(func(tx Tx) error {
+		require.NoError(t, hot.View(func(h Tx) error {
+			assert.Equal(t, true, h.Bucket(coldBucket).Get([]byte{2}) == nil)
+			return nil
+		}))
+		b := tx.Bucket(coldBucket)
+		assert.DeepEqual(t, []byte{2}, b.Get([]byte{2}))
+		assert.DeepEqual(t, []byte{3}, b.Get([]byte{3}))
+		forward, backward := keys(tx)
+		assert.DeepEqual(t, []byte{1, 2, 3, 4, 5, 6}, forward)
+		assert.DeepEqual(t, []byte{6, 5, 4, 3, 2, 1}, backward)
+		k, _ := b.Cursor().Seek([]byte{4})
+		assert.DeepEqual(t, []byte{4}, k)
+		return nil
+	}))
+
+	// Hot values shadow cold ones, and deletes remove keys from both databases.
+	require.NoError(t, db.Update(func(tx Tx) error {
+		b := tx.Bucket(coldBucket)
+		require.NoError(t, b.Put([]byte{2}, []byte{20}))
+		require.NoError(t, b.Delete([]byte{4}))
+		return b.Delete([]byte{5})
+	}))
+	require.NoError(t, db.View(func(tx Tx) error {
+		assert.DeepEqual(t, []byte{20}, tx.Bucket(coldBucket).Get([]byte{2}))
+		forward, _ := keys(tx)
+		assert.DeepEqual(t, []byte{1, 2, 3, 6}, forward)
+		return nil
+	}))
+	require.NoError(t, cold.View(func(tx Tx) error {
+		assert.Equal(t, true, tx.Bucket(coldBucket).Get([]byte{4}) == nil)
+		return nil
+	}))
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
-	bolt "go.etcd.io/bbolt"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -20,7 +20,7 @@ func (s *Store) SaveBackfillStatus(ctx context.Context, bf *dbval.BackfillStatus
 	if err != nil {
 		return err
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		return bucket.Put(backfillStatusKey, bfb)
 	})
@@ -32,7 +32,7 @@ func (s *Store) BackfillStatus(ctx context.Context) (*dbval.BackfillStatus, erro
 	_, span := trace.StartSpan(ctx, "BeaconDB.BackfillStatus")
 	defer span.End()
 	bf := &dbval.BackfillStatus{}
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bucket := tx.Bucket(blocksBucket)
 		bs := bucket.Get(backfillStatusKey)
 		if len(bs) == 0 {
@@ -5,12 +5,12 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 )
 
 var errMissingStateForCheckpoint = errors.New("missing state summary for checkpoint root")
@@ -20,7 +20,7 @@ func (s *Store) JustifiedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, err
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.JustifiedCheckpoint")
 	defer span.End()
 	var checkpoint *ethpb.Checkpoint
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(checkpointBucket)
 		enc := bkt.Get(justifiedCheckpointKey)
 		if enc == nil {
@@ -38,7 +38,7 @@ func (s *Store) FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, err
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.FinalizedCheckpoint")
 	defer span.End()
 	var checkpoint *ethpb.Checkpoint
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(checkpointBucket)
 		enc := bkt.Get(finalizedCheckpointKey)
 		if enc == nil {
@@ -70,7 +70,7 @@ func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		return err
 	}
 	hasStateSummary := s.HasStateSummary(ctx, bytesutil.ToBytes32(checkpoint.Root))
-	err = s.db.Update(func(tx *bolt.Tx) error {
+	err = s.update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(checkpointBucket)
 		hasStateInDB := tx.Bucket(stateBucket).Get(checkpoint.Root) != nil
 		if !(hasStateInDB || hasStateSummary) {
@@ -86,6 +86,9 @@ func (s *Store) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *ethpb.C
 		return s.updateFinalizedBlockRoots(ctx, tx, checkpoint)
 	})
 	tracing.AnnotateError(span, err)
+	if err == nil {
+		s.notifyFreezer()
+	}
 	return err
 }
 
@@ -99,7 +102,7 @@ func (s *Store) saveCheckpoint(ctx context.Context, key []byte, checkpoint *ethp
 		return err
 	}
 	hasStateSummary := s.HasStateSummary(ctx, bytesutil.ToBytes32(checkpoint.Root))
-	err = s.db.Update(func(tx *bolt.Tx) error {
+	err = s.update(func(tx backend.Tx) error {
 		bucket := tx.Bucket(checkpointBucket)
 		hasStateInDB := tx.Bucket(stateBucket).Get(checkpoint.Root) != nil
 		if !(hasStateInDB || hasStateSummary) {
@@ -115,7 +118,7 @@ func (s *Store) saveCheckpoint(ctx context.Context, key []byte, checkpoint *ethp
 }
 
 // Recovers and saves state summary for a given root if the root has a block in the DB.
-func recoverStateSummary(ctx context.Context, tx *bolt.Tx, root []byte) error {
+func recoverStateSummary(ctx context.Context, tx backend.Tx, root []byte) error {
 	blkBucket := tx.Bucket(blocksBucket)
 	blkEnc := blkBucket.Get(root)
 	if blkEnc == nil {
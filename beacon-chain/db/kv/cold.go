@@ -0,0 +1,148 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const freezeBatchSize = 64
+
+// coldBuckets hold the data of the finalized chain, which is moved to the cold database once finalized.
+// Indices and validator entries, which are shared between states, remain in the hot database.
+var coldBuckets = [][]byte{blocksBucket, stateBucket, stateSummaryBucket}
+
+// WithColdPath stores the finalized blocks and states in a separate database within the given
+// directory, which can be located on cheaper storage than the rest of the database.
+func WithColdPath(dirPath string) KVStoreOption {
+	return func(s *Store) {
+		s.coldPath = dirPath
+	}
+}
+
+// openCold opens the cold database and combines it with the hot database.
+func (s *Store) openCold(hot backend.DB) (backend.DB, error) {
+	if err := file.MkdirAll(s.coldPath); err != nil {
+		return nil, err
+	}
+	if err := checkBackend(s.backendType, s.coldPath); err != nil {
+		return nil, err
+	}
+	cold, err := backend.Open(s.backendType, s.coldPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open cold database")
+	}
+	tiered, err := backend.NewTiered(hot, cold, coldBuckets...)
+	if err != nil {
+		if closeErr := cold.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Could not close cold database")
+		}
+		return nil, err
+	}
+	s.tiered = tiered
+	s.freezeNotify = make(chan struct{}, 1)
+	s.freezerDone = make(chan struct{})
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.stopFreezer = cancel
+	go s.runFreezer(ctx)
+	return tiered, nil
+}
+
+// notifyFreezer schedules moving newly finalized data to the cold database.
+func (s *Store) notifyFreezer() {
+	if s.tiered == nil {
+		return
+	}
+	select {
+	case s.freezeNotify <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Store) runFreezer(ctx context.Context) {
+	defer close(s.freezerDone)
+	for {
+		select {
+		case <-s.freezeNotify:
+			if err := s.freezeFinalized(ctx); err != nil {
+				log.WithError(err).Error("Could not move finalized data to the cold database")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// freezeFinalized moves the blocks, states and state summaries of the finalized canonical chain below
+// the start of the finalized epoch to the cold database. The slot up to which data was moved is stored in
+// the hot database, so that a restarted node resumes from it instead of scanning the chain from genesis.
+func (s *Store) freezeFinalized(ctx context.Context) error {
+	f, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return err
+	}
+	end, err := slots.EpochStart(f.Epoch)
+	if err != nil {
+		return err
+	}
+	var frozen primitives.Slot
+	if err := s.db.View(func(tx backend.Tx) error {
+		frozen = bytesutil.BytesToSlotBigEndian(tx.Bucket(chainMetadataBucket).Get(frozenSlotKey))
+		return nil
+	}); err != nil {
+		return err
+	}
+	if end <= frozen {
+		return nil
+	}
+	var roots [][]byte
+	if err := s.db.View(func(tx backend.Tx) error {
+		genesis := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		endKey := bytesutil.SlotToBytesBigEndian(end)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(frozen)); k != nil && bytes.Compare(k, endKey) < 0; k, v = c.Next() {
+			indexed, err := splitRoots(v)
+			if err != nil {
+				return errors.Wrapf(err, "corrupt value in block slot index for key %#x", k)
+			}
+			for _, root := range indexed {
+				if finalized.Get(root[:]) != nil || bytes.Equal(root[:], genesis) {
+					roots = append(roots, bytes.Clone(root[:]))
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	moved := 0
+	for start := 0; start < len(roots); start += freezeBatchSize {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		batch := roots[start:min(start+freezeBatchSize, len(roots))]
+		for _, bucket := range coldBuckets {
+			n, err := s.tiered.Freeze(bucket, batch)
+			if err != nil {
+				return errors.Wrapf(err, "could not move %s to the cold database", bucket)
+			}
+			moved += n
+		}
+	}
+	if err := s.db.Update(func(tx backend.Tx) error {
+		return tx.Bucket(chainMetadataBucket).Put(frozenSlotKey, bytesutil.SlotToBytesBigEndian(end))
+	}); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{"slot": end, "keys": moved}).Debug("Moved finalized data to the cold database")
+	return nil
+}
@@ -0,0 +1,98 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_FreezeFinalized(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	ctx := context.Background()
+	hotPath, coldPath := t.TempDir(), t.TempDir()
+	db, err := NewKVStore(ctx, hotPath, WithColdPath(coldPath))
+	require.NoError(t, err)
+	assert.Equal(t, coldPath, db.ColdPath())
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		roots[i], err = b.Block().HashTreeRoot()
+		require.NoError(t, err)
+	}
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(8))
+	require.NoError(t, db.SaveState(ctx, st, roots[7]))
+	finalized := roots[slotsPerEpoch*2-1]
+	require.NoError(t, db.SaveState(ctx, st, finalized))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalized[:]}))
+	require.NoError(t, db.freezeFinalized(ctx))
+
+	inCold := func(db *Store, bucket []byte, root [32]byte) bool {
+		found := false
+		require.NoError(t, db.tiered.Cold().View(func(tx backend.Tx) error {
+			found = tx.Bucket(bucket).Get(root[:]) != nil
+			return nil
+		}))
+		return found
+	}
+	// Blocks and states of the finalized chain below the finalized epoch are moved to the cold database.
+	assert.Equal(t, true, inCold(db, blocksBucket, roots[0]))
+	assert.Equal(t, true, inCold(db, blocksBucket, roots[slotsPerEpoch*2-2]))
+	assert.Equal(t, true, inCold(db, stateBucket, roots[7]))
+	assert.Equal(t, false, inCold(db, blocksBucket, finalized))
+	assert.Equal(t, false, inCold(db, stateBucket, finalized))
+	assert.Equal(t, false, inCold(db, blocksBucket, roots[len(roots)-1]))
+	require.NoError(t, db.Close())
+
+	// Data remains readable through the combined view after a restart.
+	db, err = NewKVStore(ctx, hotPath, WithColdPath(coldPath))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	for i, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r), "Block at index %d is missing", i)
+	}
+	assert.Equal(t, true, inCold(db, blocksBucket, roots[0]))
+	// The slot up to which data was moved is kept across the restart.
+	require.NoError(t, db.db.View(func(tx backend.Tx) error {
+		frozen := bytesutil.BytesToSlotBigEndian(tx.Bucket(chainMetadataBucket).Get(frozenSlotKey))
+		assert.Equal(t, primitives.Slot(slotsPerEpoch*2), frozen)
+		return nil
+	}))
+	blk, err := db.Block(ctx, roots[0])
+	require.NoError(t, err)
+	assert.Equal(t, blks[0].Block().Slot(), blk.Block().Slot())
+	saved, err := db.State(ctx, roots[7])
+	require.NoError(t, err)
+	assert.Equal(t, st.Slot(), saved.Slot())
+
+	// Deleting a frozen state removes it from the cold database.
+	require.NoError(t, db.DeleteState(ctx, roots[7]))
+	assert.Equal(t, false, inCold(db, stateBucket, roots[7]))
+}
+
+func TestStore_CloseStopsFreezer(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), WithColdPath(t.TempDir()))
+	require.NoError(t, err)
+	db.notifyFreezer()
+	require.NoError(t, db.Close())
+	select {
+	case <-db.freezerDone:
+	default:
+		t.Fatal("Freezer is still running after the database closed")
+	}
+}
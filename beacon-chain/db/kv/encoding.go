@@ -7,6 +7,7 @@ import (
 
 	"github.com/golang/snappy"
 	fastssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"google.golang.org/protobuf/proto"
@@ -41,18 +42,19 @@ func encode(ctx context.Context, msg proto.Message) ([]byte, error) {
 	if msg == nil || reflect.ValueOf(msg).IsNil() {
 		return nil, errors.New("cannot encode nil message")
 	}
-	var enc []byte
-	var err error
 	if isSSZStorageFormat(msg) {
-		enc, err = msg.(fastssz.Marshaler).MarshalSSZ()
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		enc, err = proto.Marshal(msg)
+		// The snappy output is retained by the database transaction,
+		// but the intermediate SSZ encoding can be reused.
+		enc, release, err := ssz.MarshalWithPool(msg.(fastssz.Marshaler))
 		if err != nil {
 			return nil, err
 		}
+		defer release()
+		return snappy.Encode(nil, enc), nil
+	}
+	enc, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
 	}
 	return snappy.Encode(nil, enc), nil
 }
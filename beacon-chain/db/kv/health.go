@@ -0,0 +1,20 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// CheckWritable checks that the database accepts writes, by writing the time of the check to the chain metadata.
+func (s *Store) CheckWritable(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "BeaconDB.CheckWritable")
+	defer span.End()
+	return s.db.Update(func(tx backend.Tx) error {
+		bucket := tx.Bucket(chainMetadataBucket)
+		return bucket.Put(healthCheckKey, bytesutil.Uint64ToBytesBigEndian(uint64(prysmTime.Now().Unix())))
+	})
+}
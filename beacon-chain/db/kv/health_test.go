@@ -0,0 +1,17 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_CheckWritable(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, db.CheckWritable(ctx))
+	require.NoError(t, db.Close())
+	require.NotNil(t, db.CheckWritable(ctx), "closed database is writable")
+}
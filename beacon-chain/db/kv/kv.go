@@ -13,13 +13,12 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
-	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
-	bolt "go.etcd.io/bbolt"
+	"github.com/sirupsen/logrus"
 )
 
 var _ iface.Database = (*Store)(nil)
@@ -33,13 +32,11 @@ const (
 	// BeaconNodeDbDirName is the name of the directory containing the beacon node database.
 	BeaconNodeDbDirName = "beaconchaindata"
 	// DatabaseFileName is the name of the beacon node database.
-	DatabaseFileName = "beaconchain.db"
+	DatabaseFileName = backend.BoltFileName
 
 	boltAllocSize = 8 * 1024 * 1024
 	// The size of hash length in bytes
 	hashLength = 32
-	// Specifies the initial mmap size of bolt.
-	mmapSize = 536870912
 )
 
 var (
@@ -82,14 +79,26 @@ var blockedBuckets = [][]byte{
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using BoltDB, or an experimental alternative backend, as the underlying
+// persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  backend.DB
+	backendType         backend.Type
 	databasePath        string
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
 	ctx                 context.Context
+	coldPath            string
+	tiered              *backend.Tiered
+	freezeNotify        chan struct{}
+	freezerDone         chan struct{}
+	stopFreezer         context.CancelFunc
+	writeOpts           backend.WriteOptions
+	batchWrites         bool
+	fsyncInterval       time.Duration
+	stopSyncer          context.CancelFunc
+	syncerDone          chan struct{}
 }
 
 // StoreDatafilePath is the canonical construction of a full
@@ -124,7 +133,14 @@ var Buckets = [][]byte{
 // KVStoreOption is a functional option that modifies a kv.Store.
 type KVStoreOption func(*Store)
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// WithBackend selects the storage engine of the kv.Store, which defaults to BoltDB.
+func WithBackend(t backend.Type) KVStoreOption {
+	return func(s *Store) {
+		s.backendType = t
+	}
+}
+
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
 // an open connection db object as a property of the Store struct.
 func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*Store, error) {
@@ -137,23 +153,6 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 			return nil, err
 		}
 	}
-	datafile := StoreDatafilePath(dirPath)
-	log.WithField("path", datafile).Info("Opening Bolt DB")
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: mmapSize,
-		},
-	)
-	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
-		return nil, err
-	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -173,7 +172,7 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		backendType:         backend.Bolt,
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
@@ -183,12 +182,39 @@ func NewKVStore(ctx context.Context, dirPath string, opts ...KVStoreOption) (*St
 	for _, o := range opts {
 		o(kv)
 	}
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if kv.writeOpts.NoSync && kv.backendType != backend.Pebble {
+		return nil, errors.Errorf("periodic fsync is not supported by the %s backend", kv.backendType)
+	}
+	if err := checkBackend(kv.backendType, dirPath); err != nil {
+		return nil, err
+	}
+	datafile, err := backend.DataPath(kv.backendType, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{"path": datafile, "backend": kv.backendType}).Info("Opening DB")
+	kv.db, err = backend.Open(kv.backendType, dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if kv.coldPath != "" {
+		log.WithField("path", kv.coldPath).Info("Opening cold DB")
+		tiered, err := kv.openCold(kv.db)
+		if err != nil {
+			if closeErr := kv.db.Close(); closeErr != nil {
+				log.WithError(closeErr).Error("Could not close database")
+			}
+			return nil, err
+		}
+		kv.db = tiered
+	}
+	kv.startSyncer()
+	if err := kv.db.Update(func(tx backend.Tx) error {
 		return createBuckets(tx, Buckets...)
 	}); err != nil {
 		return nil, err
 	}
-	if err = prometheus.Register(createBoltCollector(kv.db)); err != nil {
+	if err = prometheus.Register(createCollector(kv.db)); err != nil {
 		return nil, err
 	}
 	// Setup the type of block storage used depending on whether or not this is a fresh database.
@@ -207,21 +233,42 @@ func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	prometheus.Unregister(createCollector(s.db))
+	if err := backend.Remove(s.backendType, s.databasePath); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
+	if s.coldPath != "" {
+		if err := backend.Remove(s.backendType, s.coldPath); err != nil {
+			return errors.Wrap(err, "could not remove cold database")
+		}
+	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying database.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	prometheus.Unregister(createCollector(s.db))
+
+	// The freezer moves data between the hot and cold databases, it must be done before they close.
+	if s.stopFreezer != nil {
+		s.stopFreezer()
+		<-s.freezerDone
+	}
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
+	if s.stopSyncer != nil {
+		s.stopSyncer()
+		<-s.syncerDone
+	}
+
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
 		return err
 	}
+	if s.writeOpts.NoSync {
+		if err := s.db.Sync(); err != nil {
+			return err
+		}
+	}
 
 	return s.db.Close()
 }
@@ -231,6 +278,39 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
+// Backend returns the type of the storage engine underlying the database.
+func (s *Store) Backend() backend.Type {
+	return s.backendType
+}
+
+// ColdPath returns the directory of the cold database, or an empty string if it is not used.
+func (s *Store) ColdPath() string {
+	return s.coldPath
+}
+
+// checkBackend refuses to open a fresh database with the requested backend when the directory
+// already holds a database of another backend, which would otherwise silently resync the node.
+func checkBackend(t backend.Type, dirPath string) error {
+	found, err := backend.Detect(dirPath)
+	if err != nil {
+		return err
+	}
+	for _, f := range found {
+		if f == t {
+			if len(found) > 1 {
+				log.WithField("backend", t).Warn("Database directory contains databases of multiple backends, " +
+					"only the selected one is used")
+			}
+			return nil
+		}
+	}
+	if len(found) > 0 {
+		return fmt.Errorf("database at %s uses the %s backend but %s was requested, convert it with "+
+			"`prysmctl db migrate-backend` or select the existing backend", dirPath, found[0], t)
+	}
+	return nil
+}
+
 func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	// We check if we want to save blinded beacon blocks by checking a key in the db
 	// otherwise, we check the last stored block and set that key in the DB if it is blinded.
@@ -243,7 +323,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	saveFull := features.Get().SaveFullExecutionPayloads
 
 	var saveBlinded bool
-	if err := s.db.Update(func(tx *bolt.Tx) error {
+	if err := s.db.Update(func(tx backend.Tx) error {
 		// If we have a key stating we wish to save blinded beacon blocks, then we set saveBlinded to true.
 		metadataBkt := tx.Bucket(chainMetadataBucket)
 		keyExists := len(metadataBkt.Get(saveBlindedBeaconBlocksKey)) > 0
@@ -287,7 +367,7 @@ func (s *Store) setupBlockStorageType(ctx context.Context) error {
 	return nil
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+func createBuckets(tx backend.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
@@ -296,7 +376,7 @@ func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
 	return nil
 }
 
-// createBoltCollector returns a prometheus collector specifically configured for boltdb.
-func createBoltCollector(db *bolt.DB) prometheus.Collector {
-	return prombolt.New("boltDB", db, blockedBuckets...)
+// createCollector returns a prometheus collector for the metrics of the underlying database.
+func createCollector(db backend.DB) prometheus.Collector {
+	return db.Collector(blockedBuckets...)
 }
@@ -6,12 +6,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
-	bolt "go.etcd.io/bbolt"
 )
 
 // setupDB instantiates and returns a Store instance.
@@ -73,7 +73,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with blinded blocks but no key in metadata bucket should continue storing blinded blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Put(saveBlindedBeaconBlocksKey, []byte{1})
 		}))
 
@@ -92,7 +92,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.DeepEqual(t, wrappedBlock, retrievedBlk)
 
 		// We then delete the key from the bucket.
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
@@ -101,7 +101,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.NoError(t, err)
 
 		var shouldSaveBlinded bool
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			bkt := tx.Bucket(chainMetadataBucket)
 			shouldSaveBlinded = len(bkt.Get(saveBlindedBeaconBlocksKey)) > 0
 			return nil
@@ -139,7 +139,7 @@ func Test_setupBlockStorageType(t *testing.T) {
 	})
 	t.Run("existing database with full blocks type should continue storing full blocks", func(t *testing.T) {
 		store := setupDB(t)
-		require.NoError(t, store.db.Update(func(tx *bolt.Tx) error {
+		require.NoError(t, store.db.Update(func(tx backend.Tx) error {
 			return tx.Bucket(chainMetadataBucket).Delete(saveBlindedBeaconBlocksKey)
 		}))
 
@@ -213,3 +213,30 @@ func Test_setupBlockStorageType(t *testing.T) {
 		require.ErrorContains(t, fmt.Sprintf(errMsg, features.SaveFullExecutionPayloads.Name), err)
 	})
 }
+
+func TestStore_Backend(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	require.Equal(t, backend.Pebble, store.Backend())
+
+	wrappedBlock, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	root, err := wrappedBlock.Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveBlock(ctx, wrappedBlock))
+	require.NoError(t, store.Close())
+
+	// Opening the directory with another backend must not silently start from an empty database.
+	_, err = NewKVStore(ctx, dir)
+	require.ErrorContains(t, "uses the pebble backend but bolt was requested", err)
+
+	store, err = NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	require.Equal(t, true, store.HasBlock(ctx, root))
+	require.NoError(t, store.ClearDB())
+	found, err := backend.Detect(dir)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(found))
+}
@@ -5,17 +5,17 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
-	bolt "go.etcd.io/bbolt"
 )
 
 func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdateWithVersion) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.saveLightClientUpdate")
 	defer span.End()
 
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		updateMarshalled, err := encode(ctx, update)
 		if err != nil {
@@ -34,7 +34,7 @@ func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod u
 	}
 
 	updates := make(map[uint64]*ethpbv2.LightClientUpdateWithVersion)
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		c := bkt.Cursor()
 
@@ -67,7 +67,7 @@ func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.
 	defer span.End()
 
 	var update ethpbv2.LightClientUpdateWithVersion
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx backend.Tx) error {
 		bkt := tx.Bucket(lightClientUpdatesBucket)
 		updateBytes := bkt.Get(bytesutil.Uint64ToBytesBigEndian(period))
 		if updateBytes == nil {
@@ -77,3 +77,46 @@ func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.
 	})
 	return &update, err
 }
+
+// EarliestLightClientUpdatePeriod returns the earliest sync committee period with a light client update,
+// or ErrNotFound if there are no light client updates.
+func (s *Store) EarliestLightClientUpdatePeriod(ctx context.Context) (uint64, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.EarliestLightClientUpdatePeriod")
+	defer span.End()
+
+	var period uint64
+	err := s.db.View(func(tx backend.Tx) error {
+		k, _ := tx.Bucket(lightClientUpdatesBucket).Cursor().First()
+		if k == nil {
+			return ErrNotFound
+		}
+		period = binary.BigEndian.Uint64(k)
+		return nil
+	})
+	return period, err
+}
+
+// DeleteLightClientUpdatesBefore deletes the light client updates of the sync committee periods before the given
+// period, and returns the number of deleted updates.
+func (s *Store) DeleteLightClientUpdatesBefore(ctx context.Context, period uint64) (int, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.DeleteLightClientUpdatesBefore")
+	defer span.End()
+
+	deleted := 0
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.First(); k != nil && binary.BigEndian.Uint64(k) < period; k, _ = c.Next() {
+			keys = append(keys, bytesutil.SafeCopyBytes(k))
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		deleted = len(keys)
+		return nil
+	})
+	return deleted, err
+}
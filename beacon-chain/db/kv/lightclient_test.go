@@ -660,6 +660,33 @@ func TestStore_LightClientUpdate_MissingPeriodsAtTheEnd_SimpleData(t *testing.T)
 
 }
 
+func TestStore_DeleteLightClientUpdatesBefore(t *testing.T) {
+	db, ctx := setupLightClientTestDB(t)
+	earliest, err := db.EarliestLightClientUpdatePeriod(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), earliest)
+
+	deleted, err := db.DeleteLightClientUpdatesBefore(ctx, 120)
+	require.NoError(t, err)
+	require.Equal(t, 101, deleted)
+	earliest, err = db.EarliestLightClientUpdatePeriod(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), earliest)
+	retrievedUpdates, err := db.LightClientUpdates(ctx, 0, 200)
+	require.NoError(t, err)
+	require.Equal(t, 81, len(retrievedUpdates))
+
+	deleted, err = db.DeleteLightClientUpdatesBefore(ctx, 120)
+	require.NoError(t, err)
+	require.Equal(t, 0, deleted)
+
+	deleted, err = db.DeleteLightClientUpdatesBefore(ctx, 300)
+	require.NoError(t, err)
+	require.Equal(t, 81, deleted)
+	_, err = db.EarliestLightClientUpdatePeriod(ctx)
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
 func setupLightClientTestDB(t *testing.T) (*Store, context.Context) {
 	db := setupDB(t)
 	ctx := context.Background()
@@ -3,12 +3,12 @@ package kv
 import (
 	"context"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(context.Context, *bolt.DB) error
+type migration func(context.Context, backend.DB) error
 
 var migrations = []migration{
 	migrateArchivedIndex,
@@ -7,21 +7,21 @@ import (
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/progress"
 	v1alpha1 "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/schollz/progressbar/v3"
-	bolt "go.etcd.io/bbolt"
 )
 
 const batchSize = 10
 
 var migrationStateValidatorsKey = []byte("migration_state_validator")
 
-func shouldMigrateValidators(db *bolt.DB) (bool, error) {
+func shouldMigrateValidators(db backend.DB) (bool, error) {
 	migrateDB := false
-	if updateErr := db.View(func(tx *bolt.Tx) error {
+	if updateErr := db.View(func(tx backend.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		// feature flag is not enabled
 		// - migration is complete, don't migrate the DB but warn that this will work as if the flag is enabled.
@@ -53,7 +53,7 @@ func shouldMigrateValidators(db *bolt.DB) (bool, error) {
 	return migrateDB, nil
 }
 
-func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
+func migrateStateValidators(ctx context.Context, db backend.DB) error {
 	if ok, err := shouldMigrateValidators(db); err != nil {
 		return err
 	} else if !ok {
@@ -65,7 +65,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 
 	// get all the keys to migrate
 	var keys [][]byte
-	if err := db.Update(func(tx *bolt.Tx) error {
+	if err := db.Update(func(tx backend.Tx) error {
 		stateBkt := tx.Bucket(stateBucket)
 		if stateBkt == nil {
 			return nil
@@ -91,7 +91,7 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 	}
 
 	// set the migration entry to done
-	if err := db.Update(func(tx *bolt.Tx) error {
+	if err := db.Update(func(tx backend.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		if mb == nil {
 			return nil
@@ -104,8 +104,8 @@ func migrateStateValidators(ctx context.Context, db *bolt.DB) error {
 	return nil
 }
 
-func performValidatorStateMigration(ctx context.Context, bar *progressbar.ProgressBar, batchIndex int, keys [][]byte) func(tx *bolt.Tx) error {
-	return func(tx *bolt.Tx) error {
+func performValidatorStateMigration(ctx context.Context, bar *progressbar.ProgressBar, batchIndex int, keys [][]byte) func(tx backend.Tx) error {
+	return func(tx backend.Tx) error {
 		//create the source and destination buckets
 		stateBkt := tx.Bucket(stateBucket)
 		if stateBkt == nil {
@@ -196,7 +196,7 @@ func performValidatorStateMigration(ctx context.Context, bar *progressbar.Progre
 	}
 }
 
-func stateBucketKeys(stateBucket *bolt.Bucket) ([][]byte, error) {
+func stateBucketKeys(stateBucket backend.Bucket) ([][]byte, error) {
 	var keys [][]byte
 	if err := stateBucket.ForEach(func(pubKey, v []byte) error {
 		keys = append(keys, pubKey)
@@ -207,7 +207,7 @@ func stateBucketKeys(stateBucket *bolt.Bucket) ([][]byte, error) {
 	return keys, nil
 }
 
-func insertValidatorHashes(ctx context.Context, validators []*v1alpha1.Validator, valBkt *bolt.Bucket) ([]byte, error) {
+func insertValidatorHashes(ctx context.Context, validators []*v1alpha1.Validator, valBkt backend.Bucket) ([]byte, error) {
 	// move all the validators in this state registry out to a new bucket.
 	var validatorKeys []byte
 	for _, val := range validators {
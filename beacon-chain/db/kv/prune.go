@@ -0,0 +1,242 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// PruneStats summarizes the data removed by a prune pass.
+type PruneStats struct {
+	// Slot is the slot below which data was pruned, after capping it at the finalized checkpoint.
+	Slot    primitives.Slot
+	Blocks  int
+	States  int
+	Indices int
+}
+
+type slotRoot struct {
+	root [32]byte
+	slot primitives.Slot
+}
+
+// indexEntry is a root stored under a key of an index bucket.
+type indexEntry struct {
+	bucket []byte
+	key    []byte
+	root   [32]byte
+}
+
+// PruneBelow deletes data which is no longer needed below the given slot:
+//   - blocks which are not part of the finalized canonical chain, together with their state summaries,
+//   - all states except the genesis, origin checkpoint and finalized states, and the newest state
+//     below the slot, which remains as the starting point to replay later states,
+//   - block and state slot index entries which reference missing data.
+//
+// The slot is capped at the start of the finalized epoch, as only blocks before it are known to be
+// canonical or not. Deletions are spread over transactions of at most batchSize items, so that the
+// database remains usable by a running node while pruning.
+func (s *Store) PruneBelow(ctx context.Context, slot primitives.Slot, batchSize int) (*PruneStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBelow")
+	defer span.End()
+
+	if batchSize <= 0 {
+		return nil, errors.New("batch size must be positive")
+	}
+	f, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	finalizedSlot, err := slots.EpochStart(f.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	stats := &PruneStats{Slot: min(slot, finalizedSlot)}
+	if stats.Slot == 0 {
+		return stats, nil
+	}
+	keep := map[[32]byte]bool{bytesutil.ToBytes32(f.Root): true}
+	oRoot, err := s.OriginCheckpointBlockRoot(ctx)
+	if err != nil && !errors.Is(err, ErrNotFoundOriginBlockRoot) {
+		return nil, err
+	}
+	if err == nil {
+		keep[oRoot] = true
+	}
+	if err := s.db.View(func(tx backend.Tx) error {
+		keep[bytesutil.ToBytes32(tx.Bucket(blocksBucket).Get(genesisBlockRootKey))] = true
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// States are deleted first, as deleting a state looks up its slot through the block or state summary.
+	states, staleStateIndices, err := s.prunableStates(ctx, stats.Slot, keep)
+	if err != nil {
+		return nil, err
+	}
+	for _, root := range states {
+		if err := s.DeleteState(ctx, root); err != nil {
+			// The justified state is protected as well, and simply kept.
+			if errors.Is(err, ErrDeleteJustifiedAndFinalized) {
+				continue
+			}
+			return stats, errors.Wrapf(err, "could not delete state %#x", root)
+		}
+		stats.States++
+	}
+	if err := s.deleteIndexEntries(ctx, staleStateIndices, batchSize); err != nil {
+		return stats, err
+	}
+	stats.Indices += len(staleStateIndices)
+
+	blks, staleBlockIndices, err := s.prunableBlocks(ctx, stats.Slot, keep)
+	if err != nil {
+		return stats, err
+	}
+	for start := 0; start < len(blks); start += batchSize {
+		batch := blks[start:min(start+batchSize, len(blks))]
+		if err := s.deleteNonCanonicalBlocks(ctx, batch); err != nil {
+			return stats, err
+		}
+		stats.Blocks += len(batch)
+	}
+	if err := s.deleteIndexEntries(ctx, staleBlockIndices, batchSize); err != nil {
+		return stats, err
+	}
+	stats.Indices += len(staleBlockIndices)
+	return stats, nil
+}
+
+// prunableStates returns the roots of the states below the slot which can be deleted, and the state
+// slot index entries which reference missing states.
+func (s *Store) prunableStates(ctx context.Context, slot primitives.Slot, keep map[[32]byte]bool) ([][32]byte, []indexEntry, error) {
+	var roots [][32]byte
+	var stale []indexEntry
+	err := s.db.View(func(tx backend.Tx) error {
+		states := tx.Bucket(stateBucket)
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		end := bytesutil.SlotToBytesBigEndian(slot)
+		for k, v := c.First(); k != nil && bytes.Compare(k, end) < 0; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			indexed, err := splitRoots(v)
+			if err != nil {
+				return errors.Wrapf(err, "corrupt value in state slot index for key %#x", k)
+			}
+			for _, root := range indexed {
+				if states.Get(root[:]) == nil {
+					stale = append(stale, indexEntry{bucket: stateSlotIndicesBucket, key: bytes.Clone(k), root: root})
+					continue
+				}
+				if !keep[root] {
+					roots = append(roots, root)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	// The newest state below the slot is kept, so that states above it can still be regenerated.
+	if len(roots) > 0 {
+		roots = roots[:len(roots)-1]
+	}
+	return roots, stale, nil
+}
+
+// prunableBlocks returns the blocks below the slot which are not part of the finalized canonical chain,
+// and the block slot index entries which reference missing blocks.
+func (s *Store) prunableBlocks(ctx context.Context, slot primitives.Slot, keep map[[32]byte]bool) ([]slotRoot, []indexEntry, error) {
+	var blks []slotRoot
+	var stale []indexEntry
+	err := s.db.View(func(tx backend.Tx) error {
+		blocksBkt := tx.Bucket(blocksBucket)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		end := bytesutil.SlotToBytesBigEndian(slot)
+		for k, v := c.First(); k != nil && bytes.Compare(k, end) < 0; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			indexed, err := splitRoots(v)
+			if err != nil {
+				return errors.Wrapf(err, "corrupt value in block slot index for key %#x", k)
+			}
+			for _, root := range indexed {
+				if blocksBkt.Get(root[:]) == nil {
+					stale = append(stale, indexEntry{bucket: blockSlotIndicesBucket, key: bytes.Clone(k), root: root})
+					continue
+				}
+				if !keep[root] && finalized.Get(root[:]) == nil {
+					blks = append(blks, slotRoot{root: root, slot: bytesutil.BytesToSlotBigEndian(k)})
+				}
+			}
+		}
+		return nil
+	})
+	return blks, stale, err
+}
+
+// deleteNonCanonicalBlocks removes the blocks, their state summaries and their block index entries.
+func (s *Store) deleteNonCanonicalBlocks(ctx context.Context, blks []slotRoot) error {
+	err := s.db.Update(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		for _, b := range blks {
+			enc := bkt.Get(b.root[:])
+			if enc == nil {
+				continue
+			}
+			blk, err := unmarshalBlock(ctx, enc)
+			if err != nil {
+				return errors.Wrapf(err, "could not unmarshal block %#x", b.root)
+			}
+			indices := blockIndices(b.slot, blk.Block().ParentRoot())
+			if err := deleteValueForIndices(ctx, indices, b.root[:], tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			if err := bkt.Delete(b.root[:]); err != nil {
+				return err
+			}
+			if err := tx.Bucket(stateSummaryBucket).Delete(b.root[:]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, b := range blks {
+		s.blockCache.Del(string(b.root[:]))
+		s.stateSummaryCache.delete(b.root)
+	}
+	return nil
+}
+
+// deleteIndexEntries removes the given roots from their index buckets.
+func (s *Store) deleteIndexEntries(ctx context.Context, entries []indexEntry, batchSize int) error {
+	for start := 0; start < len(entries); start += batchSize {
+		batch := entries[start:min(start+batchSize, len(entries))]
+		if err := s.db.Update(func(tx backend.Tx) error {
+			for _, e := range batch {
+				indices := map[string][]byte{string(e.bucket): e.key}
+				if err := deleteValueForIndices(ctx, indices, e.root[:], tx); err != nil {
+					return errors.Wrapf(err, "could not delete stale entry of %s", e.bucket)
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_PruneBelow(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+	}
+
+	// Two blocks forking off the canonical chain at slot 2.
+	forks := make([][32]byte, 2)
+	for i := range forks {
+		b := util.NewBeaconBlock()
+		b.Block.Slot = primitives.Slot(3 + i)
+		b.Block.ParentRoot = roots[1][:]
+		b.Block.Body.Graffiti = bytesutil.PadTo([]byte{'f', 'o', 'r', 'k', byte(i)}, 32)
+		wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, db.SaveBlock(ctx, wsb))
+		forks[i], err = b.Block.HashTreeRoot()
+		require.NoError(t, err)
+	}
+
+	saveState := func(slot primitives.Slot, root [32]byte) {
+		st, err := util.NewBeaconState()
+		require.NoError(t, err)
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, root))
+	}
+	saveState(3, forks[0])
+	for _, slot := range []uint64{8, 16, 24} {
+		saveState(primitives.Slot(slot), roots[slot-1])
+	}
+	finalized := roots[slotsPerEpoch*2-1]
+	saveState(primitives.Slot(slotsPerEpoch*2), finalized)
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: finalized[:]}))
+
+	_, err := db.PruneBelow(ctx, 1, 0)
+	require.ErrorContains(t, "batch size must be positive", err)
+
+	stats, err := db.PruneBelow(ctx, primitives.Slot(slotsPerEpoch*10), 1)
+	require.NoError(t, err)
+	// The slot is capped at the start of the finalized epoch.
+	assert.Equal(t, primitives.Slot(slotsPerEpoch*2), stats.Slot)
+	assert.Equal(t, 2, stats.Blocks)
+	assert.Equal(t, 3, stats.States)
+	assert.Equal(t, 0, stats.Indices)
+
+	for _, r := range forks {
+		assert.Equal(t, false, db.HasBlock(ctx, r))
+		assert.Equal(t, false, db.HasStateSummary(ctx, r))
+	}
+	for i, r := range roots {
+		assert.Equal(t, true, db.HasBlock(ctx, r), "Canonical block at index %d was pruned", i)
+	}
+	assert.Equal(t, false, db.HasState(ctx, forks[0]))
+	assert.Equal(t, false, db.HasState(ctx, roots[7]))
+	assert.Equal(t, false, db.HasState(ctx, roots[15]))
+	// The newest state below the slot and the finalized state are kept.
+	assert.Equal(t, true, db.HasState(ctx, roots[23]))
+	assert.Equal(t, true, db.HasState(ctx, finalized))
+
+	// The slot index only references the canonical block.
+	_, slotRoots, err := db.BlockRootsBySlot(ctx, 3)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{roots[2]}, slotRoots)
+
+	// A second pass has nothing left to prune.
+	stats, err = db.PruneBelow(ctx, primitives.Slot(slotsPerEpoch*2), 1)
+	require.NoError(t, err)
+	assert.Equal(t, 0, stats.Blocks+stats.States+stats.Indices)
+}
@@ -58,6 +58,10 @@ var (
 	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
 	// tracking data about an ongoing backfill
 	backfillStatusKey = []byte("backfill-status")
+	// time of the last write of the database health check
+	healthCheckKey = []byte("health-check")
+	// slot below which the finalized data was moved to the cold database
+	frozenSlotKey = []byte("frozen-slot")
 
 	// Deprecated: This index key was migrated in PR 6461. Do not use, except for migrations.
 	lastArchivedIndexKey = []byte("last-archived")
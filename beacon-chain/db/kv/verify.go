@@ -0,0 +1,179 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+)
+
+const repairBatchSize = 64
+
+// VerifyReport describes the inconsistencies found in the database by Verify.
+type VerifyReport struct {
+	Blocks int
+	States int
+	// MissingParents are the roots of blocks whose parent block is not in the database, excluding the
+	// genesis block and the lowest blocks of the database, such as a checkpoint sync origin block.
+	MissingParents [][32]byte
+	// StateRootMismatches are the roots of blocks whose state root does not match the stored state.
+	StateRootMismatches [][32]byte
+	// DanglingIndices is the number of index entries which reference a missing block or state.
+	DanglingIndices int
+	// Repaired is the number of dangling index entries which were deleted.
+	Repaired int
+}
+
+// Issues returns the number of inconsistencies which were found and not repaired.
+func (r *VerifyReport) Issues() int {
+	return len(r.MissingParents) + len(r.StateRootMismatches) + r.DanglingIndices - r.Repaired
+}
+
+// Verify checks the integrity of the database. It walks every block to its parent, compares the state
+// root of blocks against the states stored for them, and looks for block and state index entries which
+// reference missing data. When repair is set, the dangling index entries are deleted.
+func (s *Store) Verify(ctx context.Context, repair bool) (*VerifyReport, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Verify")
+	defer span.End()
+
+	report := &VerifyReport{}
+	if err := s.verifyParents(ctx, report); err != nil {
+		return nil, err
+	}
+	if err := s.verifyStateRoots(ctx, report); err != nil {
+		return nil, err
+	}
+	dangling, err := s.danglingIndices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.DanglingIndices = len(dangling)
+	if repair {
+		if err := s.deleteIndexEntries(ctx, dangling, repairBatchSize); err != nil {
+			return report, err
+		}
+		report.Repaired = len(dangling)
+	}
+	return report, nil
+}
+
+// verifyParents records the blocks whose parent is missing.
+func (s *Store) verifyParents(ctx context.Context, report *VerifyReport) error {
+	var orphans []slotRoot
+	lowest := primitives.Slot(0)
+	err := s.db.View(func(tx backend.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		c := bkt.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			// The bucket also holds keys such as the genesis and head block roots.
+			if len(k) != 32 {
+				continue
+			}
+			blk, err := unmarshalBlock(ctx, v)
+			if err != nil {
+				return errors.Wrapf(err, "could not unmarshal block %#x", k)
+			}
+			slot := blk.Block().Slot()
+			if report.Blocks == 0 || slot < lowest {
+				lowest = slot
+			}
+			report.Blocks++
+			parent := blk.Block().ParentRoot()
+			if parent == [32]byte{} || bkt.Get(parent[:]) != nil {
+				continue
+			}
+			orphans = append(orphans, slotRoot{root: [32]byte(k), slot: slot})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, o := range orphans {
+		if o.slot != lowest {
+			report.MissingParents = append(report.MissingParents, o.root)
+		}
+	}
+	return nil
+}
+
+// verifyStateRoots records the states which do not match the state root of the block they are stored for.
+// States which were advanced past the slot of their block are not checked.
+func (s *Store) verifyStateRoots(ctx context.Context, report *VerifyReport) error {
+	var roots [][32]byte
+	if err := s.db.View(func(tx backend.Tx) error {
+		return tx.Bucket(stateBucket).ForEach(func(k, _ []byte) error {
+			if len(k) == 32 {
+				roots = append(roots, [32]byte(k))
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, root := range roots {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		report.States++
+		blk, err := s.Block(ctx, root)
+		if err != nil {
+			return err
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		st, err := s.State(ctx, root)
+		if err != nil {
+			return errors.Wrapf(err, "could not load state %#x", root)
+		}
+		if st.Slot() != blk.Block().Slot() {
+			continue
+		}
+		stRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return err
+		}
+		if want := blk.Block().StateRoot(); !bytes.Equal(stRoot[:], want[:]) {
+			report.StateRootMismatches = append(report.StateRootMismatches, root)
+		}
+	}
+	return nil
+}
+
+// danglingIndices returns the slot and parent root index entries which reference missing blocks or states.
+func (s *Store) danglingIndices(ctx context.Context) ([]indexEntry, error) {
+	var dangling []indexEntry
+	err := s.db.View(func(tx backend.Tx) error {
+		for index, target := range map[string][]byte{
+			string(blockSlotIndicesBucket):       blocksBucket,
+			string(blockParentRootIndicesBucket): blocksBucket,
+			string(stateSlotIndicesBucket):       stateBucket,
+		} {
+			targets := tx.Bucket(target)
+			c := tx.Bucket([]byte(index)).Cursor()
+			for k, v := c.First(); k != nil; k, v = c.Next() {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				roots, err := splitRoots(v)
+				if err != nil {
+					return errors.Wrapf(err, "corrupt value in %s for key %#x", index, k)
+				}
+				for _, root := range roots {
+					if targets.Get(root[:]) == nil {
+						dangling = append(dangling, indexEntry{bucket: []byte(index), key: bytes.Clone(k), root: root})
+					}
+				}
+			}
+		}
+		return nil
+	})
+	return dangling, err
+}
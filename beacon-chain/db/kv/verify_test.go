@@ -0,0 +1,92 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	consensusblocks "github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestStore_Verify(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+
+	// The parent of the lowest block is expected to be missing.
+	blks := makeBlocks(t, 0, 8, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	parent, err := blks[7].Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	// A block with a state matching its state root.
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(9))
+	stRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b := util.NewBeaconBlock()
+	b.Block.Slot = 9
+	b.Block.ParentRoot = parent[:]
+	b.Block.StateRoot = stRoot[:]
+	wsb, err := consensusblocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	root, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, root))
+
+	// A block whose parent is missing.
+	orphan := util.NewBeaconBlock()
+	orphan.Block.Slot = 10
+	orphan.Block.ParentRoot = bytesutil.PadTo([]byte("missing"), 32)
+	wsb, err = consensusblocks.NewSignedBeaconBlock(orphan)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+	orphanRoot, err := orphan.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	// A state which does not match the state root of its block.
+	mismatched, err := blks[4].Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err = util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(blks[4].Block().Slot()))
+	require.NoError(t, db.SaveState(ctx, st, mismatched))
+
+	// Index entries referencing a missing block.
+	missing := bytesutil.PadTo([]byte("gone"), 32)
+	require.NoError(t, db.db.Update(func(tx backend.Tx) error {
+		for k, v := range blockIndices(11, [32]byte(missing)) {
+			if err := tx.Bucket([]byte(k)).Put(v, missing); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+
+	report, err := db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 10, report.Blocks)
+	assert.Equal(t, 2, report.States)
+	assert.DeepEqual(t, [][32]byte{orphanRoot}, report.MissingParents)
+	assert.DeepEqual(t, [][32]byte{mismatched}, report.StateRootMismatches)
+	assert.Equal(t, 2, report.DanglingIndices)
+	assert.Equal(t, 4, report.Issues())
+
+	report, err = db.Verify(ctx, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, report.Repaired)
+	assert.Equal(t, 2, report.Issues())
+	_, roots, err := db.BlockRootsBySlot(ctx, 11)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(roots))
+
+	report, err = db.Verify(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.DanglingIndices)
+}
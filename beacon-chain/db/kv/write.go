@@ -0,0 +1,65 @@
+package kv
+
+import (
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+)
+
+// WithWriteBatching coalesces concurrent saves of blocks, states, state summaries and checkpoints into
+// shared transactions of at most maxSize saves, waiting up to maxDelay for more saves to arrive. Backend
+// defaults are used for zero values. This reduces the number of commits on slow disks during sync.
+func WithWriteBatching(maxSize int, maxDelay time.Duration) KVStoreOption {
+	return func(s *Store) {
+		s.batchWrites = true
+		s.writeOpts.MaxBatchSize = maxSize
+		s.writeOpts.MaxBatchDelay = maxDelay
+	}
+}
+
+// WithFsyncInterval stops syncing every commit to disk, and syncs the database at the given interval
+// instead. A crash may lose the writes of the last interval, which are then fetched again from peers.
+// It is only supported by the Pebble backend, see backend.WriteOptions.
+func WithFsyncInterval(interval time.Duration) KVStoreOption {
+	return func(s *Store) {
+		s.fsyncInterval = interval
+		s.writeOpts.NoSync = interval > 0
+	}
+}
+
+// update runs fn in a read-write transaction, which is shared with concurrent saves when write
+// batching is enabled. As a shared transaction may be retried, fn must be idempotent.
+func (s *Store) update(fn func(backend.Tx) error) error {
+	if s.batchWrites {
+		return s.db.Batch(fn)
+	}
+	return s.db.Update(fn)
+}
+
+// startSyncer configures how the database commits writes, and starts syncing it periodically if
+// commits are not synced.
+func (s *Store) startSyncer() {
+	s.db.SetWriteOptions(s.writeOpts)
+	if s.fsyncInterval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.stopSyncer = cancel
+	s.syncerDone = make(chan struct{})
+	go func() {
+		defer close(s.syncerDone)
+		ticker := time.NewTicker(s.fsyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := s.db.Sync(); err != nil {
+					log.WithError(err).Error("Could not sync database to disk")
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
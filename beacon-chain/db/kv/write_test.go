@@ -0,0 +1,56 @@
+package kv
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestStore_WriteBatching(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, WithBackend(backend.Pebble), WithWriteBatching(4, time.Millisecond), WithFsyncInterval(10*time.Millisecond))
+	require.NoError(t, err)
+
+	blks := makeBlocks(t, 0, 16, genesisBlockRoot)
+	var wg sync.WaitGroup
+	errs := make(chan error, len(blks))
+	for _, b := range blks {
+		wg.Add(1)
+		go func(b interfaces.ReadOnlySignedBeaconBlock) {
+			defer wg.Done()
+			errs <- db.SaveBlock(ctx, b)
+		}(b)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	// Let the syncer run at least once.
+	time.Sleep(20 * time.Millisecond)
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, WithBackend(backend.Pebble))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+	for i, b := range blks {
+		root, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, root), "Block at index %d is missing", i)
+	}
+}
+
+func TestStore_FsyncIntervalBolt(t *testing.T) {
+	// BoltDB files may be corrupted by an operating system crash when commits are not synced.
+	_, err := NewKVStore(context.Background(), t.TempDir(), WithFsyncInterval(time.Second))
+	require.ErrorContains(t, "periodic fsync is not supported by the bolt backend", err)
+}
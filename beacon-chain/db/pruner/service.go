@@ -0,0 +1,121 @@
+// Package pruner defines a service which periodically deletes non-canonical blocks, old states and
+// stale indices from the beacon node database while the node runs.
+package pruner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const defaultBatchSize = 64
+
+// Database is the subset of the beacon node database used by the pruner.
+type Database interface {
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	PruneBelow(ctx context.Context, slot primitives.Slot, batchSize int) (*kv.PruneStats, error)
+}
+
+// Service prunes the database once per epoch, keeping the given number of epochs before the
+// finalized checkpoint.
+type Service struct {
+	ctx          context.Context
+	cancel       context.CancelFunc
+	db           Database
+	cw           startup.ClockWaiter
+	retention    primitives.Epoch
+	batchSize    int
+	prunedBefore primitives.Slot
+}
+
+// NewService initializes the pruner service.
+func NewService(ctx context.Context, db Database, cw startup.ClockWaiter, retention primitives.Epoch) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		db:        db,
+		cw:        cw,
+		retention: retention,
+		batchSize: defaultBatchSize,
+	}
+}
+
+// Start the pruner service in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cw.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not obtain clock, database pruner will not run")
+		return
+	}
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	// Prune in the middle of the epoch, away from the epoch boundary processing.
+	offset := params.BeaconConfig().SlotsPerEpoch / 2
+	for {
+		select {
+		case slot := <-ticker.C():
+			if slot%params.BeaconConfig().SlotsPerEpoch != offset {
+				continue
+			}
+			if err := s.prune(); err != nil {
+				log.WithError(err).Error("Could not prune database")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// prune deletes the data below the retention slot, if it advanced since the previous pass.
+func (s *Service) prune() error {
+	f, err := s.db.FinalizedCheckpoint(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	if f.Epoch <= s.retention {
+		return nil
+	}
+	slot, err := slots.EpochStart(f.Epoch - s.retention)
+	if err != nil {
+		return err
+	}
+	if slot <= s.prunedBefore {
+		return nil
+	}
+	stats, err := s.db.PruneBelow(s.ctx, slot, s.batchSize)
+	if err != nil {
+		return err
+	}
+	s.prunedBefore = stats.Slot
+	log.WithFields(logrus.Fields{
+		"slot":    stats.Slot,
+		"blocks":  stats.Blocks,
+		"states":  stats.States,
+		"indices": stats.Indices,
+	}).Debug("Pruned database")
+	return nil
+}
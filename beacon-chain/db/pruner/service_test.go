@@ -0,0 +1,49 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockDB struct {
+	finalized primitives.Epoch
+	pruned    []primitives.Slot
+}
+
+func (m *mockDB) FinalizedCheckpoint(context.Context) (*ethpb.Checkpoint, error) {
+	return &ethpb.Checkpoint{Epoch: m.finalized, Root: make([]byte, 32)}, nil
+}
+
+func (m *mockDB) PruneBelow(_ context.Context, slot primitives.Slot, _ int) (*kv.PruneStats, error) {
+	m.pruned = append(m.pruned, slot)
+	return &kv.PruneStats{Slot: slot}, nil
+}
+
+func TestService_Prune(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	db := &mockDB{finalized: 3}
+	s := NewService(context.Background(), db, nil, 4)
+
+	// Nothing is pruned while the finalized epoch is within the retention period.
+	require.NoError(t, s.prune())
+	assert.Equal(t, 0, len(db.pruned))
+
+	db.finalized = 10
+	require.NoError(t, s.prune())
+	assert.DeepEqual(t, []primitives.Slot{6 * slotsPerEpoch}, db.pruned)
+
+	// The database is not pruned again until finality advances.
+	require.NoError(t, s.prune())
+	assert.Equal(t, 1, len(db.pruned))
+
+	db.finalized = 11
+	require.NoError(t, s.prune())
+	assert.DeepEqual(t, []primitives.Slot{6 * slotsPerEpoch, 7 * slotsPerEpoch}, db.pruned)
+}
@@ -0,0 +1,195 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// forkReadinessEpochs is the number of epochs before a fork from which the capabilities of the execution client
+// are exchanged every epoch, and missing engine methods reported.
+const forkReadinessEpochs = primitives.Epoch(256)
+
+// engineMethodsByFork lists the engine methods used from each fork on. The execution client must support all of
+// them to follow the chain after the fork.
+var engineMethodsByFork = map[int][]string{
+	version.Bellatrix: {NewPayloadMethod, ForkchoiceUpdatedMethod, GetPayloadMethod},
+	version.Capella:   {NewPayloadMethodV2, ForkchoiceUpdatedMethodV2, GetPayloadMethodV2},
+	version.Deneb:     {NewPayloadMethodV3, ForkchoiceUpdatedMethodV3, GetPayloadMethodV3},
+	version.Electra:   {NewPayloadMethodV4, ForkchoiceUpdatedMethodV3, GetPayloadMethodV4},
+}
+
+// EngineCapabilities is the result of the last capability exchange with the execution client, along with the
+// readiness of the execution client for the current and next forks.
+type EngineCapabilities struct {
+	Methods     []string
+	ExchangedAt time.Time
+	Current     *ForkReadiness
+	// Next is nil when no fork is scheduled.
+	Next *ForkReadiness
+}
+
+// ForkReadiness describes whether the execution client supports the engine methods used from a fork on.
+type ForkReadiness struct {
+	Fork           int
+	Epoch          primitives.Epoch
+	Ready          bool
+	MissingMethods []string
+}
+
+// EngineCapabilitiesFetcher returns the capabilities of the execution client.
+type EngineCapabilitiesFetcher interface {
+	EngineCapabilities(current primitives.Epoch) *EngineCapabilities
+}
+
+var _ EngineCapabilitiesFetcher = (*Service)(nil)
+
+// EngineCapabilities returns the capabilities of the execution client, and its readiness for the fork of the
+// given epoch and the next scheduled fork. Until capabilities are exchanged, no fork is ready.
+func (s *Service) EngineCapabilities(current primitives.Epoch) *EngineCapabilities {
+	c := &EngineCapabilities{}
+	if s.capabilityCache != nil {
+		c.Methods, c.ExchangedAt = s.capabilityCache.list()
+	}
+	v, epoch := forkAtEpoch(current)
+	c.Current = s.forkReadiness(v, epoch)
+	if v, epoch, ok := nextFork(current); ok {
+		c.Next = s.forkReadiness(v, epoch)
+	}
+	return c
+}
+
+func (s *Service) forkReadiness(v int, epoch primitives.Epoch) *ForkReadiness {
+	r := &ForkReadiness{Fork: v, Epoch: epoch}
+	for _, m := range engineMethodsByFork[v] {
+		if !s.capabilityCache.exchanged() || !s.capabilityCache.has(m) {
+			r.MissingMethods = append(r.MissingMethods, m)
+		}
+	}
+	r.Ready = len(r.MissingMethods) == 0
+	return r
+}
+
+// refreshCapabilities exchanges capabilities with the execution client and caches them.
+func (s *Service) refreshCapabilities(ctx context.Context) error {
+	c, err := s.ExchangeCapabilities(ctx)
+	if err != nil {
+		return err
+	}
+	s.capabilityCache.save(c)
+	return nil
+}
+
+// requireEngineMethod returns an error when the execution client reported that it does not support the
+// engine method, so that the node does not cross a fork boundary with an execution client which cannot
+// follow it. As the execution client may have been upgraded since, capabilities are exchanged again
+// before refusing the method. Nothing is checked until capabilities are exchanged.
+func (s *Service) requireEngineMethod(ctx context.Context, method string) error {
+	if !s.capabilityCache.exchanged() || s.capabilityCache.has(method) {
+		return nil
+	}
+	if err := s.refreshCapabilities(ctx); err != nil {
+		log.WithError(err).Debug("Could not exchange capabilities with execution client")
+	} else if s.capabilityCache.has(method) {
+		return nil
+	}
+	return errors.Wrapf(ErrUnsupportedEngineMethod, "%s is required at the current fork, update the execution client", method)
+}
+
+// monitorForkReadiness checks the readiness of the execution client for the next fork every epoch.
+func (s *Service) monitorForkReadiness() {
+	if s.clockWaiter == nil {
+		return
+	}
+	clock, err := s.clockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not obtain clock, fork readiness of the execution client will not be checked")
+		return
+	}
+	s.checkForkReadiness(s.ctx, slots.ToEpoch(clock.CurrentSlot()))
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			s.checkForkReadiness(s.ctx, slots.ToEpoch(slot))
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// checkForkReadiness exchanges capabilities with the execution client when the next fork is close, and warns
+// about the engine methods it is missing for the fork.
+func (s *Service) checkForkReadiness(ctx context.Context, current primitives.Epoch) {
+	v, epoch, ok := nextFork(current)
+	if !ok || epoch-current > forkReadinessEpochs {
+		nextForkReadyGauge.Set(1)
+		return
+	}
+	if err := s.refreshCapabilities(ctx); err != nil {
+		log.WithError(err).Warn("Could not exchange capabilities with execution client ahead of fork")
+		return
+	}
+	r := s.forkReadiness(v, epoch)
+	if r.Ready {
+		nextForkReadyGauge.Set(1)
+		return
+	}
+	nextForkReadyGauge.Set(0)
+	log.WithFields(logrus.Fields{
+		"fork":            version.String(v),
+		"forkEpoch":       epoch,
+		"epochsUntilFork": epoch - current,
+		"missingMethods":  r.MissingMethods,
+	}).Warn("Execution client does not support the engine methods of the next fork. " +
+		"Update the execution client before the fork, or the node will not follow the chain after it")
+}
+
+type scheduledFork struct {
+	version int
+	epoch   primitives.Epoch
+}
+
+func forkSchedule() []scheduledFork {
+	cfg := params.BeaconConfig()
+	return []scheduledFork{
+		{version.Phase0, cfg.GenesisEpoch},
+		{version.Altair, cfg.AltairForkEpoch},
+		{version.Bellatrix, cfg.BellatrixForkEpoch},
+		{version.Capella, cfg.CapellaForkEpoch},
+		{version.Deneb, cfg.DenebForkEpoch},
+		{version.Electra, cfg.ElectraForkEpoch},
+	}
+}
+
+// forkAtEpoch returns the fork active at the given epoch and its epoch.
+func forkAtEpoch(e primitives.Epoch) (int, primitives.Epoch) {
+	v, epoch := version.Phase0, params.BeaconConfig().GenesisEpoch
+	for _, f := range forkSchedule() {
+		if f.epoch <= e {
+			v, epoch = f.version, f.epoch
+		}
+	}
+	return v, epoch
+}
+
+// nextFork returns the first fork scheduled after the given epoch, if any.
+func nextFork(e primitives.Epoch) (int, primitives.Epoch, bool) {
+	for _, f := range forkSchedule() {
+		if f.epoch > e && f.epoch != params.BeaconConfig().FarFutureEpoch {
+			return f.version, f.epoch, true
+		}
+	}
+	return 0, 0, false
+}
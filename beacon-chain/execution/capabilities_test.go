@@ -0,0 +1,91 @@
+package execution
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// capabilitiesRPCClient answers capability exchanges with the given methods.
+type capabilitiesRPCClient struct {
+	RPCClientBad
+	methods   []string
+	exchanges int
+}
+
+func (c *capabilitiesRPCClient) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	if method != ExchangeCapabilities {
+		return errors.New("unexpected method")
+	}
+	c.exchanges++
+	*result.(*[]string) = c.methods
+	return nil
+}
+
+func TestRequireEngineMethod(t *testing.T) {
+	ctx := context.Background()
+	client := &capabilitiesRPCClient{methods: []string{NewPayloadMethodV3}}
+	s := &Service{capabilityCache: &capabilityCache{}, rpcClient: client}
+	// Nothing is refused until capabilities are exchanged.
+	require.NoError(t, s.requireEngineMethod(ctx, NewPayloadMethodV4))
+	assert.Equal(t, 0, client.exchanges)
+
+	s.capabilityCache.save([]string{NewPayloadMethodV3})
+	require.NoError(t, s.requireEngineMethod(ctx, NewPayloadMethodV3))
+	assert.Equal(t, 0, client.exchanges)
+	// Capabilities are exchanged again before refusing a method.
+	require.ErrorIs(t, s.requireEngineMethod(ctx, NewPayloadMethodV4), ErrUnsupportedEngineMethod)
+	assert.Equal(t, 1, client.exchanges)
+
+	// The execution client was upgraded.
+	client.methods = []string{NewPayloadMethodV3, NewPayloadMethodV4}
+	require.NoError(t, s.requireEngineMethod(ctx, NewPayloadMethodV4))
+	assert.Equal(t, 2, client.exchanges)
+	require.NoError(t, s.requireEngineMethod(ctx, NewPayloadMethodV4))
+	assert.Equal(t, 2, client.exchanges)
+}
+
+func TestCapabilityCache_Save(t *testing.T) {
+	c := &capabilityCache{}
+	assert.Equal(t, false, c.exchanged())
+	c.save([]string{NewPayloadMethodV3, ForkchoiceUpdatedMethodV3})
+	c.save([]string{NewPayloadMethodV4})
+	methods, at := c.list()
+	assert.DeepEqual(t, []string{NewPayloadMethodV4}, methods)
+	assert.Equal(t, false, at.IsZero())
+	assert.Equal(t, false, c.has(NewPayloadMethodV3))
+}
+
+func TestEngineCapabilities(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 10
+	cfg.DenebForkEpoch = 20
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	params.OverrideBeaconConfig(cfg)
+
+	s := &Service{capabilityCache: &capabilityCache{}}
+	c := s.EngineCapabilities(12)
+	assert.Equal(t, version.Capella, c.Current.Fork)
+	assert.Equal(t, false, c.Current.Ready)
+	require.NotNil(t, c.Next)
+	assert.Equal(t, version.Deneb, c.Next.Fork)
+
+	s.capabilityCache.save([]string{NewPayloadMethodV2, ForkchoiceUpdatedMethodV2, GetPayloadMethodV2, NewPayloadMethodV3})
+	c = s.EngineCapabilities(12)
+	assert.Equal(t, true, c.Current.Ready)
+	assert.Equal(t, false, c.Next.Ready)
+	assert.DeepEqual(t, []string{ForkchoiceUpdatedMethodV3, GetPayloadMethodV3}, c.Next.MissingMethods)
+
+	// Electra is not scheduled, so there is no next fork after Deneb.
+	c = s.EngineCapabilities(25)
+	assert.Equal(t, version.Deneb, c.Current.Fork)
+	assert.Equal(t, (*ForkReadiness)(nil), c.Next)
+}
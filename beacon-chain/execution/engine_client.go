@@ -118,6 +118,7 @@ type EngineCaller interface {
 	GetPayload(ctx context.Context, payloadId [8]byte, slot primitives.Slot) (*blocks.GetPayloadResponse, error)
 	ExecutionBlockByHash(ctx context.Context, hash common.Hash, withTxs bool) (*pb.ExecutionBlock, error)
 	GetTerminalBlockHash(ctx context.Context, transitionTime uint64) ([]byte, bool, error)
+	SetGasLimitTarget(ctx context.Context, gasLimit uint64) error
 }
 
 var ErrEmptyBlockHash = errors.New("Block hash is empty 0x0000...")
@@ -142,6 +143,9 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if !ok {
 			return nil, errors.New("execution data must be a Bellatrix or Capella execution payload")
 		}
+		if err := s.requireEngineMethod(ctx, NewPayloadMethod); err != nil {
+			return nil, err
+		}
 		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethod, payloadPb)
 		if err != nil {
 			return nil, handleRPCError(err)
@@ -151,6 +155,9 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 		if !ok {
 			return nil, errors.New("execution data must be a Capella execution payload")
 		}
+		if err := s.requireEngineMethod(ctx, NewPayloadMethodV2); err != nil {
+			return nil, err
+		}
 		err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV2, payloadPb)
 		if err != nil {
 			return nil, handleRPCError(err)
@@ -161,6 +168,9 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 			return nil, errors.New("execution data must be a Deneb execution payload")
 		}
 		if executionRequests == nil {
+			if err := s.requireEngineMethod(ctx, NewPayloadMethodV3); err != nil {
+				return nil, err
+			}
 			err := s.rpcClient.CallContext(ctx, result, NewPayloadMethodV3, payloadPb, versionedHashes, parentBlockRoot)
 			if err != nil {
 				return nil, handleRPCError(err)
@@ -170,6 +180,9 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 			if err != nil {
 				return nil, errors.Wrap(err, "failed to encode execution requests")
 			}
+			if err := s.requireEngineMethod(ctx, NewPayloadMethodV4); err != nil {
+				return nil, err
+			}
 			err = s.rpcClient.CallContext(ctx, result, NewPayloadMethodV4, payloadPb, versionedHashes, parentBlockRoot, flattenedRequests)
 			if err != nil {
 				return nil, handleRPCError(err)
@@ -198,6 +211,14 @@ func (s *Service) NewPayload(ctx context.Context, payload interfaces.ExecutionDa
 // ForkchoiceUpdated calls the engine_forkchoiceUpdatedV1 method via JSON-RPC.
 func (s *Service) ForkchoiceUpdated(
 	ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
+) (*pb.PayloadIDBytes, []byte, error) {
+	payloadID, latestValidHash, err := s.forkchoiceUpdated(ctx, state, attrs)
+	s.forkchoiceStatus.record(err)
+	return payloadID, latestValidHash, err
+}
+
+func (s *Service) forkchoiceUpdated(
+	ctx context.Context, state *pb.ForkchoiceState, attrs payloadattribute.Attributer,
 ) (*pb.PayloadIDBytes, []byte, error) {
 	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.ForkchoiceUpdated")
 	defer span.End()
@@ -220,6 +241,9 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
+		if err := s.requireEngineMethod(ctx, ForkchoiceUpdatedMethod); err != nil {
+			return nil, nil, err
+		}
 		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethod, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
@@ -229,6 +253,9 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
+		if err := s.requireEngineMethod(ctx, ForkchoiceUpdatedMethodV2); err != nil {
+			return nil, nil, err
+		}
 		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV2, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
@@ -238,6 +265,9 @@ func (s *Service) ForkchoiceUpdated(
 		if err != nil {
 			return nil, nil, err
 		}
+		if err := s.requireEngineMethod(ctx, ForkchoiceUpdatedMethodV3); err != nil {
+			return nil, nil, err
+		}
 		err = s.rpcClient.CallContext(ctx, result, ForkchoiceUpdatedMethodV3, state, a)
 		if err != nil {
 			return nil, nil, handleRPCError(err)
@@ -293,6 +323,9 @@ func (s *Service) GetPayload(ctx context.Context, payloadId [8]byte, slot primit
 	defer cancel()
 
 	method, result := getPayloadMethodAndMessage(slot)
+	if err := s.requireEngineMethod(ctx, method); err != nil {
+		return nil, err
+	}
 	err := s.rpcClient.CallContext(ctx, result, method, pb.PayloadIDBytes(payloadId))
 	if err != nil {
 		return nil, handleRPCError(err)
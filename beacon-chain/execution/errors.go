@@ -35,4 +35,6 @@ var (
 	ErrRequestTooLarge = errors.New("request too large")
 	// ErrUnsupportedVersion represents a case where a payload is requested for a block type that doesn't have a known mapping.
 	ErrUnsupportedVersion = errors.New("unknown ExecutionPayload schema for block version")
+	// ErrUnsupportedEngineMethod when the execution client reported that it does not support an engine method.
+	ErrUnsupportedEngineMethod = errors.New("execution client does not support engine method")
 )
@@ -0,0 +1,23 @@
+package execution
+
+import (
+	"context"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
+)
+
+// faultInjectionRPCClient delays the Engine API calls of the RPC client by the configured fault injection delay.
+type faultInjectionRPCClient struct {
+	RPCClient
+}
+
+// CallContext delays Engine API calls before calling the execution client. Other calls are not delayed.
+func (c *faultInjectionRPCClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	if strings.HasPrefix(method, "engine_") {
+		if err := faultinjection.DelayEngineCall(ctx); err != nil {
+			return err
+		}
+	}
+	return c.RPCClient.CallContext(ctx, result, method, args...)
+}
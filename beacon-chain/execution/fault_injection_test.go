@@ -0,0 +1,37 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type callRecordingRPCClient struct {
+	RPCClientEmpty
+	calls []string
+}
+
+func (c *callRecordingRPCClient) CallContext(_ context.Context, _ interface{}, method string, _ ...interface{}) error {
+	c.calls = append(c.calls, method)
+	return nil
+}
+
+func TestFaultInjectionRPCClient_DelaysEngineCalls(t *testing.T) {
+	d, err := faultinjection.ParseDelay("fixed:1h")
+	require.NoError(t, err)
+	faultinjection.Init(&faultinjection.Config{EngineDelay: d})
+	defer faultinjection.Init(nil)
+
+	inner := &callRecordingRPCClient{}
+	client := &faultInjectionRPCClient{RPCClient: inner}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	require.NoError(t, client.CallContext(ctx, nil, BlockByNumberMethod))
+	require.ErrorIs(t, client.CallContext(ctx, nil, NewPayloadMethodV3), context.DeadlineExceeded)
+	assert.DeepEqual(t, []string{BlockByNumberMethod}, inner.calls)
+}
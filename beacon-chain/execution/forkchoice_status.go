@@ -0,0 +1,45 @@
+package execution
+
+import (
+	"sync"
+	"time"
+)
+
+// ForkchoiceUpdateStatus describes the outcome of the last forkchoice updates sent to the execution client.
+type ForkchoiceUpdateStatus struct {
+	// LastSuccess is the zero time when no forkchoice update succeeded yet.
+	LastSuccess time.Time
+	// LastFailure is the zero time when no forkchoice update failed yet.
+	LastFailure time.Time
+	LastErr     error
+}
+
+// ForkchoiceUpdateStatusFetcher returns the outcome of the last forkchoice updates sent to the execution client.
+type ForkchoiceUpdateStatusFetcher interface {
+	ForkchoiceUpdateStatus() ForkchoiceUpdateStatus
+}
+
+var _ ForkchoiceUpdateStatusFetcher = (*Service)(nil)
+
+// ForkchoiceUpdateStatus returns the outcome of the last forkchoice updates sent to the execution client.
+func (s *Service) ForkchoiceUpdateStatus() ForkchoiceUpdateStatus {
+	s.forkchoiceStatus.Lock()
+	defer s.forkchoiceStatus.Unlock()
+	return s.forkchoiceStatus.status
+}
+
+type forkchoiceStatusTracker struct {
+	sync.Mutex
+	status ForkchoiceUpdateStatus
+}
+
+func (t *forkchoiceStatusTracker) record(err error) {
+	t.Lock()
+	defer t.Unlock()
+	if err != nil {
+		t.status.LastFailure = time.Now()
+		t.status.LastErr = err
+		return
+	}
+	t.status.LastSuccess = time.Now()
+}
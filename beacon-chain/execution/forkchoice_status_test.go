@@ -0,0 +1,31 @@
+package execution
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForkchoiceUpdateStatus(t *testing.T) {
+	s := &Service{capabilityCache: &capabilityCache{}}
+	status := s.ForkchoiceUpdateStatus()
+	assert.Equal(t, true, status.LastSuccess.IsZero())
+	assert.Equal(t, true, status.LastFailure.IsZero())
+
+	_, _, err := s.ForkchoiceUpdated(context.Background(), nil, nil)
+	require.ErrorContains(t, "nil payload attributer", err)
+	status = s.ForkchoiceUpdateStatus()
+	assert.Equal(t, true, status.LastSuccess.IsZero())
+	assert.Equal(t, false, status.LastFailure.IsZero())
+	assert.ErrorContains(t, "nil payload attributer", status.LastErr)
+
+	s.forkchoiceStatus.record(nil)
+	status = s.ForkchoiceUpdateStatus()
+	assert.Equal(t, false, status.LastSuccess.Before(status.LastFailure))
+
+	s.forkchoiceStatus.record(errors.New("syncing"))
+	assert.ErrorContains(t, "syncing", s.ForkchoiceUpdateStatus().LastErr)
+}
@@ -0,0 +1,72 @@
+package execution
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+)
+
+// gasLimitTargetRefreshPeriod is the period after which an unchanged gas limit target is set again, so that an
+// execution client which restarted in the meantime targets it too.
+const gasLimitTargetRefreshPeriod = 10 * time.Minute
+
+// SetGasLimitTarget sets the gas limit targeted by the execution client when building payloads locally, through the
+// method and the regular JSON-RPC endpoint of the execution client configured with WithGasLimitTarget, as the engine
+// API does not carry the gas limit preference of the proposer. A gas limit of 0, for a proposer without preference,
+// restores the default gas limit once another target was set. Nothing is called when no method or endpoint is
+// configured, or when the execution client recently was set to target the gas limit.
+func (s *Service) SetGasLimitTarget(ctx context.Context, gasLimit uint64) error {
+	if s.cfg.gasLimitMethod == "" || s.cfg.gasLimitEndpoint == "" {
+		return nil
+	}
+	s.gasLimitTarget.Lock()
+	defer s.gasLimitTarget.Unlock()
+	if gasLimit == 0 {
+		if s.gasLimitTarget.value == 0 {
+			return nil
+		}
+		gasLimit = s.cfg.defaultGasLimit
+	}
+	if gasLimit == 0 || gasLimit == s.gasLimitTarget.value && time.Since(s.gasLimitTarget.setAt) < gasLimitTargetRefreshPeriod {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "powchain.engine-api-client.SetGasLimitTarget")
+	defer span.End()
+
+	if s.gasLimitTarget.client == nil {
+		client, err := gethRPC.DialContext(ctx, s.cfg.gasLimitEndpoint)
+		if err != nil {
+			return errors.Wrapf(err, "could not connect to %s", s.cfg.gasLimitEndpoint)
+		}
+		s.gasLimitTarget.client = client
+	}
+	var result interface{}
+	if err := s.gasLimitTarget.client.CallContext(ctx, &result, s.cfg.gasLimitMethod, hexutil.Uint64(gasLimit)); err != nil {
+		return errors.Wrapf(handleRPCError(err), "could not call %s", s.cfg.gasLimitMethod)
+	}
+	s.gasLimitTarget.value = gasLimit
+	s.gasLimitTarget.setAt = time.Now()
+	return nil
+}
+
+// gasLimitTarget is the last gas limit target set on the execution client, through the client of its regular
+// JSON-RPC endpoint.
+type gasLimitTarget struct {
+	sync.Mutex
+	client *gethRPC.Client
+	value  uint64
+	setAt  time.Time
+}
+
+func (t *gasLimitTarget) close() {
+	t.Lock()
+	defer t.Unlock()
+	if t.client != nil {
+		t.client.Close()
+	}
+}
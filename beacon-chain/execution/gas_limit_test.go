@@ -0,0 +1,59 @@
+package execution
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_SetGasLimitTarget(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		defer func() {
+			require.NoError(t, r.Body.Close())
+		}()
+		enc, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := struct {
+			Method string   `json:"method"`
+			Params []string `json:"params"`
+		}{}
+		require.NoError(t, json.Unmarshal(enc, &req))
+		requests = append(requests, req.Method+" "+req.Params[0])
+		resp := map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      1,
+			"result":  true,
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	s := &Service{cfg: &config{gasLimitEndpoint: srv.URL, defaultGasLimit: 30_000_000}}
+	defer s.gasLimitTarget.close()
+	require.NoError(t, s.SetGasLimitTarget(ctx, 36_000_000))
+	assert.Equal(t, 0, len(requests))
+
+	s.cfg.gasLimitMethod = "miner_setGasLimit"
+	// Nothing is restored until a target was set.
+	require.NoError(t, s.SetGasLimitTarget(ctx, 0))
+	require.NoError(t, s.SetGasLimitTarget(ctx, 36_000_000))
+	require.NoError(t, s.SetGasLimitTarget(ctx, 36_000_000))
+	require.NoError(t, s.SetGasLimitTarget(ctx, 0))
+	require.NoError(t, s.SetGasLimitTarget(ctx, 0))
+	assert.DeepEqual(t, []string{"miner_setGasLimit 0x2255100", "miner_setGasLimit 0x1c9c380"}, requests)
+
+	// An unchanged target is set again after the refresh period.
+	s.gasLimitTarget.setAt = time.Now().Add(-gasLimitTargetRefreshPeriod)
+	require.NoError(t, s.SetGasLimitTarget(ctx, 0))
+	assert.Equal(t, 3, len(requests))
+}
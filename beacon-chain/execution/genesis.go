@@ -0,0 +1,49 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// errGenesisExecutionBlockMismatch is returned when the execution client does not agree with the execution payload
+// header of the genesis state, which happens when the execution client was initialized with another genesis.
+var errGenesisExecutionBlockMismatch = errors.New("execution client genesis does not match the genesis state")
+
+// validateGenesisExecutionBlock checks that the execution client knows the block of the execution payload header in
+// the genesis state, for networks starting after the merge, such as devnets and shadow forks, and that the block has
+// the number and timestamp of the header. Genesis states without an execution payload header are not checked.
+func (s *Service) validateGenesisExecutionBlock(ctx context.Context) error {
+	st, err := s.cfg.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis state")
+	}
+	if st == nil || st.IsNil() || st.Version() < version.Bellatrix {
+		return nil
+	}
+	header, err := st.LatestExecutionPayloadHeader()
+	if err != nil {
+		return errors.Wrap(err, "could not get execution payload header of genesis state")
+	}
+	hash := common.BytesToHash(header.BlockHash())
+	if hash == (common.Hash{}) {
+		return nil
+	}
+	info, err := s.HeaderByHash(ctx, hash)
+	if errors.Is(err, ethereum.NotFound) {
+		return errors.Wrapf(errGenesisExecutionBlockMismatch, "execution client does not know block %#x", hash)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "could not get execution block %#x", hash)
+	}
+	if info.Number.Uint64() != header.BlockNumber() || info.Time != header.Timestamp() {
+		return errors.Wrap(errGenesisExecutionBlockMismatch, fmt.Sprintf(
+			"execution block %#x has number %d and timestamp %d, the genesis state has number %d and timestamp %d",
+			hash, info.Number.Uint64(), info.Time, header.BlockNumber(), header.Timestamp()))
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package execution
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	dbutil "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidateGenesisExecutionBlock(t *testing.T) {
+	ctx := context.Background()
+	hash := [32]byte{'a'}
+	st, err := util.NewBeaconStateBellatrix(func(s *ethpb.BeaconStateBellatrix) error {
+		s.LatestExecutionPayloadHeader = &enginev1.ExecutionPayloadHeader{
+			ParentHash:       make([]byte, 32),
+			FeeRecipient:     make([]byte, 20),
+			StateRoot:        make([]byte, 32),
+			ReceiptsRoot:     make([]byte, 32),
+			LogsBloom:        make([]byte, 256),
+			PrevRandao:       make([]byte, 32),
+			BlockNumber:      100,
+			Timestamp:        1000,
+			BaseFeePerGas:    make([]byte, 32),
+			BlockHash:        hash[:],
+			TransactionsRoot: make([]byte, 32),
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	beaconDB := dbutil.SetupDB(t)
+	root := [32]byte{'r'}
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+
+	cli, srv := newMockEngine(t)
+	s := &Service{cfg: &config{beaconDB: beaconDB}, rpcClient: cli}
+	respond := func(result any) {
+		srv.register(BlockByHashMethod, func(msg *jsonrpcMessage, w http.ResponseWriter, _ *http.Request) {
+			mockWriteResult(t, w, msg, result)
+		})
+	}
+
+	respond(map[string]string{"hash": hexutil.Encode(hash[:]), "number": "0x64", "timestamp": "0x3e8"})
+	require.NoError(t, s.validateGenesisExecutionBlock(ctx))
+
+	respond(map[string]string{"hash": hexutil.Encode(hash[:]), "number": "0x64", "timestamp": "0x3e9"})
+	err = s.validateGenesisExecutionBlock(ctx)
+	require.ErrorIs(t, err, errGenesisExecutionBlockMismatch)
+	require.ErrorContains(t, "has number 100 and timestamp 1001, the genesis state has number 100 and timestamp 1000", err)
+
+	respond(nil)
+	err = s.validateGenesisExecutionBlock(ctx)
+	require.ErrorIs(t, err, errGenesisExecutionBlockMismatch)
+	require.ErrorContains(t, "does not know block", err)
+}
+
+func TestValidateGenesisExecutionBlock_PreMergeGenesis(t *testing.T) {
+	ctx := context.Background()
+	st, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	beaconDB := dbutil.SetupDB(t)
+	root := [32]byte{'r'}
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+
+	// The execution client is not called for a genesis state without an execution payload header.
+	s := &Service{cfg: &config{beaconDB: beaconDB}, rpcClient: RPCClientEmpty{}}
+	require.NoError(t, s.validateGenesisExecutionBlock(ctx))
+}
@@ -71,4 +71,8 @@ var (
 		Name: "execution_payload_bodies_count",
 		Help: "The number of requested payload bodies is too large",
 	})
+	nextForkReadyGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "execution_next_fork_ready",
+		Help: "1 if the execution client supports the engine methods of the next fork, or if no fork is close, 0 otherwise",
+	})
 )
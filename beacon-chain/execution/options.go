@@ -5,6 +5,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
@@ -117,6 +118,18 @@ func WithJwtId(jwtId string) Option {
 	}
 }
 
+// WithGasLimitTarget sets the JSON-RPC method, such as miner_setGasLimit, and the regular JSON-RPC endpoint of the
+// execution client called to set the gas limit targeted when building payloads locally, along with the gas limit
+// restored for the proposers without preference.
+func WithGasLimitTarget(method, endpoint string, defaultGasLimit uint64) Option {
+	return func(s *Service) error {
+		s.cfg.gasLimitMethod = method
+		s.cfg.gasLimitEndpoint = endpoint
+		s.cfg.defaultGasLimit = defaultGasLimit
+		return nil
+	}
+}
+
 // WithVerifierWaiter gives the sync package direct access to the verifier waiter.
 func WithVerifierWaiter(v *verification.InitializerWaiter) Option {
 	return func(s *Service) error {
@@ -124,3 +137,12 @@ func WithVerifierWaiter(v *verification.InitializerWaiter) Option {
 		return nil
 	}
 }
+
+// WithClockWaiter gives the service access to the clock, to check the readiness of the execution client for
+// upcoming forks.
+func WithClockWaiter(cw startup.ClockWaiter) Option {
+	return func(s *Service) error {
+		s.clockWaiter = cw
+		return nil
+	}
+}
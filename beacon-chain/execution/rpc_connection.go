@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/network"
 	"github.com/prysmaticlabs/prysm/v5/network/authorization"
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
 )
 
 func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpoint network.Endpoint) error {
@@ -25,6 +26,9 @@ func (s *Service) setupExecutionClientConnections(ctx context.Context, currEndpo
 	// Attach the clients to the service struct.
 	fetcher := ethclient.NewClient(client)
 	s.rpcClient = client
+	if faultinjection.Get().EngineDelay != nil {
+		s.rpcClient = &faultInjectionRPCClient{RPCClient: client}
+	}
 	s.httpLogger = fetcher
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(s.cfg.depositContractAddr, fetcher)
@@ -79,11 +83,9 @@ func (s *Service) pollConnectionStatus(ctx context.Context) {
 			}
 			log.WithField("endpoint", logs.MaskCredentialsLogging(s.cfg.currHttpEndpoint.Url)).Info("Connected to new endpoint")
 
-			c, err := s.ExchangeCapabilities(ctx)
-			if err != nil {
+			if err := s.refreshCapabilities(ctx); err != nil {
 				errorLogger(err, "Could not exchange capabilities with execution client")
 			}
-			s.capabilityCache.save(c)
 
 			return
 		case <-s.ctx.Done():
@@ -110,6 +112,10 @@ func (s *Service) retryExecutionClientConnection(ctx context.Context, err error)
 	}
 	// Reset run error in the event of a successful connection.
 	s.runError = nil
+	// The execution client may have been upgraded while disconnected.
+	if err := s.refreshCapabilities(ctx); err != nil {
+		log.WithError(err).Warn("Could not exchange capabilities with execution client")
+	}
 }
 
 // Initializes an RPC connection with authentication headers.
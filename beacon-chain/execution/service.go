@@ -26,6 +26,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/types"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
@@ -130,6 +131,9 @@ type config struct {
 	headers                 []string
 	finalizedStateAtStartup state.BeaconState
 	jwtId                   string
+	gasLimitMethod          string
+	gasLimitEndpoint        string
+	defaultGasLimit         uint64
 }
 
 // Service fetches important information about the canonical
@@ -160,6 +164,9 @@ type Service struct {
 	verifierWaiter          *verification.InitializerWaiter
 	blobVerifier            verification.NewBlobVerifier
 	capabilityCache         *capabilityCache
+	clockWaiter             startup.ClockWaiter
+	forkchoiceStatus        forkchoiceStatusTracker
+	gasLimitTarget          gasLimitTarget
 }
 
 // NewService sets up a new instance with an ethclient when given a web3 endpoint as a string in the config.
@@ -248,6 +255,7 @@ func (s *Service) Start() {
 	s.pollConnectionStatus(s.ctx)
 
 	go s.run(s.ctx.Done())
+	go s.monitorForkReadiness()
 }
 
 // Stop the web3 service's main event loop and associated goroutines.
@@ -258,6 +266,7 @@ func (s *Service) Stop() error {
 	if s.rpcClient != nil {
 		s.rpcClient.Close()
 	}
+	s.gasLimitTarget.close()
 	return nil
 }
 
@@ -386,9 +395,11 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*ethpb.DepositCo
 	}
 	validDepositsCount.Add(float64(currIndex))
 	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
+	// is more than the current index in state. Containers may
+	// start after index 0 when initialized from a deposit snapshot.
+	offset := uint64(firstDepositIndex(ctrs))
+	if offset+uint64(len(ctrs)) > currIndex {
+		for _, c := range ctrs[max(currIndex, offset)-offset:] {
 			s.cfg.depositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 		}
 	}
@@ -530,6 +541,13 @@ func (s *Service) initPOWService() {
 			s.latestEth1Data.BlockTime = header.Time
 			s.latestEth1DataLock.Unlock()
 
+			if err := s.validateGenesisExecutionBlock(ctx); err != nil {
+				err = errors.Wrap(err, "validateGenesisExecutionBlock")
+				s.retryExecutionClientConnection(ctx, err)
+				log.WithError(err).Error("Execution client is not on the chain of the genesis state, " +
+					"check that it was initialized with the execution genesis of the network and that it is synced")
+				continue
+			}
 			if err := s.processPastLogs(ctx); err != nil {
 				err = errors.Wrap(err, "processPastLogs")
 				s.retryExecutionClientConnection(ctx, err)
@@ -781,15 +799,35 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *ethpb.ET
 	}
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	// Nodes initialized from a deposit snapshot, such as checkpoint synced nodes, do not
+	// hold the deposits covered by the snapshot, so the cache is seeded with it instead.
+	if snapshot := eth1DataInDB.DepositSnapshot; snapshot != nil && snapshot.DepositCount > 0 && firstDepositIndex(ctrs) != 0 {
+		if err := s.cfg.depositCache.InsertFinalizedSnapshot(ctx, snapshot); err != nil {
+			return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+		}
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
 	return nil
 }
 
+// firstDepositIndex returns the lowest deposit index among the containers, or -1 if there are none.
+func firstDepositIndex(ctrs []*ethpb.DepositContainer) int64 {
+	if len(ctrs) == 0 {
+		return -1
+	}
+	first := ctrs[0].Index
+	for _, c := range ctrs[1:] {
+		first = min(first, c.Index)
+	}
+	return first
+}
+
 // Validates that all deposit containers are valid and have their relevant indices
-// in order.
-func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
+// in order. Containers may start after index 0 only when the preceding deposits are
+// covered by the persisted deposit snapshot.
+func validateDepositContainers(ctrs []*ethpb.DepositContainer, snapshot *ethpb.DepositSnapshot) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -800,6 +838,9 @@ func validateDepositContainers(ctrs []*ethpb.DepositContainer) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
 	startIndex := int64(0)
+	if snapshot != nil && ctrs[0].Index > 0 && ctrs[0].Index <= int64(snapshot.DepositCount) { // lint:ignore uintcast -- deposit count will not exceed int64 in your lifetime.
+		startIndex = ctrs[0].Index
+	}
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -824,7 +865,7 @@ func (s *Service) validPowchainData(ctx context.Context) (*ethpb.ETH1ChainData,
 	if genState == nil || genState.IsNil() {
 		return eth1Data, nil
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !validateDepositContainers(eth1Data.DepositContainers, eth1Data.DepositSnapshot) {
 		pbState, err := native.ProtobufBeaconStatePhase0(s.preGenesisState.ToProtoUnsafe())
 		if err != nil {
 			return nil, err
@@ -908,20 +949,20 @@ func newBlobVerifierFromInitializer(ini *verification.Initializer) verification.
 
 type capabilityCache struct {
 	capabilities     map[string]interface{}
+	exchangedAt      time.Time
 	capabilitiesLock sync.RWMutex
 }
 
+// save replaces the cached capabilities with the result of the last capability exchange.
 func (c *capabilityCache) save(cs []string) {
 	c.capabilitiesLock.Lock()
 	defer c.capabilitiesLock.Unlock()
 
-	if c.capabilities == nil {
-		c.capabilities = make(map[string]interface{})
-	}
-
+	c.capabilities = make(map[string]interface{}, len(cs))
 	for _, capability := range cs {
 		c.capabilities[capability] = struct{}{}
 	}
+	c.exchangedAt = time.Now()
 }
 
 func (c *capabilityCache) has(capability string) bool {
@@ -935,3 +976,26 @@ func (c *capabilityCache) has(capability string) bool {
 	_, ok := c.capabilities[capability]
 	return ok
 }
+
+// exchanged returns true once capabilities were exchanged with the execution client.
+func (c *capabilityCache) exchanged() bool {
+	if c == nil {
+		return false
+	}
+	c.capabilitiesLock.RLock()
+	defer c.capabilitiesLock.RUnlock()
+	return len(c.capabilities) > 0
+}
+
+// list returns the sorted capabilities and the time they were exchanged.
+func (c *capabilityCache) list() ([]string, time.Time) {
+	c.capabilitiesLock.RLock()
+	defer c.capabilitiesLock.RUnlock()
+
+	cs := make([]string, 0, len(c.capabilities))
+	for capability := range c.capabilities {
+		cs = append(cs, capability)
+	}
+	sort.Strings(cs)
+	return cs, c.exchangedAt
+}
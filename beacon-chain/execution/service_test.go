@@ -661,6 +661,7 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 	var tt = []struct {
 		name        string
 		ctrsFunc    func() []*ethpb.DepositContainer
+		snapshot    *ethpb.DepositSnapshot
 		expectedRes bool
 	}{
 		{
@@ -706,10 +707,34 @@ func TestService_ValidateDepositContainers(t *testing.T) {
 			},
 			expectedRes: false,
 		},
+		{
+			name: "containers following snapshot",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 5; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 5},
+			expectedRes: true,
+		},
+		{
+			name: "gap between snapshot and containers",
+			ctrsFunc: func() []*ethpb.DepositContainer {
+				ctrs := make([]*ethpb.DepositContainer, 0)
+				for i := 6; i < 10; i++ {
+					ctrs = append(ctrs, &ethpb.DepositContainer{Index: int64(i), Eth1BlockHeight: uint64(i + 10)})
+				}
+				return ctrs
+			},
+			snapshot:    &ethpb.DepositSnapshot{DepositCount: 5},
+			expectedRes: false,
+		},
 	}
 
 	for _, test := range tt {
-		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc()))
+		assert.Equal(t, test.expectedRes, validateDepositContainers(test.ctrsFunc(), test.snapshot), test.name)
 	}
 }
 
@@ -38,6 +38,8 @@ type EngineClient struct {
 	ErrGetPayload               error
 	BlobSidecars                []blocks.VerifiedROBlob
 	ErrorBlobSidecars           error
+	GasLimitTargets             []uint64
+	ErrSetGasLimitTarget        error
 }
 
 // NewPayload --
@@ -55,6 +57,12 @@ func (e *EngineClient) ForkchoiceUpdated(
 	return e.PayloadIDBytes, e.ForkChoiceUpdatedResp, e.ErrForkchoiceUpdated
 }
 
+// SetGasLimitTarget --
+func (e *EngineClient) SetGasLimitTarget(_ context.Context, gasLimit uint64) error {
+	e.GasLimitTargets = append(e.GasLimitTargets, gasLimit)
+	return e.ErrSetGasLimitTarget
+}
+
 // GetPayload --
 func (e *EngineClient) GetPayload(_ context.Context, _ [8]byte, _ primitives.Slot) (*blocks.GetPayloadResponse, error) {
 	return e.GetPayloadResponse, e.ErrGetPayload
@@ -0,0 +1,26 @@
+package finality
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	finalityStalledGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finality_stalled",
+		Help: "1 while the chain has not finalized for longer than the finality stall threshold, 0 otherwise.",
+	})
+	epochsSinceFinalityGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finality_epochs_since_finality",
+		Help: "The number of epochs between the finalized checkpoint and the current epoch.",
+	})
+	inactivityLeakGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finality_inactivity_leak",
+		Help: "1 while the chain is in an inactivity leak, 0 otherwise.",
+	})
+	trackedValidatorsPenaltyGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finality_tracked_validators_inactivity_penalty_gwei",
+		Help: "The estimated inactivity penalty of the tracked validators for the next epoch, in Gwei, " +
+			"if none of them attest to the correct target.",
+	})
+)
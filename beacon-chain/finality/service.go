@@ -0,0 +1,258 @@
+// Package finality defines a service which watches the finalized checkpoint of the chain and reports when
+// finality stalls, along with the estimated inactivity leak impact on the validators tracked by the node.
+package finality
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Config of the finality stall service.
+type Config struct {
+	StateNotifier          statefeed.Notifier
+	HeadFetcher            blockchain.HeadFetcher
+	FinalizationFetcher    blockchain.FinalizationFetcher
+	ClockWaiter            startup.ClockWaiter
+	TrackedValidatorsCache *cache.TrackedValidatorsCache
+	// StallEpochs is the number of epochs without finality after which finality is reported as stalled.
+	StallEpochs primitives.Epoch
+}
+
+// Status is the finality status of the chain as of the last slot.
+type Status struct {
+	Stalled             bool
+	InactivityLeak      bool
+	CurrentEpoch        primitives.Epoch
+	FinalizedEpoch      primitives.Epoch
+	EpochsSinceFinality primitives.Epoch
+	StallEpochs         primitives.Epoch
+	Validators          []ValidatorPenalty
+}
+
+// ValidatorPenalty is the estimated inactivity leak impact on a tracked validator.
+type ValidatorPenalty struct {
+	Index            primitives.ValidatorIndex
+	EffectiveBalance uint64
+	InactivityScore  uint64
+	// EstimatedPenalty is the inactivity penalty in Gwei of the next epoch in which the validator does not
+	// attest to the correct target.
+	EstimatedPenalty uint64
+}
+
+// StatusFetcher returns the finality status of the chain.
+type StatusFetcher interface {
+	FinalityStatus() *Status
+}
+
+// Service updates the finality status of the chain every slot. While finality is stalled, it sends a
+// FinalityStall event every epoch, and one more event once the chain finalizes again.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	lock   sync.RWMutex
+	status *Status
+}
+
+var _ StatusFetcher = (*Service)(nil)
+
+// NewService initializes the finality stall service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start the finality stall service in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the finality stall service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the finality stall service.
+func (*Service) Status() error {
+	return nil
+}
+
+// FinalityStatus returns the finality status of the last slot, or nil if the status was not updated yet.
+func (s *Service) FinalityStatus() *Status {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.status == nil {
+		return nil
+	}
+	status := *s.status
+	status.Validators = append([]ValidatorPenalty(nil), s.status.Validators...)
+	return &status
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not obtain clock, finality stall detection will not run")
+		return
+	}
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if err := s.update(slots.ToEpoch(slot)); err != nil {
+				log.WithError(err).Error("Could not update finality status")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// update computes the finality status for the given epoch, and reports changes of the status.
+func (s *Service) update(current primitives.Epoch) error {
+	cp := s.cfg.FinalizationFetcher.FinalizedCheckpt()
+	if cp == nil {
+		return errors.New("nil finalized checkpoint")
+	}
+	status := &Status{
+		CurrentEpoch:   current,
+		FinalizedEpoch: cp.Epoch,
+		StallEpochs:    s.cfg.StallEpochs,
+	}
+	if current > cp.Epoch {
+		status.EpochsSinceFinality = current - cp.Epoch
+		status.InactivityLeak = helpers.IsInInactivityLeak(current-1, cp.Epoch)
+	}
+	status.Stalled = status.EpochsSinceFinality > s.cfg.StallEpochs
+	vals, err := s.trackedValidatorPenalties()
+	if err != nil {
+		return err
+	}
+	status.Validators = vals
+
+	s.lock.Lock()
+	prev := s.status
+	s.status = status
+	s.lock.Unlock()
+
+	s.reportMetrics(status)
+	if status.Stalled && (prev == nil || !prev.Stalled || prev.EpochsSinceFinality != status.EpochsSinceFinality) {
+		log.WithFields(logrus.Fields{
+			"currentEpoch":        status.CurrentEpoch,
+			"finalizedEpoch":      status.FinalizedEpoch,
+			"epochsSinceFinality": status.EpochsSinceFinality,
+			"inactivityLeak":      status.InactivityLeak,
+		}).Warn("Finality is stalled")
+		s.notify(status)
+	} else if !status.Stalled && prev != nil && prev.Stalled {
+		log.WithField("finalizedEpoch", status.FinalizedEpoch).Info("Finality recovered")
+		s.notify(status)
+	}
+	return nil
+}
+
+// trackedValidatorPenalties estimates the inactivity penalties of the tracked validators from the head state.
+// Inactivity scores only exist since Altair, so nothing is estimated before.
+func (s *Service) trackedValidatorPenalties() ([]ValidatorPenalty, error) {
+	if s.cfg.TrackedValidatorsCache == nil {
+		return nil, nil
+	}
+	tracked := s.cfg.TrackedValidatorsCache.Validators()
+	if len(tracked) == 0 {
+		return nil, nil
+	}
+	st, err := s.cfg.HeadFetcher.HeadStateReadOnly(s.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if st == nil || st.IsNil() || st.Version() < version.Altair {
+		return nil, nil
+	}
+	sp, ok := st.(state.SpecParametersProvider)
+	if !ok {
+		return nil, errors.Errorf("head state of type %T does not provide spec parameters", st)
+	}
+	quotient, err := sp.InactivityPenaltyQuotient()
+	if err != nil {
+		return nil, err
+	}
+	scores, err := st.InactivityScores()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get inactivity scores")
+	}
+	bias := params.BeaconConfig().InactivityScoreBias
+	vals := make([]ValidatorPenalty, 0, len(tracked))
+	for _, t := range tracked {
+		if uint64(t.Index) >= uint64(len(scores)) {
+			continue
+		}
+		v, err := st.ValidatorAtIndexReadOnly(t.Index)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %d", t.Index)
+		}
+		// The inactivity score of a validator missing the target increases by the bias before penalties apply.
+		score := scores[t.Index]
+		vals = append(vals, ValidatorPenalty{
+			Index:            t.Index,
+			EffectiveBalance: v.EffectiveBalance(),
+			InactivityScore:  score,
+			EstimatedPenalty: v.EffectiveBalance() * (score + bias) / (bias * quotient),
+		})
+	}
+	return vals, nil
+}
+
+func (s *Service) reportMetrics(status *Status) {
+	finalityStalledGauge.Set(boolToFloat(status.Stalled))
+	inactivityLeakGauge.Set(boolToFloat(status.InactivityLeak))
+	epochsSinceFinalityGauge.Set(float64(status.EpochsSinceFinality))
+	var penalty uint64
+	for _, v := range status.Validators {
+		penalty += v.EstimatedPenalty
+	}
+	trackedValidatorsPenaltyGauge.Set(float64(penalty))
+}
+
+func (s *Service) notify(status *Status) {
+	if s.cfg.StateNotifier == nil {
+		return
+	}
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.FinalityStall,
+		Data: &statefeed.FinalityStallData{
+			Stalled:             status.Stalled,
+			CurrentEpoch:        status.CurrentEpoch,
+			FinalizedEpoch:      status.FinalizedEpoch,
+			EpochsSinceFinality: status.EpochsSinceFinality,
+			InactivityLeak:      status.InactivityLeak,
+		},
+	})
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
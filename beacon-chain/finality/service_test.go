@@ -0,0 +1,99 @@
+package finality
+
+import (
+	"context"
+	"testing"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestService_Update(t *testing.T) {
+	chain := &mock.ChainService{FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 10, Root: make([]byte, 32)}}
+	notifier := &mock.MockStateNotifier{}
+	events := make(chan *feed.Event, 10)
+	sub := notifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	s := NewService(context.Background(), &Config{
+		StateNotifier:       notifier,
+		HeadFetcher:         chain,
+		FinalizationFetcher: chain,
+		StallEpochs:         4,
+	})
+	assert.Equal(t, (*Status)(nil), s.FinalityStatus())
+
+	// Finality is not stalled, no event is sent.
+	require.NoError(t, s.update(12))
+	status := s.FinalityStatus()
+	assert.Equal(t, false, status.Stalled)
+	assert.Equal(t, primitives.Epoch(2), status.EpochsSinceFinality)
+	assert.Equal(t, 0, len(events))
+
+	// An event is sent once per epoch while finality is stalled.
+	require.NoError(t, s.update(15))
+	require.NoError(t, s.update(15))
+	require.NoError(t, s.update(16))
+	status = s.FinalityStatus()
+	assert.Equal(t, true, status.Stalled)
+	assert.Equal(t, true, status.InactivityLeak)
+	assert.Equal(t, primitives.Epoch(6), status.EpochsSinceFinality)
+	require.Equal(t, 2, len(events))
+	e := <-events
+	assert.Equal(t, feed.EventType(statefeed.FinalityStall), e.Type)
+	data, ok := e.Data.(*statefeed.FinalityStallData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, true, data.Stalled)
+	assert.Equal(t, primitives.Epoch(5), data.EpochsSinceFinality)
+	assert.Equal(t, false, data.InactivityLeak)
+	<-events
+
+	// One more event is sent when finality recovers.
+	chain.FinalizedCheckPoint = &ethpb.Checkpoint{Epoch: 15, Root: make([]byte, 32)}
+	require.NoError(t, s.update(17))
+	require.NoError(t, s.update(17))
+	require.Equal(t, 1, len(events))
+	e = <-events
+	data, ok = e.Data.(*statefeed.FinalityStallData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, false, data.Stalled)
+	assert.Equal(t, primitives.Epoch(15), data.FinalizedEpoch)
+}
+
+func TestService_TrackedValidatorPenalties(t *testing.T) {
+	cfg := params.BeaconConfig()
+	st, err := util.NewBeaconStateBellatrix()
+	require.NoError(t, err)
+	vals := make([]*ethpb.Validator, 4)
+	for i := range vals {
+		vals[i] = &ethpb.Validator{EffectiveBalance: cfg.MaxEffectiveBalance}
+	}
+	require.NoError(t, st.SetValidators(vals))
+	require.NoError(t, st.SetInactivityScores([]uint64{0, 100, 200, 300}))
+	tracked := cache.NewTrackedValidatorsCache()
+	tracked.Set(cache.TrackedValidator{Index: 1, Active: true})
+	tracked.Set(cache.TrackedValidator{Index: 3, Active: true})
+	tracked.Set(cache.TrackedValidator{Index: 10, Active: true})
+	chain := &mock.ChainService{State: st}
+	s := NewService(context.Background(), &Config{
+		HeadFetcher:            chain,
+		TrackedValidatorsCache: tracked,
+	})
+
+	penalties, err := s.trackedValidatorPenalties()
+	require.NoError(t, err)
+	require.Equal(t, 2, len(penalties))
+	denominator := cfg.InactivityScoreBias * cfg.InactivityPenaltyQuotientBellatrix
+	assert.Equal(t, primitives.ValidatorIndex(1), penalties[0].Index)
+	assert.Equal(t, uint64(100), penalties[0].InactivityScore)
+	assert.Equal(t, cfg.MaxEffectiveBalance*(100+cfg.InactivityScoreBias)/denominator, penalties[0].EstimatedPenalty)
+	assert.Equal(t, primitives.ValidatorIndex(3), penalties[1].Index)
+	assert.Equal(t, cfg.MaxEffectiveBalance*(300+cfg.InactivityScoreBias)/denominator, penalties[1].EstimatedPenalty)
+}
@@ -0,0 +1,5 @@
+package forkreadiness
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "fork-readiness")
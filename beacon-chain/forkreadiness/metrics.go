@@ -0,0 +1,21 @@
+package forkreadiness
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	epochsUntilForkGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_readiness_epochs_until_fork",
+		Help: "The number of epochs until the next scheduled fork, -1 when no fork is scheduled.",
+	})
+	peersOnNextForkGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_readiness_peers_on_next_fork",
+		Help: "The number of connected peers whose ENR announces the same next fork version and epoch as the node.",
+	})
+	compatiblePeersPercentGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "fork_readiness_compatible_peers_percent",
+		Help: "The percentage of connected peers whose ENR fork ID is compatible with the fork ID of the node.",
+	})
+)
@@ -0,0 +1,288 @@
+// Package forkreadiness defines a service which summarizes the readiness of the node for the next scheduled
+// fork: the support of the engine methods of the fork by the execution client, the chain config and blob
+// parameters of the node, and how many peers announce the same fork schedule.
+package forkreadiness
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// logEpochs is the number of epochs before the next fork from which the readiness report is logged every epoch.
+const logEpochs = primitives.Epoch(256)
+
+// Config of the fork readiness service.
+type Config struct {
+	ClockWaiter               startup.ClockWaiter
+	EngineCapabilitiesFetcher execution.EngineCapabilitiesFetcher
+	PeersProvider             p2p.PeersProvider
+}
+
+// Report is the readiness of the node for the next scheduled fork.
+type Report struct {
+	CurrentEpoch       primitives.Epoch
+	CurrentForkVersion [4]byte
+	CurrentForkDigest  [4]byte
+	// NextFork is nil when no fork is scheduled.
+	NextFork *NextFork
+	// ConfigDigest is the SHA-256 of the chain config of the node in the YAML format of the consensus specs, so
+	// that the config of nodes can be compared ahead of a fork.
+	ConfigDigest [32]byte
+	// Engine is the readiness of the execution client for the next fork, nil when no fork is scheduled or the
+	// node has no execution client.
+	Engine *execution.ForkReadiness
+	// Blobs are the blob parameters in effect from the next fork on, nil when no fork is scheduled or the next
+	// fork is before Deneb.
+	Blobs           *BlobParameters
+	ConnectedPeers  int
+	PeersWithForkID int
+	// CompatiblePeers is the number of peers whose ENR fork ID has the current fork digest of the node.
+	CompatiblePeers int
+	// CompatiblePeersPercent is the percentage of peers with a fork ID in their ENR which are compatible.
+	CompatiblePeersPercent float64
+	// PeersOnNextFork is the number of peers whose ENR fork ID announces the next fork version and epoch
+	// of the node.
+	PeersOnNextFork int
+}
+
+// NextFork describes the next scheduled fork.
+type NextFork struct {
+	Name            string
+	Version         [4]byte
+	Epoch           primitives.Epoch
+	Digest          [4]byte
+	EpochsUntilFork primitives.Epoch
+}
+
+// BlobParameters are the blob parameters of a fork.
+type BlobParameters struct {
+	MaxBlobsPerBlock                 uint64
+	BlobSidecarSubnetCount           uint64
+	MaxRequestBlobSidecars           uint64
+	MinEpochsForBlobSidecarsRequests primitives.Epoch
+}
+
+// ReportFetcher returns the fork readiness report of the node.
+type ReportFetcher interface {
+	ForkReadiness() *Report
+}
+
+// Service logs the readiness of the node for the next fork every epoch once the fork is close.
+type Service struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	cfg    *Config
+	lock   sync.RWMutex
+	clock  *startup.Clock
+}
+
+var _ ReportFetcher = (*Service)(nil)
+
+// NewService initializes the fork readiness service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start the fork readiness service in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the fork readiness service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the fork readiness service.
+func (*Service) Status() error {
+	return nil
+}
+
+// ForkReadiness returns the fork readiness report of the current epoch, or nil until genesis is known.
+func (s *Service) ForkReadiness() *Report {
+	s.lock.RLock()
+	clock := s.clock
+	s.lock.RUnlock()
+	if clock == nil {
+		return nil
+	}
+	r, err := s.report(slots.ToEpoch(clock.CurrentSlot()), clock.GenesisValidatorsRoot())
+	if err != nil {
+		log.WithError(err).Error("Could not compute fork readiness report")
+		return nil
+	}
+	return r
+}
+
+func (s *Service) run() {
+	clock, err := s.cfg.ClockWaiter.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not obtain clock, fork readiness will not be reported")
+		return
+	}
+	s.lock.Lock()
+	s.clock = clock
+	s.lock.Unlock()
+
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if !slots.IsEpochStart(slot) {
+				continue
+			}
+			r, err := s.report(slots.ToEpoch(slot), clock.GenesisValidatorsRoot())
+			if err != nil {
+				log.WithError(err).Error("Could not compute fork readiness report")
+				continue
+			}
+			reportMetrics(r)
+			logReport(r)
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// report computes the fork readiness report for the given epoch.
+func (s *Service) report(current primitives.Epoch, genesisValidatorsRoot [32]byte) (*Report, error) {
+	cfg := params.BeaconConfig()
+	fork, err := forks.Fork(current)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := signing.ComputeForkDigest(fork.CurrentVersion, genesisValidatorsRoot[:])
+	if err != nil {
+		return nil, err
+	}
+	nextVersion, nextEpoch, err := forks.NextForkData(current)
+	if err != nil {
+		return nil, err
+	}
+	r := &Report{
+		CurrentEpoch:      current,
+		CurrentForkDigest: digest,
+		ConfigDigest:      sha256.Sum256(params.ConfigToYaml(cfg)),
+	}
+	copy(r.CurrentForkVersion[:], fork.CurrentVersion)
+	if nextEpoch != cfg.FarFutureEpoch {
+		nextDigest, err := signing.ComputeForkDigest(nextVersion[:], genesisValidatorsRoot[:])
+		if err != nil {
+			return nil, err
+		}
+		r.NextFork = &NextFork{
+			Name:            cfg.ForkVersionNames[nextVersion],
+			Version:         nextVersion,
+			Epoch:           nextEpoch,
+			Digest:          nextDigest,
+			EpochsUntilFork: nextEpoch - current,
+		}
+		if s.cfg.EngineCapabilitiesFetcher != nil {
+			r.Engine = s.cfg.EngineCapabilitiesFetcher.EngineCapabilities(current).Next
+		}
+		if nextEpoch >= cfg.DenebForkEpoch {
+			r.Blobs = &BlobParameters{
+				MaxBlobsPerBlock:                 fieldparams.MaxBlobsPerBlock,
+				BlobSidecarSubnetCount:           cfg.BlobsidecarSubnetCount,
+				MaxRequestBlobSidecars:           cfg.MaxRequestBlobSidecars,
+				MinEpochsForBlobSidecarsRequests: cfg.MinEpochsForBlobsSidecarsRequest,
+			}
+		}
+	}
+	s.countPeers(r, nextVersion, nextEpoch)
+	return r, nil
+}
+
+// countPeers counts the connected peers whose ENR fork ID is compatible with the fork ID of the node.
+func (s *Service) countPeers(r *Report, nextVersion [4]byte, nextEpoch primitives.Epoch) {
+	if s.cfg.PeersProvider == nil {
+		return
+	}
+	status := s.cfg.PeersProvider.Peers()
+	connected := status.Connected()
+	r.ConnectedPeers = len(connected)
+	for _, pid := range connected {
+		record, err := status.ENR(pid)
+		if err != nil || record == nil {
+			continue
+		}
+		forkID, err := p2p.ForkEntry(record)
+		if err != nil {
+			continue
+		}
+		r.PeersWithForkID++
+		if bytes.Equal(forkID.CurrentForkDigest, r.CurrentForkDigest[:]) {
+			r.CompatiblePeers++
+		}
+		if bytes.Equal(forkID.NextForkVersion, nextVersion[:]) && forkID.NextForkEpoch == nextEpoch {
+			r.PeersOnNextFork++
+		}
+	}
+	if r.PeersWithForkID > 0 {
+		r.CompatiblePeersPercent = float64(r.CompatiblePeers) * 100 / float64(r.PeersWithForkID)
+	}
+}
+
+func reportMetrics(r *Report) {
+	if r.NextFork == nil {
+		epochsUntilForkGauge.Set(-1)
+	} else {
+		epochsUntilForkGauge.Set(float64(r.NextFork.EpochsUntilFork))
+	}
+	peersOnNextForkGauge.Set(float64(r.PeersOnNextFork))
+	compatiblePeersPercentGauge.Set(r.CompatiblePeersPercent)
+}
+
+// logReport logs the report every epoch once the next fork is less than logEpochs away, as a warning when
+// the execution client is missing engine methods of the fork.
+func logReport(r *Report) {
+	if r.NextFork == nil || r.NextFork.EpochsUntilFork > logEpochs {
+		return
+	}
+	fields := logrus.Fields{
+		"fork":                   r.NextFork.Name,
+		"forkEpoch":              r.NextFork.Epoch,
+		"epochsUntilFork":        r.NextFork.EpochsUntilFork,
+		"forkDigest":             fmt.Sprintf("%#x", r.NextFork.Digest),
+		"configDigest":           fmt.Sprintf("%#x", r.ConfigDigest),
+		"peersOnNextFork":        r.PeersOnNextFork,
+		"connectedPeers":         r.ConnectedPeers,
+		"compatiblePeersPercent": r.CompatiblePeersPercent,
+	}
+	if r.Blobs != nil {
+		fields["maxBlobsPerBlock"] = r.Blobs.MaxBlobsPerBlock
+	}
+	if r.Engine == nil {
+		log.WithFields(fields).Info("Fork readiness")
+		return
+	}
+	fields["engineReady"] = r.Engine.Ready
+	if !r.Engine.Ready {
+		fields["missingEngineMethods"] = r.Engine.MissingMethods
+		log.WithFields(fields).Warn("Fork readiness: execution client is not ready for the next fork")
+		return
+	}
+	log.WithFields(fields).Info("Fork readiness")
+}
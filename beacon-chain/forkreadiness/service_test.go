@@ -0,0 +1,105 @@
+package forkreadiness
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockEngineCapabilitiesFetcher struct {
+	next *execution.ForkReadiness
+}
+
+func (m *mockEngineCapabilitiesFetcher) EngineCapabilities(primitives.Epoch) *execution.EngineCapabilities {
+	return &execution.EngineCapabilities{Next: m.next}
+}
+
+func forkIDRecord(t *testing.T, digest [4]byte, nextVersion []byte, nextEpoch primitives.Epoch) *enr.Record {
+	enc, err := (&ethpb.ENRForkID{
+		CurrentForkDigest: digest[:],
+		NextForkVersion:   nextVersion,
+		NextForkEpoch:     nextEpoch,
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	record := &enr.Record{}
+	record.Set(enr.WithEntry(params.BeaconNetworkConfig().ETH2Key, enc))
+	return record
+}
+
+func TestService_Report(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 10
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	gvr := [32]byte{'a'}
+	capellaDigest, err := signing.ComputeForkDigest(cfg.CapellaForkVersion, gvr[:])
+	require.NoError(t, err)
+	denebDigest, err := signing.ComputeForkDigest(cfg.DenebForkVersion, gvr[:])
+	require.NoError(t, err)
+
+	provider := &mockp2p.MockPeersProvider{}
+	provider.ClearPeers()
+	records := []*enr.Record{
+		forkIDRecord(t, capellaDigest, cfg.DenebForkVersion, 10),
+		forkIDRecord(t, capellaDigest, cfg.DenebForkVersion, 12),
+		forkIDRecord(t, [4]byte{'b'}, cfg.DenebForkVersion, 10),
+		nil,
+	}
+	for i, record := range records {
+		pid := peer.ID(rune('a' + i))
+		provider.Peers().Add(record, pid, nil, network.DirOutbound)
+		provider.Peers().SetConnectionState(pid, peers.PeerConnected)
+	}
+	engine := &execution.ForkReadiness{Fork: version.Deneb, Epoch: 10, MissingMethods: []string{execution.NewPayloadMethodV3}}
+	s := NewService(context.Background(), &Config{
+		EngineCapabilitiesFetcher: &mockEngineCapabilitiesFetcher{next: engine},
+		PeersProvider:             provider,
+	})
+
+	r, err := s.report(5, gvr)
+	require.NoError(t, err)
+	assert.Equal(t, capellaDigest, r.CurrentForkDigest)
+	assert.Equal(t, bytesutil.ToBytes4(cfg.CapellaForkVersion), r.CurrentForkVersion)
+	require.NotNil(t, r.NextFork)
+	assert.Equal(t, "deneb", r.NextFork.Name)
+	assert.Equal(t, primitives.Epoch(10), r.NextFork.Epoch)
+	assert.Equal(t, primitives.Epoch(5), r.NextFork.EpochsUntilFork)
+	assert.Equal(t, denebDigest, r.NextFork.Digest)
+	assert.Equal(t, engine, r.Engine)
+	require.NotNil(t, r.Blobs)
+	assert.Equal(t, cfg.MaxRequestBlobSidecars, r.Blobs.MaxRequestBlobSidecars)
+	assert.Equal(t, 4, r.ConnectedPeers)
+	assert.Equal(t, 3, r.PeersWithForkID)
+	assert.Equal(t, 2, r.CompatiblePeers)
+	assert.Equal(t, 2, r.PeersOnNextFork)
+	assert.Equal(t, float64(200)/3, r.CompatiblePeersPercent)
+
+	// Electra is not scheduled, so there is no next fork after Deneb.
+	r, err = s.report(20, gvr)
+	require.NoError(t, err)
+	assert.Equal(t, denebDigest, r.CurrentForkDigest)
+	assert.Equal(t, (*NextFork)(nil), r.NextFork)
+	assert.Equal(t, (*execution.ForkReadiness)(nil), r.Engine)
+	assert.Equal(t, (*BlobParameters)(nil), r.Blobs)
+	assert.Equal(t, 0, r.CompatiblePeers)
+}
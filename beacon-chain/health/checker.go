@@ -0,0 +1,170 @@
+// Package health checks the dependencies of the beacon node for the liveness and readiness probes of orchestrators
+// such as Kubernetes. Liveness fails when the node must be restarted, readiness fails while the node cannot serve
+// its validators, and both report the failing checks with machine-readable reasons.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// checkTimeout bounds the time spent by a check, a check which times out fails.
+const checkTimeout = 5 * time.Second
+
+// Status of a check or of a probe.
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Reasons of failed checks.
+const (
+	ReasonDBNotWritable    = "db_not_writable"
+	ReasonExecutionOffline = "execution_client_offline"
+	ReasonSyncing          = "syncing"
+	ReasonOptimistic       = "optimistic"
+	ReasonNotEnoughPeers   = "not_enough_peers"
+	ReasonTimeout          = "timeout"
+)
+
+// Failure of a check.
+type Failure struct {
+	// Reason is a machine-readable reason of the failure, one of the Reason constants.
+	Reason  string
+	Message string
+}
+
+// CheckFunc checks a dependency, and returns nil when it is healthy.
+type CheckFunc func(ctx context.Context) *Failure
+
+// Result of a check.
+type Result struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// Response of a probe.
+type Response struct {
+	Status string    `json:"status"`
+	Checks []*Result `json:"checks"`
+}
+
+type namedCheck struct {
+	name     string
+	check    CheckFunc
+	liveness bool
+}
+
+// Checker runs the checks of the liveness and readiness probes.
+type Checker struct {
+	lock   sync.RWMutex
+	checks []namedCheck
+}
+
+// NewChecker returns a checker without checks, whose probes succeed.
+func NewChecker() *Checker {
+	return &Checker{}
+}
+
+// AddLivenessCheck adds a check to both probes.
+func (c *Checker) AddLivenessCheck(name string, check CheckFunc) {
+	c.add(namedCheck{name: name, check: check, liveness: true})
+}
+
+// AddReadinessCheck adds a check to the readiness probe.
+func (c *Checker) AddReadinessCheck(name string, check CheckFunc) {
+	c.add(namedCheck{name: name, check: check})
+}
+
+func (c *Checker) add(check namedCheck) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.checks = append(c.checks, check)
+}
+
+// Liveness runs the liveness checks.
+func (c *Checker) Liveness(ctx context.Context) *Response {
+	return c.run(ctx, true)
+}
+
+// Readiness runs all checks.
+func (c *Checker) Readiness(ctx context.Context) *Response {
+	return c.run(ctx, false)
+}
+
+// LivenessHandler serves the liveness probe, with the status 503 when it fails.
+func (c *Checker) LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, c.Liveness(r.Context()))
+}
+
+// ReadinessHandler serves the readiness probe, with the status 503 when it fails.
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	writeResponse(w, c.Readiness(r.Context()))
+}
+
+// run runs the checks concurrently, so that a slow dependency does not delay the others.
+func (c *Checker) run(ctx context.Context, livenessOnly bool) *Response {
+	c.lock.RLock()
+	checks := make([]namedCheck, 0, len(c.checks))
+	for _, check := range c.checks {
+		if check.liveness || !livenessOnly {
+			checks = append(checks, check)
+		}
+	}
+	c.lock.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	results := make([]*Result, len(checks))
+	var wg sync.WaitGroup
+	for i, check := range checks {
+		wg.Add(1)
+		go func(i int, check namedCheck) {
+			defer wg.Done()
+			results[i] = runCheck(ctx, check)
+		}(i, check)
+	}
+	wg.Wait()
+
+	resp := &Response{Status: StatusOK, Checks: results}
+	for _, r := range results {
+		if r.Status != StatusOK {
+			resp.Status = StatusFail
+		}
+	}
+	return resp
+}
+
+func runCheck(ctx context.Context, check namedCheck) *Result {
+	done := make(chan *Failure, 1)
+	go func() {
+		done <- check.check(ctx)
+	}()
+	var failure *Failure
+	select {
+	case failure = <-done:
+	case <-ctx.Done():
+		failure = &Failure{Reason: ReasonTimeout, Message: "check did not complete in time"}
+	}
+	if failure == nil {
+		return &Result{Name: check.name, Status: StatusOK}
+	}
+	return &Result{Name: check.name, Status: StatusFail, Reason: failure.Reason, Message: failure.Message}
+}
+
+func writeResponse(w http.ResponseWriter, resp *Response) {
+	w.Header().Set("Content-Type", "application/json")
+	if resp.Status != StatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Error("Could not write health response")
+	}
+}
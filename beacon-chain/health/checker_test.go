@@ -0,0 +1,158 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockDB struct {
+	err    error
+	writes int
+}
+
+func (m *mockDB) CheckWritable(context.Context) error {
+	m.writes++
+	return m.err
+}
+
+type mockExecution struct {
+	connected bool
+}
+
+func (*mockExecution) GenesisExecutionChainInfo() (uint64, *big.Int) {
+	return 0, nil
+}
+
+func (m *mockExecution) ExecutionClientConnected() bool {
+	return m.connected
+}
+
+func (*mockExecution) ExecutionClientEndpoint() string {
+	return ""
+}
+
+func (m *mockExecution) ExecutionClientConnectionErr() error {
+	if m.connected {
+		return nil
+	}
+	return errors.New("connection refused")
+}
+
+func newChecker(db *mockDB, connected, syncing, optimistic bool, minPeers int) *Checker {
+	c := NewChecker()
+	c.AddLivenessCheck("db", DBCheck(db))
+	c.AddReadinessCheck("execution", ExecutionCheck(&mockExecution{connected: connected}))
+	c.AddReadinessCheck("sync", SyncCheck(&mockSync.Sync{IsSyncing: syncing}, &mock.ChainService{Optimistic: optimistic}))
+	c.AddReadinessCheck("peers", PeersCheck(&p2ptest.MockPeersProvider{}, minPeers))
+	return c
+}
+
+func serve(t *testing.T, handler http.HandlerFunc) (int, *Response) {
+	writer := httptest.NewRecorder()
+	handler(writer, httptest.NewRequest(http.MethodGet, "http://example.com", nil))
+	resp := &Response{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	return writer.Code, resp
+}
+
+func reasons(resp *Response) map[string]string {
+	r := make(map[string]string)
+	for _, c := range resp.Checks {
+		if c.Status != StatusOK {
+			r[c.Name] = c.Reason
+		}
+	}
+	return r
+}
+
+func TestChecker_Ready(t *testing.T) {
+	c := newChecker(&mockDB{}, true, false, false, 2)
+	code, resp := serve(t, c.ReadinessHandler)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, StatusOK, resp.Status)
+	assert.Equal(t, 4, len(resp.Checks))
+}
+
+func TestChecker_NotReady(t *testing.T) {
+	c := newChecker(&mockDB{}, false, true, false, 3)
+	code, resp := serve(t, c.ReadinessHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	assert.Equal(t, StatusFail, resp.Status)
+	assert.DeepEqual(t, map[string]string{
+		"execution": ReasonExecutionOffline,
+		"sync":      ReasonSyncing,
+		"peers":     ReasonNotEnoughPeers,
+	}, reasons(resp))
+
+	// Readiness failures do not fail liveness.
+	code, resp = serve(t, c.LivenessHandler)
+	assert.Equal(t, http.StatusOK, code)
+	require.Equal(t, 1, len(resp.Checks))
+	assert.Equal(t, "db", resp.Checks[0].Name)
+}
+
+func TestChecker_Optimistic(t *testing.T) {
+	c := newChecker(&mockDB{}, true, false, true, 0)
+	_, resp := serve(t, c.ReadinessHandler)
+	assert.DeepEqual(t, map[string]string{"sync": ReasonOptimistic}, reasons(resp))
+}
+
+func TestChecker_DBNotWritable(t *testing.T) {
+	c := newChecker(&mockDB{err: errors.New("read-only file system")}, true, false, false, 0)
+	code, resp := serve(t, c.LivenessHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, 1, len(resp.Checks))
+	assert.Equal(t, ReasonDBNotWritable, resp.Checks[0].Reason)
+	assert.Equal(t, "read-only file system", resp.Checks[0].Message)
+
+	code, _ = serve(t, c.ReadinessHandler)
+	assert.Equal(t, http.StatusServiceUnavailable, code)
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	c := NewChecker()
+	block := make(chan struct{})
+	defer close(block)
+	c.AddReadinessCheck("stuck", func(context.Context) *Failure {
+		<-block
+		return nil
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	resp := c.Readiness(ctx)
+	assert.Equal(t, StatusFail, resp.Status)
+	assert.Equal(t, ReasonTimeout, resp.Checks[0].Reason)
+}
+
+func TestDBCheck_Cached(t *testing.T) {
+	db := &mockDB{}
+	check := DBCheck(db)
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, (*Failure)(nil), check(context.Background()))
+	}
+	assert.Equal(t, 1, db.writes)
+
+	var calls int
+	check = cachedCheck(func(context.Context) *Failure {
+		calls++
+		return &Failure{Reason: ReasonDBNotWritable}
+	}, 20*time.Millisecond)
+	assert.Equal(t, ReasonDBNotWritable, check(context.Background()).Reason)
+	assert.Equal(t, ReasonDBNotWritable, check(context.Background()).Reason)
+	assert.Equal(t, 1, calls)
+	time.Sleep(30 * time.Millisecond)
+	check(context.Background())
+	assert.Equal(t, 2, calls)
+}
@@ -0,0 +1,91 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	beaconsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
+)
+
+// WritableDB is a database whose writability can be checked.
+type WritableDB interface {
+	CheckWritable(ctx context.Context) error
+}
+
+// dbCheckInterval is how long the result of the database check is reused, so that frequent probes do not each
+// write to the database.
+const dbCheckInterval = 30 * time.Second
+
+// DBCheck fails when the database does not accept writes. The database is written at most once per dbCheckInterval.
+func DBCheck(db WritableDB) CheckFunc {
+	return cachedCheck(func(ctx context.Context) *Failure {
+		if err := db.CheckWritable(ctx); err != nil {
+			return &Failure{Reason: ReasonDBNotWritable, Message: err.Error()}
+		}
+		return nil
+	}, dbCheckInterval)
+}
+
+// cachedCheck reuses the result of a check for the given duration. Concurrent probes wait for the check in flight
+// rather than running it again.
+func cachedCheck(check CheckFunc, ttl time.Duration) CheckFunc {
+	var lock sync.Mutex
+	var last *Failure
+	var checked time.Time
+	return func(ctx context.Context) *Failure {
+		lock.Lock()
+		defer lock.Unlock()
+		if !checked.IsZero() && time.Since(checked) < ttl {
+			return last
+		}
+		last = check(ctx)
+		checked = time.Now()
+		return last
+	}
+}
+
+// ExecutionCheck fails when the execution client is not connected.
+func ExecutionCheck(fetcher execution.ChainInfoFetcher) CheckFunc {
+	return func(context.Context) *Failure {
+		if !fetcher.ExecutionClientConnected() {
+			msg := "execution client is not connected"
+			if err := fetcher.ExecutionClientConnectionErr(); err != nil {
+				msg = err.Error()
+			}
+			return &Failure{Reason: ReasonExecutionOffline, Message: msg}
+		}
+		return nil
+	}
+}
+
+// SyncCheck fails while the node syncs, or while its head is optimistic.
+func SyncCheck(syncChecker beaconsync.Checker, optimistic blockchain.OptimisticModeFetcher) CheckFunc {
+	return func(ctx context.Context) *Failure {
+		if syncChecker.Syncing() {
+			return &Failure{Reason: ReasonSyncing, Message: "node is syncing"}
+		}
+		isOptimistic, err := optimistic.IsOptimistic(ctx)
+		if err != nil {
+			return &Failure{Reason: ReasonOptimistic, Message: err.Error()}
+		}
+		if isOptimistic {
+			return &Failure{Reason: ReasonOptimistic, Message: "head is optimistic, the execution client has not validated it"}
+		}
+		return nil
+	}
+}
+
+// PeersCheck fails when the node has less connected peers than the minimum.
+func PeersCheck(peers p2p.PeersProvider, minPeers int) CheckFunc {
+	return func(context.Context) *Failure {
+		if n := len(peers.Peers().Connected()); n < minPeers {
+			return &Failure{Reason: ReasonNotEnoughPeers, Message: fmt.Sprintf("%d connected peers, expected at least %d", n, minPeers)}
+		}
+		return nil
+	}
+}
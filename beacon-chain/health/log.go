@@ -0,0 +1,5 @@
+package health
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "health")
@@ -90,4 +90,28 @@ var (
 			"validator_index",
 		},
 	)
+	// syncCommitteeMissedCounter used to track sync committee messages
+	// which were not included in the SyncAggregate
+	syncCommitteeMissedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "monitor",
+			Name:      "sync_committee_missed_total",
+			Help:      "Number of Sync committee messages not included in the SyncAggregate",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
+	// syncCommitteeSuccessRateGauge used to track the share of sync committee
+	// messages included in the current sync committee period
+	syncCommitteeSuccessRateGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "monitor",
+			Name:      "sync_committee_success_rate",
+			Help:      "Share of Sync committee messages included in the current sync committee period",
+		},
+		[]string{
+			"validator_index",
+		},
+	)
 )
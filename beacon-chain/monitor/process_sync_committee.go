@@ -64,6 +64,7 @@ func (s *Service) processSyncAggregate(state state.BeaconState, blk interfaces.R
 			aggPerf := s.aggregatedPerformance[validatorIdx]
 			aggPerf.totalSyncCommitteeContributions += uint64(contrib)
 			s.aggregatedPerformance[validatorIdx] = aggPerf
+			s.updateSyncCommitteePerformance(validatorIdx, blk.Slot(), uint64(len(committeeIndices)), uint64(contrib))
 
 			syncCommitteeContributionCounter.WithLabelValues(
 				fmt.Sprintf("%d", validatorIdx)).Add(float64(contrib))
@@ -56,4 +56,8 @@ func TestProcessSyncAggregate(t *testing.T) {
 	require.LogsContain(t, hook, "\"Sync committee contribution included\" balanceChange=0 contribCount=1 expectedContribCount=4 newBalance=32000000000 prefix=monitor validatorIndex=1")
 	require.LogsContain(t, hook, "\"Sync committee contribution included\" balanceChange=100000000 contribCount=2 expectedContribCount=2 newBalance=32000000000 prefix=monitor validatorIndex=12")
 	require.LogsDoNotContain(t, hook, "validatorIndex=2")
+	require.DeepEqual(t, []SyncCommitteePerformance{
+		{ValidatorIndex: 1, Expected: 4, Included: 1, LastMissedSlot: 2},
+		{ValidatorIndex: 12, Expected: 2, Included: 2},
+	}, s.SyncCommitteePerformance())
 }
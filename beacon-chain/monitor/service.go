@@ -71,7 +71,7 @@ type Service struct {
 	isLogging bool
 
 	// Locks access to TrackedValidators, latestPerformance, aggregatedPerformance,
-	// trackedSyncedCommitteeIndices and lastSyncedEpoch
+	// trackedSyncedCommitteeIndices, syncCommitteePerformance and lastSyncedEpoch
 	sync.RWMutex
 
 	TrackedValidators           map[primitives.ValidatorIndex]bool
@@ -79,6 +79,7 @@ type Service struct {
 	aggregatedPerformance       map[primitives.ValidatorIndex]ValidatorAggregatedPerformance
 	attestationStats            map[primitives.ValidatorIndex]*AttestationStats
 	trackedSyncCommitteeIndices map[primitives.ValidatorIndex][]primitives.CommitteeIndex
+	syncCommitteePerformance    map[primitives.ValidatorIndex][]SyncCommitteePerformance
 	lastSyncedEpoch             primitives.Epoch
 }
 
@@ -94,6 +95,7 @@ func NewService(ctx context.Context, config *ValidatorMonitorConfig, tracked []p
 		aggregatedPerformance:       make(map[primitives.ValidatorIndex]ValidatorAggregatedPerformance),
 		attestationStats:            make(map[primitives.ValidatorIndex]*AttestationStats),
 		trackedSyncCommitteeIndices: make(map[primitives.ValidatorIndex][]primitives.CommitteeIndex),
+		syncCommitteePerformance:    make(map[primitives.ValidatorIndex][]SyncCommitteePerformance),
 		isLogging:                   false,
 	}
 	for _, idx := range tracked {
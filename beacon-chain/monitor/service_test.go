@@ -116,6 +116,7 @@ func setupService(t *testing.T) *Service {
 		latestPerformance:           latestPerformance,
 		aggregatedPerformance:       aggregatedPerformance,
 		trackedSyncCommitteeIndices: trackedSyncCommitteeIndices,
+		syncCommitteePerformance:    make(map[primitives.ValidatorIndex][]SyncCommitteePerformance),
 		attestationStats:            attestationStats,
 		lastSyncedEpoch:             0,
 	}
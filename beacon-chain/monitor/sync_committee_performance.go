@@ -0,0 +1,81 @@
+package monitor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// syncCommitteePerformancePeriods is the number of sync committee periods the performance is kept for,
+// the current one and the previous one.
+const syncCommitteePerformancePeriods = 2
+
+// SyncCommitteePerformance is the performance of a tracked validator in a sync committee period. Its
+// messages are expected once per slot for each of its positions in the committee, and are included when
+// their bits are set in the SyncAggregate of the next block.
+type SyncCommitteePerformance struct {
+	ValidatorIndex primitives.ValidatorIndex
+	Period         uint64
+	Expected       uint64
+	Included       uint64
+	// LastMissedSlot is the slot of the last block whose SyncAggregate did not include all the messages of
+	// the validator. It is zero when no message was missed.
+	LastMissedSlot primitives.Slot
+}
+
+// SuccessRate returns the share of the expected sync committee messages which were included.
+func (p SyncCommitteePerformance) SuccessRate() float64 {
+	if p.Expected == 0 {
+		return 0
+	}
+	return float64(p.Included) / float64(p.Expected)
+}
+
+// SyncCommitteePerformanceFetcher returns the sync committee performance of the tracked validators.
+type SyncCommitteePerformanceFetcher interface {
+	SyncCommitteePerformance() []SyncCommitteePerformance
+}
+
+var _ SyncCommitteePerformanceFetcher = (*Service)(nil)
+
+// SyncCommitteePerformance returns the sync committee performance of the tracked validators in the
+// current and previous periods, sorted by validator index and then by period.
+func (s *Service) SyncCommitteePerformance() []SyncCommitteePerformance {
+	s.RLock()
+	defer s.RUnlock()
+	perf := make([]SyncCommitteePerformance, 0, len(s.syncCommitteePerformance))
+	for _, p := range s.syncCommitteePerformance {
+		perf = append(perf, p...)
+	}
+	sort.Slice(perf, func(i, j int) bool {
+		if perf[i].ValidatorIndex != perf[j].ValidatorIndex {
+			return perf[i].ValidatorIndex < perf[j].ValidatorIndex
+		}
+		return perf[i].Period < perf[j].Period
+	})
+	return perf
+}
+
+// updateSyncCommitteePerformance records the inclusion of the messages of a tracked validator in the
+// SyncAggregate of the block at the slot. It assumes the caller holds the service Lock.
+func (s *Service) updateSyncCommitteePerformance(idx primitives.ValidatorIndex, slot primitives.Slot, expected, included uint64) {
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+	perf := s.syncCommitteePerformance[idx]
+	if len(perf) == 0 || perf[len(perf)-1].Period != period {
+		perf = append(perf, SyncCommitteePerformance{ValidatorIndex: idx, Period: period})
+		if len(perf) > syncCommitteePerformancePeriods {
+			perf = perf[len(perf)-syncCommitteePerformancePeriods:]
+		}
+	}
+	p := &perf[len(perf)-1]
+	p.Expected += expected
+	p.Included += included
+	if included < expected {
+		p.LastMissedSlot = slot
+		syncCommitteeMissedCounter.WithLabelValues(fmt.Sprintf("%d", idx)).Add(float64(expected - included))
+	}
+	syncCommitteeSuccessRateGauge.WithLabelValues(fmt.Sprintf("%d", idx)).Set(p.SuccessRate())
+	s.syncCommitteePerformance[idx] = perf
+}
@@ -0,0 +1,39 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSyncCommitteePerformance(t *testing.T) {
+	s := setupService(t)
+	periodSlots := primitives.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+
+	s.Lock()
+	s.updateSyncCommitteePerformance(12, 1, 2, 2)
+	s.updateSyncCommitteePerformance(1, 1, 4, 4)
+	s.updateSyncCommitteePerformance(1, 2, 4, 3)
+	s.updateSyncCommitteePerformance(1, periodSlots, 4, 4)
+	s.Unlock()
+
+	require.DeepEqual(t, []SyncCommitteePerformance{
+		{ValidatorIndex: 1, Period: 0, Expected: 8, Included: 7, LastMissedSlot: 2},
+		{ValidatorIndex: 1, Period: 1, Expected: 4, Included: 4},
+		{ValidatorIndex: 12, Period: 0, Expected: 2, Included: 2},
+	}, s.SyncCommitteePerformance())
+	require.Equal(t, 0.875, s.SyncCommitteePerformance()[0].SuccessRate())
+
+	// Only the current and previous periods are kept.
+	s.Lock()
+	s.updateSyncCommitteePerformance(1, 2*periodSlots, 4, 0)
+	s.Unlock()
+	perf := s.SyncCommitteePerformance()
+	require.Equal(t, 3, len(perf))
+	require.Equal(t, uint64(1), perf[0].Period)
+	require.Equal(t, uint64(2), perf[1].Period)
+	require.Equal(t, float64(0), perf[1].SuccessRate())
+	require.Equal(t, 2*periodSlots, perf[1].LastMissedSlot)
+}
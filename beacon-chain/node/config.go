@@ -4,12 +4,20 @@ import (
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
+	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
 )
 
 func configureTracing(cliCtx *cli.Context) error {
@@ -18,7 +26,8 @@ func configureTracing(cliCtx *cli.Context) error {
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
-		cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		// Traces are exported by the OTLP service when an OTLP endpoint is set.
+		cliCtx.Bool(cmd.EnableTracingFlag.Name) && cliCtx.String(cmd.OTLPEndpointFlag.Name) == "",
 	)
 }
 
@@ -102,6 +111,43 @@ func configureSlotsPerArchivedPoint(cliCtx *cli.Context) error {
 	return nil
 }
 
+// configureCacheSizes sets the cache sizes of the memory profile, overridden by the cache tuning flags.
+func configureCacheSizes(cliCtx *cli.Context) error {
+	p, err := selectedMemoryProfile(cliCtx)
+	if err != nil {
+		return err
+	}
+	sizes := p.sizes
+	if cliCtx.IsSet(flags.CacheStateByRootSizeFlag.Name) {
+		sizes.StateByRoot = cliCtx.Int(flags.CacheStateByRootSizeFlag.Name)
+	}
+	if cliCtx.IsSet(flags.CacheCheckpointStateSizeFlag.Name) {
+		sizes.CheckpointState = cliCtx.Int(flags.CacheCheckpointStateSizeFlag.Name)
+	}
+	if cliCtx.IsSet(flags.CachePayloadIDSlotsFlag.Name) {
+		sizes.PayloadIDSlots = cliCtx.Int(flags.CachePayloadIDSlotsFlag.Name)
+	}
+	if cliCtx.IsSet(flags.CacheCommitteesSizeFlag.Name) {
+		sizes.Committees = cliCtx.Int(flags.CacheCommitteesSizeFlag.Name)
+	}
+	if cliCtx.IsSet(flags.CacheRegistrationsSizeFlag.Name) {
+		sizes.Registrations = cliCtx.Int(flags.CacheRegistrationsSizeFlag.Name)
+	}
+	if err := cache.ConfigureSizes(sizes); err != nil {
+		return err
+	}
+	// The global committee cache is created before the flags are parsed, so it is recreated with the configured size.
+	helpers.ClearCache()
+	log.WithFields(logrus.Fields{
+		"stateByRoot":     sizes.StateByRoot,
+		"checkpointState": sizes.CheckpointState,
+		"payloadIDSlots":  sizes.PayloadIDSlots,
+		"committees":      sizes.Committees,
+		"registrations":   sizes.Registrations,
+	}).Debug("Configured cache sizes")
+	return nil
+}
+
 func configureEth1Config(cliCtx *cli.Context) error {
 	c := params.BeaconConfig().Copy()
 	if cliCtx.IsSet(flags.ChainID.Name) {
@@ -161,6 +207,37 @@ func configureInteropConfig(cliCtx *cli.Context) error {
 	return nil
 }
 
+func configureFaultInjection(cliCtx *cli.Context) error {
+	cfg := &faultinjection.Config{}
+	if cliCtx.IsSet(flags.FaultInjectionGossipDelayFlag.Name) {
+		d, err := faultinjection.ParseDelay(cliCtx.String(flags.FaultInjectionGossipDelayFlag.Name))
+		if err != nil {
+			return err
+		}
+		cfg.GossipDelay = d
+	}
+	if cliCtx.IsSet(flags.FaultInjectionEngineDelayFlag.Name) {
+		d, err := faultinjection.ParseDelay(cliCtx.String(flags.FaultInjectionEngineDelayFlag.Name))
+		if err != nil {
+			return err
+		}
+		cfg.EngineDelay = d
+	}
+	cfg.RPCDropPercent = cliCtx.Float64(flags.FaultInjectionRPCDropPercentFlag.Name)
+	if cfg.RPCDropPercent < 0 || cfg.RPCDropPercent > 100 {
+		return fmt.Errorf("--%s must be between 0 and 100", flags.FaultInjectionRPCDropPercentFlag.Name)
+	}
+	if cfg.Enabled() {
+		log.WithFields(logrus.Fields{
+			"gossipDelay":    cfg.GossipDelay,
+			"rpcDropPercent": cfg.RPCDropPercent,
+			"engineDelay":    cfg.EngineDelay,
+		}).Warn("Fault injection is enabled, this node deliberately misbehaves and must not be used in production")
+	}
+	faultinjection.Init(cfg)
+	return nil
+}
+
 func configureExecutionSetting(cliCtx *cli.Context) error {
 	if cliCtx.IsSet(flags.TerminalTotalDifficultyOverride.Name) {
 		c := params.BeaconConfig()
@@ -211,3 +288,62 @@ func configureExecutionSetting(cliCtx *cli.Context) error {
 		" Default fee recipient will be used as a fall back", checksumAddress.Hex())
 	return params.SetActive(c)
 }
+
+type trackedProposersFile struct {
+	Proposers []struct {
+		ValidatorIndex primitives.ValidatorIndex `yaml:"validator_index"`
+		FeeRecipient   string                    `yaml:"fee_recipient"`
+	} `yaml:"proposers"`
+}
+
+// configureTrackedProposers adds the validators of the tracked proposers file to the cache, so that
+// payloads are prepared for them even without a validator client connected to the node.
+func configureTrackedProposers(cliCtx *cli.Context, c *cache.TrackedValidatorsCache) error {
+	if !cliCtx.IsSet(flags.TrackedProposersFile.Name) {
+		return nil
+	}
+	path := cliCtx.String(flags.TrackedProposersFile.Name)
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return errors.Wrap(err, "could not read tracked proposers file")
+	}
+	var f trackedProposersFile
+	if err := yaml.UnmarshalStrict(enc, &f); err != nil {
+		return errors.Wrap(err, "could not parse tracked proposers file")
+	}
+	for _, p := range f.Proposers {
+		if !common.IsHexAddress(p.FeeRecipient) {
+			return fmt.Errorf("invalid fee recipient %q for validator %d", p.FeeRecipient, p.ValidatorIndex)
+		}
+		c.Set(cache.TrackedValidator{
+			Active:       true,
+			Index:        p.ValidatorIndex,
+			FeeRecipient: primitives.ExecutionAddress(common.HexToAddress(p.FeeRecipient)),
+			Source:       cache.StaticConfigSource,
+		})
+	}
+	log.WithFields(logrus.Fields{
+		"path":      path,
+		"proposers": len(f.Proposers),
+	}).Info("Loaded tracked proposers")
+	return nil
+}
+
+// configureScoreParamsOverrides loads the gossipsub peer scoring overrides file, which is refused on mainnet and
+// public testnets.
+func configureScoreParamsOverrides(cliCtx *cli.Context) (*p2p.ScoreParamsOverrides, error) {
+	if !cliCtx.IsSet(flags.P2PScoreParamsFileFlag.Name) {
+		return nil, nil
+	}
+	switch name := params.BeaconConfig().ConfigName; name {
+	case params.MainnetName, params.SepoliaName, params.HoleskyName:
+		return nil, fmt.Errorf("--%s is not allowed on %s", flags.P2PScoreParamsFileFlag.Name, name)
+	}
+	path := cliCtx.String(flags.P2PScoreParamsFileFlag.Name)
+	o, err := p2p.LoadScoreParamsOverrides(path)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("path", path).Warn("Overriding gossipsub peer scoring parameters")
+	return o, nil
+}
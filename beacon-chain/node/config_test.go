@@ -3,16 +3,21 @@ package node
 import (
 	"flag"
 	"fmt"
+	"math"
 	"os"
+	"path/filepath"
+	"runtime/debug"
 	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -229,6 +234,34 @@ func TestConfigureInterop(t *testing.T) {
 	}
 }
 
+func TestConfigureFaultInjection(t *testing.T) {
+	defer faultinjection.Init(nil)
+	newCliCtx := func(gossipDelay, dropPercent string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(flags.FaultInjectionGossipDelayFlag.Name, "", "")
+		set.String(flags.FaultInjectionEngineDelayFlag.Name, "", "")
+		set.Float64(flags.FaultInjectionRPCDropPercentFlag.Name, 0, "")
+		if gossipDelay != "" {
+			require.NoError(t, set.Set(flags.FaultInjectionGossipDelayFlag.Name, gossipDelay))
+		}
+		require.NoError(t, set.Set(flags.FaultInjectionRPCDropPercentFlag.Name, dropPercent))
+		return cli.NewContext(&cli.App{}, set, nil)
+	}
+
+	hook := logTest.NewGlobal()
+	require.NoError(t, configureFaultInjection(newCliCtx("", "0")))
+	assert.Equal(t, false, faultinjection.Get().Enabled())
+	assert.LogsDoNotContain(t, hook, "Fault injection is enabled")
+
+	require.NoError(t, configureFaultInjection(newCliCtx("uniform:10ms,20ms", "5")))
+	assert.Equal(t, "uniform:10ms,20ms", faultinjection.Get().GossipDelay.String())
+	assert.Equal(t, 5.0, faultinjection.Get().RPCDropPercent)
+	assert.LogsContain(t, hook, "Fault injection is enabled")
+
+	require.ErrorContains(t, "unknown delay distribution", configureFaultInjection(newCliCtx("gamma:1s", "0")))
+	require.ErrorContains(t, "must be between 0 and 100", configureFaultInjection(newCliCtx("", "150")))
+}
+
 func TestAliasFlag(t *testing.T) {
 	// Create a new app with the flag
 	app := &cli.App{
@@ -251,3 +284,123 @@ func TestAliasFlag(t *testing.T) {
 	// Check if the alias set the flag correctly
 	assert.NoError(t, err)
 }
+
+func TestConfigureTrackedProposers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposers.yaml")
+	content := `proposers:
+  - validator_index: 3
+    fee_recipient: "0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa"
+  - validator_index: 7
+    fee_recipient: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"
+`
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.TrackedProposersFile.Name, "", "")
+	require.NoError(t, set.Set(flags.TrackedProposersFile.Name, path))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	c := cache.NewTrackedValidatorsCache()
+	require.NoError(t, configureTrackedProposers(cliCtx, c))
+	val, ok := c.Validator(7)
+	require.Equal(t, true, ok)
+	assert.Equal(t, cache.StaticConfigSource, val.Source)
+	assert.Equal(t, common.HexToAddress("0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9"), common.Address(val.FeeRecipient))
+	assert.Equal(t, 2, len(c.Validators()))
+
+	require.NoError(t, os.WriteFile(path, []byte("proposers:\n  - validator_index: 1\n    fee_recipient: \"0xb\"\n"), 0600))
+	require.ErrorContains(t, "invalid fee recipient", configureTrackedProposers(cliCtx, c))
+}
+
+func TestConfigureScoreParamsOverrides(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	path := filepath.Join(t.TempDir(), "scoring.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("thresholds:\n  graylist_threshold: -20000\n"), 0600))
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.P2PScoreParamsFileFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.P2PScoreParamsFileFlag.Name, path))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	cfg := params.MinimalSpecConfig().Copy()
+	cfg.ConfigName = params.DevnetName
+	params.OverrideBeaconConfig(cfg)
+	o, err := configureScoreParamsOverrides(cliCtx)
+	require.NoError(t, err)
+	assert.NotNil(t, o)
+
+	cfg = params.MainnetConfig().Copy()
+	params.OverrideBeaconConfig(cfg)
+	_, err = configureScoreParamsOverrides(cliCtx)
+	require.ErrorContains(t, "not allowed on mainnet", err)
+}
+
+func TestConfigureCacheSizes(t *testing.T) {
+	defer func() {
+		require.NoError(t, cache.ConfigureSizes(cache.DefaultSizes()))
+	}()
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Int(flags.CacheCheckpointStateSizeFlag.Name, 0, "")
+	set.Int(flags.CacheRegistrationsSizeFlag.Name, 0, "")
+	require.NoError(t, set.Set(flags.CacheCheckpointStateSizeFlag.Name, "20"))
+	require.NoError(t, set.Set(flags.CacheRegistrationsSizeFlag.Name, "1000"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureCacheSizes(cliCtx))
+
+	want := cache.DefaultSizes()
+	want.CheckpointState = 20
+	want.Registrations = 1000
+	assert.Equal(t, want, cache.ConfiguredSizes())
+
+	require.NoError(t, set.Set(flags.CacheCheckpointStateSizeFlag.Name, "0"))
+	require.ErrorContains(t, "checkpoint state cache size must be positive", configureCacheSizes(cliCtx))
+}
+
+func TestConfigureMemoryProfile(t *testing.T) {
+	defer func() {
+		require.NoError(t, cache.ConfigureSizes(cache.DefaultSizes()))
+	}()
+	gcPercent := debug.SetGCPercent(100)
+	memoryLimit := debug.SetMemoryLimit(math.MaxInt64)
+	defer func() {
+		debug.SetGCPercent(gcPercent)
+		debug.SetMemoryLimit(memoryLimit)
+	}()
+	t.Setenv("GOGC", "")
+	t.Setenv("GOMEMLIMIT", "")
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(flags.MemoryProfileFlag.Name, "default", "")
+	set.Int(flags.SetGCPercent.Name, 100, "")
+	set.Int(flags.CacheCheckpointStateSizeFlag.Name, 0, "")
+	require.NoError(t, set.Set(flags.MemoryProfileFlag.Name, "low"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, configureMemoryProfile(cliCtx))
+	require.NoError(t, configureCacheSizes(cliCtx))
+	assert.Equal(t, 50, debug.SetGCPercent(50))
+	assert.Equal(t, 5*gib, debug.SetMemoryLimit(-1))
+	assert.Equal(t, memoryProfiles["low"].sizes, cache.ConfiguredSizes())
+
+	// Explicit settings take precedence over the profile.
+	require.NoError(t, set.Set(flags.MemoryProfileFlag.Name, "high"))
+	require.NoError(t, set.Set(flags.SetGCPercent.Name, "80"))
+	require.NoError(t, set.Set(flags.CacheCheckpointStateSizeFlag.Name, "20"))
+	require.NoError(t, configureMemoryProfile(cliCtx))
+	require.NoError(t, configureCacheSizes(cliCtx))
+	assert.Equal(t, 50, debug.SetGCPercent(50))
+	assert.Equal(t, 48*gib, debug.SetMemoryLimit(-1))
+	want := memoryProfiles["high"].sizes
+	want.CheckpointState = 20
+	assert.Equal(t, want, cache.ConfiguredSizes())
+
+	require.NoError(t, set.Set(flags.MemoryProfileFlag.Name, "huge"))
+	require.ErrorContains(t, "unknown memory profile", configureMemoryProfile(cliCtx))
+	require.ErrorContains(t, "unknown memory profile", configureCacheSizes(cliCtx))
+}
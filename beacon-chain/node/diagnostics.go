@@ -0,0 +1,120 @@
+package node
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/logs"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/diagnostics"
+	"github.com/urfave/cli/v2"
+)
+
+// diagnosticsPeer is the state of a peer written to diagnostics bundles.
+type diagnosticsPeer struct {
+	PeerID          string `json:"peer_id"`
+	Address         string `json:"address,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	ConnectionState string `json:"connection_state"`
+	HeadSlot        string `json:"head_slot,omitempty"`
+	FinalizedEpoch  string `json:"finalized_epoch,omitempty"`
+}
+
+// registerDiagnosticsSources sets the directory of the diagnostics bundles, and registers the forkchoice store, the
+// peers, the chain config and the flags of the node as sources of the bundles.
+func (b *BeaconNode) registerDiagnosticsSources() error {
+	dir := b.cliCtx.String(flags.DiagnosticsDirFlag.Name)
+	if dir == "" {
+		dir = filepath.Join(b.cliCtx.String(cmd.DataDirFlag.Name), "diagnostics")
+	}
+	diagnostics.SetDir(dir)
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	diagnostics.AddSource("forkchoice.json", func(ctx context.Context, w io.Writer) error {
+		dump, err := chainService.ForkChoiceDump(ctx)
+		if err != nil {
+			return err
+		}
+		return writeJSON(w, structs.ForkChoiceDumpFromConsensus(dump))
+	})
+	p := b.fetchP2P().Peers()
+	diagnostics.AddSource("peers.json", func(_ context.Context, w io.Writer) error {
+		return writeJSON(w, diagnosticsPeers(p))
+	})
+	diagnostics.AddSource("config.yaml", func(_ context.Context, w io.Writer) error {
+		_, err := w.Write(params.ConfigToYaml(params.BeaconConfig()))
+		return err
+	})
+	diagnostics.AddSource("flags.txt", func(_ context.Context, w io.Writer) error {
+		return writeFlags(w, b.cliCtx)
+	})
+	return nil
+}
+
+func diagnosticsPeers(p *peers.Status) []*diagnosticsPeer {
+	ids := p.All()
+	result := make([]*diagnosticsPeer, 0, len(ids))
+	for _, id := range ids {
+		dp := &diagnosticsPeer{PeerID: id.String()}
+		if addr, err := p.Address(id); err == nil && addr != nil {
+			dp.Address = addr.String()
+		}
+		if dir, err := p.Direction(id); err == nil {
+			dp.Direction = dir.String()
+		}
+		if state, err := p.ConnectionState(id); err == nil {
+			dp.ConnectionState = ethpb.ConnectionState(state).String()
+		}
+		if status, err := p.ChainState(id); err == nil && status != nil {
+			dp.HeadSlot = fmt.Sprintf("%d", status.HeadSlot)
+			dp.FinalizedEpoch = fmt.Sprintf("%d", status.FinalizedEpoch)
+		}
+		result = append(result, dp)
+	}
+	return result
+}
+
+// writeFlags writes the flags set on the command line or in the config file, masking the credentials of URLs.
+func writeFlags(w io.Writer, cliCtx *cli.Context) error {
+	names := cliCtx.FlagNames()
+	sort.Strings(names)
+	for _, name := range names {
+		var value string
+		switch v := cliCtx.Value(name).(type) {
+		case string:
+			value = logs.MaskCredentialsLogging(v)
+		case cli.StringSlice:
+			values := make([]string, len(v.Value()))
+			for i, s := range v.Value() {
+				values[i] = logs.MaskCredentialsLogging(s)
+			}
+			value = strings.Join(values, ",")
+		default:
+			value = fmt.Sprint(v)
+		}
+		if _, err := fmt.Fprintf(w, "--%s=%s\n", name, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
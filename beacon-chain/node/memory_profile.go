@@ -0,0 +1,100 @@
+package node
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+const gib = int64(1) << 30
+
+// memoryProfile tunes the garbage collector and the caches of the node together, for the memory of the machine.
+type memoryProfile struct {
+	// gcPercent is the GOGC of the node.
+	gcPercent int
+	// memoryLimit is the soft memory limit of the Go runtime in bytes, or math.MaxInt64 for none. The garbage
+	// collector runs more often as the heap nears it, rather than letting a high gcPercent exhaust the memory.
+	memoryLimit int64
+	sizes       cache.Sizes
+}
+
+// memoryProfiles are the presets of --memory-profile, low for machines with about 8GB of memory, and high for
+// machines with 64GB or more.
+var memoryProfiles = map[string]memoryProfile{
+	"low": {
+		gcPercent:   50,
+		memoryLimit: 5 * gib,
+		sizes: cache.Sizes{
+			StateByRoot:     8,
+			CheckpointState: 4,
+			PayloadIDSlots:  cache.DefaultSizes().PayloadIDSlots,
+			Committees:      cache.DefaultSizes().Committees,
+			Registrations:   4096,
+		},
+	},
+	"default": {
+		gcPercent:   100,
+		memoryLimit: math.MaxInt64,
+		sizes:       cache.DefaultSizes(),
+	},
+	"high": {
+		gcPercent:   200,
+		memoryLimit: 48 * gib,
+		sizes: cache.Sizes{
+			StateByRoot:     128,
+			CheckpointState: 32,
+			PayloadIDSlots:  cache.DefaultSizes().PayloadIDSlots,
+			Committees:      16,
+			Registrations:   0,
+		},
+	},
+}
+
+// selectedMemoryProfile returns the preset selected with --memory-profile.
+func selectedMemoryProfile(cliCtx *cli.Context) (memoryProfile, error) {
+	name := cliCtx.String(flags.MemoryProfileFlag.Name)
+	if name == "" {
+		name = "default"
+	}
+	p, ok := memoryProfiles[name]
+	if !ok {
+		names := make([]string, 0, len(memoryProfiles))
+		for n := range memoryProfiles {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return memoryProfile{}, fmt.Errorf("unknown memory profile %q, expected one of %s", name, strings.Join(names, ", "))
+	}
+	return p, nil
+}
+
+// configureMemoryProfile applies the GOGC and the soft memory limit of the memory profile. The GOGC and GOMEMLIMIT
+// environment variables and --gc-percent take precedence over the profile. The cache sizes of the profile are
+// applied by configureCacheSizes.
+func configureMemoryProfile(cliCtx *cli.Context) error {
+	p, err := selectedMemoryProfile(cliCtx)
+	if err != nil {
+		return err
+	}
+	fields := logrus.Fields{"profile": cliCtx.String(flags.MemoryProfileFlag.Name)}
+	if !cliCtx.IsSet(flags.SetGCPercent.Name) && os.Getenv("GOGC") == "" {
+		debug.SetGCPercent(p.gcPercent)
+		fields["gcPercent"] = p.gcPercent
+	}
+	if os.Getenv("GOMEMLIMIT") == "" {
+		debug.SetMemoryLimit(p.memoryLimit)
+		if p.memoryLimit != math.MaxInt64 {
+			fields["memoryLimitGiB"] = p.memoryLimit / gib
+		}
+	}
+	log.WithFields(fields).Info("Configured memory profile")
+	return nil
+}
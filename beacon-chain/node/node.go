@@ -19,6 +19,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client"
+	beaconapi "github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v5/api/server/httprest"
 	"github.com/prysmaticlabs/prysm/v5/api/server/middleware"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
@@ -26,14 +28,21 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clocksync"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/slasherkv"
 	interopcoldstart "github.com/prysmaticlabs/prysm/v5/beacon-chain/deterministic-genesis"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/finality"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkreadiness"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/health"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
@@ -44,6 +53,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc"
+	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -54,6 +64,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/checkpoint"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/genesis"
 	initialsync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/light-client"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
@@ -62,6 +73,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/slice"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/otlp"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
@@ -102,6 +114,8 @@ type BeaconNode struct {
 	depositCache            cache.DepositCache
 	trackedValidatorsCache  *cache.TrackedValidatorsCache
 	payloadIDCache          *cache.PayloadIDCache
+	slotTimingsCache        *cache.SlotTimingsCache
+	payloadValuesCache      *cache.PayloadValuesCache
 	stateFeed               *event.Feed
 	blockFeed               *event.Feed
 	opFeed                  *event.Feed
@@ -152,6 +166,8 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		blsToExecPool:           blstoexec.NewPool(),
 		trackedValidatorsCache:  cache.NewTrackedValidatorsCache(),
 		payloadIDCache:          cache.NewPayloadIDCache(),
+		slotTimingsCache:        cache.NewSlotTimingsCache(),
+		payloadValuesCache:      cache.NewPayloadValuesCache(),
 		slasherBlockHeadersFeed: new(event.Feed),
 		slasherAttestationsFeed: new(event.Feed),
 		serviceFlagOpts:         &serviceFlagOpts{},
@@ -165,6 +181,10 @@ func New(cliCtx *cli.Context, cancel context.CancelFunc, opts ...Option) (*Beaco
 		}
 	}
 
+	if err := configureTrackedProposers(cliCtx, beacon.trackedValidatorsCache); err != nil {
+		return nil, errors.Wrap(err, "could not configure tracked proposers")
+	}
+
 	synchronizer := startup.NewClockSynchronizer()
 	beacon.clockWaiter = synchronizer
 	beacon.forkChoicer = doublylinkedtree.New()
@@ -262,6 +282,14 @@ func configureBeacon(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not configure slots per archived point")
 	}
 
+	if err := configureMemoryProfile(cliCtx); err != nil {
+		return errors.Wrap(err, "could not configure memory profile")
+	}
+
+	if err := configureCacheSizes(cliCtx); err != nil {
+		return errors.Wrap(err, "could not configure cache sizes")
+	}
+
 	if err := configureEth1Config(cliCtx); err != nil {
 		return errors.Wrap(err, "could not configure eth1 config")
 	}
@@ -276,6 +304,10 @@ func configureBeacon(cliCtx *cli.Context) error {
 		return errors.Wrap(err, "could not configure execution setting")
 	}
 
+	if err := configureFaultInjection(cliCtx); err != nil {
+		return errors.Wrap(err, "could not configure fault injection")
+	}
+
 	return nil
 }
 
@@ -360,6 +392,26 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register builder service")
 	}
 
+	log.Debugln("Registering Finality Stall Service")
+	if err := beacon.registerFinalityStallService(); err != nil {
+		return errors.Wrap(err, "could not register finality stall service")
+	}
+
+	log.Debugln("Registering Fork Readiness Service")
+	if err := beacon.registerForkReadinessService(); err != nil {
+		return errors.Wrap(err, "could not register fork readiness service")
+	}
+
+	log.Debugln("Registering Clock Sync Service")
+	if err := beacon.registerClockSyncService(); err != nil {
+		return errors.Wrap(err, "could not register clock sync service")
+	}
+
+	log.Debugln("Registering Validator Monitoring Service")
+	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
+		return errors.Wrap(err, "could not register validator monitoring service")
+	}
+
 	log.Debugln("Registering RPC Service")
 	router := http.NewServeMux()
 	if err := beacon.registerRPCService(router); err != nil {
@@ -371,9 +423,19 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		return errors.Wrap(err, "could not register HTTP service")
 	}
 
-	log.Debugln("Registering Validator Monitoring Service")
-	if err := beacon.registerValidatorMonitorService(beacon.initialSyncComplete); err != nil {
-		return errors.Wrap(err, "could not register validator monitoring service")
+	log.Debugln("Registering Light Client Service")
+	if err := beacon.registerLightClientService(); err != nil {
+		return errors.Wrap(err, "could not register light client service")
+	}
+
+	log.Debugln("Registering Database Pruner Service")
+	if err := beacon.registerPrunerService(); err != nil {
+		return errors.Wrap(err, "could not register database pruner service")
+	}
+
+	log.Debugln("Registering Diagnostics Sources")
+	if err := beacon.registerDiagnosticsSources(); err != nil {
+		return errors.Wrap(err, "could not register diagnostics sources")
 	}
 
 	if !cliCtx.Bool(cmd.DisableMonitoringFlag.Name) {
@@ -383,6 +445,11 @@ func registerServices(cliCtx *cli.Context, beacon *BeaconNode, synchronizer *sta
 		}
 	}
 
+	log.Debugln("Registering OTLP Service")
+	if err := beacon.registerOTLPService(); err != nil {
+		return errors.Wrap(err, "could not register OTLP service")
+	}
+
 	return nil
 }
 
@@ -461,7 +528,7 @@ func (b *BeaconNode) Close() {
 	close(b.stop)
 }
 
-func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string) (*kv.Store, error) {
+func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath string, opts ...kv.KVStoreOption) (*kv.Store, error) {
 	var err error
 	clearDBConfirmed := false
 
@@ -489,7 +556,7 @@ func (b *BeaconNode) clearDB(clearDB, forceClearDB bool, d *kv.Store, dbPath str
 			return nil, errors.Wrap(err, "could not clear blob storage")
 		}
 
-		d, err = kv.NewKVStore(b.ctx, dbPath)
+		d, err = kv.NewKVStore(b.ctx, dbPath, opts...)
 		if err != nil {
 			return nil, errors.Wrap(err, "could not create new database")
 		}
@@ -529,15 +596,38 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	clearDBRequired := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDBRequired := cliCtx.Bool(cmd.ForceClearDB.Name)
 
+	dbBackend := backend.Bolt
+	if name := cliCtx.String(flags.BeaconDBBackendFlag.Name); name != "" {
+		var err error
+		dbBackend, err = backend.ParseType(name)
+		if err != nil {
+			return errors.Wrapf(err, "invalid --%s value", flags.BeaconDBBackendFlag.Name)
+		}
+	}
+
+	opts := []kv.KVStoreOption{kv.WithBackend(dbBackend)}
+	if coldPath := cliCtx.String(flags.BeaconDBColdDirFlag.Name); coldPath != "" {
+		opts = append(opts, kv.WithColdPath(filepath.Join(coldPath, kv.BeaconNodeDbDirName)))
+	}
+	if cliCtx.Bool(flags.BeaconDBBatchWritesFlag.Name) {
+		opts = append(opts, kv.WithWriteBatching(
+			cliCtx.Int(flags.BeaconDBBatchMaxSizeFlag.Name),
+			cliCtx.Duration(flags.BeaconDBBatchMaxDelayFlag.Name),
+		))
+	}
+	if interval := cliCtx.Duration(flags.BeaconDBFsyncIntervalFlag.Name); interval > 0 {
+		opts = append(opts, kv.WithFsyncInterval(interval))
+	}
+
 	log.WithField("databasePath", dbPath).Info("Checking DB")
 
-	d, err := kv.NewKVStore(b.ctx, dbPath)
+	d, err := kv.NewKVStore(b.ctx, dbPath, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "could not create database at %s", dbPath)
 	}
 
 	if clearDBRequired || forceClearDBRequired {
-		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath)
+		d, err = b.clearDB(clearDBRequired, forceClearDBRequired, d, dbPath, opts...)
 		if err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
@@ -576,6 +666,13 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		if err := b.CheckpointInitializer.Initialize(b.ctx, d); err != nil {
 			return err
 		}
+		ws, err := helpers.ParseWeakSubjectivityInputString(cliCtx.String(flags.WeakSubjectivityCheckpoint.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not parse weak subjectivity checkpoint")
+		}
+		if err := checkpoint.VerifyWeakSubjectivity(b.ctx, d, ws); err != nil {
+			return errors.Wrap(err, "could not verify weak subjectivity checkpoint against checkpoint sync origin")
+		}
 	}
 
 	if err := b.checkAndSaveDepositContract(depositAddress); err != nil {
@@ -634,6 +731,14 @@ func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
 
 func (b *BeaconNode) startStateGen(ctx context.Context, bfs coverage.AvailableBlocker, fc forkchoice.ForkChoicer) error {
 	opts := []stategen.Option{stategen.WithAvailableBlocker(bfs)}
+	if u := b.cliCtx.String(flags.StateProviderURLFlag.Name); u != "" {
+		c, err := beaconapi.NewClient(u, client.WithTimeout(b.cliCtx.Duration(flags.StateProviderTimeoutFlag.Name)))
+		if err != nil {
+			return errors.Wrap(err, "could not create the state provider client")
+		}
+		opts = append(opts, stategen.WithRemoteStateProvider(stategen.NewBeaconAPIStateProvider(c)))
+		log.WithField("host", c.BaseURL().Host).Info("Fetching states missing locally from the state provider")
+	}
 	sg := stategen.New(b.db, fc, opts...)
 
 	cp, err := b.db.FinalizedCheckpoint(ctx)
@@ -670,30 +775,42 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	if err != nil {
 		return errors.Wrapf(err, "could not register p2p service")
 	}
+	gossipBandwidthCaps, err := p2p.ParseGossipBandwidthCaps(cliCtx.StringSlice(flags.GossipBandwidthCapFlag.Name))
+	if err != nil {
+		return err
+	}
+	scoreParamsOverrides, err := configureScoreParamsOverrides(cliCtx)
+	if err != nil {
+		return err
+	}
 
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
-		NoDiscovery:          cliCtx.Bool(cmd.NoDiscovery.Name),
-		StaticPeers:          slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
-		Discv5BootStrapAddrs: p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
-		RelayNodeAddr:        cliCtx.String(cmd.RelayNode.Name),
-		DataDir:              dataDir,
-		LocalIP:              cliCtx.String(cmd.P2PIP.Name),
-		HostAddress:          cliCtx.String(cmd.P2PHost.Name),
-		HostDNS:              cliCtx.String(cmd.P2PHostDNS.Name),
-		PrivateKey:           cliCtx.String(cmd.P2PPrivKey.Name),
-		StaticPeerID:         cliCtx.Bool(cmd.P2PStaticID.Name),
-		MetaDataDir:          cliCtx.String(cmd.P2PMetadata.Name),
-		QUICPort:             cliCtx.Uint(cmd.P2PQUICPort.Name),
-		TCPPort:              cliCtx.Uint(cmd.P2PTCPPort.Name),
-		UDPPort:              cliCtx.Uint(cmd.P2PUDPPort.Name),
-		MaxPeers:             cliCtx.Uint(cmd.P2PMaxPeers.Name),
-		QueueSize:            cliCtx.Uint(cmd.PubsubQueueSize.Name),
-		AllowListCIDR:        cliCtx.String(cmd.P2PAllowList.Name),
-		DenyListCIDR:         slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
-		EnableUPnP:           cliCtx.Bool(cmd.EnableUPnPFlag.Name),
-		StateNotifier:        b,
-		DB:                   b.db,
-		ClockWaiter:          b.clockWaiter,
+		NoDiscovery:           cliCtx.Bool(cmd.NoDiscovery.Name),
+		StaticPeers:           slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		Discv5BootStrapAddrs:  p2p.ParseBootStrapAddrs(bootstrapNodeAddrs),
+		RelayNodeAddr:         cliCtx.String(cmd.RelayNode.Name),
+		DataDir:               dataDir,
+		LocalIP:               cliCtx.String(cmd.P2PIP.Name),
+		HostAddress:           cliCtx.String(cmd.P2PHost.Name),
+		HostDNS:               cliCtx.String(cmd.P2PHostDNS.Name),
+		PrivateKey:            cliCtx.String(cmd.P2PPrivKey.Name),
+		StaticPeerID:          cliCtx.Bool(cmd.P2PStaticID.Name),
+		MetaDataDir:           cliCtx.String(cmd.P2PMetadata.Name),
+		QUICPort:              cliCtx.Uint(cmd.P2PQUICPort.Name),
+		TCPPort:               cliCtx.Uint(cmd.P2PTCPPort.Name),
+		UDPPort:               cliCtx.Uint(cmd.P2PUDPPort.Name),
+		MaxPeers:              cliCtx.Uint(cmd.P2PMaxPeers.Name),
+		QueueSize:             cliCtx.Uint(cmd.PubsubQueueSize.Name),
+		AllowListCIDR:         cliCtx.String(cmd.P2PAllowList.Name),
+		DenyListCIDR:          slice.SplitCommaSeparated(cliCtx.StringSlice(cmd.P2PDenyList.Name)),
+		EnableUPnP:            cliCtx.Bool(cmd.EnableUPnPFlag.Name),
+		StateNotifier:         b,
+		DB:                    b.db,
+		ClockWaiter:           b.clockWaiter,
+		GossipBandwidthCaps:   gossipBandwidthCaps,
+		ScoreParamsOverrides:  scoreParamsOverrides,
+		TrustedPeersFile:      cliCtx.String(flags.TrustedPeersFileFlag.Name),
+		ServeHistoricalBlocks: cliCtx.Bool(flags.ServeHistoricalBlocksFlag.Name),
 	})
 	if err != nil {
 		return err
@@ -762,6 +879,7 @@ func (b *BeaconNode) registerBlockchainService(fc forkchoice.ForkChoicer, gs *st
 		blockchain.WithBlobStorage(b.BlobStorage),
 		blockchain.WithTrackedValidatorsCache(b.trackedValidatorsCache),
 		blockchain.WithPayloadIDCache(b.payloadIDCache),
+		blockchain.WithSlotTimingsCache(b.slotTimingsCache),
 		blockchain.WithSyncChecker(b.syncChecker),
 	)
 
@@ -797,6 +915,7 @@ func (b *BeaconNode) registerPOWChainService() error {
 		execution.WithFinalizedStateAtStartup(b.finalizedStateAtStartUp),
 		execution.WithJwtId(b.cliCtx.String(flags.JwtId.Name)),
 		execution.WithVerifierWaiter(b.verifyInitWaiter),
+		execution.WithClockWaiter(b.clockWaiter),
 	)
 	web3Service, err := execution.NewService(b.ctx, opts...)
 	if err != nil {
@@ -846,6 +965,7 @@ func (b *BeaconNode) registerSyncService(initialSyncComplete chan struct{}, bFil
 		regularsync.WithBlobStorage(b.BlobStorage),
 		regularsync.WithVerifierWaiter(b.verifyInitWaiter),
 		regularsync.WithAvailableBlocker(bFillStore),
+		regularsync.WithSlotTimingsCache(b.slotTimingsCache),
 	)
 	return b.services.RegisterService(rs)
 }
@@ -927,6 +1047,30 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		}
 	}
 
+	var finalityService *finality.Service
+	if err := b.services.FetchService(&finalityService); err != nil {
+		return err
+	}
+
+	var forkReadinessService *forkreadiness.Service
+	if err := b.services.FetchService(&forkReadinessService); err != nil {
+		return err
+	}
+
+	var clockSyncService *clocksync.Service
+	if err := b.services.FetchService(&clockSyncService); err != nil {
+		return err
+	}
+
+	var validatorMonitor monitor.SyncCommitteePerformanceFetcher
+	if b.cliCtx.IntSlice(cmd.ValidatorMonitorIndicesFlag.Name) != nil {
+		var monitorService *monitor.Service
+		if err := b.services.FetchService(&monitorService); err != nil {
+			return err
+		}
+		validatorMonitor = monitorService
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	var depositFetcher cache.DepositFetcher
 	var chainStartFetcher execution.ChainStartFetcher
@@ -951,8 +1095,23 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 	mockEth1DataVotes := b.cliCtx.Bool(flags.InteropMockEth1DataVotesFlag.Name)
 	maxMsgSize := b.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	enableDebugRPCEndpoints := !b.cliCtx.Bool(flags.DisableDebugRPCEndpoints.Name)
+	operationWeights, err := validatorv1alpha1.ParseOperationWeights(b.cliCtx.StringSlice(flags.OperationInclusionWeightFlag.Name))
+	if err != nil {
+		return err
+	}
+	payloadDeadline := b.cliCtx.Duration(flags.ProposalPayloadDeadlineFlag.Name)
+	if err := validatorv1alpha1.ValidatePayloadDeadline(payloadDeadline); err != nil {
+		return err
+	}
+	adminToken, err := readAdminToken(b.cliCtx.String(flags.AdminTokenFileFlag.Name))
+	if err != nil {
+		return err
+	}
 
-	p2pService := b.fetchP2P()
+	var p2pService *p2p.Service
+	if err := b.services.FetchService(&p2pService); err != nil {
+		return err
+	}
 	rpcService := rpc.NewService(b.ctx, &rpc.Config{
 		ExecutionEngineCaller:     web3Service,
 		ExecutionReconstructor:    web3Service,
@@ -1003,6 +1162,21 @@ func (b *BeaconNode) registerRPCService(router *http.ServeMux) error {
 		BlobStorage:               b.BlobStorage,
 		TrackedValidatorsCache:    b.trackedValidatorsCache,
 		PayloadIDCache:            b.payloadIDCache,
+		SlotTimingsCache:          b.slotTimingsCache,
+		PayloadValuesCache:        b.payloadValuesCache,
+		PayloadDeadline:           payloadDeadline,
+		FinalityStatusFetcher:     finalityService,
+		EngineCapabilitiesFetcher: web3Service,
+		ForkchoiceStatusFetcher:   web3Service,
+		ForkReadinessFetcher:      forkReadinessService,
+		ClockChecker:              clockSyncService,
+		PeerScoresFetcher:         p2pService,
+		ReachabilityFetcher:       p2pService,
+		OperationWeights:          operationWeights,
+		BlockRebroadcastDelay:     b.cliCtx.Duration(flags.BlockRebroadcastDelayFlag.Name),
+		BlockRebroadcastMinAtts:   b.cliCtx.Uint64(flags.BlockRebroadcastMinAttestationsFlag.Name),
+		ValidatorMonitor:          validatorMonitor,
+		AdminToken:                adminToken,
 	})
 
 	return b.services.RegisterService(rpcService)
@@ -1021,6 +1195,15 @@ func (b *BeaconNode) registerPrometheusService(_ *cli.Context) error {
 		panic(err)
 	}
 
+	checker, err := b.healthChecker(c, p)
+	if err != nil {
+		return err
+	}
+	additionalHandlers = append(additionalHandlers,
+		prometheus.Handler{Path: "/healthz", Handler: checker.LivenessHandler},
+		prometheus.Handler{Path: "/readyz", Handler: checker.ReadinessHandler},
+	)
+
 	service := prometheus.NewService(
 		fmt.Sprintf("%s:%d", b.cliCtx.String(cmd.MonitoringHostFlag.Name), b.cliCtx.Int(flags.MonitoringPortFlag.Name)),
 		b.services,
@@ -1116,6 +1299,113 @@ func (b *BeaconNode) registerValidatorMonitorService(initialSyncComplete chan st
 	return b.services.RegisterService(svc)
 }
 
+func (b *BeaconNode) registerFinalityStallService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+	svc := finality.NewService(b.ctx, &finality.Config{
+		StateNotifier:          b,
+		HeadFetcher:            chainService,
+		FinalizationFetcher:    chainService,
+		ClockWaiter:            b.clockWaiter,
+		TrackedValidatorsCache: b.trackedValidatorsCache,
+		StallEpochs:            primitives.Epoch(b.cliCtx.Uint64(flags.FinalityStallEpochsFlag.Name)),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerForkReadinessService() error {
+	var web3Service *execution.Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return err
+	}
+	svc := forkreadiness.NewService(b.ctx, &forkreadiness.Config{
+		ClockWaiter:               b.clockWaiter,
+		EngineCapabilitiesFetcher: web3Service,
+		PeersProvider:             b.fetchP2P(),
+	})
+	return b.services.RegisterService(svc)
+}
+
+// healthChecker returns the checks of the liveness and readiness probes of the node.
+func (b *BeaconNode) healthChecker(chainService *blockchain.Service, p2pService *p2p.Service) (*health.Checker, error) {
+	var web3Service *execution.Service
+	if err := b.services.FetchService(&web3Service); err != nil {
+		return nil, err
+	}
+	var syncService *initialsync.Service
+	if err := b.services.FetchService(&syncService); err != nil {
+		return nil, err
+	}
+	db, ok := b.db.(health.WritableDB)
+	if !ok {
+		return nil, errors.New("database does not support health checks")
+	}
+	checker := health.NewChecker()
+	checker.AddLivenessCheck("db", health.DBCheck(db))
+	checker.AddReadinessCheck("execution", health.ExecutionCheck(web3Service))
+	checker.AddReadinessCheck("sync", health.SyncCheck(syncService, chainService))
+	checker.AddReadinessCheck("peers", health.PeersCheck(p2pService, b.cliCtx.Int(flags.ReadinessMinPeersFlag.Name)))
+	return checker, nil
+}
+
+func (b *BeaconNode) registerOTLPService() error {
+	endpoint := b.cliCtx.String(cmd.OTLPEndpointFlag.Name)
+	if endpoint == "" {
+		return nil
+	}
+	headers, err := otlp.ParseHeaders(b.cliCtx.StringSlice(cmd.OTLPHeadersFlag.Name))
+	if err != nil {
+		return err
+	}
+	svc, err := otlp.NewService(b.ctx, &otlp.Config{
+		Endpoint:       endpoint,
+		Headers:        headers,
+		Interval:       b.cliCtx.Duration(cmd.OTLPExportIntervalFlag.Name),
+		ServiceName:    "beacon-chain",
+		InstanceID:     b.cliCtx.String(cmd.OTLPInstanceIDFlag.Name),
+		Network:        params.BeaconConfig().ConfigName,
+		Traces:         b.cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		SampleFraction: b.cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerClockSyncService() error {
+	svc := clocksync.NewService(b.ctx, &clocksync.Config{
+		Servers:            b.cliCtx.StringSlice(flags.NTPServersFlag.Name),
+		Threshold:          b.cliCtx.Duration(flags.ClockDriftThresholdFlag.Name),
+		RefuseAttestations: b.cliCtx.Bool(flags.ClockDriftRefuseAttestationsFlag.Name),
+	})
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerLightClientService() error {
+	if !features.Get().EnableLightClient {
+		return nil
+	}
+	retention := b.cliCtx.Uint64(flags.LightClientRetentionPeriodsFlag.Name)
+	svc := lightclient.NewService(b.ctx, b.db, b.stateGen, b.clockWaiter, retention)
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	retention := b.cliCtx.Uint64(flags.BeaconDBPruneRetentionEpochsFlag.Name)
+	if retention == 0 {
+		return nil
+	}
+	d, ok := b.db.(pruner.Database)
+	if !ok {
+		return errors.New("database does not support pruning")
+	}
+	svc := pruner.NewService(b.ctx, d, b.clockWaiter, primitives.Epoch(retention))
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -1136,6 +1426,22 @@ func (b *BeaconNode) registerBuilderService(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
+// readAdminToken reads the token of the admin endpoints from its file, if set.
+func readAdminToken(path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	enc, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return "", errors.Wrap(err, "could not read admin token file")
+	}
+	token := strings.TrimSpace(string(enc))
+	if token == "" {
+		return "", errors.New("admin token file is empty")
+	}
+	return token, nil
+}
+
 func hasNetworkFlag(cliCtx *cli.Context) bool {
 	for _, flag := range features.NetworkFlags {
 		for _, name := range flag.Names() {
@@ -1,6 +1,8 @@
 package kv
 
 import (
+	"bytes"
+
 	"github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-bitfield"
@@ -62,3 +64,33 @@ func (c *AttCaches) hasSeenBit(att ethpb.Att) (bool, error) {
 	}
 	return false, nil
 }
+
+// DeleteSeenBits deletes the aggregation bits of the attestation from the seen bits of its attestation data, so that
+// the attestation can be saved again after the block which included it was orphaned.
+func (c *AttCaches) DeleteSeenBits(att ethpb.Att) error {
+	id, err := attestation.NewId(att, attestation.Data)
+	if err != nil {
+		return errors.Wrap(err, "could not create attestation ID")
+	}
+
+	v, ok := c.seenAtt.Get(id.String())
+	if !ok {
+		return nil
+	}
+	seenBits, ok := v.([]bitfield.Bitlist)
+	if !ok {
+		return errors.New("could not convert to bitlist type")
+	}
+	filtered := make([]bitfield.Bitlist, 0, len(seenBits))
+	for _, bit := range seenBits {
+		if !bytes.Equal(bit, att.GetAggregationBits()) {
+			filtered = append(filtered, bit)
+		}
+	}
+	if len(filtered) == 0 {
+		c.seenAtt.Delete(id.String())
+		return nil
+	}
+	c.seenAtt.Set(id.String(), filtered, cache.DefaultExpiration /* one epoch */)
+	return nil
+}
@@ -55,3 +55,23 @@ func TestAttCaches_insertSeenBitDuplicates(t *testing.T) {
 	require.Equal(t, true, ok)
 	require.Equal(t, true, expirationprysmTime.After(expirationTime1), "Expiration time is not updated")
 }
+
+func TestAttCaches_DeleteSeenBits(t *testing.T) {
+	c := NewAttCaches()
+	att1 := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b10000011}})
+	att2 := util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b11100000}})
+	require.NoError(t, c.insertSeenBit(att1))
+	require.NoError(t, c.insertSeenBit(att2))
+
+	require.NoError(t, c.DeleteSeenBits(att1))
+	seen, err := c.hasSeenBit(att1)
+	require.NoError(t, err)
+	require.Equal(t, false, seen)
+	seen, err = c.hasSeenBit(att2)
+	require.NoError(t, err)
+	require.Equal(t, true, seen)
+
+	require.NoError(t, c.DeleteSeenBits(att2))
+	require.Equal(t, 0, c.seenAtt.ItemCount())
+	require.NoError(t, c.DeleteSeenBits(att2))
+}
@@ -88,6 +88,11 @@ func (*PoolMock) DeleteSeenUnaggregatedAttestations() (int, error) {
 	panic("implement me")
 }
 
+// DeleteSeenBits --
+func (*PoolMock) DeleteSeenBits(_ ethpb.Att) error {
+	panic("implement me")
+}
+
 // UnaggregatedAttestationCount --
 func (*PoolMock) UnaggregatedAttestationCount() int {
 	panic("implement me")
@@ -44,3 +44,13 @@ func (*PoolMock) MarkIncludedAttesterSlashing(_ ethpb.AttSlashing) {
 func (*PoolMock) MarkIncludedProposerSlashing(_ *ethpb.ProposerSlashing) {
 	panic("implement me")
 }
+
+// MarkOrphanedAttesterSlashing --
+func (*PoolMock) MarkOrphanedAttesterSlashing(_ ethpb.AttSlashing) {
+	panic("implement me")
+}
+
+// MarkOrphanedProposerSlashing --
+func (*PoolMock) MarkOrphanedProposerSlashing(_ *ethpb.ProposerSlashing) {
+	panic("implement me")
+}
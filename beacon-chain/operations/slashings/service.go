@@ -264,6 +264,25 @@ func (p *Pool) MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing) {
 	numProposerSlashingsIncluded.Inc()
 }
 
+// MarkOrphanedAttesterSlashing is used when a block which included an attester slashing was orphaned. The validators of
+// the slashing are no longer considered recently included, so that the slashing can be inserted again.
+func (p *Pool) MarkOrphanedAttesterSlashing(as ethpb.AttSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	slashedVal := slice.IntersectionUint64(as.FirstAttestation().GetAttestingIndices(), as.SecondAttestation().GetAttestingIndices())
+	for _, val := range slashedVal {
+		delete(p.included, primitives.ValidatorIndex(val))
+	}
+}
+
+// MarkOrphanedProposerSlashing is used when a block which included a proposer slashing was orphaned. The proposer of
+// the slashing is no longer considered recently included, so that the slashing can be inserted again.
+func (p *Pool) MarkOrphanedProposerSlashing(ps *ethpb.ProposerSlashing) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	delete(p.included, ps.Header_1.Header.ProposerIndex)
+}
+
 // this function checks a few items about a validator before proceeding with inserting
 // a proposer/attester slashing into the pool. First, it checks if the validator
 // has been recently included in the pool, then it checks if the validator is slashable.
@@ -322,6 +322,20 @@ func TestPool_MarkIncludedProposerSlashing(t *testing.T) {
 	}
 }
 
+func TestPool_MarkOrphanedProposerSlashing(t *testing.T) {
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	sl, err := util.GenerateProposerSlashingForValidator(beaconState, privKeys[3], 3)
+	require.NoError(t, err)
+	p := NewPool()
+	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, sl))
+	p.MarkIncludedProposerSlashing(sl)
+	require.ErrorContains(t, "cannot be slashed", p.InsertProposerSlashing(context.Background(), beaconState, sl))
+
+	p.MarkOrphanedProposerSlashing(sl)
+	require.NoError(t, p.InsertProposerSlashing(context.Background(), beaconState, sl))
+	assert.Equal(t, 1, len(p.PendingProposerSlashings(context.Background(), beaconState, true)))
+}
+
 func TestPool_PendingProposerSlashings(t *testing.T) {
 	type fields struct {
 		pending []*ethpb.ProposerSlashing
@@ -31,6 +31,8 @@ type PoolManager interface {
 	PendingProposerSlashings(ctx context.Context, state state.ReadOnlyBeaconState, noLimit bool) []*ethpb.ProposerSlashing
 	MarkIncludedAttesterSlashing(as ethpb.AttSlashing)
 	MarkIncludedProposerSlashing(ps *ethpb.ProposerSlashing)
+	MarkOrphanedAttesterSlashing(as ethpb.AttSlashing)
+	MarkOrphanedProposerSlashing(ps *ethpb.ProposerSlashing)
 }
 
 // Pool is a concrete implementation of PoolManager.
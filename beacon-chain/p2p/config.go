@@ -34,6 +34,15 @@ type Config struct {
 	StateNotifier        statefeed.Notifier
 	DB                   db.ReadOnlyDatabase
 	ClockWaiter          startup.ClockWaiter
+	// GossipBandwidthCaps are the soft caps in bytes per second of the gossip messages of each topic kind.
+	GossipBandwidthCaps map[string]uint64
+	// ScoreParamsOverrides override the default gossipsub peer scoring parameters.
+	ScoreParamsOverrides *ScoreParamsOverrides
+	// TrustedPeersFile is a YAML list of multiaddrs or ENRs of trusted peers, reloaded whenever it changes.
+	TrustedPeersFile string
+	// ServeHistoricalBlocks advertises in the ENR that the node serves blocks older than
+	// MIN_EPOCHS_FOR_BLOCK_REQUESTS over by-range requests.
+	ServeHistoricalBlocks bool
 }
 
 // validateConfig validates whether the values provided are accurate and will set
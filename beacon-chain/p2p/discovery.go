@@ -225,7 +225,9 @@ func (s *Service) listenForNewNodes() {
 				thresholdCount = 0
 			}
 		default:
-			if s.isPeerAtLimit(false /* inbound */) {
+			// Around a fork, look for peers announcing the next fork even at the peer limit.
+			needsNextForkPeers := s.needsNextForkPeers()
+			if s.isPeerAtLimit(false /* inbound */) && !needsNextForkPeers {
 				// Pause the main loop for a period to stop looking
 				// for new peers.
 				log.Trace("Not looking for peers, at peer limit")
@@ -233,6 +235,12 @@ func (s *Service) listenForNewNodes() {
 				continue
 			}
 			wantedCount := s.wantedPeerDials()
+			nodes := iterator
+			if wantedCount == 0 && needsNextForkPeers {
+				// Beyond the peer limit, only dial peers announcing the next fork.
+				wantedCount = forkTransitionDials
+				nodes = filterNodes(s.ctx, iterator, s.announcesNextFork)
+			}
 			if wantedCount == 0 {
 				log.Trace("Not looking for peers, at peer limit")
 				time.Sleep(pollingPeriod)
@@ -242,7 +250,7 @@ func (s *Service) listenForNewNodes() {
 			if flags.MaxDialIsActive() {
 				wantedCount = min(wantedCount, flags.Get().MaxConcurrentDials)
 			}
-			wantedNodes := enode.ReadNodes(iterator, wantedCount)
+			wantedNodes := enode.ReadNodes(nodes, wantedCount)
 			wg := new(sync.WaitGroup)
 			for i := 0; i < len(wantedNodes); i++ {
 				node := wantedNodes[i]
@@ -378,6 +386,13 @@ func (s *Service) createLocalNode(
 	localNode = initializeAttSubnets(localNode)
 	localNode = initializeSyncCommSubnets(localNode)
 
+	if s.cfg != nil && s.cfg.ServeHistoricalBlocks {
+		localNode, err = addHistoricalBlocksEntry(s.ctx, localNode, s.cfg.DB)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not add historical blocks entry to enr")
+		}
+	}
+
 	if s.cfg != nil && s.cfg.HostAddress != "" {
 		hostIP := net.ParseIP(s.cfg.HostAddress)
 		if hostIP.To4() == nil && hostIP.To16() == nil {
@@ -425,11 +440,14 @@ func (s *Service) startDiscoveryV5(
 // try to ascertain that the peer can be a valid protocol peer.
 // Validity Conditions:
 //  1. Peer has a valid IP and a (QUIC and/or TCP) port set in their enr.
-//  2. Peer hasn't been marked as 'bad'.
-//  3. Peer is not currently active or connected.
-//  4. Peer is ready to receive incoming connections.
-//  5. Peer's fork digest in their ENR matches that of
+//  2. Peer's fork digest in their ENR matches that of
 //     our localnodes.
+//  3. Peer hasn't been marked as 'bad'.
+//  4. Peer is not currently active or connected.
+//  5. Peer is ready to receive incoming connections.
+//
+// The subnet searches apply the same filter. Rejected nodes are counted
+// by reason in the p2p_discovery_rejected_peers_total metric.
 func (s *Service) filterPeer(node *enode.Node) bool {
 	// Ignore nil node entries passed in.
 	if node == nil {
@@ -438,48 +456,57 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 
 	// Ignore nodes with no IP address stored.
 	if node.IP() == nil {
+		discoveryRejectedPeers.WithLabelValues("no_ip").Inc()
 		return false
 	}
 
+	// Ignore nodes that don't match our fork digest. This is checked first as
+	// most of the nodes rejected are of other networks sharing the DHT.
+	nodeENR := node.Record()
+	if s.genesisValidatorsRoot != nil {
+		if err := s.compareForkENR(nodeENR); err != nil {
+			discoveryRejectedPeers.WithLabelValues(forkRejectReason(err)).Inc()
+			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
+			return false
+		}
+	}
+
 	peerData, multiAddrs, err := convertToAddrInfo(node)
 	if err != nil {
+		discoveryRejectedPeers.WithLabelValues("invalid_address").Inc()
 		log.WithError(err).Debug("Could not convert to peer data")
 		return false
 	}
 
 	if peerData == nil || len(multiAddrs) == 0 {
+		discoveryRejectedPeers.WithLabelValues("invalid_address").Inc()
 		return false
 	}
 
 	// Ignore bad nodes.
 	if s.peers.IsBad(peerData.ID) {
+		discoveryRejectedPeers.WithLabelValues("bad_peer").Inc()
 		return false
 	}
 
 	// Ignore nodes that are already active.
 	if s.peers.IsActive(peerData.ID) {
+		discoveryRejectedPeers.WithLabelValues("active").Inc()
 		return false
 	}
 
 	// Ignore nodes that are already connected.
 	if s.host.Network().Connectedness(peerData.ID) == network.Connected {
+		discoveryRejectedPeers.WithLabelValues("connected").Inc()
 		return false
 	}
 
 	// Ignore nodes that are not ready to receive incoming connections.
 	if !s.peers.IsReadyToDial(peerData.ID) {
+		discoveryRejectedPeers.WithLabelValues("not_ready_to_dial").Inc()
 		return false
 	}
 
-	// Ignore nodes that don't match our fork digest.
-	nodeENR := node.Record()
-	if s.genesisValidatorsRoot != nil {
-		if err := s.compareForkENR(nodeENR); err != nil {
-			log.WithError(err).Trace("Fork ENR mismatches between peer and local node")
-			return false
-		}
-	}
-
 	// If the peer has 2 multiaddrs, favor the QUIC address, which is in first position.
 	multiAddr := multiAddrs[0]
 
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/math"
 )
 
@@ -39,14 +40,18 @@ func (_ SszNetworkEncoder) EncodeGossip(w io.Writer, msg fastssz.Marshaler) (int
 	if msg == nil {
 		return 0, nil
 	}
-	b, err := msg.MarshalSSZ()
+	b, release, err := ssz.MarshalWithPool(msg)
 	if err != nil {
 		return 0, err
 	}
+	defer release()
 	if uint64(len(b)) > MaxGossipSize {
 		return 0, errors.Errorf("gossip message exceeds max gossip size: %d bytes > %d bytes", len(b), MaxGossipSize)
 	}
-	b = snappy.Encode(nil /*dst*/, b)
+	maxLen := snappy.MaxEncodedLen(len(b))
+	dst := ssz.GetBuffer(maxLen)
+	defer ssz.PutBuffer(dst)
+	b = snappy.Encode((*dst)[:maxLen], b)
 	return w.Write(b)
 }
 
@@ -56,10 +61,11 @@ func (_ SszNetworkEncoder) EncodeWithMaxLength(w io.Writer, msg fastssz.Marshale
 	if msg == nil {
 		return 0, nil
 	}
-	b, err := msg.MarshalSSZ()
+	b, release, err := ssz.MarshalWithPool(msg)
 	if err != nil {
 		return 0, err
 	}
+	defer release()
 	if uint64(len(b)) > MaxChunkSize {
 		return 0, fmt.Errorf(
 			"size of encoded message is %d which is larger than the provided max limit of %d",
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
@@ -28,28 +29,42 @@ func (s *Service) currentForkDigest() ([4]byte, error) {
 	return forks.CreateForkDigest(s.genesisTime, s.genesisValidatorsRoot)
 }
 
+var (
+	errNoForkEntry        = errors.New("no fork entry in peer ENR")
+	errInvalidForkEntry   = errors.New("invalid fork entry in peer ENR")
+	errOtherNetwork       = errors.New("peer is on another network")
+	errForkDigestMismatch = errors.New("fork digest of peer does not match local value")
+)
+
 // Compares fork ENRs between an incoming peer's record and our node's
 // local record values for current and next fork version/epoch.
+// Peers with a fork digest unknown to our network, such as those of
+// other chains sharing the discovery DHT, are told apart from peers of
+// our network on another fork.
 func (s *Service) compareForkENR(record *enr.Record) error {
-	currentRecord := s.dv5Listener.LocalNode().Node().Record()
-	peerForkENR, err := forkEntry(record)
+	peerForkENR, err := ForkEntry(record)
 	if err != nil {
-		return err
+		if enr.IsNotFound(err) {
+			return errNoForkEntry
+		}
+		return fmt.Errorf("%w: %v", errInvalidForkEntry, err)
 	}
-	currentForkENR, err := forkEntry(currentRecord)
-	if err != nil {
-		return err
-	}
-	enrString, err := SerializeENR(record)
+	currentRecord := s.dv5Listener.LocalNode().Node().Record()
+	currentForkENR, err := ForkEntry(currentRecord)
 	if err != nil {
 		return err
 	}
 	// Clients SHOULD connect to peers with current_fork_digest, next_fork_version,
 	// and next_fork_epoch that match local values.
 	if !bytes.Equal(peerForkENR.CurrentForkDigest, currentForkENR.CurrentForkDigest) {
-		return fmt.Errorf(
-			"fork digest of peer with ENR %s: %v, does not match local value: %v",
-			enrString,
+		reason := errForkDigestMismatch
+		if _, _, err := forks.RetrieveForkDataFromDigest(bytesutil.ToBytes4(peerForkENR.CurrentForkDigest), s.genesisValidatorsRoot); err != nil {
+			reason = errOtherNetwork
+		}
+		return errors.Wrapf(
+			reason,
+			"fork digest of peer with ENR %s: %v, local value: %v",
+			serializedENR(record),
 			peerForkENR.CurrentForkDigest,
 			currentForkENR.CurrentForkDigest,
 		)
@@ -62,18 +77,44 @@ func (s *Service) compareForkENR(record *enr.Record) error {
 	if peerForkENR.NextForkEpoch != currentForkENR.NextForkEpoch {
 		log.WithFields(logrus.Fields{
 			"peerNextForkEpoch": peerForkENR.NextForkEpoch,
-			"peerENR":           enrString,
+			"peerENR":           serializedENR(record),
 		}).Trace("Peer matches fork digest but has different next fork epoch")
 	}
 	if !bytes.Equal(peerForkENR.NextForkVersion, currentForkENR.NextForkVersion) {
 		log.WithFields(logrus.Fields{
 			"peerNextForkVersion": peerForkENR.NextForkVersion,
-			"peerENR":             enrString,
+			"peerENR":             serializedENR(record),
 		}).Trace("Peer matches fork digest but has different next fork version")
 	}
 	return nil
 }
 
+// forkRejectReason returns the label of the metric of rejected peers for an error of compareForkENR.
+func forkRejectReason(err error) string {
+	switch {
+	case errors.Is(err, errNoForkEntry):
+		return "no_fork_entry"
+	case errors.Is(err, errInvalidForkEntry):
+		return "invalid_fork_entry"
+	case errors.Is(err, errOtherNetwork):
+		return "other_network"
+	case errors.Is(err, errForkDigestMismatch):
+		return "fork_digest_mismatch"
+	default:
+		return "fork_check_failed"
+	}
+}
+
+// serializedENR returns the ENR of the record for logs, which is only
+// computed when needed as it is costly on the hot path of discovery.
+func serializedENR(record *enr.Record) string {
+	enrString, err := SerializeENR(record)
+	if err != nil {
+		return "invalid"
+	}
+	return enrString
+}
+
 // Adds a fork entry as an ENR record under the Ethereum consensus EnrKey for
 // the local node. The fork entry is an ssz-encoded enrForkID type
 // which takes into account the current fork version from the current
@@ -111,9 +152,9 @@ func addForkEntry(
 	return node, nil
 }
 
-// Retrieves an enrForkID from an ENR record by key lookup
+// ForkEntry retrieves an enrForkID from an ENR record by key lookup
 // under the Ethereum consensus EnrKey
-func forkEntry(record *enr.Record) (*pb.ENRForkID, error) {
+func ForkEntry(record *enr.Record) (*pb.ENRForkID, error) {
 	sszEncodedForkEntry := make([]byte, 16)
 	entry := enr.WithEntry(eth2ENRKey, &sszEncodedForkEntry)
 	err := record.Load(entry)
@@ -246,7 +246,7 @@ func TestDiscv5_AddRetrieveForkEntryENR(t *testing.T) {
 	want, err := signing.ComputeForkDigest([]byte{0, 0, 0, 0}, genesisValidatorsRoot)
 	require.NoError(t, err)
 
-	resp, err := forkEntry(localNode.Node().Record())
+	resp, err := ForkEntry(localNode.Node().Record())
 	require.NoError(t, err)
 	assert.DeepEqual(t, want[:], resp.CurrentForkDigest)
 	assert.DeepEqual(t, nextForkVersion, resp.NextForkVersion)
@@ -272,9 +272,97 @@ func TestAddForkEntry_Genesis(t *testing.T) {
 	localNode := enode.NewLocalNode(db, pkey)
 	localNode, err = addForkEntry(localNode, time.Now().Add(10*time.Second), bytesutil.PadTo([]byte{'A', 'B', 'C', 'D'}, 32))
 	require.NoError(t, err)
-	forkEntry, err := forkEntry(localNode.Node().Record())
+	forkEntry, err := ForkEntry(localNode.Node().Record())
 	require.NoError(t, err)
 	assert.DeepEqual(t,
 		params.BeaconConfig().GenesisForkVersion, forkEntry.NextForkVersion,
 		"Wanted Next Fork Version to be equal to genesis fork version")
 }
+
+type localNodeListener struct {
+	mockListener
+}
+
+func (l localNodeListener) LocalNode() *enode.LocalNode {
+	return l.localNode
+}
+
+func TestCompareForkENR_RejectReasons(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.ForkVersionSchedule = map[[4]byte]primitives.Epoch{
+		bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion): 0,
+		{0, 0, 0, 1}: 1000,
+	}
+	params.OverrideBeaconConfig(c)
+
+	genesisTime := time.Now()
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{'A'}, 32)
+	_, pkey := createAddrAndPrivKey(t)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode, err := addForkEntry(enode.NewLocalNode(db, pkey), genesisTime, genesisValidatorsRoot)
+	require.NoError(t, err)
+	s := &Service{
+		genesisTime:           genesisTime,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		dv5Listener:           localNodeListener{mockListener{localNode: localNode}},
+	}
+
+	peerRecord := func(t *testing.T, entry enr.Entry) *enr.Record {
+		_, peerKey := createAddrAndPrivKey(t)
+		peerDB, err := enode.OpenDB("")
+		require.NoError(t, err)
+		peerNode := enode.NewLocalNode(peerDB, peerKey)
+		if entry != nil {
+			peerNode.Set(entry)
+		}
+		return peerNode.Node().Record()
+	}
+	forkEntry := func(t *testing.T, version []byte, root []byte) enr.Entry {
+		digest, err := signing.ComputeForkDigest(version, root)
+		require.NoError(t, err)
+		enc, err := (&pb.ENRForkID{
+			CurrentForkDigest: digest[:],
+			NextForkVersion:   []byte{0, 0, 0, 1},
+			NextForkEpoch:     1000,
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		return enr.WithEntry(eth2ENRKey, enc)
+	}
+
+	tests := []struct {
+		name   string
+		entry  enr.Entry
+		reason string
+	}{
+		{
+			name:   "no fork entry",
+			reason: "no_fork_entry",
+		},
+		{
+			name:   "invalid fork entry",
+			entry:  enr.WithEntry(eth2ENRKey, []byte{1, 2, 3}),
+			reason: "invalid_fork_entry",
+		},
+		{
+			name:   "other network",
+			entry:  forkEntry(t, params.BeaconConfig().GenesisForkVersion, bytesutil.PadTo([]byte{'B'}, 32)),
+			reason: "other_network",
+		},
+		{
+			name:   "other fork",
+			entry:  forkEntry(t, []byte{0, 0, 0, 1}, genesisValidatorsRoot),
+			reason: "fork_digest_mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.compareForkENR(peerRecord(t, tt.entry))
+			require.NotNil(t, err)
+			assert.Equal(t, tt.reason, forkRejectReason(err))
+		})
+	}
+
+	require.NoError(t, s.compareForkENR(peerRecord(t, forkEntry(t, params.BeaconConfig().GenesisForkVersion, genesisValidatorsRoot))))
+}
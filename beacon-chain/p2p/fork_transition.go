@@ -0,0 +1,94 @@
+package p2p
+
+import (
+	"bytes"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const (
+	// forkTransitionEpochs is the number of epochs before a fork during which the node prefers peers which
+	// announce the next fork in their ENR, so that gossip meshes do not collapse at the fork.
+	forkTransitionEpochs = primitives.Epoch(4)
+	// forkTransitionDials is the number of peers announcing the next fork dialed beyond the peer limit during a
+	// fork transition, while fewer than half of the connected peers announce it. Peers which do not announce it
+	// are then pruned first, for lack of the score bonus of the peers announcing it.
+	forkTransitionDials = 4
+)
+
+// forkTransition returns the version and epoch of the next fork while the node is in the transition window
+// before it.
+func (s *Service) forkTransition() ([4]byte, primitives.Epoch, bool) {
+	if s.genesisTime.IsZero() || prysmTime.Now().Before(s.genesisTime) {
+		return [4]byte{}, 0, false
+	}
+	return forkTransitionAt(slots.ToEpoch(slots.Since(s.genesisTime)))
+}
+
+// forkTransitionAt returns the version and epoch of the next fork if it is at most forkTransitionEpochs after
+// the given epoch.
+func forkTransitionAt(current primitives.Epoch) ([4]byte, primitives.Epoch, bool) {
+	version, epoch, err := forks.NextForkData(current)
+	if err != nil || epoch == params.BeaconConfig().FarFutureEpoch || epoch-current > forkTransitionEpochs {
+		return [4]byte{}, 0, false
+	}
+	return version, epoch, true
+}
+
+// announcesFork returns true if the ENR fork ID of the record announces the given fork as its next fork.
+func announcesFork(record *enr.Record, version [4]byte, epoch primitives.Epoch) bool {
+	forkID, err := ForkEntry(record)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(forkID.NextForkVersion, version[:]) && forkID.NextForkEpoch == epoch
+}
+
+// announcesNextFork returns true if the node announces the next fork while the node is in a fork transition.
+func (s *Service) announcesNextFork(node *enode.Node) bool {
+	version, epoch, ok := s.forkTransition()
+	return ok && announcesFork(node.Record(), version, epoch)
+}
+
+// updateForkTransition gives a score bonus, when pruning, to the peers which announce the next fork while the
+// node is in a fork transition, and clears the bonus otherwise.
+func (s *Service) updateForkTransition() {
+	version, epoch, ok := s.forkTransition()
+	if !ok {
+		s.peers.SetPreferredPeers(nil)
+		return
+	}
+	s.peers.SetPreferredPeers(func(record *enr.Record) bool {
+		return announcesFork(record, version, epoch)
+	})
+}
+
+// needsNextForkPeers returns true while the node is in a fork transition and fewer than half of its connected
+// peers announce the next fork, as long as the node is below its peer limit plus the high watermark buffer.
+func (s *Service) needsNextForkPeers() bool {
+	version, epoch, ok := s.forkTransition()
+	if !ok {
+		return false
+	}
+	connected := s.peers.Connected()
+	if len(connected) >= int(s.cfg.MaxPeers)+highWatermarkBuffer {
+		return false
+	}
+	announcing := 0
+	for _, pid := range connected {
+		record, err := s.peers.ENR(pid)
+		if err != nil || record == nil {
+			continue
+		}
+		if announcesFork(record, version, epoch) {
+			announcing++
+		}
+	}
+	return announcing*2 < len(connected)
+}
@@ -0,0 +1,54 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestForkTransitionAt(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 100
+	cfg.ElectraForkEpoch = cfg.FarFutureEpoch
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+
+	_, _, ok := forkTransitionAt(100 - forkTransitionEpochs - 1)
+	assert.Equal(t, false, ok)
+	version, epoch, ok := forkTransitionAt(100 - forkTransitionEpochs)
+	require.Equal(t, true, ok)
+	assert.Equal(t, bytesutil.ToBytes4(cfg.DenebForkVersion), version)
+	assert.Equal(t, primitives.Epoch(100), epoch)
+	// Electra is not scheduled, so there is no transition after Deneb.
+	_, _, ok = forkTransitionAt(100)
+	assert.Equal(t, false, ok)
+}
+
+func TestAnnouncesFork(t *testing.T) {
+	version := [4]byte{4, 0, 0, 0}
+	record := func(nextVersion [4]byte, nextEpoch primitives.Epoch) *enr.Record {
+		enc, err := (&pb.ENRForkID{
+			CurrentForkDigest: make([]byte, 4),
+			NextForkVersion:   nextVersion[:],
+			NextForkEpoch:     nextEpoch,
+		}).MarshalSSZ()
+		require.NoError(t, err)
+		r := &enr.Record{}
+		r.Set(enr.WithEntry(eth2ENRKey, enc))
+		return r
+	}
+	assert.Equal(t, true, announcesFork(record(version, 100), version, 100))
+	assert.Equal(t, false, announcesFork(record(version, 101), version, 100))
+	assert.Equal(t, false, announcesFork(record([4]byte{3, 0, 0, 0}, 100), version, 100))
+	assert.Equal(t, false, announcesFork(&enr.Record{}, version, 100))
+}
@@ -0,0 +1,191 @@
+package p2p
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+)
+
+const (
+	gossipIngress = "ingress"
+	gossipEgress  = "egress"
+	// gossipBandwidthWindow is the period over which the gossip bytes of each topic kind are capped.
+	gossipBandwidthWindow = time.Minute
+	// messageKindsCacheSize is the number of message IDs whose topic kind is remembered, in order to cap the
+	// IWANT requests served for a topic kind. It covers the messages of the gossip history.
+	messageKindsCacheSize = 1 << 14
+)
+
+var subnetSuffix = regexp.MustCompile(`_\d+$`)
+
+// gossipTopicKind returns the name of a gossip topic without its fork digest, encoding and subnet index, so that
+// /eth2/{digest}/beacon_attestation_3/ssz_snappy becomes beacon_attestation.
+func gossipTopicKind(topic string) string {
+	parts := strings.Split(topic, "/")
+	if len(parts) != 5 {
+		return topic
+	}
+	return subnetSuffix.ReplaceAllString(parts[3], "")
+}
+
+// ParseGossipBandwidthCaps parses gossip bandwidth caps given as <topic kind>=<bytes per second>, such as
+// blob_sidecar=1048576, into the cap in bytes per second of each topic kind.
+func ParseGossipBandwidthCaps(values []string) (map[string]uint64, error) {
+	caps := make(map[string]uint64, len(values))
+	for _, v := range values {
+		kind, rate, ok := strings.Cut(v, "=")
+		if !ok || kind == "" {
+			return nil, errors.Errorf("invalid gossip bandwidth cap %q, expected <topic>=<bytes per second>", v)
+		}
+		bytesPerSecond, err := strconv.ParseUint(rate, 10, 64)
+		if err != nil || bytesPerSecond == 0 {
+			return nil, errors.Errorf("invalid bytes per second in gossip bandwidth cap %q", v)
+		}
+		caps[kind] = bytesPerSecond
+	}
+	return caps, nil
+}
+
+// gossipBandwidth accounts the bytes of the gossip messages received and sent on each topic, and applies soft
+// caps per topic kind. Once the bytes received for a topic kind exceed its cap in the current window, IHAVE
+// announcements of the topic kind are ignored. Once the bytes sent exceed it, IWANT requests for messages of the
+// topic kind are ignored. Messages pushed through the mesh are never dropped.
+type gossipBandwidth struct {
+	caps         map[string]uint64
+	messageKinds *lru.Cache
+	lock         sync.Mutex
+	windowStart  time.Time
+	ingress      map[string]uint64
+	egress       map[string]uint64
+}
+
+func newGossipBandwidth(caps map[string]uint64) *gossipBandwidth {
+	b := &gossipBandwidth{
+		caps:    caps,
+		ingress: make(map[string]uint64),
+		egress:  make(map[string]uint64),
+	}
+	if len(caps) > 0 {
+		b.messageKinds = lruwrpr.New(messageKindsCacheSize)
+	}
+	return b
+}
+
+// capped returns true when soft caps are configured.
+func (b *gossipBandwidth) capped() bool {
+	return b != nil && len(b.caps) > 0
+}
+
+// received accounts the messages of an RPC received from a peer.
+func (b *gossipBandwidth) received(rpc *pubsub.RPC) {
+	b.account(rpc, gossipIngress)
+}
+
+// sent accounts the messages of an RPC sent to a peer.
+func (b *gossipBandwidth) sent(rpc *pubsub.RPC) {
+	b.account(rpc, gossipEgress)
+}
+
+// delivered remembers the topic kind of a delivered message, which may be requested by peers through IWANT.
+func (b *gossipBandwidth) delivered(msg *pubsub.Message) {
+	if !b.capped() || msg.Topic == nil {
+		return
+	}
+	b.messageKinds.Add(msg.ID, gossipTopicKind(*msg.Topic))
+}
+
+func (b *gossipBandwidth) account(rpc *pubsub.RPC, direction string) {
+	if b == nil || len(rpc.Publish) == 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.rotateNoLock()
+	counts := b.ingress
+	if direction == gossipEgress {
+		counts = b.egress
+	}
+	for _, msg := range rpc.Publish {
+		topic := msg.GetTopic()
+		size := uint64(msg.Size())
+		pubsubTopicBytes.WithLabelValues(topic, direction).Add(float64(size))
+		counts[gossipTopicKind(topic)] += size
+	}
+}
+
+// inspect removes from an incoming RPC the IHAVE announcements and IWANT requests of the topic kinds which are
+// over their cap. It never rejects the RPC.
+func (b *gossipBandwidth) inspect(_ peer.ID, rpc *pubsub.RPC) error {
+	if !b.capped() || rpc.Control == nil {
+		return nil
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.rotateNoLock()
+
+	ihave := rpc.Control.Ihave[:0]
+	for _, msg := range rpc.Control.Ihave {
+		kind := gossipTopicKind(msg.GetTopicID())
+		if b.overCapNoLock(b.ingress, kind) {
+			pubsubBandwidthCapDropped.WithLabelValues(kind, "ihave").Add(float64(len(msg.MessageIDs)))
+			continue
+		}
+		ihave = append(ihave, msg)
+	}
+	rpc.Control.Ihave = ihave
+
+	for _, msg := range rpc.Control.Iwant {
+		ids := msg.MessageIDs[:0]
+		for _, id := range msg.MessageIDs {
+			if k, ok := b.messageKinds.Get(id); ok {
+				if kind, ok := k.(string); ok && b.overCapNoLock(b.egress, kind) {
+					pubsubBandwidthCapDropped.WithLabelValues(kind, "iwant").Inc()
+					continue
+				}
+			}
+			ids = append(ids, id)
+		}
+		msg.MessageIDs = ids
+	}
+	rpc.Control.Iwant = nonEmptyIwant(rpc.Control.Iwant)
+	return nil
+}
+
+func nonEmptyIwant(iwant []*pubsubpb.ControlIWant) []*pubsubpb.ControlIWant {
+	kept := iwant[:0]
+	for _, msg := range iwant {
+		if len(msg.MessageIDs) > 0 {
+			kept = append(kept, msg)
+		}
+	}
+	return kept
+}
+
+// overCapNoLock returns true when the bytes of the topic kind exceed its cap in the current window.
+func (b *gossipBandwidth) overCapNoLock(counts map[string]uint64, kind string) bool {
+	bytesPerSecond, ok := b.caps[kind]
+	if !ok {
+		return false
+	}
+	return counts[kind] > bytesPerSecond*uint64(gossipBandwidthWindow/time.Second)
+}
+
+// rotateNoLock starts a new window once the current one is over.
+func (b *gossipBandwidth) rotateNoLock() {
+	now := time.Now()
+	if now.Sub(b.windowStart) < gossipBandwidthWindow {
+		return
+	}
+	b.windowStart = now
+	b.ingress = make(map[string]uint64)
+	b.egress = make(map[string]uint64)
+}
@@ -0,0 +1,69 @@
+package p2p
+
+import (
+	"testing"
+
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestGossipTopicKind(t *testing.T) {
+	assert.Equal(t, "beacon_attestation", gossipTopicKind("/eth2/b5303f2a/beacon_attestation_12/ssz_snappy"))
+	assert.Equal(t, "blob_sidecar", gossipTopicKind("/eth2/b5303f2a/blob_sidecar_0/ssz_snappy"))
+	assert.Equal(t, "beacon_block", gossipTopicKind("/eth2/b5303f2a/beacon_block/ssz_snappy"))
+	assert.Equal(t, "junk", gossipTopicKind("junk"))
+}
+
+func TestParseGossipBandwidthCaps(t *testing.T) {
+	caps, err := ParseGossipBandwidthCaps([]string{"blob_sidecar=1024", "beacon_attestation=2048"})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]uint64{"blob_sidecar": 1024, "beacon_attestation": 2048}, caps)
+
+	_, err = ParseGossipBandwidthCaps([]string{"blob_sidecar"})
+	require.ErrorContains(t, "expected <topic>=<bytes per second>", err)
+	_, err = ParseGossipBandwidthCaps([]string{"blob_sidecar=0"})
+	require.ErrorContains(t, "invalid bytes per second", err)
+}
+
+func TestGossipBandwidth_Inspect(t *testing.T) {
+	blobTopic := "/eth2/b5303f2a/blob_sidecar_1/ssz_snappy"
+	blockTopic := "/eth2/b5303f2a/beacon_block/ssz_snappy"
+	b := newGossipBandwidth(map[string]uint64{"blob_sidecar": 1})
+	publish := func(topic string, size int) *pubsub.RPC {
+		return &pubsub.RPC{RPC: pubsubpb.RPC{Publish: []*pubsubpb.Message{{Topic: &topic, Data: make([]byte, size)}}}}
+	}
+	control := func() *pubsub.RPC {
+		return &pubsub.RPC{RPC: pubsubpb.RPC{Control: &pubsubpb.ControlMessage{
+			Ihave: []*pubsubpb.ControlIHave{
+				{TopicID: &blobTopic, MessageIDs: []string{"a"}},
+				{TopicID: &blockTopic, MessageIDs: []string{"b"}},
+			},
+			Iwant: []*pubsubpb.ControlIWant{{MessageIDs: []string{"blob", "block"}}},
+		}}}
+	}
+	b.delivered(&pubsub.Message{Message: &pubsubpb.Message{Topic: &blobTopic}, ID: "blob"})
+	b.delivered(&pubsub.Message{Message: &pubsubpb.Message{Topic: &blockTopic}, ID: "block"})
+
+	// Below the caps, control messages are kept.
+	rpc := control()
+	require.NoError(t, b.inspect("", rpc))
+	assert.Equal(t, 2, len(rpc.Control.Ihave))
+	assert.DeepEqual(t, []string{"blob", "block"}, rpc.Control.Iwant[0].MessageIDs)
+
+	// Once the blob sidecar ingress exceeds its cap, IHAVE of blob sidecars are ignored.
+	b.received(publish(blobTopic, 61))
+	rpc = control()
+	require.NoError(t, b.inspect("", rpc))
+	require.Equal(t, 1, len(rpc.Control.Ihave))
+	assert.Equal(t, blockTopic, rpc.Control.Ihave[0].GetTopicID())
+	assert.DeepEqual(t, []string{"blob", "block"}, rpc.Control.Iwant[0].MessageIDs)
+
+	// Once the blob sidecar egress exceeds its cap, IWANT of blob sidecars are ignored.
+	b.sent(publish(blobTopic, 61))
+	b.sent(publish(blockTopic, 1000))
+	rpc = control()
+	require.NoError(t, b.inspect("", rpc))
+	assert.DeepEqual(t, []string{"block"}, rpc.Control.Iwant[0].MessageIDs)
+}
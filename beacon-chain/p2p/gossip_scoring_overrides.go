@@ -0,0 +1,141 @@
+package p2p
+
+import (
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"gopkg.in/yaml.v2"
+)
+
+// ScoreParamsOverrides override the gossipsub peer scoring parameters, so that scoring can be tuned on devnets
+// without recompiling. Fields which are not set keep their default value. Topic overrides are keyed by topic
+// kind, such as beacon_attestation for all the attestation subnets.
+type ScoreParamsOverrides struct {
+	Thresholds *scoreThresholdsOverrides       `yaml:"thresholds"`
+	Peer       *peerScoreOverrides             `yaml:"peer"`
+	Topics     map[string]*topicScoreOverrides `yaml:"topics"`
+}
+
+type scoreThresholdsOverrides struct {
+	GossipThreshold             *float64 `yaml:"gossip_threshold"`
+	PublishThreshold            *float64 `yaml:"publish_threshold"`
+	GraylistThreshold           *float64 `yaml:"graylist_threshold"`
+	AcceptPXThreshold           *float64 `yaml:"accept_px_threshold"`
+	OpportunisticGraftThreshold *float64 `yaml:"opportunistic_graft_threshold"`
+}
+
+type peerScoreOverrides struct {
+	TopicScoreCap               *float64 `yaml:"topic_score_cap"`
+	AppSpecificWeight           *float64 `yaml:"app_specific_weight"`
+	IPColocationFactorWeight    *float64 `yaml:"ip_colocation_factor_weight"`
+	IPColocationFactorThreshold *int     `yaml:"ip_colocation_factor_threshold"`
+	BehaviourPenaltyWeight      *float64 `yaml:"behaviour_penalty_weight"`
+	BehaviourPenaltyThreshold   *float64 `yaml:"behaviour_penalty_threshold"`
+	BehaviourPenaltyDecay       *float64 `yaml:"behaviour_penalty_decay"`
+}
+
+type topicScoreOverrides struct {
+	TopicWeight                    *float64 `yaml:"topic_weight"`
+	TimeInMeshWeight               *float64 `yaml:"time_in_mesh_weight"`
+	TimeInMeshCap                  *float64 `yaml:"time_in_mesh_cap"`
+	FirstMessageDeliveriesWeight   *float64 `yaml:"first_message_deliveries_weight"`
+	FirstMessageDeliveriesDecay    *float64 `yaml:"first_message_deliveries_decay"`
+	FirstMessageDeliveriesCap      *float64 `yaml:"first_message_deliveries_cap"`
+	MeshMessageDeliveriesWeight    *float64 `yaml:"mesh_message_deliveries_weight"`
+	MeshMessageDeliveriesDecay     *float64 `yaml:"mesh_message_deliveries_decay"`
+	MeshMessageDeliveriesCap       *float64 `yaml:"mesh_message_deliveries_cap"`
+	MeshMessageDeliveriesThreshold *float64 `yaml:"mesh_message_deliveries_threshold"`
+	MeshFailurePenaltyWeight       *float64 `yaml:"mesh_failure_penalty_weight"`
+	MeshFailurePenaltyDecay        *float64 `yaml:"mesh_failure_penalty_decay"`
+	InvalidMessageDeliveriesWeight *float64 `yaml:"invalid_message_deliveries_weight"`
+	InvalidMessageDeliveriesDecay  *float64 `yaml:"invalid_message_deliveries_decay"`
+}
+
+// scoredTopicKinds are the topic kinds whose scoring parameters can be overridden.
+var scoredTopicKinds = map[string]bool{
+	GossipBlockMessage:                true,
+	GossipAggregateAndProofMessage:    true,
+	GossipAttestationMessage:          true,
+	GossipSyncCommitteeMessage:        true,
+	GossipContributionAndProofMessage: true,
+	GossipExitMessage:                 true,
+	GossipProposerSlashingMessage:     true,
+	GossipAttesterSlashingMessage:     true,
+	GossipBlsToExecutionChangeMessage: true,
+	GossipBlobSidecarMessage:          true,
+}
+
+// LoadScoreParamsOverrides reads the peer scoring overrides of a YAML file.
+func LoadScoreParamsOverrides(path string) (*ScoreParamsOverrides, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read peer scoring file")
+	}
+	o := &ScoreParamsOverrides{}
+	if err := yaml.UnmarshalStrict(enc, o); err != nil {
+		return nil, errors.Wrap(err, "could not parse peer scoring file")
+	}
+	for kind, t := range o.Topics {
+		if !scoredTopicKinds[kind] {
+			return nil, errors.Errorf("unknown topic %q in peer scoring file", kind)
+		}
+		if t == nil {
+			return nil, errors.Errorf("no parameters for topic %q in peer scoring file", kind)
+		}
+	}
+	return o, nil
+}
+
+// applyPeer overrides the peer scoring parameters and thresholds.
+func (o *ScoreParamsOverrides) applyPeer(p *pubsub.PeerScoreParams, t *pubsub.PeerScoreThresholds) {
+	if o == nil {
+		return
+	}
+	if th := o.Thresholds; th != nil {
+		override(&t.GossipThreshold, th.GossipThreshold)
+		override(&t.PublishThreshold, th.PublishThreshold)
+		override(&t.GraylistThreshold, th.GraylistThreshold)
+		override(&t.AcceptPXThreshold, th.AcceptPXThreshold)
+		override(&t.OpportunisticGraftThreshold, th.OpportunisticGraftThreshold)
+	}
+	if ps := o.Peer; ps != nil {
+		override(&p.TopicScoreCap, ps.TopicScoreCap)
+		override(&p.AppSpecificWeight, ps.AppSpecificWeight)
+		override(&p.IPColocationFactorWeight, ps.IPColocationFactorWeight)
+		override(&p.IPColocationFactorThreshold, ps.IPColocationFactorThreshold)
+		override(&p.BehaviourPenaltyWeight, ps.BehaviourPenaltyWeight)
+		override(&p.BehaviourPenaltyThreshold, ps.BehaviourPenaltyThreshold)
+		override(&p.BehaviourPenaltyDecay, ps.BehaviourPenaltyDecay)
+	}
+}
+
+// applyTopic overrides the scoring parameters of a topic with the overrides of its topic kind.
+func (o *ScoreParamsOverrides) applyTopic(topic string, p *pubsub.TopicScoreParams) {
+	if o == nil || p == nil {
+		return
+	}
+	t, ok := o.Topics[gossipTopicKind(topic)]
+	if !ok {
+		return
+	}
+	override(&p.TopicWeight, t.TopicWeight)
+	override(&p.TimeInMeshWeight, t.TimeInMeshWeight)
+	override(&p.TimeInMeshCap, t.TimeInMeshCap)
+	override(&p.FirstMessageDeliveriesWeight, t.FirstMessageDeliveriesWeight)
+	override(&p.FirstMessageDeliveriesDecay, t.FirstMessageDeliveriesDecay)
+	override(&p.FirstMessageDeliveriesCap, t.FirstMessageDeliveriesCap)
+	override(&p.MeshMessageDeliveriesWeight, t.MeshMessageDeliveriesWeight)
+	override(&p.MeshMessageDeliveriesDecay, t.MeshMessageDeliveriesDecay)
+	override(&p.MeshMessageDeliveriesCap, t.MeshMessageDeliveriesCap)
+	override(&p.MeshMessageDeliveriesThreshold, t.MeshMessageDeliveriesThreshold)
+	override(&p.MeshFailurePenaltyWeight, t.MeshFailurePenaltyWeight)
+	override(&p.MeshFailurePenaltyDecay, t.MeshFailurePenaltyDecay)
+	override(&p.InvalidMessageDeliveriesWeight, t.InvalidMessageDeliveriesWeight)
+	override(&p.InvalidMessageDeliveriesDecay, t.InvalidMessageDeliveriesDecay)
+}
+
+func override[T any](dst *T, v *T) {
+	if v != nil {
+		*dst = *v
+	}
+}
@@ -0,0 +1,62 @@
+package p2p
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func writeScoreParamsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "scoring.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadScoreParamsOverrides(t *testing.T) {
+	path := writeScoreParamsFile(t, `
+thresholds:
+  graylist_threshold: -20000
+peer:
+  behaviour_penalty_weight: -10
+  ip_colocation_factor_threshold: 20
+topics:
+  beacon_attestation:
+    invalid_message_deliveries_weight: -100
+`)
+	o, err := LoadScoreParamsOverrides(path)
+	require.NoError(t, err)
+
+	scoreParams, thresholds := peerScoringParams()
+	defaultScoreParams, defaultThresholds := peerScoringParams()
+	o.applyPeer(scoreParams, thresholds)
+	assert.Equal(t, float64(-20000), thresholds.GraylistThreshold)
+	assert.Equal(t, defaultThresholds.GossipThreshold, thresholds.GossipThreshold)
+	assert.Equal(t, float64(-10), scoreParams.BehaviourPenaltyWeight)
+	assert.Equal(t, 20, scoreParams.IPColocationFactorThreshold)
+	assert.Equal(t, defaultScoreParams.TopicScoreCap, scoreParams.TopicScoreCap)
+
+	topicParams := defaultAggregateSubnetTopicParams(1 << 16)
+	o.applyTopic("/eth2/b5303f2a/beacon_attestation_5/ssz_snappy", topicParams)
+	assert.Equal(t, float64(-100), topicParams.InvalidMessageDeliveriesWeight)
+	assert.Equal(t, defaultAggregateSubnetTopicParams(1<<16).TopicWeight, topicParams.TopicWeight)
+
+	blockParams := defaultBlockTopicParams()
+	o.applyTopic("/eth2/b5303f2a/beacon_block/ssz_snappy", blockParams)
+	assert.DeepEqual(t, defaultBlockTopicParams(), blockParams)
+
+	// Without overrides, parameters are unchanged.
+	var none *ScoreParamsOverrides
+	none.applyPeer(scoreParams, thresholds)
+	none.applyTopic("/eth2/b5303f2a/beacon_block/ssz_snappy", blockParams)
+}
+
+func TestLoadScoreParamsOverrides_Invalid(t *testing.T) {
+	_, err := LoadScoreParamsOverrides(writeScoreParamsFile(t, "peer:\n  unknown_weight: 1\n"))
+	require.ErrorContains(t, "could not parse peer scoring file", err)
+
+	_, err = LoadScoreParamsOverrides(writeScoreParamsFile(t, "topics:\n  beacon_blocks:\n    topic_weight: 1\n"))
+	require.ErrorContains(t, "unknown topic \"beacon_blocks\"", err)
+}
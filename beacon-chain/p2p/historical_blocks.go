@@ -0,0 +1,94 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+)
+
+// historicalBlocksEnrKey is the custom ENR key of the nodes serving blocks older than
+// MIN_EPOCHS_FOR_BLOCK_REQUESTS over by-range requests. Its value is the earliest slot the node serves.
+const historicalBlocksEnrKey = "hblk"
+
+// historicalBlocksRefreshPeriod is how often the historical blocks entry follows the progress of backfill.
+const historicalBlocksRefreshPeriod = time.Minute
+
+// addHistoricalBlocksEntry advertises the earliest slot of the blocks the node serves in its ENR. A node
+// synced from genesis serves every block, a checkpoint synced node serves the blocks it has backfilled.
+// The entry is omitted while the earliest slot is unknown.
+func addHistoricalBlocksEntry(ctx context.Context, node *enode.LocalNode, beaconDB db.ReadOnlyDatabase) (*enode.LocalNode, error) {
+	earliest, ok, err := earliestServedSlot(ctx, beaconDB)
+	if err != nil {
+		return nil, err
+	}
+	setHistoricalBlocksEntry(node, earliest, ok)
+	return node, nil
+}
+
+// earliestServedSlot returns the earliest slot of the blocks stored in the database, and false if it is
+// unknown, like before backfill records the status of a checkpoint synced node.
+func earliestServedSlot(ctx context.Context, beaconDB db.ReadOnlyDatabase) (primitives.Slot, bool, error) {
+	if beaconDB == nil {
+		return 0, false, nil
+	}
+	status, err := beaconDB.BackfillStatus(ctx)
+	if err == nil {
+		return primitives.Slot(status.LowSlot), true, nil
+	}
+	if !db.IsNotFound(err) {
+		return 0, false, errors.Wrap(err, "could not get backfill status")
+	}
+	// Without a backfill status, only a node without an origin checkpoint was synced from genesis.
+	_, err = beaconDB.OriginCheckpointBlockRoot(ctx)
+	switch {
+	case errors.Is(err, db.ErrNotFoundOriginBlockRoot):
+		return 0, true, nil
+	case err != nil:
+		return 0, false, errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	return 0, false, nil
+}
+
+func setHistoricalBlocksEntry(node *enode.LocalNode, earliest primitives.Slot, ok bool) {
+	if !ok {
+		node.Delete(enr.WithEntry(historicalBlocksEnrKey, uint64(0)))
+		return
+	}
+	node.Set(enr.WithEntry(historicalBlocksEnrKey, uint64(earliest)))
+}
+
+// refreshHistoricalBlocksEntry updates the historical blocks entry of the node's ENR as backfill advances.
+func (s *Service) refreshHistoricalBlocksEntry() {
+	if s.dv5Listener == nil {
+		return
+	}
+	earliest, ok, err := earliestServedSlot(s.ctx, s.cfg.DB)
+	if err != nil {
+		log.WithError(err).Error("Could not refresh historical blocks entry")
+		return
+	}
+	current, currentOk := HistoricalBlocksEntry(s.dv5Listener.Self().Record())
+	if ok == currentOk && earliest == current {
+		return
+	}
+	setHistoricalBlocksEntry(s.dv5Listener.LocalNode(), earliest, ok)
+	log.WithField("earliestSlot", earliest).Debug("Updated historical blocks entry of the ENR")
+}
+
+// HistoricalBlocksEntry returns the earliest slot of the blocks a node serves over by-range requests, as
+// advertised in its ENR, and false if the node doesn't serve blocks older than MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func HistoricalBlocksEntry(record *enr.Record) (primitives.Slot, bool) {
+	if record == nil {
+		return 0, false
+	}
+	var earliest uint64
+	if err := record.Load(enr.WithEntry(historicalBlocksEnrKey, &earliest)); err != nil {
+		return 0, false
+	}
+	return primitives.Slot(earliest), true
+}
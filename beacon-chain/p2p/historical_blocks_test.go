@@ -0,0 +1,113 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestCreateLocalNode_HistoricalBlocks(t *testing.T) {
+	ctx := context.Background()
+	d := testDB.SetupDB(t)
+	address, privKey := createAddrAndPrivKey(t)
+	service := &Service{
+		ctx:                   ctx,
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+		cfg:                   &Config{DB: d},
+	}
+
+	localNode, err := service.createLocalNode(privKey, address, 2000, 3000, 3000)
+	require.NoError(t, err)
+	_, ok := HistoricalBlocksEntry(localNode.Node().Record())
+	assert.Equal(t, false, ok)
+
+	// A node synced from genesis serves every block.
+	service.cfg.ServeHistoricalBlocks = true
+	localNode, err = service.createLocalNode(privKey, address, 2000, 3000, 3000)
+	require.NoError(t, err)
+	earliest, ok := HistoricalBlocksEntry(localNode.Node().Record())
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(0), earliest)
+
+	// The earliest slot of a checkpoint synced node is unknown until backfill records its status.
+	concreteDB, ok := d.(*kv.Store)
+	require.Equal(t, true, ok)
+	require.NoError(t, concreteDB.SaveOriginCheckpointBlockRoot(ctx, [32]byte{'o'}))
+	localNode, err = service.createLocalNode(privKey, address, 2000, 3000, 3000)
+	require.NoError(t, err)
+	_, ok = HistoricalBlocksEntry(localNode.Node().Record())
+	assert.Equal(t, false, ok)
+
+	// A checkpoint synced node serves the blocks it has backfilled.
+	require.NoError(t, d.SaveBackfillStatus(ctx, &dbval.BackfillStatus{LowSlot: 100, OriginSlot: 200}))
+	localNode, err = service.createLocalNode(privKey, address, 2000, 3000, 3000)
+	require.NoError(t, err)
+	earliest, ok = HistoricalBlocksEntry(localNode.Node().Record())
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(100), earliest)
+}
+
+func TestRefreshHistoricalBlocksEntry(t *testing.T) {
+	ctx := context.Background()
+	d := testDB.SetupDB(t)
+	concreteDB, ok := d.(*kv.Store)
+	require.Equal(t, true, ok)
+	require.NoError(t, concreteDB.SaveOriginCheckpointBlockRoot(ctx, [32]byte{'o'}))
+	nodeDB, err := enode.OpenDB(t.TempDir())
+	require.NoError(t, err)
+	_, key := createAddrAndPrivKey(t)
+	node := enode.NewLocalNode(nodeDB, key)
+	node.Set(enr.WithEntry(historicalBlocksEnrKey, uint64(0)))
+	service := &Service{
+		ctx:         ctx,
+		cfg:         &Config{DB: d, ServeHistoricalBlocks: true},
+		dv5Listener: mockListener{localNode: node},
+	}
+
+	// The entry is removed while the earliest slot is unknown.
+	service.refreshHistoricalBlocksEntry()
+	_, ok = HistoricalBlocksEntry(node.Node().Record())
+	assert.Equal(t, false, ok)
+
+	// The entry follows the progress of backfill.
+	require.NoError(t, d.SaveBackfillStatus(ctx, &dbval.BackfillStatus{LowSlot: 100, OriginSlot: 200}))
+	service.refreshHistoricalBlocksEntry()
+	earliest, ok := HistoricalBlocksEntry(node.Node().Record())
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(100), earliest)
+
+	seq := node.Seq()
+	service.refreshHistoricalBlocksEntry()
+	assert.Equal(t, seq, node.Seq())
+
+	require.NoError(t, d.SaveBackfillStatus(ctx, &dbval.BackfillStatus{LowSlot: 50, OriginSlot: 200}))
+	service.refreshHistoricalBlocksEntry()
+	earliest, ok = HistoricalBlocksEntry(node.Node().Record())
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(50), earliest)
+}
+
+func TestHistoricalBlocksEntry(t *testing.T) {
+	_, ok := HistoricalBlocksEntry(nil)
+	assert.Equal(t, false, ok)
+
+	record := &enr.Record{}
+	_, ok = HistoricalBlocksEntry(record)
+	assert.Equal(t, false, ok)
+
+	record.Set(enr.WithEntry(historicalBlocksEnrKey, uint64(64)))
+	earliest, ok := HistoricalBlocksEntry(record)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, primitives.Slot(64), earliest)
+}
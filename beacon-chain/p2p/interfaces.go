@@ -36,6 +36,7 @@ type Broadcaster interface {
 	BroadcastAttestation(ctx context.Context, subnet uint64, att ethpb.Att) error
 	BroadcastSyncCommitteeMessage(ctx context.Context, subnet uint64, sMsg *ethpb.SyncCommitteeMessage) error
 	BroadcastBlob(ctx context.Context, subnet uint64, blob *ethpb.BlobSidecar) error
+	Rebroadcast(context.Context, proto.Message) error
 }
 
 // SetStreamHandler configures p2p to handle streams of a certain topic ID.
@@ -102,3 +103,13 @@ type MetadataProvider interface {
 	Metadata() metadata.Metadata
 	MetadataSeq() uint64
 }
+
+// PeerScoresFetcher returns the gossipsub peer score snapshots of the connected peers.
+type PeerScoresFetcher interface {
+	PeerScores() map[peer.ID]*pubsub.PeerScoreSnapshot
+}
+
+// ReachabilityFetcher returns whether the node is reachable by peers.
+type ReachabilityFetcher interface {
+	Reachability() *Reachability
+}
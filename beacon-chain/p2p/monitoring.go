@@ -24,6 +24,10 @@ var (
 		Help: "The number of peers in a given state.",
 	},
 		[]string{"state"})
+	reachabilityGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "p2p_reachability",
+		Help: "The reachability of the node by peers: 0 for unknown, 1 for public and 2 for private.",
+	})
 	connectedPeersCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "connected_libp2p_peers",
 		Help: "Tracks the total number of connected libp2p peers by agent string",
@@ -36,6 +40,11 @@ var (
 	},
 		[]string{"agent"},
 	)
+	discoveryRejectedPeers = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_discovery_rejected_peers_total",
+		Help: "The number of nodes found by discovery which are not dialed, by reason.",
+	},
+		[]string{"reason"})
 	repeatPeerConnections = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "p2p_repeat_attempts",
 		Help: "The number of repeat attempts the connection handler is triggered for a peer.",
@@ -161,6 +170,17 @@ var (
 		Help: "The number of publish messages sent via rpc for a particular topic",
 	},
 		[]string{"topic"})
+	pubsubTopicBytes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_pubsub_topic_bytes_total",
+		Help: "The number of bytes of the gossip messages received (ingress) and sent (egress) on a particular topic",
+	},
+		[]string{"topic", "direction"})
+	pubsubBandwidthCapDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "p2p_pubsub_bandwidth_cap_dropped_total",
+		Help: "The number of message IDs of IHAVE and IWANT control messages ignored because the gossip " +
+			"bandwidth of their topic kind exceeded its cap",
+	},
+		[]string{"topic_kind", "control_message"})
 )
 
 func (s *Service) updateMetrics() {
@@ -170,6 +190,7 @@ func (s *Service) updateMetrics() {
 	p2pPeerCount.WithLabelValues("Connecting").Set(float64(len(s.peers.Connecting())))
 	p2pPeerCount.WithLabelValues("Disconnecting").Set(float64(len(s.peers.Disconnecting())))
 	p2pPeerCount.WithLabelValues("Bad").Set(float64(len(s.peers.Bad())))
+	reachabilityGauge.Set(float64(s.Reachability().Reachability))
 
 	store := s.Host().Peerstore()
 	numConnectedPeersByClient := make(map[string]float64)
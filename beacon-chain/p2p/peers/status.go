@@ -75,6 +75,10 @@ const (
 	MinBackOffDuration = 100
 	// MaxBackOffDuration maximum amount (in milliseconds) to wait before peer is re-dialed.
 	MaxBackOffDuration = 5000
+
+	// preferredPeerScoreBonus is added to the score of the preferred peers when selecting the peers to prune, so
+	// that they are retained over the other peers of a similar score.
+	preferredPeerScoreBonus = 0.5
 )
 
 type InternetProtocol string
@@ -91,6 +95,8 @@ type Status struct {
 	store     *peerdata.Store
 	ipTracker map[string]uint64
 	rand      *rand.Rand
+	// preferred selects, from their ENR, the peers given a score bonus when pruning. Guarded by the store lock.
+	preferred func(record *enr.Record) bool
 }
 
 // StatusConfig represents peer status service params.
@@ -122,6 +128,25 @@ func (p *Status) Scorers() *scorers.Service {
 	return p.scorers
 }
 
+// SetPreferredPeers sets a predicate on the ENR of peers which selects the peers given a score bonus when
+// selecting the connected peers to prune, for instance the peers announcing the next fork around a fork
+// transition. A nil predicate clears the preference.
+func (p *Status) SetPreferredPeers(preferred func(record *enr.Record) bool) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.preferred = preferred
+}
+
+// pruneScoreNoLock returns the score of the peer used to select the peers to prune, which includes the bonus of
+// the preferred peers. The store lock must be held.
+func (p *Status) pruneScoreNoLock(pid peer.ID, peerData *peerdata.PeerData) float64 {
+	score := p.scorers.ScoreNoLock(pid)
+	if p.preferred != nil && peerData.Enr != nil && p.preferred(peerData.Enr) {
+		score += preferredPeerScoreBonus
+	}
+	return score
+}
+
 // MaxPeerLimit returns the max peer limit stored in the current peer store.
 func (p *Status) MaxPeerLimit() int {
 	return p.store.Config().MaxPeers
@@ -818,7 +843,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:   pid,
-				score: p.scorers.ScoreNoLock(pid),
+				score: p.pruneScoreNoLock(pid, peerData),
 			})
 		}
 	}
@@ -860,6 +860,60 @@ func TestPrunePeers_TrustedPeers(t *testing.T) {
 	}
 }
 
+func TestPrunePeers_PreferredPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 10,
+			},
+		},
+	})
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	preferred := make(map[*enr.Record]bool)
+	notPreferred := make(map[peer.ID]bool)
+	var preferredPeer peer.ID
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		if i%6 == 0 {
+			notPreferred[pid] = true
+			continue
+		}
+		// Preferred peers have lower scores, so they would be pruned first without the bonus.
+		p.Scorers().BadResponsesScorer().Increment(pid)
+		preferredPeer = pid
+		record, err := p.ENR(pid)
+		require.NoError(t, err)
+		preferred[record] = true
+	}
+	p.SetPreferredPeers(func(record *enr.Record) bool {
+		return preferred[record]
+	})
+
+	peersToPrune := p.PeersToPrune()
+	require.Equal(t, 3, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, true, notPreferred[pid])
+	}
+
+	// The bonus does not save a preferred peer with a much lower score.
+	for i := 0; i < 10; i++ {
+		p.Scorers().BadResponsesScorer().Increment(preferredPeer)
+	}
+	peersToPrune = p.PeersToPrune()
+	require.Equal(t, 3, len(peersToPrune))
+	assert.Equal(t, preferredPeer, peersToPrune[0])
+
+	p.SetPreferredPeers(nil)
+	peersToPrune = p.PeersToPrune()
+	require.Equal(t, 3, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, notPreferred[pid])
+	}
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       primitives.Slot
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	pbrpc "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
 )
 
 const (
@@ -38,6 +39,9 @@ const (
 	rSubD = 8 // random gossip target
 )
 
+// ErrGossipObserver occurs on a publish attempt of a node running as a gossip observer.
+var ErrGossipObserver = errors.New("gossip observers do not publish messages")
+
 var errInvalidTopic = errors.New("invalid topic format")
 
 // Specifies the fixed size context length.
@@ -79,10 +83,16 @@ func (s *Service) LeaveTopic(topic string) error {
 
 // PublishToTopic joins (if necessary) and publishes a message to a PubSub topic.
 func (s *Service) PublishToTopic(ctx context.Context, topic string, data []byte, opts ...pubsub.PubOpt) error {
+	if flags.Get().GossipObserver {
+		return ErrGossipObserver
+	}
 	topicHandle, err := s.JoinTopic(topic)
 	if err != nil {
 		return err
 	}
+	if err := faultinjection.DelayGossip(ctx); err != nil {
+		return err
+	}
 
 	// Wait for at least 1 peer to be available to receive the published message.
 	for {
@@ -111,6 +121,7 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 		return nil, err
 	}
 
+	s.cfg.ScoreParamsOverrides.applyTopic(topic, scoringParams)
 	if scoringParams != nil {
 		if err := topicHandle.SetScoreParams(scoringParams); err != nil {
 			return nil, err
@@ -129,24 +140,40 @@ func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
 		s.peers.Scorers().GossipScorer().SetGossipData(pid, snap.Score,
 			snap.BehaviourPenalty, convertTopicScores(snap.Topics))
 	}
+	s.peerScoresLock.Lock()
+	s.peerScores = peerMap
+	s.peerScoresLock.Unlock()
+}
+
+// PeerScores returns the latest gossipsub peer score snapshots, which are refreshed every minute.
+func (s *Service) PeerScores() map[peer.ID]*pubsub.PeerScoreSnapshot {
+	s.peerScoresLock.RLock()
+	defer s.peerScoresLock.RUnlock()
+	return s.peerScores
 }
 
 // pubsubOptions creates a list of options to configure our router with.
 func (s *Service) pubsubOptions() []pubsub.Option {
+	scoreParams, thresholds := peerScoringParams()
+	s.cfg.ScoreParamsOverrides.applyPeer(scoreParams, thresholds)
 	psOpts := []pubsub.Option{
 		pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
 		pubsub.WithNoAuthor(),
 		pubsub.WithMessageIdFn(func(pmsg *pubsubpb.Message) string {
 			return MsgID(s.genesisValidatorsRoot, pmsg)
 		}),
+		pubsub.WithSeenMessagesTTL(SeenMessagesTTL),
 		pubsub.WithSubscriptionFilter(s),
 		pubsub.WithPeerOutboundQueueSize(int(s.cfg.QueueSize)),
 		pubsub.WithMaxMessageSize(int(params.BeaconConfig().GossipMaxSize)),
 		pubsub.WithValidateQueueSize(int(s.cfg.QueueSize)),
-		pubsub.WithPeerScore(peerScoringParams()),
+		pubsub.WithPeerScore(scoreParams, thresholds),
 		pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute),
 		pubsub.WithGossipSubParams(pubsubGossipParam()),
-		pubsub.WithRawTracer(gossipTracer{host: s.host}),
+		pubsub.WithRawTracer(gossipTracer{host: s.host, bandwidth: s.gossipBandwidth}),
+	}
+	if s.gossipBandwidth.capped() {
+		psOpts = append(psOpts, pubsub.WithAppSpecificRpcInspector(s.gossipBandwidth.inspect))
 	}
 
 	if len(s.cfg.StaticPeers) > 0 {
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/encoder"
 	testp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -57,6 +58,16 @@ func TestService_PublishToTopicConcurrentMapWrite(t *testing.T) {
 	wg.Wait()
 }
 
+func TestService_PublishToTopic_GossipObserver(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{GossipObserver: true})
+	defer flags.Init(resetFlags)
+
+	s := &Service{}
+	err := s.PublishToTopic(context.Background(), "/eth2/00000000/beacon_block/ssz_snappy", []byte{})
+	require.ErrorIs(t, err, ErrGossipObserver)
+}
+
 func TestExtractGossipDigest(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -22,7 +22,8 @@ const (
 // This tracer is used to implement metrics collection for messages received
 // and broadcasted through gossipsub.
 type gossipTracer struct {
-	host host.Host
+	host      host.Host
+	bandwidth *gossipBandwidth
 }
 
 // AddPeer .
@@ -63,6 +64,7 @@ func (g gossipTracer) ValidateMessage(msg *pubsub.Message) {
 // DeliverMessage .
 func (g gossipTracer) DeliverMessage(msg *pubsub.Message) {
 	pubsubMessageDeliver.WithLabelValues(*msg.Topic).Inc()
+	g.bandwidth.delivered(msg)
 }
 
 // RejectMessage .
@@ -89,11 +91,13 @@ func (g gossipTracer) ThrottlePeer(p peer.ID) {
 // RecvRPC .
 func (g gossipTracer) RecvRPC(rpc *pubsub.RPC) {
 	g.setMetricFromRPC(recv, pubsubRPCSubRecv, pubsubRPCPubRecv, pubsubRPCRecv, rpc)
+	g.bandwidth.received(rpc)
 }
 
 // SendRPC .
 func (g gossipTracer) SendRPC(rpc *pubsub.RPC, p peer.ID) {
 	g.setMetricFromRPC(send, pubsubRPCSubSent, pubsubRPCPubSent, pubsubRPCSent, rpc)
+	g.bandwidth.sent(rpc)
 }
 
 // DropRPC .
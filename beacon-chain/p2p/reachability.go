@@ -0,0 +1,131 @@
+package p2p
+
+import (
+	"context"
+	"net/netip"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/p2p/net/nat"
+	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+// natDiscoveryTimeout is the time allowed to find a NAT device and map the discovery port.
+const natDiscoveryTimeout = 10 * time.Second
+
+// Reachability describes whether the node is reachable by peers, and through which addresses.
+type Reachability struct {
+	// Reachability is the reachability found by AutoNAT, or public when AutoNAT is undecided and peers
+	// connected to the node.
+	Reachability network.Reachability
+	// AutoNATReachability is the reachability found by AutoNAT dial backs of peers.
+	AutoNATReachability network.Reachability
+	// AdvertisedAddrs are the addresses the node advertises to peers, including addresses mapped on the NAT
+	// device of the network.
+	AdvertisedAddrs []ma.Multiaddr
+	// ObservedAddrs are the addresses of the node as observed by peers.
+	ObservedAddrs []ma.Multiaddr
+	PortMappings  []PortMapping
+	InboundPeers  int
+}
+
+// PortMapping is a port of the node mapped on the NAT device of the network.
+type PortMapping struct {
+	Protocol     string
+	InternalPort uint
+	External     netip.AddrPort
+}
+
+// Reachability returns the reachability of the node.
+func (s *Service) Reachability() *Reachability {
+	s.natLock.Lock()
+	r := &Reachability{AutoNATReachability: s.autoNATReachability}
+	mapping := s.natMapping
+	s.natLock.Unlock()
+
+	r.AdvertisedAddrs = s.host.Addrs()
+	if h, ok := s.host.(interface{ IDService() identify.IDService }); ok && h.IDService() != nil {
+		r.ObservedAddrs = h.IDService().OwnObservedAddrs()
+	}
+	if mapping != nil {
+		if external, ok := mapping.GetMapping("udp", int(s.cfg.UDPPort)); ok && external.Port() != 0 {
+			r.PortMappings = append(r.PortMappings, PortMapping{Protocol: "udp", InternalPort: s.cfg.UDPPort, External: external})
+		}
+	}
+	r.InboundPeers = len(s.peers.InboundConnected())
+	r.Reachability = r.AutoNATReachability
+	if r.Reachability == network.ReachabilityUnknown && r.InboundPeers > 0 {
+		r.Reachability = network.ReachabilityPublic
+	}
+	return r
+}
+
+// watchReachability records the reachability of the node found by AutoNAT.
+func (s *Service) watchReachability() {
+	sub, err := s.host.EventBus().Subscribe(new(event.EvtLocalReachabilityChanged))
+	if err != nil {
+		log.WithError(err).Error("Could not subscribe to reachability changes")
+		return
+	}
+	defer func() {
+		if err := sub.Close(); err != nil {
+			log.WithError(err).Debug("Could not close reachability subscription")
+		}
+	}()
+	for {
+		select {
+		case e, ok := <-sub.Out():
+			if !ok {
+				return
+			}
+			evt, ok := e.(event.EvtLocalReachabilityChanged)
+			if !ok {
+				continue
+			}
+			s.natLock.Lock()
+			s.autoNATReachability = evt.Reachability
+			s.natLock.Unlock()
+			log.WithField("reachability", strings.ToLower(evt.Reachability.String())).Info("Reachability of the node changed")
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// mapDiscoveryPort maps the UDP port of discovery on the NAT device of the network through UPnP or NAT-PMP,
+// and renews the mapping until the service stops. The TCP and QUIC ports are mapped by libp2p.
+func (s *Service) mapDiscoveryPort() {
+	ctx, cancel := context.WithTimeout(s.ctx, natDiscoveryTimeout)
+	defer cancel()
+	n, err := nat.DiscoverNAT(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not find a NAT device supporting UPnP or NAT-PMP")
+		return
+	}
+	defer func() {
+		if err := n.Close(); err != nil {
+			log.WithError(err).Debug("Could not close port mapping")
+		}
+	}()
+	if err := n.AddMapping(ctx, "udp", int(s.cfg.UDPPort)); err != nil {
+		log.WithError(err).Warn("Could not map discovery port")
+		return
+	}
+	external, ok := n.GetMapping("udp", int(s.cfg.UDPPort))
+	if !ok || external.Port() == 0 {
+		log.Warn("NAT device did not map the discovery port")
+	} else {
+		log.WithField("external", external.String()).Info("Mapped discovery port on the NAT device")
+	}
+	s.natLock.Lock()
+	s.natMapping = n
+	s.natLock.Unlock()
+
+	<-s.ctx.Done()
+	s.natLock.Lock()
+	s.natMapping = nil
+	s.natLock.Unlock()
+}
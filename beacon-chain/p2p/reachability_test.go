@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestService_Reachability(t *testing.T) {
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	s := &Service{
+		cfg:  &Config{},
+		host: h,
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+
+	r := s.Reachability()
+	assert.Equal(t, network.ReachabilityUnknown, r.Reachability)
+	assert.DeepEqual(t, h.Addrs(), r.AdvertisedAddrs)
+	assert.Equal(t, 0, len(r.PortMappings))
+
+	// Inbound peers show that the node is reachable while AutoNAT is undecided.
+	pid := peer.ID("inbound")
+	s.peers.Add(nil, pid, h.Addrs()[0], network.DirInbound)
+	s.peers.SetConnectionState(pid, peers.PeerConnected)
+	r = s.Reachability()
+	assert.Equal(t, 1, r.InboundPeers)
+	assert.Equal(t, network.ReachabilityPublic, r.Reachability)
+
+	// AutoNAT takes precedence over inbound peers.
+	s.autoNATReachability = network.ReachabilityPrivate
+	r = s.Reachability()
+	assert.Equal(t, network.ReachabilityPrivate, r.Reachability)
+	assert.Equal(t, network.ReachabilityPrivate, r.AutoNATReachability)
+}
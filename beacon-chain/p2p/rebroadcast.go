@@ -0,0 +1,67 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"google.golang.org/protobuf/proto"
+)
+
+// SeenMessagesTTL is how long the router remembers the IDs of the messages it has seen, during which it drops the
+// messages of the same content as duplicates.
+const SeenMessagesTTL = 2 * time.Minute
+
+// Rebroadcast broadcasts a block or a blob sidecar again to the p2p network, although it was already broadcast, so
+// that the peers which missed the first broadcast receive it. The message is published with its content addressed ID,
+// which the router drops as a duplicate until SeenMessagesTTL elapsed since it saw the message, so it must not be
+// rebroadcast earlier. The message is assumed to be broadcasted to the current fork.
+func (s *Service) Rebroadcast(ctx context.Context, msg proto.Message) error {
+	ctx, span := trace.StartSpan(ctx, "p2p.Rebroadcast")
+	defer span.End()
+
+	forkDigest, err := s.currentForkDigest()
+	if err != nil {
+		err := errors.Wrap(err, "could not retrieve fork digest")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	var topic string
+	if blob, ok := msg.(*ethpb.BlobSidecar); ok {
+		topic = blobSubnetToTopic(blob.Index%params.BeaconConfig().BlobsidecarSubnetCount, forkDigest)
+	} else {
+		format, ok := GossipTypeMapping[reflect.TypeOf(msg)]
+		if !ok {
+			tracing.AnnotateError(span, ErrMessageNotMapped)
+			return ErrMessageNotMapped
+		}
+		topic = fmt.Sprintf(format, forkDigest)
+	}
+	topic += s.Encoding().ProtocolSuffix()
+
+	castMsg, ok := msg.(ssz.Marshaler)
+	if !ok {
+		return errors.Errorf("message of %T does not support marshaller interface", msg)
+	}
+	buf := new(bytes.Buffer)
+	if _, err := s.Encoding().EncodeGossip(buf, castMsg); err != nil {
+		err := errors.Wrap(err, "could not encode message")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+
+	if err := s.PublishToTopic(ctx, topic, buf.Bytes()); err != nil {
+		err := errors.Wrap(err, "could not publish message")
+		tracing.AnnotateError(span, err)
+		return err
+	}
+	return nil
+}
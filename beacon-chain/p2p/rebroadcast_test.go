@@ -0,0 +1,19 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	testpb "github.com/prysmaticlabs/prysm/v5/proto/testing"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestService_Rebroadcast_ReturnsErr_TopicNotMapped(t *testing.T) {
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+	}
+	assert.ErrorContains(t, ErrMessageNotMapped.Error(), s.Rebroadcast(context.Background(), &testpb.AddressBook{}))
+}
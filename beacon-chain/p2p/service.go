@@ -17,6 +17,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-libp2p/p2p/net/nat"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/async"
@@ -78,6 +79,13 @@ type Service struct {
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
 	activeValidatorCount  uint64
+	gossipBandwidth       *gossipBandwidth
+	peerScores            map[peer.ID]*pubsub.PeerScoreSnapshot
+	peerScoresLock        sync.RWMutex
+	trustedPeers          *trustedPeers
+	natLock               sync.Mutex
+	natMapping            *nat.NAT
+	autoNATReachability   network.Reachability
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -117,7 +125,9 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		isPreGenesis: true,
 		joinedTopics: make(map[string]*pubsub.Topic, len(gossipTopicMappings)),
 		subnetsLock:  make(map[uint64]*sync.RWMutex),
+		trustedPeers: newTrustedPeers(),
 	}
+	s.gossipBandwidth = newGossipBandwidth(cfg.GossipBandwidthCaps)
 
 	ipAddr := prysmnetwork.IPAddr()
 
@@ -222,19 +232,34 @@ func (s *Service) Start() {
 		// Set trusted peers for those that are provided as static addresses.
 		pids := peerIdsFromMultiAddrs(addrs)
 		s.peers.SetTrustedPeers(pids)
+		if infos, err := peer.AddrInfosFromP2pAddrs(addrs...); err == nil {
+			s.trustedPeers.setStatic(infos)
+		}
 		s.connectWithAllTrustedPeers(addrs)
 	}
+	go s.watchReachability()
+	if s.cfg.EnableUPnP && !s.cfg.NoDiscovery {
+		go s.mapDiscoveryPort()
+	}
+	if s.cfg.TrustedPeersFile != "" {
+		s.reloadTrustedPeersFile()
+		go s.watchTrustedPeersFile()
+	}
 	// Initialize metadata according to the
 	// current epoch.
 	s.RefreshENR()
 
 	// Periodic functions.
 	async.RunEvery(s.ctx, params.BeaconConfig().TtfbTimeoutDuration(), func() {
-		ensurePeerConnections(s.ctx, s.host, s.peers, relayNodes...)
+		ensurePeerConnections(s.ctx, s.host, relayNodes...)
+		s.reconnectTrustedPeers()
 	})
 	async.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
 	async.RunEvery(s.ctx, time.Duration(params.BeaconConfig().RespTimeout)*time.Second, s.updateMetrics)
 	async.RunEvery(s.ctx, refreshRate, s.RefreshENR)
+	if s.cfg.ServeHistoricalBlocks {
+		async.RunEvery(s.ctx, historicalBlocksRefreshPeriod, s.refreshHistoricalBlocksEntry)
+	}
 	async.RunEvery(s.ctx, 1*time.Minute, func() {
 		inboundQUICCount := len(s.peers.InboundConnectedWithProtocol(peers.QUIC))
 		inboundTCPCount := len(s.peers.InboundConnectedWithProtocol(peers.TCP))
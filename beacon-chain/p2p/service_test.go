@@ -60,8 +60,8 @@ func (mockListener) RequestENR(*enode.Node) (*enode.Node, error) {
 	panic("implement me")
 }
 
-func (mockListener) LocalNode() *enode.LocalNode {
-	panic("implement me")
+func (m mockListener) LocalNode() *enode.LocalNode {
+	return m.localNode
 }
 
 func (mockListener) RandomNodes() enode.Iterator {
@@ -242,7 +242,7 @@ func computeSubscribedSubnet(nodeID enode.ID, epoch primitives.Epoch, index uint
 	nodeOffset, nodeIdPrefix := computeOffsetAndPrefix(nodeID)
 	seedInput := (nodeOffset + uint64(epoch)) / params.BeaconConfig().EpochsPerSubnetSubscription
 	permSeed := hash.Hash(bytesutil.Bytes8(seedInput))
-	permutatedPrefix, err := helpers.ComputeShuffledIndex(primitives.ValidatorIndex(nodeIdPrefix), 1<<params.BeaconConfig().AttestationSubnetPrefixBits, permSeed, true)
+	permutatedPrefix, err := helpers.MemoizedShuffledIndex(primitives.ValidatorIndex(nodeIdPrefix), 1<<params.BeaconConfig().AttestationSubnetPrefixBits, permSeed)
 	if err != nil {
 		return 0, err
 	}
@@ -14,6 +14,7 @@ type MockBroadcaster struct {
 	BroadcastCalled       atomic.Bool
 	BroadcastMessages     []proto.Message
 	BroadcastAttestations []ethpb.Att
+	RebroadcastMessages   []proto.Message
 	msgLock               sync.Mutex
 	attLock               sync.Mutex
 }
@@ -48,6 +49,14 @@ func (m *MockBroadcaster) BroadcastBlob(context.Context, uint64, *ethpb.BlobSide
 	return nil
 }
 
+// Rebroadcast records a rebroadcast occurred.
+func (m *MockBroadcaster) Rebroadcast(_ context.Context, msg proto.Message) error {
+	m.msgLock.Lock()
+	defer m.msgLock.Unlock()
+	m.RebroadcastMessages = append(m.RebroadcastMessages, msg)
+	return nil
+}
+
 // NumMessages returns the number of messages broadcasted.
 func (m *MockBroadcaster) NumMessages() int {
 	m.msgLock.Lock()
@@ -61,3 +70,10 @@ func (m *MockBroadcaster) NumAttestations() int {
 	defer m.attLock.Unlock()
 	return len(m.BroadcastAttestations)
 }
+
+// NumRebroadcasts returns the number of messages rebroadcasted.
+func (m *MockBroadcaster) NumRebroadcasts() int {
+	m.msgLock.Lock()
+	defer m.msgLock.Unlock()
+	return len(m.RebroadcastMessages)
+}
@@ -0,0 +1,259 @@
+package p2p
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// trustedPeerMinRedialDelay is the delay before redialing a trusted peer after a failed dial. It doubles after
+	// every consecutive failure, up to trustedPeerMaxRedialDelay.
+	trustedPeerMinRedialDelay = 5 * time.Second
+	trustedPeerMaxRedialDelay = 5 * time.Minute
+	// trustedPeersFileDebounce is the interval over which changes of the trusted peers file are debounced.
+	trustedPeersFileDebounce = time.Second
+)
+
+// trustedPeers tracks the configured addresses of the trusted peers, given as static peers or in the trusted
+// peers file, and when to redial each trusted peer.
+type trustedPeers struct {
+	lock  sync.Mutex
+	peers map[peer.ID]*trustedPeer
+}
+
+type trustedPeer struct {
+	addrs    []ma.Multiaddr
+	static   bool
+	fromFile bool
+	failures int
+	nextDial time.Time
+}
+
+func newTrustedPeers() *trustedPeers {
+	return &trustedPeers{peers: make(map[peer.ID]*trustedPeer)}
+}
+
+func (t *trustedPeers) entryNoLock(pid peer.ID) *trustedPeer {
+	p, ok := t.peers[pid]
+	if !ok {
+		p = &trustedPeer{}
+		t.peers[pid] = p
+	}
+	return p
+}
+
+// setStatic records the addresses of the static peers.
+func (t *trustedPeers) setStatic(infos []peer.AddrInfo) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, info := range infos {
+		p := t.entryNoLock(info.ID)
+		p.static = true
+		p.addrs = info.Addrs
+	}
+}
+
+// setFromFile replaces the peers of the trusted peers file, and returns the peers which were removed from the
+// file and are not static peers either.
+func (t *trustedPeers) setFromFile(infos []peer.AddrInfo) []peer.ID {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	inFile := make(map[peer.ID]bool, len(infos))
+	for _, info := range infos {
+		p := t.entryNoLock(info.ID)
+		p.fromFile = true
+		p.addrs = info.Addrs
+		inFile[info.ID] = true
+	}
+	var removed []peer.ID
+	for pid, p := range t.peers {
+		if !p.fromFile || inFile[pid] {
+			continue
+		}
+		p.fromFile = false
+		if !p.static {
+			delete(t.peers, pid)
+			removed = append(removed, pid)
+		}
+	}
+	return removed
+}
+
+// retain forgets the redial state of the peers which are no longer trusted, such as peers removed through the
+// API.
+func (t *trustedPeers) retain(trusted []peer.ID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	keep := make(map[peer.ID]bool, len(trusted))
+	for _, pid := range trusted {
+		keep[pid] = true
+	}
+	for pid, p := range t.peers {
+		if !keep[pid] && !p.static && !p.fromFile {
+			delete(t.peers, pid)
+		}
+	}
+}
+
+// addrs returns the configured addresses of a trusted peer, nil for peers added through the API.
+func (t *trustedPeers) addrs(pid peer.ID) []ma.Multiaddr {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if p, ok := t.peers[pid]; ok {
+		return p.addrs
+	}
+	return nil
+}
+
+// dialDue returns true once the redial delay of a trusted peer is over.
+func (t *trustedPeers) dialDue(pid peer.ID, now time.Time) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	p, ok := t.peers[pid]
+	return !ok || !now.Before(p.nextDial)
+}
+
+// dialed records the outcome of a dial of a trusted peer, a nil error also meaning that the peer is connected.
+func (t *trustedPeers) dialed(pid peer.ID, err error, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	p := t.entryNoLock(pid)
+	if err == nil {
+		p.failures = 0
+		p.nextDial = time.Time{}
+		return
+	}
+	p.failures++
+	p.nextDial = now.Add(redialDelay(p.failures))
+}
+
+// redialDelay returns the delay before redialing a trusted peer after the given number of consecutive failures.
+func redialDelay(failures int) time.Duration {
+	delay := trustedPeerMinRedialDelay
+	for i := 1; i < failures && delay < trustedPeerMaxRedialDelay; i++ {
+		delay *= 2
+	}
+	if delay > trustedPeerMaxRedialDelay {
+		return trustedPeerMaxRedialDelay
+	}
+	return delay
+}
+
+// readTrustedPeersFile reads the trusted peers file, a YAML list of multiaddrs or ENRs.
+func readTrustedPeersFile(path string) ([]peer.AddrInfo, error) {
+	enc, err := file.ReadFileAsBytes(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read trusted peers file")
+	}
+	var entries []string
+	if err := yaml.UnmarshalStrict(enc, &entries); err != nil {
+		return nil, errors.Wrap(err, "could not parse trusted peers file")
+	}
+	addrs, err := PeersFromStringAddrs(entries)
+	if err != nil {
+		return nil, err
+	}
+	return peer.AddrInfosFromP2pAddrs(addrs...)
+}
+
+// reloadTrustedPeersFile trusts the peers of the trusted peers file, which are then connected by
+// reconnectTrustedPeers, and stops trusting the peers removed from the file.
+func (s *Service) reloadTrustedPeersFile() {
+	infos, err := readTrustedPeersFile(s.cfg.TrustedPeersFile)
+	if err != nil {
+		log.WithError(err).Error("Could not load trusted peers file")
+		return
+	}
+	removed := s.trustedPeers.setFromFile(infos)
+	s.peers.DeleteTrustedPeers(removed)
+	pids := make([]peer.ID, 0, len(infos))
+	for _, info := range infos {
+		pids = append(pids, info.ID)
+		if len(info.Addrs) > 0 {
+			s.peers.Add(nil, info.ID, info.Addrs[0], network.DirUnknown)
+		}
+	}
+	s.peers.SetTrustedPeers(pids)
+	log.WithFields(logrus.Fields{
+		"path":    s.cfg.TrustedPeersFile,
+		"trusted": len(pids),
+		"removed": len(removed),
+	}).Info("Loaded trusted peers file")
+}
+
+// watchTrustedPeersFile reloads the trusted peers file whenever it changes. The directory of the file is
+// watched, so that the file can be replaced rather than modified in place.
+func (s *Service) watchTrustedPeersFile() {
+	path := filepath.Clean(s.cfg.TrustedPeersFile)
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize trusted peers file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close trusted peers file watcher")
+		}
+	}()
+	if err := watcher.Add(filepath.Dir(path)); err != nil {
+		log.WithError(err).Errorf("Could not watch trusted peers file %s", path)
+		return
+	}
+	changes := make(chan interface{}, 100)
+	go async.Debounce(s.ctx, trustedPeersFileDebounce, changes, func(interface{}) {
+		s.reloadTrustedPeersFile()
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) == path && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				changes <- event
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch trusted peers file %s", path)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// reconnectTrustedPeers dials the trusted peers which are not connected, backing off exponentially from the
+// peers which fail to connect.
+func (s *Service) reconnectTrustedPeers() {
+	trusted := s.peers.GetTrustedPeers()
+	s.trustedPeers.retain(trusted)
+	for _, pid := range trusted {
+		if s.host.Network().Connectedness(pid) == network.Connected {
+			s.trustedPeers.dialed(pid, nil, prysmTime.Now())
+			continue
+		}
+		if !s.trustedPeers.dialDue(pid, prysmTime.Now()) {
+			continue
+		}
+		info := &peer.AddrInfo{ID: pid, Addrs: s.trustedPeers.addrs(pid)}
+		if len(info.Addrs) == 0 {
+			addr, err := s.peers.Address(pid)
+			if err != nil || addr == nil {
+				continue
+			}
+			info.Addrs = []ma.Multiaddr{addr}
+		}
+		err := connectWithTimeout(s.ctx, s.host, info)
+		if err != nil {
+			log.WithField("peer", pid).WithError(err).Debug("Could not reconnect to trusted peer")
+		}
+		s.trustedPeers.dialed(pid, err, prysmTime.Now())
+	}
+}
@@ -0,0 +1,90 @@
+package p2p
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const (
+	trustedPeerA = "/ip4/127.0.0.1/tcp/6660/p2p/QmQ7zhY7nGY66yK1n8hLGevfVyjbtvHSgtZuXkCH9oTrgi"
+	trustedPeerB = "/ip4/127.0.0.1/tcp/33201/p2p/QmaXZhW44pwQxBSeLkE5FNeLz8tGTTEsRciFg1DNWXXrWG"
+)
+
+func TestRedialDelay(t *testing.T) {
+	assert.Equal(t, 5*time.Second, redialDelay(1))
+	assert.Equal(t, 10*time.Second, redialDelay(2))
+	assert.Equal(t, 80*time.Second, redialDelay(5))
+	assert.Equal(t, trustedPeerMaxRedialDelay, redialDelay(7))
+	assert.Equal(t, trustedPeerMaxRedialDelay, redialDelay(100))
+}
+
+func TestTrustedPeers_Backoff(t *testing.T) {
+	tp := newTrustedPeers()
+	pid := peer.ID("a")
+	now := time.Now()
+	assert.Equal(t, true, tp.dialDue(pid, now))
+
+	tp.dialed(pid, errors.New("refused"), now)
+	assert.Equal(t, false, tp.dialDue(pid, now.Add(4*time.Second)))
+	assert.Equal(t, true, tp.dialDue(pid, now.Add(5*time.Second)))
+
+	tp.dialed(pid, errors.New("refused"), now)
+	assert.Equal(t, false, tp.dialDue(pid, now.Add(9*time.Second)))
+	assert.Equal(t, true, tp.dialDue(pid, now.Add(10*time.Second)))
+
+	tp.dialed(pid, nil, now)
+	assert.Equal(t, true, tp.dialDue(pid, now))
+
+	// Peers which are no longer trusted are forgotten.
+	tp.retain(nil)
+	assert.Equal(t, 0, len(tp.peers))
+}
+
+func TestService_ReloadTrustedPeersFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trusted-peers.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("- "+trustedPeerA+"\n- "+trustedPeerB+"\n"), 0600))
+	infoA, err := MakePeer(trustedPeerA)
+	require.NoError(t, err)
+	infoB, err := MakePeer(trustedPeerB)
+	require.NoError(t, err)
+
+	s := &Service{
+		cfg: &Config{TrustedPeersFile: path},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+		trustedPeers: newTrustedPeers(),
+	}
+	// Peer B is also a static peer.
+	s.trustedPeers.setStatic([]peer.AddrInfo{*infoB})
+	s.peers.SetTrustedPeers([]peer.ID{infoB.ID})
+
+	s.reloadTrustedPeersFile()
+	assert.Equal(t, true, s.peers.IsTrustedPeers(infoA.ID))
+	assert.Equal(t, true, s.peers.IsTrustedPeers(infoB.ID))
+	assert.DeepEqual(t, infoA.Addrs, s.trustedPeers.addrs(infoA.ID))
+	addr, err := s.peers.Address(infoA.ID)
+	require.NoError(t, err)
+	assert.Equal(t, infoA.Addrs[0].String(), addr.String())
+
+	// Removing peers from the file stops trusting them, unless they are static peers.
+	require.NoError(t, os.WriteFile(path, []byte("[]\n"), 0600))
+	s.reloadTrustedPeersFile()
+	assert.Equal(t, false, s.peers.IsTrustedPeers(infoA.ID))
+	assert.Equal(t, true, s.peers.IsTrustedPeers(infoB.ID))
+
+	// An invalid file keeps the current trusted peers.
+	require.NoError(t, os.WriteFile(path, []byte("- not an address\n"), 0600))
+	s.reloadTrustedPeersFile()
+	assert.Equal(t, true, s.peers.IsTrustedPeers(infoB.ID))
+}
@@ -5,14 +5,13 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
-	ma "github.com/multiformats/go-multiaddr"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 )
 
-// ensurePeerConnections will attempt to reestablish connection to the peers
-// if there are currently no connections to that peer.
-func ensurePeerConnections(ctx context.Context, h host.Host, peers *peers.Status, relayNodes ...string) {
-	// every time reset peersToWatch, add RelayNodes and trust peers
+// ensurePeerConnections will attempt to reestablish connection to the relay nodes
+// if there are currently no connections to them. Trusted peers are reconnected by
+// reconnectTrustedPeers.
+func ensurePeerConnections(ctx context.Context, h host.Host, relayNodes ...string) {
+	// every time reset peersToWatch, add RelayNodes
 	var peersToWatch []*peer.AddrInfo
 
 	// add RelayNodes
@@ -28,21 +27,6 @@ func ensurePeerConnections(ctx context.Context, h host.Host, peers *peers.Status
 		peersToWatch = append(peersToWatch, peerInfo)
 	}
 
-	// add trusted peers
-	trustedPeers := peers.GetTrustedPeers()
-	for _, trustedPeer := range trustedPeers {
-		maddr, err := peers.Address(trustedPeer)
-
-		// avoid invalid trusted peers
-		if err != nil || maddr == nil {
-			log.WithField("peer", trustedPeers).WithError(err).Error("Could not get peer address")
-			continue
-		}
-		peerInfo := &peer.AddrInfo{ID: trustedPeer}
-		peerInfo.Addrs = []ma.Multiaddr{maddr}
-		peersToWatch = append(peersToWatch, peerInfo)
-	}
-
 	if len(peersToWatch) == 0 {
 		return
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
@@ -414,7 +415,7 @@ func (s *Service) SubmitSignedAggregateSelectionProof(
 		return &RpcError{Err: &AggregateBroadcastFailedError{err: err}, Reason: Internal}
 	}
 
-	if logrus.GetLevel() >= logrus.DebugLevel {
+	if logging.IsLevelEnabled(log, logrus.DebugLevel) {
 		var fields logrus.Fields
 		if agg.Version() >= version.Electra {
 			fields = logrus.Fields{
@@ -920,3 +921,32 @@ func (s *Service) ValidatorActiveSetChanges(
 		EjectedIndices:      ejectedIndices,
 	}, nil
 }
+
+// TrackRegisteredValidators tracks the fee recipients of validators registered for the builder, so
+// that payloads are prepared for them even when no validator client prepares them as proposers, and
+// their gas limit preferences, so that local payloads target them too. Registrations of public keys
+// which are not in the state are skipped.
+func TrackRegisteredValidators(
+	c *cache.TrackedValidatorsCache,
+	st beaconState.ReadOnlyBeaconState,
+	regs []*ethpb.SignedValidatorRegistrationV1,
+) {
+	for _, reg := range regs {
+		if reg.GetMessage() == nil {
+			continue
+		}
+		index, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(reg.Message.Pubkey))
+		if !ok {
+			continue
+		}
+		c.Set(cache.TrackedValidator{
+			Active:       true,
+			Index:        index,
+			FeeRecipient: primitives.ExecutionAddress(bytesutil.ToBytes20(reg.Message.FeeRecipient)),
+			Source:       cache.BuilderRegistrationSource,
+		})
+		if reg.Message.GasLimit != 0 {
+			c.SetGasLimit(index, reg.Message.GasLimit)
+		}
+	}
+}
@@ -7,10 +7,13 @@ import (
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
 func TestRegisterSyncSubnetProto(t *testing.T) {
@@ -63,3 +66,23 @@ func pubKey(i uint64) []byte {
 	binary.LittleEndian.PutUint64(pubKey, i)
 	return pubKey
 }
+
+func TestTrackRegisteredValidators(t *testing.T) {
+	st, keys := util.DeterministicGenesisState(t, 4)
+	c := cache.NewTrackedValidatorsCache()
+	regs := []*ethpb.SignedValidatorRegistrationV1{
+		{Message: &ethpb.ValidatorRegistrationV1{Pubkey: keys[2].PublicKey().Marshal(), FeeRecipient: bytesutil.PadTo([]byte{2}, 20), GasLimit: 36_000_000}},
+		{Message: &ethpb.ValidatorRegistrationV1{Pubkey: pubKey(100), FeeRecipient: bytesutil.PadTo([]byte{9}, 20)}},
+		{},
+	}
+	TrackRegisteredValidators(c, st, regs)
+
+	vals := c.Validators()
+	require.Equal(t, 1, len(vals))
+	assert.Equal(t, primitives.ValidatorIndex(2), vals[0].Index)
+	assert.Equal(t, primitives.ExecutionAddress{2}, vals[0].FeeRecipient)
+	assert.Equal(t, cache.BuilderRegistrationSource, vals[0].Source)
+	gasLimit, ok := c.GasLimit(2)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(36_000_000), gasLimit)
+}
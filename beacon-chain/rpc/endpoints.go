@@ -24,8 +24,17 @@ import (
 	validatorv1alpha1 "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/v1alpha1/validator"
 	validatorprysm "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/prysm/validator"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/runtime/diagnostics"
 )
 
+// adminRequestMaxBodySize bounds the body of the requests to the admin endpoints, which carry no or small bodies.
+const adminRequestMaxBodySize = 1 << 10
+
+// balanceHistoryMaxBodySize bounds the body of the balance history requests, enough for the maximum number of hex
+// encoded public keys.
+const balanceHistoryMaxBodySize = 128 << 10
+
 type endpoint struct {
 	template   string
 	name       string
@@ -69,7 +78,7 @@ func (s *Service) endpoints(
 	endpoints = append(endpoints, s.eventsEndpoints()...)
 	endpoints = append(endpoints, s.prysmBeaconEndpoints(ch, stater, coreService)...)
 	endpoints = append(endpoints, s.prysmNodeEndpoints()...)
-	endpoints = append(endpoints, s.prysmValidatorEndpoints(stater, coreService)...)
+	endpoints = append(endpoints, s.prysmValidatorEndpoints(enableDebug, stater, coreService)...)
 	if enableDebug {
 		endpoints = append(endpoints, s.debugEndpoints(stater)...)
 	}
@@ -85,6 +94,7 @@ func (s *Service) rewardsEndpoints(blocker lookup.Blocker, stater lookup.Stater,
 		Stater:                stater,
 		HeadFetcher:           s.cfg.HeadFetcher,
 		BlockRewardFetcher:    rewardFetcher,
+		BlockIncomeCache:      lruwrpr.New(rewards.BlockIncomeCacheSize),
 	}
 
 	const namespace = "rewards"
@@ -118,6 +128,16 @@ func (s *Service) rewardsEndpoints(blocker lookup.Blocker, stater lookup.Stater,
 			handler: server.SyncCommitteeRewards,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/validators/income/{epoch}",
+			name:     namespace + ".ValidatorIncome",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.ValidatorIncome,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
 
@@ -497,6 +517,7 @@ func (s *Service) beaconEndpoints(
 		FinalizationFetcher:     s.cfg.FinalizationFetcher,
 		ForkchoiceFetcher:       s.cfg.ForkchoiceFetcher,
 		CoreService:             coreService,
+		ClockChecker:            s.cfg.ClockChecker,
 	}
 
 	const namespace = "beacon"
@@ -816,6 +837,7 @@ func (s *Service) beaconEndpoints(
 			middleware: []middleware.Middleware{
 				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.CompressionHandler,
 			},
 			handler: server.GetValidators,
 			methods: []string{http.MethodGet, http.MethodPost},
@@ -952,6 +974,7 @@ func (s *Service) debugEndpoints(stater lookup.Stater) []endpoint {
 			name:     namespace + ".GetBeaconStateV2",
 			middleware: []middleware.Middleware{
 				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+				middleware.CompressionHandler,
 			},
 			handler: server.GetBeaconStateV2,
 			methods: []string{http.MethodGet},
@@ -1020,6 +1043,9 @@ func (s *Service) prysmBeaconEndpoints(
 		Broadcaster:           s.cfg.Broadcaster,
 		BlobReceiver:          s.cfg.BlobReceiver,
 	}
+	if s.cfg.StateGen != nil {
+		server.ReplayQueueFetcher = s.cfg.StateGen.ReplayLimiter()
+	}
 
 	const namespace = "prysm.beacon"
 	return []endpoint{
@@ -1032,6 +1058,15 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.GetWeakSubjectivity,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/beacon/state_replays",
+			name:     namespace + ".GetStateReplays",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetStateReplays,
+			methods: []string{http.MethodGet},
+		},
 		{
 			template: "/eth/v1/beacon/states/{state_id}/validator_count",
 			name:     namespace + ".GetValidatorCount",
@@ -1079,6 +1114,60 @@ func (s *Service) prysmBeaconEndpoints(
 			handler: server.PublishBlobs,
 			methods: []string{http.MethodPost},
 		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/pending_deposits",
+			name:     namespace + ".GetPendingDeposits",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPendingDeposits,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/pending_partial_withdrawals",
+			name:     namespace + ".GetPendingPartialWithdrawals",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPendingPartialWithdrawals,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/pending_consolidations",
+			name:     namespace + ".GetPendingConsolidations",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPendingConsolidations,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/states/{state_id}/queue_eta",
+			name:     namespace + ".GetQueueETA",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetQueueETA,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/committee_assignments",
+			name:     namespace + ".GetCommitteeAssignments",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType, api.OctetStreamMediaType}),
+			},
+			handler: server.GetCommitteeAssignments,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/beacon/block_tree",
+			name:     namespace + ".GetBlockTree",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetBlockTree,
+			methods: []string{http.MethodGet},
+		},
 	}
 }
 
@@ -1093,6 +1182,13 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 		MetadataProvider:          s.cfg.MetadataProvider,
 		HeadFetcher:               s.cfg.HeadFetcher,
 		ExecutionChainInfoFetcher: s.cfg.ExecutionChainInfoFetcher,
+		FinalityStatusFetcher:     s.cfg.FinalityStatusFetcher,
+		EngineCapabilitiesFetcher: s.cfg.EngineCapabilitiesFetcher,
+		ForkReadinessFetcher:      s.cfg.ForkReadinessFetcher,
+		PeerScoresFetcher:         s.cfg.PeerScoresFetcher,
+		ReachabilityFetcher:       s.cfg.ReachabilityFetcher,
+		DiagnosticsBundleWriter:   diagnostics.WriteBundle,
+		SlotTimingsCache:          s.cfg.SlotTimingsCache,
 	}
 
 	const namespace = "prysm.node"
@@ -1153,18 +1249,93 @@ func (s *Service) prysmNodeEndpoints() []endpoint {
 			handler: server.RemoveTrustedPeer,
 			methods: []string{http.MethodDelete},
 		},
+		{
+			template: "/prysm/v1/node/finality_status",
+			name:     namespace + ".GetFinalityStatus",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetFinalityStatus,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/engine_capabilities",
+			name:     namespace + ".GetEngineCapabilities",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetEngineCapabilities,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/fork_readiness",
+			name:     namespace + ".GetForkReadiness",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetForkReadiness,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/peer_scores",
+			name:     namespace + ".GetPeerScores",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPeerScores,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/reachability",
+			name:     namespace + ".GetReachability",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetReachability,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/slot_timings",
+			name:     namespace + ".GetSlotTimings",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSlotTimings,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/node/diagnostics",
+			name:     namespace + ".WriteDiagnosticsBundle",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.MaxBodySizeHandler(adminRequestMaxBodySize),
+				// The last middleware runs first, so that unauthenticated requests are rejected upfront.
+				middleware.BearerTokenHandler(s.cfg.AdminToken),
+			},
+			handler: server.WriteDiagnosticsBundle,
+			methods: []string{http.MethodPost},
+		},
 	}
 }
 
-func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *core.Service) []endpoint {
+func (s *Service) prysmValidatorEndpoints(enableDebug bool, stater lookup.Stater, coreService *core.Service) []endpoint {
 	server := &validatorprysm.Server{
-		ChainInfoFetcher: s.cfg.ChainInfoFetcher,
-		Stater:           stater,
-		CoreService:      coreService,
+		ChainInfoFetcher:                s.cfg.ChainInfoFetcher,
+		Stater:                          stater,
+		CoreService:                     coreService,
+		TrackedValidatorsCache:          s.cfg.TrackedValidatorsCache,
+		SyncCommitteePerformanceFetcher: s.cfg.ValidatorMonitor,
+		GenesisTimeFetcher:              s.cfg.GenesisTimeFetcher,
+		OptimisticModeFetcher:           s.cfg.OptimisticModeFetcher,
+		ExecutionChainInfoFetcher:       s.cfg.ExecutionChainInfoFetcher,
+		EngineCapabilitiesFetcher:       s.cfg.EngineCapabilitiesFetcher,
+		ForkchoiceUpdateStatusFetcher:   s.cfg.ForkchoiceStatusFetcher,
+		BlockBuilder:                    s.cfg.BlockBuilder,
+		PayloadValuesCache:              s.cfg.PayloadValuesCache,
 	}
 
 	const namespace = "prysm.validator"
-	return []endpoint{
+	endpoints := []endpoint{
 		{
 			template: "/prysm/validators/performance",
 			name:     namespace + ".GetPerformance",
@@ -1203,5 +1374,69 @@ func (s *Service) prysmValidatorEndpoints(stater lookup.Stater, coreService *cor
 			handler: server.GetActiveSetChanges,
 			methods: []string{http.MethodGet},
 		},
+		{
+			template: "/prysm/v1/validators/tracked_proposers",
+			name:     namespace + ".GetTrackedProposers",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetTrackedProposers,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/sync_committee_performance",
+			name:     namespace + ".GetSyncCommitteePerformance",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetSyncCommitteePerformance,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validator/proposal_preflight",
+			name:     namespace + ".GetProposalPreflight",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetProposalPreflight,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/payload_values",
+			name:     namespace + ".GetPayloadValues",
+			middleware: []middleware.Middleware{
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+			},
+			handler: server.GetPayloadValues,
+			methods: []string{http.MethodGet},
+		},
+		{
+			template: "/prysm/v1/validators/gas_limits",
+			name:     namespace + ".SetGasLimits",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				// The last middleware runs first, so that unauthenticated requests are rejected upfront.
+				middleware.BearerTokenHandler(s.cfg.AdminToken),
+			},
+			handler: server.SetGasLimits,
+			methods: []string{http.MethodPost},
+		},
 	}
+	if enableDebug {
+		// The balance history replays up to a state per sampled epoch, so it is only served along with the other
+		// state heavy debug endpoints.
+		endpoints = append(endpoints, endpoint{
+			template: "/prysm/v1/validators/balance_history",
+			name:     namespace + ".GetBalanceHistory",
+			middleware: []middleware.Middleware{
+				middleware.ContentTypeHandler([]string{api.JsonMediaType}),
+				middleware.AcceptHeaderHandler([]string{api.JsonMediaType}),
+				middleware.MaxBodySizeHandler(balanceHistoryMaxBodySize),
+			},
+			handler: server.GetBalanceHistory,
+			methods: []string{http.MethodPost},
+		})
+	}
+	return endpoints
 }
@@ -14,6 +14,7 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v1/beacon/rewards/blocks/{block_id}":         {http.MethodGet},
 		"/eth/v1/beacon/rewards/attestations/{epoch}":      {http.MethodPost},
 		"/eth/v1/beacon/rewards/sync_committee/{block_id}": {http.MethodPost},
+		"/prysm/v1/validators/income/{epoch}":              {http.MethodPost},
 	}
 
 	beaconRoutes := map[string][]string{
@@ -76,6 +77,7 @@ func Test_endpoints(t *testing.T) {
 		"/eth/v2/debug/beacon/states/{state_id}": {http.MethodGet},
 		"/eth/v2/debug/beacon/heads":             {http.MethodGet},
 		"/eth/v1/debug/fork_choice":              {http.MethodGet},
+		"/prysm/v1/validators/balance_history":   {http.MethodPost},
 	}
 
 	eventsRoutes := map[string][]string{
@@ -116,11 +118,18 @@ func Test_endpoints(t *testing.T) {
 	}
 
 	prysmBeaconRoutes := map[string][]string{
-		"/prysm/v1/beacon/weak_subjectivity":                 {http.MethodGet},
-		"/eth/v1/beacon/states/{state_id}/validator_count":   {http.MethodGet},
-		"/prysm/v1/beacon/states/{state_id}/validator_count": {http.MethodGet},
-		"/prysm/v1/beacon/chain_head":                        {http.MethodGet},
-		"/prysm/v1/beacon/blobs":                             {http.MethodPost},
+		"/prysm/v1/beacon/weak_subjectivity":                             {http.MethodGet},
+		"/prysm/v1/beacon/state_replays":                                 {http.MethodGet},
+		"/eth/v1/beacon/states/{state_id}/validator_count":               {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/validator_count":             {http.MethodGet},
+		"/prysm/v1/beacon/chain_head":                                    {http.MethodGet},
+		"/prysm/v1/beacon/blobs":                                         {http.MethodPost},
+		"/prysm/v1/beacon/states/{state_id}/pending_deposits":            {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/pending_partial_withdrawals": {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/pending_consolidations":      {http.MethodGet},
+		"/prysm/v1/beacon/states/{state_id}/queue_eta":                   {http.MethodGet},
+		"/prysm/v1/beacon/committee_assignments":                         {http.MethodGet},
+		"/prysm/v1/beacon/block_tree":                                    {http.MethodGet},
 	}
 
 	prysmNodeRoutes := map[string][]string{
@@ -128,13 +137,25 @@ func Test_endpoints(t *testing.T) {
 		"/prysm/v1/node/trusted_peers":           {http.MethodGet, http.MethodPost},
 		"/prysm/node/trusted_peers/{peer_id}":    {http.MethodDelete},
 		"/prysm/v1/node/trusted_peers/{peer_id}": {http.MethodDelete},
+		"/prysm/v1/node/finality_status":         {http.MethodGet},
+		"/prysm/v1/node/engine_capabilities":     {http.MethodGet},
+		"/prysm/v1/node/fork_readiness":          {http.MethodGet},
+		"/prysm/v1/node/peer_scores":             {http.MethodGet},
+		"/prysm/v1/node/reachability":            {http.MethodGet},
+		"/prysm/v1/node/slot_timings":            {http.MethodGet},
+		"/prysm/v1/node/diagnostics":             {http.MethodPost},
 	}
 
 	prysmValidatorRoutes := map[string][]string{
-		"/prysm/validators/performance":           {http.MethodPost},
-		"/prysm/v1/validators/performance":        {http.MethodPost},
-		"/prysm/v1/validators/participation":      {http.MethodGet},
-		"/prysm/v1/validators/active_set_changes": {http.MethodGet},
+		"/prysm/validators/performance":                   {http.MethodPost},
+		"/prysm/v1/validators/performance":                {http.MethodPost},
+		"/prysm/v1/validators/participation":              {http.MethodGet},
+		"/prysm/v1/validators/active_set_changes":         {http.MethodGet},
+		"/prysm/v1/validators/tracked_proposers":          {http.MethodGet},
+		"/prysm/v1/validators/sync_committee_performance": {http.MethodGet},
+		"/prysm/v1/validator/proposal_preflight":          {http.MethodGet},
+		"/prysm/v1/validators/payload_values":             {http.MethodGet},
+		"/prysm/v1/validators/gas_limits":                 {http.MethodPost},
 	}
 
 	s := &Service{cfg: &Config{}}
@@ -28,6 +28,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	sszutil "github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
@@ -112,11 +113,12 @@ func (s *Server) GetBlindedBlock(w http.ResponseWriter, r *http.Request) {
 
 // getBlockV2Ssz returns the SSZ-serialized version of the beacon block for given block ID.
 func (s *Server) getBlockV2Ssz(w http.ResponseWriter, blk interfaces.ReadOnlySignedBeaconBlock) {
-	result, err := s.getBlockResponseBodySsz(blk)
+	result, release, err := s.getBlockResponseBodySsz(blk)
 	if err != nil {
 		httputil.HandleError(w, "Could not get signed beacon block: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
+	defer release()
 	if result == nil {
 		httputil.HandleError(w, fmt.Sprintf("Unknown block type %T", blk), http.StatusInternalServerError)
 		return
@@ -125,24 +127,26 @@ func (s *Server) getBlockV2Ssz(w http.ResponseWriter, blk interfaces.ReadOnlySig
 	httputil.WriteSsz(w, result, "beacon_block.ssz")
 }
 
-func (*Server) getBlockResponseBodySsz(blk interfaces.ReadOnlySignedBeaconBlock) ([]byte, error) {
+// getBlockResponseBodySsz encodes the block into a pooled buffer. The returned
+// release function must be called once the response has been written.
+func (*Server) getBlockResponseBodySsz(blk interfaces.ReadOnlySignedBeaconBlock) ([]byte, func(), error) {
 	err := blocks.BeaconBlockIsNil(blk)
 	if err != nil {
-		return nil, errNilBlock
+		return nil, nil, errNilBlock
 	}
 	pb, err := blk.Proto()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	marshaler, ok := pb.(ssz.Marshaler)
 	if !ok {
-		return nil, errMarshalSSZ
+		return nil, nil, errMarshalSSZ
 	}
-	sszData, err := marshaler.MarshalSSZ()
+	sszData, release, err := sszutil.MarshalWithPool(marshaler)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not marshal block into SSZ")
+		return nil, nil, errors.Wrapf(err, "could not marshal block into SSZ")
 	}
-	return sszData, nil
+	return sszData, release, nil
 }
 
 // getBlockV2Json returns the JSON-serialized version of the beacon block for given block ID.
@@ -180,6 +180,13 @@ func (s *Server) SubmitAttestations(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestations")
 	defer span.End()
 
+	if s.ClockChecker != nil {
+		if err := s.ClockChecker.CheckClock(); err != nil {
+			httputil.HandleError(w, "Could not publish attestations: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
 	var req structs.SubmitAttestationsRequest
 	err := json.NewDecoder(r.Body).Decode(&req.Data)
 	switch {
@@ -222,6 +229,13 @@ func (s *Server) SubmitAttestationsV2(w http.ResponseWriter, r *http.Request) {
 	ctx, span := trace.StartSpan(r.Context(), "beacon.SubmitAttestationsV2")
 	defer span.End()
 
+	if s.ClockChecker != nil {
+		if err := s.ClockChecker.CheckClock(); err != nil {
+			httputil.HandleError(w, "Could not publish attestations: "+err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
 	versionHeader := r.Header.Get(api.VersionHeader)
 	if versionHeader == "" {
 		httputil.HandleError(w, api.VersionHeader+" header is required", http.StatusBadRequest)
@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/server"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clocksync"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	prysmtime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
@@ -788,6 +789,36 @@ func TestSubmitAttestations(t *testing.T) {
 
 }
 
+type clockCheckerMock struct {
+	err error
+}
+
+func (c *clockCheckerMock) CheckClock() error {
+	return c.err
+}
+
+func TestSubmitAttestations_ClockDrift(t *testing.T) {
+	broadcaster := &p2pMock.MockBroadcaster{}
+	s := &Server{
+		Broadcaster:  broadcaster,
+		ClockChecker: &clockCheckerMock{err: clocksync.ErrClockDrift},
+	}
+
+	var body bytes.Buffer
+	_, err := body.WriteString(singleAtt)
+	require.NoError(t, err)
+	request := httptest.NewRequest(http.MethodPost, "http://example.com", &body)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.SubmitAttestations(writer, request)
+	assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, clocksync.ErrClockDrift.Error(), e.Message)
+	assert.Equal(t, false, broadcaster.BroadcastCalled.Load())
+}
+
 func TestListVoluntaryExits(t *testing.T) {
 	exit1 := &ethpbv1alpha1.SignedVoluntaryExit{
 		Exit: &ethpbv1alpha1.VoluntaryExit{
@@ -112,13 +112,14 @@ func buildSidecarsJsonResponse(verifiedBlobs []*blocks.VerifiedROBlob) *structs.
 }
 
 func buildSidecarsSSZResponse(verifiedBlobs []*blocks.VerifiedROBlob) ([]byte, error) {
-	ssz := make([]byte, field_params.BlobSidecarSize*len(verifiedBlobs))
-	for i, sidecar := range verifiedBlobs {
-		sszrep, err := sidecar.MarshalSSZ()
+	ssz := make([]byte, 0, field_params.BlobSidecarSize*len(verifiedBlobs))
+	for _, sidecar := range verifiedBlobs {
+		var err error
+		// Encode each sidecar directly into the response instead of copying a temporary encoding.
+		ssz, err = sidecar.MarshalSSZTo(ssz)
 		if err != nil {
 			return nil, errors.Wrap(err, "failed to marshal sidecar ssz")
 		}
-		copy(ssz[i*field_params.BlobSidecarSize:(i+1)*field_params.BlobSidecarSize], sszrep)
 	}
 	return ssz, nil
 }
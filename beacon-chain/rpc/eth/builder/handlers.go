@@ -1,6 +1,8 @@
 package builder
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -8,17 +10,24 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 // ExpectedWithdrawals get the withdrawals computed from the specified state, that will be included in the block that gets built on the specified state.
+// The state is advanced to the requested proposal slot before computing withdrawals, making use of the next slot cache when the
+// proposal slot directly follows the state's block.
 func (s *Server) ExpectedWithdrawals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "builder.ExpectedWithdrawals")
+	defer span.End()
+
 	// Retrieve beacon state
 	stateId := r.PathValue("state_id")
 	if stateId == "" {
@@ -28,9 +37,9 @@ func (s *Server) ExpectedWithdrawals(w http.ResponseWriter, r *http.Request) {
 		})
 		return
 	}
-	st, err := s.Stater.State(r.Context(), []byte(stateId))
+	st, err := s.Stater.State(ctx, []byte(stateId))
 	if err != nil {
-		httputil.WriteError(w, handleWrapError(err, "could not retrieve state", http.StatusNotFound))
+		shared.WriteStateFetchError(w, err)
 		return
 	}
 	queryParam := r.URL.Query().Get("proposal_slot")
@@ -74,33 +83,36 @@ func (s *Server) ExpectedWithdrawals(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	// Get metadata for response
-	isOptimistic, err := s.OptimisticModeFetcher.IsOptimistic(r.Context())
+	isOptimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
 	if err != nil {
 		httputil.WriteError(w, handleWrapError(err, "could not get optimistic mode info", http.StatusInternalServerError))
 		return
 	}
-	root, err := helpers.BlockRootAtSlot(st, slots.PrevSlot(st.Slot()))
+	blockRoot, err := latestBlockRoot(ctx, st)
 	if err != nil {
 		httputil.WriteError(w, handleWrapError(err, "could not get block root", http.StatusInternalServerError))
 		return
 	}
-	var blockRoot = [32]byte(root)
-	isFinalized := s.FinalizationFetcher.IsFinalized(r.Context(), blockRoot)
+	isFinalized := s.FinalizationFetcher.IsFinalized(ctx, blockRoot)
 	// Advance state forward to proposal slot
-	st, err = transition.ProcessSlots(r.Context(), st, proposalSlot)
+	st, err = transition.ProcessSlotsUsingNextSlotCache(ctx, st, blockRoot[:], proposalSlot)
 	if err != nil {
-		httputil.WriteError(w, &httputil.DefaultJsonError{
-			Message: "could not process slots",
-			Code:    http.StatusInternalServerError,
-		})
+		httputil.WriteError(w, handleWrapError(err, "could not process slots", http.StatusInternalServerError))
 		return
 	}
 	withdrawals, _, err := st.ExpectedWithdrawals()
 	if err != nil {
-		httputil.WriteError(w, &httputil.DefaultJsonError{
-			Message: "could not get expected withdrawals",
-			Code:    http.StatusInternalServerError,
-		})
+		httputil.WriteError(w, handleWrapError(err, "could not get expected withdrawals", http.StatusInternalServerError))
+		return
+	}
+
+	if httputil.RespondWithSsz(r) {
+		sszResp, err := marshalWithdrawalsSsz(withdrawals)
+		if err != nil {
+			httputil.WriteError(w, handleWrapError(err, "could not marshal withdrawals", http.StatusInternalServerError))
+			return
+		}
+		httputil.WriteSsz(w, sszResp, "expected_withdrawals.ssz")
 		return
 	}
 	httputil.WriteJson(w, &structs.ExpectedWithdrawalsResponse{
@@ -110,6 +122,32 @@ func (s *Server) ExpectedWithdrawals(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+// latestBlockRoot returns the root of the latest block applied to the state. The state root of the
+// latest block header is only filled in during the next slot's processing, so it is computed here if missing.
+func latestBlockRoot(ctx context.Context, st state.BeaconState) ([32]byte, error) {
+	header := st.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		stateRoot, err := st.HashTreeRoot(ctx)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		header.StateRoot = stateRoot[:]
+	}
+	return header.HashTreeRoot()
+}
+
+func marshalWithdrawalsSsz(withdrawals []*enginev1.Withdrawal) ([]byte, error) {
+	resp := make([]byte, 0, len(withdrawals)*(&enginev1.Withdrawal{}).SizeSSZ())
+	for _, withdrawal := range withdrawals {
+		var err error
+		resp, err = withdrawal.MarshalSSZTo(resp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
 func buildExpectedWithdrawalsData(withdrawals []*enginev1.Withdrawal) []*structs.ExpectedWithdrawal {
 	data := make([]*structs.ExpectedWithdrawal, len(withdrawals))
 	for i, withdrawal := range withdrawals {
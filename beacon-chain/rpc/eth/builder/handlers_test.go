@@ -2,6 +2,7 @@ package builder
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -9,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
@@ -17,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -208,3 +211,75 @@ func TestExpectedWithdrawals(t *testing.T) {
 		require.DeepEqual(t, expectedWithdrawal3, resp.Data[2])
 	})
 }
+
+func TestExpectedWithdrawals_Encoding(t *testing.T) {
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	capellaSlot, err := slots.EpochStart(params.BeaconConfig().CapellaForkEpoch)
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(capellaSlot+1))
+
+	validators := make([]*eth.Validator, 2)
+	for i := range validators {
+		validators[i] = &eth.Validator{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+		}
+		validators[i].WithdrawalCredentials[0] = params.BeaconConfig().ETH1AddressWithdrawalPrefixByte
+	}
+	// Fully withdrawable.
+	validators[1].WithdrawableEpoch = 0
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances([]uint64{params.BeaconConfig().MaxEffectiveBalance, params.BeaconConfig().MaxEffectiveBalance}))
+
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	header := st.LatestBlockHeader()
+	header.StateRoot = stateRoot[:]
+	blockRoot, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	mockChainService := &mock.ChainService{FinalizedRoots: map[[32]byte]bool{blockRoot: true}}
+
+	t.Run("json", func(t *testing.T) {
+		s := &Server{
+			FinalizationFetcher:   mockChainService,
+			OptimisticModeFetcher: mockChainService,
+			Stater:                &testutil.MockStater{BeaconState: st.Copy()},
+		}
+		request := httptest.NewRequest("GET", "/eth/v1/builder/states/{state_id}/expected_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ExpectedWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ExpectedWithdrawalsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Finalized)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[0].ValidatorIndex)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		s := &Server{
+			FinalizationFetcher:   mockChainService,
+			OptimisticModeFetcher: mockChainService,
+			Stater:                &testutil.MockStater{BeaconState: st.Copy()},
+		}
+		request := httptest.NewRequest("GET", "/eth/v1/builder/states/{state_id}/expected_withdrawals", nil)
+		request.SetPathValue("state_id", "head")
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ExpectedWithdrawals(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		w := &enginev1.Withdrawal{}
+		require.Equal(t, w.SizeSSZ(), writer.Body.Len())
+		require.NoError(t, w.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, primitives.ValidatorIndex(1), w.ValidatorIndex)
+		assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, w.Amount)
+	})
+}
@@ -3,9 +3,7 @@ package config
 import (
 	"fmt"
 	"net/http"
-	"reflect"
 	"strconv"
-	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
@@ -81,38 +79,5 @@ func GetSpec(w http.ResponseWriter, r *http.Request) {
 }
 
 func prepareConfigSpec() (map[string]string, error) {
-	data := make(map[string]string)
-	config := *params.BeaconConfig()
-	t := reflect.TypeOf(config)
-	v := reflect.ValueOf(config)
-
-	for i := 0; i < t.NumField(); i++ {
-		tField := t.Field(i)
-		_, isSpecField := tField.Tag.Lookup("spec")
-		if !isSpecField {
-			// Field should not be returned from API.
-			continue
-		}
-
-		tagValue := strings.ToUpper(tField.Tag.Get("yaml"))
-		vField := v.Field(i)
-		switch vField.Kind() {
-		case reflect.Int:
-			data[tagValue] = strconv.FormatInt(vField.Int(), 10)
-		case reflect.Uint64:
-			data[tagValue] = strconv.FormatUint(vField.Uint(), 10)
-		case reflect.Slice:
-			data[tagValue] = hexutil.Encode(vField.Bytes())
-		case reflect.Array:
-			data[tagValue] = hexutil.Encode(reflect.ValueOf(&config).Elem().Field(i).Slice(0, vField.Len()).Bytes())
-		case reflect.String:
-			data[tagValue] = vField.String()
-		case reflect.Uint8:
-			data[tagValue] = hexutil.Encode([]byte{uint8(vField.Uint())})
-		default:
-			return nil, fmt.Errorf("unsupported config field type: %s", vField.Kind().String())
-		}
-	}
-
-	return data, nil
+	return params.SpecValues(params.BeaconConfig())
 }
@@ -122,13 +122,8 @@ func (s *Server) getBeaconStateSSZV2(ctx context.Context, w http.ResponseWriter,
 		shared.WriteStateFetchError(w, err)
 		return
 	}
-	sszState, err := st.MarshalSSZ()
-	if err != nil {
-		httputil.HandleError(w, "Could not marshal state into SSZ: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
 	w.Header().Set(api.VersionHeader, version.String(st.Version()))
-	httputil.WriteSsz(w, sszState, "beacon_state.ssz")
+	httputil.WriteSszStream(w, "beacon_state.ssz", st.WriteSSZ)
 }
 
 // GetForkChoiceHeadsV2 retrieves the leaves of the current fork choice tree.
@@ -167,37 +162,5 @@ func (s *Server) GetForkChoice(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	nodes := make([]*structs.ForkChoiceNode, len(dump.ForkChoiceNodes))
-	for i, n := range dump.ForkChoiceNodes {
-		nodes[i] = &structs.ForkChoiceNode{
-			Slot:               fmt.Sprintf("%d", n.Slot),
-			BlockRoot:          hexutil.Encode(n.BlockRoot),
-			ParentRoot:         hexutil.Encode(n.ParentRoot),
-			JustifiedEpoch:     fmt.Sprintf("%d", n.JustifiedEpoch),
-			FinalizedEpoch:     fmt.Sprintf("%d", n.FinalizedEpoch),
-			Weight:             fmt.Sprintf("%d", n.Weight),
-			ExecutionBlockHash: hexutil.Encode(n.ExecutionBlockHash),
-			Validity:           n.Validity.String(),
-			ExtraData: &structs.ForkChoiceNodeExtraData{
-				UnrealizedJustifiedEpoch: fmt.Sprintf("%d", n.UnrealizedJustifiedEpoch),
-				UnrealizedFinalizedEpoch: fmt.Sprintf("%d", n.UnrealizedFinalizedEpoch),
-				Balance:                  fmt.Sprintf("%d", n.Balance),
-				ExecutionOptimistic:      n.ExecutionOptimistic,
-				TimeStamp:                fmt.Sprintf("%d", n.Timestamp),
-			},
-		}
-	}
-	resp := &structs.GetForkChoiceDumpResponse{
-		JustifiedCheckpoint: structs.CheckpointFromConsensus(dump.JustifiedCheckpoint),
-		FinalizedCheckpoint: structs.CheckpointFromConsensus(dump.FinalizedCheckpoint),
-		ForkChoiceNodes:     nodes,
-		ExtraData: &structs.ForkChoiceDumpExtraData{
-			UnrealizedJustifiedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedJustifiedCheckpoint),
-			UnrealizedFinalizedCheckpoint: structs.CheckpointFromConsensus(dump.UnrealizedFinalizedCheckpoint),
-			ProposerBoostRoot:             hexutil.Encode(dump.ProposerBoostRoot),
-			PreviousProposerBoostRoot:     hexutil.Encode(dump.PreviousProposerBoostRoot),
-			HeadRoot:                      hexutil.Encode(dump.HeadRoot),
-		},
-	}
-	httputil.WriteJson(w, resp)
+	httputil.WriteJson(w, structs.ForkChoiceDumpFromConsensus(dump))
 }
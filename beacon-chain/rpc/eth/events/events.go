@@ -62,6 +62,8 @@ const (
 	LightClientFinalityUpdateTopic = "light_client_finality_update"
 	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
 	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
+	// FinalityStallTopic represents a finality stall status event topic.
+	FinalityStallTopic = "finality_stall"
 )
 
 var (
@@ -95,6 +97,7 @@ var stateFeedEventTopics = map[feed.EventType]string{
 	statefeed.LightClientOptimisticUpdate: LightClientOptimisticUpdateTopic,
 	statefeed.Reorg:                       ChainReorgTopic,
 	statefeed.BlockProcessed:              BlockTopic,
+	statefeed.FinalityStall:               FinalityStallTopic,
 }
 
 var topicsForStateFeed = topicsForFeed(stateFeedEventTopics)
@@ -418,6 +421,8 @@ func topicForEvent(event *feed.Event) string {
 		return ChainReorgTopic
 	case *statefeed.BlockProcessedData:
 		return BlockTopic
+	case *statefeed.FinalityStallData:
+		return FinalityStallTopic
 	default:
 		if event.Type == statefeed.MissedSlot {
 			return PayloadAttributesTopic
@@ -551,6 +556,16 @@ func (s *Server) lazyReaderForEvent(ctx context.Context, event *feed.Event, topi
 			}
 			return jsonMarshalReader(eventName, blk)
 		}, nil
+	case *statefeed.FinalityStallData:
+		return func() io.Reader {
+			return jsonMarshalReader(eventName, &structs.FinalityStallEvent{
+				Stalled:             v.Stalled,
+				InactivityLeak:      v.InactivityLeak,
+				CurrentEpoch:        fmt.Sprintf("%d", v.CurrentEpoch),
+				FinalizedEpoch:      fmt.Sprintf("%d", v.FinalizedEpoch),
+				EpochsSinceFinality: fmt.Sprintf("%d", v.EpochsSinceFinality),
+			})
+		}, nil
 	default:
 		return nil, errors.Wrapf(errUnhandledEventData, "event data type %T unsupported", v)
 	}
@@ -324,6 +324,7 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			FinalizedCheckpointTopic,
 			ChainReorgTopic,
 			BlockTopic,
+			FinalityStallTopic,
 		})
 		require.NoError(t, err)
 		request := topics.testHttpRequest(testSync.ctx, t)
@@ -376,6 +377,15 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 					ExecutionOptimistic: false,
 				},
 			},
+			&feed.Event{
+				Type: statefeed.FinalityStall,
+				Data: &statefeed.FinalityStallData{
+					Stalled:             true,
+					CurrentEpoch:        15,
+					FinalizedEpoch:      10,
+					EpochsSinceFinality: 5,
+				},
+			},
 		}
 
 		go func() {
@@ -120,6 +120,12 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 	// Populate updates
 	var updates []*structs.LightClientUpdateResponse
 	for period := startPeriod; period <= endPeriod; period++ {
+		// Serve the stored update of the period if there is one.
+		if update, ok := s.storedLightClientUpdate(ctx, period); ok {
+			updates = append(updates, update)
+			continue
+		}
+
 		// Get the last known state of the period,
 		//    1. We wish the block has a parent in the same period if possible
 		//	  2. We wish the block has a state in the same period
@@ -224,6 +230,25 @@ func (s *Server) GetLightClientUpdatesByRange(w http.ResponseWriter, req *http.R
 	httputil.WriteJson(w, updates)
 }
 
+// storedLightClientUpdate returns the light client update of the period from the database, if there is one.
+func (s *Server) storedLightClientUpdate(ctx context.Context, period uint64) (*structs.LightClientUpdateResponse, bool) {
+	if s.BeaconDB == nil {
+		return nil, false
+	}
+	stored, err := s.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil || stored == nil || stored.Data == nil {
+		return nil, false
+	}
+	update, err := structs.LightClientUpdateFromConsensus(stored.Data)
+	if err != nil {
+		return nil, false
+	}
+	return &structs.LightClientUpdateResponse{
+		Version: version.String(int(stored.Version)),
+		Data:    update,
+	}, true
+}
+
 // GetLightClientFinalityUpdate - implements https://github.com/ethereum/beacon-APIs/blob/263f4ed6c263c967f13279c7a9f5629b51c5fc55/apis/beacon/light_client/finality_update.yaml
 func (s *Server) GetLightClientFinalityUpdate(w http.ResponseWriter, req *http.Request) {
 	ctx, span := trace.StartSpan(req.Context(), "beacon.GetLightClientFinalityUpdate")
@@ -14,15 +14,20 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	lightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	dbtesting "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestLightClientHandler_GetLightClientBootstrap_Altair(t *testing.T) {
@@ -331,6 +336,42 @@ func TestLightClientHandler_GetLightClientUpdatesByRangeAltair(t *testing.T) {
 	require.NotNil(t, resp)
 }
 
+func TestLightClientHandler_GetLightClientUpdatesByRange_Stored(t *testing.T) {
+	helpers.ClearCache()
+	l := util.NewTestLightClient(t).SetupTestAltair()
+	update, err := lightclient.NewLightClientUpdateFromBeaconState(l.Ctx, l.State, l.Block, l.AttestedState, l.AttestedBlock, l.FinalizedBlock)
+	require.NoError(t, err)
+
+	slot := l.State.Slot()
+	period := slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+	db := dbtesting.SetupDB(t)
+	require.NoError(t, db.SaveLightClientUpdate(l.Ctx, period, &ethpbv2.LightClientUpdateWithVersion{
+		Version: ethpbv2.Version(version.Altair),
+		Data:    update,
+	}))
+
+	// The stater and blocker have no data, so the update can only come from the database.
+	s := &Server{
+		Stater:      &testutil.MockStater{},
+		Blocker:     &testutil.MockBlocker{},
+		HeadFetcher: &mock.ChainService{State: l.State},
+		BeaconDB:    db,
+	}
+	url := fmt.Sprintf("http://foo.com/?count=1&start_period=%d", period)
+	request := httptest.NewRequest("GET", url, nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetLightClientUpdatesByRange(writer, request)
+
+	require.Equal(t, http.StatusOK, writer.Code)
+	var resp structs.LightClientUpdatesByRangeResponse
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), &resp.Updates))
+	require.Equal(t, 1, len(resp.Updates))
+	require.Equal(t, "altair", resp.Updates[0].Version)
+	require.Equal(t, strconv.FormatUint(uint64(slot), 10), resp.Updates[0].Data.SignatureSlot)
+}
+
 func TestLightClientHandler_GetLightClientUpdatesByRangeCapella(t *testing.T) {
 	helpers.ClearCache()
 	ctx := context.Background()
@@ -0,0 +1,201 @@
+package rewards
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// BlockIncomeCacheSize is the number of finalized epochs whose block proposal and sync committee rewards are cached.
+const BlockIncomeCacheSize = 64
+
+// blockIncome holds the rewards of an epoch which are earned through its blocks, by validator index.
+type blockIncome struct {
+	proposals     map[primitives.ValidatorIndex]int64
+	syncCommittee map[primitives.ValidatorIndex]int64
+}
+
+// ValidatorIncome retrieves the income of the validators specified by array of public keys or validator index in an
+// epoch, made of the attestation, sync committee and block proposal rewards and penalties of the epoch.
+// If no array is provided, return income info for every validator.
+func (s *Server) ValidatorIncome(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.ValidatorIncome")
+	defer span.End()
+
+	st, ok := s.attRewardsState(w, r)
+	if !ok {
+		return
+	}
+	bal, vals, valIndices, ok := attRewardsBalancesAndVals(w, r, st)
+	if !ok {
+		return
+	}
+	deltas, err := altair.AttestationsDelta(st, bal, vals)
+	if err != nil {
+		httputil.HandleError(w, "Could not get attestations delta: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not get optimistic mode info: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	blkRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not get block root: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	finalized := s.FinalizationFetcher.IsFinalized(ctx, blkRoot)
+
+	// The state of the attestation rewards is at the end of the epoch following the requested one.
+	epoch := slots.ToEpoch(st.Slot()) - 1
+	income, httpErr := s.epochBlockIncome(ctx, epoch, finalized)
+	if httpErr != nil {
+		httputil.WriteError(w, httpErr)
+		return
+	}
+
+	data := make([]structs.ValidatorIncome, len(valIndices))
+	for i, valIdx := range valIndices {
+		d := deltas[i]
+		head := int64(d.HeadReward)                              // lint:ignore uintcast
+		source := int64(d.SourceReward) - int64(d.SourcePenalty) // lint:ignore uintcast
+		target := int64(d.TargetReward) - int64(d.TargetPenalty) // lint:ignore uintcast
+		inactivity := -int64(d.InactivityPenalty)                // lint:ignore uintcast
+		syncCommittee := income.syncCommittee[valIdx]
+		proposals := income.proposals[valIdx]
+		data[i] = structs.ValidatorIncome{
+			ValidatorIndex: strconv.FormatUint(uint64(valIdx), 10),
+			Head:           strconv.FormatInt(head, 10),
+			Target:         strconv.FormatInt(target, 10),
+			Source:         strconv.FormatInt(source, 10),
+			Inactivity:     strconv.FormatInt(inactivity, 10),
+			SyncCommittee:  strconv.FormatInt(syncCommittee, 10),
+			Proposals:      strconv.FormatInt(proposals, 10),
+			Total:          strconv.FormatInt(head+source+target+inactivity+syncCommittee+proposals, 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.ValidatorIncomeResponse{
+		Data:                data,
+		ExecutionOptimistic: optimistic,
+		Finalized:           finalized,
+	})
+}
+
+// epochBlockIncome returns the block proposal and sync committee rewards of the canonical blocks of the epoch. They
+// need a state replay per block, so the rewards of finalized epochs, which cannot change anymore, are cached.
+func (s *Server) epochBlockIncome(ctx context.Context, epoch primitives.Epoch, finalized bool) (*blockIncome, *httputil.DefaultJsonError) {
+	if s.BlockIncomeCache != nil {
+		if income, ok := s.BlockIncomeCache.Get(epoch); ok {
+			return income.(*blockIncome), nil
+		}
+	}
+
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get epoch's starting slot: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	income := &blockIncome{
+		proposals:     make(map[primitives.ValidatorIndex]int64),
+		syncCommittee: make(map[primitives.ValidatorIndex]int64),
+	}
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		blk, err := s.Blocker.Block(ctx, []byte(strconv.FormatUint(uint64(slot), 10)))
+		if err != nil {
+			return nil, &httputil.DefaultJsonError{
+				Message: fmt.Sprintf("Could not get block at slot %d: %v", slot, err),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		if blk == nil || blk.IsNil() {
+			continue
+		}
+		if httpErr := s.addSyncCommitteeIncome(ctx, blk.Block(), income); httpErr != nil {
+			return nil, httpErr
+		}
+		blockRewards, httpErr := s.BlockRewardFetcher.GetBlockRewardsData(ctx, blk.Block())
+		if httpErr != nil {
+			return nil, httpErr
+		}
+		total, err := strconv.ParseInt(blockRewards.Total, 10, 64)
+		if err != nil {
+			return nil, &httputil.DefaultJsonError{
+				Message: "Could not parse block rewards: " + err.Error(),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		income.proposals[blk.Block().ProposerIndex()] += total
+	}
+
+	if finalized && s.BlockIncomeCache != nil {
+		s.BlockIncomeCache.Add(epoch, income)
+	}
+	return income, nil
+}
+
+// addSyncCommitteeIncome adds the rewards and penalties of the sync committee members for the sync aggregate of the block.
+func (s *Server) addSyncCommitteeIncome(ctx context.Context, blk interfaces.ReadOnlyBeaconBlock, income *blockIncome) *httputil.DefaultJsonError {
+	sa, err := blk.Body().SyncAggregate()
+	if err != nil {
+		return &httputil.DefaultJsonError{
+			Message: "Could not get sync aggregate: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	st, httpErr := s.BlockRewardFetcher.GetStateForRewards(ctx, blk)
+	if httpErr != nil {
+		return httpErr
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		return &httputil.DefaultJsonError{
+			Message: "Could not get total active balance: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	if err != nil {
+		return &httputil.DefaultJsonError{
+			Message: "Could not get sync committee rewards: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	sc, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return &httputil.DefaultJsonError{
+			Message: "Could not get current sync committee: " + err.Error(),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	for i, pk := range sc.Pubkeys {
+		valIdx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pk))
+		if !ok {
+			return &httputil.DefaultJsonError{
+				Message: fmt.Sprintf("No validator index found for pubkey %#x", pk),
+				Code:    http.StatusInternalServerError,
+			}
+		}
+		if sa.SyncCommitteeBits.BitAt(uint64(i)) {
+			income.syncCommittee[valIdx] += int64(participantReward) // lint:ignore uintcast
+		} else {
+			income.syncCommittee[valIdx] -= int64(participantReward) // lint:ignore uintcast
+		}
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+package rewards
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	mockrewards "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/rewards/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestValidatorIncome(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig()
+	cfg.AltairForkEpoch = 1
+	params.OverrideBeaconConfig(cfg)
+	helpers.ClearCache()
+
+	valCount := 64
+	st, err := util.NewBeaconStateCapella()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(params.BeaconConfig().SlotsPerEpoch*3-1))
+	validators := make([]*eth.Validator, valCount)
+	balances := make([]uint64, valCount)
+	for i := range validators {
+		validators[i] = &eth.Validator{
+			PublicKey:         bytesutil.PadTo([]byte{byte(i + 1)}, fieldparams.BLSPubkeyLength),
+			ExitEpoch:         params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch: params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance:  params.BeaconConfig().MaxEffectiveBalance,
+		}
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances(balances))
+	require.NoError(t, st.SetInactivityScores(make([]uint64, valCount)))
+	participation := make([]byte, valCount)
+	for i := range participation {
+		participation[i] = 0b111
+	}
+	require.NoError(t, st.SetCurrentParticipationBits(participation))
+	require.NoError(t, st.SetPreviousParticipationBits(participation))
+	// The even positions of the sync committee belong to validator 0, which signs, and the odd ones to validator 1,
+	// which does not.
+	syncCommittee := &eth.SyncCommittee{
+		Pubkeys:         make([][]byte, params.BeaconConfig().SyncCommitteeSize),
+		AggregatePubkey: make([]byte, fieldparams.BLSPubkeyLength),
+	}
+	syncBits := bitfield.NewBitvector512()
+	for i := range syncCommittee.Pubkeys {
+		syncCommittee.Pubkeys[i] = validators[i%2].PublicKey
+		if i%2 == 0 {
+			syncBits.SetBitAt(uint64(i), true)
+		}
+	}
+	require.NoError(t, st.SetCurrentSyncCommittee(syncCommittee))
+
+	b := util.NewBeaconBlockCapella()
+	b.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	b.Block.ProposerIndex = 2
+	b.Block.Body.SyncAggregate.SyncCommitteeBits = syncBits
+	blk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+
+	blkRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	require.NoError(t, err)
+	currentSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	mockChainService := &mock.ChainService{
+		Slot:           &currentSlot,
+		FinalizedRoots: map[[32]byte]bool{blkRoot: true},
+	}
+	blocker := &testutil.MockBlocker{SlotBlockMap: map[primitives.Slot]interfaces.ReadOnlySignedBeaconBlock{
+		params.BeaconConfig().SlotsPerEpoch: blk,
+	}}
+	s := &Server{
+		Blocker: blocker,
+		Stater: &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{
+			params.BeaconConfig().SlotsPerEpoch*3 - 1: st,
+		}},
+		TimeFetcher:           mockChainService,
+		OptimisticModeFetcher: mockChainService,
+		FinalizationFetcher:   mockChainService,
+		BlockRewardFetcher: &mockrewards.MockBlockRewardFetcher{
+			Rewards: &structs.BlockRewards{Total: "1000"},
+			State:   st,
+		},
+		BlockIncomeCache: lruwrpr.New(BlockIncomeCacheSize),
+	}
+
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	require.NoError(t, err)
+	_, participantReward, err := altair.SyncRewards(activeBalance)
+	require.NoError(t, err)
+	syncReward := int64(participantReward) * int64(params.BeaconConfig().SyncCommitteeSize) / 2
+
+	income := func(t *testing.T) []structs.ValidatorIncome {
+		valIds, err := json.Marshal([]string{"0", "1", fmt.Sprintf("%#x", validators[2].PublicKey)})
+		require.NoError(t, err)
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/income/1", bytes.NewReader(valIds))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.ValidatorIncome(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ValidatorIncomeResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Finalized)
+		require.Equal(t, 3, len(resp.Data))
+		return resp.Data
+	}
+
+	data := income(t)
+	assert.Equal(t, strconv.FormatInt(syncReward, 10), data[0].SyncCommittee)
+	assert.Equal(t, strconv.FormatInt(-syncReward, 10), data[1].SyncCommittee)
+	assert.Equal(t, "0", data[2].SyncCommittee)
+	assert.Equal(t, "0", data[0].Proposals)
+	assert.Equal(t, "1000", data[2].Proposals)
+	for _, d := range data {
+		var total int64
+		for _, component := range []string{d.Head, d.Source, d.Target, d.Inactivity, d.SyncCommittee, d.Proposals} {
+			c, err := strconv.ParseInt(component, 10, 64)
+			require.NoError(t, err)
+			total += c
+		}
+		assert.Equal(t, strconv.FormatInt(total, 10), d.Total)
+	}
+
+	t.Run("cached", func(t *testing.T) {
+		blocker.ErrorToReturn = errors.New("blocks are not read for a cached epoch")
+		assert.DeepEqual(t, data, income(t))
+	})
+}
@@ -1,6 +1,7 @@
 package rewards
 
 import (
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 )
@@ -13,4 +14,5 @@ type Server struct {
 	Stater                lookup.Stater
 	HeadFetcher           blockchain.HeadFetcher
 	BlockRewardFetcher    BlockRewardsFetcher
+	BlockIncomeCache      *lru.Cache
 }
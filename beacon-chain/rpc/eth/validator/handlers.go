@@ -724,6 +724,15 @@ func (s *Server) RegisterValidator(w http.ResponseWriter, r *http.Request) {
 		httputil.HandleError(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if s.TrackedValidatorsCache == nil {
+		return
+	}
+	st, err := s.HeadFetcher.HeadStateReadOnly(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state to track registered validators")
+		return
+	}
+	core.TrackRegisteredValidators(s.TrackedValidatorsCache, st, registrations)
 }
 
 // PrepareBeaconProposer endpoint saves the fee recipient given a validator index, this is used when proposing a block.
@@ -761,6 +770,7 @@ func (s *Server) PrepareBeaconProposer(w http.ResponseWriter, r *http.Request) {
 			Active:       true, // TODO: either check or add the field in the request
 			Index:        primitives.ValidatorIndex(validatorIndex),
 			FeeRecipient: feeRecipient,
+			Source:       cache.PrepareProposerSource,
 		}
 		s.TrackedValidatorsCache.Set(val)
 		validatorIndices = append(validatorIndices, primitives.ValidatorIndex(validatorIndex))
@@ -101,6 +101,8 @@ func (p *BeaconDbStater) State(ctx context.Context, stateId []byte) (state.Beaco
 		s   state.BeaconState
 		err error
 	)
+	// States of API queries are replayed after the states needed by consensus.
+	ctx = stategen.WithReplayPriority(ctx, stategen.ReplayPriorityAPI)
 
 	stateIdString := strings.ToLower(string(stateId))
 	switch stateIdString {
@@ -230,6 +232,7 @@ func (p *BeaconDbStater) StateBySlot(ctx context.Context, target primitives.Slot
 	if target > p.GenesisTimeFetcher.CurrentSlot() {
 		return nil, errors.New("requested slot is in the future")
 	}
+	ctx = stategen.WithReplayPriority(ctx, stategen.ReplayPriorityAPI)
 
 	st, err := p.ReplayerBuilder.ReplayerForSlot(target).ReplayBlocks(ctx)
 	if err != nil {
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// blockTreeRefreshInterval is how long a snapshot of the block tree is served before being refreshed from forkchoice,
+// so that visualizers polling the tree do not contend for the forkchoice lock with the processing of blocks and
+// attestations.
+const blockTreeRefreshInterval = time.Second
+
+// blockTreeCache holds the last snapshot of the block tree. Requests arriving while it is refreshed wait for the
+// refresh rather than dumping forkchoice again.
+type blockTreeCache struct {
+	sync.Mutex
+	tree *structs.BlockTree
+	at   time.Time
+}
+
+// GetBlockTree returns the tree of the blocks in forkchoice since the finalized checkpoint, with their weights,
+// whether they are optimistic and on the canonical chain, for fork visualizers. The tree is a snapshot refreshed from
+// forkchoice at most once a second.
+func (s *Server) GetBlockTree(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetBlockTree")
+	defer span.End()
+
+	tree, err := s.blockTree.get(ctx, s.ChainInfoFetcher.ForkChoiceDump)
+	if err != nil {
+		httputil.HandleError(w, "Could not get block tree: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetBlockTreeResponse{Data: tree})
+}
+
+// get returns the snapshot of the block tree, first refreshing it with dump when it is older than
+// blockTreeRefreshInterval.
+func (c *blockTreeCache) get(ctx context.Context, dump func(context.Context) (*forkchoice.Dump, error)) (*structs.BlockTree, error) {
+	c.Lock()
+	defer c.Unlock()
+	if c.tree != nil && time.Since(c.at) < blockTreeRefreshInterval {
+		return c.tree, nil
+	}
+	d, err := dump(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c.at = time.Now()
+	c.tree = blockTreeFromDump(d, c.at)
+	return c.tree, nil
+}
+
+// blockTreeFromDump converts the forkchoice dump, marking the blocks from the head down to the finalized checkpoint
+// as canonical.
+func blockTreeFromDump(d *forkchoice.Dump, at time.Time) *structs.BlockTree {
+	parents := make(map[string][]byte, len(d.ForkChoiceNodes))
+	for _, n := range d.ForkChoiceNodes {
+		parents[string(n.BlockRoot)] = n.ParentRoot
+	}
+	canonical := make(map[string]bool)
+	for root := d.HeadRoot; root != nil; {
+		if canonical[string(root)] {
+			break
+		}
+		parent, ok := parents[string(root)]
+		if !ok {
+			break
+		}
+		canonical[string(root)] = true
+		root = parent
+	}
+
+	nodes := make([]*structs.BlockTreeNode, len(d.ForkChoiceNodes))
+	for i, n := range d.ForkChoiceNodes {
+		nodes[i] = &structs.BlockTreeNode{
+			Root:                hexutil.Encode(n.BlockRoot),
+			ParentRoot:          hexutil.Encode(n.ParentRoot),
+			Slot:                strconv.FormatUint(uint64(n.Slot), 10),
+			Weight:              strconv.FormatUint(n.Weight, 10),
+			Canonical:           canonical[string(n.BlockRoot)],
+			ExecutionOptimistic: n.ExecutionOptimistic,
+			Validity:            n.Validity.String(),
+			Timestamp:           strconv.FormatUint(n.Timestamp, 10),
+		}
+	}
+	return &structs.BlockTree{
+		JustifiedCheckpoint: structs.CheckpointFromConsensus(d.JustifiedCheckpoint),
+		FinalizedCheckpoint: structs.CheckpointFromConsensus(d.FinalizedCheckpoint),
+		HeadRoot:            hexutil.Encode(d.HeadRoot),
+		ProposerBoostRoot:   hexutil.Encode(d.ProposerBoostRoot),
+		UpdatedAt:           at.UTC().Format(time.RFC3339Nano),
+		Nodes:               nodes,
+	}
+}
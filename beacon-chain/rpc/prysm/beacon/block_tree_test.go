@@ -0,0 +1,90 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	blockchainmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/forkchoice"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type dumpCountingChainService struct {
+	*blockchainmock.ChainService
+	dump  *forkchoice.Dump
+	count int
+}
+
+func (c *dumpCountingChainService) ForkChoiceDump(_ context.Context) (*forkchoice.Dump, error) {
+	c.count++
+	return c.dump, nil
+}
+
+func TestGetBlockTree(t *testing.T) {
+	root := func(s string) []byte {
+		return bytesutil.PadTo([]byte(s), 32)
+	}
+	// a is finalized, b and c fork from it, and d on top of c is the head.
+	chain := &dumpCountingChainService{
+		ChainService: &blockchainmock.ChainService{},
+		dump: &forkchoice.Dump{
+			JustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: root("a")},
+			FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: root("a")},
+			ProposerBoostRoot:   root("d"),
+			HeadRoot:            root("d"),
+			ForkChoiceNodes: []*forkchoice.Node{
+				{Slot: 32, BlockRoot: root("a"), ParentRoot: make([]byte, 32), Weight: 30},
+				{Slot: 33, BlockRoot: root("b"), ParentRoot: root("a"), Weight: 10},
+				{Slot: 34, BlockRoot: root("c"), ParentRoot: root("a"), Weight: 20, Timestamp: 100},
+				{Slot: 35, BlockRoot: root("d"), ParentRoot: root("c"), Weight: 20, ExecutionOptimistic: true, Validity: forkchoice.Optimistic},
+			},
+		},
+	}
+	s := &Server{ChainInfoFetcher: chain}
+
+	get := func() *structs.BlockTree {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/block_tree", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetBlockTree(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetBlockTreeResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		return resp.Data
+	}
+
+	tree := get()
+	assert.Equal(t, hexutil.Encode(root("d")), tree.HeadRoot)
+	assert.Equal(t, hexutil.Encode(root("d")), tree.ProposerBoostRoot)
+	assert.Equal(t, "1", tree.FinalizedCheckpoint.Epoch)
+	require.Equal(t, 4, len(tree.Nodes))
+	canonical := make(map[string]bool)
+	for _, n := range tree.Nodes {
+		canonical[n.Root] = n.Canonical
+	}
+	assert.Equal(t, true, canonical[hexutil.Encode(root("a"))])
+	assert.Equal(t, false, canonical[hexutil.Encode(root("b"))])
+	assert.Equal(t, true, canonical[hexutil.Encode(root("c"))])
+	assert.Equal(t, true, canonical[hexutil.Encode(root("d"))])
+	assert.Equal(t, "34", tree.Nodes[2].Slot)
+	assert.Equal(t, "20", tree.Nodes[2].Weight)
+	assert.Equal(t, "100", tree.Nodes[2].Timestamp)
+	assert.Equal(t, true, tree.Nodes[3].ExecutionOptimistic)
+	assert.Equal(t, "optimistic", tree.Nodes[3].Validity)
+
+	// The snapshot is served again without dumping forkchoice until it is refreshed.
+	get()
+	assert.Equal(t, 1, chain.count)
+	s.blockTree.at = s.blockTree.at.Add(-blockTreeRefreshInterval)
+	get()
+	assert.Equal(t, 2, chain.count)
+}
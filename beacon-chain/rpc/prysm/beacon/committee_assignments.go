@@ -0,0 +1,115 @@
+package beacon
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// GetCommitteeAssignments returns all beacon committees of the current and the next epoch, together with the
+// attestation subnet of every committee. The response is SSZ encoded when requested with the octet-stream media type.
+func (s *Server) GetCommitteeAssignments(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetCommitteeAssignments")
+	defer span.End()
+
+	if shared.IsSyncing(ctx, w, s.SyncChecker, s.HeadFetcher, s.TimeFetcher, s.OptimisticModeFetcher) {
+		return
+	}
+
+	currentEpoch := slots.ToEpoch(s.TimeFetcher.CurrentSlot())
+	startSlot, err := slots.EpochStart(currentEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get start slot of the current epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	st, err := s.Stater.StateBySlot(ctx, startSlot)
+	if err != nil {
+		httputil.HandleError(w, "Could not get state: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	current, err := committeeAssignments(ctx, st, currentEpoch)
+	if err != nil {
+		httputil.HandleError(w, "Could not get committees of the current epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	next, err := committeeAssignments(ctx, st, currentEpoch+1)
+	if err != nil {
+		httputil.HandleError(w, "Could not get committees of the next epoch: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	lookahead := &eth.CommitteeAssignmentsLookahead{
+		CurrentEpoch: current,
+		NextEpoch:    next,
+	}
+
+	if httputil.RespondWithSsz(r) {
+		sszResp, err := lookahead.MarshalSSZ()
+		if err != nil {
+			httputil.HandleError(w, "Could not marshal committee assignments: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		httputil.WriteSsz(w, sszResp, "committee_assignments.ssz")
+		return
+	}
+
+	isOptimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.GetCommitteeAssignmentsResponse{
+		ExecutionOptimistic: isOptimistic,
+		Data:                structs.CommitteeAssignmentsLookaheadFromConsensus(lookahead),
+	})
+}
+
+// committeeAssignments computes the committees of every slot of the epoch from the state, which must be in the
+// epoch or in the previous one.
+func committeeAssignments(ctx context.Context, st state.ReadOnlyBeaconState, epoch primitives.Epoch) (*eth.CommitteeAssignments, error) {
+	activeCount, err := helpers.ActiveValidatorCount(ctx, st, epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get active validator count")
+	}
+	committeesPerSlot := helpers.SlotCommitteeCount(activeCount)
+	startSlot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	committees := make([]*eth.CommitteeAssignment, 0, committeesPerSlot*uint64(slotsPerEpoch))
+	for slot := startSlot; slot < startSlot+slotsPerEpoch; slot++ {
+		for i := uint64(0); i < committeesPerSlot; i++ {
+			committeeIndex := primitives.CommitteeIndex(i)
+			committee, err := helpers.BeaconCommitteeFromState(ctx, st, slot, committeeIndex)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get committee %d at slot %d", committeeIndex, slot)
+			}
+			validators := make([]uint64, len(committee))
+			for j, v := range committee {
+				validators[j] = uint64(v)
+			}
+			committees = append(committees, &eth.CommitteeAssignment{
+				Slot:           slot,
+				CommitteeIndex: committeeIndex,
+				Subnet:         helpers.ComputeSubnetFromCommitteeAndSlot(activeCount, committeeIndex, slot),
+				Validators:     validators,
+			})
+		}
+	}
+	return &eth.CommitteeAssignments{
+		Epoch:      epoch,
+		Committees: committees,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package beacon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetCommitteeAssignments(t *testing.T) {
+	helpers.ClearCache()
+	valCount := 256
+	slot := params.BeaconConfig().SlotsPerEpoch * 2
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	validators := make([]*eth.Validator, valCount)
+	for i := range validators {
+		validators[i] = &eth.Validator{
+			PublicKey:        bytesutil.PadTo(bytesutil.Uint64ToBytesLittleEndian(uint64(i)), fieldparams.BLSPubkeyLength),
+			ExitEpoch:        params.BeaconConfig().FarFutureEpoch,
+			EffectiveBalance: params.BeaconConfig().MaxEffectiveBalance,
+		}
+	}
+	require.NoError(t, st.SetValidators(validators))
+
+	chainService := &chainMock.ChainService{Slot: &slot}
+	s := &Server{
+		SyncChecker:           &mockSync.Sync{IsSyncing: false},
+		TimeFetcher:           chainService,
+		OptimisticModeFetcher: chainService,
+		Stater:                &testutil.MockStater{StatesBySlot: map[primitives.Slot]state.BeaconState{slot: st}},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/committee_assignments", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetCommitteeAssignmentsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.Data.CurrentEpoch.Epoch)
+		assert.Equal(t, "3", resp.Data.NextEpoch.Epoch)
+
+		committeesPerSlot := helpers.SlotCommitteeCount(uint64(valCount))
+		for _, assignments := range []*structs.CommitteeAssignments{resp.Data.CurrentEpoch, resp.Data.NextEpoch} {
+			require.Equal(t, int(committeesPerSlot)*int(params.BeaconConfig().SlotsPerEpoch), len(assignments.Committees))
+			// Every active validator is in exactly one committee of the epoch.
+			seen := make(map[string]bool)
+			for _, c := range assignments.Committees {
+				for _, v := range c.Validators {
+					require.Equal(t, false, seen[v])
+					seen[v] = true
+				}
+			}
+			assert.Equal(t, valCount, len(seen))
+		}
+		first := resp.Data.CurrentEpoch.Committees[committeesPerSlot]
+		assert.Equal(t, "65", first.Slot)
+		assert.Equal(t, "0", first.CommitteeIndex)
+		assert.Equal(t, "1", first.Subnet)
+	})
+	t.Run("ssz", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/committee_assignments", nil)
+		request.Header.Set("Accept", api.OctetStreamMediaType)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetCommitteeAssignments(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		lookahead := &eth.CommitteeAssignmentsLookahead{}
+		require.NoError(t, lookahead.UnmarshalSSZ(writer.Body.Bytes()))
+		assert.Equal(t, primitives.Epoch(2), lookahead.CurrentEpoch.Epoch)
+		assert.Equal(t, primitives.Epoch(3), lookahead.NextEpoch.Epoch)
+		committee, err := helpers.BeaconCommitteeFromState(context.Background(), st, slot+1, 0)
+		require.NoError(t, err)
+		c := lookahead.CurrentEpoch.Committees[helpers.SlotCommitteeCount(uint64(valCount))]
+		require.Equal(t, len(committee), len(c.Validators))
+		for i, v := range committee {
+			assert.Equal(t, uint64(v), c.Validators[i])
+		}
+	})
+}
@@ -0,0 +1,243 @@
+package beacon
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/pagination"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// pendingQueueRequest holds the parsed inputs and state metadata shared by all pending queue endpoints.
+type pendingQueueRequest struct {
+	st                  state.BeaconState
+	pageToken           string
+	pageSize            int
+	executionOptimistic bool
+	finalized           bool
+}
+
+// GetPendingDeposits returns the pending deposits queue of the requested state, together with the
+// position of every deposit in the queue. Results can be filtered by the `pubkey` query parameter
+// and are paginated with the `page_size` and `page_token` query parameters.
+func (s *Server) GetPendingDeposits(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingDeposits")
+	defer span.End()
+
+	_, pubkey, ok := shared.HexFromQuery(w, r, "pubkey", fieldparams.BLSPubkeyLength, false)
+	if !ok {
+		return
+	}
+	req, ok := s.parsePendingQueueRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	deposits, err := req.st.PendingDeposits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	positions := make([]int, 0, len(deposits))
+	for i, d := range deposits {
+		if pubkey != nil && string(d.PublicKey) != string(pubkey) {
+			continue
+		}
+		positions = append(positions, i)
+	}
+	start, end, nextPageToken, ok := paginatePendingQueue(w, req, len(positions))
+	if !ok {
+		return
+	}
+
+	data := make([]*structs.QueuedPendingDeposit, 0, end-start)
+	for _, pos := range positions[start:end] {
+		data = append(data, &structs.QueuedPendingDeposit{
+			Position: strconv.Itoa(pos),
+			Deposit:  structs.PendingDepositsFromConsensus(deposits[pos : pos+1])[0],
+		})
+	}
+	httputil.WriteJson(w, &structs.GetPendingDepositsResponse{
+		ExecutionOptimistic: req.executionOptimistic,
+		Finalized:           req.finalized,
+		Data:                data,
+		NextPageToken:       nextPageToken,
+		TotalSize:           strconv.Itoa(len(positions)),
+	})
+}
+
+// GetPendingPartialWithdrawals returns the pending partial withdrawals queue of the requested state, together with
+// the position of every withdrawal in the queue. Results can be filtered by the `validator_index` query parameter
+// and are paginated with the `page_size` and `page_token` query parameters.
+func (s *Server) GetPendingPartialWithdrawals(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingPartialWithdrawals")
+	defer span.End()
+
+	rawIndex, index, ok := shared.UintFromQuery(w, r, "validator_index", false)
+	if !ok {
+		return
+	}
+	req, ok := s.parsePendingQueueRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	withdrawals, err := req.st.PendingPartialWithdrawals()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending partial withdrawals: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	positions := make([]int, 0, len(withdrawals))
+	for i, pw := range withdrawals {
+		if rawIndex != "" && pw.Index != primitives.ValidatorIndex(index) {
+			continue
+		}
+		positions = append(positions, i)
+	}
+	start, end, nextPageToken, ok := paginatePendingQueue(w, req, len(positions))
+	if !ok {
+		return
+	}
+
+	data := make([]*structs.QueuedPendingPartialWithdrawal, 0, end-start)
+	for _, pos := range positions[start:end] {
+		data = append(data, &structs.QueuedPendingPartialWithdrawal{
+			Position:   strconv.Itoa(pos),
+			Withdrawal: structs.PendingPartialWithdrawalsFromConsensus(withdrawals[pos : pos+1])[0],
+		})
+	}
+	httputil.WriteJson(w, &structs.GetPendingPartialWithdrawalsResponse{
+		ExecutionOptimistic: req.executionOptimistic,
+		Finalized:           req.finalized,
+		Data:                data,
+		NextPageToken:       nextPageToken,
+		TotalSize:           strconv.Itoa(len(positions)),
+	})
+}
+
+// GetPendingConsolidations returns the pending consolidations queue of the requested state, together with the
+// position of every consolidation in the queue. Results can be filtered by the `validator_index` query parameter,
+// which matches both the source and the target of a consolidation, and are paginated with the `page_size` and
+// `page_token` query parameters.
+func (s *Server) GetPendingConsolidations(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetPendingConsolidations")
+	defer span.End()
+
+	rawIndex, index, ok := shared.UintFromQuery(w, r, "validator_index", false)
+	if !ok {
+		return
+	}
+	req, ok := s.parsePendingQueueRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	consolidations, err := req.st.PendingConsolidations()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	positions := make([]int, 0, len(consolidations))
+	for i, c := range consolidations {
+		if rawIndex != "" && c.SourceIndex != primitives.ValidatorIndex(index) && c.TargetIndex != primitives.ValidatorIndex(index) {
+			continue
+		}
+		positions = append(positions, i)
+	}
+	start, end, nextPageToken, ok := paginatePendingQueue(w, req, len(positions))
+	if !ok {
+		return
+	}
+
+	data := make([]*structs.QueuedPendingConsolidation, 0, end-start)
+	for _, pos := range positions[start:end] {
+		data = append(data, &structs.QueuedPendingConsolidation{
+			Position:      strconv.Itoa(pos),
+			Consolidation: structs.PendingConsolidationsFromConsensus(consolidations[pos : pos+1])[0],
+		})
+	}
+	httputil.WriteJson(w, &structs.GetPendingConsolidationsResponse{
+		ExecutionOptimistic: req.executionOptimistic,
+		Finalized:           req.finalized,
+		Data:                data,
+		NextPageToken:       nextPageToken,
+		TotalSize:           strconv.Itoa(len(positions)),
+	})
+}
+
+// parsePendingQueueRequest validates the pagination query parameters and fetches the requested state,
+// writing an error response and returning false if any step fails.
+func (s *Server) parsePendingQueueRequest(ctx context.Context, w http.ResponseWriter, r *http.Request) (*pendingQueueRequest, bool) {
+	_, pageSize, ok := shared.UintFromQuery(w, r, "page_size", false)
+	if !ok {
+		return nil, false
+	}
+	if pageSize > uint64(cmd.Get().MaxRPCPageSize) {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Requested page size %d can not be greater than max size %d", pageSize, cmd.Get().MaxRPCPageSize),
+			http.StatusBadRequest,
+		)
+		return nil, false
+	}
+
+	stateID := r.PathValue("state_id")
+	if stateID == "" {
+		httputil.HandleError(w, "state_id is required in URL params", http.StatusBadRequest)
+		return nil, false
+	}
+	st, err := s.Stater.State(ctx, []byte(stateID))
+	if err != nil {
+		shared.WriteStateFetchError(w, err)
+		return nil, false
+	}
+	if st.Version() < version.Electra {
+		httputil.HandleError(w, "Pending queues are not available before Electra", http.StatusBadRequest)
+		return nil, false
+	}
+
+	isOptimistic, err := helpers.IsOptimistic(ctx, []byte(stateID), s.OptimisticModeFetcher, s.Stater, s.ChainInfoFetcher, s.BeaconDB)
+	if err != nil {
+		httputil.HandleError(w, "Could not check optimistic status: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	blockRoot, err := st.LatestBlockHeader().HashTreeRoot()
+	if err != nil {
+		httputil.HandleError(w, "Could not calculate root of latest block header: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+
+	return &pendingQueueRequest{
+		st:                  st,
+		pageToken:           r.URL.Query().Get("page_token"),
+		pageSize:            int(pageSize),
+		executionOptimistic: isOptimistic,
+		finalized:           s.FinalizationFetcher.IsFinalized(ctx, blockRoot),
+	}, true
+}
+
+// paginatePendingQueue returns the bounds of the requested page for a queue of the given size.
+// An empty queue always yields an empty first page.
+func paginatePendingQueue(w http.ResponseWriter, req *pendingQueueRequest, total int) (int, int, string, bool) {
+	if total == 0 && (req.pageToken == "" || req.pageToken == "0") {
+		return 0, 0, "", true
+	}
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.pageToken, req.pageSize, total)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not paginate results").Error(), http.StatusBadRequest)
+		return 0, 0, "", false
+	}
+	return start, end, nextPageToken, true
+}
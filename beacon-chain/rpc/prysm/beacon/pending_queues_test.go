@@ -0,0 +1,192 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func pendingQueuesServer(t *testing.T, st state.BeaconState) *Server {
+	chainService := &chainMock.ChainService{Optimistic: true, FinalizedRoots: make(map[[32]byte]bool)}
+	root, err := st.LatestBlockHeader().HashTreeRoot()
+	require.NoError(t, err)
+	chainService.FinalizedRoots[root] = true
+	return &Server{
+		OptimisticModeFetcher: chainService,
+		FinalizationFetcher:   chainService,
+		Stater:                &testutil.MockStater{BeaconState: st},
+	}
+}
+
+func TestGetPendingDeposits(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	pubkeyA := bytes.Repeat([]byte{0xAA}, fieldparams.BLSPubkeyLength)
+	pubkeyB := bytes.Repeat([]byte{0xBB}, fieldparams.BLSPubkeyLength)
+	for i, pk := range [][]byte{pubkeyA, pubkeyB, pubkeyA, pubkeyA} {
+		require.NoError(t, st.AppendPendingDeposit(&eth.PendingDeposit{
+			PublicKey:             pk,
+			WithdrawalCredentials: make([]byte, 32),
+			Amount:                uint64(i + 1),
+			Signature:             make([]byte, fieldparams.BLSSignatureLength),
+		}))
+	}
+	s := pendingQueuesServer(t, st)
+
+	t.Run("all", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+		assert.Equal(t, true, resp.Finalized)
+		assert.Equal(t, "4", resp.TotalSize)
+		assert.Equal(t, "", resp.NextPageToken)
+		require.Equal(t, 4, len(resp.Data))
+		assert.Equal(t, "1", resp.Data[1].Position)
+		assert.Equal(t, hexutil.Encode(pubkeyB), resp.Data[1].Deposit.Pubkey)
+	})
+	t.Run("filtered and paginated", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?page_size=1&page_token=1&pubkey="+hexutil.Encode(pubkeyA), nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingDepositsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "3", resp.TotalSize)
+		assert.Equal(t, "2", resp.NextPageToken)
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "2", resp.Data[0].Position)
+		assert.Equal(t, "3", resp.Data[0].Deposit.Amount)
+	})
+	t.Run("page out of range", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?page_size=2&page_token=5", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+		e := &httputil.DefaultJsonError{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+		assert.StringContains(t, "Could not paginate results", e.Message)
+	})
+	t.Run("invalid pubkey", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?pubkey=0x1234", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingDeposits(writer, request)
+		require.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
+
+func TestGetPendingPartialWithdrawals(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	for i, idx := range []primitives.ValidatorIndex{3, 7, 3} {
+		require.NoError(t, st.AppendPendingPartialWithdrawal(&eth.PendingPartialWithdrawal{
+			Index:             idx,
+			Amount:            uint64(i + 1),
+			WithdrawableEpoch: 10,
+		}))
+	}
+	s := pendingQueuesServer(t, st)
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com?validator_index=3", nil)
+	request.SetPathValue("state_id", "head")
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetPendingPartialWithdrawals(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPendingPartialWithdrawalsResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	assert.Equal(t, "2", resp.TotalSize)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, "0", resp.Data[0].Position)
+	assert.Equal(t, "2", resp.Data[1].Position)
+	assert.Equal(t, "3", resp.Data[1].Withdrawal.Amount)
+}
+
+func TestGetPendingConsolidations(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+	s := pendingQueuesServer(t, st)
+
+	t.Run("empty queue", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingConsolidationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0", resp.TotalSize)
+		assert.Equal(t, 0, len(resp.Data))
+	})
+
+	require.NoError(t, st.AppendPendingConsolidation(&eth.PendingConsolidation{SourceIndex: 1, TargetIndex: 2}))
+	require.NoError(t, st.AppendPendingConsolidation(&eth.PendingConsolidation{SourceIndex: 4, TargetIndex: 5}))
+	require.NoError(t, st.AppendPendingConsolidation(&eth.PendingConsolidation{SourceIndex: 6, TargetIndex: 1}))
+
+	t.Run("matches source and target", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?validator_index=1", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetPendingConsolidations(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetPendingConsolidationsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "2", resp.TotalSize)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "0", resp.Data[0].Position)
+		assert.Equal(t, "2", resp.Data[1].Position)
+		assert.Equal(t, "6", resp.Data[1].Consolidation.SourceIndex)
+	})
+}
+
+func TestGetPendingQueues_PreElectra(t *testing.T) {
+	st, err := util.NewBeaconStateDeneb()
+	require.NoError(t, err)
+	s := pendingQueuesServer(t, st)
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+	request.SetPathValue("state_id", "head")
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+
+	s.GetPendingDeposits(writer, request)
+	require.Equal(t, http.StatusBadRequest, writer.Code)
+	e := &httputil.DefaultJsonError{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), e))
+	assert.StringContains(t, "not available before Electra", e.Message)
+}
@@ -0,0 +1,291 @@
+package beacon
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// maxQueueETAEpochs bounds the epochs simulated to estimate when the pending deposits are processed.
+const maxQueueETAEpochs = 1 << 20
+
+// GetQueueETA estimates when the pending deposits of the validator with the `pubkey` query parameter are processed,
+// when it is activated and when its pending consolidations complete, from the pending deposits and consolidations
+// queues of the requested state and the churn limits. With the `amount` query parameter, a new deposit of that
+// amount in gwei is estimated too, as if it were appended to the deposits queue.
+//
+// The deposits queue is simulated epoch by epoch as the epoch processing would process it, assuming that the total
+// active balance stays the same and that the chain finalizes every epoch.
+func (s *Server) GetQueueETA(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "beacon.GetQueueETA")
+	defer span.End()
+
+	_, pubkey, ok := shared.HexFromQuery(w, r, "pubkey", fieldparams.BLSPubkeyLength, true)
+	if !ok {
+		return
+	}
+	rawAmount, amount, ok := shared.UintFromQuery(w, r, "amount", false)
+	if !ok {
+		return
+	}
+	req, ok := s.parsePendingQueueRequest(ctx, w, r)
+	if !ok {
+		return
+	}
+	st := req.st
+	deposits, err := st.PendingDeposits()
+	if err != nil {
+		httputil.HandleError(w, "Could not get pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if rawAmount != "" {
+		// A new deposit request is included in the queue at the slot of the block containing it.
+		deposits = append(deposits, &ethpb.PendingDeposit{PublicKey: pubkey, Amount: amount, Slot: st.Slot() + 1})
+	}
+	activeBalance, err := helpers.TotalActiveBalance(st)
+	if err != nil {
+		httputil.HandleError(w, "Could not get total active balance: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	processed, err := simulatePendingDeposits(st, deposits, primitives.Gwei(activeBalance))
+	if err != nil {
+		httputil.HandleError(w, "Could not simulate pending deposits: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	genesis := time.Unix(int64(st.GenesisTime()), 0)
+	eta := &structs.QueueETA{
+		Epoch:          strconv.FormatUint(uint64(slots.ToEpoch(st.Slot())), 10),
+		Deposits:       make([]*structs.DepositETA, 0),
+		Consolidations: make([]*structs.ConsolidationETA, 0),
+	}
+	var deposited uint64
+	activationProcessing := params.BeaconConfig().FarFutureEpoch
+	for i, d := range deposits {
+		if string(d.PublicKey) != string(pubkey) {
+			continue
+		}
+		de := &structs.DepositETA{
+			Position: strconv.Itoa(i),
+			Amount:   strconv.FormatUint(d.Amount, 10),
+			Slot:     strconv.FormatUint(uint64(d.Slot), 10),
+			New:      rawAmount != "" && i == len(deposits)-1,
+		}
+		if e, ok := processed[i]; ok {
+			// Deposits processed by the epoch processing of an epoch apply from the next one.
+			de.Epoch = strconv.FormatUint(uint64(e+1), 10)
+			de.Time = epochTime(genesis, e+1)
+			deposited += d.Amount
+			if deposited >= params.BeaconConfig().MinActivationBalance && activationProcessing == params.BeaconConfig().FarFutureEpoch {
+				activationProcessing = e
+			}
+		}
+		eta.Deposits = append(eta.Deposits, de)
+	}
+
+	idx, found := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+	if found {
+		eta.Activation, err = existingValidatorActivationETA(st, idx, genesis)
+		if err != nil {
+			httputil.HandleError(w, "Could not get validator: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		eta.Consolidations, err = consolidationETAs(st, idx, genesis)
+		if err != nil {
+			httputil.HandleError(w, "Could not get pending consolidations: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	} else if activationProcessing != params.BeaconConfig().FarFutureEpoch {
+		// The validator is added to the registry by the epoch processing of its deposits, and becomes eligible for
+		// activation in the following one.
+		eligibility := activationProcessing + 2
+		eta.Activation = activationETA(st, eligibility, genesis)
+	}
+
+	httputil.WriteJson(w, &structs.GetQueueETAResponse{
+		ExecutionOptimistic: req.executionOptimistic,
+		Finalized:           req.finalized,
+		Data:                eta,
+	})
+}
+
+// simulatePendingDeposits returns the epochs whose epoch processing processes the pending deposits, by their
+// position in the queue, following the processing of the pending deposits of the epoch processing. Deposits not
+// processed within maxQueueETAEpochs are left out.
+func simulatePendingDeposits(st state.ReadOnlyBeaconState, deposits []*ethpb.PendingDeposit, activeBalance primitives.Gwei) (map[int]primitives.Epoch, error) {
+	cfg := params.BeaconConfig()
+	toConsume, err := st.DepositBalanceToConsume()
+	if err != nil {
+		return nil, err
+	}
+	churn := helpers.ActivationExitChurnLimit(activeBalance)
+	current := slots.ToEpoch(st.Slot())
+	finalized := st.FinalizedCheckpoint().Epoch
+
+	// The exit and withdrawable epochs of the validators of the deposits, which do not change in the simulation.
+	type depositor struct {
+		exited       bool
+		withdrawable primitives.Epoch
+	}
+	depositors := make(map[[fieldparams.BLSPubkeyLength]byte]depositor)
+	for _, d := range deposits {
+		pk := bytesutil.ToBytes48(d.PublicKey)
+		if _, ok := depositors[pk]; ok {
+			continue
+		}
+		dep := depositor{withdrawable: cfg.FarFutureEpoch}
+		if idx, ok := st.ValidatorIndexByPubkey(pk); ok {
+			val, err := st.ValidatorAtIndexReadOnly(idx)
+			if err != nil {
+				return nil, err
+			}
+			dep.exited = val.ExitEpoch() < cfg.FarFutureEpoch
+			dep.withdrawable = val.WithdrawableEpoch()
+		}
+		depositors[pk] = dep
+	}
+
+	queue := make([]int, len(deposits))
+	for i := range queue {
+		queue[i] = i
+	}
+	processed := make(map[int]primitives.Epoch, len(deposits))
+	for epoch := current; len(queue) > 0 && epoch < current+maxQueueETAEpochs; epoch++ {
+		if epoch > current && epoch >= 2 && epoch-2 > finalized {
+			finalized = epoch - 2
+		}
+		finalizedSlot, err := slots.EpochStart(finalized)
+		if err != nil {
+			return nil, err
+		}
+		available := toConsume + churn
+		var processedAmount primitives.Gwei
+		var next int
+		var postponed []int
+		churnLimitReached := false
+		for _, i := range queue {
+			d := deposits[i]
+			if d.Slot > finalizedSlot || uint64(next) >= cfg.MaxPendingDepositsPerEpoch {
+				break
+			}
+			dep := depositors[bytesutil.ToBytes48(d.PublicKey)]
+			if dep.withdrawable < epoch+1 {
+				processed[i] = epoch
+			} else if dep.exited {
+				postponed = append(postponed, i)
+			} else {
+				churnLimitReached = processedAmount+primitives.Gwei(d.Amount) > available
+				if churnLimitReached {
+					break
+				}
+				processedAmount += primitives.Gwei(d.Amount)
+				processed[i] = epoch
+			}
+			next++
+		}
+		queue = append(queue[next:], postponed...)
+		if churnLimitReached {
+			toConsume = available - processedAmount
+		} else {
+			toConsume = 0
+		}
+	}
+	return processed, nil
+}
+
+// existingValidatorActivationETA returns the activation of the validator, or nil when it was activated or is not
+// eligible for activation yet.
+func existingValidatorActivationETA(st state.ReadOnlyBeaconState, idx primitives.ValidatorIndex, genesis time.Time) (*structs.ActivationETA, error) {
+	cfg := params.BeaconConfig()
+	val, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil {
+		return nil, err
+	}
+	var eta *structs.ActivationETA
+	switch {
+	case val.ActivationEpoch() != cfg.FarFutureEpoch:
+		eta = &structs.ActivationETA{
+			EligibilityEpoch: strconv.FormatUint(uint64(val.ActivationEligibilityEpoch()), 10),
+			ActivationEpoch:  strconv.FormatUint(uint64(val.ActivationEpoch()), 10),
+			ActivationTime:   epochTime(genesis, val.ActivationEpoch()),
+		}
+	case val.ActivationEligibilityEpoch() != cfg.FarFutureEpoch:
+		eta = activationETA(st, val.ActivationEligibilityEpoch(), genesis)
+	case val.EffectiveBalance() >= cfg.MinActivationBalance:
+		eta = activationETA(st, slots.ToEpoch(st.Slot())+1, genesis)
+	default:
+		return nil, nil
+	}
+	eta.ValidatorIndex = strconv.FormatUint(uint64(idx), 10)
+	return eta, nil
+}
+
+// activationETA returns the activation of a validator eligible for activation at the epoch, by the first epoch
+// processing after the eligibility epoch is finalized, assuming that the chain finalizes every epoch.
+func activationETA(st state.ReadOnlyBeaconState, eligibility primitives.Epoch, genesis time.Time) *structs.ActivationETA {
+	processing := slots.ToEpoch(st.Slot())
+	if st.FinalizedCheckpoint().Epoch < eligibility {
+		processing = max(processing, eligibility+2)
+	}
+	activation := helpers.ActivationExitEpoch(processing)
+	return &structs.ActivationETA{
+		EligibilityEpoch: strconv.FormatUint(uint64(eligibility), 10),
+		ActivationEpoch:  strconv.FormatUint(uint64(activation), 10),
+		ActivationTime:   epochTime(genesis, activation),
+	}
+}
+
+// consolidationETAs returns when the pending consolidations of the validator, as source or target, complete. The
+// consolidations are processed in order once their source is withdrawable, so a consolidation completes at the
+// latest withdrawable epoch of the sources up to it.
+func consolidationETAs(st state.ReadOnlyBeaconState, idx primitives.ValidatorIndex, genesis time.Time) ([]*structs.ConsolidationETA, error) {
+	consolidations, err := st.PendingConsolidations()
+	if err != nil {
+		return nil, err
+	}
+	etas := make([]*structs.ConsolidationETA, 0)
+	var completion primitives.Epoch
+	for i, c := range consolidations {
+		source, err := st.ValidatorAtIndexReadOnly(c.SourceIndex)
+		if err != nil {
+			return nil, err
+		}
+		// Consolidations from slashed validators are skipped without waiting.
+		if !source.Slashed() {
+			completion = max(completion, source.WithdrawableEpoch())
+		}
+		if c.SourceIndex != idx && c.TargetIndex != idx {
+			continue
+		}
+		etas = append(etas, &structs.ConsolidationETA{
+			Position:    strconv.Itoa(i),
+			SourceIndex: strconv.FormatUint(uint64(c.SourceIndex), 10),
+			TargetIndex: strconv.FormatUint(uint64(c.TargetIndex), 10),
+			Epoch:       strconv.FormatUint(uint64(completion), 10),
+			Time:        epochTime(genesis, completion),
+		})
+	}
+	return etas, nil
+}
+
+// epochTime returns the start time of the epoch.
+func epochTime(genesis time.Time, epoch primitives.Epoch) string {
+	start, err := slots.EpochStart(epoch)
+	if err != nil {
+		return ""
+	}
+	return slots.BeginsAt(start, genesis).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,113 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestGetQueueETA(t *testing.T) {
+	// 64 validators of 32 ETH churn the minimum of 128 ETH per epoch.
+	st, _ := util.DeterministicGenesisStateElectra(t, 64)
+	gwei := params.BeaconConfig().GweiPerEth
+	pubkeyA := bytes.Repeat([]byte{0xAA}, fieldparams.BLSPubkeyLength)
+	pubkeyX := bytes.Repeat([]byte{0xBB}, fieldparams.BLSPubkeyLength)
+	for _, d := range []*eth.PendingDeposit{
+		{PublicKey: pubkeyA, Amount: 100 * gwei},
+		{PublicKey: pubkeyX, Amount: 32 * gwei},
+	} {
+		d.WithdrawalCredentials = make([]byte, 32)
+		d.Signature = make([]byte, fieldparams.BLSSignatureLength)
+		require.NoError(t, st.AppendPendingDeposit(d))
+	}
+	for _, idx := range []primitives.ValidatorIndex{1, 2, 5} {
+		val, err := st.ValidatorAtIndex(idx)
+		require.NoError(t, err)
+		val.ExitEpoch = 5
+		val.WithdrawableEpoch = map[primitives.ValidatorIndex]primitives.Epoch{1: 20, 2: 50, 5: 15}[idx]
+		val.Slashed = idx == 2
+		require.NoError(t, st.UpdateValidatorAtIndex(idx, val))
+	}
+	for _, c := range []*eth.PendingConsolidation{
+		{SourceIndex: 1, TargetIndex: 3},
+		{SourceIndex: 2, TargetIndex: 4},
+		{SourceIndex: 5, TargetIndex: 6},
+	} {
+		require.NoError(t, st.AppendPendingConsolidation(c))
+	}
+	s := pendingQueuesServer(t, st)
+	genesis := time.Unix(int64(st.GenesisTime()), 0)
+
+	get := func(t *testing.T, query string) *structs.QueueETA {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com?"+query, nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetQueueETA(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetQueueETAResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.ExecutionOptimistic)
+		assert.Equal(t, true, resp.Finalized)
+		return resp.Data
+	}
+
+	t.Run("new validator", func(t *testing.T) {
+		eta := get(t, "amount=1000000000&pubkey="+hexutil.Encode(pubkeyX))
+		require.Equal(t, 2, len(eta.Deposits))
+		// The deposit of A fills the churn of epoch 0, so the deposit of X is processed in epoch 1.
+		assert.Equal(t, "1", eta.Deposits[0].Position)
+		assert.Equal(t, false, eta.Deposits[0].New)
+		assert.Equal(t, "2", eta.Deposits[0].Epoch)
+		// The new deposit waits for its slot to be finalized.
+		assert.Equal(t, "2", eta.Deposits[1].Position)
+		assert.Equal(t, true, eta.Deposits[1].New)
+		assert.Equal(t, "4", eta.Deposits[1].Epoch)
+		assert.Equal(t, epochTime(genesis, 4), eta.Deposits[1].Time)
+		// Eligible in epoch 3, which is finalized in epoch 5.
+		require.NotNil(t, eta.Activation)
+		assert.Equal(t, "", eta.Activation.ValidatorIndex)
+		assert.Equal(t, "3", eta.Activation.EligibilityEpoch)
+		assert.Equal(t, "10", eta.Activation.ActivationEpoch)
+		assert.Equal(t, 0, len(eta.Consolidations))
+	})
+	t.Run("existing validator", func(t *testing.T) {
+		val, err := st.ValidatorAtIndexReadOnly(6)
+		require.NoError(t, err)
+		pk := val.PublicKey()
+		eta := get(t, "pubkey="+hexutil.Encode(pk[:]))
+		assert.Equal(t, 0, len(eta.Deposits))
+		require.NotNil(t, eta.Activation)
+		assert.Equal(t, "6", eta.Activation.ValidatorIndex)
+		assert.Equal(t, "0", eta.Activation.ActivationEpoch)
+		// The consolidation waits for the earlier one from validator 1, but not for the slashed validator 2.
+		require.Equal(t, 1, len(eta.Consolidations))
+		assert.Equal(t, "2", eta.Consolidations[0].Position)
+		assert.Equal(t, "5", eta.Consolidations[0].SourceIndex)
+		assert.Equal(t, "20", eta.Consolidations[0].Epoch)
+		assert.Equal(t, epochTime(genesis, 20), eta.Consolidations[0].Time)
+	})
+	t.Run("missing pubkey", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com", nil)
+		request.SetPathValue("state_id", "head")
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+
+		s.GetQueueETA(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
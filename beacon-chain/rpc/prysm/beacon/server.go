@@ -23,4 +23,7 @@ type Server struct {
 	CoreService           *core.Service
 	Broadcaster           p2p.Broadcaster
 	BlobReceiver          blockchain.BlobReceiver
+	ReplayQueueFetcher    stategen.ReplayQueueFetcher
+
+	blockTree blockTreeCache
 }
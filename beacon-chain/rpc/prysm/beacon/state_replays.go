@@ -0,0 +1,43 @@
+package beacon
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// GetStateReplays returns the state replays which are running and those waiting to run, with their priority,
+// the slot of the replayed state and since when they run or wait.
+func (s *Server) GetStateReplays(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "beacon.GetStateReplays")
+	defer span.End()
+
+	if s.ReplayQueueFetcher == nil {
+		httputil.HandleError(w, "State replays are not available", http.StatusServiceUnavailable)
+		return
+	}
+	q := s.ReplayQueueFetcher.ReplayQueue()
+	httputil.WriteJson(w, &structs.GetStateReplaysResponse{
+		Data: &structs.StateReplays{
+			Active: stateReplaysToStructs(q.Active),
+			Queued: stateReplaysToStructs(q.Queued),
+		},
+	})
+}
+
+func stateReplaysToStructs(replays []stategen.Replay) []*structs.StateReplay {
+	result := make([]*structs.StateReplay, len(replays))
+	for i, r := range replays {
+		result[i] = &structs.StateReplay{
+			Priority: r.Priority.String(),
+			Slot:     strconv.FormatUint(uint64(r.Slot), 10),
+			Since:    r.Since.UTC().Format(time.RFC3339Nano),
+		}
+	}
+	return result
+}
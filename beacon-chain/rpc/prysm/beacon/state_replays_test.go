@@ -0,0 +1,55 @@
+package beacon
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockReplayQueueFetcher struct {
+	queue *stategen.ReplayQueue
+}
+
+func (m *mockReplayQueueFetcher) ReplayQueue() *stategen.ReplayQueue {
+	return m.queue
+}
+
+func TestGetStateReplays(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/state_replays", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetStateReplays(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		since := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		s := &Server{ReplayQueueFetcher: &mockReplayQueueFetcher{queue: &stategen.ReplayQueue{
+			Active: []stategen.Replay{{Priority: stategen.ReplayPriorityConsensus, Slot: 10, Since: since}},
+			Queued: []stategen.Replay{{Priority: stategen.ReplayPriorityAPI, Slot: 20, Since: since}},
+		}}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/beacon/state_replays", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetStateReplays(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetStateReplaysResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data.Active))
+		assert.Equal(t, "consensus", resp.Data.Active[0].Priority)
+		assert.Equal(t, "10", resp.Data.Active[0].Slot)
+		assert.Equal(t, "2024-01-02T03:04:05Z", resp.Data.Active[0].Since)
+		require.Equal(t, 1, len(resp.Data.Queued))
+		assert.Equal(t, "api", resp.Data.Queued[0].Priority)
+		assert.Equal(t, "20", resp.Data.Queued[0].Slot)
+	})
+}
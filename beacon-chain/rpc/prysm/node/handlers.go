@@ -4,18 +4,28 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/diagnostics"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 // ListTrustedPeer retrieves data about the node's trusted peers.
@@ -129,6 +139,287 @@ func (s *Server) RemoveTrustedPeer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetFinalityStatus returns whether finality is stalled, along with the estimated inactivity leak impact on the
+// validators tracked by the node.
+func (s *Server) GetFinalityStatus(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetFinalityStatus")
+	defer span.End()
+
+	if s.FinalityStatusFetcher == nil {
+		httputil.HandleError(w, "Finality status is not available", http.StatusServiceUnavailable)
+		return
+	}
+	status := s.FinalityStatusFetcher.FinalityStatus()
+	if status == nil {
+		httputil.HandleError(w, "Finality status is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	vals := make([]*structs.ValidatorInactivityLeak, len(status.Validators))
+	for i, v := range status.Validators {
+		vals[i] = &structs.ValidatorInactivityLeak{
+			Index:            strconv.FormatUint(uint64(v.Index), 10),
+			EffectiveBalance: strconv.FormatUint(v.EffectiveBalance, 10),
+			InactivityScore:  strconv.FormatUint(v.InactivityScore, 10),
+			EstimatedPenalty: strconv.FormatUint(v.EstimatedPenalty, 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.FinalityStatusResponse{
+		Data: &structs.FinalityStatus{
+			Stalled:             status.Stalled,
+			InactivityLeak:      status.InactivityLeak,
+			CurrentEpoch:        strconv.FormatUint(uint64(status.CurrentEpoch), 10),
+			FinalizedEpoch:      strconv.FormatUint(uint64(status.FinalizedEpoch), 10),
+			EpochsSinceFinality: strconv.FormatUint(uint64(status.EpochsSinceFinality), 10),
+			StallEpochs:         strconv.FormatUint(uint64(status.StallEpochs), 10),
+			Validators:          vals,
+		},
+	})
+}
+
+// GetEngineCapabilities returns the engine methods supported by the execution client, and whether it supports the
+// methods used by the current and next forks.
+func (s *Server) GetEngineCapabilities(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetEngineCapabilities")
+	defer span.End()
+
+	if s.EngineCapabilitiesFetcher == nil {
+		httputil.HandleError(w, "Engine capabilities are not available", http.StatusServiceUnavailable)
+		return
+	}
+	c := s.EngineCapabilitiesFetcher.EngineCapabilities(slots.ToEpoch(s.GenesisTimeFetcher.CurrentSlot()))
+	resp := &structs.EngineCapabilities{
+		Methods:     c.Methods,
+		CurrentFork: engineForkReadiness(c.Current),
+		NextFork:    engineForkReadiness(c.Next),
+	}
+	if resp.Methods == nil {
+		resp.Methods = []string{}
+	}
+	if !c.ExchangedAt.IsZero() {
+		resp.ExchangedAt = strconv.FormatInt(c.ExchangedAt.Unix(), 10)
+	}
+	httputil.WriteJson(w, &structs.EngineCapabilitiesResponse{Data: resp})
+}
+
+func engineForkReadiness(r *execution.ForkReadiness) *structs.EngineForkReadiness {
+	if r == nil {
+		return nil
+	}
+	missing := r.MissingMethods
+	if missing == nil {
+		missing = []string{}
+	}
+	return &structs.EngineForkReadiness{
+		Fork:           version.String(r.Fork),
+		Epoch:          strconv.FormatUint(uint64(r.Epoch), 10),
+		Ready:          r.Ready,
+		MissingMethods: missing,
+	}
+}
+
+// GetForkReadiness returns the readiness of the node for the next scheduled fork.
+func (s *Server) GetForkReadiness(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetForkReadiness")
+	defer span.End()
+
+	if s.ForkReadinessFetcher == nil {
+		httputil.HandleError(w, "Fork readiness is not available", http.StatusServiceUnavailable)
+		return
+	}
+	report := s.ForkReadinessFetcher.ForkReadiness()
+	if report == nil {
+		httputil.HandleError(w, "Fork readiness is not available yet", http.StatusServiceUnavailable)
+		return
+	}
+	resp := &structs.ForkReadiness{
+		CurrentEpoch:           strconv.FormatUint(uint64(report.CurrentEpoch), 10),
+		CurrentForkVersion:     hexutil.Encode(report.CurrentForkVersion[:]),
+		CurrentForkDigest:      hexutil.Encode(report.CurrentForkDigest[:]),
+		ConfigDigest:           hexutil.Encode(report.ConfigDigest[:]),
+		Engine:                 engineForkReadiness(report.Engine),
+		ConnectedPeers:         strconv.Itoa(report.ConnectedPeers),
+		PeersWithForkId:        strconv.Itoa(report.PeersWithForkID),
+		CompatiblePeers:        strconv.Itoa(report.CompatiblePeers),
+		CompatiblePeersPercent: strconv.FormatFloat(report.CompatiblePeersPercent, 'f', 2, 64),
+		PeersOnNextFork:        strconv.Itoa(report.PeersOnNextFork),
+	}
+	if f := report.NextFork; f != nil {
+		resp.NextFork = &structs.NextFork{
+			Name:            f.Name,
+			Version:         hexutil.Encode(f.Version[:]),
+			Epoch:           strconv.FormatUint(uint64(f.Epoch), 10),
+			Digest:          hexutil.Encode(f.Digest[:]),
+			EpochsUntilFork: strconv.FormatUint(uint64(f.EpochsUntilFork), 10),
+		}
+	}
+	if b := report.Blobs; b != nil {
+		resp.BlobParameters = &structs.BlobParameters{
+			MaxBlobsPerBlock:                 strconv.FormatUint(b.MaxBlobsPerBlock, 10),
+			BlobSidecarSubnetCount:           strconv.FormatUint(b.BlobSidecarSubnetCount, 10),
+			MaxRequestBlobSidecars:           strconv.FormatUint(b.MaxRequestBlobSidecars, 10),
+			MinEpochsForBlobSidecarsRequests: strconv.FormatUint(uint64(b.MinEpochsForBlobSidecarsRequests), 10),
+		}
+	}
+	httputil.WriteJson(w, &structs.ForkReadinessResponse{Data: resp})
+}
+
+// GetPeerScores returns the latest gossipsub peer score snapshots, lowest score first.
+func (s *Server) GetPeerScores(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetPeerScores")
+	defer span.End()
+
+	if s.PeerScoresFetcher == nil {
+		httputil.HandleError(w, "Peer scores are not available", http.StatusServiceUnavailable)
+		return
+	}
+	snapshots := s.PeerScoresFetcher.PeerScores()
+	pids := make([]peer.ID, 0, len(snapshots))
+	for pid := range snapshots {
+		pids = append(pids, pid)
+	}
+	sort.Slice(pids, func(i, j int) bool {
+		if snapshots[pids[i]].Score != snapshots[pids[j]].Score {
+			return snapshots[pids[i]].Score < snapshots[pids[j]].Score
+		}
+		return pids[i] < pids[j]
+	})
+	data := make([]*structs.PeerScore, 0, len(pids))
+	for _, pid := range pids {
+		snap := snapshots[pid]
+		topics := make([]*structs.TopicScore, 0, len(snap.Topics))
+		for topic, ts := range snap.Topics {
+			topics = append(topics, &structs.TopicScore{
+				Topic:                    topic,
+				TimeInMeshMs:             strconv.FormatInt(ts.TimeInMesh.Milliseconds(), 10),
+				FirstMessageDeliveries:   formatScore(ts.FirstMessageDeliveries),
+				MeshMessageDeliveries:    formatScore(ts.MeshMessageDeliveries),
+				InvalidMessageDeliveries: formatScore(ts.InvalidMessageDeliveries),
+			})
+		}
+		sort.Slice(topics, func(i, j int) bool {
+			return topics[i].Topic < topics[j].Topic
+		})
+		data = append(data, &structs.PeerScore{
+			PeerId:             pid.String(),
+			Score:              formatScore(snap.Score),
+			AppSpecificScore:   formatScore(snap.AppSpecificScore),
+			IpColocationFactor: formatScore(snap.IPColocationFactor),
+			BehaviourPenalty:   formatScore(snap.BehaviourPenalty),
+			Topics:             topics,
+		})
+	}
+	httputil.WriteJson(w, &structs.PeerScoresResponse{Data: data})
+}
+
+func formatScore(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// GetReachability returns whether the node is reachable by peers, with its advertised and observed addresses
+// and the ports mapped on the NAT device of its network.
+func (s *Server) GetReachability(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetReachability")
+	defer span.End()
+
+	if s.ReachabilityFetcher == nil {
+		httputil.HandleError(w, "Reachability is not available", http.StatusServiceUnavailable)
+		return
+	}
+	reachability := s.ReachabilityFetcher.Reachability()
+	resp := &structs.Reachability{
+		Reachability:        strings.ToLower(reachability.Reachability.String()),
+		AutonatReachability: strings.ToLower(reachability.AutoNATReachability.String()),
+		AdvertisedAddresses: make([]string, 0, len(reachability.AdvertisedAddrs)),
+		ObservedAddresses:   make([]string, 0, len(reachability.ObservedAddrs)),
+		PortMappings:        make([]*structs.PortMapping, 0, len(reachability.PortMappings)),
+		InboundPeers:        strconv.Itoa(reachability.InboundPeers),
+	}
+	for _, a := range reachability.AdvertisedAddrs {
+		resp.AdvertisedAddresses = append(resp.AdvertisedAddresses, a.String())
+	}
+	for _, a := range reachability.ObservedAddrs {
+		resp.ObservedAddresses = append(resp.ObservedAddresses, a.String())
+	}
+	for _, m := range reachability.PortMappings {
+		resp.PortMappings = append(resp.PortMappings, &structs.PortMapping{
+			Protocol:        m.Protocol,
+			InternalPort:    strconv.FormatUint(uint64(m.InternalPort), 10),
+			ExternalAddress: m.External.String(),
+		})
+	}
+	httputil.WriteJson(w, &structs.ReachabilityResponse{Data: resp})
+}
+
+// GetSlotTimings returns the timings of the blocks of the last slots, most recent first, for diagnosing late heads:
+// when the block was first seen on gossip, when its blob sidecars were complete and when it became the head, measured
+// from the start of the slot, the durations of its newPayload and forkchoiceUpdated calls, and the margin left until the
+// attestation deadline when it became the head. Timings which were not recorded are empty.
+func (s *Server) GetSlotTimings(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "node.GetSlotTimings")
+	defer span.End()
+
+	if s.SlotTimingsCache == nil {
+		httputil.HandleError(w, "Slot timings are not available", http.StatusServiceUnavailable)
+		return
+	}
+	rawCount, count, ok := shared.UintFromQuery(w, r, "count", false)
+	if !ok {
+		return
+	}
+	if rawCount == "" || count > cache.SlotTimingsSize {
+		count = cache.SlotTimingsSize
+	}
+	timings := s.SlotTimingsCache.Last(int(count))
+	data := make([]*structs.SlotTiming, len(timings))
+	for i, t := range timings {
+		st := &structs.SlotTiming{
+			Slot:                strconv.FormatUint(uint64(t.Slot), 10),
+			BlockFirstSeenMs:    formatTimingMs(t.BlockFirstSeen),
+			BlobsCompleteMs:     formatTimingMs(t.BlobsComplete),
+			NewPayloadMs:        formatTimingMs(t.NewPayload),
+			ForkchoiceUpdatedMs: formatTimingMs(t.ForkchoiceUpdated),
+			HeadUpdatedMs:       formatTimingMs(t.HeadUpdated),
+		}
+		if t.BlockRoot != [32]byte{} {
+			st.BlockRoot = hexutil.Encode(t.BlockRoot[:])
+		}
+		if t.HeadUpdated != 0 {
+			st.AttestationCutoffMarginMs = strconv.FormatInt(t.AttestationCutoffMargin.Milliseconds(), 10)
+		}
+		data[i] = st
+	}
+	httputil.WriteJson(w, &structs.SlotTimingsResponse{Data: data})
+}
+
+func formatTimingMs(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10)
+}
+
+// WriteDiagnosticsBundle writes a diagnostics bundle on the disk of the node, gathering the goroutine dump, a heap
+// profile, the recent logs, the forkchoice store, the peers and the config of the node, and returns its path.
+func (s *Server) WriteDiagnosticsBundle(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "node.WriteDiagnosticsBundle")
+	defer span.End()
+
+	if s.DiagnosticsBundleWriter == nil {
+		httputil.HandleError(w, "Diagnostics bundles are not available", http.StatusServiceUnavailable)
+		return
+	}
+	path, err := s.DiagnosticsBundleWriter(ctx, "requested by the operator")
+	if errors.Is(err, diagnostics.ErrBundleInProgress) {
+		httputil.HandleError(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	if err != nil {
+		httputil.HandleError(w, "Could not write diagnostics bundle: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	httputil.WriteJson(w, &structs.DiagnosticsBundleResponse{Data: &structs.DiagnosticsBundle{Path: path}})
+}
+
 // httpPeerInfo does the same thing as peerInfo function in node.go but returns the
 // http peer response.
 func httpPeerInfo(peerStatus *peers.Status, id peer.ID) (*structs.Peer, error) {
@@ -2,23 +2,38 @@ package node
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/netip"
 	"strconv"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	corenet "github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
 	libp2ptest "github.com/libp2p/go-libp2p/p2p/host/peerstore/test"
 	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/finality"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkreadiness"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/runtime/diagnostics"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 )
@@ -249,3 +264,358 @@ func TestRemoveTrustedPeer_EmptyParameter(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, writer.Code)
 	assert.Equal(t, "Could not decode peer id: failed to parse peer ID: invalid cid: cid too short", e.Message)
 }
+
+type mockFinalityStatusFetcher struct {
+	status *finality.Status
+}
+
+func (m *mockFinalityStatusFetcher) FinalityStatus() *finality.Status {
+	return m.status
+}
+
+func TestGetFinalityStatus(t *testing.T) {
+	fetcher := &mockFinalityStatusFetcher{}
+	s := Server{FinalityStatusFetcher: fetcher}
+
+	t.Run("not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/finality_status", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetFinalityStatus(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("stalled", func(t *testing.T) {
+		fetcher.status = &finality.Status{
+			Stalled:             true,
+			InactivityLeak:      true,
+			CurrentEpoch:        20,
+			FinalizedEpoch:      10,
+			EpochsSinceFinality: 10,
+			StallEpochs:         4,
+			Validators: []finality.ValidatorPenalty{
+				{Index: 3, EffectiveBalance: 32000000000, InactivityScore: 40, EstimatedPenalty: 1500},
+			},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/finality_status", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetFinalityStatus(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.FinalityStatusResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, true, resp.Data.Stalled)
+		assert.Equal(t, true, resp.Data.InactivityLeak)
+		assert.Equal(t, "10", resp.Data.EpochsSinceFinality)
+		assert.Equal(t, "4", resp.Data.StallEpochs)
+		require.Equal(t, 1, len(resp.Data.Validators))
+		assert.Equal(t, "3", resp.Data.Validators[0].Index)
+		assert.Equal(t, "40", resp.Data.Validators[0].InactivityScore)
+		assert.Equal(t, "1500", resp.Data.Validators[0].EstimatedPenalty)
+	})
+}
+
+type mockEngineCapabilitiesFetcher struct {
+	capabilities *execution.EngineCapabilities
+}
+
+func (m *mockEngineCapabilitiesFetcher) EngineCapabilities(primitives.Epoch) *execution.EngineCapabilities {
+	return m.capabilities
+}
+
+func TestGetEngineCapabilities(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/engine_capabilities", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetEngineCapabilities(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("next fork not ready", func(t *testing.T) {
+		s := Server{
+			GenesisTimeFetcher: &mock.ChainService{Genesis: time.Now()},
+			EngineCapabilitiesFetcher: &mockEngineCapabilitiesFetcher{capabilities: &execution.EngineCapabilities{
+				Methods:     []string{execution.NewPayloadMethodV3},
+				ExchangedAt: time.Unix(1700000000, 0),
+				Current:     &execution.ForkReadiness{Fork: version.Deneb, Epoch: 10, Ready: true},
+				Next: &execution.ForkReadiness{
+					Fork:           version.Electra,
+					Epoch:          20,
+					MissingMethods: []string{execution.NewPayloadMethodV4},
+				},
+			}},
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/engine_capabilities", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetEngineCapabilities(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.EngineCapabilitiesResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, []string{execution.NewPayloadMethodV3}, resp.Data.Methods)
+		assert.Equal(t, "1700000000", resp.Data.ExchangedAt)
+		assert.Equal(t, "deneb", resp.Data.CurrentFork.Fork)
+		assert.Equal(t, true, resp.Data.CurrentFork.Ready)
+		assert.Equal(t, 0, len(resp.Data.CurrentFork.MissingMethods))
+		assert.Equal(t, "electra", resp.Data.NextFork.Fork)
+		assert.Equal(t, "20", resp.Data.NextFork.Epoch)
+		assert.Equal(t, false, resp.Data.NextFork.Ready)
+		assert.DeepEqual(t, []string{execution.NewPayloadMethodV4}, resp.Data.NextFork.MissingMethods)
+	})
+}
+
+type mockForkReadinessFetcher struct {
+	report *forkreadiness.Report
+}
+
+func (m *mockForkReadinessFetcher) ForkReadiness() *forkreadiness.Report {
+	return m.report
+}
+
+func TestGetForkReadiness(t *testing.T) {
+	fetcher := &mockForkReadinessFetcher{}
+	s := Server{ForkReadinessFetcher: fetcher}
+
+	t.Run("not available", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/fork_readiness", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetForkReadiness(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("next fork scheduled", func(t *testing.T) {
+		fetcher.report = &forkreadiness.Report{
+			CurrentEpoch:      5,
+			CurrentForkDigest: [4]byte{1, 2, 3, 4},
+			NextFork: &forkreadiness.NextFork{
+				Name:            "deneb",
+				Version:         [4]byte{4, 0, 0, 0},
+				Epoch:           10,
+				Digest:          [4]byte{5, 6, 7, 8},
+				EpochsUntilFork: 5,
+			},
+			Engine:                 &execution.ForkReadiness{Fork: version.Deneb, Epoch: 10, Ready: true},
+			Blobs:                  &forkreadiness.BlobParameters{MaxBlobsPerBlock: 6},
+			ConnectedPeers:         4,
+			PeersWithForkID:        3,
+			CompatiblePeers:        2,
+			CompatiblePeersPercent: float64(200) / 3,
+			PeersOnNextFork:        1,
+		}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/fork_readiness", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetForkReadiness(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ForkReadinessResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "0x01020304", resp.Data.CurrentForkDigest)
+		assert.Equal(t, "deneb", resp.Data.NextFork.Name)
+		assert.Equal(t, "0x05060708", resp.Data.NextFork.Digest)
+		assert.Equal(t, "5", resp.Data.NextFork.EpochsUntilFork)
+		assert.Equal(t, true, resp.Data.Engine.Ready)
+		assert.Equal(t, "6", resp.Data.BlobParameters.MaxBlobsPerBlock)
+		assert.Equal(t, "66.67", resp.Data.CompatiblePeersPercent)
+		assert.Equal(t, "1", resp.Data.PeersOnNextFork)
+	})
+}
+
+type mockPeerScoresFetcher struct {
+	scores map[peer.ID]*pubsub.PeerScoreSnapshot
+}
+
+func (m *mockPeerScoresFetcher) PeerScores() map[peer.ID]*pubsub.PeerScoreSnapshot {
+	return m.scores
+}
+
+func TestGetPeerScores(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("lowest score first", func(t *testing.T) {
+		ids := libp2ptest.GeneratePeerIDs(2)
+		s := Server{PeerScoresFetcher: &mockPeerScoresFetcher{scores: map[peer.ID]*pubsub.PeerScoreSnapshot{
+			ids[0]: {Score: 12.5},
+			ids[1]: {
+				Score:              -20,
+				IPColocationFactor: 1,
+				BehaviourPenalty:   2.5,
+				Topics: map[string]*pubsub.TopicScoreSnapshot{
+					"/eth2/b5303f2a/beacon_block/ssz_snappy": {
+						TimeInMesh:               2 * time.Second,
+						FirstMessageDeliveries:   3,
+						InvalidMessageDeliveries: 1,
+					},
+				},
+			},
+		}}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/peer_scores", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPeerScores(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.PeerScoresResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, ids[1].String(), resp.Data[0].PeerId)
+		assert.Equal(t, "-20", resp.Data[0].Score)
+		assert.Equal(t, "2.5", resp.Data[0].BehaviourPenalty)
+		require.Equal(t, 1, len(resp.Data[0].Topics))
+		assert.Equal(t, "2000", resp.Data[0].Topics[0].TimeInMeshMs)
+		assert.Equal(t, "3", resp.Data[0].Topics[0].FirstMessageDeliveries)
+		assert.Equal(t, "1", resp.Data[0].Topics[0].InvalidMessageDeliveries)
+		assert.Equal(t, ids[0].String(), resp.Data[1].PeerId)
+		assert.Equal(t, "12.5", resp.Data[1].Score)
+	})
+}
+
+type mockReachabilityFetcher struct {
+	reachability *p2p.Reachability
+}
+
+func (m *mockReachabilityFetcher) Reachability() *p2p.Reachability {
+	return m.reachability
+}
+
+func TestGetReachability(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/reachability", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetReachability(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		advertised, err := ma.NewMultiaddr("/ip4/192.168.0.2/tcp/13000")
+		require.NoError(t, err)
+		observed, err := ma.NewMultiaddr("/ip4/203.0.113.7/tcp/13000")
+		require.NoError(t, err)
+		s := Server{ReachabilityFetcher: &mockReachabilityFetcher{reachability: &p2p.Reachability{
+			Reachability:        corenet.ReachabilityPublic,
+			AutoNATReachability: corenet.ReachabilityUnknown,
+			AdvertisedAddrs:     []ma.Multiaddr{advertised},
+			ObservedAddrs:       []ma.Multiaddr{observed},
+			PortMappings: []p2p.PortMapping{
+				{Protocol: "udp", InternalPort: 12000, External: netip.MustParseAddrPort("203.0.113.7:12001")},
+			},
+			InboundPeers: 3,
+		}}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/reachability", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetReachability(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.ReachabilityResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "public", resp.Data.Reachability)
+		assert.Equal(t, "unknown", resp.Data.AutonatReachability)
+		assert.DeepEqual(t, []string{advertised.String()}, resp.Data.AdvertisedAddresses)
+		assert.DeepEqual(t, []string{observed.String()}, resp.Data.ObservedAddresses)
+		require.Equal(t, 1, len(resp.Data.PortMappings))
+		assert.Equal(t, "udp", resp.Data.PortMappings[0].Protocol)
+		assert.Equal(t, "12000", resp.Data.PortMappings[0].InternalPort)
+		assert.Equal(t, "203.0.113.7:12001", resp.Data.PortMappings[0].ExternalAddress)
+		assert.Equal(t, "3", resp.Data.InboundPeers)
+	})
+}
+
+func TestGetSlotTimings(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/slot_timings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSlotTimings(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		c := cache.NewSlotTimingsCache()
+		c.SetBlockFirstSeen(5, [32]byte{'a'}, 1200*time.Millisecond)
+		c.SetNewPayload(5, 250*time.Millisecond)
+		c.SetHeadUpdated(5, [32]byte{'a'}, 1600*time.Millisecond)
+		c.SetNewPayload(6, 100*time.Millisecond)
+		s := Server{SlotTimingsCache: c}
+
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/slot_timings", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSlotTimings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.SlotTimingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, "6", resp.Data[0].Slot)
+		assert.Equal(t, "", resp.Data[0].BlockRoot)
+		assert.Equal(t, "100", resp.Data[0].NewPayloadMs)
+		assert.Equal(t, "", resp.Data[0].AttestationCutoffMarginMs)
+		assert.DeepEqual(t, &structs.SlotTiming{
+			Slot:                      "5",
+			BlockRoot:                 hexutil.Encode(bytesutil.PadTo([]byte{'a'}, 32)),
+			BlockFirstSeenMs:          "1200",
+			NewPayloadMs:              "250",
+			HeadUpdatedMs:             "1600",
+			AttestationCutoffMarginMs: "2400",
+		}, resp.Data[1])
+
+		request = httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/node/slot_timings?count=1", nil)
+		writer = httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSlotTimings(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp = &structs.SlotTimingsResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "6", resp.Data[0].Slot)
+	})
+}
+
+func TestWriteDiagnosticsBundle(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := Server{}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/diagnostics", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.WriteDiagnosticsBundle(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		s := Server{DiagnosticsBundleWriter: func(_ context.Context, reason string) (string, error) {
+			return "/data/diagnostics/diagnostics-" + reason + ".tar.gz", nil
+		}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/diagnostics", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.WriteDiagnosticsBundle(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.DiagnosticsBundleResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.Equal(t, "/data/diagnostics/diagnostics-requested by the operator.tar.gz", resp.Data.Path)
+	})
+	t.Run("in progress", func(t *testing.T) {
+		s := Server{DiagnosticsBundleWriter: func(context.Context, string) (string, error) {
+			return "", diagnostics.ErrBundleInProgress
+		}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/diagnostics", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.WriteDiagnosticsBundle(writer, request)
+		assert.Equal(t, http.StatusTooManyRequests, writer.Code)
+	})
+	t.Run("failure", func(t *testing.T) {
+		s := Server{DiagnosticsBundleWriter: func(context.Context, string) (string, error) {
+			return "", errors.New("disk full")
+		}}
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/node/diagnostics", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.WriteDiagnosticsBundle(writer, request)
+		assert.Equal(t, http.StatusInternalServerError, writer.Code)
+		assert.StringContains(t, "disk full", writer.Body.String())
+	})
+}
@@ -1,9 +1,14 @@
 package node
 
 import (
+	"context"
+
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/finality"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/forkreadiness"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync"
 )
@@ -18,4 +23,11 @@ type Server struct {
 	GenesisTimeFetcher        blockchain.TimeFetcher
 	HeadFetcher               blockchain.HeadFetcher
 	ExecutionChainInfoFetcher execution.ChainInfoFetcher
+	FinalityStatusFetcher     finality.StatusFetcher
+	EngineCapabilitiesFetcher execution.EngineCapabilitiesFetcher
+	ForkReadinessFetcher      forkreadiness.ReportFetcher
+	PeerScoresFetcher         p2p.PeerScoresFetcher
+	ReachabilityFetcher       p2p.ReachabilityFetcher
+	DiagnosticsBundleWriter   func(ctx context.Context, reason string) (string, error)
+	SlotTimingsCache          *cache.SlotTimingsCache
 }
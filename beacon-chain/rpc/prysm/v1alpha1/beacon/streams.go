@@ -0,0 +1,484 @@
+package beacon
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	rpchelpers "github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamBeaconBlocks sends the blocks processed by the beacon node, blinded if requested.
+func (bs *Server) StreamBeaconBlocks(req *ethpb.StreamBeaconBlocksRequest, stream ethpb.BeaconChainStream_StreamBeaconBlocksServer) error {
+	ch := make(chan *feed.Event, 1)
+	sub := bs.StateNotifier.StateFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != statefeed.BlockProcessed {
+				continue
+			}
+			data, ok := ev.Data.(*statefeed.BlockProcessedData)
+			if !ok || data == nil || data.SignedBlock == nil {
+				continue
+			}
+			resp, err := streamBlockResponse(data.SignedBlock, req.Blinded)
+			if err != nil {
+				log.WithError(err).WithField("blockSlot", data.Slot).Error("Could not convert block to stream response")
+				continue
+			}
+			resp.BlockRoot = data.BlockRoot[:]
+			resp.Optimistic = data.Optimistic
+			if err := stream.Send(resp); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// StreamAttestations sends the attestations received by the beacon node, for the requested committees only.
+func (bs *Server) StreamAttestations(req *ethpb.StreamAttestationsRequest, stream ethpb.BeaconChainStream_StreamAttestationsServer) error {
+	committees := make(map[primitives.CommitteeIndex]bool, len(req.CommitteeIndices))
+	for _, idx := range req.CommitteeIndices {
+		committees[idx] = true
+	}
+
+	ch := make(chan *feed.Event, 1)
+	sub := bs.AttestationNotifier.OperationFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	for {
+		select {
+		case ev := <-ch:
+			var att ethpb.Att
+			aggregate := false
+			switch ev.Type {
+			case operation.UnaggregatedAttReceived:
+				if req.AggregatesOnly {
+					continue
+				}
+				data, ok := ev.Data.(*operation.UnAggregatedAttReceivedData)
+				if !ok || data == nil || data.Attestation == nil || data.Attestation.GetData() == nil {
+					continue
+				}
+				att = data.Attestation
+			case operation.AggregatedAttReceived:
+				data, ok := ev.Data.(*operation.AggregatedAttReceivedData)
+				if !ok || data == nil || data.Attestation == nil || data.Attestation.Aggregate.GetData() == nil {
+					continue
+				}
+				att = data.Attestation.Aggregate
+				aggregate = true
+			default:
+				continue
+			}
+			if len(committees) > 0 && !inCommittees(att, committees) {
+				continue
+			}
+			resp := &ethpb.StreamAttestationsResponse{Aggregate: aggregate}
+			switch a := att.(type) {
+			case *ethpb.Attestation:
+				resp.Attestation = &ethpb.StreamAttestationsResponse_Phase0Attestation{Phase0Attestation: a}
+			case *ethpb.AttestationElectra:
+				resp.Attestation = &ethpb.StreamAttestationsResponse_ElectraAttestation{ElectraAttestation: a}
+			default:
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// StreamBalanceChanges sends the balances of the requested validators at the time of the request, and then the
+// balances which changed every time the head of the chain changes.
+func (bs *Server) StreamBalanceChanges(req *ethpb.StreamBalanceChangesRequest, stream ethpb.BeaconChainStream_StreamBalanceChangesServer) error {
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		return status.Error(codes.InvalidArgument, "Must request at least one validator index or public key")
+	}
+
+	// Subscribe before reading the head state, so that no head change is missed.
+	ch := make(chan *feed.Event, 1)
+	sub := bs.StateNotifier.StateFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	headRoot, err := bs.HeadFetcher.HeadRoot(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	watched, err := newWatchedValidators(headState, req.Indices, req.PublicKeys)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	balances := make(map[primitives.ValidatorIndex]uint64, len(watched.indices))
+	resp, err := balanceChanges(stream, headState, headRoot, watched.indices, balances, true)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get balances: %v", err)
+	}
+	if err := stream.Send(resp); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != statefeed.NewHead {
+				continue
+			}
+			head, ok := ev.Data.(*ethpbv1.EventHead)
+			if !ok || head == nil {
+				continue
+			}
+			headState, err := bs.HeadFetcher.HeadStateReadOnly(stream.Context())
+			if err != nil {
+				log.WithError(err).Error("Could not get head state")
+				continue
+			}
+			watched.resolve(headState)
+			resp, err := balanceChanges(stream, headState, head.Block, watched.indices, balances, false)
+			if err != nil {
+				log.WithError(err).Error("Could not get balances")
+				continue
+			}
+			if len(resp.Changes) == 0 {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// StreamValidatorRegistryChanges sends the status, effective balance and withdrawal credentials of the requested
+// validators at the time of the request, and then those which changed at every epoch transition of the head of the
+// chain.
+func (bs *Server) StreamValidatorRegistryChanges(req *ethpb.StreamValidatorRegistryChangesRequest, stream ethpb.BeaconChainStream_StreamValidatorRegistryChangesServer) error {
+	if len(req.Indices) == 0 && len(req.PublicKeys) == 0 {
+		return status.Error(codes.InvalidArgument, "Must request at least one validator index or public key")
+	}
+
+	// Subscribe before reading the head state, so that no head change is missed.
+	ch := make(chan *feed.Event, 1)
+	sub := bs.StateNotifier.StateFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	headRoot, err := bs.HeadFetcher.HeadRoot(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head root: %v", err)
+	}
+	headState, err := bs.HeadFetcher.HeadStateReadOnly(stream.Context())
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	watched, err := newWatchedValidators(headState, req.Indices, req.PublicKeys)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	entries := make(map[primitives.ValidatorIndex]*registryEntry, len(watched.indices))
+	resp, err := registryChanges(headState, headRoot, watched.indices, entries, true)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Could not get validators: %v", err)
+	}
+	if err := stream.Send(resp); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+	epoch := resp.Epoch
+
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != statefeed.NewHead {
+				continue
+			}
+			head, ok := ev.Data.(*ethpbv1.EventHead)
+			if !ok || head == nil {
+				continue
+			}
+			// The registry only changes at epoch transitions, which the first head of an epoch went through. A
+			// reorg to an earlier epoch is compared too.
+			if slots.ToEpoch(head.Slot) == epoch {
+				continue
+			}
+			headState, err := bs.HeadFetcher.HeadStateReadOnly(stream.Context())
+			if err != nil {
+				log.WithError(err).Error("Could not get head state")
+				continue
+			}
+			watched.resolve(headState)
+			resp, err := registryChanges(headState, head.Block, watched.indices, entries, false)
+			if err != nil {
+				log.WithError(err).Error("Could not get validators")
+				continue
+			}
+			epoch = resp.Epoch
+			if len(resp.Changes) == 0 {
+				continue
+			}
+			if err := stream.Send(resp); err != nil {
+				return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+			}
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-bs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// watchedValidators are the validators requested by index or public key. The public keys which are not in the
+// registry yet, such as those of pending deposits, are resolved to indices once they appear in a head state.
+type watchedValidators struct {
+	indices []primitives.ValidatorIndex
+	seen    map[primitives.ValidatorIndex]bool
+	pending [][fieldparams.BLSPubkeyLength]byte
+}
+
+func newWatchedValidators(
+	st state.ReadOnlyBeaconState,
+	reqIndices []primitives.ValidatorIndex,
+	publicKeys [][]byte,
+) (*watchedValidators, error) {
+	w := &watchedValidators{
+		indices: make([]primitives.ValidatorIndex, 0, len(reqIndices)+len(publicKeys)),
+		seen:    make(map[primitives.ValidatorIndex]bool),
+	}
+	for _, idx := range reqIndices {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			return nil, fmt.Errorf("validator index %d >= validator count %d", idx, st.NumValidators())
+		}
+		w.add(idx)
+	}
+	for _, pubKey := range publicKeys {
+		if len(pubKey) != fieldparams.BLSPubkeyLength {
+			return nil, fmt.Errorf("public key %#x is not %d bytes long", pubKey, fieldparams.BLSPubkeyLength)
+		}
+		w.pending = append(w.pending, bytesutil.ToBytes48(pubKey))
+	}
+	w.resolve(st)
+	return w, nil
+}
+
+func (w *watchedValidators) add(idx primitives.ValidatorIndex) {
+	if !w.seen[idx] {
+		w.seen[idx] = true
+		w.indices = append(w.indices, idx)
+	}
+}
+
+// resolve watches the validators of the pending public keys which are in the registry of the state.
+func (w *watchedValidators) resolve(st state.ReadOnlyBeaconState) {
+	pending := w.pending[:0]
+	for _, pubKey := range w.pending {
+		idx, ok := st.ValidatorIndexByPubkey(pubKey)
+		if !ok {
+			pending = append(pending, pubKey)
+			continue
+		}
+		w.add(idx)
+	}
+	w.pending = pending
+}
+
+// balanceChanges returns the balances of the validators which differ from the previous balances, or all balances
+// when all is set, and updates the previous balances.
+func balanceChanges(
+	stream ethpb.BeaconChainStream_StreamBalanceChangesServer,
+	st state.ReadOnlyBeaconState,
+	headRoot []byte,
+	indices []primitives.ValidatorIndex,
+	previous map[primitives.ValidatorIndex]uint64,
+	all bool,
+) (*ethpb.StreamBalanceChangesResponse, error) {
+	resp := &ethpb.StreamBalanceChangesResponse{Slot: st.Slot(), BlockRoot: headRoot}
+	for _, idx := range indices {
+		if err := stream.Context().Err(); err != nil {
+			return nil, err
+		}
+		// A validator resolved from its public key may not be in the registry of a reorged state.
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			continue
+		}
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get balance of validator %d", idx)
+		}
+		// A validator which was not watched yet had no balance before.
+		prev, ok := previous[idx]
+		if !ok && all {
+			prev = balance
+		}
+		if !all && prev == balance {
+			continue
+		}
+		pubKey := st.PubkeyAtIndex(idx)
+		resp.Changes = append(resp.Changes, &ethpb.BalanceChange{
+			Index:           idx,
+			PublicKey:       pubKey[:],
+			PreviousBalance: prev,
+			Balance:         balance,
+		})
+		previous[idx] = balance
+	}
+	return resp, nil
+}
+
+// registryEntry is the part of the registry entry of a validator sent by StreamValidatorRegistryChanges.
+type registryEntry struct {
+	status                string
+	effectiveBalance      uint64
+	withdrawalCredentials []byte
+}
+
+func (e *registryEntry) equal(other *registryEntry) bool {
+	return e.status == other.status &&
+		e.effectiveBalance == other.effectiveBalance &&
+		bytes.Equal(e.withdrawalCredentials, other.withdrawalCredentials)
+}
+
+// registryChanges returns the registry entries of the validators which differ from the previous entries, or all
+// entries when all is set, and updates the previous entries.
+func registryChanges(
+	st state.ReadOnlyBeaconState,
+	headRoot []byte,
+	indices []primitives.ValidatorIndex,
+	previous map[primitives.ValidatorIndex]*registryEntry,
+	all bool,
+) (*ethpb.StreamValidatorRegistryChangesResponse, error) {
+	epoch := slots.ToEpoch(st.Slot())
+	resp := &ethpb.StreamValidatorRegistryChangesResponse{Epoch: epoch, BlockRoot: headRoot}
+	for _, idx := range indices {
+		if uint64(idx) >= uint64(st.NumValidators()) {
+			continue
+		}
+		val, err := st.ValidatorAtIndexReadOnly(idx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get validator %d", idx)
+		}
+		valStatus, err := rpchelpers.ValidatorSubStatus(val, epoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get status of validator %d", idx)
+		}
+		entry := &registryEntry{
+			status:                valStatus.String(),
+			effectiveBalance:      val.EffectiveBalance(),
+			withdrawalCredentials: val.GetWithdrawalCredentials(),
+		}
+		// A validator which was not watched yet had no registry entry before.
+		prev, ok := previous[idx]
+		if !ok {
+			prev = &registryEntry{}
+			if all {
+				prev = entry
+			}
+		}
+		if !all && prev.equal(entry) {
+			continue
+		}
+		pubKey := val.PublicKey()
+		resp.Changes = append(resp.Changes, &ethpb.ValidatorRegistryChange{
+			Index:                         idx,
+			PublicKey:                     pubKey[:],
+			PreviousStatus:                prev.status,
+			Status:                        entry.status,
+			PreviousEffectiveBalance:      prev.effectiveBalance,
+			EffectiveBalance:              entry.effectiveBalance,
+			PreviousWithdrawalCredentials: prev.withdrawalCredentials,
+			WithdrawalCredentials:         entry.withdrawalCredentials,
+		})
+		previous[idx] = entry
+	}
+	return resp, nil
+}
+
+// inCommittees returns whether the attestation is from one of the committees.
+func inCommittees(att ethpb.Att, committees map[primitives.CommitteeIndex]bool) bool {
+	for _, idx := range att.CommitteeBitsVal().BitIndices() {
+		if committees[primitives.CommitteeIndex(idx)] {
+			return true
+		}
+	}
+	return false
+}
+
+// streamBlockResponse wraps the block into a stream response, blinding blocks from bellatrix when blinded is set.
+func streamBlockResponse(blk interfaces.ReadOnlySignedBeaconBlock, blinded bool) (*ethpb.StreamBeaconBlocksResponse, error) {
+	if blinded && blk.Version() >= version.Bellatrix && !blk.IsBlinded() {
+		var err error
+		blk, err = blk.ToBlinded()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not blind block")
+		}
+	}
+	pb, err := blk.Proto()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get protobuf block")
+	}
+	resp := &ethpb.StreamBeaconBlocksResponse{}
+	switch b := pb.(type) {
+	case *ethpb.SignedBeaconBlock:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_Phase0Block{Phase0Block: b}
+	case *ethpb.SignedBeaconBlockAltair:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_AltairBlock{AltairBlock: b}
+	case *ethpb.SignedBeaconBlockBellatrix:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_BellatrixBlock{BellatrixBlock: b}
+	case *ethpb.SignedBlindedBeaconBlockBellatrix:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_BlindedBellatrixBlock{BlindedBellatrixBlock: b}
+	case *ethpb.SignedBeaconBlockCapella:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_CapellaBlock{CapellaBlock: b}
+	case *ethpb.SignedBlindedBeaconBlockCapella:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_BlindedCapellaBlock{BlindedCapellaBlock: b}
+	case *ethpb.SignedBeaconBlockDeneb:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_DenebBlock{DenebBlock: b}
+	case *ethpb.SignedBlindedBeaconBlockDeneb:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_BlindedDenebBlock{BlindedDenebBlock: b}
+	case *ethpb.SignedBeaconBlockElectra:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_ElectraBlock{ElectraBlock: b}
+	case *ethpb.SignedBlindedBeaconBlockElectra:
+		resp.Block = &ethpb.StreamBeaconBlocksResponse_BlindedElectraBlock{BlindedElectraBlock: b}
+	default:
+		return nil, fmt.Errorf("unsupported block type %T", pb)
+	}
+	return resp, nil
+}
@@ -0,0 +1,370 @@
+package beacon
+
+import (
+	"context"
+	"testing"
+
+	chainMock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/mock"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"go.uber.org/mock/gomock"
+)
+
+func TestServer_StreamBeaconBlocks_ContextCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamBeaconBlocksServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx)
+	go func(tt *testing.T) {
+		assert.ErrorContains(tt, "Context canceled", server.StreamBeaconBlocks(&ethpb.StreamBeaconBlocksRequest{}, mockStream))
+		<-exitRoutine
+	}(t)
+	cancel()
+	exitRoutine <- true
+}
+
+func TestServer_StreamBeaconBlocks_Blinded(t *testing.T) {
+	ctx := context.Background()
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		Ctx:           ctx,
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	b := util.NewBeaconBlockBellatrix()
+	b.Block.Slot = 1
+	wrappedBlk, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	blinded, err := wrappedBlk.ToBlinded()
+	require.NoError(t, err)
+	blindedPb, err := blinded.Proto()
+	require.NoError(t, err)
+	root := bytesutil.ToBytes32([]byte("root"))
+
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamBeaconBlocksServer(ctrl)
+	mockStream.EXPECT().Send(&ethpb.StreamBeaconBlocksResponse{
+		BlockRoot:  root[:],
+		Optimistic: true,
+		Block: &ethpb.StreamBeaconBlocksResponse_BlindedBellatrixBlock{
+			BlindedBellatrixBlock: blindedPb.(*ethpb.SignedBlindedBeaconBlockBellatrix),
+		},
+	}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamBeaconBlocks(&ethpb.StreamBeaconBlocksRequest{Blinded: true}, mockStream), "Could not call RPC method")
+	}(t)
+	// Send in a loop to ensure it is delivered (busy wait for the service to subscribe to the state feed).
+	for sent := 0; sent == 0; {
+		sent = server.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.BlockProcessed,
+			Data: &statefeed.BlockProcessedData{
+				Slot:        1,
+				BlockRoot:   root,
+				SignedBlock: wrappedBlk,
+				Optimistic:  true,
+			},
+		})
+	}
+	<-exitRoutine
+}
+
+func TestServer_StreamAttestations_FiltersCommittees(t *testing.T) {
+	ctx := context.Background()
+	chainService := &chainMock.ChainService{}
+	server := &Server{
+		Ctx:                 ctx,
+		AttestationNotifier: chainService.OperationNotifier(),
+	}
+
+	other := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{CommitteeIndex: 1}})
+	att := util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{CommitteeIndex: 2}})
+
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamAttestationsServer(ctrl)
+	mockStream.EXPECT().Send(&ethpb.StreamAttestationsResponse{
+		Aggregate:   true,
+		Attestation: &ethpb.StreamAttestationsResponse_Phase0Attestation{Phase0Attestation: att},
+	}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamAttestations(&ethpb.StreamAttestationsRequest{
+			CommitteeIndices: []primitives.CommitteeIndex{2},
+		}, mockStream), "Could not call RPC method")
+	}(t)
+	// The attestation of the other committee is filtered out, so the only expected response is the aggregate.
+	for sent := 0; sent == 0; {
+		sent = server.AttestationNotifier.OperationFeed().Send(&feed.Event{
+			Type: operation.UnaggregatedAttReceived,
+			Data: &operation.UnAggregatedAttReceivedData{Attestation: other},
+		})
+	}
+	server.AttestationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.AggregatedAttReceived,
+		Data: &operation.AggregatedAttReceivedData{Attestation: &ethpb.AggregateAttestationAndProof{Aggregate: att}},
+	})
+	<-exitRoutine
+}
+
+func TestServer_StreamBalanceChanges(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	validators := make([]*ethpb.Validator, 3)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{PublicKey: bytesutil.PadTo([]byte{byte(i + 1)}, 48)}
+	}
+	require.NoError(t, st.SetValidators(validators))
+	require.NoError(t, st.SetBalances([]uint64{10, 20, 30}))
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	chainService := &chainMock.ChainService{State: st, Root: headRoot}
+	server := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	first := make(chan bool)
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamBalanceChangesServer(ctrl)
+	mockStream.EXPECT().Send(&ethpb.StreamBalanceChangesResponse{
+		BlockRoot: headRoot,
+		Changes: []*ethpb.BalanceChange{
+			{Index: 0, PublicKey: validators[0].PublicKey, PreviousBalance: 10, Balance: 10},
+			{Index: 2, PublicKey: validators[2].PublicKey, PreviousBalance: 30, Balance: 30},
+		},
+	}).Do(func(arg0 interface{}) {
+		first <- true
+	})
+	newRoot := bytesutil.PadTo([]byte("new head"), 32)
+	mockStream.EXPECT().Send(&ethpb.StreamBalanceChangesResponse{
+		Slot:      1,
+		BlockRoot: newRoot,
+		Changes: []*ethpb.BalanceChange{
+			{Index: 2, PublicKey: validators[2].PublicKey, PreviousBalance: 30, Balance: 31},
+		},
+	}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamBalanceChanges(&ethpb.StreamBalanceChangesRequest{
+			Indices:    []primitives.ValidatorIndex{0},
+			PublicKeys: [][]byte{validators[2].PublicKey, validators[0].PublicKey},
+		}, mockStream), "Could not call RPC method")
+	}(t)
+	<-first
+
+	// The balance of validator 1 changes too, but it is not requested.
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, st.SetBalances([]uint64{10, 21, 31}))
+	server.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpbv1.EventHead{Slot: 1, Block: newRoot},
+	})
+	<-exitRoutine
+}
+
+func TestServer_StreamBalanceChanges_UnknownValidator(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{{PublicKey: bytesutil.PadTo([]byte{1}, 48)}}))
+	require.NoError(t, st.SetBalances([]uint64{10}))
+	chainService := &chainMock.ChainService{State: st, Root: make([]byte, 32)}
+	server := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamBalanceChangesServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	err = server.StreamBalanceChanges(&ethpb.StreamBalanceChangesRequest{}, mockStream)
+	assert.ErrorContains(t, "Must request at least one validator", err)
+	err = server.StreamBalanceChanges(&ethpb.StreamBalanceChangesRequest{Indices: []primitives.ValidatorIndex{1}}, mockStream)
+	assert.ErrorContains(t, "validator index 1 >= validator count 1", err)
+	err = server.StreamBalanceChanges(&ethpb.StreamBalanceChangesRequest{PublicKeys: [][]byte{{2}}}, mockStream)
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+}
+
+func TestServer_StreamBalanceChanges_PendingPublicKey(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetValidators([]*ethpb.Validator{{PublicKey: bytesutil.PadTo([]byte{1}, 48)}}))
+	require.NoError(t, st.SetBalances([]uint64{10}))
+	chainService := &chainMock.ChainService{State: st, Root: make([]byte, 32)}
+	server := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	first := make(chan bool)
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamBalanceChangesServer(ctrl)
+	pending := bytesutil.PadTo([]byte{2}, 48)
+	mockStream.EXPECT().Send(&ethpb.StreamBalanceChangesResponse{BlockRoot: make([]byte, 32)}).Do(func(arg0 interface{}) {
+		first <- true
+	})
+	newRoot := bytesutil.PadTo([]byte("new head"), 32)
+	// The validator of the public key is sent once it is in the registry.
+	mockStream.EXPECT().Send(&ethpb.StreamBalanceChangesResponse{
+		Slot:      1,
+		BlockRoot: newRoot,
+		Changes:   []*ethpb.BalanceChange{{Index: 1, PublicKey: pending, Balance: 32}},
+	}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamBalanceChanges(&ethpb.StreamBalanceChangesRequest{
+			PublicKeys: [][]byte{pending},
+		}, mockStream), "Could not call RPC method")
+	}(t)
+	<-first
+
+	require.NoError(t, st.SetSlot(1))
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{PublicKey: pending}))
+	require.NoError(t, st.AppendBalance(32))
+	server.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpbv1.EventHead{Slot: 1, Block: newRoot},
+	})
+	<-exitRoutine
+}
+
+func TestServer_StreamValidatorRegistryChanges(t *testing.T) {
+	ctx := context.Background()
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	validators := make([]*ethpb.Validator, 3)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:                  bytesutil.PadTo([]byte{byte(i + 1)}, 48),
+			WithdrawalCredentials:      make([]byte, 32),
+			EffectiveBalance:           params.BeaconConfig().MaxEffectiveBalance,
+			ActivationEligibilityEpoch: 0,
+			ActivationEpoch:            0,
+			ExitEpoch:                  farFuture,
+			WithdrawableEpoch:          farFuture,
+		}
+	}
+	require.NoError(t, st.SetValidators(validators))
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	chainService := &chainMock.ChainService{State: st, Root: headRoot}
+	server := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   chainService,
+		StateNotifier: chainService.StateNotifier(),
+	}
+
+	first := make(chan bool)
+	exitRoutine := make(chan bool)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconChainStream_StreamValidatorRegistryChangesServer(ctrl)
+	entry := func(i int) *ethpb.ValidatorRegistryChange {
+		return &ethpb.ValidatorRegistryChange{
+			Index:                         primitives.ValidatorIndex(i),
+			PublicKey:                     validators[i].PublicKey,
+			PreviousStatus:                "active_ongoing",
+			Status:                        "active_ongoing",
+			PreviousEffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			EffectiveBalance:              params.BeaconConfig().MaxEffectiveBalance,
+			PreviousWithdrawalCredentials: make([]byte, 32),
+			WithdrawalCredentials:         make([]byte, 32),
+		}
+	}
+	mockStream.EXPECT().Send(&ethpb.StreamValidatorRegistryChangesResponse{
+		BlockRoot: headRoot,
+		Changes:   []*ethpb.ValidatorRegistryChange{entry(0), entry(2)},
+	}).Do(func(arg0 interface{}) {
+		first <- true
+	})
+	newRoot := bytesutil.PadTo([]byte("new head"), 32)
+	credentials := bytesutil.PadTo([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, 32)
+	change := entry(2)
+	change.Status = "active_exiting"
+	change.EffectiveBalance = params.BeaconConfig().MaxEffectiveBalance - params.BeaconConfig().EffectiveBalanceIncrement
+	change.WithdrawalCredentials = credentials
+	mockStream.EXPECT().Send(&ethpb.StreamValidatorRegistryChangesResponse{
+		Epoch:     1,
+		BlockRoot: newRoot,
+		Changes:   []*ethpb.ValidatorRegistryChange{change},
+	}).Do(func(arg0 interface{}) {
+		exitRoutine <- true
+	})
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, server.StreamValidatorRegistryChanges(&ethpb.StreamValidatorRegistryChangesRequest{
+			Indices:    []primitives.ValidatorIndex{0},
+			PublicKeys: [][]byte{validators[2].PublicKey, validators[0].PublicKey},
+		}, mockStream), "Could not call RPC method")
+	}(t)
+	<-first
+
+	// A head in the same epoch is not compared.
+	server.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpbv1.EventHead{Slot: 1, Block: bytesutil.PadTo([]byte("same epoch"), 32)},
+	})
+
+	// Validator 1 changes too, but it is not requested.
+	slot := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, st.SetSlot(slot))
+	for _, i := range []primitives.ValidatorIndex{1, 2} {
+		val, err := st.ValidatorAtIndex(i)
+		require.NoError(t, err)
+		val.ExitEpoch = 5
+		val.WithdrawableEpoch = 10
+		val.EffectiveBalance = change.EffectiveBalance
+		val.WithdrawalCredentials = credentials
+		require.NoError(t, st.UpdateValidatorAtIndex(i, val))
+	}
+	server.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpbv1.EventHead{Slot: slot, Block: newRoot},
+	})
+	<-exitRoutine
+}
@@ -137,6 +137,11 @@ func (vs *Server) SubscribeCommitteeSubnets(ctx context.Context, req *ethpb.Comm
 }
 
 func (vs *Server) proposeAtt(ctx context.Context, att ethpb.Att, committee primitives.CommitteeIndex) (*ethpb.AttestResponse, error) {
+	if vs.ClockChecker != nil {
+		if err := vs.ClockChecker.CheckClock(); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Could not publish attestation: %v", err)
+		}
+	}
 	if _, err := bls.SignatureFromBytes(att.GetSignature()); err != nil {
 		return nil, status.Error(codes.InvalidArgument, "Incorrect attestation signature")
 	}
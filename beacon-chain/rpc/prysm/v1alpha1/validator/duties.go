@@ -1,18 +1,23 @@
 package validator
 
 import (
+	"bytes"
 	"context"
 
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	coreTime "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/time"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -25,6 +30,80 @@ func (vs *Server) GetDuties(ctx context.Context, req *ethpb.DutiesRequest) (*eth
 	return vs.duties(ctx, req)
 }
 
+// StreamDuties sends the duties of the current epoch assigned to a list of validators specified in the
+// request object, and sends them again whenever a new head changes the epoch or the dependent roots of
+// the duties, and with them the duties.
+func (vs *Server) StreamDuties(req *ethpb.DutiesRequest, stream ethpb.BeaconNodeValidator_StreamDutiesServer) error {
+	if vs.SyncChecker.Syncing() {
+		return status.Error(codes.Unavailable, "Syncing to latest head, not ready to respond")
+	}
+
+	// Subscribe before computing the first duties, so that no head change is missed.
+	ch := make(chan *feed.Event, 1)
+	sub := vs.StateNotifier.StateFeed().Subscribe(ch)
+	defer sub.Unsubscribe()
+
+	var last *ethpb.StreamDutiesResponse
+	send := func(epoch primitives.Epoch) error {
+		res, err := vs.duties(stream.Context(), &ethpb.DutiesRequest{Epoch: epoch, PublicKeys: req.PublicKeys})
+		if err != nil {
+			return err
+		}
+		resp := &ethpb.StreamDutiesResponse{Epoch: epoch, Duties: res}
+		if proto.Equal(resp, last) {
+			return nil
+		}
+		if err := stream.Send(resp); err != nil {
+			return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+		}
+		last = resp
+		return nil
+	}
+
+	epoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+	if err := send(epoch); err != nil {
+		return err
+	}
+	var previousDependentRoot, currentDependentRoot []byte
+	for {
+		select {
+		case ev := <-ch:
+			if ev.Type != statefeed.NewHead {
+				continue
+			}
+			head, ok := ev.Data.(*ethpbv1.EventHead)
+			if !ok || head == nil {
+				continue
+			}
+			currentEpoch := slots.ToEpoch(vs.TimeFetcher.CurrentSlot())
+			if currentEpoch == epoch &&
+				bytes.Equal(head.PreviousDutyDependentRoot, previousDependentRoot) &&
+				bytes.Equal(head.CurrentDutyDependentRoot, currentDependentRoot) {
+				continue
+			}
+			if vs.SyncChecker.Syncing() {
+				continue
+			}
+			if err := send(currentEpoch); err != nil {
+				if status.Code(err) == codes.Unavailable {
+					return err
+				}
+				log.WithError(err).WithField("epoch", currentEpoch).Error("Could not compute duties")
+				continue
+			}
+			epoch = currentEpoch
+			previousDependentRoot = head.PreviousDutyDependentRoot
+			currentDependentRoot = head.CurrentDutyDependentRoot
+		case <-sub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-vs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
 // Compute the validator duties from the head state's corresponding epoch
 // for validators public key / indices requested.
 func (vs *Server) duties(ctx context.Context, req *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
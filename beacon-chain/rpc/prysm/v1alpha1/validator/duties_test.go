@@ -11,6 +11,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
@@ -19,10 +21,13 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv1 "github.com/prysmaticlabs/prysm/v5/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/mock"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"go.uber.org/mock/gomock"
 )
 
 // pubKey is a helper to generate a well-formed public key.
@@ -463,6 +468,79 @@ func TestGetDuties_SyncNotReady(t *testing.T) {
 	assert.ErrorContains(t, "Syncing to latest head", err)
 }
 
+func TestStreamDuties_OnDependentRootChange(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	validators := make([]*ethpb.Validator, 64)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             pubKey(uint64(i)),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+			ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+			WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+	require.NoError(t, st.SetValidators(validators))
+	chain := &mockChain.ChainService{State: st, Root: make([]byte, 32), Genesis: time.Now()}
+	vs := &Server{
+		Ctx:           ctx,
+		HeadFetcher:   chain,
+		TimeFetcher:   chain,
+		SyncChecker:   &mockSync.Sync{IsSyncing: false},
+		StateNotifier: chain.StateNotifier(),
+	}
+
+	first := make(chan *ethpb.StreamDutiesResponse)
+	exitRoutine := make(chan *ethpb.StreamDutiesResponse)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconNodeValidator_StreamDutiesServer(ctrl)
+	gomock.InOrder(
+		mockStream.EXPECT().Send(gomock.Any()).Do(func(arg0 *ethpb.StreamDutiesResponse) {
+			first <- arg0
+		}),
+		mockStream.EXPECT().Send(gomock.Any()).Do(func(arg0 *ethpb.StreamDutiesResponse) {
+			exitRoutine <- arg0
+		}),
+	)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+
+	go func(tt *testing.T) {
+		assert.NoError(tt, vs.StreamDuties(&ethpb.DutiesRequest{PublicKeys: [][]byte{pubKey(0)}}, mockStream), "Could not call RPC method")
+	}(t)
+	res := <-first
+	assert.Equal(t, primitives.Epoch(0), res.Epoch)
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, res.Duties.CurrentEpochDuties[0].Status)
+
+	// A head with the same dependent roots does not change the duties.
+	vs.StateNotifier.StateFeed().Send(&feed.Event{Type: statefeed.NewHead, Data: &ethpbv1.EventHead{}})
+
+	validators[0].ExitEpoch = 10
+	require.NoError(t, st.SetValidators(validators))
+	vs.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &ethpbv1.EventHead{
+			PreviousDutyDependentRoot: bytesutil.PadTo([]byte("previous"), 32),
+			CurrentDutyDependentRoot:  bytesutil.PadTo([]byte("current"), 32),
+		},
+	})
+	res = <-exitRoutine
+	assert.Equal(t, ethpb.ValidatorStatus_EXITING, res.Duties.CurrentEpochDuties[0].Status)
+}
+
+func TestStreamDuties_SyncNotReady(t *testing.T) {
+	vs := &Server{
+		SyncChecker: &mockSync.Sync{IsSyncing: true},
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := mock.NewMockBeaconNodeValidator_StreamDutiesServer(ctrl)
+	err := vs.StreamDuties(&ethpb.DutiesRequest{}, mockStream)
+	assert.ErrorContains(t, "Syncing to latest head", err)
+}
+
 func BenchmarkCommitteeAssignment(b *testing.B) {
 
 	genesis := util.NewBeaconBlock()
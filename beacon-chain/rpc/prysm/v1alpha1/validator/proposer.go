@@ -21,6 +21,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -184,68 +185,21 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-
-		// Set eth1 data.
-		eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
-		if err != nil {
-			eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
-			log.WithError(err).Error("Could not get eth1data")
-		}
-		sBlk.SetEth1Data(eth1Data)
-
-		// Set deposit and attestation.
-		deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
-		if err != nil {
-			sBlk.SetDeposits([]*ethpb.Deposit{})
-			if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
-			log.WithError(err).Error("Could not pack deposits and attestations")
-		} else {
-			sBlk.SetDeposits(deposits)
-			if err := sBlk.SetAttestations(atts); err != nil {
-				log.WithError(err).Error("Could not set attestations on block")
-			}
-		}
-
-		// Set slashings.
-		validProposerSlashings, validAttSlashings := vs.getSlashings(ctx, head)
-		sBlk.SetProposerSlashings(validProposerSlashings)
-		if err := sBlk.SetAttesterSlashings(validAttSlashings); err != nil {
-			log.WithError(err).Error("Could not set attester slashings on block")
-		}
-
-		// Set exits.
-		sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
-
-		// Set sync aggregate. New in Altair.
-		vs.setSyncAggregate(ctx, sBlk)
-
-		// Set bls to execution change. New in Capella.
-		vs.setBlsToExecData(sBlk, head)
+		vs.setConsensusContents(ctx, sBlk, head)
 	}()
 
 	winningBid := primitives.ZeroWei()
 	var bundle *enginev1.BlobsBundle
 	if sBlk.Version() >= version.Bellatrix {
-		local, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
+		// The payload is fetched as late as allowed, for it to include more transactions, while the consensus
+		// contents are assembled.
+		if err := vs.waitForPayloadDeadline(ctx, sBlk.Block().Slot()); err != nil {
+			return nil, status.Errorf(codes.Canceled, "Could not wait for payload deadline: %v", err)
 		}
-
-		// There's no reason to try to get a builder bid if local override is true.
-		var builderBid builderapi.Bid
-		if !(local.OverrideBuilder || skipMevBoost) {
-			builderBid, err = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
-			if err != nil {
-				builderGetPayloadMissCount.Inc()
-				log.WithError(err).Error("Could not get builder payload")
-			}
-		}
-
-		winningBid, bundle, err = setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor)
+		var err error
+		winningBid, bundle, err = vs.setExecutionContents(ctx, sBlk, head, skipMevBoost, builderBoostFactor)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+			return nil, err
 		}
 	}
 
@@ -260,6 +214,80 @@ func (vs *Server) BuildBlockParallel(ctx context.Context, sBlk interfaces.Signed
 	return vs.constructGenericBeaconBlock(sBlk, bundle, winningBid)
 }
 
+// setConsensusContents sets the operations and the other consensus contents of the block.
+func (vs *Server) setConsensusContents(ctx context.Context, sBlk interfaces.SignedBeaconBlock, head state.BeaconState) {
+	// Set eth1 data.
+	eth1Data, err := vs.eth1DataMajorityVote(ctx, head)
+	if err != nil {
+		eth1Data = &ethpb.Eth1Data{DepositRoot: params.BeaconConfig().ZeroHash[:], BlockHash: params.BeaconConfig().ZeroHash[:]}
+		log.WithError(err).Error("Could not get eth1data")
+	}
+	sBlk.SetEth1Data(eth1Data)
+
+	// Set deposit and attestation.
+	deposits, atts, err := vs.packDepositsAndAttestations(ctx, head, sBlk.Block().Slot(), eth1Data) // TODO: split attestations and deposits
+	if err != nil {
+		sBlk.SetDeposits([]*ethpb.Deposit{})
+		if err := sBlk.SetAttestations([]ethpb.Att{}); err != nil {
+			log.WithError(err).Error("Could not set attestations on block")
+		}
+		log.WithError(err).Error("Could not pack deposits and attestations")
+	} else {
+		sBlk.SetDeposits(deposits)
+		if err := sBlk.SetAttestations(atts); err != nil {
+			log.WithError(err).Error("Could not set attestations on block")
+		}
+	}
+
+	// Set slashings.
+	validProposerSlashings, validAttSlashings := vs.getSlashings(ctx, head)
+	sBlk.SetProposerSlashings(validProposerSlashings)
+	if err := sBlk.SetAttesterSlashings(validAttSlashings); err != nil {
+		log.WithError(err).Error("Could not set attester slashings on block")
+	}
+
+	// Set exits.
+	sBlk.SetVoluntaryExits(vs.getExits(head, sBlk.Block().Slot()))
+
+	// Set sync aggregate. New in Altair.
+	vs.setSyncAggregate(ctx, sBlk)
+
+	// Set bls to execution change. New in Capella.
+	vs.setBlsToExecData(sBlk, head)
+}
+
+// setExecutionContents sets the most valuable of the local payload and the builder bid as the execution payload of the
+// block, and returns its value and its blobs bundle.
+func (vs *Server) setExecutionContents(
+	ctx context.Context,
+	sBlk interfaces.SignedBeaconBlock,
+	head state.BeaconState,
+	skipMevBoost bool,
+	builderBoostFactor primitives.Gwei,
+) (primitives.Wei, *enginev1.BlobsBundle, error) {
+	local, err := vs.getLocalPayload(ctx, sBlk.Block(), head)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not get local payload: %v", err)
+	}
+
+	// There's no reason to try to get a builder bid if local override is true.
+	var builderBid builderapi.Bid
+	if !(local.OverrideBuilder || skipMevBoost) {
+		builderBid, err = vs.getBuilderPayloadAndBlobs(ctx, sBlk.Block().Slot(), sBlk.Block().ProposerIndex())
+		if err != nil {
+			builderGetPayloadMissCount.Inc()
+			log.WithError(err).Error("Could not get builder payload")
+		}
+	}
+
+	winningBid, bundle, err := setExecutionData(ctx, sBlk, local, builderBid, builderBoostFactor)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "Could not set execution data: %v", err)
+	}
+	vs.recordPayloadValues(sBlk, local, builderBid)
+	return winningBid, bundle, nil
+}
+
 // ProposeBeaconBlock handles the proposal of beacon blocks.
 func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
 	ctx, span := trace.StartSpan(ctx, "ProposerServer.ProposeBeaconBlock")
@@ -310,6 +338,7 @@ func (vs *Server) ProposeBeaconBlock(ctx context.Context, req *ethpb.GenericSign
 	if err := <-errChan; err != nil {
 		return nil, status.Errorf(codes.Internal, "Could not broadcast/receive block: %v", err)
 	}
+	vs.watchProposedBlock(block, root, sidecars)
 
 	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
 }
@@ -422,6 +451,7 @@ func (vs *Server) PrepareBeaconProposer(
 			Active:       true, // TODO: either check or add the field in the request
 			Index:        r.ValidatorIndex,
 			FeeRecipient: feeRecipient,
+			Source:       cache.PrepareProposerSource,
 		}
 		vs.TrackedValidatorsCache.Set(val)
 		validatorIndices = append(validatorIndices, r.ValidatorIndex)
@@ -498,6 +528,14 @@ func (vs *Server) SubmitValidatorRegistrations(ctx context.Context, reg *ethpb.S
 	if err := vs.BlockBuilder.RegisterValidator(ctx, reg.Messages); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "Could not register block builder: %v", err)
 	}
+	if vs.TrackedValidatorsCache != nil {
+		st, err := vs.HeadFetcher.HeadStateReadOnly(ctx)
+		if err != nil {
+			log.WithError(err).Error("Could not get head state to track registered validators")
+		} else {
+			core.TrackRegisteredValidators(vs.TrackedValidatorsCache, st, reg.Messages)
+		}
+	}
 
 	return &emptypb.Empty{}, nil
 }
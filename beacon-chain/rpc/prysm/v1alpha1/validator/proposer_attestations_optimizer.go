@@ -0,0 +1,245 @@
+package validator
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/attestation"
+)
+
+var (
+	attestationPackingDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "attestation_packing_milliseconds",
+		Help:    "Time spent selecting the attestations of a proposed block.",
+		Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000},
+	})
+	attestationPackingVotes = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "attestation_packing_votes",
+		Help: "Number of distinct attester votes in the attestations of the last proposed block.",
+	})
+	attestationPackingVotesUpperBound = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "attestation_packing_votes_upper_bound",
+		Help: "Number of distinct attester votes in all the attestations available for the last proposed block, " +
+			"an upper bound of the votes of an optimal packing.",
+	})
+	attestationPackingImprovements = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "attestation_packing_improvements_total",
+		Help: "Number of attestation swaps which improved the greedy packing of proposed blocks.",
+	})
+)
+
+// packingCandidate is an attestation with the index of its attestation data, and the indices of its aggregation bits.
+type packingCandidate struct {
+	key  int
+	bits []int
+}
+
+// attPacking is a selection of attestations out of the candidates, with the number of selected attestations which
+// cover each vote, per attestation data.
+type attPacking struct {
+	candidates []packingCandidate
+	selected   []bool
+	coverage   [][]uint16
+	votes      int
+}
+
+func newAttPacking(atts proposerAtts) (*attPacking, error) {
+	p := &attPacking{
+		candidates: make([]packingCandidate, len(atts)),
+		selected:   make([]bool, len(atts)),
+	}
+	keys := make(map[attestation.Id]int)
+	for i, att := range atts {
+		id, err := attestation.NewId(att, attestation.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create attestation ID")
+		}
+		key, ok := keys[id]
+		if !ok {
+			key = len(p.coverage)
+			keys[id] = key
+			p.coverage = append(p.coverage, nil)
+		}
+		bits := att.GetAggregationBits()
+		if int(bits.Len()) > len(p.coverage[key]) {
+			grown := make([]uint16, bits.Len())
+			copy(grown, p.coverage[key])
+			p.coverage[key] = grown
+		}
+		p.candidates[i] = packingCandidate{key: key, bits: bits.BitIndices()}
+	}
+	return p, nil
+}
+
+func (p *attPacking) add(i int) {
+	p.selected[i] = true
+	c := p.candidates[i]
+	for _, b := range c.bits {
+		if p.coverage[c.key][b] == 0 {
+			p.votes++
+		}
+		p.coverage[c.key][b]++
+	}
+}
+
+func (p *attPacking) remove(i int) {
+	p.selected[i] = false
+	c := p.candidates[i]
+	for _, b := range c.bits {
+		p.coverage[c.key][b]--
+		if p.coverage[c.key][b] == 0 {
+			p.votes--
+		}
+	}
+}
+
+// loss is the number of votes only the selected attestation covers.
+func (p *attPacking) loss(i int) int {
+	c := p.candidates[i]
+	n := 0
+	for _, b := range c.bits {
+		if p.coverage[c.key][b] == 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// gain is the number of votes only the excluded attestation would cover.
+func (p *attPacking) gain(i int) int {
+	c := p.candidates[i]
+	n := 0
+	for _, b := range c.bits {
+		if p.coverage[c.key][b] == 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// swapGain is the number of votes gained by selecting the attestation in place of the selected attestation, given the
+// gain of the first and the loss of the second.
+func (p *attPacking) swapGain(in, out, inGain, outLoss int) int {
+	ci, co := p.candidates[in], p.candidates[out]
+	if ci.key != co.key {
+		return inGain - outLoss
+	}
+	// Votes the removed attestation covers alone are kept when the added attestation covers them too. Bit indices are
+	// sorted, so the common votes are found in a single pass.
+	kept := 0
+	for i, j := 0, 0; i < len(ci.bits) && j < len(co.bits); {
+		switch {
+		case ci.bits[i] < co.bits[j]:
+			i++
+		case ci.bits[i] > co.bits[j]:
+			j++
+		default:
+			if p.coverage[co.key][co.bits[j]] == 1 {
+				kept++
+			}
+			i++
+			j++
+		}
+	}
+	return inGain - outLoss + kept
+}
+
+// upperBound is the number of votes of all the candidates, which no selection can exceed.
+func (p *attPacking) upperBound() int {
+	covered := make([][]bool, len(p.coverage))
+	for key := range p.coverage {
+		covered[key] = make([]bool, len(p.coverage[key]))
+	}
+	n := 0
+	for _, c := range p.candidates {
+		for _, b := range c.bits {
+			if !covered[c.key][b] {
+				covered[c.key][b] = true
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// improve swaps selected attestations for the best excluded attestations for as long as a swap adds votes, and
+// returns the number of swaps. It stops at the deadline, keeping the best selection found so far.
+func (p *attPacking) improve(ctx context.Context, deadline time.Time) int {
+	swaps := 0
+	losses := make([]int, len(p.candidates))
+	for {
+		for out := range p.candidates {
+			if p.selected[out] {
+				losses[out] = p.loss(out)
+			}
+		}
+		bestIn, bestOut, bestGain := -1, -1, 0
+		for in := range p.candidates {
+			if p.selected[in] {
+				continue
+			}
+			if ctx.Err() != nil || time.Now().After(deadline) {
+				return swaps
+			}
+			inGain := p.gain(in)
+			if inGain == 0 {
+				continue
+			}
+			for out := range p.candidates {
+				if !p.selected[out] {
+					continue
+				}
+				if gain := p.swapGain(in, out, inGain, losses[out]); gain > bestGain {
+					bestIn, bestOut, bestGain = in, out, gain
+				}
+			}
+		}
+		if bestIn < 0 {
+			return swaps
+		}
+		p.remove(bestOut)
+		p.add(bestIn)
+		swaps++
+	}
+}
+
+// optimize selects the attestations to include in a block out of the sorted attestations. It starts from the greedy
+// selection of limitToMaxAttestations and, within the time budget, swaps attestations for excluded attestations which
+// add votes to the block. The selected attestations keep their sorted order.
+func (a proposerAtts) optimize(ctx context.Context, budget time.Duration) (proposerAtts, error) {
+	start := time.Now()
+	defer func() {
+		attestationPackingDuration.Observe(float64(time.Since(start).Milliseconds()))
+	}()
+
+	greedy := a.limitToMaxAttestations()
+	p, err := newAttPacking(a)
+	if err != nil {
+		return nil, err
+	}
+	for i := range greedy {
+		p.add(i)
+	}
+	attestationPackingVotesUpperBound.Set(float64(p.upperBound()))
+	if len(greedy) == len(a) || budget <= 0 {
+		attestationPackingVotes.Set(float64(p.votes))
+		return greedy, nil
+	}
+
+	swaps := p.improve(ctx, start.Add(budget))
+	attestationPackingVotes.Set(float64(p.votes))
+	if swaps == 0 {
+		return greedy, nil
+	}
+	attestationPackingImprovements.Add(float64(swaps))
+	packed := make(proposerAtts, 0, len(greedy))
+	for i, att := range a {
+		if p.selected[i] {
+			packed = append(packed, att)
+		}
+	}
+	return packed, nil
+}
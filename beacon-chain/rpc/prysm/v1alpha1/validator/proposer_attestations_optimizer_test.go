@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestProposer_ProposerAtts_optimize(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.MaxAttestations = 2
+	params.OverrideBeaconConfig(cfg)
+
+	att := func(slot primitives.Slot, bits bitfield.Bitlist) ethpb.Att {
+		return util.HydrateAttestation(&ethpb.Attestation{
+			Data: &ethpb.AttestationData{Slot: slot}, AggregationBits: bits})
+	}
+	// The greedy packing takes the two overlapping attestations of slot 1, with 5 votes, while swapping the second
+	// for the attestation of slot 2 packs 6 votes.
+	atts := proposerAtts{
+		att(1, bitfield.Bitlist{0b00001111, 0b1}),
+		att(1, bitfield.Bitlist{0b00011100, 0b1}),
+		att(2, bitfield.Bitlist{0b00000011, 0b1}),
+	}
+
+	t.Run("no budget", func(t *testing.T) {
+		packed, err := atts.optimize(context.Background(), 0)
+		require.NoError(t, err)
+		assert.DeepEqual(t, atts[:2], packed)
+	})
+	t.Run("improves greedy packing", func(t *testing.T) {
+		packed, err := atts.optimize(context.Background(), time.Second)
+		require.NoError(t, err)
+		assert.DeepEqual(t, proposerAtts{atts[0], atts[2]}, packed)
+	})
+	t.Run("under the limit", func(t *testing.T) {
+		packed, err := atts[:2].optimize(context.Background(), time.Second)
+		require.NoError(t, err)
+		assert.DeepEqual(t, atts[:2], packed)
+	})
+	t.Run("canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		packed, err := atts.optimize(ctx, time.Second)
+		require.NoError(t, err)
+		assert.DeepEqual(t, atts[:2], packed)
+	})
+}
+
+func TestAttPacking_votes(t *testing.T) {
+	atts := proposerAtts{
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b00010111}}),
+		util.HydrateAttestation(&ethpb.Attestation{AggregationBits: bitfield.Bitlist{0b00011011}}),
+		util.HydrateAttestation(&ethpb.Attestation{
+			Data: &ethpb.AttestationData{Slot: 1}, AggregationBits: bitfield.Bitlist{0b00010011}}),
+	}
+	p, err := newAttPacking(atts)
+	require.NoError(t, err)
+	assert.Equal(t, 6, p.upperBound())
+
+	p.add(0)
+	p.add(1)
+	assert.Equal(t, 4, p.votes)
+	assert.Equal(t, 1, p.loss(1))
+	assert.Equal(t, 2, p.gain(2))
+	assert.Equal(t, 1, p.swapGain(2, 1, p.gain(2), p.loss(1)))
+
+	p.remove(1)
+	assert.Equal(t, 3, p.votes)
+}
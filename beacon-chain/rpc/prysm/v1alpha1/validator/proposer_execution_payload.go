@@ -178,6 +178,11 @@ func (vs *Server) getLocalPayloadFromEngine(
 	default:
 		return nil, errors.New("unknown beacon state version")
 	}
+	if gasLimit, ok := vs.TrackedValidatorsCache.GasLimit(proposerId); ok {
+		if err := vs.ExecutionEngineCaller.SetGasLimitTarget(ctx, gasLimit); err != nil {
+			log.WithError(err).WithFields(logFields).Warn("Could not set the gas limit target of the execution client")
+		}
+	}
 	payloadID, _, err := vs.ExecutionEngineCaller.ForkchoiceUpdated(ctx, f, attr)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not prepare payload")
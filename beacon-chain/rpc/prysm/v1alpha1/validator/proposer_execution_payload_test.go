@@ -289,6 +289,51 @@ func TestServer_getExecutionPayload_UnexpectedFeeRecipient(t *testing.T) {
 	require.LogsContain(t, hook, "Fee recipient address from execution client is not what was expected")
 }
 
+func TestServer_getExecutionPayload_GasLimitTarget(t *testing.T) {
+	beaconDB := dbTest.SetupDB(t)
+	transitionSt, _ := util.DeterministicGenesisStateBellatrix(t, 1)
+	wrappedHeader, err := blocks.WrappedExecutionPayloadHeader(&pb.ExecutionPayloadHeader{BlockNumber: 1})
+	require.NoError(t, err)
+	require.NoError(t, transitionSt.SetLatestExecutionPayloadHeader(wrappedHeader))
+	b1pb := util.NewBeaconBlockBellatrix()
+	b1r, err := b1pb.Block.HashTreeRoot()
+	require.NoError(t, err)
+	util.SaveBlock(t, context.Background(), beaconDB, b1pb)
+	require.NoError(t, transitionSt.SetFinalizedCheckpoint(&ethpb.Checkpoint{
+		Root: b1r[:],
+	}))
+
+	ed, err := blocks.NewWrappedExecutionData(emptyPayload())
+	require.NoError(t, err)
+	engine := &powtesting.EngineClient{
+		PayloadIDBytes:     &pb.PayloadIDBytes{0x1},
+		GetPayloadResponse: &blocks.GetPayloadResponse{ExecutionData: ed},
+	}
+	vs := &Server{
+		ExecutionEngineCaller:  engine,
+		HeadFetcher:            &chainMock.ChainService{State: transitionSt},
+		FinalizationFetcher:    &chainMock.ChainService{},
+		BeaconDB:               beaconDB,
+		PayloadIDCache:         cache.NewPayloadIDCache(),
+		TrackedValidatorsCache: cache.NewTrackedValidatorsCache(),
+	}
+	vs.TrackedValidatorsCache.Set(cache.TrackedValidator{Active: true, Index: 0})
+
+	blk := util.NewBeaconBlockBellatrix()
+	blk.Block.Slot = transitionSt.Slot()
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte{}, 32)
+	b, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	_, err = vs.getLocalPayload(context.Background(), b.Block(), transitionSt)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(engine.GasLimitTargets))
+
+	vs.TrackedValidatorsCache.SetGasLimit(0, 36_000_000)
+	_, err = vs.getLocalPayload(context.Background(), b.Block(), transitionSt)
+	require.NoError(t, err)
+	require.DeepEqual(t, []uint64{36_000_000}, engine.GasLimitTargets)
+}
+
 func TestServer_getTerminalBlockHashIfExists(t *testing.T) {
 	params.SetupTestConfigCleanup(t)
 	tests := []struct {
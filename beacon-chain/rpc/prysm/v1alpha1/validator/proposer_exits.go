@@ -1,16 +1,50 @@
 package validator
 
 import (
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
+// getExits returns the pending exits ready for inclusion at the slot with the highest inclusion priority. Exits are
+// verified in order of priority until the block is full, and invalid ones are removed from the pool.
 func (vs *Server) getExits(head state.BeaconState, slot primitives.Slot) []*ethpb.SignedVoluntaryExit {
-	exits, err := vs.ExitPool.ExitsForInclusion(head, slot)
+	pending, err := vs.ExitPool.PendingExits()
 	if err != nil {
 		log.WithError(err).Error("Could not get exits")
 		return []*ethpb.SignedVoluntaryExit{}
 	}
+	ready := make([]*ethpb.SignedVoluntaryExit, 0, len(pending))
+	priorities := make([]float64, 0, len(pending))
+	for _, exit := range pending {
+		if exit.Exit.Epoch > slots.ToEpoch(slot) {
+			continue
+		}
+		ready = append(ready, exit)
+		priorities = append(priorities, vs.exitPriority(head, exit))
+	}
+	ready = prioritize(ready, priorities, uint64(len(ready)))
+
+	maxExits := params.BeaconConfig().MaxVoluntaryExits
+	exits := make([]*ethpb.SignedVoluntaryExit, 0, maxExits)
+	for _, exit := range ready {
+		if uint64(len(exits)) >= maxExits {
+			break
+		}
+		val, err := head.ValidatorAtIndexReadOnly(exit.Exit.ValidatorIndex)
+		if err != nil {
+			log.WithError(err).Warnf("Could not get validator at index %d", exit.Exit.ValidatorIndex)
+			continue
+		}
+		if err := blocks.VerifyExitAndSignature(val, head, exit); err != nil {
+			log.WithError(err).Warn("Removing invalid exit from pool")
+			vs.ExitPool.MarkIncluded(exit)
+			continue
+		}
+		exits = append(exits, exit)
+	}
 	return exits
 }
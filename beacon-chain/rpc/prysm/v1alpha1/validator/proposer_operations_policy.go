@@ -0,0 +1,143 @@
+package validator
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/slice"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Operations of a block prioritized by the inclusion policy.
+const (
+	ProposerSlashingOperation = "proposer_slashing"
+	AttesterSlashingOperation = "attester_slashing"
+	VoluntaryExitOperation    = "voluntary_exit"
+)
+
+// OperationWeight weighs the profitability and the age of an operation in its inclusion priority. The priority of an
+// operation is Profit times the proposer reward of the operation in ETH, plus Age times its age in epochs.
+type OperationWeight struct {
+	Profit float64
+	Age    float64
+}
+
+// DefaultOperationWeights returns the weights of the operations when the operator does not override them. A proposer
+// slashing reward of 1/512 of a 32 ETH effective balance weighs as much as 6.25 epochs of age.
+func DefaultOperationWeights() map[string]OperationWeight {
+	return map[string]OperationWeight{
+		ProposerSlashingOperation: {Profit: 1, Age: 0.01},
+		AttesterSlashingOperation: {Profit: 1, Age: 0.01},
+		VoluntaryExitOperation:    {Profit: 1, Age: 0.01},
+	}
+}
+
+// ParseOperationWeights parses operation weights given as <operation>=<profit weight>/<age weight>, such as
+// voluntary_exit=0/1, over the default weights.
+func ParseOperationWeights(values []string) (map[string]OperationWeight, error) {
+	weights := DefaultOperationWeights()
+	for _, v := range values {
+		op, w, ok := strings.Cut(v, "=")
+		if _, known := weights[op]; !ok || !known {
+			return nil, errors.Errorf("invalid operation weight %q, expected <operation>=<profit weight>/<age weight> "+
+				"with an operation of %s, %s or %s", v, ProposerSlashingOperation, AttesterSlashingOperation,
+				VoluntaryExitOperation)
+		}
+		profit, age, ok := strings.Cut(w, "/")
+		if !ok {
+			return nil, errors.Errorf("invalid operation weight %q, expected <operation>=<profit weight>/<age weight>", v)
+		}
+		p, err := strconv.ParseFloat(profit, 64)
+		if err != nil || p < 0 {
+			return nil, errors.Errorf("invalid profit weight in operation weight %q", v)
+		}
+		a, err := strconv.ParseFloat(age, 64)
+		if err != nil || a < 0 {
+			return nil, errors.Errorf("invalid age weight in operation weight %q", v)
+		}
+		weights[op] = OperationWeight{Profit: p, Age: a}
+	}
+	return weights, nil
+}
+
+func (vs *Server) operationWeight(op string) OperationWeight {
+	if w, ok := vs.OperationWeights[op]; ok {
+		return w
+	}
+	return DefaultOperationWeights()[op]
+}
+
+// priority is the inclusion priority of an operation with the proposer reward in Gwei and the age in epochs.
+func (w OperationWeight) priority(reward primitives.Gwei, age primitives.Epoch) float64 {
+	return w.Profit*float64(reward)/float64(params.BeaconConfig().GweiPerEth) + w.Age*float64(age)
+}
+
+// prioritize returns at most limit operations, in decreasing order of priority. Operations of equal priority keep
+// their pool order.
+func prioritize[T any](ops []T, priorities []float64, limit uint64) []T {
+	indices := make([]int, len(ops))
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		return priorities[indices[i]] > priorities[indices[j]]
+	})
+	if uint64(len(indices)) > limit {
+		indices = indices[:limit]
+	}
+	prioritized := make([]T, len(indices))
+	for i, idx := range indices {
+		prioritized[i] = ops[idx]
+	}
+	return prioritized
+}
+
+// age is the number of epochs from the epoch to the epoch of the state, zero for epochs after it.
+func age(st state.ReadOnlyBeaconState, epoch primitives.Epoch) primitives.Epoch {
+	current := slots.ToEpoch(st.Slot())
+	if epoch > current {
+		return 0
+	}
+	return current - epoch
+}
+
+// whistleblowerReward is the reward of the proposer for slashing the validator, zero when the validator is unknown or
+// already slashed.
+func whistleblowerReward(st state.ReadOnlyBeaconState, idx primitives.ValidatorIndex) primitives.Gwei {
+	val, err := st.ValidatorAtIndexReadOnly(idx)
+	if err != nil || val.Slashed() {
+		return 0
+	}
+	quotient := params.BeaconConfig().WhistleBlowerRewardQuotient
+	if st.Version() >= version.Electra {
+		quotient = params.BeaconConfig().WhistleBlowerRewardQuotientElectra
+	}
+	return primitives.Gwei(val.EffectiveBalance() / quotient)
+}
+
+func (vs *Server) proposerSlashingPriority(st state.ReadOnlyBeaconState, s *ethpb.ProposerSlashing) float64 {
+	header := s.Header_1.Header
+	reward := whistleblowerReward(st, header.ProposerIndex)
+	return vs.operationWeight(ProposerSlashingOperation).priority(reward, age(st, slots.ToEpoch(header.Slot)))
+}
+
+func (vs *Server) attesterSlashingPriority(st state.ReadOnlyBeaconState, s ethpb.AttSlashing) float64 {
+	var reward primitives.Gwei
+	slashed := slice.IntersectionUint64(s.FirstAttestation().GetAttestingIndices(), s.SecondAttestation().GetAttestingIndices())
+	for _, idx := range slashed {
+		reward += whistleblowerReward(st, primitives.ValidatorIndex(idx))
+	}
+	epoch := s.FirstAttestation().GetData().Target.Epoch
+	return vs.operationWeight(AttesterSlashingOperation).priority(reward, age(st, epoch))
+}
+
+func (vs *Server) exitPriority(st state.ReadOnlyBeaconState, e *ethpb.SignedVoluntaryExit) float64 {
+	return vs.operationWeight(VoluntaryExitOperation).priority(0, age(st, e.Exit.Epoch))
+}
@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/voluntaryexits"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestParseOperationWeights(t *testing.T) {
+	weights, err := ParseOperationWeights(nil)
+	require.NoError(t, err)
+	assert.DeepEqual(t, DefaultOperationWeights(), weights)
+
+	weights, err = ParseOperationWeights([]string{"voluntary_exit=0/1", "attester_slashing=2.5/0"})
+	require.NoError(t, err)
+	assert.Equal(t, OperationWeight{Profit: 0, Age: 1}, weights[VoluntaryExitOperation])
+	assert.Equal(t, OperationWeight{Profit: 2.5, Age: 0}, weights[AttesterSlashingOperation])
+	assert.Equal(t, DefaultOperationWeights()[ProposerSlashingOperation], weights[ProposerSlashingOperation])
+
+	for _, v := range []string{"voluntary_exit", "deposit=1/1", "voluntary_exit=1", "voluntary_exit=a/1", "voluntary_exit=1/-1"} {
+		_, err = ParseOperationWeights([]string{v})
+		assert.ErrorContains(t, "invalid", err, v)
+	}
+}
+
+func TestPrioritize(t *testing.T) {
+	ops := []string{"a", "b", "c", "d"}
+	assert.DeepEqual(t, []string{"c", "a", "b"}, prioritize(ops, []float64{1, 1, 2, 0}, 3))
+	assert.DeepEqual(t, []string{"c", "a", "b", "d"}, prioritize(ops, []float64{1, 1, 2, 0}, 10))
+	assert.DeepEqual(t, []string{}, prioritize([]string{}, nil, 10))
+}
+
+func TestServer_getExits_Priority(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.ShardCommitteePeriod = 0
+	config.MaxVoluntaryExits = 1
+	params.OverrideBeaconConfig(config)
+
+	beaconState, privKeys := util.DeterministicGenesisState(t, 64)
+	proposerServer := &Server{
+		ExitPool: voluntaryexits.NewPool(),
+	}
+
+	// The first exit of the pool is signed for a later epoch than the second.
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+	recent, err := util.GenerateVoluntaryExits(beaconState, privKeys[0], 0)
+	require.NoError(t, err)
+	proposerServer.ExitPool.InsertVoluntaryExit(recent)
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch*2))
+	old, err := util.GenerateVoluntaryExits(beaconState, privKeys[1], 1)
+	require.NoError(t, err)
+	proposerServer.ExitPool.InsertVoluntaryExit(old)
+	require.NoError(t, beaconState.SetSlot(params.BeaconConfig().SlotsPerEpoch*5))
+
+	// An invalid exit of lower priority than the exits filling the block is not verified, so it stays in the pool.
+	invalid, err := util.GenerateVoluntaryExits(beaconState, privKeys[3], 2)
+	require.NoError(t, err)
+	proposerServer.ExitPool.InsertVoluntaryExit(invalid)
+
+	slot := beaconState.Slot()
+	assert.DeepEqual(t, []*eth.SignedVoluntaryExit{old}, proposerServer.getExits(beaconState, slot))
+	pending, err := proposerServer.ExitPool.PendingExits()
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(pending))
+
+	// Without an age weight, exits are included in pool order.
+	proposerServer.OperationWeights = map[string]OperationWeight{VoluntaryExitOperation: {Profit: 1, Age: 0}}
+	assert.DeepEqual(t, []*eth.SignedVoluntaryExit{recent}, proposerServer.getExits(beaconState, slot))
+
+	// Exits for later epochs are not ready for inclusion.
+	assert.DeepEqual(t, []*eth.SignedVoluntaryExit{old}, proposerServer.getExits(beaconState, primitives.Slot(2)*params.BeaconConfig().SlotsPerEpoch))
+}
@@ -0,0 +1,58 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+var payloadDeadlineWait = promauto.NewHistogram(prometheus.HistogramOpts{
+	Name:    "proposal_payload_deadline_wait_seconds",
+	Help:    "Time waited for the payload deadline before fetching the execution payload and builder bid of a proposal.",
+	Buckets: []float64{0, 0.25, 0.5, 0.75, 1, 1.5, 2, 3},
+})
+
+// waitForPayloadDeadline waits until PayloadDeadline into the slot, for the execution client and the builders to
+// include more transactions in the payload of the proposal, while its consensus contents are assembled. It returns
+// immediately when PayloadDeadline is 0, or has passed, such as for proposals requested late in the slot.
+func (vs *Server) waitForPayloadDeadline(ctx context.Context, slot primitives.Slot) error {
+	if vs.PayloadDeadline == 0 {
+		return nil
+	}
+	deadline := slots.BeginsAt(slot, vs.TimeFetcher.GenesisTime()).Add(vs.PayloadDeadline)
+	wait := time.Until(deadline)
+	if wait <= 0 {
+		payloadDeadlineWait.Observe(0)
+		return nil
+	}
+	log.WithFields(logrus.Fields{
+		"slot": slot,
+		"wait": wait,
+	}).Debug("Waiting for the payload deadline")
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		payloadDeadlineWait.Observe(wait.Seconds())
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ValidatePayloadDeadline checks that the payload deadline leaves the proposal enough time to reach the attesters of
+// the slot, which attest a third into the slot.
+func ValidatePayloadDeadline(deadline time.Duration) error {
+	attestationDeadline := slots.DivideSlotBy(int64(params.BeaconConfig().IntervalsPerSlot))
+	if deadline < 0 || deadline >= attestationDeadline {
+		return fmt.Errorf("payload deadline %s must be between 0 and the attestation deadline %s", deadline, attestationDeadline)
+	}
+	return nil
+}
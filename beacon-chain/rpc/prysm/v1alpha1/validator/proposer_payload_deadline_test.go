@@ -0,0 +1,46 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestServer_WaitForPayloadDeadline(t *testing.T) {
+	ctx := context.Background()
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// Slot 1 starts now.
+	vs := &Server{TimeFetcher: &mock.ChainService{Genesis: time.Now().Add(-slotDuration)}}
+
+	start := time.Now()
+	require.NoError(t, vs.waitForPayloadDeadline(ctx, 1))
+	assert.Equal(t, true, time.Since(start) < 50*time.Millisecond, "waited without a deadline")
+
+	vs.PayloadDeadline = 200 * time.Millisecond
+	start = time.Now()
+	require.NoError(t, vs.waitForPayloadDeadline(ctx, 1))
+	assert.Equal(t, true, time.Since(start) >= 150*time.Millisecond, "did not wait for the deadline")
+
+	// The deadline of a past slot has passed.
+	start = time.Now()
+	require.NoError(t, vs.waitForPayloadDeadline(ctx, 0))
+	assert.Equal(t, true, time.Since(start) < 50*time.Millisecond, "waited for a passed deadline")
+
+	vs.PayloadDeadline = time.Minute
+	ctx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, vs.waitForPayloadDeadline(ctx, 1), context.DeadlineExceeded)
+}
+
+func TestValidatePayloadDeadline(t *testing.T) {
+	require.NoError(t, ValidatePayloadDeadline(0))
+	require.NoError(t, ValidatePayloadDeadline(time.Second))
+	require.ErrorContains(t, "must be between 0 and the attestation deadline", ValidatePayloadDeadline(-time.Second))
+	attestationDeadline := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / time.Duration(params.BeaconConfig().IntervalsPerSlot)
+	require.ErrorContains(t, "must be between 0 and the attestation deadline", ValidatePayloadDeadline(attestationDeadline))
+}
@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	builderapi "github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// recordPayloadValues records the values and gas limits of the local payload and of the builder bid offered for the
+// proposal of the block, along with which one was set in the block and the gas limit preferred by the proposer.
+func (vs *Server) recordPayloadValues(blk interfaces.ReadOnlySignedBeaconBlock, local *blocks.GetPayloadResponse, bid builderapi.Bid) {
+	if vs.PayloadValuesCache == nil || local == nil {
+		return
+	}
+	v := cache.PayloadValues{
+		Slot:          blk.Block().Slot(),
+		ProposerIndex: blk.Block().ProposerIndex(),
+		Chosen:        cache.LocalPayloadSource,
+		Local:         payloadAlternative(local.ExecutionData, len(local.BlobsBundle.GetKzgCommitments())),
+	}
+	v.Local.Value = local.Bid
+	if vs.TrackedValidatorsCache != nil {
+		v.PreferredGasLimit, _ = vs.TrackedValidatorsCache.GasLimit(v.ProposerIndex)
+	}
+	if bid != nil {
+		header, err := bid.Header()
+		if err != nil {
+			log.WithError(err).Debug("Could not get header of builder bid")
+		}
+		var commitments [][]byte
+		if bid.Version() >= version.Deneb {
+			commitments, err = bid.BlobKzgCommitments()
+			if err != nil {
+				log.WithError(err).Debug("Could not get blob commitments of builder bid")
+			}
+		}
+		v.Builder = payloadAlternative(header, len(commitments))
+		v.Builder.Value = bid.Value()
+	}
+	if blk.IsBlinded() {
+		v.Chosen = cache.BuilderPayloadSource
+	}
+	vs.PayloadValuesCache.Add(v)
+}
+
+// payloadAlternative returns the gas limit and the blob gas parameters of the execution payload, the latter being only
+// set from Deneb on.
+func payloadAlternative(payload interfaces.ExecutionData, blobCount int) *cache.PayloadAlternative {
+	a := &cache.PayloadAlternative{BlobCount: uint64(blobCount)}
+	if payload == nil || payload.IsNil() {
+		return a
+	}
+	a.GasLimit = payload.GasLimit()
+	used, err := payload.BlobGasUsed()
+	if err != nil {
+		return a
+	}
+	excess, err := payload.ExcessBlobGas()
+	if err != nil {
+		return a
+	}
+	a.BlobGasUsed = used
+	a.ExcessBlobGas = excess
+	a.BlobBaseFee = eip4844.CalcBlobFee(excess)
+	return a
+}
@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	builderapi "github.com/prysmaticlabs/prysm/v5/api/client/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_RecordPayloadValues(t *testing.T) {
+	payload, err := blocks.WrappedExecutionPayloadDeneb(&v1.ExecutionPayloadDeneb{GasLimit: 30_000_000, BlobGasUsed: 131072, ExcessBlobGas: 10_000_000})
+	require.NoError(t, err)
+	local := &blocks.GetPayloadResponse{
+		ExecutionData: payload,
+		BlobsBundle:   &v1.BlobsBundle{KzgCommitments: [][]byte{{1}}},
+		Bid:           big.NewInt(1e15),
+	}
+	bid, err := builderapi.WrappedBuilderBidDeneb(&ethpb.BuilderBidDeneb{
+		Header:             &v1.ExecutionPayloadHeaderDeneb{GasLimit: 30_029_295, BlobGasUsed: 262144, ExcessBlobGas: 10_000_000},
+		Value:              bytesutil.PadTo(bytesutil.ReverseByteOrder(big.NewInt(2e15).Bytes()), 32),
+		BlobKzgCommitments: [][]byte{make([]byte, fieldparams.BLSPubkeyLength), make([]byte, fieldparams.BLSPubkeyLength)},
+	})
+	require.NoError(t, err)
+	tracked := cache.NewTrackedValidatorsCache()
+	tracked.SetGasLimit(0, 36_000_000)
+	vs := &Server{PayloadValuesCache: cache.NewPayloadValuesCache(), TrackedValidatorsCache: tracked}
+
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+	require.NoError(t, err)
+	vs.recordPayloadValues(blk, local, nil)
+	blinded, err := blocks.NewSignedBeaconBlock(util.NewBlindedBeaconBlockDeneb())
+	require.NoError(t, err)
+	vs.recordPayloadValues(blinded, local, bid)
+
+	values := vs.PayloadValuesCache.Last(cache.PayloadValuesSize)
+	require.Equal(t, 2, len(values))
+	assert.Equal(t, cache.BuilderPayloadSource, values[0].Chosen)
+	assert.DeepEqual(t, &cache.PayloadAlternative{
+		Value:         bid.Value(),
+		GasLimit:      30_029_295,
+		BlobCount:     2,
+		BlobGasUsed:   262144,
+		ExcessBlobGas: 10_000_000,
+		BlobBaseFee:   eip4844.CalcBlobFee(10_000_000),
+	}, values[0].Builder)
+	assert.Equal(t, cache.LocalPayloadSource, values[1].Chosen)
+	assert.Equal(t, (*cache.PayloadAlternative)(nil), values[1].Builder)
+	assert.Equal(t, uint64(1), values[1].Local.BlobCount)
+	assert.Equal(t, uint64(131072), values[1].Local.BlobGasUsed)
+	assert.Equal(t, 0, big.NewInt(1e15).Cmp(values[1].Local.Value))
+	assert.Equal(t, uint64(30_000_000), values[1].Local.GasLimit)
+	assert.Equal(t, uint64(36_000_000), values[1].PreferredGasLimit)
+}
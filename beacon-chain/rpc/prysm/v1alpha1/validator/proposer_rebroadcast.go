@@ -0,0 +1,133 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// rebroadcastEventsBufferSize is the size of the buffer of the operation events observed while waiting to rebroadcast
+// a proposed block, so that gossip validation is not slowed down by their processing.
+const rebroadcastEventsBufferSize = 256
+
+// rebroadcastAfter is the time after the proposal of a block from which it can be rebroadcast, once the routers of the
+// node and of its peers forgot its first broadcast.
+var rebroadcastAfter = p2p.SeenMessagesTTL
+
+var blockRebroadcasts = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "proposed_block_rebroadcasts_total",
+	Help: "Number of proposed blocks rebroadcast because few attestations voting for them were observed on gossip.",
+})
+
+// watchProposedBlock rebroadcasts the proposed block and its blob sidecars when fewer than BlockRebroadcastMinAtts
+// attestations voting for it are observed on gossip until BlockRebroadcastDelay after its proposal, as its peers may
+// have missed it because of a transient failure of the gossip mesh. As the router drops the messages it has already
+// seen, the block is rebroadcast once it forgot the first broadcast, rebroadcastAfter the proposal.
+func (vs *Server) watchProposedBlock(block interfaces.ReadOnlySignedBeaconBlock, root [32]byte, sidecars []*ethpb.BlobSidecar) {
+	if vs.BlockRebroadcastDelay == 0 || vs.OperationNotifier == nil {
+		return
+	}
+	events := make(chan *feed.Event, rebroadcastEventsBufferSize)
+	sub := vs.OperationNotifier.OperationFeed().Subscribe(events)
+	go vs.rebroadcastIfUnattested(sub, events, block, root, sidecars, time.Now().Add(rebroadcastAfter))
+}
+
+func (vs *Server) rebroadcastIfUnattested(
+	sub event.Subscription,
+	events <-chan *feed.Event,
+	block interfaces.ReadOnlySignedBeaconBlock,
+	root [32]byte,
+	sidecars []*ethpb.BlobSidecar,
+	rebroadcastAt time.Time,
+) {
+	defer sub.Unsubscribe()
+	timer := time.NewTimer(vs.BlockRebroadcastDelay)
+	defer timer.Stop()
+
+	var observed uint64
+	for {
+		select {
+		case e := <-events:
+			if votesFor(e, root) {
+				observed++
+			}
+		case <-timer.C:
+			if observed >= vs.BlockRebroadcastMinAtts {
+				return
+			}
+			sub.Unsubscribe()
+			wait := time.NewTimer(time.Until(rebroadcastAt))
+			defer wait.Stop()
+			select {
+			case <-wait.C:
+				vs.rebroadcastBlock(block, root, sidecars, observed)
+			case <-vs.Ctx.Done():
+			}
+			return
+		case <-sub.Err():
+			return
+		case <-vs.Ctx.Done():
+			return
+		}
+	}
+}
+
+// rebroadcastBlock broadcasts the block and its blob sidecars again.
+func (vs *Server) rebroadcastBlock(block interfaces.ReadOnlySignedBeaconBlock, root [32]byte, sidecars []*ethpb.BlobSidecar, observed uint64) {
+	log.WithFields(logrus.Fields{
+		"slot":                 block.Block().Slot(),
+		"blockRoot":            fmt.Sprintf("%#x", root),
+		"observedAttestations": observed,
+		"blobSidecars":         len(sidecars),
+	}).Warn("Rebroadcasting proposed block observed in few attestations")
+	blockRebroadcasts.Inc()
+
+	oneSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx, cancel := context.WithTimeout(vs.Ctx, oneSlot)
+	defer cancel()
+	protoBlock, err := block.Proto()
+	if err != nil {
+		log.WithError(err).Error("Could not convert proposed block to protobuf")
+		return
+	}
+	if err := vs.P2P.Rebroadcast(ctx, protoBlock); err != nil {
+		log.WithError(err).Error("Could not rebroadcast proposed block")
+	}
+	for _, sc := range sidecars {
+		if err := vs.P2P.Rebroadcast(ctx, sc); err != nil {
+			log.WithError(err).WithField("index", sc.Index).Error("Could not rebroadcast blob sidecar")
+		}
+	}
+}
+
+// votesFor returns whether the event is the reception of an attestation voting for the block root.
+func votesFor(e *feed.Event, root [32]byte) bool {
+	var data *ethpb.AttestationData
+	switch e.Type {
+	case operation.UnaggregatedAttReceived:
+		d, ok := e.Data.(*operation.UnAggregatedAttReceivedData)
+		if !ok || d.Attestation == nil {
+			return false
+		}
+		data = d.Attestation.GetData()
+	case operation.AggregatedAttReceived:
+		d, ok := e.Data.(*operation.AggregatedAttReceivedData)
+		if !ok || d.Attestation == nil || d.Attestation.Aggregate == nil {
+			return false
+		}
+		data = d.Attestation.Aggregate.Data
+	}
+	return data != nil && bytes.Equal(data.BeaconBlockRoot, root[:])
+}
@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	p2pmock "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_WatchProposedBlock(t *testing.T) {
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+	require.NoError(t, err)
+	root := [32]byte{'a'}
+	sidecars := []*ethpb.BlobSidecar{{Index: 0}, {Index: 1}}
+	attestation := func(root [32]byte) *feed.Event {
+		return &feed.Event{
+			Type: operation.UnaggregatedAttReceived,
+			Data: &operation.UnAggregatedAttReceivedData{
+				Attestation: util.HydrateAttestation(&ethpb.Attestation{Data: &ethpb.AttestationData{BeaconBlockRoot: root[:]}}),
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		attestations []*feed.Event
+		rebroadcasts int
+	}{
+		{
+			name:         "no attestation",
+			rebroadcasts: 3,
+		},
+		{
+			name:         "attestations for another block",
+			attestations: []*feed.Event{attestation([32]byte{'b'}), attestation([32]byte{'c'})},
+			rebroadcasts: 3,
+		},
+		{
+			name:         "enough attestations",
+			attestations: []*feed.Event{attestation([32]byte{'b'}), attestation(root), attestation(root)},
+			rebroadcasts: 0,
+		},
+	}
+	rebroadcastAfter = 300 * time.Millisecond
+	t.Cleanup(func() {
+		rebroadcastAfter = p2p.SeenMessagesTTL
+	})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			broadcaster := &p2pmock.MockBroadcaster{}
+			notifier := (&mock.ChainService{}).OperationNotifier()
+			vs := &Server{
+				Ctx:                     context.Background(),
+				P2P:                     broadcaster,
+				OperationNotifier:       notifier,
+				BlockRebroadcastDelay:   100 * time.Millisecond,
+				BlockRebroadcastMinAtts: 2,
+			}
+			vs.watchProposedBlock(blk, root, sidecars)
+			for _, e := range tt.attestations {
+				notifier.OperationFeed().Send(e)
+			}
+			time.Sleep(200 * time.Millisecond)
+			// The block is not rebroadcast before the router forgot its first broadcast.
+			assert.Equal(t, 0, broadcaster.NumRebroadcasts())
+			time.Sleep(200 * time.Millisecond)
+			assert.Equal(t, tt.rebroadcasts, broadcaster.NumRebroadcasts())
+		})
+	}
+}
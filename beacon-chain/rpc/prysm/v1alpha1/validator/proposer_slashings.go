@@ -6,13 +6,26 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	v "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
+// getSlashings returns the valid pending slashings with the highest inclusion priority. Slashings are validated in
+// order of priority by processing them on the head state, so only the slashings of the block slash validators in it.
 func (vs *Server) getSlashings(ctx context.Context, head state.BeaconState) ([]*ethpb.ProposerSlashing, []ethpb.AttSlashing) {
-	proposerSlashings := vs.SlashingsPool.PendingProposerSlashings(ctx, head, false /*noLimit*/)
-	validProposerSlashings := make([]*ethpb.ProposerSlashing, 0, len(proposerSlashings))
+	proposerSlashings := vs.SlashingsPool.PendingProposerSlashings(ctx, head, true /*noLimit*/)
+	proposerPriorities := make([]float64, len(proposerSlashings))
+	for i, slashing := range proposerSlashings {
+		proposerPriorities[i] = vs.proposerSlashingPriority(head, slashing)
+	}
+	proposerSlashings = prioritize(proposerSlashings, proposerPriorities, uint64(len(proposerSlashings)))
+	maxProposerSlashings := params.BeaconConfig().MaxProposerSlashings
+	validProposerSlashings := make([]*ethpb.ProposerSlashing, 0, maxProposerSlashings)
 	for _, slashing := range proposerSlashings {
+		if uint64(len(validProposerSlashings)) >= maxProposerSlashings {
+			break
+		}
 		_, err := blocks.ProcessProposerSlashing(ctx, head, slashing, v.SlashValidator)
 		if err != nil {
 			log.WithError(err).Warn("Could not validate proposer slashing for block inclusion")
@@ -20,9 +33,22 @@ func (vs *Server) getSlashings(ctx context.Context, head state.BeaconState) ([]*
 		}
 		validProposerSlashings = append(validProposerSlashings, slashing)
 	}
-	attSlashings := vs.SlashingsPool.PendingAttesterSlashings(ctx, head, false /*noLimit*/)
-	validAttSlashings := make([]ethpb.AttSlashing, 0, len(attSlashings))
+
+	attSlashings := vs.SlashingsPool.PendingAttesterSlashings(ctx, head, true /*noLimit*/)
+	attPriorities := make([]float64, len(attSlashings))
+	for i, slashing := range attSlashings {
+		attPriorities[i] = vs.attesterSlashingPriority(head, slashing)
+	}
+	attSlashings = prioritize(attSlashings, attPriorities, uint64(len(attSlashings)))
+	maxAttSlashings := params.BeaconConfig().MaxAttesterSlashings
+	if head.Version() >= version.Electra {
+		maxAttSlashings = params.BeaconConfig().MaxAttesterSlashingsElectra
+	}
+	validAttSlashings := make([]ethpb.AttSlashing, 0, maxAttSlashings)
 	for _, slashing := range attSlashings {
+		if uint64(len(validAttSlashings)) >= maxAttSlashings {
+			break
+		}
 		_, err := blocks.ProcessAttesterSlashing(ctx, head, slashing, v.SlashValidator)
 		if err != nil {
 			log.WithError(err).Warn("Could not validate attester slashing for block inclusion")
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/clocksync"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	opfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -78,6 +79,17 @@ type Server struct {
 	BLSChangesPool         blstoexec.PoolManager
 	ClockWaiter            startup.ClockWaiter
 	CoreService            *core.Service
+	ClockChecker           clocksync.Checker
+	OperationWeights       map[string]OperationWeight
+	// BlockRebroadcastDelay is the time after their proposal at which proposed blocks observed in fewer than
+	// BlockRebroadcastMinAtts attestations are rebroadcast. Blocks are not rebroadcast when 0.
+	BlockRebroadcastDelay   time.Duration
+	BlockRebroadcastMinAtts uint64
+	// PayloadValuesCache records the payloads offered for the proposals. Nothing is recorded when nil.
+	PayloadValuesCache *cache.PayloadValuesCache
+	// PayloadDeadline is the time into the slot until which fetching the execution payload and the builder bid of
+	// proposals is delayed, while their consensus contents are assembled. They are fetched immediately when 0.
+	PayloadDeadline time.Duration
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
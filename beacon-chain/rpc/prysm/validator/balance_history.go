@@ -0,0 +1,211 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+const (
+	// maxBalanceHistorySamples is the maximum number of sampled epochs of a balance history request, each of which may
+	// need a state replay.
+	maxBalanceHistorySamples = 256
+	// maxBalanceHistoryValidators is the maximum number of validators of a balance history request.
+	maxBalanceHistoryValidators = 1024
+)
+
+// GetBalanceHistory returns the balances of the validators specified by an array of validator indices or public keys
+// in the request body, at the start of every interval epochs from the start epoch to the end epoch. The end epoch
+// defaults to, and is capped at, the epoch of the head. Sampling every 225 epochs gives daily balances on mainnet.
+// Requests are limited to maxBalanceHistoryValidators validators and maxBalanceHistorySamples sampled epochs, and the
+// states are replayed through the replay limiter of the node at the priority of the API.
+func (s *Server) GetBalanceHistory(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetBalanceHistory")
+	defer span.End()
+
+	_, start, ok := shared.UintFromQuery(w, r, "start_epoch", true)
+	if !ok {
+		return
+	}
+	rawEnd, end, ok := shared.UintFromQuery(w, r, "end_epoch", false)
+	if !ok {
+		return
+	}
+	rawInterval, interval, ok := shared.UintFromQuery(w, r, "interval", false)
+	if !ok {
+		return
+	}
+	if rawInterval == "" {
+		interval = 1
+	}
+	if interval == 0 {
+		httputil.HandleError(w, "Interval must be greater than 0", http.StatusBadRequest)
+		return
+	}
+	headEpoch := slots.ToEpoch(s.ChainInfoFetcher.HeadSlot())
+	if rawEnd == "" || end > uint64(headEpoch) {
+		end = uint64(headEpoch)
+	}
+	if start > end {
+		httputil.HandleError(w, fmt.Sprintf("Start epoch %d is after end epoch %d", start, end), http.StatusBadRequest)
+		return
+	}
+	samples := (end-start)/interval + 1
+	if samples > maxBalanceHistorySamples {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Requested %d sampled epochs, the maximum is %d, increase the interval", samples, maxBalanceHistorySamples),
+			http.StatusBadRequest,
+		)
+		return
+	}
+
+	var rawIds []string
+	if r.Body != http.NoBody {
+		if err := json.NewDecoder(r.Body).Decode(&rawIds); err != nil {
+			httputil.HandleError(w, "Could not decode validators: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	if len(rawIds) == 0 {
+		httputil.HandleError(w, "No validators requested", http.StatusBadRequest)
+		return
+	}
+	if len(rawIds) > maxBalanceHistoryValidators {
+		httputil.HandleError(
+			w,
+			fmt.Sprintf("Requested %d validators, the maximum is %d", len(rawIds), maxBalanceHistoryValidators),
+			http.StatusBadRequest,
+		)
+		return
+	}
+
+	epochs := make([]primitives.Epoch, samples)
+	for i := range epochs {
+		epochs[i] = primitives.Epoch(start + uint64(i)*interval)
+	}
+	// The validator indices of the public keys are looked up in the last sampled state, which has all the
+	// validators of the earlier states.
+	last := len(epochs) - 1
+	lastState, httpErr := s.balanceHistoryState(ctx, epochs[last])
+	if httpErr != nil {
+		httputil.WriteError(w, httpErr)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, len(rawIds))
+	for i, id := range rawIds {
+		if indices[i], httpErr = validatorIndex(lastState, id); httpErr != nil {
+			httputil.WriteError(w, httpErr)
+			return
+		}
+	}
+
+	data := make([]*structs.ValidatorBalanceHistory, len(indices))
+	for i, idx := range indices {
+		data[i] = &structs.ValidatorBalanceHistory{
+			Index:    strconv.FormatUint(uint64(idx), 10),
+			Balances: make([]string, len(epochs)),
+		}
+	}
+	rawEpochs := make([]string, len(epochs))
+	for j, epoch := range epochs {
+		rawEpochs[j] = strconv.FormatUint(uint64(epoch), 10)
+		st := lastState
+		if j != last {
+			if st, httpErr = s.balanceHistoryState(ctx, epoch); httpErr != nil {
+				httputil.WriteError(w, httpErr)
+				return
+			}
+		}
+		for i, idx := range indices {
+			var balance uint64
+			// Validators which are not in the registry yet have no balance.
+			if uint64(idx) < uint64(st.NumValidators()) {
+				b, err := st.BalanceAtIndex(idx)
+				if err != nil {
+					httputil.HandleError(w, "Could not get balance: "+err.Error(), http.StatusInternalServerError)
+					return
+				}
+				balance = b
+			}
+			data[i].Balances[j] = strconv.FormatUint(balance, 10)
+		}
+		if ctx.Err() != nil {
+			httputil.HandleError(w, "Request canceled: "+ctx.Err().Error(), http.StatusServiceUnavailable)
+			return
+		}
+	}
+
+	httputil.WriteJson(w, &structs.GetValidatorBalanceHistoryResponse{
+		Epochs:    rawEpochs,
+		Data:      data,
+		Finalized: epochs[last] <= s.ChainInfoFetcher.FinalizedCheckpt().Epoch,
+	})
+}
+
+// balanceHistoryState returns the state at the start slot of the epoch.
+func (s *Server) balanceHistoryState(ctx context.Context, epoch primitives.Epoch) (state.ReadOnlyBeaconState, *httputil.DefaultJsonError) {
+	slot, err := slots.EpochStart(epoch)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: "Could not get epoch's starting slot: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	st, err := s.Stater.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, &httputil.DefaultJsonError{
+			Message: fmt.Sprintf("Could not get state at slot %d: %v", slot, err),
+			Code:    http.StatusInternalServerError,
+		}
+	}
+	if st == nil || st.IsNil() {
+		return nil, &httputil.DefaultJsonError{
+			Message: fmt.Sprintf("No state found at slot %d", slot),
+			Code:    http.StatusNotFound,
+		}
+	}
+	return st, nil
+}
+
+// validatorIndex returns the index of the validator with the index or the public key in the state.
+func validatorIndex(st state.ReadOnlyBeaconState, id string) (primitives.ValidatorIndex, *httputil.DefaultJsonError) {
+	index, err := strconv.ParseUint(id, 10, 64)
+	if err == nil {
+		if index >= uint64(st.NumValidators()) {
+			return 0, &httputil.DefaultJsonError{
+				Message: fmt.Sprintf("Validator index %d is too large. Maximum allowed index is %d", index, st.NumValidators()-1),
+				Code:    http.StatusBadRequest,
+			}
+		}
+		return primitives.ValidatorIndex(index), nil
+	}
+	pubkey, err := hexutil.Decode(id)
+	if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+		return 0, &httputil.DefaultJsonError{
+			Message: fmt.Sprintf("%s is not a validator index or pubkey", id),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubkey))
+	if !ok {
+		return 0, &httputil.DefaultJsonError{
+			Message: fmt.Sprintf("No validator index found for pubkey %#x", pubkey),
+			Code:    http.StatusBadRequest,
+		}
+	}
+	return idx, nil
+}
@@ -0,0 +1,98 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestServer_GetBalanceHistory(t *testing.T) {
+	spe := params.BeaconConfig().SlotsPerEpoch
+	statesBySlot := make(map[primitives.Slot]state.BeaconState)
+	for epoch := primitives.Slot(0); epoch <= 10; epoch++ {
+		// The registry grows by a validator every epoch.
+		st, _ := util.DeterministicGenesisState(t, 2+uint64(epoch))
+		require.NoError(t, st.SetSlot(epoch*spe))
+		for i := 0; i < st.NumValidators(); i++ {
+			require.NoError(t, st.UpdateBalancesAtIndex(primitives.ValidatorIndex(i), uint64(epoch)*100+uint64(i)))
+		}
+		statesBySlot[epoch*spe] = st
+	}
+	head := statesBySlot[10*spe]
+	pubkey := head.PubkeyAtIndex(5)
+	s := &Server{
+		ChainInfoFetcher: &mock.ChainService{State: head, FinalizedCheckPoint: &ethpb.Checkpoint{Epoch: 6}},
+		Stater:           &testutil.MockStater{StatesBySlot: statesBySlot},
+	}
+
+	request := func(query string, ids []string) *httptest.ResponseRecorder {
+		body, err := json.Marshal(ids)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/balance_history?"+query, bytes.NewReader(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetBalanceHistory(writer, req)
+		return writer
+	}
+
+	t.Run("downsampled", func(t *testing.T) {
+		writer := request("start_epoch=2&end_epoch=6&interval=2", []string{"1", hexutil.Encode(pubkey[:])})
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorBalanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, []string{"2", "4", "6"}, resp.Epochs)
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &structs.ValidatorBalanceHistory{Index: "1", Balances: []string{"201", "401", "601"}}, resp.Data[0])
+		// Validator 5 joins the registry at epoch 4.
+		assert.DeepEqual(t, &structs.ValidatorBalanceHistory{Index: "5", Balances: []string{"0", "405", "605"}}, resp.Data[1])
+		assert.Equal(t, true, resp.Finalized)
+	})
+	t.Run("end epoch defaults to head", func(t *testing.T) {
+		writer := request("start_epoch=8", []string{"0"})
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetValidatorBalanceHistoryResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		assert.DeepEqual(t, []string{"8", "9", "10"}, resp.Epochs)
+		assert.DeepEqual(t, []string{"800", "900", "1000"}, resp.Data[0].Balances)
+		assert.Equal(t, false, resp.Finalized)
+	})
+	t.Run("zero interval", func(t *testing.T) {
+		writer := request("start_epoch=0&end_epoch=10&interval=0", []string{"0"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Interval must be greater than 0", writer.Body.String())
+	})
+	t.Run("start after end", func(t *testing.T) {
+		writer := request("start_epoch=7&end_epoch=6", []string{"0"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Start epoch 7 is after end epoch 6", writer.Body.String())
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		writer := request("start_epoch=0", []string{"12"})
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "Validator index 12 is too large", writer.Body.String())
+	})
+	t.Run("no validators", func(t *testing.T) {
+		writer := request("start_epoch=0", nil)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "No validators requested", writer.Body.String())
+	})
+	t.Run("too many validators", func(t *testing.T) {
+		writer := request("start_epoch=0", make([]string, maxBalanceHistoryValidators+1))
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+		assert.StringContains(t, "the maximum is 1024", writer.Body.String())
+	})
+}
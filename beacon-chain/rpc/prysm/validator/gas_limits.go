@@ -0,0 +1,51 @@
+package validator
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// SetGasLimits stores the gas limits the validators prefer for the payloads of their proposals, which the execution
+// client is asked to target when it builds the payloads locally, and removes the preferences of the validators with a
+// gas limit of 0. Builder registrations carry the preferences of the validators registered with a builder, this
+// endpoint carries them for the validators which are not.
+func (s *Server) SetGasLimits(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.SetGasLimits")
+	defer span.End()
+
+	var req []structs.ValidatorGasLimit
+	err := json.NewDecoder(r.Body).Decode(&req)
+	switch {
+	case errors.Is(err, io.EOF):
+		httputil.HandleError(w, "No data submitted", http.StatusBadRequest)
+		return
+	case err != nil:
+		httputil.HandleError(w, "Could not decode request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	indices := make([]primitives.ValidatorIndex, len(req))
+	gasLimits := make([]uint64, len(req))
+	for i, g := range req {
+		index, ok := shared.ValidateUint(w, "validator_index", g.ValidatorIndex)
+		if !ok {
+			return
+		}
+		gasLimit, ok := shared.ValidateUint(w, "gas_limit", g.GasLimit)
+		if !ok {
+			return
+		}
+		indices[i] = primitives.ValidatorIndex(index)
+		gasLimits[i] = gasLimit
+	}
+	for i, index := range indices {
+		s.TrackedValidatorsCache.SetGasLimit(index, gasLimits[i])
+	}
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestServer_SetGasLimits(t *testing.T) {
+	s := &Server{TrackedValidatorsCache: cache.NewTrackedValidatorsCache()}
+	setGasLimits := func(body string) int {
+		request := httptest.NewRequest(http.MethodPost, "http://example.com/prysm/v1/validators/gas_limits", bytes.NewBufferString(body))
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.SetGasLimits(writer, request)
+		return writer.Code
+	}
+
+	require.Equal(t, http.StatusOK, setGasLimits(`[{"validator_index":"1","gas_limit":"36000000"},{"validator_index":"2","gas_limit":"30000000"}]`))
+	gasLimit, ok := s.TrackedValidatorsCache.GasLimit(1)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(36_000_000), gasLimit)
+	gasLimit, ok = s.TrackedValidatorsCache.GasLimit(2)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(30_000_000), gasLimit)
+
+	// A gas limit of 0 removes the preference.
+	require.Equal(t, http.StatusOK, setGasLimits(`[{"validator_index":"2","gas_limit":"0"}]`))
+	_, ok = s.TrackedValidatorsCache.GasLimit(2)
+	require.Equal(t, false, ok)
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, setGasLimits(""))
+		assert.Equal(t, http.StatusBadRequest, setGasLimits(`[{"validator_index":"4","gas_limit":"36000000"},{"validator_index":"x","gas_limit":"1"}]`))
+		_, ok := s.TrackedValidatorsCache.GasLimit(4)
+		assert.Equal(t, false, ok)
+	})
+}
@@ -2,10 +2,12 @@ package validator
 
 import (
 	"fmt"
+	"math/big"
 	"net/http"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
@@ -114,3 +116,103 @@ func uint64SliceToStringSlice(indices []primitives.ValidatorIndex) []string {
 	}
 	return s
 }
+
+// GetTrackedProposers lists the validators the beacon node prepares payloads for, with the fee
+// recipient and the source of the entry which is used when they propose.
+func (s *Server) GetTrackedProposers(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetTrackedProposers")
+	defer span.End()
+
+	vals := s.TrackedValidatorsCache.Validators()
+	data := make([]*structs.TrackedProposer, len(vals))
+	for i, val := range vals {
+		data[i] = &structs.TrackedProposer{
+			ValidatorIndex: fmt.Sprintf("%d", val.Index),
+			FeeRecipient:   hexutil.Encode(val.FeeRecipient[:]),
+			Active:         val.Active,
+			Source:         val.Source.String(),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetTrackedProposersResponse{Data: data})
+}
+
+// GetSyncCommitteePerformance returns the share of the sync committee messages of the validators tracked by the
+// validator monitor which were included in a SyncAggregate, for the current and previous sync committee periods.
+func (s *Server) GetSyncCommitteePerformance(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetSyncCommitteePerformance")
+	defer span.End()
+
+	if s.SyncCommitteePerformanceFetcher == nil {
+		httputil.HandleError(w, "Validator monitor is not enabled", http.StatusServiceUnavailable)
+		return
+	}
+	perf := s.SyncCommitteePerformanceFetcher.SyncCommitteePerformance()
+	data := make([]*structs.SyncCommitteePerformance, len(perf))
+	for i, p := range perf {
+		data[i] = &structs.SyncCommitteePerformance{
+			ValidatorIndex: fmt.Sprintf("%d", p.ValidatorIndex),
+			Period:         fmt.Sprintf("%d", p.Period),
+			Expected:       fmt.Sprintf("%d", p.Expected),
+			Included:       fmt.Sprintf("%d", p.Included),
+			SuccessRate:    fmt.Sprintf("%.4f", p.SuccessRate()),
+			LastMissedSlot: fmt.Sprintf("%d", p.LastMissedSlot),
+		}
+	}
+	httputil.WriteJson(w, &structs.GetSyncCommitteePerformanceResponse{Data: data})
+}
+
+// GetPayloadValues returns the execution payloads offered for the last proposals of the node, most recent first: the
+// value, gas limit and blob gas parameters of the local payload returned by the execution client and of the builder
+// bid, which of the two was chosen, and the gas limit preferred by the proposer, for comparing builder and local
+// payloads over time.
+func (s *Server) GetPayloadValues(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.GetPayloadValues")
+	defer span.End()
+
+	if s.PayloadValuesCache == nil {
+		httputil.HandleError(w, "Payload values are not available", http.StatusServiceUnavailable)
+		return
+	}
+	rawCount, count, ok := shared.UintFromQuery(w, r, "count", false)
+	if !ok {
+		return
+	}
+	if rawCount == "" || count > cache.PayloadValuesSize {
+		count = cache.PayloadValuesSize
+	}
+	values := s.PayloadValuesCache.Last(int(count))
+	data := make([]*structs.ProposalPayloadValues, len(values))
+	for i, v := range values {
+		data[i] = &structs.ProposalPayloadValues{
+			Slot:          fmt.Sprintf("%d", v.Slot),
+			ProposerIndex: fmt.Sprintf("%d", v.ProposerIndex),
+			Chosen:        v.Chosen,
+			Local:         payloadAlternativeJson(v.Local),
+			Builder:       payloadAlternativeJson(v.Builder),
+		}
+		if v.PreferredGasLimit != 0 {
+			data[i].PreferredGasLimit = fmt.Sprintf("%d", v.PreferredGasLimit)
+		}
+	}
+	httputil.WriteJson(w, &structs.GetPayloadValuesResponse{Data: data})
+}
+
+func payloadAlternativeJson(a *cache.PayloadAlternative) *structs.PayloadAlternative {
+	if a == nil {
+		return nil
+	}
+	j := &structs.PayloadAlternative{
+		Value:         "0",
+		GasLimit:      fmt.Sprintf("%d", a.GasLimit),
+		BlobCount:     fmt.Sprintf("%d", a.BlobCount),
+		BlobGasUsed:   fmt.Sprintf("%d", a.BlobGasUsed),
+		ExcessBlobGas: fmt.Sprintf("%d", a.ExcessBlobGas),
+	}
+	if a.Value != nil {
+		j.Value = (*big.Int)(a.Value).String()
+	}
+	if a.BlobBaseFee != nil {
+		j.BlobBaseFee = a.BlobBaseFee.String()
+	}
+	return j
+}
@@ -16,11 +16,13 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/altair"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
 	dbTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
 	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/testutil"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -555,3 +557,147 @@ func pubKey(i uint64) []byte {
 	binary.LittleEndian.PutUint64(pubKey, i)
 	return pubKey
 }
+
+func TestServer_GetTrackedProposers(t *testing.T) {
+	c := cache.NewTrackedValidatorsCache()
+	c.Set(cache.TrackedValidator{Active: true, Index: 2, FeeRecipient: primitives.ExecutionAddress{2}, Source: cache.StaticConfigSource})
+	c.Set(cache.TrackedValidator{Active: true, Index: 2, FeeRecipient: primitives.ExecutionAddress{1}, Source: cache.PrepareProposerSource})
+	c.Set(cache.TrackedValidator{Active: true, Index: 1, FeeRecipient: primitives.ExecutionAddress{3}, Source: cache.BuilderRegistrationSource})
+	s := &Server{TrackedValidatorsCache: c}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/tracked_proposers", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetTrackedProposers(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+
+	resp := &structs.GetTrackedProposersResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	assert.DeepEqual(t, &structs.TrackedProposer{
+		ValidatorIndex: "1",
+		FeeRecipient:   hexutil.Encode(bytesutil.PadTo([]byte{3}, 20)),
+		Active:         true,
+		Source:         "builder_registration",
+	}, resp.Data[0])
+	assert.Equal(t, "prepare_beacon_proposer", resp.Data[1].Source)
+	assert.Equal(t, hexutil.Encode(bytesutil.PadTo([]byte{1}, 20)), resp.Data[1].FeeRecipient)
+}
+
+type mockSyncCommitteePerformanceFetcher struct {
+	perf []monitor.SyncCommitteePerformance
+}
+
+func (m *mockSyncCommitteePerformanceFetcher) SyncCommitteePerformance() []monitor.SyncCommitteePerformance {
+	return m.perf
+}
+
+func TestServer_GetSyncCommitteePerformance(t *testing.T) {
+	t.Run("monitor not enabled", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/sync_committee_performance", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSyncCommitteePerformance(writer, request)
+		require.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+	t.Run("ok", func(t *testing.T) {
+		s := &Server{SyncCommitteePerformanceFetcher: &mockSyncCommitteePerformanceFetcher{perf: []monitor.SyncCommitteePerformance{
+			{ValidatorIndex: 1, Period: 3, Expected: 8, Included: 7, LastMissedSlot: 100},
+			{ValidatorIndex: 2, Period: 4},
+		}}}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/sync_committee_performance", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetSyncCommitteePerformance(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+
+		resp := &structs.GetSyncCommitteePerformanceResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.Equal(t, 2, len(resp.Data))
+		assert.DeepEqual(t, &structs.SyncCommitteePerformance{
+			ValidatorIndex: "1",
+			Period:         "3",
+			Expected:       "8",
+			Included:       "7",
+			SuccessRate:    "0.8750",
+			LastMissedSlot: "100",
+		}, resp.Data[0])
+		assert.Equal(t, "0.0000", resp.Data[1].SuccessRate)
+	})
+}
+
+func TestServer_GetPayloadValues(t *testing.T) {
+	t.Run("not available", func(t *testing.T) {
+		s := &Server{}
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/payload_values", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetPayloadValues(writer, request)
+		assert.Equal(t, http.StatusServiceUnavailable, writer.Code)
+	})
+
+	c := cache.NewPayloadValuesCache()
+	c.Add(cache.PayloadValues{
+		Slot:          5,
+		ProposerIndex: 3,
+		Chosen:        cache.LocalPayloadSource,
+		Local:         &cache.PayloadAlternative{Value: primitives.Uint64ToWei(100)},
+	})
+	c.Add(cache.PayloadValues{
+		Slot:          9,
+		ProposerIndex: 4,
+		Chosen:        cache.BuilderPayloadSource,
+		Local:         &cache.PayloadAlternative{Value: primitives.Uint64ToWei(100), GasLimit: 30_000_000, BlobCount: 1},
+		Builder: &cache.PayloadAlternative{
+			Value:         primitives.Uint64ToWei(200),
+			GasLimit:      30_029_295,
+			BlobCount:     2,
+			BlobGasUsed:   262144,
+			ExcessBlobGas: 0,
+			BlobBaseFee:   primitives.Uint64ToWei(1),
+		},
+		PreferredGasLimit: 36_000_000,
+	})
+	s := &Server{PayloadValuesCache: c}
+
+	request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/payload_values?count=1", nil)
+	writer := httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetPayloadValues(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp := &structs.GetPayloadValuesResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 1, len(resp.Data))
+	assert.DeepEqual(t, &structs.ProposalPayloadValues{
+		Slot:          "9",
+		ProposerIndex: "4",
+		Chosen:        "builder",
+		Local: &structs.PayloadAlternative{
+			Value:         "100",
+			GasLimit:      "30000000",
+			BlobCount:     "1",
+			BlobGasUsed:   "0",
+			ExcessBlobGas: "0",
+		},
+		Builder: &structs.PayloadAlternative{
+			Value:         "200",
+			GasLimit:      "30029295",
+			BlobCount:     "2",
+			BlobGasUsed:   "262144",
+			ExcessBlobGas: "0",
+			BlobBaseFee:   "1",
+		},
+		PreferredGasLimit: "36000000",
+	}, resp.Data[0])
+
+	request = httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validators/payload_values", nil)
+	writer = httptest.NewRecorder()
+	writer.Body = &bytes.Buffer{}
+	s.GetPayloadValues(writer, request)
+	require.Equal(t, http.StatusOK, writer.Code)
+	resp = &structs.GetPayloadValuesResponse{}
+	require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, (*structs.PayloadAlternative)(nil), resp.Data[1].Builder)
+}
@@ -0,0 +1,200 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Names of the proposal preflight checks.
+const (
+	executionClientCheck     = "execution_client"
+	feeRecipientCheck        = "fee_recipient"
+	builderRegistrationCheck = "builder_registration"
+	blobPoolCheck            = "blob_pool"
+	forkchoiceUpdatedCheck   = "forkchoice_updated"
+)
+
+// forkchoiceUpdateMaxAge returns the age after which the last successful forkchoice update is considered stale.
+func forkchoiceUpdateMaxAge() time.Duration {
+	return time.Duration(uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second
+}
+
+// GetProposalPreflight verifies that the beacon node is ready for an upcoming proposal of the validator: the
+// execution client is connected and synced, the payload will pay the expected fee recipient, the validator is
+// registered with the builder unless builder_enabled is false, and forkchoice updates succeed. Whether blobs can be
+// fetched from the mempool of the execution client is informational, as engine_getBlobsV1 is optional, and doesn't
+// affect the readiness. Every failed check carries a message describing how to fix it.
+func (s *Server) GetProposalPreflight(w http.ResponseWriter, r *http.Request) {
+	ctx, span := trace.StartSpan(r.Context(), "validator.GetProposalPreflight")
+	defer span.End()
+
+	_, index, ok := shared.UintFromQuery(w, r, "proposer_index", true)
+	if !ok {
+		return
+	}
+	_, feeRecipient, ok := shared.HexFromQuery(w, r, "fee_recipient", fieldparams.FeeRecipientLength, false)
+	if !ok {
+		return
+	}
+
+	builderEnabled := true
+	if raw := r.URL.Query().Get("builder_enabled"); raw != "" {
+		var err error
+		builderEnabled, err = strconv.ParseBool(raw)
+		if err != nil {
+			httputil.HandleError(w, "builder_enabled is invalid: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	checks := []*structs.ProposalPreflightCheck{
+		s.checkExecutionClient(ctx),
+		s.checkFeeRecipient(primitives.ValidatorIndex(index), feeRecipient),
+		s.checkBuilderRegistration(ctx, primitives.ValidatorIndex(index), feeRecipient, builderEnabled),
+		s.checkBlobPool(),
+		s.checkForkchoiceUpdated(),
+	}
+	ready := true
+	for _, c := range checks {
+		ready = ready && (c.Ok || c.Informational)
+	}
+	httputil.WriteJson(w, &structs.GetProposalPreflightResponse{
+		Data: &structs.ProposalPreflight{Ready: ready, Checks: checks},
+	})
+}
+
+func (s *Server) checkExecutionClient(ctx context.Context) *structs.ProposalPreflightCheck {
+	c := &structs.ProposalPreflightCheck{Name: executionClientCheck}
+	if s.ExecutionChainInfoFetcher == nil || !s.ExecutionChainInfoFetcher.ExecutionClientConnected() {
+		c.Message = "Execution client is not connected, check the --execution-endpoint and JWT secret of the beacon node"
+		if s.ExecutionChainInfoFetcher != nil && s.ExecutionChainInfoFetcher.ExecutionClientConnectionErr() != nil {
+			c.Message += fmt.Sprintf(": %v", s.ExecutionChainInfoFetcher.ExecutionClientConnectionErr())
+		}
+		return c
+	}
+	if s.OptimisticModeFetcher != nil {
+		optimistic, err := s.OptimisticModeFetcher.IsOptimistic(ctx)
+		if err != nil {
+			c.Message = fmt.Sprintf("Could not check whether the head is optimistic: %v", err)
+			return c
+		}
+		if optimistic {
+			c.Message = "Head is optimistic, the execution client is still syncing and the proposal will fail until it is synced"
+			return c
+		}
+	}
+	c.Ok = true
+	return c
+}
+
+func (s *Server) checkFeeRecipient(index primitives.ValidatorIndex, expected []byte) *structs.ProposalPreflightCheck {
+	c := &structs.ProposalPreflightCheck{Name: feeRecipientCheck}
+	if s.TrackedValidatorsCache == nil {
+		c.Message = "Tracked validators are not available"
+		return c
+	}
+	val, ok := s.TrackedValidatorsCache.Validator(index)
+	if !ok {
+		c.Message = "Validator is not prepared for proposals, the payload will pay the default fee recipient of the " +
+			"beacon node. Check that the validator client calls prepare_beacon_proposer"
+		return c
+	}
+	if expected != nil && !bytes.Equal(val.FeeRecipient[:], expected) {
+		c.Message = fmt.Sprintf("Payload will pay fee recipient %s instead of %s, check the fee recipient configuration "+
+			"of the validator client and the proposer settings of the beacon node", hexutil.Encode(val.FeeRecipient[:]), hexutil.Encode(expected))
+		return c
+	}
+	if val.FeeRecipient == (primitives.ExecutionAddress{}) {
+		c.Message = "Payload will pay the zero address, set a fee recipient in the validator client"
+		return c
+	}
+	c.Ok = true
+	c.Message = fmt.Sprintf("Payload will pay fee recipient %s", hexutil.Encode(val.FeeRecipient[:]))
+	return c
+}
+
+func (s *Server) checkBuilderRegistration(ctx context.Context, index primitives.ValidatorIndex, expected []byte, enabled bool) *structs.ProposalPreflightCheck {
+	c := &structs.ProposalPreflightCheck{Name: builderRegistrationCheck, Ok: true}
+	if s.BlockBuilder == nil || !s.BlockBuilder.Configured() {
+		c.Message = "No builder is configured, the payload will be built locally"
+		return c
+	}
+	if !enabled {
+		c.Message = "Builder is disabled for the validator, the payload will be built locally"
+		return c
+	}
+	reg, err := s.BlockBuilder.RegistrationByValidatorID(ctx, index)
+	if err != nil {
+		c.Ok = false
+		c.Message = fmt.Sprintf("Validator is not registered with the builder, the payload will be built locally. "+
+			"Check that the validator client registers validators when the builder is enabled: %v", err)
+		return c
+	}
+	if expected != nil && !bytes.Equal(reg.FeeRecipient, expected) {
+		c.Ok = false
+		c.Message = fmt.Sprintf("Builder registration pays fee recipient %s instead of %s, the validator client must "+
+			"register again", hexutil.Encode(reg.FeeRecipient), hexutil.Encode(expected))
+	}
+	return c
+}
+
+func (s *Server) checkBlobPool() *structs.ProposalPreflightCheck {
+	c := &structs.ProposalPreflightCheck{Name: blobPoolCheck, Informational: true}
+	if s.EngineCapabilitiesFetcher == nil {
+		c.Message = "Capabilities of the execution client are not available"
+		return c
+	}
+	var current primitives.Epoch
+	if s.GenesisTimeFetcher != nil {
+		current = slots.ToEpoch(s.GenesisTimeFetcher.CurrentSlot())
+	}
+	caps := s.EngineCapabilitiesFetcher.EngineCapabilities(current)
+	if caps.ExchangedAt.IsZero() {
+		c.Message = "Capabilities were not exchanged with the execution client yet, blobs may not be retrievable from its mempool"
+		return c
+	}
+	if !slices.Contains(caps.Methods, execution.GetBlobsV1) {
+		c.Message = fmt.Sprintf("Execution client does not support %s, blobs of the payload can't be fetched from its "+
+			"mempool and are only retrieved over gossip", execution.GetBlobsV1)
+		return c
+	}
+	c.Ok = true
+	return c
+}
+
+func (s *Server) checkForkchoiceUpdated() *structs.ProposalPreflightCheck {
+	c := &structs.ProposalPreflightCheck{Name: forkchoiceUpdatedCheck}
+	if s.ForkchoiceUpdateStatusFetcher == nil {
+		c.Message = "Forkchoice update status is not available"
+		return c
+	}
+	status := s.ForkchoiceUpdateStatusFetcher.ForkchoiceUpdateStatus()
+	switch {
+	case status.LastSuccess.IsZero():
+		c.Message = "No forkchoice update succeeded yet, the execution client can't build payloads"
+	case status.LastFailure.After(status.LastSuccess):
+		c.Message = fmt.Sprintf("Last forkchoice update failed, check the logs of the execution client: %v", status.LastErr)
+	case time.Since(status.LastSuccess) > forkchoiceUpdateMaxAge():
+		c.Message = fmt.Sprintf("Last successful forkchoice update was %s ago, check that the beacon node follows the chain",
+			time.Since(status.LastSuccess).Truncate(time.Second))
+	default:
+		c.Ok = true
+	}
+	return c
+}
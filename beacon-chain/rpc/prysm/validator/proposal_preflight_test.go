@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	mockChain "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	builderTest "github.com/prysmaticlabs/prysm/v5/beacon-chain/builder/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	mockExecution "github.com/prysmaticlabs/prysm/v5/beacon-chain/execution/testing"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type mockEngineCapabilitiesFetcher struct {
+	caps *execution.EngineCapabilities
+}
+
+func (m *mockEngineCapabilitiesFetcher) EngineCapabilities(primitives.Epoch) *execution.EngineCapabilities {
+	return m.caps
+}
+
+type mockForkchoiceUpdateStatusFetcher struct {
+	status execution.ForkchoiceUpdateStatus
+}
+
+func (m *mockForkchoiceUpdateStatusFetcher) ForkchoiceUpdateStatus() execution.ForkchoiceUpdateStatus {
+	return m.status
+}
+
+func TestServer_GetProposalPreflight(t *testing.T) {
+	feeRecipient := primitives.ExecutionAddress{1}
+	tracked := cache.NewTrackedValidatorsCache()
+	tracked.Set(cache.TrackedValidator{Active: true, Index: 1, FeeRecipient: feeRecipient, Source: cache.PrepareProposerSource})
+	regs := cache.NewRegistrationCache()
+	regs.UpdateIndexToRegisteredMap(context.Background(), map[primitives.ValidatorIndex]*ethpb.ValidatorRegistrationV1{
+		1: {FeeRecipient: feeRecipient[:]},
+	})
+	newServer := func() *Server {
+		return &Server{
+			GenesisTimeFetcher:        &mockChain.ChainService{},
+			OptimisticModeFetcher:     &mockChain.ChainService{},
+			ExecutionChainInfoFetcher: &mockExecution.Chain{},
+			TrackedValidatorsCache:    tracked,
+			BlockBuilder:              &builderTest.MockBuilderService{HasConfigured: true, RegistrationCache: regs},
+			EngineCapabilitiesFetcher: &mockEngineCapabilitiesFetcher{caps: &execution.EngineCapabilities{
+				Methods:     []string{execution.GetBlobsV1},
+				ExchangedAt: time.Now(),
+			}},
+			ForkchoiceUpdateStatusFetcher: &mockForkchoiceUpdateStatusFetcher{status: execution.ForkchoiceUpdateStatus{
+				LastSuccess: time.Now(),
+			}},
+		}
+	}
+	preflight := func(t *testing.T, s *Server, query string) *structs.ProposalPreflight {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposal_preflight?"+query, nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		s.GetProposalPreflight(writer, request)
+		require.Equal(t, http.StatusOK, writer.Code)
+		resp := &structs.GetProposalPreflightResponse{}
+		require.NoError(t, json.Unmarshal(writer.Body.Bytes(), resp))
+		require.NotNil(t, resp.Data)
+		return resp.Data
+	}
+	failed := func(p *structs.ProposalPreflight) []string {
+		var names []string
+		for _, c := range p.Checks {
+			if !c.Ok {
+				names = append(names, c.Name)
+			}
+		}
+		return names
+	}
+
+	t.Run("ready", func(t *testing.T) {
+		p := preflight(t, newServer(), "proposer_index=1&fee_recipient="+hexutil.Encode(feeRecipient[:]))
+		assert.Equal(t, true, p.Ready)
+		assert.Equal(t, 5, len(p.Checks))
+	})
+	t.Run("not prepared", func(t *testing.T) {
+		p := preflight(t, newServer(), "proposer_index=2")
+		assert.Equal(t, false, p.Ready)
+		assert.DeepEqual(t, []string{feeRecipientCheck, builderRegistrationCheck}, failed(p))
+	})
+	t.Run("wrong fee recipient", func(t *testing.T) {
+		other := primitives.ExecutionAddress{2}
+		p := preflight(t, newServer(), "proposer_index=1&fee_recipient="+hexutil.Encode(other[:]))
+		assert.DeepEqual(t, []string{feeRecipientCheck, builderRegistrationCheck}, failed(p))
+	})
+	t.Run("execution client not ready", func(t *testing.T) {
+		s := newServer()
+		s.OptimisticModeFetcher = &mockChain.ChainService{Optimistic: true}
+		s.EngineCapabilitiesFetcher = &mockEngineCapabilitiesFetcher{caps: &execution.EngineCapabilities{ExchangedAt: time.Now()}}
+		s.ForkchoiceUpdateStatusFetcher = &mockForkchoiceUpdateStatusFetcher{status: execution.ForkchoiceUpdateStatus{
+			LastSuccess: time.Now().Add(-time.Minute),
+			LastFailure: time.Now(),
+			LastErr:     errors.New("syncing"),
+		}}
+		p := preflight(t, s, "proposer_index=1")
+		assert.DeepEqual(t, []string{executionClientCheck, blobPoolCheck, forkchoiceUpdatedCheck}, failed(p))
+		assert.StringContains(t, "syncing", p.Checks[4].Message)
+	})
+	t.Run("blob pool unavailable", func(t *testing.T) {
+		s := newServer()
+		s.EngineCapabilitiesFetcher = &mockEngineCapabilitiesFetcher{caps: &execution.EngineCapabilities{ExchangedAt: time.Now()}}
+		p := preflight(t, s, "proposer_index=1")
+		assert.Equal(t, true, p.Ready)
+		assert.DeepEqual(t, []string{blobPoolCheck}, failed(p))
+		assert.Equal(t, true, p.Checks[3].Informational)
+	})
+	t.Run("builder disabled", func(t *testing.T) {
+		p := preflight(t, newServer(), "proposer_index=2&builder_enabled=false")
+		assert.DeepEqual(t, []string{feeRecipientCheck}, failed(p))
+		assert.StringContains(t, "disabled", p.Checks[2].Message)
+	})
+	t.Run("no builder", func(t *testing.T) {
+		s := newServer()
+		s.BlockBuilder = &builderTest.MockBuilderService{}
+		p := preflight(t, s, "proposer_index=2")
+		assert.DeepEqual(t, []string{feeRecipientCheck}, failed(p))
+	})
+	t.Run("invalid builder enabled", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposal_preflight?proposer_index=1&builder_enabled=maybe", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		newServer().GetProposalPreflight(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+	t.Run("missing proposer index", func(t *testing.T) {
+		request := httptest.NewRequest(http.MethodGet, "http://example.com/prysm/v1/validator/proposal_preflight", nil)
+		writer := httptest.NewRecorder()
+		writer.Body = &bytes.Buffer{}
+		newServer().GetProposalPreflight(writer, request)
+		assert.Equal(t, http.StatusBadRequest, writer.Code)
+	})
+}
@@ -2,16 +2,30 @@ package validator
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/builder"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/monitor"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/core"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/lookup"
 )
 
 type Server struct {
-	BeaconDB            db.ReadOnlyDatabase
-	Stater              lookup.Stater
-	CanonicalFetcher    blockchain.CanonicalFetcher
-	FinalizationFetcher blockchain.FinalizationFetcher
-	ChainInfoFetcher    blockchain.ChainInfoFetcher
-	CoreService         *core.Service
+	BeaconDB               db.ReadOnlyDatabase
+	Stater                 lookup.Stater
+	CanonicalFetcher       blockchain.CanonicalFetcher
+	FinalizationFetcher    blockchain.FinalizationFetcher
+	ChainInfoFetcher       blockchain.ChainInfoFetcher
+	CoreService            *core.Service
+	TrackedValidatorsCache *cache.TrackedValidatorsCache
+	// SyncCommitteePerformanceFetcher is nil when the validator monitor is not enabled.
+	SyncCommitteePerformanceFetcher monitor.SyncCommitteePerformanceFetcher
+	GenesisTimeFetcher              blockchain.TimeFetcher
+	OptimisticModeFetcher           blockchain.OptimisticModeFetcher
+	ExecutionChainInfoFetcher       execution.ChainInfoFetcher
+	EngineCapabilitiesFetcher       execution.EngineCapabilitiesFetcher
+	ForkchoiceUpdateStatusFetcher   execution.ForkchoiceUpdateStatusFetcher
+	BlockBuilder                    builder.BlockBuilder
+	PayloadValuesCache              *cache.PayloadValuesCache
 }
@@ -66,6 +66,14 @@ func fieldConverters(field types.FieldIndex, indices []uint64, elements interfac
 		return convertAttestations(indices, elements, convertAll)
 	case types.Balances:
 		return convertBalances(indices, elements, convertAll)
+	case types.HistoricalSummaries:
+		return convertHashable[*ethpb.HistoricalSummary](field, indices, elements, convertAll)
+	case types.PendingDeposits:
+		return convertHashable[*ethpb.PendingDeposit](field, indices, elements, convertAll)
+	case types.PendingPartialWithdrawals:
+		return convertHashable[*ethpb.PendingPartialWithdrawal](field, indices, elements, convertAll)
+	case types.PendingConsolidations:
+		return convertHashable[*ethpb.PendingConsolidation](field, indices, elements, convertAll)
 	default:
 		return [][32]byte{}, errors.Errorf("got unsupported type of %v", reflect.TypeOf(elements).Name())
 	}
@@ -124,6 +132,55 @@ func convertBalances(indices []uint64, elements interface{}, convertAll bool) ([
 	}
 }
 
+// hashable describes a container that can compute its own hash tree root.
+type hashable interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+func convertHashable[T hashable](field types.FieldIndex, indices []uint64, elements interface{}, convertAll bool) ([][32]byte, error) {
+	val, ok := elements.([]T)
+	if !ok {
+		return nil, errors.Errorf("Wanted type of %T but got %T", []T{}, elements)
+	}
+	return handleHashableSlice(field, val, indices, convertAll)
+}
+
+// handleHashableSlice processes a list of containers and indices into the appropriate roots.
+func handleHashableSlice[T hashable](field types.FieldIndex, val []T, indices []uint64, convertAll bool) ([][32]byte, error) {
+	length := len(indices)
+	if convertAll {
+		length = len(val)
+	}
+	roots := make([][32]byte, 0, length)
+	rootCreator := func(input T) error {
+		newRoot, err := input.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		roots = append(roots, newRoot)
+		return nil
+	}
+	if convertAll {
+		for i := range val {
+			if err := rootCreator(val[i]); err != nil {
+				return nil, err
+			}
+		}
+		return roots, nil
+	}
+	if len(val) > 0 {
+		for _, idx := range indices {
+			if idx > uint64(len(val))-1 {
+				return nil, fmt.Errorf("index %d greater than number of items in %s %d", idx, field.String(), len(val))
+			}
+			if err := rootCreator(val[idx]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return roots, nil
+}
+
 // handle32ByteMVslice computes and returns 32 byte arrays in a slice of root format. This is modified
 // to be used with multivalue slices.
 func handle32ByteMVslice(mv multi_value_slice.MultiValueSliceComposite[[32]byte],
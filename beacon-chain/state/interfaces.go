@@ -6,6 +6,7 @@ package state
 import (
 	"context"
 	"encoding/json"
+	"io"
 
 	"github.com/prysmaticlabs/go-bitfield"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
@@ -73,6 +74,7 @@ type ReadOnlyBeaconState interface {
 	FieldReferencesCount() map[string]uint64
 	RecordStateMetrics()
 	MarshalSSZ() ([]byte, error)
+	WriteSSZ(w io.Writer) error
 	IsNil() bool
 	Version() int
 	LatestExecutionPayloadHeader() (interfaces.ExecutionData, error)
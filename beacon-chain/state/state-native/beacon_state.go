@@ -34,7 +34,7 @@ type BeaconState struct {
 	eth1DepositIndex                    uint64
 	validators                          []*ethpb.Validator
 	validatorsMultiValue                *MultiValueValidators
-	balances                            []uint64
+	balances                            *chunkedSlice[uint64]
 	balancesMultiValue                  *MultiValueBalances
 	randaoMixes                         customtypes.RandaoMixes
 	randaoMixesMultiValue               *MultiValueRandaoMixes
@@ -47,7 +47,7 @@ type BeaconState struct {
 	previousJustifiedCheckpoint         *ethpb.Checkpoint
 	currentJustifiedCheckpoint          *ethpb.Checkpoint
 	finalizedCheckpoint                 *ethpb.Checkpoint
-	inactivityScores                    []uint64
+	inactivityScores                    *chunkedSlice[uint64]
 	inactivityScoresMultiValue          *MultiValueInactivityScores
 	currentSyncCommittee                *ethpb.SyncCommittee
 	nextSyncCommittee                   *ethpb.SyncCommittee
@@ -146,8 +146,8 @@ func (b *BeaconState) MarshalJSON() ([]byte, error) {
 		bRoots = b.blockRoots
 		sRoots = b.stateRoots
 		mixes = b.randaoMixes
-		balances = b.balances
-		inactivityScores = b.inactivityScores
+		balances = b.balances.Value(b)
+		inactivityScores = b.inactivityScores.Value(b)
 		vals = b.validators
 	}
 
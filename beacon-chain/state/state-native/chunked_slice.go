@@ -0,0 +1,138 @@
+package state_native
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
+	consensus_types "github.com/prysmaticlabs/prysm/v5/consensus-types"
+	mvslice "github.com/prysmaticlabs/prysm/v5/container/multi-value-slice"
+)
+
+// sliceChunkLength is the number of values of a chunk of a chunked slice, 16KiB of uint64 values.
+const sliceChunkLength = 2048
+
+// chunkedSlice is a slice split into chunks, which copies of the state share and copy on write individually. Writing a
+// value of a copy copies the chunk of the value, rather than the entire slice, which matters for the lists with a
+// value per validator, such as the balances and the inactivity scores, written in every slot.
+//
+// The methods take the state as an argument to implement mvslice.MultiValueSlice, for the field tries, but a chunked
+// slice belongs to a single state, which must hold its lock.
+type chunkedSlice[V comparable] struct {
+	chunks []*sliceChunk[V]
+	length int
+}
+
+type sliceChunk[V comparable] struct {
+	values []V
+	ref    *stateutil.Reference
+}
+
+var _ mvslice.MultiValueSlice[uint64] = &chunkedSlice[uint64]{}
+
+// newChunkedSlice returns a chunked slice of the values, or nil for nil values. The chunks use the values directly,
+// without copying them.
+func newChunkedSlice[V comparable](values []V) *chunkedSlice[V] {
+	if values == nil {
+		return nil
+	}
+	c := &chunkedSlice[V]{
+		chunks: make([]*sliceChunk[V], 0, (len(values)+sliceChunkLength-1)/sliceChunkLength),
+		length: len(values),
+	}
+	for i := 0; i < len(values); i += sliceChunkLength {
+		end := min(i+sliceChunkLength, len(values))
+		// Capping the capacity prevents appending to a chunk from writing into the next one.
+		c.chunks = append(c.chunks, &sliceChunk[V]{values: values[i:end:end], ref: stateutil.NewRef(1)})
+	}
+	return c
+}
+
+// Len returns the number of values.
+func (c *chunkedSlice[V]) Len(_ mvslice.Identifiable) int {
+	if c == nil {
+		return 0
+	}
+	return c.length
+}
+
+// At returns the value at the index.
+func (c *chunkedSlice[V]) At(_ mvslice.Identifiable, index uint64) (V, error) {
+	if c == nil || index >= uint64(c.length) {
+		var def V
+		return def, errors.Wrapf(consensus_types.ErrOutOfBounds, "index %d does not exist", index)
+	}
+	return c.chunks[index/sliceChunkLength].values[index%sliceChunkLength], nil
+}
+
+// Value returns a copy of the values.
+func (c *chunkedSlice[V]) Value(_ mvslice.Identifiable) []V {
+	if c == nil {
+		return nil
+	}
+	values := make([]V, 0, c.length)
+	for _, chunk := range c.chunks {
+		values = append(values, chunk.values...)
+	}
+	return values
+}
+
+// UpdateAt sets the value at the index, copying its chunk when it is shared.
+func (c *chunkedSlice[V]) UpdateAt(_ mvslice.Identifiable, index uint64, val V) error {
+	if c == nil || index >= uint64(c.length) {
+		return errors.Wrapf(consensus_types.ErrOutOfBounds, "index %d does not exist", index)
+	}
+	c.ownChunk(index / sliceChunkLength).values[index%sliceChunkLength] = val
+	return nil
+}
+
+// Append appends the value, copying the last chunk when it is shared.
+func (c *chunkedSlice[V]) Append(_ mvslice.Identifiable, val V) {
+	if c.length%sliceChunkLength == 0 {
+		values := make([]V, 0, sliceChunkLength)
+		c.chunks = append(c.chunks, &sliceChunk[V]{values: values, ref: stateutil.NewRef(1)})
+	}
+	chunk := c.ownChunk(uint64(len(c.chunks) - 1))
+	chunk.values = append(chunk.values, val)
+	c.length++
+}
+
+// ownChunk returns the chunk at the index, first replacing it with a copy when other states share it.
+func (c *chunkedSlice[V]) ownChunk(i uint64) *sliceChunk[V] {
+	chunk := c.chunks[i]
+	if chunk.ref.Refs() == 1 {
+		return chunk
+	}
+	values := make([]V, len(chunk.values), sliceChunkLength)
+	copy(values, chunk.values)
+	// The chunk is copied before being released, as another state may write it once it holds the only reference.
+	chunk.ref.MinusRef()
+	c.chunks[i] = &sliceChunk[V]{values: values, ref: stateutil.NewRef(1)}
+	return c.chunks[i]
+}
+
+// Copy returns a chunked slice sharing the chunks.
+func (c *chunkedSlice[V]) Copy() *chunkedSlice[V] {
+	if c == nil {
+		return nil
+	}
+	dst := &chunkedSlice[V]{
+		chunks: make([]*sliceChunk[V], len(c.chunks)),
+		length: c.length,
+	}
+	copy(dst.chunks, c.chunks)
+	for _, chunk := range c.chunks {
+		chunk.ref.AddRef()
+	}
+	return dst
+}
+
+// Detach releases the chunks, when the state is collected or replaces the values.
+func (c *chunkedSlice[V]) Detach() {
+	if c == nil {
+		return
+	}
+	for _, chunk := range c.chunks {
+		chunk.ref.MinusRef()
+	}
+	c.chunks = nil
+	c.length = 0
+}
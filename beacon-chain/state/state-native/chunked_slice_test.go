@@ -0,0 +1,134 @@
+package state_native
+
+import (
+	"context"
+	"runtime"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native/types"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestChunkedSlice(t *testing.T) {
+	values := make([]uint64, 2*sliceChunkLength+10)
+	for i := range values {
+		values[i] = uint64(i)
+	}
+	a := newChunkedSlice(values)
+	assert.Equal(t, 3, len(a.chunks))
+	assert.Equal(t, len(values), a.Len(nil))
+	v, err := a.At(nil, sliceChunkLength+1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(sliceChunkLength+1), v)
+	_, err = a.At(nil, uint64(len(values)))
+	require.ErrorContains(t, "does not exist", err)
+	assert.DeepEqual(t, values, a.Value(nil))
+
+	b := a.Copy()
+	for i := range a.chunks {
+		assert.Equal(t, a.chunks[i], b.chunks[i])
+		assert.Equal(t, uint(2), a.chunks[i].ref.Refs())
+	}
+
+	// Writing copies the chunk of the value only.
+	require.NoError(t, b.UpdateAt(nil, sliceChunkLength+1, 42))
+	assert.Equal(t, a.chunks[0], b.chunks[0])
+	assert.NotEqual(t, a.chunks[1], b.chunks[1])
+	assert.Equal(t, a.chunks[2], b.chunks[2])
+	assert.Equal(t, uint(1), a.chunks[1].ref.Refs())
+	assert.Equal(t, uint(1), b.chunks[1].ref.Refs())
+	v, err = a.At(nil, sliceChunkLength+1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(sliceChunkLength+1), v)
+	v, err = b.At(nil, sliceChunkLength+1)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), v)
+	require.ErrorContains(t, "does not exist", b.UpdateAt(nil, uint64(len(values)), 0))
+
+	// Appending copies the last chunk, and starts a new one once it is full.
+	b.Append(nil, 1)
+	assert.Equal(t, len(values), a.Len(nil))
+	assert.Equal(t, len(values)+1, b.Len(nil))
+	assert.NotEqual(t, a.chunks[2], b.chunks[2])
+	for b.Len(nil) < 3*sliceChunkLength+1 {
+		b.Append(nil, 2)
+	}
+	assert.Equal(t, 4, len(b.chunks))
+	assert.Equal(t, 3, len(a.chunks))
+	assert.DeepEqual(t, values, a.Value(nil))
+
+	b.Detach()
+	assert.Equal(t, uint(1), a.chunks[0].ref.Refs())
+	assert.Equal(t, 0, b.Len(nil))
+}
+
+func TestChunkedSlice_Nil(t *testing.T) {
+	var c *chunkedSlice[uint64]
+	assert.Equal(t, c, newChunkedSlice[uint64](nil))
+	assert.Equal(t, 0, c.Len(nil))
+	assert.DeepEqual(t, []uint64(nil), c.Value(nil))
+	_, err := c.At(nil, 0)
+	require.ErrorContains(t, "does not exist", err)
+	assert.Equal(t, c, c.Copy())
+	c.Detach()
+}
+
+func TestStateReferenceSharing_Balances(t *testing.T) {
+	balances := make([]uint64, 3*sliceChunkLength)
+	s, err := InitializeFromProtoUnsafeAltair(&ethpb.BeaconStateAltair{
+		Balances:         balances,
+		InactivityScores: make([]uint64, len(balances)),
+	})
+	require.NoError(t, err)
+	a, ok := s.(*BeaconState)
+	require.Equal(t, true, ok)
+
+	func() {
+		// Create object in a different scope for GC
+		b := a.Copy()
+		assert.Equal(t, uint(2), a.balances.chunks[0].ref.Refs())
+		assert.Equal(t, uint(2), a.inactivityScores.chunks[0].ref.Refs())
+		_ = b
+	}()
+
+	runtime.GC() // Should run finalizer on object b
+	assert.Equal(t, uint(1), a.balances.chunks[0].ref.Refs())
+	assert.Equal(t, uint(1), a.inactivityScores.chunks[0].ref.Refs())
+
+	copied := a.Copy()
+	b, ok := copied.(*BeaconState)
+	require.Equal(t, true, ok)
+	require.NoError(t, b.UpdateBalancesAtIndex(sliceChunkLength, 1))
+	require.NoError(t, b.AppendInactivityScore(2))
+	bal, err := a.BalanceAtIndex(sliceChunkLength)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), bal)
+	bal, err = b.BalanceAtIndex(sliceChunkLength)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), bal)
+	assert.Equal(t, a.balances.chunks[0], b.balances.chunks[0])
+	assert.NotEqual(t, a.balances.chunks[1], b.balances.chunks[1])
+	scores, err := b.InactivityScores()
+	require.NoError(t, err)
+	assert.Equal(t, len(balances)+1, len(scores))
+
+	// The roots of the fields follow the values of the states.
+	ctx := context.Background()
+	aBalancesRoot, err := a.rootSelector(ctx, types.Balances)
+	require.NoError(t, err)
+	aScoresRoot, err := a.rootSelector(ctx, types.InactivityScores)
+	require.NoError(t, err)
+	bBalancesRoot, err := b.rootSelector(ctx, types.Balances)
+	require.NoError(t, err)
+	assert.NotEqual(t, aBalancesRoot, bBalancesRoot)
+	require.NoError(t, b.UpdateBalancesAtIndex(sliceChunkLength, 0))
+	require.NoError(t, b.SetInactivityScores(make([]uint64, len(balances))))
+	bBalancesRoot, err = b.rootSelector(ctx, types.Balances)
+	require.NoError(t, err)
+	bScoresRoot, err := b.rootSelector(ctx, types.InactivityScores)
+	require.NoError(t, err)
+	assert.Equal(t, aBalancesRoot, bBalancesRoot)
+	assert.Equal(t, aScoresRoot, bScoresRoot)
+}
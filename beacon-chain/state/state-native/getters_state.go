@@ -35,8 +35,8 @@ func (b *BeaconState) ToProtoUnsafe() interface{} {
 			vals = b.validatorsMultiValue.Value(b)
 		}
 	} else {
-		bals = b.balances
-		inactivityScores = b.inactivityScores
+		bals = b.balances.Value(b)
+		inactivityScores = b.inactivityScores.Value(b)
 		vals = b.validators
 	}
 
@@ -348,12 +348,7 @@ func (b *BeaconState) balancesVal() []uint64 {
 		}
 		return b.balancesMultiValue.Value(b)
 	}
-	if b.balances == nil {
-		return nil
-	}
-	res := make([]uint64, len(b.balances))
-	copy(res, b.balances)
-	return res
+	return b.balances.Value(b)
 }
 
 // BalanceAtIndex of validator with the provided index.
@@ -374,10 +369,7 @@ func (b *BeaconState) balanceAtIndex(idx primitives.ValidatorIndex) (uint64, err
 	if b.balances == nil {
 		return 0, nil
 	}
-	if uint64(len(b.balances)) <= uint64(idx) {
-		return 0, errors.Wrapf(consensus_types.ErrOutOfBounds, "balance index %d does not exist", idx)
-	}
-	return b.balances[idx], nil
+	return b.balances.At(b, uint64(idx))
 }
 
 // BalancesLength returns the length of the balances slice.
@@ -391,7 +383,7 @@ func (b *BeaconState) BalancesLength() int {
 		}
 		return b.balancesMultiValue.Len(b)
 	}
-	return len(b.balances)
+	return b.balances.Len(b)
 }
 
 // Slashings of validators on the beacon chain.
@@ -437,12 +429,7 @@ func (b *BeaconState) inactivityScoresVal() []uint64 {
 		}
 		return b.inactivityScoresMultiValue.Value(b)
 	}
-	if b.inactivityScores == nil {
-		return nil
-	}
-	res := make([]uint64, len(b.inactivityScores))
-	copy(res, b.inactivityScores)
-	return res
+	return b.inactivityScores.Value(b)
 }
 
 // PendingBalanceToWithdraw returns the sum of all pending withdrawals for the given validator.
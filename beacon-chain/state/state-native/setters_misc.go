@@ -150,6 +150,7 @@ func (b *BeaconState) AppendHistoricalSummaries(summary *ethpb.HistoricalSummary
 
 	b.historicalSummaries = append(summaries, summary)
 	b.markFieldAsDirty(types.HistoricalSummaries)
+	b.addDirtyIndices(types.HistoricalSummaries, []uint64{uint64(len(b.historicalSummaries) - 1)})
 	return nil
 }
 
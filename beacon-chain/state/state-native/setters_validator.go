@@ -6,7 +6,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
-	"github.com/prysmaticlabs/prysm/v5/config/params"
 	consensus_types "github.com/prysmaticlabs/prysm/v5/consensus-types"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -151,9 +150,8 @@ func (b *BeaconState) SetBalances(val []uint64) error {
 		}
 		b.balancesMultiValue = NewMultiValueBalances(val)
 	} else {
-		b.sharedFieldReferences[types.Balances].MinusRef()
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
-		b.balances = val
+		b.balances.Detach()
+		b.balances = newChunkedSlice(val)
 	}
 
 	b.markFieldAsDirty(types.Balances)
@@ -169,22 +167,12 @@ func (b *BeaconState) UpdateBalancesAtIndex(idx primitives.ValidatorIndex, val u
 			return errors.Wrap(err, "could not update balances")
 		}
 	} else {
-		if uint64(len(b.balances)) <= uint64(idx) {
-			return errors.Wrapf(consensus_types.ErrOutOfBounds, "balance index %d does not exist", idx)
-		}
-
 		b.lock.Lock()
-
-		bals := b.balances
-		if b.sharedFieldReferences[types.Balances].Refs() > 1 {
-			bals = b.balancesVal()
-			b.sharedFieldReferences[types.Balances].MinusRef()
-			b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
-		}
-		bals[idx] = val
-		b.balances = bals
-
+		err := b.balances.UpdateAt(b, uint64(idx), val)
 		b.lock.Unlock()
+		if err != nil {
+			return errors.Wrap(err, "could not update balances")
+		}
 	}
 
 	b.lock.Lock()
@@ -275,16 +263,11 @@ func (b *BeaconState) AppendBalance(bal uint64) error {
 	} else {
 		b.lock.Lock()
 
-		bals := b.balances
-		if b.sharedFieldReferences[types.Balances].Refs() > 1 {
-			bals = make([]uint64, 0, len(b.balances)+int(params.BeaconConfig().MaxDeposits))
-			bals = append(bals, b.balances...)
-			b.sharedFieldReferences[types.Balances].MinusRef()
-			b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
+		if b.balances == nil {
+			b.balances = newChunkedSlice([]uint64{})
 		}
-
-		b.balances = append(bals, bal)
-		balIdx = uint64(len(b.balances) - 1)
+		b.balances.Append(b, bal)
+		balIdx = uint64(b.balances.Len(b) - 1)
 
 		b.lock.Unlock()
 	}
@@ -308,14 +291,10 @@ func (b *BeaconState) AppendInactivityScore(s uint64) error {
 	} else {
 		b.lock.Lock()
 
-		scores := b.inactivityScores
-		if b.sharedFieldReferences[types.InactivityScores].Refs() > 1 {
-			scores = make([]uint64, 0, len(b.inactivityScores)+int(params.BeaconConfig().MaxDeposits))
-			scores = append(scores, b.inactivityScores...)
-			b.sharedFieldReferences[types.InactivityScores].MinusRef()
-			b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
+		if b.inactivityScores == nil {
+			b.inactivityScores = newChunkedSlice([]uint64{})
 		}
-		b.inactivityScores = append(scores, s)
+		b.inactivityScores.Append(b, s)
 
 		b.lock.Unlock()
 	}
@@ -343,9 +322,8 @@ func (b *BeaconState) SetInactivityScores(val []uint64) error {
 		}
 		b.inactivityScoresMultiValue = NewMultiValueInactivityScores(val)
 	} else {
-		b.sharedFieldReferences[types.InactivityScores].MinusRef()
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
-		b.inactivityScores = val
+		b.inactivityScores.Detach()
+		b.inactivityScores = newChunkedSlice(val)
 	}
 
 	b.markFieldAsDirty(types.InactivityScores)
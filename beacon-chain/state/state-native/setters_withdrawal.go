@@ -54,13 +54,19 @@ func (b *BeaconState) AppendPendingPartialWithdrawal(ppw *eth.PendingPartialWith
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
-	b.sharedFieldReferences[types.PendingPartialWithdrawals].MinusRef()
-	b.sharedFieldReferences[types.PendingPartialWithdrawals] = stateutil.NewRef(1)
+	withdrawals := b.pendingPartialWithdrawals
+	if b.sharedFieldReferences[types.PendingPartialWithdrawals].Refs() > 1 {
+		// Copy elements in underlying array by reference.
+		withdrawals = make([]*eth.PendingPartialWithdrawal, 0, len(b.pendingPartialWithdrawals)+1)
+		withdrawals = append(withdrawals, b.pendingPartialWithdrawals...)
+		b.sharedFieldReferences[types.PendingPartialWithdrawals].MinusRef()
+		b.sharedFieldReferences[types.PendingPartialWithdrawals] = stateutil.NewRef(1)
+	}
 
-	b.pendingPartialWithdrawals = append(b.pendingPartialWithdrawals, ppw)
+	b.pendingPartialWithdrawals = append(withdrawals, ppw)
 
 	b.markFieldAsDirty(types.PendingPartialWithdrawals)
-	b.rebuildTrie[types.PendingPartialWithdrawals] = true
+	b.addDirtyIndices(types.PendingPartialWithdrawals, []uint64{uint64(len(b.pendingPartialWithdrawals) - 1)})
 	return nil
 }
 
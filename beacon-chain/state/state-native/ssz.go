@@ -1,12 +1,26 @@
 package state_native
 
 import (
+	"encoding/binary"
+	"io"
+	"slices"
+
 	"github.com/pkg/errors"
 	ssz "github.com/prysmaticlabs/fastssz"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
 var errAssertionFailed = errors.New("failed to convert interface to proto state")
 
+const (
+	sszOffsetSize = 4
+	sszUint64Size = 8
+	// sszWriteChunkSize is the number of validators or balances encoded before being written at once.
+	sszWriteChunkSize = 1024
+)
+
 func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 	proto := b.ToProto()
 
@@ -16,3 +30,147 @@ func (b *BeaconState) MarshalSSZ() ([]byte, error) {
 	}
 	return s.MarshalSSZ()
 }
+
+// WriteSSZ writes the SSZ encoding of the state to w, the same as MarshalSSZ, without holding the whole encoding in
+// memory. The state is marshaled without its validators and balances, which make up most of its size, and these are
+// encoded and written in chunks in between. Nothing is written when an error is returned before writing.
+func (b *BeaconState) WriteSSZ(w io.Writer) error {
+	b.lock.RLock()
+	proto := b.ToProtoUnsafe()
+	vals, bals, err := detachValidatorsAndBalances(proto)
+	b.lock.RUnlock()
+	if err != nil {
+		return err
+	}
+	m, ok := proto.(ssz.Marshaler)
+	if !ok {
+		return errAssertionFailed
+	}
+	skeleton, err := m.MarshalSSZ()
+	if err != nil {
+		return err
+	}
+
+	// The skeleton has empty validators and balances, so its variable fields from the validators on start at the
+	// offset of the validators, and the offsets of the balances and of the following fields shift by their size.
+	valsPos := validatorsOffsetPosition()
+	if len(skeleton) < valsPos+2*sszOffsetSize {
+		return errors.New("state encoding is too short")
+	}
+	valsStart := binary.LittleEndian.Uint32(skeleton[valsPos:])
+	valsSize := uint32(len(vals) * (&ethpb.Validator{}).SizeSSZ())
+	balsSize := uint32(len(bals) * sszUint64Size)
+	head := slices.Clone(skeleton[:valsStart])
+	binary.LittleEndian.PutUint32(head[valsPos+sszOffsetSize:], valsStart+valsSize)
+	for _, pos := range offsetPositionsAfterBalances(b.version) {
+		offset := binary.LittleEndian.Uint32(head[pos:])
+		binary.LittleEndian.PutUint32(head[pos:], offset+valsSize+balsSize)
+	}
+
+	if _, err := w.Write(head); err != nil {
+		return err
+	}
+	chunk := make([]byte, 0, sszWriteChunkSize*(&ethpb.Validator{}).SizeSSZ())
+	for i := 0; i < len(vals); i += sszWriteChunkSize {
+		chunk = chunk[:0]
+		for _, v := range vals[i:min(i+sszWriteChunkSize, len(vals))] {
+			if chunk, err = v.MarshalSSZTo(chunk); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < len(bals); i += sszWriteChunkSize {
+		chunk = chunk[:0]
+		for _, bal := range bals[i:min(i+sszWriteChunkSize, len(bals))] {
+			chunk = ssz.MarshalUint64(chunk, bal)
+		}
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+	}
+	_, err = w.Write(skeleton[valsStart:])
+	return err
+}
+
+// detachValidatorsAndBalances removes the validators and the balances from the proto state, and returns copies of
+// them which remain valid once the state lock is released.
+func detachValidatorsAndBalances(proto interface{}) ([]*ethpb.Validator, []uint64, error) {
+	var vals []*ethpb.Validator
+	var bals []uint64
+	switch p := proto.(type) {
+	case *ethpb.BeaconState:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	case *ethpb.BeaconStateAltair:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	case *ethpb.BeaconStateBellatrix:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	case *ethpb.BeaconStateCapella:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	case *ethpb.BeaconStateDeneb:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	case *ethpb.BeaconStateElectra:
+		vals, bals = p.Validators, p.Balances
+		p.Validators, p.Balances = nil, nil
+	default:
+		return nil, nil, errAssertionFailed
+	}
+	return slices.Clone(vals), slices.Clone(bals), nil
+}
+
+// validatorsOffsetPosition returns the position of the offset of the validators in the SSZ encoding of a state. The
+// fields up to the validators, and the offset of the balances following it, are the same in every version.
+func validatorsOffsetPosition() int {
+	return sszUint64Size + // genesis_time
+		fieldparams.RootLength + // genesis_validators_root
+		sszUint64Size + // slot
+		(&ethpb.Fork{}).SizeSSZ() +
+		(&ethpb.BeaconBlockHeader{}).SizeSSZ() +
+		fieldparams.BlockRootsLength*fieldparams.RootLength +
+		fieldparams.StateRootsLength*fieldparams.RootLength +
+		sszOffsetSize + // historical_roots
+		(&ethpb.Eth1Data{}).SizeSSZ() +
+		sszOffsetSize + // eth1_data_votes
+		sszUint64Size // eth1_deposit_index
+}
+
+// offsetPositionsAfterBalances returns the positions of the offsets of the variable size fields following the
+// balances in the SSZ encoding of a state of the version.
+func offsetPositionsAfterBalances(v int) []int {
+	pos := validatorsOffsetPosition() + 2*sszOffsetSize +
+		fieldparams.RandaoMixesLength*fieldparams.RootLength +
+		fieldparams.SlashingsLength*sszUint64Size
+	// Attestations of the previous and current epochs, or participation since Altair.
+	positions := []int{pos, pos + sszOffsetSize}
+	pos += 2*sszOffsetSize + 1 + 3*(&ethpb.Checkpoint{}).SizeSSZ()
+	if v >= version.Altair {
+		// Inactivity scores, followed by the current and next sync committees.
+		positions = append(positions, pos)
+		pos += sszOffsetSize + 2*(&ethpb.SyncCommittee{}).SizeSSZ()
+	}
+	if v >= version.Bellatrix {
+		// Latest execution payload header.
+		positions = append(positions, pos)
+		pos += sszOffsetSize
+	}
+	if v >= version.Capella {
+		// Historical summaries, after the next withdrawal index and validator index.
+		pos += 2 * sszUint64Size
+		positions = append(positions, pos)
+		pos += sszOffsetSize
+	}
+	if v >= version.Electra {
+		// Pending deposits, partial withdrawals and consolidations, after the deposit requests start index and the
+		// balances and epochs of the churn.
+		pos += 6 * sszUint64Size
+		positions = append(positions, pos, pos+sszOffsetSize, pos+2*sszOffsetSize)
+	}
+	return positions
+}
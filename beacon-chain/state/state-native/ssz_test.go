@@ -0,0 +1,65 @@
+package state_native_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestBeaconState_WriteSSZ(t *testing.T) {
+	tests := []struct {
+		name     string
+		genState func(t testing.TB, numValidators uint64) (state.BeaconState, []bls.SecretKey)
+	}{
+		{name: "phase0", genState: util.DeterministicGenesisState},
+		{name: "altair", genState: util.DeterministicGenesisStateAltair},
+		{name: "bellatrix", genState: util.DeterministicGenesisStateBellatrix},
+		{name: "capella", genState: util.DeterministicGenesisStateCapella},
+		{name: "deneb", genState: util.DeterministicGenesisStateDeneb},
+		{name: "electra", genState: util.DeterministicGenesisStateElectra},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// More validators than a chunk, and variable size fields on both sides of the validators and balances.
+			st, _ := tt.genState(t, 1030)
+			require.NoError(t, st.AppendEth1DataVotes(&ethpb.Eth1Data{DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)}))
+			if st.Version() >= version.Altair {
+				require.NoError(t, st.AppendInactivityScore(7))
+			}
+			if st.Version() >= version.Capella {
+				require.NoError(t, st.AppendHistoricalSummaries(&ethpb.HistoricalSummary{
+					BlockSummaryRoot: make([]byte, 32),
+					StateSummaryRoot: make([]byte, 32),
+				}))
+			}
+			if st.Version() >= version.Electra {
+				require.NoError(t, st.AppendPendingConsolidation(&ethpb.PendingConsolidation{SourceIndex: 1, TargetIndex: 2}))
+			}
+
+			want, err := st.MarshalSSZ()
+			require.NoError(t, err)
+			var buf bytes.Buffer
+			require.NoError(t, st.WriteSSZ(&buf))
+			assert.DeepEqual(t, want, buf.Bytes())
+		})
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+func TestBeaconState_WriteSSZ_WriteError(t *testing.T) {
+	st, _ := util.DeterministicGenesisStateElectra(t, 8)
+	require.ErrorContains(t, "closed", st.WriteSSZ(failingWriter{}))
+}
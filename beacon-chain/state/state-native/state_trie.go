@@ -112,12 +112,12 @@ var electraFields = append(
 )
 
 const (
-	phase0SharedFieldRefCount                     = 10
-	altairSharedFieldRefCount                     = 11
-	bellatrixSharedFieldRefCount                  = 12
-	capellaSharedFieldRefCount                    = 13
-	denebSharedFieldRefCount                      = 13
-	electraSharedFieldRefCount                    = 16
+	phase0SharedFieldRefCount                     = 9
+	altairSharedFieldRefCount                     = 9
+	bellatrixSharedFieldRefCount                  = 10
+	capellaSharedFieldRefCount                    = 11
+	denebSharedFieldRefCount                      = 11
+	electraSharedFieldRefCount                    = 14
 	experimentalStatePhase0SharedFieldRefCount    = 5
 	experimentalStateAltairSharedFieldRefCount    = 5
 	experimentalStateBellatrixSharedFieldRefCount = 6
@@ -222,7 +222,7 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, phase0SharedFieldRefCount)
@@ -249,7 +249,6 @@ func InitializeFromProtoUnsafePhase0(st *ethpb.BeaconState) (state.BeaconState,
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
 	}
 
@@ -329,9 +328,9 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
-		b.inactivityScores = st.InactivityScores
+		b.inactivityScores = newChunkedSlice(st.InactivityScores)
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, altairSharedFieldRefCount)
 	}
@@ -357,9 +356,7 @@ func InitializeFromProtoUnsafeAltair(st *ethpb.BeaconStateAltair) (state.BeaconS
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
 	state.Count.Inc()
@@ -439,9 +436,9 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
-		b.inactivityScores = st.InactivityScores
+		b.inactivityScores = newChunkedSlice(st.InactivityScores)
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, bellatrixSharedFieldRefCount)
 	}
@@ -468,9 +465,7 @@ func InitializeFromProtoUnsafeBellatrix(st *ethpb.BeaconStateBellatrix) (state.B
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
 	state.Count.Inc()
@@ -553,9 +548,9 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
-		b.inactivityScores = st.InactivityScores
+		b.inactivityScores = newChunkedSlice(st.InactivityScores)
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, capellaSharedFieldRefCount)
 	}
@@ -583,9 +578,7 @@ func InitializeFromProtoUnsafeCapella(st *ethpb.BeaconStateCapella) (state.Beaco
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
 	state.Count.Inc()
@@ -666,9 +659,9 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
-		b.inactivityScores = st.InactivityScores
+		b.inactivityScores = newChunkedSlice(st.InactivityScores)
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, denebSharedFieldRefCount)
 	}
@@ -696,9 +689,7 @@ func InitializeFromProtoUnsafeDeneb(st *ethpb.BeaconStateDeneb) (state.BeaconSta
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
 	state.Count.Inc()
@@ -788,9 +779,9 @@ func InitializeFromProtoUnsafeElectra(st *ethpb.BeaconStateElectra) (state.Beaco
 		}
 		b.randaoMixes = mixes
 
-		b.balances = st.Balances
+		b.balances = newChunkedSlice(st.Balances)
 		b.validators = st.Validators
-		b.inactivityScores = st.InactivityScores
+		b.inactivityScores = newChunkedSlice(st.InactivityScores)
 
 		b.sharedFieldReferences = make(map[types.FieldIndex]*stateutil.Reference, electraSharedFieldRefCount)
 	}
@@ -821,9 +812,7 @@ func InitializeFromProtoUnsafeElectra(st *ethpb.BeaconStateElectra) (state.Beaco
 		b.sharedFieldReferences[types.BlockRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.StateRoots] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.RandaoMixes] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.Balances] = stateutil.NewRef(1)
 		b.sharedFieldReferences[types.Validators] = stateutil.NewRef(1)
-		b.sharedFieldReferences[types.InactivityScores] = stateutil.NewRef(1)
 	}
 
 	state.Count.Inc()
@@ -882,7 +871,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 		slashings:                 b.slashings,
 
 		// Large arrays, increases over time.
-		balances:                   b.balances,
+		balances:                   b.balances.Copy(),
 		balancesMultiValue:         b.balancesMultiValue,
 		historicalRoots:            b.historicalRoots,
 		historicalSummaries:        b.historicalSummaries,
@@ -890,7 +879,7 @@ func (b *BeaconState) Copy() state.BeaconState {
 		validatorsMultiValue:       b.validatorsMultiValue,
 		previousEpochParticipation: b.previousEpochParticipation,
 		currentEpochParticipation:  b.currentEpochParticipation,
-		inactivityScores:           b.inactivityScores,
+		inactivityScores:           b.inactivityScores.Copy(),
 		inactivityScoresMultiValue: b.inactivityScoresMultiValue,
 		pendingDeposits:            b.pendingDeposits,
 		pendingPartialWithdrawals:  b.pendingPartialWithdrawals,
@@ -1264,7 +1253,7 @@ func (b *BeaconState) rootSelector(ctx context.Context, field types.FieldIndex)
 		if features.Get().EnableExperimentalState {
 			return stateutil.Uint64ListRootWithRegistryLimit(b.inactivityScoresMultiValue.Value(b))
 		} else {
-			return stateutil.Uint64ListRootWithRegistryLimit(b.inactivityScores)
+			return stateutil.Uint64ListRootWithRegistryLimit(b.inactivityScores.Value(b))
 		}
 	case types.CurrentSyncCommittee:
 		return stateutil.SyncCommitteeRoot(b.currentSyncCommittee)
@@ -1281,7 +1270,7 @@ func (b *BeaconState) rootSelector(ctx context.Context, field types.FieldIndex)
 	case types.NextWithdrawalValidatorIndex:
 		return ssz.Uint64Root(uint64(b.nextWithdrawalValidatorIndex)), nil
 	case types.HistoricalSummaries:
-		return stateutil.HistoricalSummariesRoot(b.historicalSummaries)
+		return b.compositeListRootSelector(field, b.historicalSummaries, fieldparams.HistoricalRootsLength)
 	case types.DepositRequestsStartIndex:
 		return ssz.Uint64Root(b.depositRequestsStartIndex), nil
 	case types.DepositBalanceToConsume:
@@ -1295,11 +1284,11 @@ func (b *BeaconState) rootSelector(ctx context.Context, field types.FieldIndex)
 	case types.EarliestConsolidationEpoch:
 		return ssz.Uint64Root(uint64(b.earliestConsolidationEpoch)), nil
 	case types.PendingDeposits:
-		return stateutil.PendingDepositsRoot(b.pendingDeposits)
+		return b.compositeListRootSelector(field, b.pendingDeposits, fieldparams.PendingDepositsLimit)
 	case types.PendingPartialWithdrawals:
-		return stateutil.PendingPartialWithdrawalsRoot(b.pendingPartialWithdrawals)
+		return b.compositeListRootSelector(field, b.pendingPartialWithdrawals, fieldparams.PendingPartialWithdrawalsLimit)
 	case types.PendingConsolidations:
-		return stateutil.PendingConsolidationsRoot(b.pendingConsolidations)
+		return b.compositeListRootSelector(field, b.pendingConsolidations, fieldparams.PendingConsolidationsLimit)
 	}
 	return [32]byte{}, errors.New("invalid field index provided")
 }
@@ -1427,6 +1416,9 @@ func finalizerCleanup(b *BeaconState) {
 		if b.validatorsMultiValue != nil {
 			b.validatorsMultiValue.Detach(b)
 		}
+	} else {
+		b.balances.Detach()
+		b.inactivityScores.Detach()
 	}
 
 	state.Count.Sub(1)
@@ -1530,7 +1522,10 @@ func (b *BeaconState) balancesRootSelector(field types.FieldIndex) ([32]byte, er
 				return [32]byte{}, err
 			}
 		} else {
-			err := b.resetFieldTrie(field, b.balances, stateutil.ValidatorLimitForBalancesChunks())
+			err := b.resetFieldTrie(field, mvslice.MultiValueSliceComposite[uint64]{
+				Identifiable:    b,
+				MultiValueSlice: b.balances,
+			}, stateutil.ValidatorLimitForBalancesChunks())
 			if err != nil {
 				return [32]byte{}, err
 			}
@@ -1544,8 +1539,24 @@ func (b *BeaconState) balancesRootSelector(field types.FieldIndex) ([32]byte, er
 			MultiValueSlice: b.balancesMultiValue,
 		})
 	} else {
-		return b.recomputeFieldTrie(field, b.balances)
+		return b.recomputeFieldTrie(field, mvslice.MultiValueSliceComposite[uint64]{
+			Identifiable:    b,
+			MultiValueSlice: b.balances,
+		})
+	}
+}
+
+// compositeListRootSelector computes the root of a variable length list of containers
+// backed by a field trie, so that only the dirty indices are rehashed on subsequent calls.
+func (b *BeaconState) compositeListRootSelector(field types.FieldIndex, elements interface{}, limit uint64) ([32]byte, error) {
+	if b.rebuildTrie[field] {
+		if err := b.resetFieldTrie(field, elements, limit); err != nil {
+			return [32]byte{}, err
+		}
+		delete(b.rebuildTrie, field)
+		return b.stateFieldLeaves[field].TrieRoot()
 	}
+	return b.recomputeFieldTrie(field, elements)
 }
 
 func (b *BeaconState) randaoMixesRootSelector(field types.FieldIndex) ([32]byte, error) {
@@ -9,8 +9,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	statenative "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
 	"github.com/prysmaticlabs/prysm/v5/config/features"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -868,3 +870,96 @@ func TestBeaconChainCopy_Electra(t *testing.T) {
 		t.Fatal("Copied state does not match original state")
 	}
 }
+
+func TestBeaconState_HashTreeRoot_PendingQueues_Electra(t *testing.T) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(t, err)
+
+	assertRootMatchesProto := func(t *testing.T, s state.BeaconState) {
+		root, err := s.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		pbState, err := statenative.ProtobufBeaconStateElectra(s.ToProtoUnsafe())
+		require.NoError(t, err)
+		genericHTR, err := pbState.HashTreeRoot()
+		require.NoError(t, err)
+		assert.DeepEqual(t, genericHTR[:], root[:], "Expected hash tree root to match generic")
+	}
+	assertRootMatchesProto(t, st)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, st.AppendPendingDeposit(&ethpb.PendingDeposit{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte{byte(i)}, 32),
+			Amount:                uint64(i),
+			Signature:             bytesutil.PadTo([]byte{byte(i)}, 96),
+			Slot:                  primitives.Slot(i),
+		}))
+		require.NoError(t, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{
+			Index:             primitives.ValidatorIndex(i),
+			Amount:            uint64(i),
+			WithdrawableEpoch: primitives.Epoch(i),
+		}))
+		require.NoError(t, st.AppendPendingConsolidation(&ethpb.PendingConsolidation{
+			SourceIndex: primitives.ValidatorIndex(i),
+			TargetIndex: primitives.ValidatorIndex(i + 1),
+		}))
+		require.NoError(t, st.AppendHistoricalSummaries(&ethpb.HistoricalSummary{
+			BlockSummaryRoot: bytesutil.PadTo([]byte{byte(i)}, 32),
+			StateSummaryRoot: bytesutil.PadTo([]byte{byte(i)}, 32),
+		}))
+		assertRootMatchesProto(t, st)
+	}
+
+	// Appending to a copy must not affect the roots of the original state.
+	cp := st.Copy()
+	originalRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, cp.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: 100}))
+	require.NoError(t, cp.AppendPendingConsolidation(&ethpb.PendingConsolidation{SourceIndex: 100}))
+	assertRootMatchesProto(t, cp)
+	root, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, originalRoot, root)
+	n, err := st.NumPendingPartialWithdrawals()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), n)
+
+	// Dequeueing shifts every element and requires a rebuild of the trie.
+	require.NoError(t, cp.DequeuePendingPartialWithdrawals(3))
+	require.NoError(t, cp.SetPendingConsolidations(nil))
+	assertRootMatchesProto(t, cp)
+	assertRootMatchesProto(t, st)
+}
+
+func BenchmarkBeaconState_HashTreeRoot_PendingPartialWithdrawals(b *testing.B) {
+	st, err := util.NewBeaconStateElectra()
+	require.NoError(b, err)
+	for i := 0; i < 100000; i++ {
+		require.NoError(b, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{
+			Index:  primitives.ValidatorIndex(i),
+			Amount: uint64(i),
+		}))
+	}
+	_, err = st.HashTreeRoot(context.Background())
+	require.NoError(b, err)
+
+	b.Run("incremental", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			require.NoError(b, st.AppendPendingPartialWithdrawal(&ethpb.PendingPartialWithdrawal{Index: primitives.ValidatorIndex(i)}))
+			_, err := st.HashTreeRoot(context.Background())
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("full recomputation", func(b *testing.B) {
+		ppws, err := st.PendingPartialWithdrawals()
+		require.NoError(b, err)
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := stateutil.PendingPartialWithdrawalsRoot(ppws)
+			require.NoError(b, err)
+		}
+	})
+}
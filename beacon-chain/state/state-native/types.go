@@ -24,6 +24,10 @@ func init() {
 	fieldMap[types.Validators] = types.CompositeArray
 	fieldMap[types.PreviousEpochAttestations] = types.CompositeArray
 	fieldMap[types.CurrentEpochAttestations] = types.CompositeArray
+	fieldMap[types.HistoricalSummaries] = types.CompositeArray
+	fieldMap[types.PendingDeposits] = types.CompositeArray
+	fieldMap[types.PendingPartialWithdrawals] = types.CompositeArray
+	fieldMap[types.PendingConsolidations] = types.CompositeArray
 	fieldMap[types.Balances] = types.CompressedArray
 }
 
@@ -63,7 +63,11 @@ func (s *State) StateByRoot(ctx context.Context, blockRoot [32]byte) (state.Beac
 		}
 		blockRoot = root
 	}
-	return s.loadStateByRoot(ctx, blockRoot)
+	st, err := s.loadStateByRoot(ctx, blockRoot)
+	if err != nil && s.useRemoteStates(ctx, err) {
+		return s.remoteStateByRoot(ctx, blockRoot, err)
+	}
+	return st, err
 }
 
 // ActiveNonSlashedBalancesByRoot retrieves the effective balances of all active and non-slashed validators at the
@@ -177,7 +181,7 @@ func (s *State) recoverStateSummary(ctx context.Context, blockRoot [32]byte) (*e
 		}
 		return summary, nil
 	}
-	return nil, errors.New("could not find block in DB")
+	return nil, errors.Wrap(errUnknownBlock, "could not find block in DB")
 }
 
 // DeleteStateFromCaches deletes the state from the caches.
@@ -238,6 +242,11 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 
 	replayBlockCount.Observe(float64(len(blks)))
 
+	release, err := s.replayLimiter.acquire(ctx, targetSlot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not wait to replay blocks")
+	}
+	defer release()
 	return s.replayBlocks(ctx, startState, blks, targetSlot)
 }
 
@@ -20,6 +20,13 @@ func WithCache(c CachedGetter) CanonicalHistoryOption {
 	}
 }
 
+// WithReplayLimiter makes the replays of the canonical history wait for the given limiter.
+func WithReplayLimiter(l *ReplayLimiter) CanonicalHistoryOption {
+	return func(h *CanonicalHistory) {
+		h.limiter = l
+	}
+}
+
 type CanonicalHistoryOption func(*CanonicalHistory)
 
 func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlotter, opts ...CanonicalHistoryOption) *CanonicalHistory {
@@ -35,14 +42,15 @@ func NewCanonicalHistory(h HistoryAccessor, cc CanonicalChecker, cs CurrentSlott
 }
 
 type CanonicalHistory struct {
-	h     HistoryAccessor
-	cc    CanonicalChecker
-	cs    CurrentSlotter
-	cache CachedGetter
+	h       HistoryAccessor
+	cc      CanonicalChecker
+	cs      CurrentSlotter
+	cache   CachedGetter
+	limiter *ReplayLimiter
 }
 
 func (c *CanonicalHistory) ReplayerForSlot(target primitives.Slot) Replayer {
-	return &stateReplayer{chainer: c, method: forSlot, target: target}
+	return &stateReplayer{chainer: c, method: forSlot, target: target, limiter: c.limiter}
 }
 
 func (c *CanonicalHistory) BlockRootForSlot(ctx context.Context, target primitives.Slot) ([32]byte, error) {
@@ -6,13 +6,12 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
 )
 
 var (
-	// hotStateCacheSize defines the max number of hot state this can cache.
-	hotStateCacheSize = 32
 	// Metrics
 	hotStateCacheHit = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "hot_state_cache_hit",
@@ -30,10 +29,11 @@ type hotStateCache struct {
 	lock  sync.RWMutex
 }
 
-// newHotStateCache initializes the map and underlying cache.
+// newHotStateCache initializes the map and underlying cache, sized by the configured
+// state by root cache size.
 func newHotStateCache() *hotStateCache {
 	return &hotStateCache{
-		cache: lruwrpr.New(hotStateCacheSize),
+		cache: lruwrpr.New(cache.ConfiguredSizes().StateByRoot),
 	}
 }
 
@@ -25,4 +25,23 @@ var (
 			Help: "Time it took to replay to slot",
 		},
 	)
+	replaysActive = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "state_replays_active",
+			Help: "The number of state replays running",
+		},
+	)
+	replaysQueued = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "state_replays_queued",
+			Help: "The number of state replays waiting to run",
+		},
+	)
+	remoteStateFetchCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "remote_state_fetch_total",
+			Help: "The number of states missing locally fetched from the remote state provider, by result",
+		},
+		[]string{"result"},
+	)
 )
@@ -0,0 +1,173 @@
+package stategen
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/singleflight"
+)
+
+// remoteStateCacheSize is the number of states fetched from the remote state provider kept in memory, so that
+// successive queries of the same deep state are answered without fetching it again.
+const remoteStateCacheSize = 2
+
+// RemoteStateProvider provides the states which are missing locally, such as those pruned or before the origin of
+// a checkpoint sync.
+type RemoteStateProvider interface {
+	StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// WithRemoteStateProvider gives stategen a provider of the states it cannot regenerate from the database, so that
+// nodes with a light footprint can answer occasional queries of deep states. Only the states of API queries are
+// fetched, so that consensus never waits on the provider.
+func WithRemoteStateProvider(p RemoteStateProvider) Option {
+	return func(sg *State) {
+		sg.remoteStates = &remoteStates{
+			provider: p,
+			cache:    lruwrpr.New(remoteStateCacheSize),
+		}
+	}
+}
+
+// remoteStates caches the states fetched from a remote state provider and deduplicates the fetches in flight.
+type remoteStates struct {
+	provider RemoteStateProvider
+	cache    *lru.Cache
+	fetches  singleflight.Group
+}
+
+// stateByBlockRoot returns a copy of the state of the block root, fetching it once for all the concurrent callers when
+// it is not cached. The fetch is not interrupted when a caller gives up, as its state is cached for the next query.
+func (r *remoteStates) stateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	if st, ok := r.cache.Get(blockRoot); ok {
+		remoteStateFetchCount.WithLabelValues("cached").Inc()
+		return st.(state.BeaconState).Copy(), nil
+	}
+	ch := r.fetches.DoChan(string(blockRoot[:]), func() (interface{}, error) {
+		st, err := r.provider.StateByBlockRoot(context.WithoutCancel(ctx), blockRoot)
+		if err != nil {
+			remoteStateFetchCount.WithLabelValues("failure").Inc()
+			return nil, err
+		}
+		remoteStateFetchCount.WithLabelValues("success").Inc()
+		r.cache.Add(blockRoot, st)
+		return st, nil
+	})
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.(state.BeaconState).Copy(), nil
+	}
+}
+
+// blockStateFetcher is the subset of the Beacon API client used by BeaconAPIStateProvider.
+type blockStateFetcher interface {
+	GetBlock(ctx context.Context, blockId beacon.StateOrBlockId) ([]byte, error)
+	GetState(ctx context.Context, stateId beacon.StateOrBlockId) ([]byte, error)
+}
+
+// BeaconAPIStateProvider fetches states from the Beacon API of another beacon node. As the node is not trusted, the
+// block is checked to have the requested root and the state to have the state root of the block.
+type BeaconAPIStateProvider struct {
+	client blockStateFetcher
+}
+
+// NewBeaconAPIStateProvider returns a provider of the states of the beacon node of the client.
+func NewBeaconAPIStateProvider(c *beacon.Client) *BeaconAPIStateProvider {
+	return &BeaconAPIStateProvider{client: c}
+}
+
+// StateByBlockRoot fetches the block with the root, then its post state by its state root, and verifies both.
+func (p *BeaconAPIStateProvider) StateByBlockRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.BeaconAPIStateProvider.StateByBlockRoot")
+	defer span.End()
+
+	bb, err := p.client.GetBlock(ctx, beacon.IdFromRoot(blockRoot))
+	if err != nil {
+		return nil, err
+	}
+	bvu, err := detect.FromBlock(bb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect the fork of the block")
+	}
+	b, err := bvu.UnmarshalBeaconBlock(bb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	if err := blocks.BeaconBlockIsNil(b); err != nil {
+		return nil, err
+	}
+	r, err := b.Block().HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	if r != blockRoot {
+		return nil, fmt.Errorf("block root %#x does not match the requested root %#x", r, blockRoot)
+	}
+
+	stateRoot := b.Block().StateRoot()
+	sb, err := p.client.GetState(ctx, beacon.IdFromRoot(stateRoot))
+	if err != nil {
+		return nil, err
+	}
+	svu, err := detect.FromState(sb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect the fork of the state")
+	}
+	st, err := svu.UnmarshalBeaconState(sb)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal state")
+	}
+	sr, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute state root")
+	}
+	if sr != stateRoot {
+		return nil, fmt.Errorf("state root %#x does not match the state root %#x of the block", sr, stateRoot)
+	}
+	return st, nil
+}
+
+// isMissingStateErr returns whether the error of regenerating a state means that the blocks or states needed are
+// missing from the database, as opposed to a failure of the replay.
+func isMissingStateErr(err error) bool {
+	return stderrors.Is(err, ErrNoDataForSlot) ||
+		stderrors.Is(err, errUnknownBlock) ||
+		stderrors.Is(err, errUnknownBoundaryState) ||
+		stderrors.Is(err, blocks.ErrNilSignedBeaconBlock)
+}
+
+// useRemoteStates returns whether the state of a root which could not be regenerated with loadErr is fetched from the
+// remote state provider. Only API queries fall back to the provider, as the roots of consensus messages come from
+// peers and must not make the node wait on, or flood, the provider.
+func (s *State) useRemoteStates(ctx context.Context, loadErr error) bool {
+	return s.remoteStates != nil && replayPriority(ctx) == ReplayPriorityAPI && isMissingStateErr(loadErr)
+}
+
+// remoteStateByRoot fetches the state from the remote state provider after failing to regenerate it with loadErr.
+func (s *State) remoteStateByRoot(ctx context.Context, blockRoot [32]byte, loadErr error) (state.BeaconState, error) {
+	log.WithError(loadErr).WithField("blockRoot", fmt.Sprintf("%#x", blockRoot)).Debug("Fetching state missing locally from the remote state provider")
+	st, err := s.remoteStates.stateByBlockRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, stderrors.Join(loadErr, errors.Wrap(err, "could not fetch state from the remote state provider"))
+	}
+	log.WithFields(logrus.Fields{
+		"blockRoot": fmt.Sprintf("%#x", blockRoot),
+		"slot":      st.Slot(),
+	}).Info("Fetched state missing locally from the remote state provider")
+	return st, nil
+}
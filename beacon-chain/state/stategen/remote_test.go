@@ -0,0 +1,174 @@
+package stategen
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	testDB "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+type mockBlockStateFetcher struct {
+	blocks map[beacon.StateOrBlockId][]byte
+	states map[beacon.StateOrBlockId][]byte
+}
+
+func (m *mockBlockStateFetcher) GetBlock(_ context.Context, id beacon.StateOrBlockId) ([]byte, error) {
+	b, ok := m.blocks[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return b, nil
+}
+
+func (m *mockBlockStateFetcher) GetState(_ context.Context, id beacon.StateOrBlockId) ([]byte, error) {
+	s, ok := m.states[id]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return s, nil
+}
+
+func TestBeaconAPIStateProvider_StateByBlockRoot(t *testing.T) {
+	ctx := context.Background()
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetFork(&ethpb.Fork{
+		PreviousVersion: params.BeaconConfig().GenesisForkVersion,
+		CurrentVersion:  params.BeaconConfig().GenesisForkVersion,
+	}))
+	require.NoError(t, st.SetSlot(10))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	sb, err := st.MarshalSSZ()
+	require.NoError(t, err)
+
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = 10
+	blk.Block.StateRoot = stateRoot[:]
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	bb, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	fetcher := &mockBlockStateFetcher{
+		blocks: map[beacon.StateOrBlockId][]byte{beacon.IdFromRoot(blockRoot): bb},
+		states: map[beacon.StateOrBlockId][]byte{beacon.IdFromRoot(stateRoot): sb},
+	}
+	p := &BeaconAPIStateProvider{client: fetcher}
+
+	got, err := p.StateByBlockRoot(ctx, blockRoot)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), got.Slot())
+
+	t.Run("block root mismatch", func(t *testing.T) {
+		otherRoot := [32]byte{'a'}
+		fetcher.blocks[beacon.IdFromRoot(otherRoot)] = bb
+		_, err := p.StateByBlockRoot(ctx, otherRoot)
+		require.ErrorContains(t, "does not match the requested root", err)
+	})
+	t.Run("state root mismatch", func(t *testing.T) {
+		require.NoError(t, st.SetSlot(11))
+		tampered, err := st.MarshalSSZ()
+		require.NoError(t, err)
+		fetcher.states[beacon.IdFromRoot(stateRoot)] = tampered
+		_, err = p.StateByBlockRoot(ctx, blockRoot)
+		require.ErrorContains(t, "does not match the state root", err)
+	})
+}
+
+type mockRemoteStateProvider struct {
+	states  map[[32]byte]state.BeaconState
+	fetches int
+	release chan struct{}
+}
+
+func (m *mockRemoteStateProvider) StateByBlockRoot(_ context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	m.fetches++
+	if m.release != nil {
+		<-m.release
+	}
+	st, ok := m.states[blockRoot]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return st, nil
+}
+
+func TestStateByRoot_RemoteStateProvider(t *testing.T) {
+	ctx := WithReplayPriority(context.Background(), ReplayPriorityAPI)
+	beaconDB := testDB.SetupDB(t)
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(10))
+	root := [32]byte{'a'}
+
+	_, err = New(beaconDB, doublylinkedtree.New()).StateByRoot(ctx, root)
+	require.ErrorIs(t, err, errUnknownBlock)
+
+	remote := &mockRemoteStateProvider{states: map[[32]byte]state.BeaconState{root: st}}
+	service := New(beaconDB, doublylinkedtree.New(), WithRemoteStateProvider(remote))
+
+	// The states needed by consensus are never fetched.
+	_, err = service.StateByRoot(context.Background(), root)
+	require.ErrorIs(t, err, errUnknownBlock)
+	assert.Equal(t, 0, remote.fetches)
+
+	got, err := service.StateByRoot(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), got.Slot())
+
+	// The fetched state is cached.
+	got, err = service.StateByRoot(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(10), got.Slot())
+	assert.Equal(t, 1, remote.fetches)
+
+	_, err = service.StateByRoot(ctx, [32]byte{'b'})
+	require.ErrorIs(t, err, errUnknownBlock)
+	require.ErrorContains(t, "could not fetch state from the remote state provider", err)
+}
+
+func TestRemoteStates_DeduplicatesFetches(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	root := [32]byte{'a'}
+	remote := &mockRemoteStateProvider{
+		states:  map[[32]byte]state.BeaconState{root: st},
+		release: make(chan struct{}),
+	}
+	r := &remoteStates{provider: remote, cache: lruwrpr.New(remoteStateCacheSize)}
+
+	// A caller giving up does not fail the fetch of the others.
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = r.stateByBlockRoot(canceled, root)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var wg sync.WaitGroup
+	errs := make([]error, 3)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = r.stateByBlockRoot(context.Background(), root)
+		}(i)
+	}
+	close(remote.release)
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, remote.fetches)
+}
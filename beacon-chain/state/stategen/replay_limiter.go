@@ -0,0 +1,195 @@
+package stategen
+
+import (
+	"container/list"
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+const (
+	// maxConcurrentReplays is the number of state replays running at the same time.
+	maxConcurrentReplays = 4
+	// maxConcurrentAPIReplays is the number of replays requested by API queries running at the same time, so that
+	// replays needed by consensus never wait behind API replays.
+	maxConcurrentAPIReplays = 2
+)
+
+// ReplayPriority is the priority of a state replay. Replays of higher priority are started first.
+type ReplayPriority int
+
+const (
+	// ReplayPriorityConsensus is the priority of replays needed to process blocks and attestations, the priority
+	// of replays which are not marked otherwise.
+	ReplayPriorityConsensus ReplayPriority = iota
+	// ReplayPriorityAPI is the priority of replays requested by API queries.
+	ReplayPriorityAPI
+)
+
+// String returns the name of the priority.
+func (p ReplayPriority) String() string {
+	switch p {
+	case ReplayPriorityConsensus:
+		return "consensus"
+	case ReplayPriorityAPI:
+		return "api"
+	default:
+		return "unknown"
+	}
+}
+
+type replayPriorityKey struct{}
+
+// WithReplayPriority returns a context marking the state replays made with it with the given priority.
+func WithReplayPriority(ctx context.Context, p ReplayPriority) context.Context {
+	return context.WithValue(ctx, replayPriorityKey{}, p)
+}
+
+func replayPriority(ctx context.Context) ReplayPriority {
+	if p, ok := ctx.Value(replayPriorityKey{}).(ReplayPriority); ok {
+		return p
+	}
+	return ReplayPriorityConsensus
+}
+
+// Replay describes a state replay which is running or waiting to run.
+type Replay struct {
+	Priority ReplayPriority
+	// Slot is the slot of the replayed state.
+	Slot primitives.Slot
+	// Since is the time the replay started, or was queued for replays waiting to run.
+	Since time.Time
+}
+
+// ReplayQueue is a snapshot of the state replays of the node.
+type ReplayQueue struct {
+	Active []Replay
+	Queued []Replay
+}
+
+// ReplayQueueFetcher returns the state replays of the node.
+type ReplayQueueFetcher interface {
+	ReplayQueue() *ReplayQueue
+}
+
+// ReplayLimiter limits the number of state replays running at the same time, as replays are expensive and
+// API queries could otherwise starve block processing. Waiting replays are started by priority, then in the
+// order they were queued.
+type ReplayLimiter struct {
+	lock      sync.Mutex
+	active    map[*replayTicket]struct{}
+	activeAPI int
+	// queues holds a queue of waiting replays per priority.
+	queues [ReplayPriorityAPI + 1]*list.List
+}
+
+type replayTicket struct {
+	Replay
+	ready chan struct{}
+}
+
+// NewReplayLimiter initializes a replay limiter.
+func NewReplayLimiter() *ReplayLimiter {
+	l := &ReplayLimiter{active: make(map[*replayTicket]struct{})}
+	for i := range l.queues {
+		l.queues[i] = list.New()
+	}
+	return l
+}
+
+// acquire waits until a replay of the given slot can run, with the priority of the context, and returns the
+// function to call once the replay is done. A nil limiter does not limit replays.
+func (l *ReplayLimiter) acquire(ctx context.Context, slot primitives.Slot) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	t := &replayTicket{
+		Replay: Replay{Priority: replayPriority(ctx), Slot: slot, Since: prysmTime.Now()},
+		ready:  make(chan struct{}),
+	}
+	l.lock.Lock()
+	e := l.queues[t.Priority].PushBack(t)
+	l.dispatchNoLock()
+	l.lock.Unlock()
+
+	select {
+	case <-t.ready:
+		return func() { l.release(t) }, nil
+	case <-ctx.Done():
+		l.lock.Lock()
+		defer l.lock.Unlock()
+		select {
+		case <-t.ready:
+			// The replay was started while the context was canceled.
+			l.releaseNoLock(t)
+		default:
+			l.queues[t.Priority].Remove(e)
+		}
+		return nil, ctx.Err()
+	}
+}
+
+func (l *ReplayLimiter) release(t *replayTicket) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.releaseNoLock(t)
+}
+
+func (l *ReplayLimiter) releaseNoLock(t *replayTicket) {
+	delete(l.active, t)
+	if t.Priority == ReplayPriorityAPI {
+		l.activeAPI--
+	}
+	l.dispatchNoLock()
+}
+
+// dispatchNoLock starts the waiting replays allowed to run.
+func (l *ReplayLimiter) dispatchNoLock() {
+	for p, q := range l.queues {
+		for len(l.active) < maxConcurrentReplays && q.Len() > 0 {
+			if ReplayPriority(p) == ReplayPriorityAPI && l.activeAPI >= maxConcurrentAPIReplays {
+				break
+			}
+			t, ok := q.Remove(q.Front()).(*replayTicket)
+			if !ok {
+				continue
+			}
+			if t.Priority == ReplayPriorityAPI {
+				l.activeAPI++
+			}
+			t.Since = prysmTime.Now()
+			l.active[t] = struct{}{}
+			close(t.ready)
+		}
+	}
+	replaysActive.Set(float64(len(l.active)))
+	replaysQueued.Set(float64(l.queues[ReplayPriorityConsensus].Len() + l.queues[ReplayPriorityAPI].Len()))
+}
+
+// ReplayQueue returns the running and waiting replays.
+func (l *ReplayLimiter) ReplayQueue() *ReplayQueue {
+	q := &ReplayQueue{Active: make([]Replay, 0), Queued: make([]Replay, 0)}
+	if l == nil {
+		return q
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for t := range l.active {
+		q.Active = append(q.Active, t.Replay)
+	}
+	sort.Slice(q.Active, func(i, j int) bool {
+		return q.Active[i].Since.Before(q.Active[j].Since)
+	})
+	for _, pq := range l.queues {
+		for e := pq.Front(); e != nil; e = e.Next() {
+			if t, ok := e.Value.(*replayTicket); ok {
+				q.Queued = append(q.Queued, t.Replay)
+			}
+		}
+	}
+	return q
+}
@@ -0,0 +1,110 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestReplayLimiter_Priority(t *testing.T) {
+	l := NewReplayLimiter()
+	ctx := context.Background()
+	apiCtx := WithReplayPriority(ctx, ReplayPriorityAPI)
+
+	// API replays can only take part of the replays.
+	var releases []func()
+	for i := 0; i < maxConcurrentAPIReplays; i++ {
+		release, err := l.acquire(apiCtx, primitives.Slot(i))
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+	apiStarted := make(chan func())
+	go func() {
+		release, err := l.acquire(apiCtx, 100)
+		if err == nil {
+			apiStarted <- release
+		}
+	}()
+	require.NoError(t, waitForQueued(l, 1))
+
+	// Consensus replays take the remaining replays.
+	for i := maxConcurrentAPIReplays; i < maxConcurrentReplays; i++ {
+		release, err := l.acquire(ctx, primitives.Slot(i))
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+	consensusStarted := make(chan func())
+	go func() {
+		release, err := l.acquire(ctx, 200)
+		if err == nil {
+			consensusStarted <- release
+		}
+	}()
+	require.NoError(t, waitForQueued(l, 2))
+
+	q := l.ReplayQueue()
+	assert.Equal(t, maxConcurrentReplays, len(q.Active))
+	require.Equal(t, 2, len(q.Queued))
+	assert.Equal(t, ReplayPriorityConsensus, q.Queued[0].Priority)
+	assert.Equal(t, primitives.Slot(200), q.Queued[0].Slot)
+	assert.Equal(t, ReplayPriorityAPI, q.Queued[1].Priority)
+
+	// A freed replay goes to the queued consensus replay, although the API replay was queued first.
+	releases[0]()
+	release := <-consensusStarted
+	select {
+	case <-apiStarted:
+		t.Fatal("API replay started before a replay was freed")
+	case <-time.After(50 * time.Millisecond):
+	}
+	release()
+	release = <-apiStarted
+	release()
+	for _, release := range releases[1:] {
+		release()
+	}
+	q = l.ReplayQueue()
+	assert.Equal(t, 0, len(q.Active))
+	assert.Equal(t, 0, len(q.Queued))
+}
+
+func TestReplayLimiter_Canceled(t *testing.T) {
+	l := NewReplayLimiter()
+	var releases []func()
+	for i := 0; i < maxConcurrentReplays; i++ {
+		release, err := l.acquire(context.Background(), primitives.Slot(i))
+		require.NoError(t, err)
+		releases = append(releases, release)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := l.acquire(ctx, 100)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 0, len(l.ReplayQueue().Queued))
+	for _, release := range releases {
+		release()
+	}
+
+	// A nil limiter does not limit replays.
+	var none *ReplayLimiter
+	release, err := none.acquire(context.Background(), 1)
+	require.NoError(t, err)
+	release()
+}
+
+func waitForQueued(l *ReplayLimiter, n int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	for len(l.ReplayQueue().Queued) < n {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Millisecond):
+		}
+	}
+	return nil
+}
@@ -66,6 +66,7 @@ type stateReplayer struct {
 	target  primitives.Slot
 	method  retrievalMethod
 	chainer chainer
+	limiter *ReplayLimiter
 }
 
 // ReplayBlocks applies all the blocks that were accumulated when building the Replayer.
@@ -74,9 +75,14 @@ func (rs *stateReplayer) ReplayBlocks(ctx context.Context) (state.BeaconState, e
 	ctx, span := trace.StartSpan(ctx, "stateGen.stateReplayer.ReplayBlocks")
 	defer span.End()
 
+	release, err := rs.limiter.acquire(ctx, rs.target)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not wait to replay blocks")
+	}
+	defer release()
+
 	var s state.BeaconState
 	var descendants []interfaces.ReadOnlySignedBeaconBlock
-	var err error
 	switch rs.method {
 	case forSlot:
 		s, descendants, err = rs.chainer.chainForSlot(ctx, rs.target)
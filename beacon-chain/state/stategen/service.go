@@ -56,6 +56,8 @@ type State struct {
 	avb                     coverage.AvailableBlocker
 	migrationLock           *sync.Mutex
 	fc                      forkchoice.ForkChoicer
+	replayLimiter           *ReplayLimiter
+	remoteStates            *remoteStates
 }
 
 // This tracks the config in the event of long non-finality,
@@ -101,6 +103,7 @@ func New(beaconDB db.NoHeadAccessDatabase, fc forkchoice.ForkChoicer, opts ...Op
 		},
 		migrationLock: new(sync.Mutex),
 		fc:            fc,
+		replayLimiter: NewReplayLimiter(),
 	}
 	for _, o := range opts {
 		o(s)
@@ -111,6 +114,11 @@ func New(beaconDB db.NoHeadAccessDatabase, fc forkchoice.ForkChoicer, opts ...Op
 	return s
 }
 
+// ReplayLimiter returns the limiter of the state replays, which replays of the canonical history share.
+func (s *State) ReplayLimiter() *ReplayLimiter {
+	return s.replayLimiter
+}
+
 // Resume resumes a new state management object from previously saved finalized checkpoint in DB.
 func (s *State) Resume(ctx context.Context, fState state.BeaconState) (state.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "stateGen.Resume")
@@ -3,15 +3,13 @@ package stateutil
 import (
 	"bytes"
 	"encoding/binary"
-	"runtime"
-	"sync"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/gohashtree"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
-	"github.com/prysmaticlabs/prysm/v5/crypto/hash/htr"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
-	"github.com/sirupsen/logrus"
 )
 
 const (
@@ -23,6 +21,10 @@ const (
 	// NumOfRoots = 2 ^ (TreeDepth)
 	// 8 = 2 ^ 3
 	validatorTreeDepth = 3
+
+	// Number of validators hashed together by a single worker. The field roots
+	// of a full shard fill the scratch buffers of the worker's cache.
+	validatorsPerShard = shardLeaves / validatorFieldRoots
 )
 
 // ValidatorRegistryRoot computes the HashTreeRoot Merkleization of
@@ -38,7 +40,7 @@ func validatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
 		return [32]byte{}, err
 	}
 
-	validatorsRootsRoot, err := ssz.BitwiseMerkleize(roots, uint64(len(roots)), fieldparams.ValidatorRegistryLimit)
+	validatorsRootsRoot, err := parallelMerkleize(roots, fieldparams.ValidatorRegistryLimit)
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not compute validator registry merkleization")
 	}
@@ -54,54 +56,74 @@ func validatorRegistryRoot(validators []*ethpb.Validator) ([32]byte, error) {
 	return res, nil
 }
 
-func hashValidatorHelper(validators []*ethpb.Validator, roots [][32]byte, j int, groupSize int, wg *sync.WaitGroup) {
-	defer wg.Done()
-	for i := 0; i < groupSize; i++ {
-		fRoots, err := ValidatorFieldRoots(validators[j*groupSize+i])
-		if err != nil {
-			logrus.WithError(err).Error("could not get validator field roots")
-			return
+// validatorShardRoots computes the roots of the given validators into out, using
+// the scratch buffers of the worker's cache. Public keys of the whole shard are
+// hashed in a single vectorized call before the validator trees are built level
+// by level.
+func validatorShardRoots(validators []*ethpb.Validator, out [][32]byte, c *shardCache) error {
+	n := len(validators)
+	fieldRoots := c.a[:n*validatorFieldRoots]
+	// A 48 byte public key is packed into 2 chunks.
+	pubkeyChunks := c.b[:2*n]
+	for i, v := range validators {
+		var pubkey [48]byte
+		if v != nil {
+			pubkey = bytesutil.ToBytes48(v.PublicKey)
+		}
+		pubkeyChunks[2*i] = [32]byte{}
+		pubkeyChunks[2*i+1] = [32]byte{}
+		copy(pubkeyChunks[2*i][:], pubkey[:32])
+		copy(pubkeyChunks[2*i+1][:], pubkey[32:])
+	}
+	pubkeyRoots := c.b[2*n : 3*n]
+	if err := gohashtree.Hash(pubkeyRoots, pubkeyChunks); err != nil {
+		return err
+	}
+	for i, v := range validators {
+		dst := fieldRoots[i*validatorFieldRoots : (i+1)*validatorFieldRoots]
+		if v == nil {
+			clear(dst)
+			continue
+		}
+		dst[0] = pubkeyRoots[i]
+		putValidatorFieldRoots(v, dst)
+	}
+
+	// A validator's tree can represented with a depth of 3. As log2(8) = 3
+	// Using this property we can lay out all the individual fields of a
+	// validator and hash them one level at a time for the whole shard.
+	layer, next := fieldRoots, c.b[:0]
+	for i := 0; i < validatorTreeDepth; i++ {
+		next = next[:len(layer)/2]
+		if i == validatorTreeDepth-1 {
+			next = out
 		}
-		for k, root := range fRoots {
-			roots[(j*groupSize+i)*validatorFieldRoots+k] = root
+		if err := gohashtree.Hash(next, layer); err != nil {
+			return err
 		}
+		layer, next = next, layer[:0]
 	}
+	return nil
 }
 
 // OptimizedValidatorRoots uses an optimized routine with gohashtree in order to
-// derive a list of validator roots from a list of validator objects.
+// derive a list of validator roots from a list of validator objects. The registry
+// is split into shards which are processed by a pool of workers, each of which
+// reuses its own scratch buffers across the shards it handles.
 func OptimizedValidatorRoots(validators []*ethpb.Validator) ([][32]byte, error) {
 	// Exit early if no validators are provided.
 	if len(validators) == 0 {
 		return [][32]byte{}, nil
 	}
-	wg := sync.WaitGroup{}
-	n := runtime.GOMAXPROCS(0)
-	rootsSize := len(validators) * validatorFieldRoots
-	groupSize := len(validators) / n
-	roots := make([][32]byte, rootsSize)
-	wg.Add(n - 1)
-	for j := 0; j < n-1; j++ {
-		go hashValidatorHelper(validators, roots, j, groupSize, &wg)
-	}
-	for i := (n - 1) * groupSize; i < len(validators); i++ {
-		fRoots, err := ValidatorFieldRoots(validators[i])
-		if err != nil {
-			return [][32]byte{}, errors.Wrap(err, "could not compute validators merkleization")
-		}
-		for k, root := range fRoots {
-			roots[i*validatorFieldRoots+k] = root
-		}
-	}
-	wg.Wait()
-
-	// A validator's tree can represented with a depth of 3. As log2(8) = 3
-	// Using this property we can lay out all the individual fields of a
-	// validator and hash them in single level using our vectorized routine.
-	for i := 0; i < validatorTreeDepth; i++ {
-		// Overwrite input lists as we are hashing by level
-		// and only need the highest level to proceed.
-		roots = htr.VectorizedSha256(roots)
+	roots := make([][32]byte, len(validators))
+	numShards := (len(validators) + validatorsPerShard - 1) / validatorsPerShard
+	err := runShards(numShards, func(shard int, c *shardCache) error {
+		start := shard * validatorsPerShard
+		end := min(start+validatorsPerShard, len(validators))
+		return validatorShardRoots(validators[start:end], roots[start:end], c)
+	})
+	if err != nil {
+		return [][32]byte{}, errors.Wrap(err, "could not compute validators merkleization")
 	}
 	return roots, nil
 }
@@ -3,9 +3,12 @@ package stateutil
 import (
 	"reflect"
 	"strings"
-	"sync"
 	"testing"
 
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
 	mathutil "github.com/prysmaticlabs/prysm/v5/math"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -33,27 +36,42 @@ func TestValidatorConstants(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestHashValidatorHelper(t *testing.T) {
-	wg := sync.WaitGroup{}
-	wg.Add(1)
-	v := &ethpb.Validator{}
-	valList := make([]*ethpb.Validator, 10*validatorFieldRoots)
+func TestOptimizedValidatorRoots(t *testing.T) {
+	// Span several shards, with the last one only partially filled.
+	valList := make([]*ethpb.Validator, 2*validatorsPerShard+13)
 	for i := range valList {
-		valList[i] = v
-	}
-	roots := make([][32]byte, len(valList))
-	hashValidatorHelper(valList, roots, 2, 2, &wg)
-	for i := 0; i < 4*validatorFieldRoots; i++ {
-		require.Equal(t, [32]byte{}, roots[i])
+		valList[i] = &ethpb.Validator{
+			PublicKey:             bytesutil.PadTo([]byte{byte(i), byte(i >> 8)}, 48),
+			WithdrawalCredentials: bytesutil.PadTo([]byte{byte(i)}, 32),
+			EffectiveBalance:      uint64(i),
+			Slashed:               i%2 == 0,
+			ExitEpoch:             primitives.Epoch(i),
+		}
 	}
-	emptyValRoots, err := ValidatorFieldRoots(v)
+	valList[7] = nil
+	roots, err := OptimizedValidatorRoots(valList)
 	require.NoError(t, err)
-	for i := 4; i < 6; i++ {
-		for j := 0; j < validatorFieldRoots; j++ {
-			require.Equal(t, emptyValRoots[j], roots[i*validatorFieldRoots+j])
+	require.Equal(t, len(valList), len(roots))
+	for i, v := range valList {
+		if v == nil {
+			continue
 		}
+		want, err := ValidatorRootWithHasher(v)
+		require.NoError(t, err)
+		require.Equal(t, want, roots[i])
 	}
-	for i := 6 * validatorFieldRoots; i < 10*validatorFieldRoots; i++ {
-		require.Equal(t, [32]byte{}, roots[i])
+}
+
+func TestParallelMerkleize(t *testing.T) {
+	for _, n := range []int{0, 1, shardLeaves - 1, shardLeaves, 3*shardLeaves + 5} {
+		chunks := make([][32]byte, n)
+		for i := range chunks {
+			chunks[i] = [32]byte{byte(i), byte(i >> 8), byte(i >> 16)}
+		}
+		want, err := ssz.BitwiseMerkleize(append([][32]byte{}, chunks...), uint64(n), fieldparams.ValidatorRegistryLimit)
+		require.NoError(t, err)
+		got, err := parallelMerkleize(chunks, fieldparams.ValidatorRegistryLimit)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
 	}
 }
@@ -0,0 +1,116 @@
+package stateutil
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prysmaticlabs/gohashtree"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/hash/htr"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+)
+
+const (
+	// Depth of the subtree merkleized by a single shard.
+	shardDepth = 14
+	// Number of leaves in a single shard, 2 ^ shardDepth.
+	shardLeaves = 1 << shardDepth
+)
+
+// shardCache holds the scratch buffers used by a single worker. The buffers
+// are reused across every shard the worker processes so that hashing a large
+// list does not allocate a new slice per tree layer.
+type shardCache struct {
+	a [][32]byte
+	b [][32]byte
+}
+
+func newShardCache() *shardCache {
+	return &shardCache{
+		a: make([][32]byte, 0, shardLeaves+1),
+		b: make([][32]byte, 0, shardLeaves+1),
+	}
+}
+
+// merkleize returns the root of the subtree of the given depth built on
+// the provided chunks, padding with zero hashes where needed.
+func (c *shardCache) merkleize(chunks [][32]byte, depth uint8) [32]byte {
+	if len(chunks) == 0 {
+		return trie.ZeroHashes[depth]
+	}
+	layer := append(c.a[:0], chunks...)
+	next := c.b[:0]
+	for i := uint8(0); i < depth; i++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, trie.ZeroHashes[i])
+		}
+		next = next[:len(layer)/2]
+		if err := gohashtree.Hash(next, layer); err != nil {
+			panic(err)
+		}
+		layer, next = next, layer[:0]
+	}
+	return layer[0]
+}
+
+// runShards executes fn for every shard in [0, numShards) using a pool of
+// at most GOMAXPROCS workers. Each worker owns a shardCache which is handed
+// to fn for every shard it processes. The first error encountered is returned.
+func runShards(numShards int, fn func(shard int, c *shardCache) error) error {
+	workers := min(runtime.GOMAXPROCS(0), numShards)
+	var (
+		next     atomic.Int64
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			c := newShardCache()
+			for {
+				shard := int(next.Add(1) - 1)
+				if shard >= numShards {
+					return
+				}
+				if err := fn(shard, c); err != nil {
+					errOnce.Do(func() { firstErr = err })
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// parallelMerkleize computes the root of the provided chunks padded up to the
+// given limit. Lists larger than a single shard are split into fixed size
+// subtrees which are merkleized concurrently before the resulting shard roots
+// are hashed up to the full depth of the tree.
+func parallelMerkleize(chunks [][32]byte, limit uint64) ([32]byte, error) {
+	if len(chunks) <= shardLeaves || uint64(len(chunks)) > limit {
+		return ssz.BitwiseMerkleize(chunks, uint64(len(chunks)), limit)
+	}
+	numShards := (len(chunks) + shardLeaves - 1) / shardLeaves
+	shardRoots := make([][32]byte, numShards)
+	err := runShards(numShards, func(shard int, c *shardCache) error {
+		start := shard * shardLeaves
+		end := min(start+shardLeaves, len(chunks))
+		shardRoots[shard] = c.merkleize(chunks[start:end], shardDepth)
+		return nil
+	})
+	if err != nil {
+		return [32]byte{}, err
+	}
+	depth := ssz.Depth(limit)
+	for i := uint8(shardDepth); i < depth; i++ {
+		if len(shardRoots)%2 == 1 {
+			shardRoots = append(shardRoots, trie.ZeroHashes[i])
+		}
+		shardRoots = htr.VectorizedSha256(shardRoots)
+	}
+	return shardRoots[0], nil
+}
@@ -26,39 +26,36 @@ func ValidatorFieldRoots(validator *ethpb.Validator) ([][32]byte, error) {
 	var fieldRoots [][32]byte
 	if validator != nil {
 		pubkey := bytesutil.ToBytes48(validator.PublicKey)
-		withdrawCreds := bytesutil.ToBytes32(validator.WithdrawalCredentials)
-		var effectiveBalanceBuf [32]byte
-		binary.LittleEndian.PutUint64(effectiveBalanceBuf[:8], validator.EffectiveBalance)
-		// Slashed.
-		var slashBuf [32]byte
-		if validator.Slashed {
-			slashBuf[0] = uint8(1)
-		} else {
-			slashBuf[0] = uint8(0)
-		}
-		var activationEligibilityBuf [32]byte
-		binary.LittleEndian.PutUint64(activationEligibilityBuf[:8], uint64(validator.ActivationEligibilityEpoch))
-
-		var activationBuf [32]byte
-		binary.LittleEndian.PutUint64(activationBuf[:8], uint64(validator.ActivationEpoch))
-
-		var exitBuf [32]byte
-		binary.LittleEndian.PutUint64(exitBuf[:8], uint64(validator.ExitEpoch))
-
-		var withdrawalBuf [32]byte
-		binary.LittleEndian.PutUint64(withdrawalBuf[:8], uint64(validator.WithdrawableEpoch))
-
 		// Public key.
 		pubKeyRoot, err := merkleizePubkey(pubkey[:])
 		if err != nil {
 			return [][32]byte{}, err
 		}
-		fieldRoots = [][32]byte{pubKeyRoot, withdrawCreds, effectiveBalanceBuf, slashBuf, activationEligibilityBuf,
-			activationBuf, exitBuf, withdrawalBuf}
+		fieldRoots = make([][32]byte, validatorFieldRoots)
+		fieldRoots[0] = pubKeyRoot
+		putValidatorFieldRoots(validator, fieldRoots)
 	}
 	return fieldRoots, nil
 }
 
+// putValidatorFieldRoots writes every field root of the validator apart from
+// the public key root into dst, which must hold validatorFieldRoots elements.
+func putValidatorFieldRoots(validator *ethpb.Validator, dst [][32]byte) {
+	dst[1] = bytesutil.ToBytes32(validator.WithdrawalCredentials)
+	for i := 2; i < validatorFieldRoots; i++ {
+		dst[i] = [32]byte{}
+	}
+	binary.LittleEndian.PutUint64(dst[2][:8], validator.EffectiveBalance)
+	// Slashed.
+	if validator.Slashed {
+		dst[3][0] = uint8(1)
+	}
+	binary.LittleEndian.PutUint64(dst[4][:8], uint64(validator.ActivationEligibilityEpoch))
+	binary.LittleEndian.PutUint64(dst[5][:8], uint64(validator.ActivationEpoch))
+	binary.LittleEndian.PutUint64(dst[6][:8], uint64(validator.ExitEpoch))
+	binary.LittleEndian.PutUint64(dst[7][:8], uint64(validator.WithdrawableEpoch))
+}
+
 // Uint64ListRootWithRegistryLimit computes the HashTreeRoot Merkleization of
 // a list of uint64 and mixed with registry limit.
 func Uint64ListRootWithRegistryLimit(balances []uint64) ([32]byte, error) {
@@ -66,7 +63,7 @@ func Uint64ListRootWithRegistryLimit(balances []uint64) ([32]byte, error) {
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not pack balances into chunks")
 	}
-	balancesRootsRoot, err := ssz.BitwiseMerkleize(balancesChunks, uint64(len(balancesChunks)), ValidatorLimitForBalancesChunks())
+	balancesRootsRoot, err := parallelMerkleize(balancesChunks, ValidatorLimitForBalancesChunks())
 	if err != nil {
 		return [32]byte{}, errors.Wrap(err, "could not compute balances merkleization")
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stateutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 func BenchmarkUint64ListRootWithRegistryLimit(b *testing.B) {
@@ -20,3 +21,21 @@ func BenchmarkUint64ListRootWithRegistryLimit(b *testing.B) {
 		}
 	})
 }
+
+func BenchmarkValidatorRegistryRoot(b *testing.B) {
+	validators := make([]*ethpb.Validator, 1<<20)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             make([]byte, 48),
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      uint64(i),
+		}
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := stateutil.ValidatorRegistryRoot(validators); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
@@ -39,6 +40,8 @@ type Service struct {
 	batchImporter   batchImporter
 	blobStore       *filesystem.BlobStorage
 	initSyncWaiter  func() error
+	ws              *ethpb.Checkpoint
+	wsVerified      bool
 }
 
 var _ runtime.Service = (*Service)(nil)
@@ -136,6 +139,15 @@ func WithMinimumSlot(s primitives.Slot) ServiceOption {
 	}
 }
 
+// WithWeakSubjectivityCheckpoint sets the weak subjectivity checkpoint that the backfilled chain must include,
+// when the checkpoint is older than the checkpoint sync origin.
+func WithWeakSubjectivityCheckpoint(cp *ethpb.Checkpoint) ServiceOption {
+	return func(s *Service) error {
+		s.ws = cp
+		return nil
+	}
+}
+
 // NewService initializes the backfill Service. Like all implementations of the Service interface,
 // the service won't begin its runloop until Start() is called.
 func NewService(ctx context.Context, su *Store, bStore *filesystem.BlobStorage, cw startup.ClockWaiter, p p2p.P2P, pa PeerAssigner, opts ...ServiceOption) (*Service, error) {
@@ -191,7 +203,9 @@ func (s *Service) updateComplete() bool {
 	return false
 }
 
-func (s *Service) importBatches(ctx context.Context) {
+// importBatches imports the importable batches in order. It returns an error, after logging it, when the backfill
+// cannot make progress, in which case the service must stop.
+func (s *Service) importBatches(ctx context.Context) error {
 	importable := s.batchSeq.importable()
 	imported := 0
 	defer func() {
@@ -206,6 +220,14 @@ func (s *Service) importBatches(ctx context.Context) {
 		if len(ib.results) == 0 {
 			log.WithFields(ib.logFields()).Error("Batch with no results, skipping importer")
 		}
+		// Batches are verified to descend from the checkpoint sync origin, so the mismatch does not depend on the
+		// peers serving them and no retry can resolve it.
+		if err := s.verifyWeakSubjectivity(ib); err != nil {
+			log.WithError(err).WithFields(ib.logFields()).
+				Error("The checkpoint sync origin is not on the chain of the weak subjectivity checkpoint, stopping backfill. " +
+					"Checkpoint sync from a trusted source on the canonical chain, or fix --weak-subjectivity-checkpoint")
+			return err
+		}
 		_, err := s.batchImporter(ctx, current, ib, s.store)
 		if err != nil {
 			log.WithError(err).WithFields(ib.logFields()).Debug("Backfill batch failed to import")
@@ -225,6 +247,7 @@ func (s *Service) importBatches(ctx context.Context) {
 		Info("Backfill batches processed")
 
 	backfillRemainingBatches.Set(float64(nt))
+	return nil
 }
 
 func (s *Service) scheduleTodos() {
@@ -310,7 +333,9 @@ func (s *Service) Start() {
 		if s.updateComplete() {
 			return
 		}
-		s.importBatches(ctx)
+		if err := s.importBatches(ctx); err != nil {
+			return
+		}
 		batchesWaiting.Set(float64(s.batchSeq.countWithState(batchImportable)))
 		if err := s.batchSeq.moveMinimum(s.ms(s.clock.CurrentSlot())); err != nil {
 			log.WithError(err).Error("Non-recoverable error while adjusting backfill minimum slot")
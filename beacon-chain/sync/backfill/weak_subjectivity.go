@@ -0,0 +1,48 @@
+package backfill
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+var errWeakSubjectivityMismatch = errors.New("backfilled chain does not include the weak subjectivity checkpoint")
+
+// verifyWeakSubjectivity checks that a batch does not complete the backfill of the weak subjectivity checkpoint
+// epoch without including the checkpoint root. Batches are imported in descending slot order, each one connected
+// to the previously imported batch, so the root must have been found once a batch reaches the start of the epoch.
+// Checkpoints at or after the checkpoint sync origin are not backfilled and are verified by the blockchain service.
+func (s *Service) verifyWeakSubjectivity(b batch) error {
+	if s.ws == nil || s.wsVerified {
+		return nil
+	}
+	start, err := slots.EpochStart(s.ws.Epoch)
+	if err != nil {
+		return err
+	}
+	end := start + params.BeaconConfig().SlotsPerEpoch
+	if end >= primitives.Slot(s.store.status().OriginSlot) {
+		return nil
+	}
+	root := bytesutil.ToBytes32(s.ws.Root)
+	for _, blk := range b.results {
+		if blk.Root() != root {
+			continue
+		}
+		if slot := blk.Block().Slot(); slot < start || slot > end {
+			return errors.Wrapf(errWeakSubjectivityMismatch, "root %#x is at slot %d, outside of epoch %d", root, slot, s.ws.Epoch)
+		}
+		s.wsVerified = true
+		log.WithField("root", fmt.Sprintf("%#x", root)).WithField("epoch", s.ws.Epoch).
+			Info("Backfilled chain includes the weak subjectivity checkpoint")
+		return nil
+	}
+	if b.begin <= start {
+		return errors.Wrapf(errWeakSubjectivityMismatch, "root=%#x, epoch=%d", root, s.ws.Epoch)
+	}
+	return nil
+}
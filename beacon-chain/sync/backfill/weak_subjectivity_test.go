@@ -0,0 +1,98 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/proto/dbval"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+func TestVerifyWeakSubjectivity(t *testing.T) {
+	epoch := primitives.Epoch(4)
+	start, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	blk, err := setupTestBlock(start + 1)
+	require.NoError(t, err)
+	ws, err := blocks.NewROBlock(blk)
+	require.NoError(t, err)
+	other, err := setupTestBlock(start + 2)
+	require.NoError(t, err)
+	rob, err := blocks.NewROBlock(other)
+	require.NoError(t, err)
+
+	newService := func(cp *ethpb.Checkpoint) *Service {
+		return &Service{
+			store: &Store{bs: &dbval.BackfillStatus{OriginSlot: uint64(start + 10*spe)}},
+			ws:    cp,
+		}
+	}
+	cp := &ethpb.Checkpoint{Epoch: epoch, Root: ws.RootSlice()}
+
+	t.Run("disabled", func(t *testing.T) {
+		s := newService(nil)
+		require.NoError(t, s.verifyWeakSubjectivity(batch{begin: 0, results: verifiedROBlocks{rob}}))
+	})
+	t.Run("batch above the epoch", func(t *testing.T) {
+		s := newService(cp)
+		require.NoError(t, s.verifyWeakSubjectivity(batch{begin: start + 2*spe, results: verifiedROBlocks{rob}}))
+		require.Equal(t, false, s.wsVerified)
+	})
+	t.Run("root in batch", func(t *testing.T) {
+		s := newService(cp)
+		require.NoError(t, s.verifyWeakSubjectivity(batch{begin: start, results: verifiedROBlocks{ws, rob}}))
+		require.Equal(t, true, s.wsVerified)
+		require.NoError(t, s.verifyWeakSubjectivity(batch{begin: 0, results: verifiedROBlocks{rob}}))
+	})
+	t.Run("epoch backfilled without root", func(t *testing.T) {
+		s := newService(cp)
+		err := s.verifyWeakSubjectivity(batch{begin: start, results: verifiedROBlocks{rob}})
+		require.ErrorIs(t, err, errWeakSubjectivityMismatch)
+	})
+	t.Run("root outside of epoch", func(t *testing.T) {
+		s := newService(&ethpb.Checkpoint{Epoch: epoch + 2, Root: ws.RootSlice()})
+		err := s.verifyWeakSubjectivity(batch{begin: 0, results: verifiedROBlocks{ws}})
+		require.ErrorIs(t, err, errWeakSubjectivityMismatch)
+	})
+	t.Run("epoch not before origin", func(t *testing.T) {
+		s := newService(&ethpb.Checkpoint{Epoch: epoch + 10, Root: ws.RootSlice()})
+		require.NoError(t, s.verifyWeakSubjectivity(batch{begin: 0, results: verifiedROBlocks{rob}}))
+	})
+}
+
+func TestImportBatches_WeakSubjectivityMismatch(t *testing.T) {
+	epoch := primitives.Epoch(4)
+	start, err := slots.EpochStart(epoch)
+	require.NoError(t, err)
+	spe := params.BeaconConfig().SlotsPerEpoch
+
+	blk, err := setupTestBlock(start + 1)
+	require.NoError(t, err)
+	rob, err := blocks.NewROBlock(blk)
+	require.NoError(t, err)
+
+	b := batch{begin: start, end: start + spe, state: batchImportable, results: verifiedROBlocks{rob}}
+	s := &Service{
+		store:    &Store{bs: &dbval.BackfillStatus{OriginSlot: uint64(start + 10*spe)}},
+		ws:       &ethpb.Checkpoint{Epoch: epoch, Root: make([]byte, 32)},
+		clock:    startup.NewClock(time.Now(), [32]byte{}),
+		batchSeq: &batchSequencer{seq: []batch{b}},
+		batchImporter: func(context.Context, primitives.Slot, batch, *Store) (*dbval.BackfillStatus, error) {
+			t.Fatal("batch on another chain than the weak subjectivity checkpoint must not be imported")
+			return nil, nil
+		},
+	}
+	err = s.importBatches(context.Background())
+	require.ErrorIs(t, err, errWeakSubjectivityMismatch)
+	// The batch is not marked for a retry, the service stops instead.
+	require.Equal(t, batchImportable, s.batchSeq.seq[0].state)
+}
@@ -6,6 +6,7 @@ import (
 
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
@@ -20,9 +21,10 @@ type signatureVerifier struct {
 	resChan chan error
 }
 
-// A routine that runs in the background to perform batch
-// verifications of incoming messages from gossip.
+// A routine that runs in the background to collect incoming messages from gossip
+// into batches, which are verified by a pool of workers.
 func (s *Service) verifierRoutine() {
+	pool := newVerifierPool(s.ctx, flags.Get().SignatureVerificationWorkers, verifyBatch)
 	verifierBatch := make([]*signatureVerifier, 0)
 	ticker := time.NewTicker(signatureVerificationInterval)
 	for {
@@ -30,19 +32,17 @@ func (s *Service) verifierRoutine() {
 		case <-s.ctx.Done():
 			// Clean up currently utilised resources.
 			ticker.Stop()
-			for i := 0; i < len(verifierBatch); i++ {
-				verifierBatch[i].resChan <- s.ctx.Err()
-			}
+			sendBatchError(verifierBatch, s.ctx.Err())
 			return
 		case sig := <-s.signatureChan:
 			verifierBatch = append(verifierBatch, sig)
 			if len(verifierBatch) >= verifierLimit {
-				verifyBatch(verifierBatch)
+				pool.submit(verifierBatch)
 				verifierBatch = []*signatureVerifier{}
 			}
 		case <-ticker.C:
 			if len(verifierBatch) > 0 {
-				verifyBatch(verifierBatch)
+				pool.submit(verifierBatch)
 				verifierBatch = []*signatureVerifier{}
 			}
 		}
@@ -78,30 +78,44 @@ func (s *Service) validateWithBatchVerifier(ctx context.Context, message string,
 	return pubsub.ValidationAccept, nil
 }
 
+// verifyBatch verifies the signature sets of the batch at once. When the batch fails verification, it is split in
+// halves which are verified again, so that a few invalid signatures only send the messages sharing a batch with them
+// to individual verification, rather than every message of the batch.
 func verifyBatch(verifierBatch []*signatureVerifier) {
 	if len(verifierBatch) == 0 {
 		return
 	}
-	aggSet := verifierBatch[0].set
+	verificationErr := verifySignatureSets(verifierBatch)
+	if verificationErr != nil && len(verifierBatch) > 1 {
+		batchVerificationFailedCounter.Inc()
+		mid := len(verifierBatch) / 2
+		verifyBatch(verifierBatch[:mid])
+		verifyBatch(verifierBatch[mid:])
+		return
+	}
+	for i := 0; i < len(verifierBatch); i++ {
+		verifierBatch[i].resChan <- verificationErr
+	}
+}
 
-	for i := 1; i < len(verifierBatch); i++ {
+func verifySignatureSets(verifierBatch []*signatureVerifier) error {
+	// The sets are joined into a new set, as the sets of the batch are verified again when the batch fails.
+	aggSet := bls.NewSet()
+	for i := 0; i < len(verifierBatch); i++ {
 		aggSet = aggSet.Join(verifierBatch[i].set)
 	}
-	var verificationErr error
-
-	aggSet, verificationErr = performBatchAggregation(aggSet)
-	if verificationErr == nil {
-		verified, err := aggSet.Verify()
-		switch {
-		case err != nil:
-			verificationErr = err
-		case !verified:
-			verificationErr = errors.New("batch signature verification failed")
-		}
+	aggSet, err := performBatchAggregation(aggSet)
+	if err != nil {
+		return err
 	}
-	for i := 0; i < len(verifierBatch); i++ {
-		verifierBatch[i].resChan <- verificationErr
+	verified, err := aggSet.Verify()
+	if err != nil {
+		return err
+	}
+	if !verified {
+		return errors.New("batch signature verification failed")
 	}
+	return nil
 }
 
 func performBatchAggregation(aggSet *bls.SignatureBatch) (*bls.SignatureBatch, error) {
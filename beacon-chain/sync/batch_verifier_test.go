@@ -8,6 +8,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 )
 
@@ -85,3 +86,36 @@ func TestValidateWithBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyBatch_SplitsFailedBatch(t *testing.T) {
+	_, keys, err := util.DeterministicDepositsAndKeys(8)
+	require.NoError(t, err)
+	verifiers := make([]*signatureVerifier, len(keys))
+	for i, k := range keys {
+		msg := [32]byte{byte(i)}
+		sig := k.Sign(msg[:])
+		if i == 5 {
+			sig = keys[0].Sign(msg[:])
+		}
+		verifiers[i] = &signatureVerifier{
+			set: &bls.SignatureBatch{
+				Messages:     [][32]byte{msg},
+				PublicKeys:   []bls.PublicKey{k.PublicKey()},
+				Signatures:   [][]byte{sig.Marshal()},
+				Descriptions: []string{signing.UnknownSignature},
+			},
+			resChan: make(chan error, 1),
+		}
+	}
+	verifyBatch(verifiers)
+	for i, v := range verifiers {
+		err := <-v.resChan
+		if i == 5 {
+			assert.NotNil(t, err)
+			continue
+		}
+		assert.NoError(t, err, "set %d", i)
+		// The sets of the batch are left untouched.
+		assert.Equal(t, 1, len(v.set.Signatures))
+	}
+}
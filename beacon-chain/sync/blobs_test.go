@@ -49,6 +49,7 @@ type blobsTestCase struct {
 	topic               protocol.ID
 	oldestSlot          oldestSlotCallback
 	streamReader        expectedRequirer
+	rateBurst           int64 // overrides the rate limiter capacity for blob requests, which tests do not limit otherwise
 }
 
 type testHandler func(s *Service) rpcHandler
@@ -220,6 +221,9 @@ func (c *blobsTestCase) setup(t *testing.T) (*Service, []blocks.ROBlob, func())
 
 	byRootRate := params.BeaconConfig().MaxRequestBlobSidecars * fieldparams.MaxBlobsPerBlock
 	byRangeRate := params.BeaconConfig().MaxRequestBlobSidecars * fieldparams.MaxBlobsPerBlock
+	if c.rateBurst > 0 {
+		byRootRate, byRangeRate = uint64(c.rateBurst), uint64(c.rateBurst)
+	}
 	s.setRateCollector(p2p.RPCBlobSidecarsByRootTopicV1, leakybucket.NewCollector(0.000001, int64(byRootRate), time.Second, false))
 	s.setRateCollector(p2p.RPCBlobSidecarsByRangeTopicV1, leakybucket.NewCollector(0.000001, int64(byRangeRate), time.Second, false))
 
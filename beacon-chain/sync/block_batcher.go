@@ -17,6 +17,8 @@ import (
 // blockRangeBatcher encapsulates the logic for splitting up a block range request into fixed-size batches of
 // blocks that are retrieved from the database, ensured to be canonical, sequential and unique.
 // If a non-nil value for ticker is set, it will be used to pause between batches lookups, as a rate-limiter.
+// A non-nil limiter charges the peer the slots of each batch. Requests charged otherwise, such as blob sidecars
+// by range which cost the number of sidecars served, leave it nil.
 type blockRangeBatcher struct {
 	start   primitives.Slot
 	end     primitives.Slot
@@ -25,17 +27,18 @@ type blockRangeBatcher struct {
 	limiter *limiter
 	ticker  *time.Ticker
 
+	// cache serves the batches of finalized slots, which are those before finalized.
+	cache     *blockServingCache
+	finalized primitives.Slot
+
 	cf      *canonicalFilter
 	current *blockBatch
 }
 
-func newBlockRangeBatcher(rp rangeParams, bdb db.NoHeadAccessDatabase, limiter *limiter, canonical canonicalChecker, ticker *time.Ticker) (*blockRangeBatcher, error) {
+func newBlockRangeBatcher(rp rangeParams, bdb db.NoHeadAccessDatabase, limiter *limiter, canonical canonicalChecker, ticker *time.Ticker, cache *blockServingCache, finalized primitives.Slot) (*blockRangeBatcher, error) {
 	if bdb == nil {
 		return nil, errors.New("nil db param, unable to initialize blockRangeBatcher")
 	}
-	if limiter == nil {
-		return nil, errors.New("nil limiter param, unable to initialize blockRangeBatcher")
-	}
 	if canonical == nil {
 		return nil, errors.New("nil canonicalChecker param, unable to initialize blockRangeBatcher")
 	}
@@ -50,13 +53,15 @@ func newBlockRangeBatcher(rp rangeParams, bdb db.NoHeadAccessDatabase, limiter *
 	}
 	cf := &canonicalFilter{canonical: canonical}
 	return &blockRangeBatcher{
-		start:   rp.start,
-		end:     rp.end,
-		size:    rp.size,
-		db:      bdb,
-		limiter: limiter,
-		ticker:  ticker,
-		cf:      cf,
+		start:     rp.start,
+		end:       rp.end,
+		size:      rp.size,
+		db:        bdb,
+		limiter:   limiter,
+		ticker:    ticker,
+		cache:     cache,
+		finalized: finalized,
+		cf:        cf,
 	}, nil
 }
 
@@ -78,43 +83,77 @@ func (bb *blockRangeBatcher) next(ctx context.Context, stream libp2pcore.Stream)
 	if !more {
 		return blockBatch{}, false
 	}
-	if err := bb.limiter.validateRequest(stream, bb.size); err != nil {
-		return blockBatch{err: errors.Wrap(err, "throttled by rate limiter")}, false
+	// Blocks by range requests cost the number of slots of the batch.
+	if bb.limiter != nil {
+		if err := bb.limiter.validateRequest(stream, uint64(1+nb.end.SubSlot(nb.start))); err != nil {
+			return blockBatch{err: errors.Wrap(err, "throttled by rate limiter")}, false
+		}
 	}
 
 	// Wait for the ticker before doing anything expensive, unless this is the first batch.
 	if bb.ticker != nil && bb.current != nil {
 		<-bb.ticker.C
 	}
+	// The genesis block is not cached, as it is read separately.
+	cacheable := nb.start > 0 && nb.end < bb.finalized
+	var rob []blocks.ROBlock
+	var cached bool
+	if cacheable {
+		var err error
+		rob, cached, err = bb.cache.get(nb.start, nb.end)
+		if err != nil {
+			log.WithError(err).Debug("Could not read served blocks from the cache")
+			cached = false
+		}
+	}
+	if !cached {
+		var err error
+		rob, err = bb.readBlocks(ctx, nb)
+		if err != nil {
+			return blockBatch{err: err}, false
+		}
+	}
+
+	// Filter and sort our retrieved blocks, so that we only return valid sets of blocks.
+	nb.lin, nb.nonlin, nb.err = bb.cf.filter(ctx, rob)
+	if cacheable && !cached && nb.err == nil && !nb.nonLinear() {
+		if err := bb.cache.put(nb.start, nb.end, nb.lin); err != nil {
+			log.WithError(err).Debug("Could not cache served blocks")
+		}
+	}
+
+	// Decrease allowed blocks capacity by the number of streamed blocks.
+	if bb.limiter != nil {
+		bb.limiter.add(stream, int64(1+nb.end.SubSlot(nb.start)))
+	}
+	bb.current = &nb
+	return *bb.current, true
+}
+
+// readBlocks reads the blocks of a batch from the database.
+func (bb *blockRangeBatcher) readBlocks(ctx context.Context, nb blockBatch) ([]blocks.ROBlock, error) {
 	filter := filters.NewFilter().SetStartSlot(nb.start).SetEndSlot(nb.end)
 	blks, roots, err := bb.db.Blocks(ctx, filter)
 	if err != nil {
-		return blockBatch{err: errors.Wrap(err, "Could not retrieve blocks")}, false
+		return nil, errors.Wrap(err, "Could not retrieve blocks")
 	}
 
 	rob := make([]blocks.ROBlock, 0)
 	if nb.start == 0 {
 		gb, err := bb.genesisBlock(ctx)
 		if err != nil {
-			return blockBatch{err: errors.Wrap(err, "could not retrieve genesis block")}, false
+			return nil, errors.Wrap(err, "could not retrieve genesis block")
 		}
 		rob = append(rob, gb)
 	}
 	for i := 0; i < len(blks); i++ {
 		rb, err := blocks.NewROBlockWithRoot(blks[i], roots[i])
 		if err != nil {
-			return blockBatch{err: errors.Wrap(err, "Could not initialize ROBlock")}, false
+			return nil, errors.Wrap(err, "Could not initialize ROBlock")
 		}
 		rob = append(rob, rb)
 	}
-
-	// Filter and sort our retrieved blocks, so that we only return valid sets of blocks.
-	nb.lin, nb.nonlin, nb.err = bb.cf.filter(ctx, rob)
-
-	// Decrease allowed blocks capacity by the number of streamed blocks.
-	bb.limiter.add(stream, int64(1+nb.end.SubSlot(nb.start)))
-	bb.current = &nb
-	return *bb.current, true
+	return rob, nil
 }
 
 func (bb *blockRangeBatcher) genesisBlock(ctx context.Context) (blocks.ROBlock, error) {
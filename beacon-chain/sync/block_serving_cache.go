@@ -0,0 +1,124 @@
+package sync
+
+import (
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// blockServingCacheSlots is the number of recent finalized slots kept by the block serving cache.
+const blockServingCacheSlots = 1024
+
+// blockServingCache holds recent finalized blocks, SSZ encoded and indexed by slot, so that the blocks by range
+// requests of syncing peers are served from memory rather than from random reads of the database, which compete
+// with block import. Slots are stored in a ring, the entry of a slot replacing the entry of the slot one capacity
+// before it. Skipped slots are cached too, so that a range is only served from the cache when all its slots are.
+type blockServingCache struct {
+	lock  sync.RWMutex
+	slots []servedSlot
+}
+
+type servedSlot struct {
+	slot    primitives.Slot
+	set     bool
+	root    [32]byte
+	blinded bool
+	enc     []byte // nil for a skipped slot.
+}
+
+func newBlockServingCache(size uint64) *blockServingCache {
+	return &blockServingCache{slots: make([]servedSlot, size)}
+}
+
+func (c *blockServingCache) index(slot primitives.Slot) uint64 {
+	return uint64(slot) % uint64(len(c.slots))
+}
+
+// get returns the blocks of the slots from start to end, with false when any slot of the range is not cached.
+func (c *blockServingCache) get(start, end primitives.Slot) ([]blocks.ROBlock, bool, error) {
+	if c == nil || end < start || uint64(end-start) >= uint64(len(c.slots)) {
+		return nil, false, nil
+	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	entries := make([]servedSlot, 0, end-start+1)
+	for slot := start; slot <= end; slot++ {
+		e := c.slots[c.index(slot)]
+		if !e.set || e.slot != slot {
+			blockServingCacheMiss.Inc()
+			return nil, false, nil
+		}
+		entries = append(entries, e)
+	}
+	blks := make([]blocks.ROBlock, 0, len(entries))
+	for _, e := range entries {
+		if e.enc == nil {
+			continue
+		}
+		b, err := unmarshalServedBlock(e.enc, e.blinded)
+		if err != nil {
+			return nil, false, errors.Wrapf(err, "could not unmarshal cached block at slot %d", e.slot)
+		}
+		rb, err := blocks.NewROBlockWithRoot(b, e.root)
+		if err != nil {
+			return nil, false, err
+		}
+		blks = append(blks, rb)
+	}
+	blockServingCacheHit.Inc()
+	return blks, true, nil
+}
+
+// put caches the blocks of the slots from start to end, which must all be finalized and canonical. The slots of
+// the range without a block are cached as skipped slots.
+func (c *blockServingCache) put(start, end primitives.Slot, blks []blocks.ROBlock) error {
+	if c == nil || end < start || uint64(end-start) >= uint64(len(c.slots)) {
+		return nil
+	}
+	bySlot := make(map[primitives.Slot]servedSlot, len(blks))
+	for _, b := range blks {
+		enc, err := b.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block at slot %d", b.Block().Slot())
+		}
+		bySlot[b.Block().Slot()] = servedSlot{root: b.Root(), blinded: b.IsBlinded(), enc: enc}
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for slot := start; slot <= end; slot++ {
+		e := bySlot[slot]
+		e.slot = slot
+		e.set = true
+		c.slots[c.index(slot)] = e
+	}
+	return nil
+}
+
+func unmarshalServedBlock(enc []byte, blinded bool) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	u, err := detect.FromBlock(enc)
+	if err != nil {
+		return nil, err
+	}
+	if blinded {
+		return u.UnmarshalBlindedBeaconBlock(enc)
+	}
+	return u.UnmarshalBeaconBlock(enc)
+}
+
+// finalizedSlot returns the first slot of the finalized epoch. The slots before it are finalized.
+func (s *Service) finalizedSlot() primitives.Slot {
+	cp := s.cfg.chain.FinalizedCheckpt()
+	if cp == nil {
+		return 0
+	}
+	slot, err := slots.EpochStart(cp.Epoch)
+	if err != nil {
+		return 0
+	}
+	return slot
+}
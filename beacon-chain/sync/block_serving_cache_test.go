@@ -0,0 +1,73 @@
+package sync
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func servedTestBlock(t *testing.T, slot primitives.Slot) blocks.ROBlock {
+	blk := util.NewBeaconBlock()
+	blk.Block.Slot = slot
+	sb, err := blocks.NewSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	rb, err := blocks.NewROBlock(sb)
+	require.NoError(t, err)
+	return rb
+}
+
+func TestBlockServingCache(t *testing.T) {
+	c := newBlockServingCache(8)
+	b10 := servedTestBlock(t, 10)
+	b12 := servedTestBlock(t, 12)
+	require.NoError(t, c.put(10, 13, []blocks.ROBlock{b10, b12}))
+
+	blks, ok, err := c.get(10, 13)
+	require.NoError(t, err)
+	require.Equal(t, true, ok)
+	require.Equal(t, 2, len(blks))
+	assert.Equal(t, b10.Root(), blks[0].Root())
+	assert.Equal(t, primitives.Slot(10), blks[0].Block().Slot())
+	assert.Equal(t, b12.Root(), blks[1].Root())
+	htr, err := blks[1].Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, b12.Root(), htr)
+
+	// Skipped slots are served too.
+	blks, ok, err = c.get(11, 11)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 0, len(blks))
+
+	// A range with a slot which is not cached is not served.
+	_, ok, err = c.get(10, 14)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+
+	// Slots are replaced by the slot one capacity later.
+	require.NoError(t, c.put(18, 18, []blocks.ROBlock{servedTestBlock(t, 18)}))
+	_, ok, err = c.get(10, 10)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+	_, ok, err = c.get(11, 13)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+
+	// Ranges longer than the cache are neither cached nor served.
+	require.NoError(t, c.put(20, 40, nil))
+	_, ok, err = c.get(20, 40)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
+
+func TestBlockServingCache_Nil(t *testing.T) {
+	var c *blockServingCache
+	require.NoError(t, c.put(1, 2, nil))
+	_, ok, err := c.get(1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/sirupsen/logrus"
 )
 
 // APIInitializer manages initializing the beacon node using checkpoint sync, retrieving the checkpoint state and root
@@ -41,5 +42,28 @@ func (dl *APIInitializer) Initialize(ctx context.Context, d db.Database) error {
 	if err != nil {
 		return errors.Wrap(err, "Error retrieving checkpoint origin state and block")
 	}
-	return d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes())
+	if err := d.SaveOrigin(ctx, od.StateBytes(), od.BlockBytes()); err != nil {
+		return err
+	}
+	dl.initDepositSnapshot(ctx, d, od)
+	return nil
+}
+
+// initDepositSnapshot downloads the remote node's deposit snapshot and persists it, so the node does not need to
+// replay historical deposit logs from the execution client. Failures are not fatal, as the node can still fall
+// back to processing the deposit logs.
+func (dl *APIInitializer) initDepositSnapshot(ctx context.Context, d db.Database, od *beacon.OriginData) {
+	snapshot, err := dl.c.GetDepositSnapshot(ctx)
+	if err != nil {
+		log.WithError(err).Warn("Could not download deposit snapshot, deposit logs will be processed from the execution client")
+		return
+	}
+	if err := saveDepositSnapshot(ctx, d, snapshot, od.State()); err != nil {
+		log.WithError(err).Warn("Could not save deposit snapshot, deposit logs will be processed from the execution client")
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"depositCount":   snapshot.DepositCount,
+		"executionBlock": snapshot.ExecutionDepth,
+	}).Info("Initialized deposit tree from checkpoint sync deposit snapshot")
 }
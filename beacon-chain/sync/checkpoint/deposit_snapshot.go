@@ -0,0 +1,68 @@
+package checkpoint
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache/depositsnapshot"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// verifyDepositSnapshot checks that the snapshot is internally consistent and only covers deposits
+// which have already been processed by the origin state.
+func verifyDepositSnapshot(snapshot *ethpb.DepositSnapshot, origin state.ReadOnlyBeaconState) error {
+	if _, err := depositsnapshot.DepositTreeFromSnapshotProto(snapshot); err != nil {
+		return errors.Wrap(err, "invalid deposit snapshot")
+	}
+	if snapshot.DepositCount > origin.Eth1DepositIndex() {
+		return errors.Errorf("deposit snapshot covers %d deposits but the origin state only processed %d",
+			snapshot.DepositCount, origin.Eth1DepositIndex())
+	}
+	eth1Data := origin.Eth1Data()
+	if snapshot.DepositCount == eth1Data.DepositCount && !bytes.Equal(snapshot.DepositRoot, eth1Data.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match origin state deposit root %#x",
+			snapshot.DepositRoot, eth1Data.DepositRoot)
+	}
+	return nil
+}
+
+// saveDepositSnapshot persists the deposit snapshot as the node's execution chain data, so that the
+// execution service builds its deposit tree from the snapshot and resumes following deposit logs from
+// the snapshot's execution block instead of replaying them from the deposit contract deployment.
+func saveDepositSnapshot(ctx context.Context, d db.Database, snapshot *ethpb.DepositSnapshot, origin state.BeaconState) error {
+	if err := verifyDepositSnapshot(snapshot, origin); err != nil {
+		return err
+	}
+	existing, err := d.ExecutionChainData(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read execution chain data")
+	}
+	if existing != nil && existing.ChainstartData != nil && existing.ChainstartData.Chainstarted {
+		return errors.New("execution chain data already exists in the database")
+	}
+	chainstart := &ethpb.ChainStartData{
+		Chainstarted:       true,
+		GenesisTime:        origin.GenesisTime(),
+		Eth1Data:           origin.Eth1Data(),
+		ChainstartDeposits: make([]*ethpb.Deposit, 0),
+	}
+	genesis, err := d.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not read genesis state")
+	}
+	if genesis != nil && !genesis.IsNil() {
+		chainstart.Eth1Data = genesis.Eth1Data()
+	}
+	return d.SaveExecutionChainData(ctx, &ethpb.ETH1ChainData{
+		CurrentEth1Data: &ethpb.LatestETH1Data{
+			BlockHeight:        snapshot.ExecutionDepth,
+			BlockHash:          snapshot.ExecutionHash,
+			LastRequestedBlock: snapshot.ExecutionDepth,
+		},
+		ChainstartData:  chainstart,
+		DepositSnapshot: snapshot,
+	})
+}
@@ -0,0 +1,67 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// ErrWeakSubjectivityMismatch is returned when the checkpoint sync origin is on a chain that does not include
+// the weak subjectivity checkpoint.
+var ErrWeakSubjectivityMismatch = errors.New("checkpoint sync origin does not include weak subjectivity checkpoint")
+
+// VerifyWeakSubjectivity checks that the checkpoint sync origin is on the chain of the weak subjectivity checkpoint.
+// The checkpoint root must be the origin block, or be in the block roots of the origin state within the checkpoint
+// epoch. Checkpoints older than the block roots of the origin state are verified by backfill, and checkpoints
+// after the origin by the blockchain service while syncing.
+func VerifyWeakSubjectivity(ctx context.Context, d db.ReadOnlyDatabase, ws *ethpb.Checkpoint) error {
+	if ws == nil {
+		return nil
+	}
+	origin, err := d.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get origin checkpoint block root")
+	}
+	root := bytesutil.ToBytes32(ws.Root)
+	if origin == root {
+		log.WithField("root", fmt.Sprintf("%#x", root)).Info("Checkpoint sync origin is the weak subjectivity checkpoint")
+		return nil
+	}
+	st, err := d.State(ctx, origin)
+	if err != nil {
+		return errors.Wrap(err, "could not get origin state")
+	}
+	if st == nil || st.IsNil() {
+		return errors.Errorf("origin state %#x not found", origin)
+	}
+	start, err := slots.EpochStart(ws.Epoch)
+	if err != nil {
+		return err
+	}
+	end := start + params.BeaconConfig().SlotsPerEpoch
+	if end > st.Slot() || st.Slot() > start+params.BeaconConfig().SlotsPerHistoricalRoot {
+		// The checkpoint epoch is not entirely covered by the block roots of the origin state.
+		return nil
+	}
+	for slot := start; slot < end; slot++ {
+		r, err := helpers.BlockRootAtSlot(st, slot)
+		if err != nil {
+			return err
+		}
+		if bytesutil.ToBytes32(r) == root {
+			log.WithField("root", fmt.Sprintf("%#x", root)).Info("Checkpoint sync origin includes the weak subjectivity checkpoint")
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrWeakSubjectivityMismatch, "root=%#x, epoch=%d, origin=%#x", root, ws.Epoch, origin)
+}
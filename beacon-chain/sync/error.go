@@ -23,6 +23,10 @@ var responseCodeInvalidRequest = byte(0x01)
 var responseCodeServerError = byte(0x02)
 var responseCodeResourceUnavailable = byte(0x03)
 
+// responseCodeRateLimited is returned to peers exceeding their request quota. It is in the range of response
+// codes left to clients, and matches the code Lighthouse uses for rate limited requests.
+var responseCodeRateLimited = byte(0x8B)
+
 func (s *Service) generateErrorResponse(code byte, reason string) ([]byte, error) {
 	return createErrorResponse(code, reason, s.cfg.p2p)
 }
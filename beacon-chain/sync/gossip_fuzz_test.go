@@ -0,0 +1,175 @@
+package sync
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/v5/beacon-chain/db/testing"
+	doublylinkedtree "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/doubly-linked-tree"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/attestations"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/operations/blstoexec"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	p2ptest "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state/stategen"
+	mockSync "github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/verification"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/fuzz"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+// fuzzPeersP2P is the fuzz test p2p with a peer set, for the validators which request missing blocks from peers.
+type fuzzPeersP2P struct {
+	*p2ptest.FakeP2P
+	peers *peers.Status
+}
+
+// Peers returns the empty peer set.
+func (p *fuzzPeersP2P) Peers() *peers.Status {
+	return p.peers
+}
+
+// newGossipFuzzService returns a service which validates gossip messages against the head state, with the real
+// verifiers and signature batch verification.
+func newGossipFuzzService(f *testing.F, st state.BeaconState) *Service {
+	ctx, cancel := context.WithCancel(context.Background())
+	f.Cleanup(cancel)
+	db := dbtest.SetupDB(f)
+	blk := util.NewBeaconBlock()
+	util.SaveBlock(f, ctx, db, blk)
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(f, err)
+	require.NoError(f, db.SaveState(ctx, st, root))
+
+	chain := &mock.ChainService{
+		Genesis:             time.Now().Add(-time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second),
+		ValidatorsRoot:      [32]byte{'A'},
+		State:               st,
+		Root:                root[:],
+		DB:                  db,
+		FinalizedCheckPoint: &ethpb.Checkpoint{Root: root[:]},
+	}
+	fc := doublylinkedtree.New()
+	stateGen := stategen.New(db, fc)
+	clock := startup.NewClock(chain.Genesis, chain.ValidatorsRoot)
+	cw := startup.NewClockSynchronizer()
+	require.NoError(f, cw.SetClock(clock))
+	ini, err := verification.NewInitializerWaiter(cw, fc, stateGen).WaitForInitializer(ctx)
+	require.NoError(f, err)
+
+	s := &Service{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg: &config{
+			p2p: &fuzzPeersP2P{
+				FakeP2P: p2ptest.NewFuzzTestP2P(),
+				peers:   peers.NewStatus(ctx, &peers.StatusConfig{ScorerParams: &scorers.Config{}}),
+			},
+			beaconDB:            db,
+			initialSync:         &mockSync.Sync{},
+			chain:               chain,
+			clock:               clock,
+			stateGen:            stateGen,
+			attPool:             attestations.NewPool(),
+			blsToExecPool:       blstoexec.NewPool(),
+			attestationNotifier: chain.OperationNotifier(),
+			operationNotifier:   chain.OperationNotifier(),
+		},
+		blkRootToPendingAtts: make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
+		seenPendingBlocks:    make(map[[32]byte]bool),
+		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+		newBlobVerifier:      newBlobVerifierFromInitializer(ini),
+	}
+	s.initCaches()
+	go s.verifierRoutine()
+	return s
+}
+
+// gossipTopic returns the topic of the format with the current fork digest, and with the subnet for subnet topics.
+func gossipTopic(f *testing.F, s *Service, format string, subnet uint64) string {
+	digest, err := s.currentForkDigest()
+	require.NoError(f, err)
+	if strings.Contains(format, "%d") {
+		return s.addDigestAndIndexToTopic(format, digest, subnet)
+	}
+	return s.addDigestToTopic(format, digest)
+}
+
+// fuzzGossip fuzzes the validator with the SSZ encoded messages, which are published on the topic the way peers
+// publish them. The corpus is seeded with the spectests of the object in the fork and the seed.
+func fuzzGossip(
+	f *testing.F,
+	fork, object string,
+	seed interface{ MarshalSSZ() ([]byte, error) },
+	topic string,
+	validate func(context.Context, peer.ID, *pubsub.Message) (pubsub.ValidationResult, error),
+) {
+	enc, err := seed.MarshalSSZ()
+	require.NoError(f, err)
+	fuzz.AddSpectestSeeds(f, "mainnet", fork, object, enc)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		msg := &pubsub.Message{
+			Message: &pb.Message{
+				Data:  snappy.Encode(nil /* dst */, data),
+				Topic: &topic,
+			},
+		}
+		// Most fuzzed messages are rejected, the validator only has to handle them without panicking.
+		_, _ = validate(context.Background(), "fuzz", msg)
+	})
+}
+
+func FuzzValidateCommitteeIndexBeaconAttestation(f *testing.F) {
+	st, _ := util.DeterministicGenesisState(f, 64)
+	s := newGossipFuzzService(f, st)
+	att := util.HydrateAttestation(&ethpb.Attestation{})
+	fuzzGossip(f, "phase0", "Attestation", att, gossipTopic(f, s, p2p.AttestationSubnetTopicFormat, 0), s.validateCommitteeIndexBeaconAttestation)
+}
+
+func FuzzValidateBlob(f *testing.F) {
+	st, _ := util.DeterministicGenesisStateDeneb(f, 64)
+	s := newGossipFuzzService(f, st)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlockDeneb())
+	require.NoError(f, err)
+	header, err := blk.Header()
+	require.NoError(f, err)
+	sc := &ethpb.BlobSidecar{
+		Blob:                     make([]byte, fieldparams.BlobLength),
+		KzgCommitment:            make([]byte, fieldparams.BLSPubkeyLength),
+		KzgProof:                 make([]byte, fieldparams.BLSPubkeyLength),
+		SignedBlockHeader:        header,
+		CommitmentInclusionProof: make([][]byte, fieldparams.KzgCommitmentInclusionProofDepth),
+	}
+	for i := range sc.CommitmentInclusionProof {
+		sc.CommitmentInclusionProof[i] = make([]byte, fieldparams.RootLength)
+	}
+	fuzzGossip(f, "deneb", "BlobSidecar", sc, gossipTopic(f, s, p2p.BlobSubnetTopicFormat, 0), s.validateBlob)
+}
+
+func FuzzValidateBlsToExecutionChange(f *testing.F) {
+	st, _ := util.DeterministicGenesisStateCapella(f, 64)
+	s := newGossipFuzzService(f, st)
+	change := &ethpb.SignedBLSToExecutionChange{
+		Message: &ethpb.BLSToExecutionChange{
+			FromBlsPubkey:      make([]byte, 48),
+			ToExecutionAddress: make([]byte, 20),
+		},
+		Signature: make([]byte, 96),
+	}
+	fuzzGossip(f, "capella", "SignedBLSToExecutionChange", change, gossipTopic(f, s, p2p.BlsToExecutionChangeSubnetTopicFormat, 0), s.validateBlsToExecutionChange)
+}
@@ -344,6 +344,8 @@ func (f *blocksFetcher) fetchBlocksFromPeer(
 	// We append the best peers to the front so that higher capacity
 	// peers are dialed first.
 	peers = append(bestPeers, peers...)
+	// Peers serving historical blocks are dialed first for blocks which other peers may have pruned.
+	peers = append(f.historicalPeers(peers, start), peers...)
 	peers = dedupPeers(peers)
 	for i := 0; i < len(peers); i++ {
 		p := peers[i]
@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	mathutil "github.com/prysmaticlabs/prysm/v5/math"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
@@ -121,6 +124,33 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 	return trimPeers(peers, peersPercentage)
 }
 
+// historicalPeers returns the peers which advertise in their ENR that they serve the blocks from the start slot,
+// when the start slot is older than MIN_EPOCHS_FOR_BLOCK_REQUESTS and other peers may not have the blocks.
+func (f *blocksFetcher) historicalPeers(peers []peer.ID, start primitives.Slot) []peer.ID {
+	if f.clock == nil {
+		return nil
+	}
+	currentEpoch := slots.ToEpoch(f.clock.CurrentSlot())
+	if currentEpoch <= helpers.MinEpochsForBlockRequests() {
+		return nil
+	}
+	minRequiredSlot, err := slots.EpochStart(currentEpoch - helpers.MinEpochsForBlockRequests())
+	if err != nil || start >= minRequiredSlot {
+		return nil
+	}
+	historical := make([]peer.ID, 0, len(peers))
+	for _, pid := range peers {
+		record, err := f.p2p.Peers().ENR(pid)
+		if err != nil {
+			continue
+		}
+		if earliest, ok := p2p.HistoricalBlocksEntry(record); ok && earliest <= start {
+			historical = append(historical, pid)
+		}
+	}
+	return historical
+}
+
 // trimPeers limits peer list, returning only specified percentage of peers.
 // Takes system constraints into account (min/max peers to sync).
 func trimPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
@@ -8,9 +8,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/peers/scorers"
+	p2pt "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	leakybucket "github.com/prysmaticlabs/prysm/v5/container/leaky-bucket"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
@@ -301,6 +307,37 @@ func TestBlocksFetcher_filterPeers(t *testing.T) {
 	}
 }
 
+func TestBlocksFetcher_historicalPeers(t *testing.T) {
+	p := p2pt.NewTestP2P(t)
+	addPeer := func(pid peer.ID, earliest *uint64) {
+		record := &enr.Record{}
+		if earliest != nil {
+			record.Set(enr.WithEntry("hblk", *earliest))
+		}
+		p.Peers().Add(record, pid, nil, network.DirOutbound)
+	}
+	genesisSlot, recentSlot := uint64(0), uint64(1000)
+	addPeer("a", nil)
+	addPeer("b", &genesisSlot)
+	addPeer("c", &recentSlot)
+	peers := []peer.ID{"a", "b", "c", "d"}
+
+	// The current epoch is 100 epochs after the minimum retention period.
+	epochs := uint64(helpers.MinEpochsForBlockRequests()) + 100
+	genesis := time.Now().Add(-time.Duration(epochs*uint64(params.BeaconConfig().SlotsPerEpoch)*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		p2p:   p,
+		clock: startup.NewClock(genesis, [32]byte{}),
+	})
+	assert.DeepEqual(t, []peer.ID{"b"}, fetcher.historicalPeers(peers, 100))
+	assert.DeepEqual(t, []peer.ID{"b", "c"}, fetcher.historicalPeers(peers, 1000))
+	assert.Equal(t, 0, len(fetcher.historicalPeers(peers, 4000)))
+
+	// Every peer serves the blocks of a chain younger than the retention period.
+	fetcher.clock = startup.NewClock(time.Now(), [32]byte{})
+	assert.Equal(t, 0, len(fetcher.historicalPeers(peers, 0)))
+}
+
 func TestBlocksFetcher_removeStalePeerLocks(t *testing.T) {
 	type peerData struct {
 		peerID   peer.ID
@@ -173,6 +173,7 @@ func (s *Service) processFetchedDataRegSync(
 		return
 	}
 	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncSidecarRequirements)
+	verifyBatchKzg(bv, bwb)
 	avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
 	batchFields := logrus.Fields{
 		"firstSlot":        data.bwb[0].Block.Block().Slot(),
@@ -332,6 +333,7 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	}
 
 	bv := verification.NewBlobBatchVerifier(s.newBlobVerifier, verification.InitsyncSidecarRequirements)
+	verifyBatchKzg(bv, bwb)
 	avs := das.NewLazilyPersistentStore(s.cfg.BlobStorage, bv)
 	s.logBatchSyncStatus(genesis, first, len(bwb))
 	for _, bb := range bwb {
@@ -346,6 +348,22 @@ func (s *Service) processBatchedBlocks(ctx context.Context, genesis time.Time,
 	return bFunc(ctx, blocks.BlockWithROBlobsSlice(bwb).ROBlocks(), avs)
 }
 
+// verifyBatchKzg verifies the kzg commitments of the blob sidecars of all the blocks of a batch together. When the
+// batch fails verification, the commitments are verified block by block by the availability check, which finds the
+// block with invalid sidecars.
+func verifyBatchKzg(bv *verification.BlobBatchVerifier, bwb []blocks.BlockWithROBlobs) {
+	var scs []blocks.ROBlob
+	for _, b := range bwb {
+		scs = append(scs, b.Blobs...)
+	}
+	if len(scs) == 0 {
+		return
+	}
+	if err := bv.VerifyKzg(scs...); err != nil {
+		log.WithError(err).Debug("Could not verify the kzg commitments of the batch, verifying them block by block")
+	}
+}
+
 // updatePeerScorerStats adjusts monitored metrics for a peer.
 func (s *Service) updatePeerScorerStats(pid peer.ID, startSlot primitives.Slot) {
 	if pid == "" {
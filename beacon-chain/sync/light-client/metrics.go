@@ -0,0 +1,21 @@
+package lightclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	oldestServablePeriodGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "light_client_oldest_servable_period",
+		Help: "The earliest sync committee period with a stored light client update.",
+	})
+	backfilledUpdatesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "light_client_backfilled_updates_total",
+		Help: "The number of light client updates backfilled from stored blocks and states.",
+	})
+	prunedUpdatesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "light_client_pruned_updates_total",
+		Help: "The number of light client updates deleted outside of the retention window.",
+	})
+)
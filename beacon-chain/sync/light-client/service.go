@@ -0,0 +1,295 @@
+// Package lightclient defines a service which manages the light client updates stored by the beacon node.
+// It backfills the best update of finalized sync committee periods from stored blocks and states, and
+// deletes updates of periods outside of the retention window.
+package lightclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corelightclient "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/light-client"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// periodsPerRun is the number of missing periods backfilled every epoch, to spread the state replays.
+const periodsPerRun = 4
+
+var (
+	errNoFinalizedBlock = errors.New("no finalized block in period")
+	errMissingBlock     = errors.New("block not found")
+	errNoSuitableBlock  = errors.New("no block with a supermajority sync aggregate in period")
+)
+
+// Database is the subset of the beacon node database used to manage light client updates.
+type Database interface {
+	Block(ctx context.Context, blockRoot [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error)
+	HighestRootsBelowSlot(ctx context.Context, slot primitives.Slot) (primitives.Slot, [][32]byte, error)
+	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
+	FinalizedCheckpoint(ctx context.Context) (*ethpb.Checkpoint, error)
+	LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdateWithVersion, error)
+	SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdateWithVersion) error
+	EarliestLightClientUpdatePeriod(ctx context.Context) (uint64, error)
+	DeleteLightClientUpdatesBefore(ctx context.Context, period uint64) (int, error)
+}
+
+// StateByRooter returns the state after the block with the given root.
+type StateByRooter interface {
+	StateByRoot(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error)
+}
+
+// Service backfills and prunes light client updates once per epoch.
+type Service struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	db        Database
+	sr        StateByRooter
+	cw        startup.ClockWaiter
+	retention uint64
+}
+
+// NewService initializes the light client update service. Updates are kept for the given number of sync
+// committee periods before the current one. When the retention is 0, updates are kept for the periods
+// covering MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func NewService(ctx context.Context, db Database, sr StateByRooter, cw startup.ClockWaiter, retention uint64) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	if retention == 0 {
+		retention = defaultRetention()
+	}
+	return &Service{
+		ctx:       ctx,
+		cancel:    cancel,
+		db:        db,
+		sr:        sr,
+		cw:        cw,
+		retention: retention,
+	}
+}
+
+// Start the light client update service in the background.
+func (s *Service) Start() {
+	go s.run()
+}
+
+// Stop the light client update service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the light client update service.
+func (*Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	clock, err := s.cw.WaitForClock(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not obtain clock, light client updates will not be backfilled")
+		return
+	}
+	ticker := slots.NewSlotTicker(clock.GenesisTime(), params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	// Replay states late in the epoch, away from the epoch boundary processing and the database pruner.
+	offset := params.BeaconConfig().SlotsPerEpoch * 3 / 4
+	for {
+		select {
+		case slot := <-ticker.C():
+			if slot%params.BeaconConfig().SlotsPerEpoch != offset {
+				continue
+			}
+			if err := s.update(slots.ToEpoch(slot)); err != nil {
+				log.WithError(err).Error("Could not update light client updates")
+			}
+		case <-s.ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// update backfills the missing updates of finalized periods within the retention window, newest first,
+// and deletes the updates before the retention window.
+func (s *Service) update(current primitives.Epoch) error {
+	oldest := s.oldestServablePeriod(slots.SyncCommitteePeriod(current))
+	f, err := s.db.FinalizedCheckpoint(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized checkpoint")
+	}
+	// Periods before the period of the finalized checkpoint will not change anymore.
+	finalizedPeriod := slots.SyncCommitteePeriod(f.Epoch)
+	backfilled := 0
+	for period := finalizedPeriod; period > oldest && backfilled < periodsPerRun; period-- {
+		p := period - 1
+		stored, err := s.db.LightClientUpdate(s.ctx, p)
+		if err != nil {
+			return errors.Wrapf(err, "could not get light client update of period %d", p)
+		}
+		if stored != nil && stored.Data != nil {
+			continue
+		}
+		update, err := s.bestUpdate(p)
+		if errors.Is(err, errMissingBlock) {
+			// Blocks before this period are not available, which happens until backfill completes.
+			log.WithError(err).WithField("period", p).Debug("Could not backfill light client updates")
+			break
+		}
+		if errors.Is(err, errNoFinalizedBlock) || errors.Is(err, errNoSuitableBlock) {
+			log.WithError(err).WithField("period", p).Debug("No light client update for period")
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "could not compute light client update of period %d", p)
+		}
+		if err := s.db.SaveLightClientUpdate(s.ctx, p, update); err != nil {
+			return errors.Wrapf(err, "could not save light client update of period %d", p)
+		}
+		backfilled++
+		backfilledUpdatesCounter.Inc()
+		log.WithField("period", p).Debug("Backfilled light client update")
+	}
+
+	pruned, err := s.db.DeleteLightClientUpdatesBefore(s.ctx, oldest)
+	if err != nil {
+		return errors.Wrap(err, "could not prune light client updates")
+	}
+	prunedUpdatesCounter.Add(float64(pruned))
+
+	earliest, err := s.db.EarliestLightClientUpdatePeriod(s.ctx)
+	if errors.Is(err, kv.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not get earliest light client update period")
+	}
+	oldestServablePeriodGauge.Set(float64(earliest))
+	if backfilled > 0 || pruned > 0 {
+		log.WithFields(logrus.Fields{
+			"backfilled":           backfilled,
+			"pruned":               pruned,
+			"oldestServablePeriod": earliest,
+		}).Info("Updated stored light client updates")
+	}
+	return nil
+}
+
+// bestUpdate computes the update of a finalized period from the latest canonical block of the period which
+// has a supermajority sync aggregate and a parent in the same period.
+func (s *Service) bestUpdate(period uint64) (*ethpbv2.LightClientUpdateWithVersion, error) {
+	cfg := params.BeaconConfig()
+	slotsPerPeriod := primitives.Slot(uint64(cfg.EpochsPerSyncCommitteePeriod) * uint64(cfg.SlotsPerEpoch))
+	start := primitives.Slot(period) * slotsPerPeriod
+	root, err := s.finalizedRootBelow(start + slotsPerPeriod)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		blk, err := s.block(root)
+		if err != nil {
+			return nil, err
+		}
+		if blk.Block().Slot() <= start || blk.Version() < version.Altair {
+			return nil, errNoSuitableBlock
+		}
+		parentRoot := blk.Block().ParentRoot()
+		parent, err := s.block(parentRoot)
+		if err != nil {
+			return nil, err
+		}
+		if parent.Block().Slot() < start {
+			return nil, errNoSuitableBlock
+		}
+		agg, err := blk.Block().Body().SyncAggregate()
+		if err != nil {
+			return nil, err
+		}
+		if agg == nil || agg.SyncCommitteeBits.Count()*3 < cfg.SyncCommitteeSize*2 {
+			root = parentRoot
+			continue
+		}
+		return s.newUpdate(root, blk, parentRoot, parent)
+	}
+}
+
+func (s *Service) newUpdate(
+	root [32]byte,
+	blk interfaces.ReadOnlySignedBeaconBlock,
+	attestedRoot [32]byte,
+	attestedBlk interfaces.ReadOnlySignedBeaconBlock,
+) (*ethpbv2.LightClientUpdateWithVersion, error) {
+	st, err := s.sr.StateByRoot(s.ctx, root)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get state")
+	}
+	attestedState, err := s.sr.StateByRoot(s.ctx, attestedRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested state")
+	}
+	var finalizedBlk interfaces.ReadOnlySignedBeaconBlock
+	if cp := attestedState.FinalizedCheckpoint(); cp != nil {
+		finalizedBlk, err = s.db.Block(s.ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			finalizedBlk = nil
+		}
+	}
+	update, err := corelightclient.NewLightClientUpdateFromBeaconState(s.ctx, st, blk, attestedState, attestedBlk, finalizedBlk)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpbv2.LightClientUpdateWithVersion{
+		Version: ethpbv2.Version(attestedBlk.Version()),
+		Data:    update,
+	}, nil
+}
+
+// finalizedRootBelow returns the root of the highest finalized block below the given slot.
+func (s *Service) finalizedRootBelow(slot primitives.Slot) ([32]byte, error) {
+	_, roots, err := s.db.HighestRootsBelowSlot(s.ctx, slot)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	for _, r := range roots {
+		if s.db.IsFinalizedBlock(s.ctx, r) {
+			return r, nil
+		}
+	}
+	return [32]byte{}, errNoFinalizedBlock
+}
+
+func (s *Service) block(root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	blk, err := s.db.Block(s.ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, errors.Wrapf(errMissingBlock, "root=%#x", root)
+	}
+	return blk, nil
+}
+
+// oldestServablePeriod returns the earliest period kept in the database.
+func (s *Service) oldestServablePeriod(current uint64) uint64 {
+	altair := slots.SyncCommitteePeriod(params.BeaconConfig().AltairForkEpoch)
+	if current < s.retention || current-s.retention < altair {
+		return altair
+	}
+	return current - s.retention
+}
+
+// defaultRetention returns the number of periods covering MIN_EPOCHS_FOR_BLOCK_REQUESTS.
+func defaultRetention() uint64 {
+	cfg := params.BeaconConfig()
+	epochs := cfg.MinEpochsForBlockRequests
+	perPeriod := uint64(cfg.EpochsPerSyncCommitteePeriod)
+	return (epochs + perPeriod - 1) / perPeriod
+}
@@ -0,0 +1,164 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpbv2 "github.com/prysmaticlabs/prysm/v5/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+type mockDB struct {
+	finalized *ethpb.Checkpoint
+	blocks    map[[32]byte]interfaces.ReadOnlySignedBeaconBlock
+	updates   map[uint64]*ethpbv2.LightClientUpdateWithVersion
+}
+
+func (m *mockDB) Block(_ context.Context, root [32]byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+	return m.blocks[root], nil
+}
+
+func (m *mockDB) HighestRootsBelowSlot(_ context.Context, slot primitives.Slot) (primitives.Slot, [][32]byte, error) {
+	var highest primitives.Slot
+	var roots [][32]byte
+	for r, b := range m.blocks {
+		s := b.Block().Slot()
+		if s >= slot || s < highest {
+			continue
+		}
+		if s > highest {
+			highest, roots = s, nil
+		}
+		roots = append(roots, r)
+	}
+	return highest, roots, nil
+}
+
+func (*mockDB) IsFinalizedBlock(context.Context, [32]byte) bool {
+	return true
+}
+
+func (m *mockDB) FinalizedCheckpoint(context.Context) (*ethpb.Checkpoint, error) {
+	return m.finalized, nil
+}
+
+func (m *mockDB) LightClientUpdate(_ context.Context, period uint64) (*ethpbv2.LightClientUpdateWithVersion, error) {
+	if u, ok := m.updates[period]; ok {
+		return u, nil
+	}
+	return &ethpbv2.LightClientUpdateWithVersion{}, nil
+}
+
+func (m *mockDB) SaveLightClientUpdate(_ context.Context, period uint64, update *ethpbv2.LightClientUpdateWithVersion) error {
+	m.updates[period] = update
+	return nil
+}
+
+func (m *mockDB) EarliestLightClientUpdatePeriod(context.Context) (uint64, error) {
+	found := false
+	var earliest uint64
+	for p := range m.updates {
+		if !found || p < earliest {
+			earliest, found = p, true
+		}
+	}
+	if !found {
+		return 0, kv.ErrNotFound
+	}
+	return earliest, nil
+}
+
+func (m *mockDB) DeleteLightClientUpdatesBefore(_ context.Context, period uint64) (int, error) {
+	deleted := 0
+	for p := range m.updates {
+		if p < period {
+			delete(m.updates, p)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+type mockStater map[[32]byte]state.BeaconState
+
+func (m mockStater) StateByRoot(_ context.Context, root [32]byte) (state.BeaconState, error) {
+	return m[root], nil
+}
+
+// addBlock saves a block with a full sync aggregate at the given slot and its post state.
+func addBlock(t *testing.T, db *mockDB, sr mockStater, slot primitives.Slot, parentRoot [32]byte) [32]byte {
+	ctx := context.Background()
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	b := util.NewBeaconBlockAltair()
+	b.Block.Slot = slot
+	b.Block.ParentRoot = parentRoot[:]
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		b.Block.Body.SyncAggregate.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	signed, err := blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	h, err := signed.Header()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(h.Header))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	b.Block.StateRoot = stateRoot[:]
+	signed, err = blocks.NewSignedBeaconBlock(b)
+	require.NoError(t, err)
+	root, err := signed.Block().HashTreeRoot()
+	require.NoError(t, err)
+	db.blocks[root] = signed
+	sr[root] = st
+	return root
+}
+
+func TestService_Update(t *testing.T) {
+	cfg := params.BeaconConfig()
+	slotsPerPeriod := primitives.Slot(uint64(cfg.EpochsPerSyncCommitteePeriod) * uint64(cfg.SlotsPerEpoch))
+	altair := slots.SyncCommitteePeriod(cfg.AltairForkEpoch)
+
+	db := &mockDB{
+		blocks:  make(map[[32]byte]interfaces.ReadOnlySignedBeaconBlock),
+		updates: make(map[uint64]*ethpbv2.LightClientUpdateWithVersion),
+	}
+	sr := mockStater{}
+	// Period altair+1 has blocks, period altair+2 has none and period altair+3 is not finalized.
+	start := primitives.Slot(altair+1) * slotsPerPeriod
+	parent := addBlock(t, db, sr, start+1, [32]byte{})
+	addBlock(t, db, sr, start+2, parent)
+	db.finalized = &ethpb.Checkpoint{Epoch: primitives.Epoch(altair+3) * cfg.EpochsPerSyncCommitteePeriod}
+	// The update of the Altair period is outside of the retention window.
+	db.updates[altair] = &ethpbv2.LightClientUpdateWithVersion{Data: &ethpbv2.LightClientUpdate{}}
+
+	s := NewService(context.Background(), db, sr, nil, 3)
+	require.NoError(t, s.update(primitives.Epoch(altair+4)*cfg.EpochsPerSyncCommitteePeriod))
+
+	require.Equal(t, 1, len(db.updates))
+	u, ok := db.updates[altair+1]
+	require.Equal(t, true, ok)
+	require.Equal(t, ethpbv2.Version(version.Altair), u.Version)
+	require.Equal(t, start+2, u.Data.SignatureSlot)
+}
+
+func TestService_OldestServablePeriod(t *testing.T) {
+	altair := slots.SyncCommitteePeriod(params.BeaconConfig().AltairForkEpoch)
+	s := NewService(context.Background(), nil, nil, nil, 10)
+	require.Equal(t, altair, s.oldestServablePeriod(5))
+	require.Equal(t, altair, s.oldestServablePeriod(altair+10))
+	require.Equal(t, altair+5, s.oldestServablePeriod(altair+15))
+
+	s = NewService(context.Background(), nil, nil, nil, 0)
+	require.Equal(t, defaultRetention(), s.retention)
+}
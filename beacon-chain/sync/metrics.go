@@ -49,6 +49,13 @@ var (
 		},
 		[]string{"topic"},
 	)
+	messageObservedValidCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "p2p_message_observed_valid_total",
+			Help: "Count of valid messages which were neither forwarded nor imported in gossip observer mode.",
+		},
+		[]string{"topic"},
+	)
 	messageFailedProcessingCounter = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "p2p_message_failed_processing_total",
@@ -68,6 +75,27 @@ var (
 			Help: "Count the number of times a duplicate signature set has been removed.",
 		},
 	)
+	batchVerificationFailedCounter = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "batch_signature_verification_failed_total",
+			Help: "Count the number of signature batches which failed verification and were split to find the invalid signatures.",
+		},
+	)
+	signatureVerificationQueueWait = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "signature_verification_queue_wait_milliseconds",
+			Help:    "Captures the time gossip signature batches wait for a verification worker in a milliseconds distribution.",
+			Buckets: []float64{1, 5, 10, 25, 50, 100, 250, 500, 1000},
+		},
+	)
+	signatureVerificationQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signature_verification_queue_depth",
+		Help: "The number of gossip signature batches waiting for a verification worker.",
+	})
+	signatureVerificationWorkers = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signature_verification_workers",
+		Help: "The number of running gossip signature verification workers.",
+	})
 	numberOfSetsAggregated = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "number_of_sets_aggregated",
@@ -82,6 +110,21 @@ var (
 			Buckets: []float64{5, 10, 50, 100, 150, 250, 500, 1000, 2000},
 		},
 	)
+	blockServingCacheHit = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "block_serving_cache_hit_total",
+		Help: "The number of blocks by range batches served from the block serving cache.",
+	})
+	blockServingCacheMiss = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "block_serving_cache_miss_total",
+		Help: "The number of finalized blocks by range batches read from the database.",
+	})
+	rpcRateLimitedCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "rpc_rate_limited_requests_total",
+			Help: "Count of rpc requests rejected because the peer exceeded its quota for the protocol.",
+		},
+		[]string{"topic"},
+	)
 	rpcBlobsByRangeResponseLatency = promauto.NewHistogram(
 		prometheus.HistogramOpts{
 			Name:    "rpc_blobs_by_range_response_latency_milliseconds",
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"github.com/prysmaticlabs/prysm/v5/async/event"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -172,6 +173,14 @@ func WithVerifierWaiter(v *verification.InitializerWaiter) Option {
 	}
 }
 
+// WithSlotTimingsCache for recording when the blocks of the recent slots are first seen on gossip.
+func WithSlotTimingsCache(c *cache.SlotTimingsCache) Option {
+	return func(s *Service) error {
+		s.cfg.slotTimings = c
+		return nil
+	}
+}
+
 // WithAvailableBlocker allows the sync package to access the current
 // status of backfill.
 func WithAvailableBlocker(avb coverage.AvailableBlocker) Option {
@@ -2,7 +2,6 @@ package sync
 
 import (
 	"context"
-	"encoding/hex"
 	"fmt"
 	"sort"
 	"sync"
@@ -22,8 +21,8 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/equality"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	prysmTrace "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
-	"github.com/sirupsen/logrus"
 	"github.com/trailofbits/go-mutexasserts"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -150,7 +149,7 @@ func (s *Service) processPendingBlocks(ctx context.Context) error {
 			if err := s.removeBlockFromQueue(b, blkRoot); err != nil {
 				return err
 			}
-			log.WithFields(logrus.Fields{"slot": slot, "blockRoot": hex.EncodeToString(bytesutil.Trunc(blkRoot[:]))}).Debug("Processed pending block and cleared it in cache")
+			log.WithFields(logging.BlockFields(slot, blkRoot)).Debug("Processed pending block and cleared it in cache")
 		}
 		span.End()
 	}
@@ -210,12 +209,11 @@ func (s *Service) processAndBroadcastBlock(ctx context.Context, b interfaces.Rea
 		return err
 	}
 	if len(request) > 0 {
-		peers := s.getBestPeers()
-		peerCount := len(peers)
-		if peerCount == 0 {
+		peers := s.blobSidecarPeers()
+		if len(peers) == 0 {
 			return errors.Wrapf(errNoPeersForPending, "block root=%#x", blkRoot)
 		}
-		if err := s.sendAndSaveBlobSidecars(ctx, request, peers[rand.NewGenerator().Int()%peerCount], b); err != nil {
+		if err := s.sendAndSaveBlobSidecars(ctx, request, peers, b); err != nil {
 			return err
 		}
 	}
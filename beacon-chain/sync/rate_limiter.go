@@ -1,7 +1,6 @@
 package sync
 
 import (
-	"reflect"
 	"sync"
 	"time"
 
@@ -25,6 +24,11 @@ const blockBucketPeriod = 30 * time.Second
 // Dummy topic to validate all incoming rpc requests.
 const rpcLimiterTopic = "rpc-limiter-topic"
 
+// limiter rate limits the rpc requests of each peer with a token bucket per protocol, so that a peer exhausting
+// its quota of one protocol, such as a syncing peer requesting blocks by range, is still served on the others.
+// The cost of a request is the number of items it asks for, blocks for block requests and sidecars for blob
+// requests. Every request and every further batch of a request served over several batches is also charged to a
+// global bucket of the peer, across protocols.
 type limiter struct {
 	limiterMap map[string]*leakybucket.Collector
 	p2p        p2p.P2P
@@ -46,6 +50,13 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	allowedBlobsPerSecond := float64(flags.Get().BlobBatchLimit)
 	allowedBlobsBurst := int64(flags.Get().BlobBatchLimitBurstFactor * flags.Get().BlobBatchLimit)
 
+	newBlockCollector := func() *leakybucket.Collector {
+		return leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
+	}
+	newBlobCollector := func() *leakybucket.Collector {
+		return leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
+	}
+
 	// Set topic map for all rpc topics.
 	topicMap := make(map[string]*leakybucket.Collector, len(p2p.RPCTopicMappings))
 	// Goodbye Message
@@ -58,26 +69,18 @@ func newRateLimiter(p2pProvider p2p.P2P) *limiter {
 	// Status Message
 	topicMap[addEncoding(p2p.RPCStatusTopicV1)] = leakybucket.NewCollector(1, defaultBurstLimit, leakyBucketPeriod, false /* deleteEmptyBuckets */)
 
-	// Use a single collector for block requests
-	blockCollector := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
-	// Collector for V2
-	blockCollectorV2 := leakybucket.NewCollector(allowedBlocksPerSecond, allowedBlocksBurst, blockBucketPeriod, false /* deleteEmptyBuckets */)
-
-	// for BlobSidecarsByRoot and BlobSidecarsByRange
-	blobCollector := leakybucket.NewCollector(allowedBlobsPerSecond, allowedBlobsBurst, blockBucketPeriod, false)
-
 	// BlocksByRoots requests
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV2)] = blockCollectorV2
+	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV1)] = newBlockCollector()
+	topicMap[addEncoding(p2p.RPCBlocksByRootTopicV2)] = newBlockCollector()
 
 	// BlockByRange requests
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = blockCollector
-	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = blockCollectorV2
+	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV1)] = newBlockCollector()
+	topicMap[addEncoding(p2p.RPCBlocksByRangeTopicV2)] = newBlockCollector()
 
 	// BlobSidecarsByRootV1
-	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = blobCollector
+	topicMap[addEncoding(p2p.RPCBlobSidecarsByRootTopicV1)] = newBlobCollector()
 	// BlobSidecarsByRangeV1
-	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = blobCollector
+	topicMap[addEncoding(p2p.RPCBlobSidecarsByRangeTopicV1)] = newBlobCollector()
 
 	// General topic for all rpc requests.
 	topicMap[rpcLimiterTopic] = leakybucket.NewCollector(5, defaultBurstLimit*2, leakyBucketPeriod, false /* deleteEmptyBuckets */)
@@ -92,7 +95,9 @@ func (l *limiter) topicCollector(topic string) (*leakybucket.Collector, error) {
 	return l.retrieveCollector(topic)
 }
 
-// validates a request with the accompanying cost.
+// validates a request with the accompanying cost. A request exceeding the remaining capacity of the peer is
+// answered with the rate limited response code, without penalizing the peer, which can retry once its bucket
+// has refilled.
 func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 	l.RLock()
 	defer l.RUnlock()
@@ -110,14 +115,15 @@ func (l *limiter) validateRequest(stream network.Stream, amt uint64) error {
 		amt = 1
 	}
 	if amt > uint64(remaining) {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
+		rpcRateLimitedCounter.WithLabelValues(topic).Inc()
+		writeErrorResponseToStream(responseCodeRateLimited, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
 	}
 	return nil
 }
 
-// This is used to validate all incoming rpc streams from external peers.
+// This is used to validate all incoming rpc streams from external peers, answering the rate limited response code
+// like validateRequest.
 func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	l.RLock()
 	defer l.RUnlock()
@@ -133,13 +139,27 @@ func (l *limiter) validateRawRpcRequest(stream network.Stream) error {
 	// Treat each request as a minimum of 1.
 	amt := int64(1)
 	if amt > remaining {
-		l.p2p.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
-		writeErrorResponseToStream(responseCodeInvalidRequest, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
+		rpcRateLimitedCounter.WithLabelValues(topic).Inc()
+		writeErrorResponseToStream(responseCodeRateLimited, p2ptypes.ErrRateLimited.Error(), stream, l.p2p)
 		return p2ptypes.ErrRateLimited
 	}
 	return nil
 }
 
+// validateBatch validates a further batch of a request served over several batches, costing amt. The batch is
+// charged to the global bucket of the peer as a request of its own, while its items are charged to the bucket of
+// the topic as they are served.
+func (l *limiter) validateBatch(stream network.Stream, amt uint64) error {
+	if err := l.validateRawRpcRequest(stream); err != nil {
+		return err
+	}
+	if err := l.validateRequest(stream, amt); err != nil {
+		return err
+	}
+	l.addRawStream(stream)
+	return nil
+}
+
 // adds the cost to our leaky bucket for the topic.
 func (l *limiter) add(stream network.Stream, amt int64) {
 	l.Lock()
@@ -179,20 +199,9 @@ func (l *limiter) free() {
 	l.Lock()
 	defer l.Unlock()
 
-	tempMap := map[uintptr]bool{}
 	for t, collector := range l.limiterMap {
-		// Check if collector has already been cleared off
-		// as all collectors are not distinct from each other.
-		ptr := reflect.ValueOf(collector).Pointer()
-		if tempMap[ptr] {
-			// Remove from map
-			delete(l.limiterMap, t)
-			continue
-		}
 		collector.Free()
-		// Remove from map
 		delete(l.limiterMap, t)
-		tempMap[ptr] = true
 	}
 }
 
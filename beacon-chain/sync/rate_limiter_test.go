@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	mockp2p "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/testing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
@@ -41,7 +42,7 @@ func TestRateLimiter_ExceedCapacity(t *testing.T) {
 		defer wg.Done()
 		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
 		require.NoError(t, err, "could not read incoming stream")
-		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
+		assert.Equal(t, responseCodeRateLimited, code, "not equal response codes")
 		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
 	})
 	wg.Add(1)
@@ -62,6 +63,49 @@ func TestRateLimiter_ExceedCapacity(t *testing.T) {
 	}
 }
 
+func TestRateLimiter_PerProtocol(t *testing.T) {
+	p1 := mockp2p.NewTestP2P(t)
+	p2 := mockp2p.NewTestP2P(t)
+	p1.Connect(p2)
+	p1.Peers().Add(nil, p2.PeerID(), p2.BHost.Addrs()[0], network.DirOutbound)
+
+	rlimiter := newRateLimiter(p1)
+
+	byRange := p2p.RPCBlocksByRangeTopicV2 + p1.Encoding().ProtocolSuffix()
+	byRoot := p2p.RPCBlocksByRootTopicV2 + p1.Encoding().ProtocolSuffix()
+
+	wg := sync.WaitGroup{}
+	p2.BHost.SetStreamHandler(protocol.ID(byRange), func(stream network.Stream) {
+		defer wg.Done()
+		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
+		require.NoError(t, err, "could not read incoming stream")
+		assert.Equal(t, responseCodeRateLimited, code, "not equal response codes")
+		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
+	})
+	p2.BHost.SetStreamHandler(protocol.ID(byRoot), func(stream network.Stream) {})
+	wg.Add(1)
+	rangeStream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(byRange))
+	require.NoError(t, err, "could not create stream")
+	rootStream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(byRoot))
+	require.NoError(t, err, "could not create stream")
+
+	capacity := int64(flags.Get().BlockBatchLimitBurstFactor * flags.Get().BlockBatchLimit)
+	require.NoError(t, rlimiter.validateRequest(rangeStream, uint64(capacity)))
+	rlimiter.add(rangeStream, capacity)
+	require.ErrorIs(t, rlimiter.validateRequest(rangeStream, 1), p2ptypes.ErrRateLimited)
+
+	// Exhausting the quota of blocks by range neither affects blocks by root nor penalizes the peer.
+	require.NoError(t, rlimiter.validateRequest(rootStream, uint64(capacity)))
+	count, err := p1.Peers().Scorers().BadResponsesScorer().Count(p2.PeerID())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, false, p1.Peers().IsBad(p2.PeerID()))
+
+	if util.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 	p1 := mockp2p.NewTestP2P(t)
 	p2 := mockp2p.NewTestP2P(t)
@@ -70,34 +114,30 @@ func TestRateLimiter_ExceedRawCapacity(t *testing.T) {
 
 	rlimiter := newRateLimiter(p1)
 
-	// BlockByRange
-	topic := p2p.RPCBlocksByRangeTopicV1 + p1.Encoding().ProtocolSuffix()
+	// BlobSidecarsByRoot
+	topic := p2p.RPCBlobSidecarsByRootTopicV1 + p1.Encoding().ProtocolSuffix()
 
 	wg := sync.WaitGroup{}
 	p2.BHost.SetStreamHandler(protocol.ID(topic), func(stream network.Stream) {
 		defer wg.Done()
 		code, errMsg, err := readStatusCodeNoDeadline(stream, p2.Encoding())
 		require.NoError(t, err, "could not read incoming stream")
-		assert.Equal(t, responseCodeInvalidRequest, code, "not equal response codes")
+		assert.Equal(t, responseCodeRateLimited, code, "not equal response codes")
 		assert.Equal(t, p2ptypes.ErrRateLimited.Error(), errMsg, "not equal errors")
 	})
 	wg.Add(1)
 	stream, err := p1.BHost.NewStream(context.Background(), p2.PeerID(), protocol.ID(topic))
 	require.NoError(t, err, "could not create stream")
 
-	for i := 0; i < 2*defaultBurstLimit; i++ {
-		err = rlimiter.validateRawRpcRequest(stream)
-		rlimiter.addRawStream(stream)
-		require.NoError(t, err, "could not validate incoming request")
-	}
-	// Triggers rate limit error on burst.
-	assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateRawRpcRequest(stream))
-
-	// Make Peer bad.
-	for i := 0; i < defaultBurstLimit; i++ {
-		assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateRawRpcRequest(stream))
+	// The request and its further batches are charged to the global bucket of the peer.
+	require.NoError(t, rlimiter.validateRawRpcRequest(stream))
+	rlimiter.addRawStream(stream)
+	for i := 1; i < 2*defaultBurstLimit; i++ {
+		require.NoError(t, rlimiter.validateBatch(stream, 1), "could not validate batch")
 	}
-	assert.Equal(t, true, p1.Peers().IsBad(p2.PeerID()), "peer is not marked as a bad peer")
+	// Triggers rate limit error on burst, without penalizing the peer.
+	assert.ErrorContains(t, p2ptypes.ErrRateLimited.Error(), rlimiter.validateBatch(stream, 1))
+	assert.Equal(t, false, p1.Peers().IsBad(p2.PeerID()), "peer is marked as a bad peer")
 	require.NoError(t, stream.Close(), "could not close stream")
 
 	if util.WaitTimeout(&wg, 1*time.Second) {
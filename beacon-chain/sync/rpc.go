@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/faultinjection"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
@@ -174,6 +175,10 @@ func (s *Service) registerRPC(baseTopic string, handle rpcHandler) {
 		}
 		s.rateLimiter.addRawStream(stream)
 
+		if faultinjection.DropRPCResponse() {
+			log.Debug("Dropping RPC request by fault injection")
+			return
+		}
 		if err := stream.SetReadDeadline(time.Now().Add(ttfbTimeout)); err != nil {
 			log.WithError(err).Debug("Could not set stream read deadline")
 			return
@@ -3,6 +3,8 @@ package sync
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync"
 
 	libp2pcore "github.com/libp2p/go-libp2p/core"
 	"github.com/libp2p/go-libp2p/core/peer"
@@ -15,11 +17,26 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
+const (
+	// Most blob sidecars requested by root from a single peer at once, so that the sidecars of a
+	// block are fetched from several peers in parallel.
+	blobsByRootPeerBatchSize = 2
+	// Times the blob sidecars a peer failed to return are requested again from other peers.
+	blobsByRootRetries = 2
+)
+
+var (
+	errNoBlobSidecarPeers  = errors.New("no peers to request blob sidecars from")
+	errMissingBlobSidecars = errors.New("peers did not return the requested blob sidecars")
+)
+
 // sendRecentBeaconBlocksRequest sends a recent beacon blocks request to a peer to get
 // those corresponding blocks from that peer.
 func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, requests *types.BeaconBlockByRootsReq, id peer.ID) error {
@@ -62,7 +79,7 @@ func (s *Service) sendRecentBeaconBlocksRequest(ctx context.Context, requests *t
 		if len(request) == 0 {
 			continue
 		}
-		if err := s.sendAndSaveBlobSidecars(ctx, request, id, blk); err != nil {
+		if err := s.sendAndSaveBlobSidecars(ctx, request, s.blobSidecarPeers(id), blk); err != nil {
 			return err
 		}
 	}
@@ -133,13 +150,13 @@ func (s *Service) beaconBlocksRootRPCHandler(ctx context.Context, msg interface{
 	return nil
 }
 
-// sendAndSaveBlobSidecars sends the blob request and saves received sidecars.
-func (s *Service) sendAndSaveBlobSidecars(ctx context.Context, request types.BlobSidecarsByRootReq, peerID peer.ID, block interfaces.ReadOnlySignedBeaconBlock) error {
+// sendAndSaveBlobSidecars requests the blob sidecars from the peers and saves received sidecars.
+func (s *Service) sendAndSaveBlobSidecars(ctx context.Context, request types.BlobSidecarsByRootReq, pids []peer.ID, block interfaces.ReadOnlySignedBeaconBlock) error {
 	if len(request) == 0 {
 		return nil
 	}
 
-	sidecars, err := SendBlobSidecarByRoot(ctx, s.cfg.clock, s.cfg.p2p, peerID, s.ctxMap, &request)
+	sidecars, err := s.fetchBlobSidecarsByRoot(ctx, request, pids)
 	if err != nil {
 		return err
 	}
@@ -170,6 +187,110 @@ func (s *Service) sendAndSaveBlobSidecars(ctx context.Context, request types.Blo
 	return nil
 }
 
+// fetchBlobSidecarsByRoot requests the blob sidecars from the peers, in the order of the request. The
+// request is split in batches of at most blobsByRootPeerBatchSize sidecars requested from different
+// peers in parallel, each within its own response timeout. The sidecars a peer fails to return are
+// requested again from other peers, up to blobsByRootRetries times.
+func (s *Service) fetchBlobSidecarsByRoot(ctx context.Context, request types.BlobSidecarsByRootReq, pids []peer.ID) ([]blocks.ROBlob, error) {
+	if len(pids) == 0 {
+		return nil, errNoBlobSidecarPeers
+	}
+	type blobID struct {
+		root  [32]byte
+		index uint64
+	}
+	received := make(map[blobID]blocks.ROBlob, len(request))
+	failed := make(map[peer.ID]bool)
+	missing := request
+	var (
+		mu      sync.Mutex
+		lastErr error
+		next    int
+	)
+	// nextPeer returns the next peer which has not failed a request, round robin. The failures are
+	// recorded by the requests of the attempt which are still running, hence read under the lock.
+	nextPeer := func() (peer.ID, bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		for range pids {
+			pid := pids[next%len(pids)]
+			next++
+			if !failed[pid] {
+				return pid, true
+			}
+		}
+		return "", false
+	}
+	for attempt := 0; attempt <= blobsByRootRetries && len(missing) > 0; attempt++ {
+		var wg sync.WaitGroup
+		for start := 0; start < len(missing); start += blobsByRootPeerBatchSize {
+			pid, ok := nextPeer()
+			if !ok {
+				break
+			}
+			batch := missing[start:min(start+blobsByRootPeerBatchSize, len(missing))]
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ctx, cancel := context.WithTimeout(ctx, respTimeout)
+				defer cancel()
+				sidecars, err := SendBlobSidecarByRoot(ctx, s.cfg.clock, s.cfg.p2p, pid, s.ctxMap, &batch)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					log.WithError(err).WithField("peer", pid).Debug("Could not request blob sidecars by root")
+					lastErr = err
+					failed[pid] = true
+					return
+				}
+				if len(sidecars) < len(batch) {
+					failed[pid] = true
+				}
+				for _, sc := range sidecars {
+					received[blobID{root: sc.BlockRoot(), index: sc.Index}] = sc
+				}
+			}()
+		}
+		wg.Wait()
+
+		var remaining types.BlobSidecarsByRootReq
+		for _, id := range missing {
+			if _, ok := received[blobID{root: bytesutil.ToBytes32(id.BlockRoot), index: id.Index}]; !ok {
+				remaining = append(remaining, id)
+			}
+		}
+		missing = remaining
+	}
+	if len(missing) > 0 {
+		if lastErr == nil {
+			lastErr = errMissingBlobSidecars
+		}
+		return nil, errors.Wrapf(lastErr, "could not fetch %d of %d blob sidecars", len(missing), len(request))
+	}
+
+	sidecars := make([]blocks.ROBlob, 0, len(request))
+	for _, id := range request {
+		sidecars = append(sidecars, received[blobID{root: bytesutil.ToBytes32(id.BlockRoot), index: id.Index}])
+	}
+	return sidecars, nil
+}
+
+// blobSidecarPeers returns the peers to request blob sidecars from, the preferred ones first and then
+// the best peers in random order.
+func (s *Service) blobSidecarPeers(preferred ...peer.ID) []peer.ID {
+	best := s.getBestPeers()
+	rand.NewGenerator().Shuffle(len(best), func(i, j int) {
+		best[i], best[j] = best[j], best[i]
+	})
+	pids := append(make([]peer.ID, 0, len(preferred)+len(best)), preferred...)
+	for _, pid := range best {
+		if !slices.Contains(preferred, pid) {
+			pids = append(pids, pid)
+		}
+	}
+	return pids
+}
+
 func (s *Service) pendingBlobsRequestForBlock(root [32]byte, b interfaces.ReadOnlySignedBeaconBlock) (types.BlobSidecarsByRootReq, error) {
 	if b.Version() < version.Deneb {
 		return nil, nil // Block before deneb has no blob.
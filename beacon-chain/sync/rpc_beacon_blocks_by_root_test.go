@@ -11,6 +11,7 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	gcache "github.com/patrickmn/go-cache"
 	mock "github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/testing"
@@ -36,6 +37,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
 func TestRecentBeaconBlocksRPCHandler_ReturnsBlocks(t *testing.T) {
@@ -372,14 +374,14 @@ func TestRequestPendingBlobs(t *testing.T) {
 		require.NoError(t, err)
 		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b)
 		require.NoError(t, err)
-		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, "test", b))
+		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, []peer.ID{"test"}, b))
 	})
 	t.Run("empty commitment block should not fail", func(t *testing.T) {
 		b, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
 		require.NoError(t, err)
 		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b)
 		require.NoError(t, err)
-		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, "test", b))
+		require.NoError(t, s.sendAndSaveBlobSidecars(context.Background(), request, []peer.ID{"test"}, b))
 	})
 	t.Run("unsupported protocol", func(t *testing.T) {
 		p1 := p2ptest.NewTestP2P(t)
@@ -412,10 +414,70 @@ func TestRequestPendingBlobs(t *testing.T) {
 		require.NoError(t, err)
 		request, err := s.pendingBlobsRequestForBlock([32]byte{}, b1)
 		require.NoError(t, err)
-		require.ErrorContains(t, "protocols not supported", s.sendAndSaveBlobSidecars(context.Background(), request, p2.PeerID(), b1))
+		require.ErrorContains(t, "protocols not supported", s.sendAndSaveBlobSidecars(context.Background(), request, []peer.ID{p2.PeerID()}, b1))
 	})
 }
 
+func TestFetchBlobSidecarsByRoot(t *testing.T) {
+	chain, clock := defaultMockChain(t)
+	denebStart, err := slots.EpochStart(params.BeaconConfig().DenebForkEpoch)
+	require.NoError(t, err)
+	_, sidecars := generateTestBlockWithSidecars(t, [32]byte{}, denebStart, fieldparams.MaxBlobsPerBlock)
+	request := blobRootRequestFromSidecars(sidecars).(*p2pTypes.BlobSidecarsByRootReq)
+
+	p1 := p2ptest.NewTestP2P(t)
+	var mu sync.Mutex
+	requested := make(map[peer.ID][]int)
+	serve := func(p *p2ptest.TestP2P) {
+		p.BHost.SetStreamHandler(protocol.ID(p2p.RPCBlobSidecarsByRootTopicV1+p.Encoding().ProtocolSuffix()), func(stream network.Stream) {
+			defer func() {
+				assert.NoError(t, stream.Close())
+			}()
+			req := new(p2pTypes.BlobSidecarsByRootReq)
+			assert.NoError(t, p.Encoding().DecodeWithMaxLength(stream, req))
+			mu.Lock()
+			requested[p.PeerID()] = append(requested[p.PeerID()], len(*req))
+			mu.Unlock()
+			for _, id := range *req {
+				assert.NoError(t, WriteBlobSidecarChunk(stream, clock, p.Encoding(), blocks.NewVerifiedROBlob(sidecars[id.Index])))
+			}
+		})
+		p1.Connect(p)
+	}
+	p2, p3 := p2ptest.NewTestP2P(t), p2ptest.NewTestP2P(t)
+	serve(p2)
+	serve(p3)
+	// The peer does not serve blob sidecars, so its batch is requested again from the others.
+	bogus := p2ptest.NewTestP2P(t)
+	p1.Connect(bogus)
+
+	ctxMap, err := ContextByteVersionsForValRoot(chain.GenesisValidatorsRoot())
+	require.NoError(t, err)
+	s := &Service{cfg: &config{p2p: p1, chain: chain, clock: clock}, ctxMap: ctxMap}
+	got, err := s.fetchBlobSidecarsByRoot(context.Background(), *request, []peer.ID{bogus.PeerID(), p2.PeerID(), p3.PeerID()})
+	require.NoError(t, err)
+	require.Equal(t, len(sidecars), len(got))
+	for i := range got {
+		require.Equal(t, sidecars[i].BlockRoot(), got[i].BlockRoot())
+		require.Equal(t, sidecars[i].Index, got[i].Index)
+	}
+	// Every peer was sent batches of at most two sidecars.
+	var total int
+	for _, sizes := range requested {
+		for _, n := range sizes {
+			require.Equal(t, true, n <= blobsByRootPeerBatchSize)
+			total += n
+		}
+	}
+	require.Equal(t, len(sidecars), total)
+	require.Equal(t, 2, len(requested))
+
+	_, err = s.fetchBlobSidecarsByRoot(context.Background(), *request, []peer.ID{bogus.PeerID()})
+	require.ErrorContains(t, "could not fetch 6 of 6 blob sidecars", err)
+	_, err = s.fetchBlobSidecarsByRoot(context.Background(), *request, nil)
+	require.ErrorIs(t, err, errNoBlobSidecarPeers)
+}
+
 func TestConstructPendingBlobsRequest(t *testing.T) {
 	d := db.SetupDB(t)
 	bs := filesystem.NewEphemeralBlobStorage(t)
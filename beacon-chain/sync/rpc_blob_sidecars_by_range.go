@@ -19,20 +19,47 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 )
 
-func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota uint64, stream libp2pcore.Stream) (uint64, error) {
+// streamBlobBatch writes the sidecars of the batch, up to the write quota of the request. The sidecars of the batch
+// are charged to the peer before they are written, and a batch after the first also counts as a request of its own.
+func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota uint64, first bool, stream libp2pcore.Stream) (uint64, error) {
 	// Defensive check to guard against underflow.
 	if wQuota == 0 {
 		return 0, nil
 	}
 	_, span := trace.StartSpan(ctx, "sync.streamBlobBatch")
 	defer span.End()
-	for _, b := range batch.canonical() {
-		root := b.Root()
+	canonical := batch.canonical()
+	indices := make([][fieldparams.MaxBlobsPerBlock]bool, len(canonical))
+	var n uint64
+	for i, b := range canonical {
 		idxs, err := s.cfg.blobStorage.Indices(b.Root())
 		if err != nil {
 			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
-			return wQuota, errors.Wrapf(err, "could not retrieve sidecars for block root %#x", root)
+			return wQuota, errors.Wrapf(err, "could not retrieve sidecars for block root %#x", b.Root())
+		}
+		indices[i] = idxs
+		for _, ok := range idxs {
+			if ok {
+				n++
+			}
 		}
+	}
+	n = min(n, wQuota)
+	if n == 0 {
+		return wQuota, nil
+	}
+	// Blob sidecars by range requests cost the number of served sidecars, rather than the slots of the batches.
+	validate := s.rateLimiter.validateBatch
+	if first {
+		validate = s.rateLimiter.validateRequest
+	}
+	if err := validate(stream, n); err != nil {
+		return wQuota, err
+	}
+	s.rateLimiter.add(stream, int64(n))
+	for bi, b := range canonical {
+		root := b.Root()
+		idxs := indices[bi]
 		for i, l := uint64(0), uint64(len(idxs)); i < l; i++ {
 			// index not available, skip
 			if !idxs[i] {
@@ -51,7 +78,6 @@ func (s *Service) streamBlobBatch(ctx context.Context, batch blockBatch, wQuota
 				tracing.AnnotateError(span, chunkErr)
 				return wQuota, chunkErr
 			}
-			s.rateLimiter.add(stream, 1)
 			wQuota -= 1
 			// Stop streaming results once the quota of writes for the request is consumed.
 			if wQuota == 0 {
@@ -90,7 +116,7 @@ func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interfa
 	// Ticker to stagger out large requests.
 	ticker := time.NewTicker(time.Second)
 	defer ticker.Stop()
-	batcher, err := newBlockRangeBatcher(rp, s.cfg.beaconDB, s.rateLimiter, s.cfg.chain.IsCanonical, ticker)
+	batcher, err := newBlockRangeBatcher(rp, s.cfg.beaconDB, nil, s.cfg.chain.IsCanonical, ticker, s.blockServingCache, s.finalizedSlot())
 	if err != nil {
 		log.WithError(err).Info("error in BlobSidecarsByRange batch")
 		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
@@ -100,13 +126,17 @@ func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interfa
 
 	var batch blockBatch
 	wQuota := params.BeaconConfig().MaxRequestBlobSidecars
+	first := true
 	for batch, ok = batcher.next(ctx, stream); ok; batch, ok = batcher.next(ctx, stream) {
 		batchStart := time.Now()
-		wQuota, err = s.streamBlobBatch(ctx, batch, wQuota, stream)
+		served := wQuota
+		wQuota, err = s.streamBlobBatch(ctx, batch, wQuota, first, stream)
 		rpcBlobsByRangeResponseLatency.Observe(float64(time.Since(batchStart).Milliseconds()))
 		if err != nil {
 			return err
 		}
+		// The first batch serving sidecars was charged as the request itself.
+		first = first && wQuota == served
 		// once we have written MAX_REQUEST_BLOB_SIDECARS, we're done serving the request
 		if wQuota == 0 {
 			break
@@ -114,7 +144,10 @@ func (s *Service) blobSidecarsByRangeRPCHandler(ctx context.Context, msg interfa
 	}
 	if err := batch.error(); err != nil {
 		log.WithError(err).Debug("error in BlobSidecarsByRange batch")
-		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		// The rate limiter already answered rate limited requests.
+		if !errors.Is(err, p2ptypes.ErrRateLimited) {
+			s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		}
 		tracing.AnnotateError(span, err)
 		return err
 	}
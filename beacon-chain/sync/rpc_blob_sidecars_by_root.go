@@ -39,10 +39,16 @@ func (s *Service) blobSidecarByRootRPCHandler(ctx context.Context, msg interface
 		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
 		return err
 	}
+	batchSize := flags.Get().BlobBatchLimit
+	// Blob sidecars by root requests cost the number of served sidecars, charged as they are served. Each batch
+	// needs the capacity of its sidecars, so that any request of up to MAX_REQUEST_BLOB_SIDECARS can be served
+	// to a peer within its quota, throttled by the ticker.
+	if err := s.rateLimiter.validateRequest(stream, uint64(min(batchSize, len(blobIdents)))); err != nil {
+		return err
+	}
 	// Sort the identifiers so that requests for the same blob root will be adjacent, minimizing db lookups.
 	sort.Sort(blobIdents)
 
-	batchSize := flags.Get().BlobBatchLimit
 	var ticker *time.Ticker
 	if len(blobIdents) > batchSize {
 		ticker = time.NewTicker(time.Second)
@@ -64,6 +70,9 @@ func (s *Service) blobSidecarByRootRPCHandler(ctx context.Context, msg interface
 		// Throttle request processing to no more than batchSize/sec.
 		if i != 0 && i%batchSize == 0 && ticker != nil {
 			<-ticker.C
+			if err := s.rateLimiter.validateBatch(stream, uint64(min(batchSize, len(blobIdents)-i))); err != nil {
+				return err
+			}
 		}
 		s.rateLimiter.add(stream, 1)
 		root, idx := bytesutil.ToBytes32(blobIdents[i].BlockRoot), blobIdents[i].Index
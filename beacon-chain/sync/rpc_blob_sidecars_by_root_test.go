@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
 	p2pTypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
@@ -259,3 +260,36 @@ func TestBlobsByRootOK(t *testing.T) {
 		})
 	}
 }
+
+func TestBlobsByRootOK_ExceedsBurst(t *testing.T) {
+	resetFlags := flags.Get()
+	gFlags := new(flags.GlobalFlags)
+	gFlags.BlobBatchLimit = 4
+	gFlags.BlobBatchLimitBurstFactor = 2
+	flags.Init(gFlags)
+	defer flags.Init(resetFlags)
+
+	// A request for more sidecars than a batch is served in batches, each charged to the peer.
+	c := &blobsTestCase{name: "more blobs than a batch", nblocks: 2, rateBurst: 12}
+	c.runTestBlobSidecarsByRoot(t)
+}
+
+func TestBlobsByRoot_RateLimitedBatch(t *testing.T) {
+	resetFlags := flags.Get()
+	gFlags := new(flags.GlobalFlags)
+	gFlags.BlobBatchLimit = 4
+	gFlags.BlobBatchLimitBurstFactor = 2
+	flags.Init(gFlags)
+	defer flags.Init(resetFlags)
+
+	// The third batch of the request exceeds the capacity of the peer, and is answered as rate limited.
+	c := &blobsTestCase{name: "batch exceeding the capacity", nblocks: 2, rateBurst: 8, err: p2pTypes.ErrRateLimited}
+	c.defineExpected = func(t *testing.T, scs []blocks.ROBlob, req interface{}) []*expectedBlobChunk {
+		expect := c.filterExpectedByRoot(t, scs, req)[:8]
+		return append(expect, &expectedBlobChunk{
+			code:    responseCodeRateLimited,
+			message: p2pTypes.ErrRateLimited.Error(),
+		})
+	}
+	c.runTestBlobSidecarsByRoot(t)
+}
@@ -98,6 +98,9 @@ func readFirstChunkedBlock(stream libp2pcore.Stream, tor blockchain.TemporalOrac
 	if err != nil {
 		return nil, err
 	}
+	if code == responseCodeRateLimited {
+		return nil, types.ErrRateLimited
+	}
 	if code != 0 {
 		return nil, errors.New(errMsg)
 	}
@@ -121,6 +124,9 @@ func readResponseChunk(stream libp2pcore.Stream, tor blockchain.TemporalOracle,
 	if err != nil {
 		return nil, err
 	}
+	if code == responseCodeRateLimited {
+		return nil, types.ErrRateLimited
+	}
 	if code != 0 {
 		return nil, errors.New(errMsg)
 	}
@@ -344,6 +344,9 @@ func readChunkedBlobSidecar(stream network.Stream, encoding encoder.NetworkEncod
 	if err != nil {
 		return b, err
 	}
+	if code == responseCodeRateLimited {
+		return b, p2ptypes.ErrRateLimited
+	}
 	if code != 0 {
 		return b, errors.Wrap(errBlobChunkedReadFailure, msg)
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/async/abool"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
 	blockfeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/v5/beacon-chain/core/feed/state"
@@ -96,6 +97,7 @@ type config struct {
 	clock                   *startup.Clock
 	stateNotifier           statefeed.Notifier
 	blobStorage             *filesystem.BlobStorage
+	slotTimings             *cache.SlotTimingsCache
 }
 
 // This defines the interface for interacting with block chain service
@@ -129,6 +131,7 @@ type Service struct {
 	chainStarted                     *abool.AtomicBool
 	validateBlockLock                sync.RWMutex
 	rateLimiter                      *limiter
+	blockServingCache                *blockServingCache
 	seenBlockLock                    sync.RWMutex
 	seenBlockCache                   *lru.Cache
 	seenBlobLock                     sync.RWMutex
@@ -173,6 +176,7 @@ func NewService(ctx context.Context, opts ...Option) *Service {
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]ethpb.SignedAggregateAttAndProof),
 		signatureChan:        make(chan *signatureVerifier, verifierLimit),
+		blockServingCache:    newBlockServingCache(blockServingCacheSlots),
 	}
 	for _, opt := range opts {
 		if err := opt(r); err != nil {
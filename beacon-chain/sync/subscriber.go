@@ -317,6 +317,11 @@ func (s *Service) wrapAndReportValidation(topic string, v wrappedVal) (string, p
 			}
 			messageIgnoredValidationCounter.WithLabelValues(topic).Inc()
 		}
+		// Gossip observers ignore valid messages, so that they are neither forwarded nor handled.
+		if b == pubsub.ValidationAccept && flags.Get().GossipObserver {
+			messageObservedValidCounter.WithLabelValues(topic).Inc()
+			return pubsub.ValidationIgnore
+		}
 		return b
 	}
 }
@@ -16,6 +16,10 @@ func (s *Service) blobSubscriber(ctx context.Context, msg proto.Message) error {
 		return fmt.Errorf("message was not type blocks.ROBlob, type=%T", msg)
 	}
 
+	// Copies of a sidecar validated concurrently can all pass validation, only the first one is imported.
+	if !s.setSeenBlobIndex(b.Slot(), b.ProposerIndex(), b.Index) {
+		return nil
+	}
 	return s.subscribeBlob(ctx, b)
 }
 
@@ -355,6 +355,7 @@ func Test_wrapAndReportValidation(t *testing.T) {
 		topic        string
 		v            wrappedVal
 		chainstarted bool
+		observer     bool
 		pid          peer.ID
 		msg          *pubsub.Message
 	}
@@ -420,6 +421,26 @@ func Test_wrapAndReportValidation(t *testing.T) {
 			},
 			want: pubsub.ValidationAccept,
 		},
+		{
+			name: "validator OK in gossip observer mode",
+			args: args{
+				topic: mockTopic,
+				v: func(ctx context.Context, id peer.ID, message *pubsub.Message) (pubsub.ValidationResult, error) {
+					return pubsub.ValidationAccept, nil
+				},
+				chainstarted: true,
+				observer:     true,
+				msg: &pubsub.Message{
+					Message: &pubsubpb.Message{
+						Topic: func() *string {
+							s := mockTopic
+							return &s
+						}(),
+					},
+				},
+			},
+			want: pubsub.ValidationIgnore,
+		},
 		{
 			name: "nil topic",
 			args: args{
@@ -438,6 +459,9 @@ func Test_wrapAndReportValidation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			resetFlags := flags.Get()
+			flags.Init(&flags.GlobalFlags{GossipObserver: tt.args.observer})
+			defer flags.Init(resetFlags)
 			chainStarted := abool.New()
 			chainStarted.SetTo(tt.args.chainstarted)
 			s := &Service{
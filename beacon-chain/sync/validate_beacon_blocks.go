@@ -140,6 +140,7 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		log.WithError(err).WithFields(getBlockFields(blk)).Debug("Ignored block: could not capture arrival time metric")
 		return pubsub.ValidationIgnore, nil
 	}
+	s.cfg.slotTimings.SetBlockFirstSeen(blk.Block().Slot(), blockRoot, time.Since(slots.BeginsAt(blk.Block().Slot(), s.cfg.clock.GenesisTime())))
 
 	cp := s.cfg.chain.FinalizedCheckpt()
 	startSlot, err := slots.EpochStart(cp.Epoch)
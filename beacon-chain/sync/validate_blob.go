@@ -112,6 +112,7 @@ func (s *Service) validateBlob(ctx context.Context, pid peer.ID, msg *pubsub.Mes
 		return pubsub.ValidationReject, err
 	}
 
+	// The KZG proofs of the sidecars of a block validated concurrently are verified together in a batch.
 	if err := vf.SidecarKzgProofVerified(); err != nil {
 		saveInvalidBlobToTemp(blob)
 		return pubsub.ValidationReject, err
@@ -150,13 +151,16 @@ func (s *Service) hasSeenBlobIndex(slot primitives.Slot, proposerIndex primitive
 	return seen
 }
 
-// Sets the blob with the same slot, proposer index, and blob index as seen.
-func (s *Service) setSeenBlobIndex(slot primitives.Slot, proposerIndex primitives.ValidatorIndex, index uint64) {
+// Sets the blob with the same slot, proposer index, and blob index as seen, and returns false if it
+// had already been seen. Copies of a sidecar validated concurrently can all pass validation before
+// any of them is imported, and only the first one must be imported.
+func (s *Service) setSeenBlobIndex(slot primitives.Slot, proposerIndex primitives.ValidatorIndex, index uint64) bool {
 	s.seenBlobLock.Lock()
 	defer s.seenBlobLock.Unlock()
 	b := append(bytesutil.Bytes32(uint64(slot)), bytesutil.Bytes32(uint64(proposerIndex))...)
 	b = append(b, bytesutil.Bytes32(index)...)
-	s.seenBlobCache.Add(string(b), true)
+	seen, _ := s.seenBlobCache.ContainsOrAdd(string(b), true)
+	return !seen
 }
 
 func blobFields(b blocks.ROBlob) logrus.Fields {
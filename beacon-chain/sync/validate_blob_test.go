@@ -290,3 +290,13 @@ func testNewBlobVerifier() verification.NewBlobVerifier {
 		return &verification.MockBlobVerifier{}
 	}
 }
+
+func TestSetSeenBlobIndex_Once(t *testing.T) {
+	s := &Service{seenBlobCache: lruwrpr.New(10)}
+	require.Equal(t, false, s.hasSeenBlobIndex(1, 2, 0))
+	require.Equal(t, true, s.setSeenBlobIndex(1, 2, 0))
+	require.Equal(t, true, s.hasSeenBlobIndex(1, 2, 0))
+	// A copy of the sidecar that passed validation concurrently is not imported again.
+	require.Equal(t, false, s.setSeenBlobIndex(1, 2, 0))
+	require.Equal(t, true, s.setSeenBlobIndex(1, 2, 1))
+}
@@ -0,0 +1,149 @@
+package sync
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"time"
+
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// verifierIdleTimeout is the time after which an idle worker of an adaptive pool stops.
+const verifierIdleTimeout = 10 * time.Second
+
+type queuedBatch struct {
+	verifiers []*signatureVerifier
+	queued    time.Time
+}
+
+// verifierPool verifies the signature batches collected from gossip on a pool of workers. A pool of fixed size runs
+// its workers for as long as it runs, whereas an adaptive pool starts a worker whenever batches are waiting and all
+// its workers are busy, up to one worker per CPU, and stops the workers which remain idle.
+type verifierPool struct {
+	ctx        context.Context
+	adaptive   bool
+	maxWorkers int
+	batches    chan queuedBatch
+	verify     func([]*signatureVerifier)
+	lock       sync.Mutex
+	workers    int
+	idle       int
+}
+
+// newVerifierPool starts a pool of the given number of workers verifying batches with verify, or an adaptive pool
+// when size is 0.
+func newVerifierPool(ctx context.Context, size int, verify func([]*signatureVerifier)) *verifierPool {
+	p := &verifierPool{ctx: ctx, maxWorkers: size, verify: verify}
+	if size <= 0 {
+		p.adaptive = true
+		p.maxWorkers = runtime.GOMAXPROCS(0)
+	}
+	p.batches = make(chan queuedBatch, p.maxWorkers)
+	start := p.maxWorkers
+	if p.adaptive {
+		start = 1
+	}
+	p.lock.Lock()
+	for i := 0; i < start; i++ {
+		p.startWorkerNoLock()
+	}
+	p.lock.Unlock()
+	return p
+}
+
+// submit queues a batch for verification, waiting when as many batches as workers are already queued.
+func (p *verifierPool) submit(verifiers []*signatureVerifier) {
+	if err := p.ctx.Err(); err != nil {
+		sendBatchError(verifiers, err)
+		return
+	}
+	b := queuedBatch{verifiers: verifiers, queued: prysmTime.Now()}
+	p.lock.Lock()
+	if p.adaptive && p.idle == 0 && p.workers < p.maxWorkers {
+		p.startWorkerNoLock()
+	}
+	p.lock.Unlock()
+	select {
+	case p.batches <- b:
+		signatureVerificationQueueDepth.Set(float64(len(p.batches)))
+	case <-p.ctx.Done():
+		sendBatchError(verifiers, p.ctx.Err())
+	}
+}
+
+func (p *verifierPool) startWorkerNoLock() {
+	p.workers++
+	p.idle++
+	signatureVerificationWorkers.Set(float64(p.workers))
+	go p.work()
+}
+
+func (p *verifierPool) work() {
+	var idleTimeout <-chan time.Time
+	if p.adaptive {
+		t := time.NewTicker(verifierIdleTimeout)
+		defer t.Stop()
+		idleTimeout = t.C
+	}
+	lastBatch := prysmTime.Now()
+	for {
+		select {
+		case <-p.ctx.Done():
+			p.drain()
+			return
+		case b := <-p.batches:
+			p.setIdle(false)
+			signatureVerificationQueueDepth.Set(float64(len(p.batches)))
+			signatureVerificationQueueWait.Observe(float64(prysmTime.Since(b.queued).Milliseconds()))
+			p.verify(b.verifiers)
+			p.setIdle(true)
+			lastBatch = prysmTime.Now()
+		case <-idleTimeout:
+			if prysmTime.Since(lastBatch) >= verifierIdleTimeout && p.stopIdle() {
+				return
+			}
+		}
+	}
+}
+
+func (p *verifierPool) setIdle(idle bool) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if idle {
+		p.idle++
+	} else {
+		p.idle--
+	}
+}
+
+// stopIdle stops an idle worker unless it is the last worker of the pool.
+func (p *verifierPool) stopIdle() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.workers <= 1 {
+		return false
+	}
+	p.workers--
+	p.idle--
+	signatureVerificationWorkers.Set(float64(p.workers))
+	return true
+}
+
+// drain fails the batches left in the queue once the pool is stopped.
+func (p *verifierPool) drain() {
+	for {
+		select {
+		case b := <-p.batches:
+			sendBatchError(b.verifiers, p.ctx.Err())
+		default:
+			return
+		}
+	}
+}
+
+func sendBatchError(verifiers []*signatureVerifier, err error) {
+	for _, v := range verifiers {
+		v.resChan <- err
+	}
+}
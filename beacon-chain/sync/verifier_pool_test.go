@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestVerifierPool_Fixed(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	verified := make(chan int, 10)
+	p := newVerifierPool(ctx, 3, func(v []*signatureVerifier) {
+		verified <- len(v)
+	})
+	assert.Equal(t, false, p.adaptive)
+	assert.Equal(t, 3, p.workers)
+	p.submit(make([]*signatureVerifier, 2))
+	assert.Equal(t, 2, <-verified)
+}
+
+func TestVerifierPool_Adaptive(t *testing.T) {
+	if runtime.GOMAXPROCS(0) < 2 {
+		t.Skip("adaptive pool needs more than one CPU to grow")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	unblock := make(chan struct{})
+	started := make(chan struct{}, 10)
+	p := newVerifierPool(ctx, 0, func([]*signatureVerifier) {
+		started <- struct{}{}
+		<-unblock
+	})
+	assert.Equal(t, true, p.adaptive)
+	assert.Equal(t, 1, p.workers)
+
+	// A batch submitted while every worker is busy starts a worker.
+	p.submit(nil)
+	<-started
+	p.submit(nil)
+	select {
+	case <-started:
+	case <-time.After(time.Second):
+		t.Fatal("second batch was not picked up by a new worker")
+	}
+	p.lock.Lock()
+	assert.Equal(t, 2, p.workers)
+	p.lock.Unlock()
+	close(unblock)
+
+	// Idle workers stop, except the last one.
+	require.Equal(t, true, p.stopIdle())
+	assert.Equal(t, false, p.stopIdle())
+}
+
+func TestVerifierPool_Stopped(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := newVerifierPool(ctx, 1, func([]*signatureVerifier) {})
+	cancel()
+	v := &signatureVerifier{resChan: make(chan error, 1)}
+	p.submit([]*signatureVerifier{v})
+	require.ErrorIs(t, <-v.resChan, context.Canceled)
+}
@@ -2,11 +2,13 @@ package verification
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 )
 
 var (
@@ -26,6 +28,7 @@ func NewBlobBatchVerifier(newVerifier NewBlobVerifier, reqs []Requirement) *Blob
 		verifyKzg:   kzg.Verify,
 		newVerifier: newVerifier,
 		reqs:        reqs,
+		verified:    make(map[*ethpb.BlobSidecar]struct{}),
 	}
 }
 
@@ -39,6 +42,36 @@ type BlobBatchVerifier struct {
 	verifyKzg   roblobCommitmentVerifier
 	newVerifier NewBlobVerifier
 	reqs        []Requirement
+	lock        sync.Mutex
+	// verified holds the sidecars of which the kzg commitments were verified by VerifyKzg.
+	verified map[*ethpb.BlobSidecar]struct{}
+}
+
+// VerifyKzg verifies the kzg commitments of the blob sidecars of several blocks together, which is cheaper than
+// verifying the sidecars of each block in turn. VerifiedROBlobs does not verify the commitments of these sidecars again.
+func (batch *BlobBatchVerifier) VerifyKzg(scs ...blocks.ROBlob) error {
+	if err := batch.verifyKzg(scs...); err != nil {
+		return err
+	}
+	batch.lock.Lock()
+	defer batch.lock.Unlock()
+	for i := range scs {
+		batch.verified[scs[i].BlobSidecar] = struct{}{}
+	}
+	return nil
+}
+
+// unverifiedKzg returns the sidecars of which the kzg commitments were not verified by VerifyKzg.
+func (batch *BlobBatchVerifier) unverifiedKzg(scs []blocks.ROBlob) []blocks.ROBlob {
+	batch.lock.Lock()
+	defer batch.lock.Unlock()
+	unverified := make([]blocks.ROBlob, 0, len(scs))
+	for i := range scs {
+		if _, ok := batch.verified[scs[i].BlobSidecar]; !ok {
+			unverified = append(unverified, scs[i])
+		}
+	}
+	return unverified
 }
 
 // VerifiedROBlobs satisfies the das.BlobBatchVerifier interface, used by das.AvailabilityStore.
@@ -61,7 +94,7 @@ func (batch *BlobBatchVerifier) VerifiedROBlobs(ctx context.Context, blk blocks.
 		}
 	}
 	// Verify commitments for all blobs at once. verifyOneBlob assumes it is only called once this check succeeds.
-	if err := batch.verifyKzg(scs...); err != nil {
+	if err := batch.verifyKzg(batch.unverifiedKzg(scs)...); err != nil {
 		return nil, err
 	}
 	vs := make([]blocks.VerifiedROBlob, len(scs))
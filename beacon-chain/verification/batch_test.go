@@ -187,3 +187,36 @@ func TestBatchVerifier(t *testing.T) {
 		})
 	}
 }
+
+func TestBatchVerifier_VerifyKzg(t *testing.T) {
+	ctx := context.Background()
+	var verified []int
+	nv := func(bl blocks.ROBlob, reqs []Requirement) BlobVerifier {
+		return &MockBlobVerifier{CbVerifiedROBlob: func() (blocks.VerifiedROBlob, error) {
+			return blocks.VerifiedROBlob{ROBlob: bl}, nil
+		}}
+	}
+	bbv := NewBlobBatchVerifier(nv, InitsyncSidecarRequirements)
+	bbv.verifyKzg = func(scs ...blocks.ROBlob) error {
+		verified = append(verified, len(scs))
+		return nil
+	}
+	blk1, blbs1 := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 1, 2)
+	blk2, blbs2 := util.GenerateTestDenebBlockWithSidecar(t, blk1.Root(), 2, 3)
+	require.NoError(t, bbv.VerifyKzg(append(blbs1, blbs2...)...))
+
+	// The commitments of the sidecars of both blocks were verified together and are not verified again.
+	vb, err := bbv.VerifiedROBlobs(ctx, blk1, blbs1)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vb))
+	vb, err = bbv.VerifiedROBlobs(ctx, blk2, blbs2)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(vb))
+	require.Equal(t, []int{5, 0, 0}, verified)
+
+	// Sidecars which were not verified together are verified with their block.
+	blk3, blbs3 := util.GenerateTestDenebBlockWithSidecar(t, blk2.Root(), 3, 1)
+	_, err = bbv.VerifiedROBlobs(ctx, blk3, blbs3)
+	require.NoError(t, err)
+	require.Equal(t, []int{5, 0, 0, 1}, verified)
+}
@@ -209,18 +209,40 @@ func (bv *ROBlobVerifier) ValidProposerSignature(ctx context.Context) (err error
 	}
 	blobVerificationProposerSignatureCache.WithLabelValues("miss").Inc()
 
-	// Retrieve the parent state to fallback to full verification.
-	parent, err := bv.parentState(ctx)
-	if err != nil {
-		log.WithFields(logging.BlobFields(bv.blob)).WithError(err).Debug("could not replay parent state for blob signature verification")
-		return ErrInvalidProposerSignature
-	}
-	// Full verification, which will subsequently be cached for anything sharing the signature cache.
-	if err = bv.sc.VerifySignature(sd, parent); err != nil {
-		log.WithFields(logging.BlobFields(bv.blob)).WithError(err).Debug("signature verification failed")
-		return ErrInvalidProposerSignature
+	// The sidecars of a block arrive together and share the signature of its header, so concurrent
+	// verifications of the same signature wait for the first one rather than each replaying the parent state.
+	for {
+		_, err, shared := bv.sigVerifications.Do(sd.key(), func() (interface{}, error) {
+			// Retrieve the parent state to fallback to full verification.
+			parent, err := bv.parentState(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					// Not a verdict on the signature, so that the waiting verifications retry with their own context.
+					return nil, ctx.Err()
+				}
+				log.WithFields(logging.BlobFields(bv.blob)).WithError(err).Debug("could not replay parent state for blob signature verification")
+				return nil, ErrInvalidProposerSignature
+			}
+			// Full verification, which will subsequently be cached for anything sharing the signature cache.
+			if err := bv.sc.VerifySignature(sd, parent); err != nil {
+				log.WithFields(logging.BlobFields(bv.blob)).WithError(err).Debug("signature verification failed")
+				return nil, ErrInvalidProposerSignature
+			}
+			return nil, nil
+		})
+		if shared {
+			blobVerificationProposerSignatureCache.WithLabelValues("shared").Inc()
+		}
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			if ctx.Err() == nil {
+				// The context of the verification shared with this one was done.
+				continue
+			}
+			log.WithFields(logging.BlobFields(bv.blob)).WithError(err).Debug("could not replay parent state for blob signature verification")
+			return ErrInvalidProposerSignature
+		}
+		return err
 	}
-	return nil
 }
 
 // SidecarParentSeen represents the spec verification:
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -223,6 +225,91 @@ func TestValidProposerSignature_CacheMiss(t *testing.T) {
 	require.NotNil(t, v.results.result(RequireValidProposerSignature))
 }
 
+func TestValidProposerSignature_SharedAcrossSidecars(t *testing.T) {
+	ctx := context.Background()
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 3)
+	var verified atomic.Bool
+	var checked sync.WaitGroup
+	checked.Add(len(blobs))
+	sc := &mockSignatureCache{
+		svcb: func(sig SignatureData) (bool, error) {
+			checked.Done()
+			return verified.Load(), nil
+		},
+		vscb: func(sig SignatureData, v ValidatorAtIndexer) (err error) {
+			verified.Store(true)
+			return nil
+		},
+	}
+	var lookups atomic.Int32
+	sr := &mockStateByRooter{sbr: func(_ context.Context, root [32]byte) (state.BeaconState, error) {
+		lookups.Add(1)
+		// Hold the verification until every sidecar missed the cache.
+		checked.Wait()
+		time.Sleep(10 * time.Millisecond)
+		return &validxStateOverride{vals: map[primitives.ValidatorIndex]*ethpb.Validator{blobs[0].ProposerIndex(): {}}}, nil
+	}}
+	ini := Initializer{shared: &sharedResources{sc: sc, sr: sr}}
+
+	var wg sync.WaitGroup
+	for _, b := range blobs {
+		v := ini.NewBlobVerifier(b, GossipSidecarRequirements)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			require.NoError(t, v.ValidProposerSignature(ctx))
+			require.NoError(t, v.results.result(RequireValidProposerSignature))
+		}()
+	}
+	wg.Wait()
+	require.Equal(t, int32(1), lookups.Load())
+}
+
+func TestValidProposerSignature_SharedCanceled(t *testing.T) {
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 2)
+	checked := make(chan struct{}, len(blobs))
+	sc := &mockSignatureCache{
+		svcb: func(sig SignatureData) (bool, error) {
+			checked <- struct{}{}
+			return false, nil
+		},
+		vscb: func(sig SignatureData, v ValidatorAtIndexer) (err error) {
+			return nil
+		},
+	}
+	var lookups atomic.Int32
+	release := make(chan struct{})
+	sr := &mockStateByRooter{sbr: func(ctx context.Context, root [32]byte) (state.BeaconState, error) {
+		if lookups.Add(1) == 1 {
+			<-release
+			return nil, ctx.Err()
+		}
+		return &validxStateOverride{vals: map[primitives.ValidatorIndex]*ethpb.Validator{blobs[0].ProposerIndex(): {}}}, nil
+	}}
+	ini := Initializer{shared: &sharedResources{sc: sc, sr: sr}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error)
+	go func() {
+		leaderErr <- ini.NewBlobVerifier(blobs[0], GossipSidecarRequirements).ValidProposerSignature(ctx)
+	}()
+	<-checked
+	followerErr := make(chan error)
+	go func() {
+		followerErr <- ini.NewBlobVerifier(blobs[1], GossipSidecarRequirements).ValidProposerSignature(context.Background())
+	}()
+	<-checked
+	// Let the follower join the verification of the leader before the leader's context is canceled.
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	close(release)
+
+	require.ErrorIs(t, <-leaderErr, ErrInvalidProposerSignature)
+	// The cancellation of the leader is not a verdict on the signature, so the follower verifies it again.
+	require.NoError(t, <-followerErr)
+	require.Equal(t, int32(2), lookups.Load())
+}
+
 func badParentCb(t *testing.T, expected [32]byte, e bool) func([32]byte) bool {
 	return func(r [32]byte) bool {
 		if expected != r {
@@ -582,6 +669,7 @@ func fcReturnsTargetRoot(root [32]byte) func([32]byte, primitives.Epoch) ([32]by
 }
 
 type mockSignatureCache struct {
+	sync.Mutex
 	svCalledForSig map[SignatureData]bool
 	svcb           func(sig SignatureData) (bool, error)
 	vsCalledForSig map[SignatureData]bool
@@ -590,19 +678,23 @@ type mockSignatureCache struct {
 
 // SignatureVerified implements SignatureCache.
 func (m *mockSignatureCache) SignatureVerified(sig SignatureData) (bool, error) {
+	m.Lock()
 	if m.svCalledForSig == nil {
 		m.svCalledForSig = make(map[SignatureData]bool)
 	}
 	m.svCalledForSig[sig] = true
+	m.Unlock()
 	return m.svcb(sig)
 }
 
 // VerifySignature implements SignatureCache.
 func (m *mockSignatureCache) VerifySignature(sig SignatureData, v ValidatorAtIndexer) (err error) {
+	m.Lock()
 	if m.vsCalledForSig == nil {
 		m.vsCalledForSig = make(map[SignatureData]bool)
 	}
 	m.vsCalledForSig[sig] = true
+	m.Unlock()
 	return m.vscb(sig, v)
 }
 
@@ -611,15 +703,18 @@ var _ SignatureCache = &mockSignatureCache{}
 type sbrfunc func(context.Context, [32]byte) (state.BeaconState, error)
 
 type mockStateByRooter struct {
+	sync.Mutex
 	sbr           sbrfunc
 	calledForRoot map[[32]byte]bool
 }
 
 func (sbr *mockStateByRooter) StateByRoot(ctx context.Context, root [32]byte) (state.BeaconState, error) {
+	sbr.Lock()
 	if sbr.calledForRoot == nil {
 		sbr.calledForRoot = make(map[[32]byte]bool)
 	}
 	sbr.calledForRoot[root] = true
+	sbr.Unlock()
 	return sbr.sbr(ctx, root)
 }
 
@@ -50,6 +50,11 @@ type SignatureData struct {
 	Slot      primitives.Slot
 }
 
+// key identifies the signature among the verifications in flight.
+func (d SignatureData) key() string {
+	return string(d.Root[:]) + string(d.Signature[:])
+}
+
 func (d SignatureData) logFields() log.Fields {
 	return log.Fields{
 		"root":       fmt.Sprintf("%#x", d.Root),
@@ -4,7 +4,6 @@ import (
 	"context"
 	"sync"
 
-	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
 	forkchoicetypes "github.com/prysmaticlabs/prysm/v5/beacon-chain/forkchoice/types"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -12,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"golang.org/x/sync/singleflight"
 )
 
 // Forkchoicer represents the forkchoice methods that the verifiers need.
@@ -38,6 +38,10 @@ type sharedResources struct {
 	sc    SignatureCache
 	pc    ProposerCache
 	sr    StateByRooter
+	// sigVerifications deduplicates the proposer signature verifications in flight.
+	sigVerifications singleflight.Group
+	// kzgBatches batches the KZG proof verifications of the sidecars of a block in flight.
+	kzgBatches kzgBatcher
 }
 
 // Initializer is used to create different Verifiers.
@@ -53,7 +57,9 @@ func (ini *Initializer) NewBlobVerifier(b blocks.ROBlob, reqs []Requirement) *RO
 		sharedResources:      ini.shared,
 		blob:                 b,
 		results:              newResults(reqs...),
-		verifyBlobCommitment: kzg.Verify,
+		verifyBlobCommitment: func(sidecars ...blocks.ROBlob) error {
+			return ini.shared.kzgBatches.verify(sidecars...)
+		},
 	}
 }
 
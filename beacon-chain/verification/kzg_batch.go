@@ -0,0 +1,115 @@
+package verification
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain/kzg"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+)
+
+// kzgBatcher batches the KZG proof verifications of the sidecars of a block which are requested concurrently, like the
+// sidecars of a block arriving on their gossip subnets at the same time. The first sidecar of a block is verified right
+// away, and the sidecars of the block requested while a verification is in flight are verified together in a single
+// batch once it completes, so that a lone sidecar is not delayed waiting for a batch to fill.
+type kzgBatcher struct {
+	sync.Mutex
+	// verifyProofs verifies the KZG proofs of sidecars, kzg.Verify unless set by tests.
+	verifyProofs roblobCommitmentVerifier
+	blocks       map[[32]byte]*kzgBlockBatches
+}
+
+// kzgBlockBatches tracks the verifications of the sidecars of a block: whether a batch is in flight, and the sidecars
+// waiting for it to complete.
+type kzgBlockBatches struct {
+	inFlight bool
+	pending  []*kzgBatchEntry
+}
+
+// kzgBatchEntry is a sidecar waiting for the verification of its KZG proof.
+type kzgBatchEntry struct {
+	blob blocks.ROBlob
+	err  error
+	done chan struct{}
+}
+
+func (b *kzgBatcher) verifier() roblobCommitmentVerifier {
+	if b.verifyProofs != nil {
+		return b.verifyProofs
+	}
+	return kzg.Verify
+}
+
+// verify verifies the KZG proofs of the sidecars. A single sidecar is batched with the other sidecars of its block
+// verified concurrently.
+func (b *kzgBatcher) verify(sidecars ...blocks.ROBlob) error {
+	if len(sidecars) != 1 {
+		return b.verifier()(sidecars...)
+	}
+	entry := &kzgBatchEntry{blob: sidecars[0], done: make(chan struct{})}
+	root := entry.blob.BlockRoot()
+
+	b.Lock()
+	if b.blocks == nil {
+		b.blocks = make(map[[32]byte]*kzgBlockBatches)
+	}
+	bb, ok := b.blocks[root]
+	if !ok {
+		bb = &kzgBlockBatches{}
+		b.blocks[root] = bb
+	}
+	bb.pending = append(bb.pending, entry)
+	var batch []*kzgBatchEntry
+	if !bb.inFlight {
+		bb.inFlight = true
+		batch, bb.pending = bb.pending, nil
+	}
+	b.Unlock()
+
+	if batch != nil {
+		b.run(root, batch)
+	}
+	<-entry.done
+	return entry.err
+}
+
+// run verifies a batch of sidecars of the block, then hands the sidecars of the block which arrived in the meantime
+// over to another goroutine as the next batch.
+func (b *kzgBatcher) run(root [32]byte, batch []*kzgBatchEntry) {
+	b.verifyBatch(batch)
+
+	b.Lock()
+	bb := b.blocks[root]
+	next := bb.pending
+	bb.pending = nil
+	if len(next) == 0 {
+		delete(b.blocks, root)
+	}
+	b.Unlock()
+
+	if len(next) > 0 {
+		go b.run(root, next)
+	}
+}
+
+// verifyBatch verifies the KZG proofs of a batch with a single batch verification, falling back to verifying them one
+// by one to tell the invalid proofs apart when the batch fails. Waiting sidecars are released in the order of their
+// index.
+func (b *kzgBatcher) verifyBatch(batch []*kzgBatchEntry) {
+	sort.SliceStable(batch, func(i, j int) bool {
+		return batch[i].blob.Index < batch[j].blob.Index
+	})
+	verify := b.verifier()
+	sidecars := make([]blocks.ROBlob, len(batch))
+	for i, e := range batch {
+		sidecars[i] = e.blob
+	}
+	err := verify(sidecars...)
+	for _, e := range batch {
+		e.err = err
+		if err != nil && len(batch) > 1 {
+			e.err = verify(e.blob)
+		}
+		close(e.done)
+	}
+}
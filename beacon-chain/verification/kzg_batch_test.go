@@ -0,0 +1,85 @@
+package verification
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestKzgBatcher(t *testing.T) {
+	_, blobs := util.GenerateTestDenebBlockWithSidecar(t, [32]byte{}, 0, 4)
+	errInvalid := errors.New("invalid proof")
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var batches [][]uint64
+	b := &kzgBatcher{verifyProofs: func(sidecars ...blocks.ROBlob) error {
+		mu.Lock()
+		indices := make([]uint64, len(sidecars))
+		for i, sc := range sidecars {
+			indices[i] = sc.Index
+		}
+		batches = append(batches, indices)
+		first := len(batches) == 1
+		mu.Unlock()
+		if first {
+			close(started)
+			<-release
+		}
+		for _, sc := range sidecars {
+			if sc.Index == 2 {
+				return errInvalid
+			}
+		}
+		return nil
+	}}
+
+	errs := make([]error, len(blobs))
+	var wg sync.WaitGroup
+	verify := func(i int) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = b.verify(blobs[i])
+		}()
+	}
+	// The first sidecar is verified right away, the others wait for it and are verified together.
+	verify(0)
+	<-started
+	for i := len(blobs) - 1; i > 0; i-- {
+		verify(i)
+	}
+	for {
+		b.Lock()
+		pending := len(b.blocks[blobs[0].BlockRoot()].pending)
+		b.Unlock()
+		if pending == len(blobs)-1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	require.NoError(t, errs[0])
+	require.NoError(t, errs[1])
+	require.ErrorIs(t, errs[2], errInvalid)
+	require.NoError(t, errs[3])
+	// The batch is in index order, and fails because of sidecar 2, which is told apart by verifying them one by one.
+	require.DeepEqual(t, [][]uint64{{0}, {1, 2, 3}, {1}, {2}, {3}}, batches)
+	// The block is forgotten once its last batch is verified, right after the batch is released.
+	for {
+		b.Lock()
+		n := len(b.blocks)
+		b.Unlock()
+		if n == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
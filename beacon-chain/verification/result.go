@@ -1,5 +1,7 @@
 package verification
 
+import "sync"
+
 // Requirement represents a validation check that needs to pass in order for a Verified form a consensus type to be issued.
 type Requirement int
 
@@ -54,7 +56,9 @@ func (rl requirementList) excluding(minus ...Requirement) []Requirement {
 // results collects positive verification results.
 // This bitmap can be used to test which verifications have been successfully completed in order to
 // decide whether it is safe to issue a "Verified" type variant.
+// Results can be recorded concurrently, for independent verifications to run in parallel.
 type results struct {
+	sync.RWMutex
 	done map[Requirement]error
 	reqs []Requirement
 }
@@ -64,11 +68,15 @@ func newResults(reqs ...Requirement) *results {
 }
 
 func (r *results) record(req Requirement, err error) {
+	r.Lock()
+	defer r.Unlock()
 	r.done[req] = err
 }
 
 // allSatisfied returns true if there is a nil error result for every Requirement.
 func (r *results) allSatisfied() bool {
+	r.RLock()
+	defer r.RUnlock()
 	if len(r.done) != len(r.reqs) {
 		return false
 	}
@@ -82,11 +90,15 @@ func (r *results) allSatisfied() bool {
 }
 
 func (r *results) executed(req Requirement) bool {
+	r.RLock()
+	defer r.RUnlock()
 	_, ok := r.done[req]
 	return ok
 }
 
 func (r *results) result(req Requirement) error {
+	r.RLock()
+	defer r.RUnlock()
 	return r.done[req]
 }
 
@@ -95,6 +107,8 @@ func (r *results) errors(err error) error {
 }
 
 func (r *results) failures() map[Requirement]error {
+	r.RLock()
+	defer r.RUnlock()
 	fail := make(map[Requirement]error, len(r.done))
 	for i := range r.reqs {
 		req := r.reqs[i]
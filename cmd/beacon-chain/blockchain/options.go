@@ -1,10 +1,18 @@
 package blockchaincmd
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v5/runtime/slotprofile"
 	"github.com/urfave/cli/v2"
 )
 
@@ -20,5 +28,43 @@ func FlagOptions(c *cli.Context) ([]blockchain.Option, error) {
 		blockchain.WithMaxGoroutines(maxRoutines),
 		blockchain.WithWeakSubjectivityCheckpoint(wsCheckpt),
 	}
+	if values := c.StringSlice(flags.SlotTaskTimingFlag.Name); len(values) > 0 {
+		timings, err := parseSlotTaskTimings(values)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, blockchain.WithSlotTaskTimings(timings))
+	}
+	if threshold := c.Duration(flags.SlotProfileThresholdFlag.Name); threshold > 0 {
+		dir := c.String(flags.SlotProfileDirFlag.Name)
+		if dir == "" {
+			dir = filepath.Join(c.String(cmd.DataDirFlag.Name), "profiles")
+		}
+		opts = append(opts, blockchain.WithSlotProfiler(slotprofile.NewProfiler(dir, threshold, slotprofile.DefaultMaxCaptures, !c.Bool(debug.PProfFlag.Name))))
+	}
 	return opts, nil
 }
+
+// parseSlotTaskTimings parses slot task times in the form <task>=<offset>[/<jitter>].
+func parseSlotTaskTimings(values []string) (map[string]blockchain.SlotTaskTiming, error) {
+	timings := make(map[string]blockchain.SlotTaskTiming, len(values))
+	for _, v := range values {
+		name, timing, ok := strings.Cut(v, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid slot task timing %q, expected <task>=<offset>[/<jitter>]", v)
+		}
+		offset, jitter, hasJitter := strings.Cut(timing, "/")
+		var t blockchain.SlotTaskTiming
+		var err error
+		if t.Offset, err = time.ParseDuration(offset); err != nil {
+			return nil, errors.Wrapf(err, "invalid offset of slot task %s", name)
+		}
+		if hasJitter {
+			if t.Jitter, err = time.ParseDuration(jitter); err != nil {
+				return nil, errors.Wrapf(err, "invalid jitter of slot task %s", name)
+			}
+		}
+		timings[strings.TrimSpace(name)] = t
+	}
+	return timings, nil
+}
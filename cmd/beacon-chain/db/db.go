@@ -3,6 +3,8 @@ package db
 import (
 	beacondb "github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
@@ -31,5 +33,25 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "verify",
+			Description: `checks that blocks link to their parents, that states match the state roots of their blocks, ` +
+				`that blob sidecars within the retention period are present, and that no index references missing data`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				flags.BeaconDBBackendFlag,
+				flags.BeaconDBColdDirFlag,
+				storage.BlobStoragePathFlag,
+				storage.BlobRetentionEpochFlag,
+				repairFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := verify(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not verify database")
+				}
+				return nil
+			},
+		},
 	},
 }
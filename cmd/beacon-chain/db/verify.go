@@ -0,0 +1,157 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	beacondb "github.com/prysmaticlabs/prysm/v5/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filters"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/storage"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var repairFlag = &cli.BoolFlag{
+	Name:  "repair",
+	Usage: "Delete index entries which reference missing blocks or states",
+}
+
+// verify checks the integrity of the beacon node database and the blob storage, and reports the
+// inconsistencies it finds.
+func verify(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	dbPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), kv.BeaconNodeDbDirName)
+	typ := backend.Bolt
+	if name := cliCtx.String(flags.BeaconDBBackendFlag.Name); name != "" {
+		var err error
+		if typ, err = backend.ParseType(name); err != nil {
+			return err
+		}
+	}
+	exists, err := backend.Exists(typ, dbPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no %s database found in %s", typ, dbPath)
+	}
+	opts := []kv.KVStoreOption{kv.WithBackend(typ)}
+	if coldPath := cliCtx.String(flags.BeaconDBColdDirFlag.Name); coldPath != "" {
+		opts = append(opts, kv.WithColdPath(filepath.Join(coldPath, kv.BeaconNodeDbDirName)))
+	}
+	d, err := kv.NewKVStore(ctx, dbPath, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	log.Info("Verifying database, this may take a while")
+	report, err := d.Verify(ctx, cliCtx.Bool(repairFlag.Name))
+	if err != nil {
+		return err
+	}
+	for _, root := range report.MissingParents {
+		log.WithField("root", fmt.Sprintf("%#x", root)).Warn("Parent of block is missing")
+	}
+	for _, root := range report.StateRootMismatches {
+		log.WithField("root", fmt.Sprintf("%#x", root)).Warn("State does not match the state root of its block")
+	}
+
+	blobOpts, err := storage.BlobStorageOptions(cliCtx)
+	if err != nil {
+		return err
+	}
+	bs, err := filesystem.NewBlobStorage(blobOpts...)
+	if err != nil {
+		return err
+	}
+	missingBlobs, err := missingBlobs(ctx, d, bs)
+	if err != nil {
+		return err
+	}
+	for _, root := range missingBlobs {
+		log.WithField("root", fmt.Sprintf("%#x", root)).Warn("Blob sidecars of block within the retention period are missing")
+	}
+
+	log.WithFields(logrus.Fields{
+		"blocks":              report.Blocks,
+		"states":              report.States,
+		"missingParents":      len(report.MissingParents),
+		"stateRootMismatches": len(report.StateRootMismatches),
+		"danglingIndices":     report.DanglingIndices,
+		"repairedIndices":     report.Repaired,
+		"missingBlobs":        len(missingBlobs),
+	}).Info("Database verification completed")
+	if issues := report.Issues() + len(missingBlobs); issues > 0 {
+		return fmt.Errorf("database verification found %d issues", issues)
+	}
+	return nil
+}
+
+// missingBlobs returns the roots of the blocks within the blob retention window, counted back from the
+// head block, for which blob sidecars are missing from the blob storage.
+func missingBlobs(ctx context.Context, d beacondb.HeadAccessDatabase, bs *filesystem.BlobStorage) ([][32]byte, error) {
+	head, err := d.HeadBlock(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head block")
+	}
+	if head == nil || head.IsNil() {
+		return nil, nil
+	}
+	var missing [][32]byte
+	current := slots.ToEpoch(head.Block().Slot())
+	for e := current; e >= params.BeaconConfig().DenebForkEpoch && bs.WithinRetentionPeriod(e, current); e-- {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		start, err := slots.EpochStart(e)
+		if err != nil {
+			return nil, err
+		}
+		end, err := slots.EpochEnd(e)
+		if err != nil {
+			return nil, err
+		}
+		blks, roots, err := d.Blocks(ctx, filters.NewFilter().SetStartSlot(start).SetEndSlot(end))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get blocks of epoch %d", e)
+		}
+		for i, blk := range blks {
+			if blk.Version() < version.Deneb {
+				continue
+			}
+			commitments, err := blk.Block().Body().BlobKzgCommitments()
+			if err != nil {
+				return nil, err
+			}
+			stored, err := bs.Indices(roots[i])
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not list blobs of block %#x", roots[i])
+			}
+			for idx := range commitments {
+				if !stored[idx] {
+					missing = append(missing, roots[i])
+					break
+				}
+			}
+		}
+		if e == 0 {
+			break
+		}
+	}
+	return missing, nil
+}
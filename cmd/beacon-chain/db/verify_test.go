@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/filesystem"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestVerify_MissingBlobs(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.DenebForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	ctx := context.Background()
+
+	d, err := kv.NewKVStore(ctx, t.TempDir())
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, d.Close())
+	})
+	// The mocked blob storage only retains blobs of the current epoch.
+	mocker, bs := filesystem.NewEphemeralBlobStorageWithMocker(t)
+
+	saveBlock := func(slot primitives.Slot) [32]byte {
+		b := util.NewBeaconBlockDeneb()
+		b.Block.Slot = slot
+		b.Block.Body.BlobKzgCommitments = [][]byte{make([]byte, 48), make([]byte, 48)}
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		require.NoError(t, d.SaveBlock(ctx, wsb))
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		return root
+	}
+
+	missing, err := missingBlobs(ctx, d, bs)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(missing))
+
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	saveBlock(slotsPerEpoch + 1)
+	complete := saveBlock(3*slotsPerEpoch + 1)
+	require.NoError(t, mocker.CreateFakeIndices(complete, 0, 1))
+	partial := saveBlock(3*slotsPerEpoch + 2)
+	require.NoError(t, mocker.CreateFakeIndices(partial, 0))
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, partial))
+	require.NoError(t, d.SaveHeadBlockRoot(ctx, partial))
+
+	missing, err = missingBlobs(ctx, d, bs)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][32]byte{partial}, missing)
+}
@@ -27,6 +27,11 @@ func FlagOptions(c *cli.Context) ([]execution.Option, error) {
 		execution.WithHttpEndpoint(endpoint),
 		execution.WithEth1HeaderRequestLimit(c.Uint64(flags.Eth1HeaderReqLimit.Name)),
 		execution.WithHeaders(headers),
+		execution.WithGasLimitTarget(
+			c.String(flags.ExecutionGasLimitMethodFlag.Name),
+			c.String(flags.ExecutionGasLimitEndpointFlag.Name),
+			c.Uint64(flags.ExecutionGasLimitDefaultFlag.Name),
+		),
 	}
 	if len(jwtSecret) > 0 {
 		opts = append(opts, execution.WithHttpEndpointAndJWTSecret(endpoint, jwtSecret))
@@ -4,6 +4,7 @@ package flags
 
 import (
 	"strings"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
@@ -91,6 +92,30 @@ var (
 		Name:  "jwt-id",
 		Usage: "JWT claims id. Could be used to identify the client",
 	}
+	// ExecutionGasLimitMethodFlag defines the JSON-RPC method setting the gas limit targeted by the execution client.
+	ExecutionGasLimitMethodFlag = &cli.StringFlag{
+		Name: "execution-gas-limit-method",
+		Usage: "JSON-RPC method of the execution client called with the gas limit preferred by the proposer before " +
+			"the execution client builds a payload locally, as the engine API does not carry it. Example: " +
+			"--execution-gas-limit-method=miner_setGasLimit. The method is called on --execution-gas-limit-endpoint. " +
+			"Gas limit preferences are not passed to the execution client when empty.",
+	}
+	// ExecutionGasLimitEndpointFlag defines the regular JSON-RPC endpoint of the execution client on which the gas
+	// limit target is set.
+	ExecutionGasLimitEndpointFlag = &cli.StringFlag{
+		Name: "execution-gas-limit-endpoint",
+		Usage: "Regular JSON-RPC endpoint of the execution client, serving the method of --execution-gas-limit-method, " +
+			"as execution clients do not serve it on the authenticated engine endpoint. Example: http://localhost:8545.",
+	}
+	// ExecutionGasLimitDefaultFlag defines the gas limit targeted by the execution client for proposers without
+	// preference.
+	ExecutionGasLimitDefaultFlag = &cli.Uint64Flag{
+		Name: "execution-gas-limit-default",
+		Usage: "Gas limit the execution client is set to target again for the proposers without gas limit " +
+			"preference, after targeting the preference of another proposer. Should match the gas limit the execution " +
+			"client targets by default.",
+		Value: 30_000_000,
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
 		Name:  "deposit-contract",
@@ -173,6 +198,14 @@ var (
 		Usage: "The percentage of freshly allocated data to live data on which the gc will be run again.",
 		Value: 100,
 	}
+	// MemoryProfileFlag selects a preset of the garbage collector and cache settings for the memory of the machine.
+	MemoryProfileFlag = &cli.StringFlag{
+		Name: "memory-profile",
+		Usage: "Preset of the garbage collector and cache settings for the memory of the machine: 'low' for about 8GB, " +
+			"'default', or 'high' for 64GB or more. It sets GOGC, a soft memory limit and the cache sizes together. " +
+			"--gc-percent, the cache tuning flags and the GOGC and GOMEMLIMIT environment variables take precedence.",
+		Value: "default",
+	}
 	// SlotsPerArchivedPoint specifies the number of slots between the archived points, to save beacon state in the cold
 	// section of beaconDB.
 	SlotsPerArchivedPoint = &cli.IntFlag{
@@ -214,6 +247,13 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation and sync subnets.",
 	}
+	// GossipObserverFlag runs the node as a gossip observer which validates messages without forwarding or importing them.
+	GossipObserverFlag = &cli.BoolFlag{
+		Name: "gossip-observer",
+		Usage: "(Experimental) Subscribes to all gossip topics and subnets, but only validates the messages and records " +
+			"their validation results in metrics. Valid messages are neither forwarded nor imported, and the node " +
+			"publishes no messages. The node follows the chain through req/resp sync. Intended for network observatories.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -248,7 +288,8 @@ var (
 		Name: "weak-subjectivity-checkpoint",
 		Usage: "Input in `block_root:epoch_number` format." +
 			" This guarantees that syncing leads to the given Weak Subjectivity Checkpoint along the canonical chain. " +
-			"If such a sync is not possible, the node will treat it as a critical and irrecoverable failure",
+			"Blocks on chains without the checkpoint are rejected, and the checkpoint sync origin and backfilled blocks " +
+			"are checked against it. If such a sync is not possible, the node will treat it as a critical and irrecoverable failure",
 		Value: "",
 	}
 	// MinPeersPerSubnet defines a flag to set the minimum number of peers that a node will attempt to peer with for a subnet.
@@ -269,6 +310,12 @@ var (
 		Usage: "Post bellatrix, this address will receive the transaction fees produced by any blocks from this node. Default to junk whilst bellatrix is in development state. Validator client can override this value through the preparebeaconproposer api.",
 		Value: params.BeaconConfig().EthBurnAddressHex,
 	}
+	// TrackedProposersFile specifies a file of validators to prepare payloads for without a validator client.
+	TrackedProposersFile = &cli.StringFlag{
+		Name: "tracked-proposers-file",
+		Usage: "Path to a YAML or JSON file listing validator indices and their fee recipients to prepare payloads for. " +
+			"Entries from prepare beacon proposer calls and builder registrations take precedence over the file.",
+	}
 	// TerminalTotalDifficultyOverride specifies the total difficulty to manual overrides the `TERMINAL_TOTAL_DIFFICULTY` parameter.
 	TerminalTotalDifficultyOverride = &cli.StringFlag{
 		Name: "terminal-total-difficulty-override",
@@ -296,4 +343,238 @@ var (
 		Usage: "Directory for the slasher database",
 		Value: cmd.DefaultDataDir(),
 	}
+	// BeaconDBBackendFlag selects the storage engine of the beacon node database.
+	BeaconDBBackendFlag = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "(Experimental) Storage engine of the beacon node database, one of bolt or pebble. " +
+			"An existing database can be converted with `prysmctl db migrate-backend`.",
+		Value: "bolt",
+	}
+	// BeaconDBColdDirFlag defines a separate location for the finalized blocks and states.
+	BeaconDBColdDirFlag = &cli.StringFlag{
+		Name: "cold-datadir",
+		Usage: "(Experimental) Directory for the database of finalized blocks and states, which are moved out of " +
+			"the main database once finalized. Allows keeping finalized data on cheaper storage.",
+	}
+	// BeaconDBBatchWritesFlag coalesces concurrent database saves into shared transactions.
+	BeaconDBBatchWritesFlag = &cli.BoolFlag{
+		Name: "db-batch-writes",
+		Usage: "Coalesces concurrent saves of blocks, states and checkpoints into shared database transactions, " +
+			"which improves sync throughput on slow disks.",
+	}
+	// BeaconDBBatchMaxSizeFlag bounds the number of saves in a shared transaction.
+	BeaconDBBatchMaxSizeFlag = &cli.IntFlag{
+		Name:  "db-batch-max-size",
+		Usage: "Maximum number of saves coalesced into one transaction with --db-batch-writes.",
+		Value: 1000,
+	}
+	// BeaconDBBatchMaxDelayFlag bounds the time a save waits for others to share its transaction.
+	BeaconDBBatchMaxDelayFlag = &cli.DurationFlag{
+		Name:  "db-batch-max-delay",
+		Usage: "Maximum time a save waits for other saves to share its transaction with --db-batch-writes.",
+		Value: 10 * time.Millisecond,
+	}
+	// BeaconDBFsyncIntervalFlag replaces syncing every commit to disk with a periodic sync.
+	BeaconDBFsyncIntervalFlag = &cli.DurationFlag{
+		Name: "db-fsync-interval",
+		Usage: "Syncs the database to disk at this interval instead of on every commit, only with --db-backend=pebble. " +
+			"A crash may lose the writes of the last interval, which are fetched again from peers. Syncs every commit " +
+			"when set to 0.",
+	}
+	// BeaconDBPruneRetentionEpochsFlag enables pruning of the beacon node database while the node runs.
+	BeaconDBPruneRetentionEpochsFlag = &cli.Uint64Flag{
+		Name: "db-prune-retention-epochs",
+		Usage: "(Experimental) Periodically deletes non-canonical blocks, old states and stale indices older than " +
+			"this number of epochs before the finalized checkpoint. Disabled when set to 0.",
+	}
+	// StateProviderURLFlag sets the beacon node from which the states missing locally are fetched.
+	StateProviderURLFlag = &cli.StringFlag{
+		Name: "state-provider-url",
+		Usage: "Beacon API URL of a beacon node from which the states that cannot be regenerated locally, such as " +
+			"those pruned or before the origin of a checkpoint sync, are fetched on demand by API queries. The block and " +
+			"the state fetched are verified against the requested block root before use, and are not saved.",
+	}
+	// StateProviderTimeoutFlag bounds the requests to the state provider.
+	StateProviderTimeoutFlag = &cli.DurationFlag{
+		Name:  "state-provider-timeout",
+		Usage: "Timeout of the requests to --state-provider-url.",
+		Value: 2 * time.Minute,
+	}
+	// CacheStateByRootSizeFlag sets the number of hot states cached by block root.
+	CacheStateByRootSizeFlag = &cli.IntFlag{
+		Name:  "cache-tuning-state-by-root-size",
+		Usage: "Number of hot states kept in memory by block root.",
+		Value: 32,
+	}
+	// CacheCheckpointStateSizeFlag sets the number of states cached by checkpoint.
+	CacheCheckpointStateSizeFlag = &cli.IntFlag{
+		Name:  "cache-tuning-checkpoint-state-size",
+		Usage: "Number of checkpoint states kept in memory for attestation verification.",
+		Value: 10,
+	}
+	// CachePayloadIDSlotsFlag sets the number of slots payload IDs are cached for.
+	CachePayloadIDSlotsFlag = &cli.IntFlag{
+		Name:  "cache-tuning-payload-id-slots",
+		Usage: "Number of slots a prepared payload ID is kept for after its proposal slot.",
+		Value: 4,
+	}
+	// CacheCommitteesSizeFlag sets the number of shuffled committee lists cached by seed.
+	CacheCommitteesSizeFlag = &cli.IntFlag{
+		Name: "cache-tuning-committees-size",
+		Usage: "Number of epoch committee shuffles kept in memory while the chain finalizes. " +
+			"The cache grows to at least 32 entries during long periods of non-finality.",
+		Value: 4,
+	}
+	// CacheRegistrationsSizeFlag sets the number of validator registrations cached.
+	CacheRegistrationsSizeFlag = &cli.IntFlag{
+		Name: "cache-tuning-registrations-size",
+		Usage: "Number of validator registrations kept in memory for the builder, dropping the oldest " +
+			"registrations first. Unbounded when set to 0.",
+	}
+	// LightClientRetentionPeriodsFlag sets the number of sync committee periods light client updates are kept for.
+	LightClientRetentionPeriodsFlag = &cli.Uint64Flag{
+		Name: "light-client-retention-periods",
+		Usage: "Number of sync committee periods before the current one to backfill and keep light client updates for, " +
+			"when light client support is enabled. Defaults to the periods covering MIN_EPOCHS_FOR_BLOCK_REQUESTS when set to 0.",
+	}
+	// FinalityStallEpochsFlag sets the number of epochs without finality after which the node reports a finality stall.
+	FinalityStallEpochsFlag = &cli.Uint64Flag{
+		Name: "finality-stall-epochs",
+		Usage: "Number of epochs without finality after which the beacon node reports a finality stall through " +
+			"metrics, the finality_stall event topic and the finality status endpoint.",
+		Value: 4,
+	}
+	// GossipBandwidthCapFlag sets soft caps on the gossip bandwidth of topic kinds.
+	GossipBandwidthCapFlag = &cli.StringSliceFlag{
+		Name: "p2p-gossip-bandwidth-cap",
+		Usage: "Soft cap on the gossip bandwidth of a topic kind in the form <topic>=<bytes per second>, such as " +
+			"blob_sidecar=1048576 for all blob sidecar subnets. Beyond the cap, the beacon node ignores IHAVE " +
+			"announcements and IWANT requests of the topic, but keeps forwarding mesh messages. Can be repeated.",
+	}
+	// P2PScoreParamsFileFlag overrides the gossipsub peer scoring parameters on devnets.
+	P2PScoreParamsFileFlag = &cli.StringFlag{
+		Name: "p2p-score-params-file",
+		Usage: "Path to a YAML file overriding the gossipsub peer scoring thresholds, peer parameters and " +
+			"parameters of topic kinds such as beacon_attestation. Not allowed on mainnet and public testnets.",
+	}
+	// TrustedPeersFileFlag specifies a file of trusted peers which is reloaded whenever it changes.
+	TrustedPeersFileFlag = &cli.StringFlag{
+		Name: "p2p-trusted-peers-file",
+		Usage: "Path to a YAML list of multiaddrs or ENRs of trusted peers, reloaded whenever the file changes. " +
+			"Trusted peers are redialed with exponential backoff, and are never disconnected for their score or " +
+			"pruned at the peer limit.",
+	}
+	// ServeHistoricalBlocksFlag advertises that the node serves blocks older than the minimum retention period.
+	ServeHistoricalBlocksFlag = &cli.BoolFlag{
+		Name: "serve-historical-blocks",
+		Usage: "Advertises in the node's ENR that it serves blocks older than MIN_EPOCHS_FOR_BLOCK_REQUESTS over " +
+			"by-range requests, from genesis or from the earliest backfilled slot. Syncing nodes request such " +
+			"blocks from these nodes first. Intended for archive nodes.",
+	}
+	// SignatureVerificationWorkers sets the number of workers verifying gossip signature batches.
+	SignatureVerificationWorkers = &cli.IntFlag{
+		Name: "signature-verification-workers",
+		Usage: "Number of workers verifying the signature batches of gossip messages. When set to 0, workers are " +
+			"added while batches wait for a worker, up to one worker per CPU, and removed once idle.",
+		Value: 1,
+	}
+	// AttestationPackingBudget sets the time proposers spend improving the attestations of a block.
+	AttestationPackingBudget = &cli.IntFlag{
+		Name: "attestation-packing-budget",
+		Usage: "Time in milliseconds a proposer spends swapping the greedily selected attestations of a block for " +
+			"attestations which add more attester votes, keeping the best packing found within the budget. When " +
+			"set to 0, the greedy packing is used.",
+		Value: 50,
+	}
+	// OperationInclusionWeightFlag sets the weights of the inclusion priority of block operations.
+	OperationInclusionWeightFlag = &cli.StringSliceFlag{
+		Name: "operation-inclusion-weight",
+		Usage: "Weights of the inclusion priority of an operation kind in the form <operation>=<profit weight>/<age weight>, " +
+			"such as voluntary_exit=0/1. Proposers include the pending operations of highest priority, which is the profit " +
+			"weight times the proposer reward of the operation in ETH plus the age weight times its age in epochs. " +
+			"The age of a slashing is the epochs since the slashed message, and of an exit the epochs since its exit epoch. " +
+			"Operations are proposer_slashing, attester_slashing and voluntary_exit, all weighted 1/0.01 by default. " +
+			"BLS to execution changes are included newest first. Can be repeated.",
+	}
+	// SlotTaskTimingFlag sets the time of a task the beacon node runs every slot.
+	SlotTaskTimingFlag = &cli.StringSliceFlag{
+		Name: "slot-task-timing",
+		Usage: "Time of a task run every slot in the form <task>=<offset>[/<jitter>], such as late_block=-8s/100ms. " +
+			"The offset is counted from the start of the slot, or back from the start of the next slot when negative, " +
+			"and the task is delayed by a random duration of up to the jitter. Tasks are new_slot (0s), late_block " +
+			"(one third of the slot) and proposer_head (-2s). Can be repeated.",
+	}
+	// NTPServersFlag sets the NTP servers queried to estimate the offset of the local clock.
+	NTPServersFlag = &cli.StringSliceFlag{
+		Name: "ntp-server",
+		Usage: "NTP server, as a host with an optional port, queried every minute to estimate the offset of the " +
+			"local clock. The median offset reported by the servers is exported as a metric, and a warning is " +
+			"logged when it exceeds --clock-drift-threshold. Disabled when unset. Can be repeated.",
+	}
+	// ClockDriftThresholdFlag sets the clock offset from which the local clock is drifting.
+	ClockDriftThresholdFlag = &cli.DurationFlag{
+		Name:  "clock-drift-threshold",
+		Usage: "Offset of the local clock from NTP time from which the clock is reported as drifting.",
+		Value: 500 * time.Millisecond,
+	}
+	// ClockDriftRefuseAttestationsFlag makes the node refuse attestations while the local clock is drifting.
+	ClockDriftRefuseAttestationsFlag = &cli.BoolFlag{
+		Name: "clock-drift-refuse-attestations",
+		Usage: "Refuses to publish the attestations submitted by validator clients while the offset of the local " +
+			"clock exceeds --clock-drift-threshold. Requires --ntp-server.",
+	}
+	// DiagnosticsDirFlag sets the directory of the diagnostics bundles.
+	DiagnosticsDirFlag = &cli.StringFlag{
+		Name: "diagnostics-dir",
+		Usage: "Directory of the diagnostics bundles, written on request to the /prysm/v1/node/diagnostics " +
+			"endpoint. Defaults to the diagnostics directory of the data directory.",
+	}
+	// AdminTokenFileFlag sets the file of the token authenticating requests to the admin endpoints.
+	AdminTokenFileFlag = &cli.StringFlag{
+		Name: "admin-token-file",
+		Usage: "Path to a file containing the token required as bearer token of the Authorization header by the admin " +
+			"endpoints of the beacon API, such as /prysm/v1/node/diagnostics and /prysm/v1/validators/gas_limits. Admin " +
+			"endpoints are disabled when unset.",
+	}
+	// SlotProfileThresholdFlag sets the block import latency above which the import is profiled.
+	SlotProfileThresholdFlag = &cli.DurationFlag{
+		Name: "slot-profile-threshold",
+		Usage: "Latency of the import of a gossip block above which its CPU profile and execution trace are written " +
+			"to --slot-profile-dir, labeled with the slot and the import path, to diagnose tail latency. An import " +
+			"is only profiled once it runs for half of the threshold, at most every 5 minutes, and without the CPU " +
+			"profile when --pprof is enabled. Disabled when 0.",
+	}
+	// SlotProfileDirFlag sets the directory of the profiles of slow block imports.
+	SlotProfileDirFlag = &cli.StringFlag{
+		Name:  "slot-profile-dir",
+		Usage: "Directory of the profiles of slow block imports, keeping the last 16. Defaults to the profiles directory of the data directory.",
+	}
+	// ReadinessMinPeersFlag sets the number of connected peers from which the node is ready.
+	ReadinessMinPeersFlag = &cli.IntFlag{
+		Name:  "readiness-min-peers",
+		Usage: "Number of connected peers below which the /readyz endpoint of the monitoring server reports the node as not ready.",
+		Value: 1,
+	}
+	// BlockRebroadcastDelayFlag sets when proposed blocks observed in few attestations are rebroadcast.
+	BlockRebroadcastDelayFlag = &cli.DurationFlag{
+		Name: "block-rebroadcast-delay",
+		Usage: "Time after the proposal of a block by a validator client of the node until which attestations voting " +
+			"for it are observed on gossip. When fewer than --block-rebroadcast-min-attestations were observed, the " +
+			"block and its blob sidecars are rebroadcast, once the gossip routers forgot the first broadcast two " +
+			"minutes after the proposal, to mitigate transient failures of the gossip mesh. Disabled when 0.",
+	}
+	// ProposalPayloadDeadlineFlag sets until when fetching the execution payload of proposals is delayed.
+	ProposalPayloadDeadlineFlag = &cli.DurationFlag{
+		Name: "proposal-payload-deadline",
+		Usage: "Time into the slot until which fetching the execution payload and the builder bid of a proposal is " +
+			"delayed, while the attestations and other operations of the block are packed, for the payload to include " +
+			"more transactions. It must be before the attestation deadline, and the delay adds to the latency of the " +
+			"proposal. Payloads are fetched immediately when 0.",
+	}
+	// BlockRebroadcastMinAttestationsFlag sets the number of attestations from which proposed blocks are not rebroadcast.
+	BlockRebroadcastMinAttestationsFlag = &cli.Uint64Flag{
+		Name:  "block-rebroadcast-min-attestations",
+		Usage: "Number of attestations voting for a proposed block observed on gossip below which the block is rebroadcast.",
+		Value: 1,
+	}
 )
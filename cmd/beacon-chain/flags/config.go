@@ -16,6 +16,12 @@ type GlobalFlags struct {
 	BlockBatchLimitBurstFactor int
 	BlobBatchLimit             int
 	BlobBatchLimitBurstFactor  int
+	// SignatureVerificationWorkers is the number of gossip signature verification workers, 0 for an adaptive pool.
+	SignatureVerificationWorkers int
+	// AttestationPackingBudget is the time in milliseconds proposers spend improving the attestations of a block.
+	AttestationPackingBudget int
+	// GossipObserver only validates gossip messages, without forwarding, importing or publishing any.
+	GossipObserver bool
 }
 
 var globalConfig *GlobalFlags
@@ -41,12 +47,19 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 		log.Warn("Subscribing to All Attestation Subnets")
 		cfg.SubscribeToAllSubnets = true
 	}
+	if ctx.Bool(GossipObserverFlag.Name) {
+		log.Warn("Running as a gossip observer, gossip messages are validated but neither forwarded nor imported")
+		cfg.GossipObserver = true
+		cfg.SubscribeToAllSubnets = true
+	}
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	cfg.BlobBatchLimit = ctx.Int(BlobBatchLimit.Name)
 	cfg.BlobBatchLimitBurstFactor = ctx.Int(BlobBatchLimitBurstFactor.Name)
 	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
 	cfg.MaxConcurrentDials = ctx.Int(MaxConcurrentDials.Name)
+	cfg.SignatureVerificationWorkers = ctx.Int(SignatureVerificationWorkers.Name)
+	cfg.AttestationPackingBudget = ctx.Int(AttestationPackingBudget.Name)
 	configureMinimumPeers(ctx, cfg)
 
 	Init(cfg)
@@ -0,0 +1,25 @@
+package flags
+
+import (
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// FaultInjectionGossipDelayFlag delays the publication of gossip messages by a distribution of delays.
+	FaultInjectionGossipDelayFlag = &cli.StringFlag{
+		Name: "fault-injection-gossip-delay",
+		Usage: "(Testing only, never use in production) Delays the publication of gossip messages by a distribution of delays: " +
+			"fixed:<delay>, uniform:<min>,<max>, normal:<mean>,<stddev> or exponential:<mean>, e.g. uniform:100ms,2s",
+	}
+	// FaultInjectionRPCDropPercentFlag drops the responses to a percentage of req/resp requests.
+	FaultInjectionRPCDropPercentFlag = &cli.Float64Flag{
+		Name:  "fault-injection-rpc-drop-percent",
+		Usage: "(Testing only, never use in production) Percentage of req/resp requests of peers which are not responded to",
+	}
+	// FaultInjectionEngineDelayFlag delays the Engine API calls to the execution client by a distribution of delays.
+	FaultInjectionEngineDelayFlag = &cli.StringFlag{
+		Name: "fault-injection-engine-delay",
+		Usage: "(Testing only, never use in production) Delays the Engine API calls to the execution client by a distribution of delays, " +
+			"in the format of --fault-injection-gossip-delay",
+	}
+)
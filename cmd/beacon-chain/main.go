@@ -30,7 +30,9 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/journald"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v5/runtime/diagnostics"
 	"github.com/prysmaticlabs/prysm/v5/runtime/fdlimits"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	prefixed "github.com/prysmaticlabs/prysm/v5/runtime/logging/logrus-prefixed-formatter"
 	_ "github.com/prysmaticlabs/prysm/v5/runtime/maxprocs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
@@ -55,6 +57,7 @@ var appFlags = []cli.Flag{
 	flags.MinSyncPeers,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
+	flags.MemoryProfileFlag,
 	flags.BlockBatchLimit,
 	flags.BlockBatchLimitBurstFactor,
 	flags.BlobBatchLimit,
@@ -62,9 +65,15 @@ var appFlags = []cli.Flag{
 	flags.InteropMockEth1DataVotesFlag,
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
+	flags.FaultInjectionGossipDelayFlag,
+	flags.FaultInjectionRPCDropPercentFlag,
+	flags.FaultInjectionEngineDelayFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.StateProviderURLFlag,
+	flags.StateProviderTimeoutFlag,
 	flags.DisableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
+	flags.GossipObserverFlag,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
@@ -73,6 +82,7 @@ var appFlags = []cli.Flag{
 	flags.MinPeersPerSubnet,
 	flags.MaxConcurrentDials,
 	flags.SuggestedFeeRecipient,
+	flags.TrackedProposersFile,
 	flags.TerminalTotalDifficultyOverride,
 	flags.TerminalBlockHashOverride,
 	flags.TerminalBlockHashActivationEpochOverride,
@@ -110,12 +120,17 @@ var appFlags = []cli.Flag{
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
 	cmd.TraceSampleFractionFlag,
+	cmd.OTLPEndpointFlag,
+	cmd.OTLPHeadersFlag,
+	cmd.OTLPExportIntervalFlag,
+	cmd.OTLPInstanceIDFlag,
 	cmd.MonitoringHostFlag,
 	flags.MonitoringPortFlag,
 	cmd.DisableMonitoringFlag,
 	cmd.ClearDB,
 	cmd.ForceClearDB,
 	cmd.LogFormat,
+	cmd.LogModuleLevels,
 	cmd.MaxGoroutines,
 	debug.PProfFlag,
 	debug.PProfAddrFlag,
@@ -141,7 +156,43 @@ var appFlags = []cli.Flag{
 	genesis.StatePath,
 	genesis.BeaconAPIURL,
 	flags.SlasherDirFlag,
+	flags.BeaconDBBackendFlag,
+	flags.BeaconDBColdDirFlag,
+	flags.BeaconDBBatchWritesFlag,
+	flags.BeaconDBBatchMaxSizeFlag,
+	flags.BeaconDBBatchMaxDelayFlag,
+	flags.BeaconDBFsyncIntervalFlag,
+	flags.BeaconDBPruneRetentionEpochsFlag,
+	flags.FinalityStallEpochsFlag,
+	flags.LightClientRetentionPeriodsFlag,
+	flags.GossipBandwidthCapFlag,
+	flags.P2PScoreParamsFileFlag,
+	flags.TrustedPeersFileFlag,
+	flags.ServeHistoricalBlocksFlag,
+	flags.SignatureVerificationWorkers,
+	flags.AttestationPackingBudget,
+	flags.OperationInclusionWeightFlag,
+	flags.BlockRebroadcastDelayFlag,
+	flags.BlockRebroadcastMinAttestationsFlag,
+	flags.ProposalPayloadDeadlineFlag,
+	flags.SlotTaskTimingFlag,
+	flags.NTPServersFlag,
+	flags.ClockDriftThresholdFlag,
+	flags.ClockDriftRefuseAttestationsFlag,
+	flags.DiagnosticsDirFlag,
+	flags.AdminTokenFileFlag,
+	flags.SlotProfileThresholdFlag,
+	flags.SlotProfileDirFlag,
+	flags.ReadinessMinPeersFlag,
+	flags.CacheStateByRootSizeFlag,
+	flags.CacheCheckpointStateSizeFlag,
+	flags.CachePayloadIDSlotsFlag,
+	flags.CacheCommitteesSizeFlag,
+	flags.CacheRegistrationsSizeFlag,
 	flags.JwtId,
+	flags.ExecutionGasLimitMethodFlag,
+	flags.ExecutionGasLimitEndpointFlag,
+	flags.ExecutionGasLimitDefaultFlag,
 	storage.BlobStoragePathFlag,
 	storage.BlobRetentionEpochFlag,
 	bflags.EnableExperimentalBackfill,
@@ -181,7 +232,7 @@ func before(ctx *cli.Context) error {
 
 		logrus.SetFormatter(f)
 	case "json":
-		logrus.SetFormatter(&logrus.JSONFormatter{})
+		logrus.SetFormatter(&logging.JSONFormatter{})
 	case "journald":
 		if err := journald.Enable(); err != nil {
 			return err
@@ -197,6 +248,8 @@ func before(ctx *cli.Context) error {
 		}
 	}
 
+	diagnostics.CaptureLogs()
+
 	if err := cmd.ExpandSingleEndpointIfFile(ctx, flags.ExecutionEngineEndpoint); err != nil {
 		return errors.Wrap(err, "failed to expand single endpoint")
 	}
@@ -224,6 +277,11 @@ func main() {
 	// rctx = root context with cancellation.
 	// note other instances of ctx in this func are *cli.Context.
 	rctx, cancel := context.WithCancel(context.Background())
+	// Panics of other goroutines can not be recovered here, so the crash output of the runtime includes the stacks
+	// of all goroutines, unless GOTRACEBACK says otherwise.
+	if os.Getenv("GOTRACEBACK") == "" {
+		runtimeDebug.SetTraceback("all")
+	}
 	app := cli.App{
 		Name:  "beacon-chain",
 		Usage: "this is a beacon chain implementation for Ethereum",
@@ -273,7 +331,11 @@ func startNode(ctx *cli.Context, cancel context.CancelFunc) error {
 	if err != nil {
 		return err
 	}
-	logrus.SetLevel(level)
+	moduleLevels, err := logging.ParseModuleLevels(ctx.StringSlice(cmd.LogModuleLevels.Name))
+	if err != nil {
+		return err
+	}
+	logrus.SetLevel(logging.ConfigureModuleLevels(level, moduleLevels))
 	// Set libp2p logger to only panic logs for the info level.
 	golog.SetAllLoggers(golog.LevelPanic)
 
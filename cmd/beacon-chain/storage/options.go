@@ -31,14 +31,23 @@ var (
 // create a cancellable context. If we switch to using App.RunContext, we can set up this cancellation in the cmd
 // package instead, and allow the functional options to tap into context cancellation.
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
+	blobOpts, err := BlobStorageOptions(c)
+	if err != nil {
+		return nil, err
+	}
+	opts := []node.Option{node.WithBlobStorageOptions(blobOpts...)}
+	return opts, nil
+}
+
+// BlobStorageOptions returns the blob storage options configured by the blob path and retention flags.
+func BlobStorageOptions(c *cli.Context) ([]filesystem.BlobStorageOption, error) {
 	e, err := blobRetentionEpoch(c)
 	if err != nil {
 		return nil, err
 	}
-	opts := []node.Option{node.WithBlobStorageOptions(
+	return []filesystem.BlobStorageOption{
 		filesystem.WithBlobRetentionEpochs(e), filesystem.WithBasePath(blobStoragePath(c)),
-	)}
-	return opts, nil
+	}, nil
 }
 
 func blobStoragePath(c *cli.Context) string {
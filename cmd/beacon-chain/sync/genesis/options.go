@@ -4,7 +4,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/sync/genesis"
+	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/checkpoint"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -27,6 +29,7 @@ var (
 // BeaconNodeOptions is responsible for determining if the checkpoint sync options have been used, and if so,
 // reading the block and state ssz-serialized values from the filesystem locations specified and preparing a
 // checkpoint.Initializer, which uses the provided io.ReadClosers to initialize the beacon node database.
+// Without a genesis state flag, the genesis state of a network bundle passed as chain config file is used.
 func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 	statePath := c.Path(StatePath.Name)
 	remoteURL := c.String(BeaconAPIURL.Name)
@@ -46,6 +49,13 @@ func BeaconNodeOptions(c *cli.Context) ([]node.Option, error) {
 		return []node.Option{opt}, nil
 	}
 
+	if statePath == "" && c.IsSet(cmd.ChainConfigFileFlag.Name) {
+		b, err := params.LoadNetworkBundle(c.String(cmd.ChainConfigFileFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		statePath = b.GenesisState
+	}
 	if statePath == "" {
 		return nil, nil
 	}
@@ -63,6 +63,10 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
 			cmd.TraceSampleFractionFlag,
+			cmd.OTLPEndpointFlag,
+			cmd.OTLPHeadersFlag,
+			cmd.OTLPExportIntervalFlag,
+			cmd.OTLPInstanceIDFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
@@ -110,13 +114,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.ExecutionEngineHeaders,
 			flags.ExecutionJWTSecretFlag,
 			flags.SetGCPercent,
+			flags.MemoryProfileFlag,
 			flags.SlotsPerArchivedPoint,
+			flags.StateProviderURLFlag,
+			flags.StateProviderTimeoutFlag,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.BlobBatchLimit,
 			flags.BlobBatchLimitBurstFactor,
 			flags.DisableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
+			flags.GossipObserverFlag,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
@@ -129,10 +137,27 @@ var appHelpFlagGroups = []flagGroup{
 			flags.MaxBuilderConsecutiveMissedSlots,
 			flags.EngineEndpointTimeoutSeconds,
 			flags.SlasherDirFlag,
+			flags.BeaconDBBackendFlag,
+			flags.BeaconDBColdDirFlag,
+			flags.BeaconDBBatchWritesFlag,
+			flags.BeaconDBBatchMaxSizeFlag,
+			flags.BeaconDBBatchMaxDelayFlag,
+			flags.BeaconDBFsyncIntervalFlag,
+			flags.BeaconDBPruneRetentionEpochsFlag,
+			flags.FinalityStallEpochsFlag,
+			flags.LightClientRetentionPeriodsFlag,
 			flags.LocalBlockValueBoost,
+			flags.AttestationPackingBudget,
+			flags.OperationInclusionWeightFlag,
+			flags.BlockRebroadcastDelayFlag,
+			flags.BlockRebroadcastMinAttestationsFlag,
+			flags.ProposalPayloadDeadlineFlag,
 			flags.MinBuilderBid,
 			flags.MinBuilderDiff,
 			flags.JwtId,
+			flags.ExecutionGasLimitMethodFlag,
+			flags.ExecutionGasLimitEndpointFlag,
+			flags.ExecutionGasLimitDefaultFlag,
 			checkpoint.BlockPath,
 			checkpoint.StatePath,
 			checkpoint.RemoteURL,
@@ -150,11 +175,22 @@ var appHelpFlagGroups = []flagGroup{
 		Name: "merge",
 		Flags: []cli.Flag{
 			flags.SuggestedFeeRecipient,
+			flags.TrackedProposersFile,
 			flags.TerminalTotalDifficultyOverride,
 			flags.TerminalBlockHashOverride,
 			flags.TerminalBlockHashActivationEpochOverride,
 		},
 	},
+	{
+		Name: "cache-tuning",
+		Flags: []cli.Flag{
+			flags.CacheStateByRootSizeFlag,
+			flags.CacheCheckpointStateSizeFlag,
+			flags.CachePayloadIDSlotsFlag,
+			flags.CacheCommitteesSizeFlag,
+			flags.CacheRegistrationsSizeFlag,
+		},
+	},
 	{
 		Name: "p2p",
 		Flags: []cli.Flag{
@@ -171,12 +207,27 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.GossipBandwidthCapFlag,
+			flags.P2PScoreParamsFileFlag,
+			flags.TrustedPeersFileFlag,
+			flags.ServeHistoricalBlocksFlag,
+			flags.SignatureVerificationWorkers,
+			flags.SlotTaskTimingFlag,
+			flags.NTPServersFlag,
+			flags.ClockDriftThresholdFlag,
+			flags.ClockDriftRefuseAttestationsFlag,
+			flags.DiagnosticsDirFlag,
+			flags.AdminTokenFileFlag,
+			flags.SlotProfileThresholdFlag,
+			flags.SlotProfileDirFlag,
+			flags.ReadinessMinPeersFlag,
 		},
 	},
 	{
 		Name: "log",
 		Flags: []cli.Flag{
 			cmd.LogFormat,
+			cmd.LogModuleLevels,
 			cmd.LogFileName,
 		},
 	},
@@ -192,6 +243,14 @@ var appHelpFlagGroups = []flagGroup{
 			flags.InteropNumValidatorsFlag,
 		},
 	},
+	{
+		Name: "fault injection",
+		Flags: []cli.Flag{
+			flags.FaultInjectionGossipDelayFlag,
+			flags.FaultInjectionRPCDropPercentFlag,
+			flags.FaultInjectionEngineDelayFlag,
+		},
+	},
 	{
 		Name: "deprecated",
 		Flags: []cli.Flag{
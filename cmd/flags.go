@@ -76,6 +76,28 @@ var (
 		Usage: "Indicates what fraction of p2p messages are sampled for tracing.",
 		Value: 0.20,
 	}
+	// OTLPEndpointFlag defines the OpenTelemetry collector receiving the metrics and the traces.
+	OTLPEndpointFlag = &cli.StringFlag{
+		Name: "otlp-endpoint",
+		Usage: "URL of the OTLP/HTTP receiver of an OpenTelemetry collector, such as http://127.0.0.1:4318, to which " +
+			"metrics are exported. Traces are exported to it instead of Jaeger when --enable-tracing is set.",
+	}
+	// OTLPHeadersFlag defines headers sent to the OpenTelemetry collector.
+	OTLPHeadersFlag = &cli.StringSliceFlag{
+		Name:  "otlp-header",
+		Usage: "Header sent with the OTLP exports, in the form <key>=<value>. Can be repeated.",
+	}
+	// OTLPExportIntervalFlag defines the time between two exports of the metrics.
+	OTLPExportIntervalFlag = &cli.DurationFlag{
+		Name:  "otlp-export-interval",
+		Usage: "Time between two exports of the metrics to the OpenTelemetry collector.",
+		Value: 15 * time.Second,
+	}
+	// OTLPInstanceIDFlag defines the identity of the node in the exported metrics and traces.
+	OTLPInstanceIDFlag = &cli.StringFlag{
+		Name:  "otlp-instance-id",
+		Usage: "Identity of the node in the `service.instance.id` attribute of the exported metrics and traces. Defaults to the host name.",
+	}
 	// MonitoringHostFlag defines the host used to serve prometheus metrics.
 	MonitoringHostFlag = &cli.StringFlag{
 		Name:  "monitoring-host",
@@ -207,6 +229,14 @@ var (
 		Usage: "Specifies log formatting. Supports: text, json, fluentd, journald.",
 		Value: "text",
 	}
+	// LogModuleLevels sets the log levels of modules.
+	LogModuleLevels = &cli.StringSliceFlag{
+		Name: "log-module-levels",
+		Usage: "Log levels of modules overriding --verbosity, in the form <module>=<level> separated by commas, such as " +
+			"blockchain=debug,p2p=warn. Modules are the prefixes of the log entries. Not applied to journald logs. " +
+			"A module level more verbose than --verbosity makes the entries of all modules at that level be built " +
+			"before the ones of other modules are dropped, which costs CPU when the level is debug or trace.",
+	}
 	// MaxGoroutines specifies the maximum amount of goroutines tolerated, before a status check fails.
 	MaxGoroutines = &cli.IntFlag{
 		Name:  "max-goroutines",
@@ -230,8 +260,10 @@ var (
 	}
 	// ChainConfigFileFlag specifies the filepath to load flag values.
 	ChainConfigFileFlag = &cli.StringFlag{
-		Name:  "chain-config-file",
-		Usage: "Path to a YAML file with chain config values.",
+		Name: "chain-config-file",
+		Usage: "Path to a YAML file with chain config values, or to a network bundle directory or zip archive with " +
+			"config.yaml, and optionally genesis.ssz, bootstrap_nodes.txt or boot_enr.yaml, deposit_contract.txt and " +
+			"deposit_contract_block.txt or deploy_block.txt files.",
 	}
 	// GrpcMaxCallRecvMsgSizeFlag defines the max call message size for GRPC
 	GrpcMaxCallRecvMsgSizeFlag = &cli.IntFlag{
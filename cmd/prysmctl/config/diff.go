@@ -0,0 +1,298 @@
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/p2p"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var diffFlags = struct {
+	ChainConfigFile       string
+	OtherConfigFile       string
+	ENR                   string
+	GenesisValidatorsRoot string
+	GenesisTime           uint64
+}{}
+
+var diffCmd = &cli.Command{
+	Name: "diff",
+	Usage: "Compare the chain config with the config file of another client, or with the fork advertised in the ENR " +
+		"of a peer, highlighting the mismatches which isolate nodes from each other on gossip.",
+	Action: func(cliCtx *cli.Context) error {
+		if err := cliActionDiff(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not compare chain config")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "chain-config-file",
+			Usage:       "Path to the chain config yaml file or network bundle to check, with its preset overrides. Defaults to mainnet.",
+			Destination: &diffFlags.ChainConfigFile,
+		},
+		&cli.StringFlag{
+			Name:        "other-config-file",
+			Usage:       "Path to the config yaml file of another client, or to the json response of its /eth/v1/config/spec endpoint.",
+			Destination: &diffFlags.OtherConfigFile,
+		},
+		&cli.StringFlag{
+			Name:        "enr",
+			Usage:       "ENR of a peer whose advertised fork digest and next fork are checked against the chain config.",
+			Destination: &diffFlags.ENR,
+		},
+		&cli.StringFlag{
+			Name:        "genesis-validators-root",
+			Usage:       "Hex encoded genesis validators root of the network, required to check the fork digest of --enr.",
+			Destination: &diffFlags.GenesisValidatorsRoot,
+		},
+		&cli.Uint64Flag{
+			Name:        "genesis-time",
+			Usage:       "Genesis time of the network in unix seconds, required to check the fork digest of --enr against the current fork.",
+			Destination: &diffFlags.GenesisTime,
+		},
+	},
+}
+
+// Spec values which make the nodes disagree on the fork digest, on gossip message ids and sizes, or on the subnets of
+// the gossip topics, so that nodes with different values do not see each other's messages.
+var gossipValues = map[string]bool{
+	"SECONDS_PER_SLOT":              true,
+	"SLOTS_PER_EPOCH":               true,
+	"GOSSIP_MAX_SIZE":               true,
+	"MAX_CHUNK_SIZE":                true,
+	"MAX_PAYLOAD_SIZE":              true,
+	"ATTESTATION_SUBNET_COUNT":      true,
+	"SYNC_COMMITTEE_SUBNET_COUNT":   true,
+	"BLOB_SIDECAR_SUBNET_COUNT":     true,
+	"MAX_BLOBS_PER_BLOCK":           true,
+	"MESSAGE_DOMAIN_VALID_SNAPPY":   true,
+	"MESSAGE_DOMAIN_INVALID_SNAPPY": true,
+}
+
+// configDiff is a value that differs between the chain config and the other config or peer.
+type configDiff struct {
+	key    string
+	local  string
+	other  string
+	gossip bool
+}
+
+func isGossipValue(key string) bool {
+	return gossipValues[key] || strings.HasSuffix(key, "_FORK_VERSION") || strings.HasSuffix(key, "_FORK_EPOCH")
+}
+
+func cliActionDiff(_ *cli.Context) error {
+	f := diffFlags
+	if f.OtherConfigFile == "" && f.ENR == "" {
+		return errors.New("one of --other-config-file or --enr is required")
+	}
+	if f.ChainConfigFile != "" {
+		if err := params.LoadChainConfigFile(f.ChainConfigFile, nil); err != nil {
+			return err
+		}
+	}
+	cfg := params.BeaconConfig()
+	fmt.Printf("Chain config %s, preset %s\n", cfg.ConfigName, cfg.PresetBase)
+
+	var diffs []configDiff
+	if f.OtherConfigFile != "" {
+		local, err := params.SpecValues(cfg)
+		if err != nil {
+			return err
+		}
+		enc, err := os.ReadFile(f.OtherConfigFile) // #nosec G304
+		if err != nil {
+			return errors.Wrap(err, "could not read other config file")
+		}
+		other, err := parseConfigValues(enc)
+		if err != nil {
+			return errors.Wrap(err, "could not parse other config file")
+		}
+		d, unknown := diffConfigValues(local, other)
+		diffs = append(diffs, d...)
+		if len(unknown) > 0 {
+			fmt.Printf("Values of the other config not in the chain config: %s\n", strings.Join(unknown, ", "))
+		}
+	}
+	if f.ENR != "" {
+		node, err := enode.Parse(enode.ValidSchemes, f.ENR)
+		if err != nil {
+			return errors.Wrap(err, "could not parse ENR")
+		}
+		root, err := hexutil.Decode(f.GenesisValidatorsRoot)
+		if err != nil || len(root) != fieldparams.RootLength {
+			return errors.New("--genesis-validators-root must be a hex encoded 32 bytes root to check an ENR")
+		}
+		if f.GenesisTime == 0 {
+			return errors.New("--genesis-time is required to check an ENR")
+		}
+		currentEpoch := slots.ToEpoch(slots.CurrentSlot(f.GenesisTime))
+		d, err := diffForkENR(node.Record(), root, currentEpoch)
+		if err != nil {
+			return err
+		}
+		diffs = append(diffs, d...)
+	}
+
+	if len(diffs) == 0 {
+		fmt.Println("No mismatches")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tLOCAL\tOTHER\t")
+	var isolating int
+	for _, d := range diffs {
+		note := ""
+		if d.gossip {
+			note = "gossip isolation"
+			isolating++
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", d.key, d.local, d.other, note)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if isolating > 0 {
+		return fmt.Errorf("%d of %d mismatches isolate the nodes on gossip", isolating, len(diffs))
+	}
+	return nil
+}
+
+// parseConfigValues parses the flat key value pairs of a config yaml file, or the json response of the config spec
+// endpoint, leaving the values as written. Nested values, such as schedules, are skipped.
+func parseConfigValues(enc []byte) (map[string]string, error) {
+	values := make(map[string]string)
+	if bytes.HasPrefix(bytes.TrimSpace(enc), []byte("{")) {
+		var resp struct {
+			Data map[string]interface{} `json:"data"`
+		}
+		if err := json.Unmarshal(enc, &resp); err != nil {
+			return nil, err
+		}
+		if resp.Data == nil {
+			if err := json.Unmarshal(enc, &resp.Data); err != nil {
+				return nil, err
+			}
+		}
+		for k, v := range resp.Data {
+			if s, ok := v.(string); ok {
+				values[k] = s
+			}
+		}
+		return values, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(enc))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		// Indented lines belong to nested values.
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "-") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid line %q", line)
+		}
+		value = strings.Trim(strings.TrimSpace(value), `'"`)
+		if value == "" {
+			continue
+		}
+		values[strings.TrimSpace(key)] = value
+	}
+	return values, scanner.Err()
+}
+
+// diffConfigValues returns the values which differ between the chain config and the other config, sorted with the
+// values isolating the nodes on gossip first, and the keys of the other config unknown to the chain config.
+func diffConfigValues(local, other map[string]string) ([]configDiff, []string) {
+	var diffs []configDiff
+	var unknown []string
+	for k, o := range other {
+		l, ok := local[k]
+		if !ok {
+			unknown = append(unknown, k)
+			continue
+		}
+		if !strings.EqualFold(l, o) {
+			diffs = append(diffs, configDiff{key: k, local: l, other: o, gossip: isGossipValue(k)})
+		}
+	}
+	sort.Slice(diffs, func(i, j int) bool {
+		if diffs[i].gossip != diffs[j].gossip {
+			return diffs[i].gossip
+		}
+		return diffs[i].key < diffs[j].key
+	})
+	sort.Strings(unknown)
+	return diffs, unknown
+}
+
+// diffForkENR checks the fork digest, next fork version and next fork epoch advertised in the record against the
+// fork of the chain config at the current epoch, as a peer on any other fork does not share gossip topics with the node.
+func diffForkENR(record *enr.Record, genesisValidatorsRoot []byte, currentEpoch primitives.Epoch) ([]configDiff, error) {
+	entry, err := p2p.ForkEntry(record)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the eth2 entry of the ENR")
+	}
+	localDigest, err := forks.ForkDigestFromEpoch(currentEpoch, genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	nextVersion, nextEpoch, err := forks.NextForkData(currentEpoch)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []configDiff
+	digest := bytesutil.ToBytes4(entry.CurrentForkDigest)
+	if digest != localDigest {
+		other := fmt.Sprintf("%#x", digest)
+		if version, epoch, err := forks.RetrieveForkDataFromDigest(digest, genesisValidatorsRoot); err == nil {
+			other = fmt.Sprintf("%#x (fork %#x of epoch %d)", digest, version, epoch)
+		}
+		diffs = append(diffs, configDiff{
+			key:    "CURRENT_FORK_DIGEST",
+			local:  fmt.Sprintf("%#x", localDigest),
+			other:  other,
+			gossip: true,
+		})
+	}
+	if !bytes.Equal(nextVersion[:], entry.NextForkVersion) {
+		diffs = append(diffs, configDiff{
+			key:    "NEXT_FORK_VERSION",
+			local:  fmt.Sprintf("%#x", nextVersion),
+			other:  fmt.Sprintf("%#x", entry.NextForkVersion),
+			gossip: true,
+		})
+	}
+	if nextEpoch != entry.NextForkEpoch {
+		diffs = append(diffs, configDiff{
+			key:    "NEXT_FORK_EPOCH",
+			local:  fmt.Sprintf("%d", nextEpoch),
+			other:  fmt.Sprintf("%d", entry.NextForkEpoch),
+			gossip: true,
+		})
+	}
+	return diffs, nil
+}
@@ -0,0 +1,114 @@
+package config
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	pb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestParseConfigValues(t *testing.T) {
+	yaml := `# Extends the mainnet preset
+PRESET_BASE: 'mainnet'
+CONFIG_NAME: "devnet"
+
+ALTAIR_FORK_VERSION: 0x01000000 # altair
+SECONDS_PER_SLOT: 12
+BLOB_SCHEDULE:
+  - EPOCH: 10
+    MAX_BLOBS_PER_BLOCK: 9
+`
+	values, err := parseConfigValues([]byte(yaml))
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]string{
+		"PRESET_BASE":         "mainnet",
+		"CONFIG_NAME":         "devnet",
+		"ALTAIR_FORK_VERSION": "0x01000000",
+		"SECONDS_PER_SLOT":    "12",
+	}, values)
+
+	values, err = parseConfigValues([]byte(`{"data": {"SECONDS_PER_SLOT": "12", "ALTAIR_FORK_VERSION": "0x01000000"}}`))
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]string{"SECONDS_PER_SLOT": "12", "ALTAIR_FORK_VERSION": "0x01000000"}, values)
+}
+
+func TestDiffConfigValues(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	local, err := params.SpecValues(cfg)
+	require.NoError(t, err)
+	other, err := parseConfigValues(params.ConfigToYaml(cfg))
+	require.NoError(t, err)
+	diffs, _ := diffConfigValues(local, other)
+	assert.Equal(t, 0, len(diffs))
+
+	other["ALTAIR_FORK_VERSION"] = "0x0100000A"
+	other["INACTIVITY_SCORE_BIAS"] = "5"
+	other["SOME_FUTURE_VALUE"] = "1"
+	// Hex values are compared regardless of case.
+	other["GENESIS_FORK_VERSION"] = "0x00000000"
+	diffs, unknown := diffConfigValues(local, other)
+	require.Equal(t, 2, len(diffs))
+	assert.Equal(t, "ALTAIR_FORK_VERSION", diffs[0].key)
+	assert.Equal(t, true, diffs[0].gossip)
+	assert.Equal(t, "INACTIVITY_SCORE_BIAS", diffs[1].key)
+	assert.Equal(t, false, diffs[1].gossip)
+	assert.Equal(t, true, slices.Contains(unknown, "SOME_FUTURE_VALUE"))
+}
+
+func TestDiffForkENR(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 5
+	cfg.BellatrixForkEpoch = 10
+	cfg.CapellaForkEpoch = 15
+	cfg.InitializeForkSchedule()
+	params.OverrideBeaconConfig(cfg)
+	root := make([]byte, 32)
+
+	record := func(version [4]byte, nextVersion []byte, nextEpoch primitives.Epoch) *enr.Record {
+		digest, err := forks.ForkDigestFromEpoch(cfg.ForkVersionSchedule[version], root)
+		require.NoError(t, err)
+		enc, err := (&pb.ENRForkID{CurrentForkDigest: digest[:], NextForkVersion: nextVersion, NextForkEpoch: nextEpoch}).MarshalSSZ()
+		require.NoError(t, err)
+		r := &enr.Record{}
+		r.Set(enr.WithEntry("eth2", enc))
+		return r
+	}
+	altair := [4]byte(cfg.AltairForkVersion)
+	genesis := [4]byte(cfg.GenesisForkVersion)
+	// The chain is on Altair, scheduled at epoch 5, with Bellatrix next at epoch 10.
+	currentEpoch := primitives.Epoch(7)
+
+	diffs, err := diffForkENR(record(altair, cfg.BellatrixForkVersion, 10), root, currentEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(diffs))
+
+	// The peer does not schedule the next fork.
+	diffs, err = diffForkENR(record(altair, cfg.AltairForkVersion, cfg.FarFutureEpoch), root, currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(diffs))
+	assert.Equal(t, "NEXT_FORK_VERSION", diffs[0].key)
+	assert.Equal(t, "NEXT_FORK_EPOCH", diffs[1].key)
+
+	// The peer is stuck on a past fork of the same network.
+	diffs, err = diffForkENR(record(genesis, cfg.AltairForkVersion, 5), root, currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(diffs))
+	assert.Equal(t, "CURRENT_FORK_DIGEST", diffs[0].key)
+	assert.StringContains(t, "epoch 0", diffs[0].other)
+	assert.Equal(t, "NEXT_FORK_VERSION", diffs[1].key)
+	assert.Equal(t, "NEXT_FORK_EPOCH", diffs[2].key)
+
+	// The peer is on another network.
+	diffs, err = diffForkENR(record(altair, cfg.BellatrixForkVersion, 10), bytes.Repeat([]byte{1}, 32), currentEpoch)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(diffs))
+	assert.Equal(t, "CURRENT_FORK_DIGEST", diffs[0].key)
+}
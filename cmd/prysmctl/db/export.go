@@ -0,0 +1,124 @@
+package db
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var exportFlags = struct {
+	Path      string
+	ColdPath  string
+	Backend   string
+	StartSlot uint64
+	EndSlot   uint64
+	Format    string
+	Output    string
+}{}
+
+var exportBlocksCmd = &cli.Command{
+	Name: "export-blocks",
+	Usage: "stream the canonical blocks of a slot range as newline-delimited JSON with deduced metadata, or as " +
+		"size-delimited protobuf, for indexers. The beacon node must be stopped",
+	Action: func(cliCtx *cli.Context) error {
+		if err := exportBlocksAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not export blocks")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to the beaconchaindata directory containing the database",
+			Destination: &exportFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "cold-path",
+			Usage:       "path to the beaconchaindata directory of the cold database, if the node uses one",
+			Destination: &exportFlags.ColdPath,
+		},
+		&cli.StringFlag{
+			Name:        "db-backend",
+			Usage:       "backend of the database, detected from the directory contents if not set",
+			Destination: &exportFlags.Backend,
+		},
+		&cli.Uint64Flag{
+			Name:        "start-slot",
+			Usage:       "first slot of the exported blocks",
+			Destination: &exportFlags.StartSlot,
+		},
+		&cli.Uint64Flag{
+			Name:        "end-slot",
+			Usage:       "last slot of the exported blocks, the head slot if not set",
+			Value:       math.MaxUint64,
+			DefaultText: "head slot",
+			Destination: &exportFlags.EndSlot,
+		},
+		&cli.StringFlag{
+			Name:        "format",
+			Usage:       "format of the exported blocks, json or protobuf",
+			Value:       string(export.JSON),
+			Destination: &exportFlags.Format,
+		},
+		&cli.StringFlag{
+			Name:        "output",
+			Usage:       "file the blocks are written to, stdout if not set",
+			Destination: &exportFlags.Output,
+		},
+	},
+}
+
+func exportBlocksAction(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	flags := exportFlags
+	typ, err := sourceBackend(flags.Path, flags.Backend)
+	if err != nil {
+		return err
+	}
+	opts := []kv.KVStoreOption{kv.WithBackend(typ)}
+	if flags.ColdPath != "" {
+		opts = append(opts, kv.WithColdPath(flags.ColdPath))
+	}
+	d, err := kv.NewKVStore(ctx, flags.Path, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	var out io.Writer = os.Stdout
+	if flags.Output != "" {
+		f, err := os.Create(flags.Output) // #nosec G304
+		if err != nil {
+			return errors.Wrap(err, "could not create output file")
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.WithError(err).Error("Could not close output file")
+			}
+		}()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	n, err := export.Blocks(ctx, d, w, export.Format(flags.Format), primitives.Slot(flags.StartSlot), primitives.Slot(flags.EndSlot))
+	if err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return errors.Wrap(err, "could not write blocks")
+	}
+	log.WithField("blocks", n).Info("Exported canonical blocks")
+	return nil
+}
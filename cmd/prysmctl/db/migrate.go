@@ -0,0 +1,208 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv/backend"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var migrateFlags = struct {
+	Path         string
+	From         string
+	To           string
+	BatchSize    int
+	DeleteSource bool
+}{}
+
+var migrateBackendCmd = &cli.Command{
+	Name:  "migrate-backend",
+	Usage: "convert the beacon node database to another storage engine",
+	Action: func(cliCtx *cli.Context) error {
+		if err := migrateBackendAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not migrate db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to the beaconchaindata directory containing the database",
+			Destination: &migrateFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "from",
+			Usage:       "backend of the existing database, detected from the directory contents if not set",
+			Destination: &migrateFlags.From,
+		},
+		&cli.StringFlag{
+			Name:        "to",
+			Usage:       "backend to convert the database to, one of bolt or pebble",
+			Destination: &migrateFlags.To,
+			Required:    true,
+		},
+		&cli.IntFlag{
+			Name:        "batch-size",
+			Usage:       "number of keys copied per transaction",
+			Value:       64,
+			Destination: &migrateFlags.BatchSize,
+		},
+		&cli.BoolFlag{
+			Name:        "delete-source",
+			Usage:       "remove the source database once the copy has been verified",
+			Destination: &migrateFlags.DeleteSource,
+		},
+	},
+}
+
+func migrateBackendAction(cliCtx *cli.Context) error {
+	flags := migrateFlags
+	to, err := backend.ParseType(flags.To)
+	if err != nil {
+		return err
+	}
+	from, err := sourceBackend(flags.Path, flags.From)
+	if err != nil {
+		return err
+	}
+	if from == to {
+		return fmt.Errorf("database already uses the %s backend", to)
+	}
+	exists, err := backend.Exists(to, flags.Path)
+	if err != nil {
+		return err
+	}
+	if exists {
+		resumable, err := interruptedMigration(flags.Path, to)
+		if err != nil {
+			return err
+		}
+		if !resumable {
+			return fmt.Errorf("a %s database already exists in %s, remove it before migrating", to, flags.Path)
+		}
+	}
+
+	log.WithFields(log.Fields{"from": from, "to": to, "path": flags.Path}).Info("Migrating database, this may take a while")
+	copied, resumable, err := migrateBackend(cliCtx.Context, flags.Path, from, to, flags.BatchSize)
+	if err != nil {
+		if resumable {
+			log.Info("Run the migration again to resume it from the last copied key")
+			return err
+		}
+		if rmErr := backend.Remove(to, flags.Path); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove incomplete target database")
+		}
+		return err
+	}
+	log.WithField("keys", copied).Info("Database migrated and verified")
+
+	if flags.DeleteSource {
+		if err := backend.Remove(from, flags.Path); err != nil {
+			return err
+		}
+		log.WithField("backend", from).Info("Removed source database")
+	}
+	log.Infof("Start the beacon node with --db-backend=%s to use the migrated database", to)
+	return nil
+}
+
+// migrateBackend copies the database in path from one backend to another and verifies the copy. The
+// copy resumes from the progress recorded in the target database by an interrupted migration. When the
+// migration fails, resumable reports whether the target database holds the progress of the copy.
+func migrateBackend(ctx context.Context, path string, from, to backend.Type, batchSize int) (copied uint64, resumable bool, err error) {
+	src, err := backend.Open(from, path)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not open %s database", from)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.WithError(err).Error("Could not close source database")
+		}
+	}()
+	dst, err := backend.Open(to, path)
+	if err != nil {
+		return 0, false, errors.Wrapf(err, "could not open %s database", to)
+	}
+	defer func() {
+		if err := dst.Close(); err != nil {
+			log.WithError(err).Error("Could not close target database")
+		}
+	}()
+	copied, err = backend.Copy(ctx, src, dst, batchSize)
+	if err != nil {
+		resumable, progressErr := backend.CopyInProgress(dst)
+		if progressErr != nil {
+			log.WithError(progressErr).Error("Could not read migration progress")
+		}
+		return copied, resumable, err
+	}
+	return copied, false, verifyMigration(src, dst)
+}
+
+// interruptedMigration returns whether the database of the backend in path is the target of an interrupted migration.
+func interruptedMigration(path string, to backend.Type) (bool, error) {
+	db, err := backend.Open(to, path)
+	if err != nil {
+		return false, errors.Wrapf(err, "could not open %s database", to)
+	}
+	resumable, err := backend.CopyInProgress(db)
+	if closeErr := db.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Could not close target database")
+	}
+	return resumable, err
+}
+
+// sourceBackend returns the backend of the database to migrate, detecting it when not given.
+func sourceBackend(path, name string) (backend.Type, error) {
+	if name != "" {
+		return backend.ParseType(name)
+	}
+	found, err := backend.Detect(path)
+	if err != nil {
+		return "", err
+	}
+	switch len(found) {
+	case 0:
+		return "", fmt.Errorf("no database found in %s", path)
+	case 1:
+		return found[0], nil
+	default:
+		return "", fmt.Errorf("found databases of backends %v in %s, select the source with --from", found, path)
+	}
+}
+
+// verifyMigration checks that every bucket holds the same number of keys in both databases.
+func verifyMigration(src, dst backend.DB) error {
+	want, err := countKeys(src)
+	if err != nil {
+		return err
+	}
+	got, err := countKeys(dst)
+	if err != nil {
+		return err
+	}
+	for name, n := range want {
+		if got[name] != n {
+			return fmt.Errorf("bucket %s has %d keys after migration, expected %d", name, got[name], n)
+		}
+	}
+	return nil
+}
+
+func countKeys(db backend.DB) (map[string]uint64, error) {
+	counts := make(map[string]uint64)
+	err := db.View(func(tx backend.Tx) error {
+		return tx.ForEach(func(name []byte, b backend.Bucket) error {
+			c := b.Cursor()
+			for k, _ := c.First(); k != nil; k, _ = c.Next() {
+				counts[string(name)]++
+			}
+			return nil
+		})
+	})
+	return counts, err
+}
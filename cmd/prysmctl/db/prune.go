@@ -0,0 +1,122 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var pruneFlags = struct {
+	Path            string
+	ColdPath        string
+	Backend         string
+	Slot            uint64
+	RetentionEpochs uint64
+	BatchSize       int
+}{}
+
+var pruneCmd = &cli.Command{
+	Name:  "prune",
+	Usage: "delete non-canonical blocks, old states and stale indices below a retention slot",
+	Action: func(cliCtx *cli.Context) error {
+		if err := pruneAction(cliCtx); err != nil {
+			log.WithError(err).Fatal("Could not prune db")
+		}
+		return nil
+	},
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "path",
+			Usage:       "path to the beaconchaindata directory containing the database",
+			Destination: &pruneFlags.Path,
+			Required:    true,
+		},
+		&cli.StringFlag{
+			Name:        "cold-path",
+			Usage:       "path to the beaconchaindata directory of the cold database, if the node uses one",
+			Destination: &pruneFlags.ColdPath,
+		},
+		&cli.StringFlag{
+			Name:        "db-backend",
+			Usage:       "backend of the database, detected from the directory contents if not set",
+			Destination: &pruneFlags.Backend,
+		},
+		&cli.Uint64Flag{
+			Name:        "slot",
+			Usage:       "prune data below this slot, takes precedence over --retention-epochs",
+			Destination: &pruneFlags.Slot,
+		},
+		&cli.Uint64Flag{
+			Name:        "retention-epochs",
+			Usage:       "number of epochs before the finalized checkpoint to keep",
+			Value:       256,
+			Destination: &pruneFlags.RetentionEpochs,
+		},
+		&cli.IntFlag{
+			Name:        "batch-size",
+			Usage:       "number of blocks or indices deleted per transaction",
+			Value:       64,
+			Destination: &pruneFlags.BatchSize,
+		},
+	},
+}
+
+func pruneAction(cliCtx *cli.Context) error {
+	ctx := cliCtx.Context
+	flags := pruneFlags
+	typ, err := sourceBackend(flags.Path, flags.Backend)
+	if err != nil {
+		return err
+	}
+	opts := []kv.KVStoreOption{kv.WithBackend(typ)}
+	if flags.ColdPath != "" {
+		opts = append(opts, kv.WithColdPath(flags.ColdPath))
+	}
+	d, err := kv.NewKVStore(ctx, flags.Path, opts...)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	slot := primitives.Slot(flags.Slot)
+	if !cliCtx.IsSet("slot") {
+		if slot, err = retentionSlot(ctx, d, primitives.Epoch(flags.RetentionEpochs)); err != nil {
+			return err
+		}
+	}
+	log.WithField("slot", slot).Info("Pruning database, this may take a while")
+	stats, err := d.PruneBelow(ctx, slot, flags.BatchSize)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"slot":    stats.Slot,
+		"blocks":  stats.Blocks,
+		"states":  stats.States,
+		"indices": stats.Indices,
+	}).Info("Pruned database")
+	return nil
+}
+
+// retentionSlot returns the first slot of the epoch which is the given number of epochs before the
+// finalized checkpoint.
+func retentionSlot(ctx context.Context, d *kv.Store, retention primitives.Epoch) (primitives.Slot, error) {
+	f, err := d.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if f.Epoch <= retention {
+		return 0, fmt.Errorf("finalized epoch %d is within the retention period of %d epochs, nothing to prune", f.Epoch, retention)
+	}
+	return slots.EpochStart(f.Epoch - retention)
+}
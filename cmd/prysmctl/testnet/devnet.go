@@ -0,0 +1,378 @@
+package testnet
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/pkg/errors"
+	cmdshared "github.com/prysmaticlabs/prysm/v5/cmd"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/v5/cmd/beacon-chain/sync/genesis"
+	validatorflags "github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Ports of a devnet node, as offsets from the base port of the node.
+const (
+	devnetP2PTCPPortOffset = iota
+	devnetP2PUDPPortOffset
+	devnetP2PQUICPortOffset
+	devnetRPCPortOffset
+	devnetHTTPPortOffset
+	devnetMonitoringPortOffset
+	devnetValidatorHTTPPortOffset
+	devnetValidatorMonitoringPortOffset
+	// devnetPortsPerNode is the number of ports reserved for every node.
+	devnetPortsPerNode = 10
+)
+
+var (
+	devnetFlags = struct {
+		NumNodes           uint64
+		NumValidators      uint64
+		DataDir            string
+		BasePort           uint64
+		BeaconChainBinary  string
+		ValidatorBinary    string
+		ExecutionEndpoints cli.StringSlice
+		JWTSecret          string
+		GenesisDelay       uint64
+	}{}
+	devnetCmd = &cli.Command{
+		Name: "devnet",
+		Usage: "Run a local devnet of beacon nodes and validator clients, started as subprocesses with a generated genesis state, " +
+			"static peers and the interop keys split among the validator clients",
+		Action: func(cliCtx *cli.Context) error {
+			if err := runDevnet(cliCtx); err != nil {
+				log.WithError(err).Fatal("Could not run devnet")
+			}
+			return nil
+		},
+		Flags: append([]cli.Flag{
+			chainConfigFileFlag,
+			configNameFlag,
+			configOverrideFlag,
+			&cli.Uint64Flag{
+				Name:        "num-nodes",
+				Usage:       "Number of beacon nodes, each with a validator client",
+				Destination: &devnetFlags.NumNodes,
+				Value:       2,
+			},
+			&cli.Uint64Flag{
+				Name:        "num-validators",
+				Usage:       "Number of interop validators in the genesis state, split among the validator clients",
+				Destination: &devnetFlags.NumValidators,
+				Value:       64,
+			},
+			&cli.StringFlag{
+				Name:        "datadir",
+				Usage:       "Directory of the genesis state, chain config, keys, databases and logs of the devnet. The datadir of a previous devnet is cleared on start",
+				Destination: &devnetFlags.DataDir,
+				Required:    true,
+			},
+			&cli.Uint64Flag{
+				Name:        "base-port",
+				Usage:       fmt.Sprintf("First port of the devnet, every node uses the %d ports following the ports of the previous node", devnetPortsPerNode),
+				Destination: &devnetFlags.BasePort,
+				Value:       14000,
+			},
+			&cli.StringFlag{
+				Name:        "beacon-chain-binary",
+				Usage:       "Path to the beacon-chain binary",
+				Destination: &devnetFlags.BeaconChainBinary,
+				Value:       "beacon-chain",
+			},
+			&cli.StringFlag{
+				Name:        "validator-binary",
+				Usage:       "Path to the validator binary",
+				Destination: &devnetFlags.ValidatorBinary,
+				Value:       "validator",
+			},
+			&cli.StringSliceFlag{
+				Name: "execution-endpoints",
+				Usage: "Engine API endpoints of the execution clients of the beacon nodes, one per node, initialized with the genesis.json " +
+					"of the datadir. Without execution clients the chain does not progress past the bellatrix fork",
+				Destination: &devnetFlags.ExecutionEndpoints,
+			},
+			&cli.StringFlag{
+				Name:        "jwt-secret",
+				Usage:       "Path to the JWT secret shared with the execution clients",
+				Destination: &devnetFlags.JWTSecret,
+			},
+			&cli.Uint64Flag{
+				Name:        "genesis-delay",
+				Usage:       "Seconds from the start of the command to the genesis of the devnet",
+				Destination: &devnetFlags.GenesisDelay,
+				Value:       30,
+			},
+		}, forkEpochFlags()...),
+	}
+)
+
+// devnetNode is a beacon node of the devnet, with its validator client.
+type devnetNode struct {
+	index    uint64
+	dir      string
+	basePort uint64
+	keyPath  string
+	peerID   peer.ID
+	// firstValidator and numValidators are the interop validators of the validator client.
+	firstValidator uint64
+	numValidators  uint64
+}
+
+func (n *devnetNode) port(offset uint64) uint64 {
+	return n.basePort + offset
+}
+
+// multiaddr is the address other nodes of the devnet connect to the node with.
+func (n *devnetNode) multiaddr() string {
+	return fmt.Sprintf("/ip4/127.0.0.1/tcp/%d/p2p/%s", n.port(devnetP2PTCPPortOffset), n.peerID)
+}
+
+// devnetFiles are the files shared by the nodes of the devnet.
+type devnetFiles struct {
+	genesisState string
+	chainConfig  string
+}
+
+// runDevnet generates the genesis state of the devnet, starts its nodes and stops them when interrupted.
+func runDevnet(cliCtx *cli.Context) error {
+	f := &devnetFlags
+	if f.NumNodes == 0 {
+		return errors.New("a devnet needs at least one node")
+	}
+	if f.NumValidators < f.NumNodes {
+		return fmt.Errorf("%d validators can not be split among %d validator clients", f.NumValidators, f.NumNodes)
+	}
+	endpoints := f.ExecutionEndpoints.Value()
+	if len(endpoints) != 0 && uint64(len(endpoints)) != f.NumNodes {
+		return fmt.Errorf("%d execution endpoints were given for %d nodes", len(endpoints), f.NumNodes)
+	}
+	if err := clearDevnetDataDir(f.DataDir); err != nil {
+		return err
+	}
+
+	files, err := writeDevnetGenesis(cliCtx)
+	if err != nil {
+		return err
+	}
+	nodes, err := newDevnetNodes(f.DataDir, f.NumNodes, f.NumValidators, f.BasePort)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(cliCtx.Context, os.Interrupt, syscall.SIGTERM)
+	var cmds []*exec.Cmd
+	defer func() {
+		// The processes are killed once the context is done.
+		cancel()
+		for _, c := range cmds {
+			if err := c.Wait(); err != nil {
+				log.WithError(err).WithField("command", c.Path).Debug("Devnet process exited")
+			}
+		}
+	}()
+	for _, n := range nodes {
+		endpoint := ""
+		if len(endpoints) != 0 {
+			endpoint = endpoints[n.index]
+		}
+		bn, err := startDevnetProcess(ctx, f.BeaconChainBinary, filepath.Join(n.dir, "beacon-chain.log"), beaconNodeArgs(n, nodes, files, endpoint, f.JWTSecret))
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, bn)
+		v, err := startDevnetProcess(ctx, f.ValidatorBinary, filepath.Join(n.dir, "validator.log"), validatorClientArgs(n, files))
+		if err != nil {
+			return err
+		}
+		cmds = append(cmds, v)
+		log.WithFields(logrus.Fields{
+			"node":       n.index,
+			"peerID":     n.peerID,
+			"rpcPort":    n.port(devnetRPCPortOffset),
+			"httpPort":   n.port(devnetHTTPPortOffset),
+			"validators": fmt.Sprintf("%d-%d", n.firstValidator, n.firstValidator+n.numValidators-1),
+		}).Info("Started devnet node")
+	}
+	log.WithField("datadir", f.DataDir).Info("Devnet is running, interrupt to stop it")
+	<-ctx.Done()
+	log.Info("Stopping devnet")
+	return nil
+}
+
+// clearDevnetDataDir clears the datadir of a previous devnet. Any other directory which is not empty is left untouched,
+// so that a mistyped datadir does not delete unrelated files.
+func clearDevnetDataDir(dataDir string) error {
+	entries, err := os.ReadDir(dataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "could not read datadir")
+	}
+	if len(entries) > 0 {
+		isDevnet, err := file.Exists(filepath.Join(dataDir, "genesis.ssz"), file.Regular)
+		if err != nil {
+			return err
+		}
+		if !isDevnet {
+			return fmt.Errorf("datadir %s is not empty and is not the datadir of a devnet", dataDir)
+		}
+		if err := os.RemoveAll(dataDir); err != nil {
+			return errors.Wrap(err, "could not clear datadir")
+		}
+	}
+	return file.MkdirAll(dataDir)
+}
+
+// writeDevnetGenesis writes the genesis state and the chain config of the devnet, and the genesis.json to initialize
+// execution clients with.
+func writeDevnetGenesis(cliCtx *cli.Context) (*devnetFiles, error) {
+	g := &generateGenesisStateFlags
+	g.NumValidators = devnetFlags.NumValidators
+	g.GenesisTime = uint64(time.Now().Unix())
+	g.GenesisTimeDelay = devnetFlags.GenesisDelay
+	g.GethGenesisJsonOut = filepath.Join(devnetFlags.DataDir, "genesis.json")
+	if err := setGlobalParams(cliCtx); err != nil {
+		return nil, fmt.Errorf("could not set config params: %w", err)
+	}
+	if err := setGenesisFork(cliCtx); err != nil {
+		return nil, err
+	}
+	st, err := generateGenesis(cliCtx.Context)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate genesis state: %w", err)
+	}
+	enc, err := st.MarshalSSZ()
+	if err != nil {
+		return nil, err
+	}
+	files := &devnetFiles{
+		genesisState: filepath.Join(devnetFlags.DataDir, "genesis.ssz"),
+		chainConfig:  filepath.Join(devnetFlags.DataDir, "config.yaml"),
+	}
+	if err := file.WriteFile(files.genesisState, enc); err != nil {
+		return nil, err
+	}
+	if err := file.WriteFile(files.chainConfig, params.ConfigToYaml(params.BeaconConfig())); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// newDevnetNodes generates the network keys of the nodes and splits the validators among their validator clients.
+func newDevnetNodes(dataDir string, numNodes, numValidators, basePort uint64) ([]*devnetNode, error) {
+	nodes := make([]*devnetNode, numNodes)
+	first := uint64(0)
+	for i := uint64(0); i < numNodes; i++ {
+		n := &devnetNode{
+			index:          i,
+			dir:            filepath.Join(dataDir, fmt.Sprintf("node-%d", i)),
+			basePort:       basePort + i*devnetPortsPerNode,
+			firstValidator: first,
+			numValidators:  numValidators / numNodes,
+		}
+		if i < numValidators%numNodes {
+			n.numValidators++
+		}
+		first += n.numValidators
+		if err := file.MkdirAll(n.dir); err != nil {
+			return nil, err
+		}
+		key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not generate network key")
+		}
+		raw, err := key.Raw()
+		if err != nil {
+			return nil, err
+		}
+		n.keyPath = filepath.Join(n.dir, "network-key")
+		if err := file.WriteFile(n.keyPath, []byte(hex.EncodeToString(raw))); err != nil {
+			return nil, err
+		}
+		if n.peerID, err = peer.IDFromPrivateKey(key); err != nil {
+			return nil, err
+		}
+		nodes[i] = n
+	}
+	return nodes, nil
+}
+
+// beaconNodeArgs are the arguments of the beacon node, which is connected to all the other nodes of the devnet.
+func beaconNodeArgs(n *devnetNode, nodes []*devnetNode, files *devnetFiles, executionEndpoint, jwtSecret string) []string {
+	args := []string{
+		fmt.Sprintf("--%s=%s", cmdshared.DataDirFlag.Name, filepath.Join(n.dir, "beacon-chain")),
+		fmt.Sprintf("--%s=%s", genesis.StatePath.Name, files.genesisState),
+		fmt.Sprintf("--%s=%s", cmdshared.ChainConfigFileFlag.Name, files.chainConfig),
+		fmt.Sprintf("--%s=%s", cmdshared.P2PPrivKey.Name, n.keyPath),
+		fmt.Sprintf("--%s=%d", cmdshared.P2PTCPPort.Name, n.port(devnetP2PTCPPortOffset)),
+		fmt.Sprintf("--%s=%d", cmdshared.P2PUDPPort.Name, n.port(devnetP2PUDPPortOffset)),
+		fmt.Sprintf("--%s=%d", cmdshared.P2PQUICPort.Name, n.port(devnetP2PQUICPortOffset)),
+		fmt.Sprintf("--%s=%d", flags.RPCPort.Name, n.port(devnetRPCPortOffset)),
+		fmt.Sprintf("--%s=%d", flags.HTTPServerPort.Name, n.port(devnetHTTPPortOffset)),
+		fmt.Sprintf("--%s=%d", flags.MonitoringPortFlag.Name, n.port(devnetMonitoringPortOffset)),
+		fmt.Sprintf("--%s=%s", flags.DepositContractFlag.Name, params.BeaconConfig().DepositContractAddress),
+		fmt.Sprintf("--%s=%d", flags.ContractDeploymentBlock.Name, 0),
+		fmt.Sprintf("--%s=%d", flags.MinSyncPeers.Name, 0),
+		"--" + cmdshared.NoDiscovery.Name,
+		"--" + flags.InteropMockEth1DataVotesFlag.Name,
+		"--" + cmdshared.AcceptTosFlag.Name,
+	}
+	for _, p := range nodes {
+		if p.index != n.index {
+			args = append(args, fmt.Sprintf("--%s=%s", cmdshared.StaticPeers.Name, p.multiaddr()))
+		}
+	}
+	if executionEndpoint != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", flags.ExecutionEngineEndpoint.Name, executionEndpoint))
+	}
+	if jwtSecret != "" {
+		args = append(args, fmt.Sprintf("--%s=%s", flags.ExecutionJWTSecretFlag.Name, jwtSecret))
+	}
+	return args
+}
+
+// validatorClientArgs are the arguments of the validator client of the node, which validates with its share of the
+// interop keys.
+func validatorClientArgs(n *devnetNode, files *devnetFiles) []string {
+	return []string{
+		fmt.Sprintf("--%s=%s", cmdshared.DataDirFlag.Name, filepath.Join(n.dir, "validator")),
+		fmt.Sprintf("--%s=%s", cmdshared.ChainConfigFileFlag.Name, files.chainConfig),
+		fmt.Sprintf("--%s=localhost:%d", validatorflags.BeaconRPCProviderFlag.Name, n.port(devnetRPCPortOffset)),
+		fmt.Sprintf("--%s=%d", validatorflags.HTTPServerPort.Name, n.port(devnetValidatorHTTPPortOffset)),
+		fmt.Sprintf("--%s=%d", validatorflags.MonitoringPortFlag.Name, n.port(devnetValidatorMonitoringPortOffset)),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropStartIndex.Name, n.firstValidator),
+		fmt.Sprintf("--%s=%d", validatorflags.InteropNumValidators.Name, n.numValidators),
+		"--" + cmdshared.ForceClearDB.Name,
+		"--" + cmdshared.AcceptTosFlag.Name,
+	}
+}
+
+// startDevnetProcess starts the binary with its output written to the log file. The process is killed when the
+// context is done.
+func startDevnetProcess(ctx context.Context, binary, logPath string, args []string) (*exec.Cmd, error) {
+	logFile, err := os.Create(filepath.Clean(logPath))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create log file")
+	}
+	c := exec.CommandContext(ctx, binary, args...) // #nosec G204 -- The binary is given by the user.
+	c.Stdout = logFile
+	c.Stderr = logFile
+	if err := c.Start(); err != nil {
+		return nil, errors.Wrapf(err, "could not start %s", binary)
+	}
+	return c, nil
+}
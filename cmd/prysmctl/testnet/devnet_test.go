@@ -0,0 +1,70 @@
+package testnet
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestNewDevnetNodes(t *testing.T) {
+	nodes, err := newDevnetNodes(t.TempDir(), 3, 10, 14000)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(nodes))
+
+	next := uint64(0)
+	for i, n := range nodes {
+		assert.Equal(t, next, n.firstValidator)
+		next += n.numValidators
+		assert.Equal(t, uint64(14000+i*devnetPortsPerNode), n.basePort)
+
+		enc, err := os.ReadFile(n.keyPath)
+		require.NoError(t, err)
+		raw, err := hex.DecodeString(string(enc))
+		require.NoError(t, err)
+		key, err := crypto.UnmarshalSecp256k1PrivateKey(raw)
+		require.NoError(t, err)
+		id, err := peer.IDFromPrivateKey(key)
+		require.NoError(t, err)
+		assert.Equal(t, n.peerID, id)
+	}
+	assert.Equal(t, uint64(10), next)
+	assert.Equal(t, uint64(4), nodes[0].numValidators)
+	assert.Equal(t, uint64(3), nodes[2].numValidators)
+
+	files := &devnetFiles{genesisState: "genesis.ssz", chainConfig: "config.yaml"}
+	args := strings.Join(beaconNodeArgs(nodes[1], nodes, files, "", ""), " ")
+	assert.Equal(t, true, strings.Contains(args, "--peer="+nodes[0].multiaddr()))
+	assert.Equal(t, true, strings.Contains(args, "--peer="+nodes[2].multiaddr()))
+	assert.Equal(t, false, strings.Contains(args, "--peer="+nodes[1].multiaddr()))
+	assert.Equal(t, false, strings.Contains(args, "--execution-endpoint"))
+	assert.Equal(t, true, strings.Contains(args, fmt.Sprintf("--rpc-port=%d", 14010+devnetRPCPortOffset)))
+
+	args = strings.Join(validatorClientArgs(nodes[1], files), " ")
+	assert.Equal(t, true, strings.Contains(args, fmt.Sprintf("--beacon-rpc-provider=localhost:%d", 14010+devnetRPCPortOffset)))
+	assert.Equal(t, true, strings.Contains(args, "--interop-start-index=4"))
+	assert.Equal(t, true, strings.Contains(args, "--interop-num-validators=3"))
+}
+
+func TestClearDevnetDataDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("keep"), 0600))
+	require.ErrorContains(t, "is not the datadir of a devnet", clearDevnetDataDir(dir))
+	_, err := os.Stat(filepath.Join(dir, "notes.txt"))
+	require.NoError(t, err)
+
+	devnet := filepath.Join(t.TempDir(), "devnet")
+	require.NoError(t, clearDevnetDataDir(devnet))
+	require.NoError(t, os.WriteFile(filepath.Join(devnet, "genesis.ssz"), []byte{}, 0600))
+	require.NoError(t, clearDevnetDataDir(devnet))
+	entries, err := os.ReadDir(devnet)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+}
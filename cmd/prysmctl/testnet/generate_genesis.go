@@ -10,7 +10,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ghodss/yaml"
@@ -38,9 +40,11 @@ var (
 		OutputSSZ          string
 		OutputJSON         string
 		OutputYaml         string
+		OutputConfigYaml   string
 		ForkName           string
 		OverrideEth1Data   bool
 		ExecutionEndpoint  string
+		ExecutionBlock     string
 		GethGenesisJsonIn  string
 		GethGenesisJsonOut string
 	}{}
@@ -63,6 +67,17 @@ var (
 		Usage:       "Output filename of the JSON marshaling of the generated genesis state",
 		Value:       "",
 	}
+	chainConfigFileFlag = &cli.StringFlag{
+		Name:        "chain-config-file",
+		Destination: &generateGenesisStateFlags.ChainConfigFile,
+		Usage:       "The path to a YAML file with chain config values",
+	}
+	configNameFlag = &cli.StringFlag{
+		Name:        "config-name",
+		Usage:       "Config kind to be used for generating the genesis state. Default: mainnet. Options include mainnet, interop, minimal, sepolia, holesky. --chain-config-file will override this flag.",
+		Destination: &generateGenesisStateFlags.ConfigName,
+		Value:       params.MainnetName,
+	}
 	generateGenesisStateCmd = &cli.Command{
 		Name:  "generate-genesis",
 		Usage: "Generate a beacon chain genesis state",
@@ -72,23 +87,14 @@ var (
 			}
 			return nil
 		},
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:        "chain-config-file",
-				Destination: &generateGenesisStateFlags.ChainConfigFile,
-				Usage:       "The path to a YAML file with chain config values",
-			},
+		Flags: append([]cli.Flag{
+			chainConfigFileFlag,
 			&cli.StringFlag{
 				Name:        "deposit-json-file",
 				Destination: &generateGenesisStateFlags.DepositJsonFile,
 				Usage:       "Path to deposit_data.json file generated by the staking-deposit-cli tool for optionally specifying validators in genesis state",
 			},
-			&cli.StringFlag{
-				Name:        "config-name",
-				Usage:       "Config kind to be used for generating the genesis state. Default: mainnet. Options include mainnet, interop, minimal, sepolia, holesky. --chain-config-file will override this flag.",
-				Destination: &generateGenesisStateFlags.ConfigName,
-				Value:       params.MainnetName,
-			},
+			configNameFlag,
 			&cli.Uint64Flag{
 				Name:        "num-validators",
 				Usage:       "Number of validators to deterministically generate in the genesis state",
@@ -127,9 +133,17 @@ var (
 				Usage:       "Endpoint to preferred execution client. If unset, defaults to Geth",
 				Value:       "http://localhost:8545",
 			},
+			&cli.StringFlag{
+				Name:        "execution-block",
+				Destination: &generateGenesisStateFlags.ExecutionBlock,
+				Usage: "Hash or number of a block of the execution client at --execution-endpoint to use as the execution " +
+					"payload header of the genesis state, such as the fork block of a shadow fork, instead of a genesis.json. " +
+					"The execution clients of the network must be on the chain of this block",
+			},
+			configOverrideFlag,
 			flags.EnumValue{
-				Name:        "fork",
-				Usage:       fmt.Sprintf("Name of the BeaconState schema to use in output encoding [%s]", strings.Join(versionNames(), ",")),
+				Name:        forkFlagName,
+				Usage:       fmt.Sprintf("Name of the BeaconState schema to use in output encoding [%s]. Defaults to the fork scheduled at genesis by the chain config", strings.Join(versionNames(), ",")),
 				Enum:        versionNames(),
 				Value:       versionNames()[0],
 				Destination: &generateGenesisStateFlags.ForkName,
@@ -137,7 +151,8 @@ var (
 			outputSSZFlag,
 			outputYamlFlag,
 			outputJsonFlag,
-		},
+			outputConfigYamlFlag,
+		}, forkEpochFlags()...),
 	}
 )
 
@@ -173,9 +188,12 @@ func cliActionGenerateGenesisState(cliCtx *cli.Context) error {
 			outputSSZFlag.Name,
 		)
 	}
-	if err := setGlobalParams(); err != nil {
+	if err := setGlobalParams(cliCtx); err != nil {
 		return fmt.Errorf("could not set config params: %w", err)
 	}
+	if err := setGenesisFork(cliCtx); err != nil {
+		return err
+	}
 	st, err := generateGenesis(cliCtx.Context)
 	if err != nil {
 		return fmt.Errorf("could not generate genesis state: %w", err)
@@ -206,21 +224,42 @@ func cliActionGenerateGenesisState(cliCtx *cli.Context) error {
 			return err
 		}
 	}
+	if generateGenesisStateFlags.OutputConfigYaml != "" {
+		if err := file.WriteFile(generateGenesisStateFlags.OutputConfigYaml, params.ConfigToYaml(params.BeaconConfig())); err != nil {
+			return err
+		}
+		log.Printf("Done writing chain config to %s", generateGenesisStateFlags.OutputConfigYaml)
+	}
 	log.Info("Command completed")
 	return nil
 }
 
-func setGlobalParams() error {
+func setGlobalParams(cliCtx *cli.Context) error {
+	var cfg *params.BeaconChainConfig
 	chainConfigFile := generateGenesisStateFlags.ChainConfigFile
 	if chainConfigFile != "" {
 		log.Infof("Specified a chain config file: %s", chainConfigFile)
-		return params.LoadChainConfigFile(chainConfigFile, nil)
+		c, err := params.UnmarshalConfigFile(chainConfigFile, nil)
+		if err != nil {
+			return err
+		}
+		cfg = c
+	} else {
+		c, err := params.ByName(generateGenesisStateFlags.ConfigName)
+		if err != nil {
+			return fmt.Errorf("unable to find config using name %s: %w", generateGenesisStateFlags.ConfigName, err)
+		}
+		cfg = c.Copy()
 	}
-	cfg, err := params.ByName(generateGenesisStateFlags.ConfigName)
+	overrides, err := configOverrides(cliCtx)
 	if err != nil {
-		return fmt.Errorf("unable to find config using name %s: %w", generateGenesisStateFlags.ConfigName, err)
+		return err
 	}
-	return params.SetActive(cfg.Copy())
+	cfg, err = overrideConfig(cfg, overrides)
+	if err != nil {
+		return err
+	}
+	return params.SetActive(cfg)
 }
 
 func generateGenesis(ctx context.Context) (state.BeaconState, error) {
@@ -260,6 +299,24 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 		)
 	}
 
+	if f.ExecutionBlock != "" {
+		if f.GethGenesisJsonIn != "" || f.GethGenesisJsonOut != "" {
+			return nil, errors.New("--execution-block can not be used with --geth-genesis-json-in or --geth-genesis-json-out")
+		}
+		gb, err := executionBlock(ctx, f.ExecutionEndpoint, f.ExecutionBlock)
+		if err != nil {
+			return nil, err
+		}
+		if gb.Time() >= f.GenesisTime {
+			return nil, fmt.Errorf("genesis time %d is not after the timestamp %d of execution block %d", f.GenesisTime, gb.Time(), gb.NumberU64())
+		}
+		log.WithFields(logrus.Fields{
+			"number": gb.NumberU64(),
+			"hash":   gb.Hash().Hex(),
+		}).Info("Using execution block as execution payload header of the genesis state")
+		return interop.NewPreminedGenesis(ctx, f.GenesisTime, nv, 0, v, gb, opts...)
+	}
+
 	gen := &core.Genesis{}
 	if f.GethGenesisJsonIn != "" {
 		gbytes, err := os.ReadFile(f.GethGenesisJsonIn) // #nosec G304
@@ -351,6 +408,34 @@ func generateGenesis(ctx context.Context) (state.BeaconState, error) {
 	return genesisState, err
 }
 
+// executionBlock fetches the execution block with the given hash or number, or the latest block, from the execution
+// client.
+func executionBlock(ctx context.Context, endpoint, block string) (*types.Block, error) {
+	conn, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not dial %s please make sure you are running your execution client", endpoint)
+	}
+	client := ethclient.NewClient(conn)
+	defer client.Close()
+	var b *types.Block
+	switch {
+	case block == "latest":
+		b, err = client.BlockByNumber(ctx, nil)
+	case len(block) == 2+2*common.HashLength && strings.HasPrefix(block, "0x"):
+		b, err = client.BlockByHash(ctx, common.HexToHash(block))
+	default:
+		n, ok := new(big.Int).SetString(block, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid execution block %s, expected a block hash, number or latest", block)
+		}
+		b, err = client.BlockByNumber(ctx, n)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get execution block %s", block)
+	}
+	return b, nil
+}
+
 func depositEntriesFromJSON(enc []byte) ([][]byte, []*ethpb.Deposit_Data, error) {
 	var depositJSON []*depositDataJSON
 	if err := json.Unmarshal(enc, &depositJSON); err != nil {
@@ -0,0 +1,116 @@
+package testnet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	configOverrideFlag = &cli.StringSliceFlag{
+		Name: "config-override",
+		Usage: "Overrides a chain config value with a KEY=VALUE pair, where KEY is the name of the value in the chain config YAML, " +
+			"e.g. --config-override SLOTS_PER_EPOCH=8. Can be used multiple times",
+	}
+	outputConfigYamlFlag = &cli.StringFlag{
+		Name:        "output-config-yaml",
+		Destination: &generateGenesisStateFlags.OutputConfigYaml,
+		Usage:       "Output filename of the chain config YAML matching the generated genesis state, to configure other clients with",
+	}
+	forkFlagName = "fork"
+)
+
+// forkEpochFlagName is the name of the flag which schedules the fork of the version.
+func forkEpochFlagName(v int) string {
+	return version.String(v) + "-fork-epoch"
+}
+
+// forkEpochFlags are the flags which schedule the forks after phase0, overriding the epochs of the chain config.
+func forkEpochFlags() []cli.Flag {
+	versions := sortedVersions()
+	fs := make([]cli.Flag, 0, len(versions)-1)
+	for _, v := range versions[1:] {
+		fs = append(fs, &cli.Uint64Flag{
+			Name:  forkEpochFlagName(v),
+			Usage: fmt.Sprintf("Epoch of the %s fork, overriding the epoch of the chain config. Set to 0 to start the chain at the fork", version.String(v)),
+		})
+	}
+	return fs
+}
+
+func sortedVersions() []int {
+	versions := append([]int{}, version.All()...)
+	sort.Ints(versions)
+	return versions
+}
+
+// configOverrides returns the chain config values to override, as chain config YAML lines.
+func configOverrides(cliCtx *cli.Context) ([]string, error) {
+	var overrides []string
+	for _, v := range sortedVersions()[1:] {
+		if cliCtx.IsSet(forkEpochFlagName(v)) {
+			overrides = append(overrides, fmt.Sprintf("%s_FORK_EPOCH: %d", strings.ToUpper(version.String(v)), cliCtx.Uint64(forkEpochFlagName(v))))
+		}
+	}
+	for _, o := range cliCtx.StringSlice(configOverrideFlag.Name) {
+		key, value, ok := strings.Cut(o, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("config override %q is not a KEY=VALUE pair", o)
+		}
+		overrides = append(overrides, fmt.Sprintf("%s: %s", strings.TrimSpace(key), strings.TrimSpace(value)))
+	}
+	return overrides, nil
+}
+
+// overrideConfig applies the chain config YAML lines to the config. The config keeps its name unless CONFIG_NAME is
+// overridden, so that it replaces the config it was derived from.
+func overrideConfig(cfg *params.BeaconChainConfig, overrides []string) (*params.BeaconChainConfig, error) {
+	if len(overrides) == 0 {
+		return cfg, nil
+	}
+	name := cfg.ConfigName
+	cfg, err := params.UnmarshalConfig([]byte(strings.Join(overrides, "\n")), cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not override chain config")
+	}
+	renamed := false
+	for _, o := range overrides {
+		renamed = renamed || strings.HasPrefix(o, "CONFIG_NAME")
+	}
+	if !renamed {
+		cfg.ConfigName = name
+	}
+	log.WithField("overrides", overrides).Info("Overrode chain config values")
+	return cfg, nil
+}
+
+// genesisFork returns the latest fork which is scheduled at genesis by the chain config.
+func genesisFork(cfg *params.BeaconChainConfig) int {
+	fork := version.Phase0
+	forkVersions := params.ConfigForkVersions(cfg)
+	for forkVersion, epoch := range cfg.ForkVersionSchedule {
+		if v, ok := forkVersions[forkVersion]; ok && epoch == 0 && v > fork {
+			fork = v
+		}
+	}
+	return fork
+}
+
+// setGenesisFork sets the fork of the genesis state to the fork scheduled at genesis by the active chain config, and
+// checks that a fork which was set with the fork flag is the same fork, as other clients derive the fork of the
+// genesis state from the chain config.
+func setGenesisFork(cliCtx *cli.Context) error {
+	fork := version.String(genesisFork(params.BeaconConfig()))
+	if cliCtx.IsSet(forkFlagName) && generateGenesisStateFlags.ForkName != fork {
+		return fmt.Errorf("the chain config schedules the %s fork at genesis, not the %s fork, schedule the forks with the --<fork>-fork-epoch flags",
+			fork, generateGenesisStateFlags.ForkName)
+	}
+	generateGenesisStateFlags.ForkName = fork
+	log.WithField("fork", fork).Info("Generating genesis state of the fork scheduled at genesis")
+	return nil
+}
@@ -0,0 +1,88 @@
+package testnet
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func genesisConfigContext(t *testing.T, args ...string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	for _, f := range append(forkEpochFlags(), configOverrideFlag) {
+		require.NoError(t, f.Apply(set))
+	}
+	set.String(forkFlagName, "", "")
+	require.NoError(t, set.Parse(args))
+	return cli.NewContext(&cli.App{}, set, nil)
+}
+
+func TestConfigOverrides(t *testing.T) {
+	cliCtx := genesisConfigContext(t,
+		"--deneb-fork-epoch", "0",
+		"--electra-fork-epoch", "4",
+		"--config-override", "SLOTS_PER_EPOCH=8",
+		"--config-override", "GENESIS_FORK_VERSION = 0x10000038",
+	)
+	overrides, err := configOverrides(cliCtx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{
+		"DENEB_FORK_EPOCH: 0",
+		"ELECTRA_FORK_EPOCH: 4",
+		"SLOTS_PER_EPOCH: 8",
+		"GENESIS_FORK_VERSION: 0x10000038",
+	}, overrides)
+
+	cfg, err := overrideConfig(params.MinimalSpecConfig().Copy(), overrides)
+	require.NoError(t, err)
+	assert.Equal(t, params.MinimalName, cfg.ConfigName)
+	assert.Equal(t, primitives.Epoch(0), cfg.DenebForkEpoch)
+	assert.Equal(t, primitives.Epoch(4), cfg.ElectraForkEpoch)
+	assert.Equal(t, primitives.Slot(8), cfg.SlotsPerEpoch)
+	assert.DeepEqual(t, []byte{0x10, 0x00, 0x00, 0x38}, cfg.GenesisForkVersion)
+
+	_, err = configOverrides(genesisConfigContext(t, "--config-override", "SLOTS_PER_EPOCH"))
+	require.ErrorContains(t, "is not a KEY=VALUE pair", err)
+}
+
+func TestGenesisFork(t *testing.T) {
+	cfg := params.MainnetConfig().Copy()
+	cfg.InitializeForkSchedule()
+	assert.Equal(t, version.Phase0, genesisFork(cfg))
+
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 0
+	cfg.ElectraForkEpoch = 1
+	cfg.InitializeForkSchedule()
+	assert.Equal(t, version.Deneb, genesisFork(cfg))
+}
+
+func TestSetGenesisFork(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.MinimalSpecConfig().Copy()
+	cfg.AltairForkEpoch = 0
+	cfg.BellatrixForkEpoch = 0
+	cfg.CapellaForkEpoch = 0
+	cfg.DenebForkEpoch = 0
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+	params.BeaconConfig().InitializeForkSchedule()
+
+	generateGenesisStateFlags.ForkName = ""
+	require.NoError(t, setGenesisFork(genesisConfigContext(t)))
+	assert.Equal(t, "electra", generateGenesisStateFlags.ForkName)
+
+	// The fork flag of the command writes the fork name to generateGenesisStateFlags.
+	generateGenesisStateFlags.ForkName = "electra"
+	require.NoError(t, setGenesisFork(genesisConfigContext(t, "--fork", "electra")))
+	generateGenesisStateFlags.ForkName = "deneb"
+	err := setGenesisFork(genesisConfigContext(t, "--fork", "deneb"))
+	require.ErrorContains(t, "schedules the electra fork at genesis, not the deneb fork", err)
+}
@@ -8,6 +8,7 @@ var Commands = []*cli.Command{
 		Usage: "commands for dealing with Ethereum beacon chain testnets",
 		Subcommands: []*cli.Command{
 			generateGenesisStateCmd,
+			devnetCmd,
 		},
 	},
 }
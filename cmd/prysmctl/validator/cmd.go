@@ -70,8 +70,86 @@ var (
 		Aliases: []string{"t"},
 		Usage:   "keymanager API bearer token, note: currently required but may be removed in the future, this is the same token as the web ui token.",
 	}
+
+	ExecutionEndpointFlag = &cli.StringFlag{
+		Name:  "execution-endpoint",
+		Usage: "JSON-RPC endpoint of the execution client used to submit execution layer requests",
+		Value: "http://127.0.0.1:8545",
+	}
+
+	ExecutionKeyFileFlag = &cli.StringFlag{
+		Name:  "execution-private-key-file",
+		Usage: "path to a file containing the hex encoded private key of the validator's withdrawal address, which has to send execution layer requests",
+	}
+
+	MaxRequestFeeFlag = &cli.StringFlag{
+		Name:  "max-request-fee",
+		Usage: "maximum request fee in wei paid to the system contract; the request is not sent while the fee is above this value",
+		Value: "1000000000000000",
+	}
+
+	ValidatorPubkeyFlag = &cli.StringFlag{
+		Name:  "validator-pubkey",
+		Usage: "hex encoded public key of the validator to withdraw from",
+	}
+
+	AmountGweiFlag = &cli.Uint64Flag{
+		Name:  "amount-gwei",
+		Usage: "amount in Gwei to partially withdraw, 0 requests a full exit of the validator",
+	}
+
+	SourcePubkeyFlag = &cli.StringFlag{
+		Name:  "source-pubkey",
+		Usage: "hex encoded public key of the validator to consolidate from",
+	}
+
+	TargetPubkeyFlag = &cli.StringFlag{
+		Name:  "target-pubkey",
+		Usage: "hex encoded public key of the validator to consolidate into",
+	}
+
+	ValidatorIndexFlag = &cli.Uint64Flag{
+		Name:  "validator-index",
+		Usage: "index of the validator to look up in the pending request queues",
+	}
+
+	ExitVaultPathFlag = &cli.StringFlag{
+		Name:  "exit-vault-path",
+		Usage: "path to the exit vault of pre-signed voluntary exits, written by the voluntary exit command with --exit-vault",
+	}
+
+	ValidatorIndicesFlag = &cli.StringSliceFlag{
+		Name:  "validator-indices",
+		Usage: "indices of the validators whose voluntary exits are broadcast, all the voluntary exits of the exit vault when not set",
+	}
+
+	DepositDataPathFlag = &cli.StringFlag{
+		Name:  "deposit-data-path",
+		Usage: "path to a deposit_data JSON file generated by the staking-deposit-cli tool, or to a directory of deposit_data files",
+	}
+
+	AllowTopUpsFlag = &cli.BoolFlag{
+		Name:  "allow-top-ups",
+		Usage: "accepts deposits to public keys which are already in the validator registry or in the pending deposits queue",
+	}
 )
 
+// confirmExecutionRequest requires explicit confirmation before a request is sent, as requests can not be reverted once included.
+func confirmExecutionRequest(cliCtx *cli.Context) error {
+	if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+		return err
+	}
+	if !cliCtx.Bool(cmd.AcceptTosFlag.Name) || !cliCtx.Bool(ConfirmFlag.Name) {
+		au := aurora.NewAurora(true)
+		fmt.Println(au.Red("===============IMPORTANT==============="))
+		fmt.Println(au.Red("Execution layer requests are paid transactions which can NOT be reverted once included."))
+		fmt.Print("The request has to be sent from the validator's withdrawal address, otherwise it is ignored by the beacon chain. \n" +
+			"Please navigate to our website (https://docs.prylabs.network/) and make sure you understand the full implications of this action. \n")
+		return fmt.Errorf("both the `--%s` and `--%s` flags are required to run this command", cmd.AcceptTosFlag.Name, ConfirmFlag.Name)
+	}
+	return nil
+}
+
 var Commands = []*cli.Command{
 	{
 		Name:    "validator",
@@ -123,6 +201,122 @@ var Commands = []*cli.Command{
 					return nil
 				},
 			},
+			{
+				Name:    "execution-request",
+				Aliases: []string{"er"},
+				Usage:   "Submit execution layer triggered withdrawal and consolidation requests and track them through the pending queues.",
+				Subcommands: []*cli.Command{
+					{
+						Name:  "withdrawal",
+						Usage: "Submit a partial withdrawal or full exit request to the withdrawal request contract.",
+						Flags: []cli.Flag{
+							ExecutionEndpointFlag,
+							ExecutionKeyFileFlag,
+							MaxRequestFeeFlag,
+							ValidatorPubkeyFlag,
+							AmountGweiFlag,
+							ConfirmFlag,
+							cmd.ConfigFileFlag,
+							cmd.AcceptTosFlag,
+						},
+						Before: confirmExecutionRequest,
+						Action: func(cliCtx *cli.Context) error {
+							if err := submitWithdrawalRequest(cliCtx); err != nil {
+								log.WithError(err).Fatal("Could not submit withdrawal request")
+							}
+							return nil
+						},
+					},
+					{
+						Name:  "consolidation",
+						Usage: "Submit a consolidation request to the consolidation request contract.",
+						Flags: []cli.Flag{
+							ExecutionEndpointFlag,
+							ExecutionKeyFileFlag,
+							MaxRequestFeeFlag,
+							SourcePubkeyFlag,
+							TargetPubkeyFlag,
+							ConfirmFlag,
+							cmd.ConfigFileFlag,
+							cmd.AcceptTosFlag,
+						},
+						Before: confirmExecutionRequest,
+						Action: func(cliCtx *cli.Context) error {
+							if err := submitConsolidationRequest(cliCtx); err != nil {
+								log.WithError(err).Fatal("Could not submit consolidation request")
+							}
+							return nil
+						},
+					},
+					{
+						Name:  "status",
+						Usage: "Display the position of a validator's requests in the pending partial withdrawals and consolidations queues.",
+						Flags: []cli.Flag{
+							BeaconHostFlag,
+							ValidatorIndexFlag,
+							cmd.ConfigFileFlag,
+						},
+						Before: func(cliCtx *cli.Context) error {
+							return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+						},
+						Action: func(cliCtx *cli.Context) error {
+							if err := showExecutionRequestStatus(cliCtx); err != nil {
+								log.WithError(err).Fatal("Could not get execution request status")
+							}
+							return nil
+						},
+					},
+				},
+			},
+			{
+				Name:  "broadcast-exits",
+				Usage: "Broadcast pre-signed voluntary exits of an exit vault through a beacon node. WARNING: voluntary exits can not be reverted once included.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					ExitVaultPathFlag,
+					flags.ExitVaultPasswordFileFlag,
+					ValidatorIndicesFlag,
+					ConfirmFlag,
+					cmd.ConfigFileFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+						return err
+					}
+					if !cliCtx.Bool(ConfirmFlag.Name) {
+						au := aurora.NewAurora(true)
+						fmt.Println(au.Red("===============IMPORTANT==============="))
+						fmt.Println(au.Red("Voluntary exits can NOT be reverted once included, the exited validators can not validate again."))
+						return fmt.Errorf("the `--%s` flag is required to run this command", ConfirmFlag.Name)
+					}
+					return nil
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := broadcastVaultExits(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not broadcast voluntary exits")
+					}
+					return nil
+				},
+			},
+			{
+				Name:  "check-deposits",
+				Usage: "Check deposit_data files against the head state of a beacon node before sending the deposit transactions.",
+				Flags: []cli.Flag{
+					BeaconHostFlag,
+					DepositDataPathFlag,
+					AllowTopUpsFlag,
+					cmd.ConfigFileFlag,
+				},
+				Before: func(cliCtx *cli.Context) error {
+					return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+				},
+				Action: func(cliCtx *cli.Context) error {
+					if err := checkDeposits(cliCtx); err != nil {
+						log.WithError(err).Fatal("Could not check deposits")
+					}
+					return nil
+				},
+			},
 			{
 				Name:    "proposer-settings",
 				Aliases: []string{"ps"},
@@ -166,6 +360,12 @@ var Commands = []*cli.Command{
 					flags.ExitAllFlag,
 					flags.ForceExitFlag,
 					flags.VoluntaryExitJSONOutputPathFlag,
+					flags.VoluntaryExitEpochFlag,
+					flags.ExitVaultFlag,
+					flags.ExitVaultPasswordFileFlag,
+					flags.ExitsPerEpochFlag,
+					flags.BLSToExecutionChangesPathFlag,
+					flags.BeaconRESTApiProviderFlag,
 					features.Mainnet,
 					features.SepoliaTestnet,
 					features.HoleskyTestnet,
@@ -0,0 +1,332 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/contracts/deposit"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// depositData is an entry of a deposit_data file generated by the staking-deposit-cli tool.
+type depositData struct {
+	Pubkey                string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                uint64 `json:"amount"`
+	Signature             string `json:"signature"`
+	DepositMessageRoot    string `json:"deposit_message_root"`
+	DepositDataRoot       string `json:"deposit_data_root"`
+	ForkVersion           string `json:"fork_version"`
+	NetworkName           string `json:"network_name"`
+	DepositCliVersion     string `json:"deposit_cli_version"`
+}
+
+// depositRegistry is the view of the beacon node on the public keys of a batch of deposits: the validators already in
+// the registry and the deposits waiting in the pending deposits queue.
+type depositRegistry struct {
+	validators      map[string]*structs.ValidatorContainer
+	pendingDeposits map[string][]*structs.QueuedPendingDeposit
+}
+
+// depositCheck is the outcome of checking a deposit against the beacon node.
+type depositCheck struct {
+	problems []string
+	topUp    bool
+}
+
+// checkDeposits checks the deposits of deposit_data files against the head state of the beacon node, so that mistakes
+// are caught before the deposit transactions are sent, as deposits can not be reverted once included.
+func checkDeposits(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.checkDeposits")
+	defer span.End()
+
+	if !c.IsSet(DepositDataPathFlag.Name) {
+		return errNoFlag(DepositDataPathFlag.Name)
+	}
+	deposits, err := readDepositData(c.String(DepositDataPathFlag.Name))
+	if err != nil {
+		return err
+	}
+	client, err := beacon.NewClient(c.String(BeaconHostFlag.Name))
+	if err != nil {
+		return err
+	}
+	spec, err := client.GetConfigSpec(ctx)
+	if err != nil {
+		return err
+	}
+	data, ok := spec.Data.(map[string]interface{})
+	if !ok {
+		return errors.New("config has incorrect structure")
+	}
+	rawForkVersion, ok := data["GENESIS_FORK_VERSION"].(string)
+	if !ok {
+		return errors.New("configs used on beacon node do not contain GENESIS_FORK_VERSION")
+	}
+	genesisForkVersion, err := hexutil.Decode(rawForkVersion)
+	if err != nil {
+		return errors.Wrap(err, "could not decode GENESIS_FORK_VERSION")
+	}
+	rawElectraEpoch, ok := data["ELECTRA_FORK_EPOCH"].(string)
+	if !ok {
+		return errors.New("configs used on beacon node do not contain ELECTRA_FORK_EPOCH")
+	}
+	electraEpoch, err := strconv.ParseUint(rawElectraEpoch, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not parse ELECTRA_FORK_EPOCH")
+	}
+	fork, err := client.GetFork(ctx, beacon.IdHead)
+	if err != nil {
+		return errors.Wrap(err, "could not get fork of the head state")
+	}
+	// The pending deposits queue only exists from Electra, before it deposits are processed as soon as they are
+	// included in a block.
+	hasPendingDeposits := fork.Epoch >= primitives.Epoch(electraEpoch)
+
+	pubkeys := make([]string, 0, len(deposits))
+	for _, d := range deposits {
+		pubkeys = append(pubkeys, with0x(d.Pubkey))
+	}
+	validators, err := client.GetValidators(ctx, beacon.IdHead, pubkeys)
+	if err != nil {
+		return errors.Wrap(err, "could not get validators of the deposits")
+	}
+	registry := &depositRegistry{
+		validators:      make(map[string]*structs.ValidatorContainer, len(validators.Data)),
+		pendingDeposits: make(map[string][]*structs.QueuedPendingDeposit),
+	}
+	for _, v := range validators.Data {
+		registry.validators[v.Validator.Pubkey] = v
+	}
+	for _, pubkey := range pubkeys {
+		if !hasPendingDeposits {
+			break
+		}
+		if _, ok := registry.pendingDeposits[pubkey]; ok {
+			continue
+		}
+		pk, err := hexutil.Decode(pubkey)
+		if err != nil {
+			// Reported as a problem of the deposit.
+			continue
+		}
+		pending, err := client.GetPendingDeposits(ctx, beacon.IdHead, pk)
+		if err != nil {
+			return errors.Wrap(err, "could not get pending deposits")
+		}
+		registry.pendingDeposits[pubkey] = pending.Data
+	}
+
+	allowTopUps := c.Bool(AllowTopUpsFlag.Name)
+	var invalid, topUps int
+	var total uint64
+	seen := make(map[string]bool, len(deposits))
+	for _, d := range deposits {
+		pubkey := with0x(d.Pubkey)
+		check := checkDeposit(d, genesisForkVersion, registry)
+		if seen[pubkey] {
+			check.problems = append(check.problems, "public key is deposited more than once in the batch")
+		}
+		seen[pubkey] = true
+		if check.topUp {
+			topUps++
+			if !allowTopUps {
+				check.problems = append(check.problems, fmt.Sprintf("deposit tops up an existing validator, use --%s if this is intended", AllowTopUpsFlag.Name))
+			}
+		}
+		total += d.Amount
+		if len(check.problems) == 0 {
+			continue
+		}
+		invalid++
+		for _, problem := range check.problems {
+			log.WithField("pubkey", pubkey).Error(problem)
+		}
+	}
+	log.WithFields(log.Fields{
+		"deposits":       len(deposits),
+		"newValidators":  len(deposits) - topUps,
+		"topUps":         topUps,
+		"totalGwei":      total,
+		"failedDeposits": invalid,
+	}).Info("Checked deposits")
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d deposits failed the checks, do not send the deposit transactions", invalid, len(deposits))
+	}
+	return nil
+}
+
+// readDepositData reads the deposits of a deposit_data file, or of all the JSON files of a directory.
+func readDepositData(path string) ([]*depositData, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read deposit data path")
+	}
+	files := []string{path}
+	if info.IsDir() {
+		files, err = filepath.Glob(filepath.Join(path, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var deposits []*depositData
+	for _, f := range files {
+		b, err := os.ReadFile(filepath.Clean(f))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open file")
+		}
+		var fileDeposits []*depositData
+		if err := json.Unmarshal(b, &fileDeposits); err != nil {
+			return nil, errors.Wrapf(err, "provided file %s is not a deposit data file", f)
+		}
+		deposits = append(deposits, fileDeposits...)
+	}
+	if len(deposits) == 0 {
+		return nil, errors.New("no deposits were found")
+	}
+	return deposits, nil
+}
+
+// checkDeposit checks the format, roots and signature of a deposit and whether it creates a validator or tops up a
+// validator which is either in the registry or in the pending deposits queue.
+func checkDeposit(d *depositData, genesisForkVersion []byte, registry *depositRegistry) *depositCheck {
+	check := &depositCheck{}
+	cfg := params.BeaconConfig()
+	pubkey, err := hexutil.Decode(with0x(d.Pubkey))
+	if err != nil || len(pubkey) != fieldparams.BLSPubkeyLength {
+		check.problems = append(check.problems, "invalid public key")
+		return check
+	}
+	credentials, err := hexutil.Decode(with0x(d.WithdrawalCredentials))
+	if err != nil || len(credentials) != fieldparams.RootLength {
+		check.problems = append(check.problems, "invalid withdrawal credentials")
+		return check
+	}
+	signature, err := hexutil.Decode(with0x(d.Signature))
+	if err != nil || len(signature) != fieldparams.BLSSignatureLength {
+		check.problems = append(check.problems, "invalid signature")
+		return check
+	}
+
+	switch credentials[0] {
+	case cfg.BLSWithdrawalPrefixByte:
+		log.WithField("pubkey", with0x(d.Pubkey)).Warn("Withdrawal credentials are BLS credentials, " +
+			"a BLS to execution change is needed before the validator can withdraw")
+	case cfg.ETH1AddressWithdrawalPrefixByte, cfg.CompoundingWithdrawalPrefixByte:
+		if !bytes.Equal(credentials[1:12], make([]byte, 11)) {
+			check.problems = append(check.problems, "execution withdrawal credentials are not an execution address padded with zeros")
+		}
+	default:
+		check.problems = append(check.problems, fmt.Sprintf("unknown withdrawal credentials prefix %#x", credentials[0]))
+	}
+
+	forkVersion, err := hexutil.Decode(with0x(d.ForkVersion))
+	if err != nil || !bytes.Equal(forkVersion, genesisForkVersion) {
+		check.problems = append(check.problems, fmt.Sprintf("fork version %s is not the genesis fork version %#x of the beacon node", d.ForkVersion, genesisForkVersion))
+	}
+	depositDataRoot, err := hexutil.Decode(with0x(d.DepositDataRoot))
+	if err != nil {
+		check.problems = append(check.problems, "invalid deposit data root")
+	} else {
+		root, err := (&ethpb.Deposit_Data{
+			PublicKey:             pubkey,
+			WithdrawalCredentials: credentials,
+			Amount:                d.Amount,
+			Signature:             signature,
+		}).HashTreeRoot()
+		if err != nil {
+			check.problems = append(check.problems, "could not compute deposit data root")
+		} else if !bytes.Equal(root[:], depositDataRoot) {
+			check.problems = append(check.problems, fmt.Sprintf("deposit data root %s is not the root %#x of the deposit", d.DepositDataRoot, root))
+		}
+	}
+	message := &ethpb.DepositMessage{
+		PublicKey:             pubkey,
+		WithdrawalCredentials: credentials,
+		Amount:                d.Amount,
+	}
+	depositMessageRoot, err := hexutil.Decode(with0x(d.DepositMessageRoot))
+	if err != nil {
+		check.problems = append(check.problems, "invalid deposit message root")
+	} else {
+		root, err := message.HashTreeRoot()
+		if err != nil {
+			check.problems = append(check.problems, "could not compute deposit message root")
+		} else if !bytes.Equal(root[:], depositMessageRoot) {
+			check.problems = append(check.problems, fmt.Sprintf("deposit message root %s is not the root %#x of the deposit", d.DepositMessageRoot, root))
+		}
+	}
+	// A deposit with an invalid signature which creates a validator is ignored by the beacon chain and its amount is
+	// lost, while top-ups are applied without checking the signature.
+	var signatureErr error
+	domain, err := signing.ComputeDomain(cfg.DomainDeposit, genesisForkVersion, nil)
+	if err != nil {
+		signatureErr = errors.Wrap(err, "could not compute deposit domain")
+	} else {
+		signatureErr = deposit.VerifyDepositSignature(&ethpb.Deposit_Data{
+			PublicKey:             message.PublicKey,
+			WithdrawalCredentials: message.WithdrawalCredentials,
+			Amount:                message.Amount,
+			Signature:             signature,
+		}, domain)
+	}
+	if d.Amount < cfg.MinDepositAmount {
+		check.problems = append(check.problems, fmt.Sprintf("amount %d Gwei is below the minimum deposit amount %d Gwei", d.Amount, cfg.MinDepositAmount))
+	}
+
+	if v, ok := registry.validators[with0x(d.Pubkey)]; ok {
+		check.topUp = true
+		log.WithFields(log.Fields{
+			"pubkey":         with0x(d.Pubkey),
+			"validatorIndex": v.Index,
+			"status":         v.Status,
+		}).Info("Public key is already in the validator registry")
+		if v.Validator.WithdrawalCredentials != hexutil.Encode(credentials) {
+			check.problems = append(check.problems, "withdrawal credentials differ from the credentials of the validator, which are not changed by a top-up")
+		}
+		return check
+	}
+	if pending := registry.pendingDeposits[with0x(d.Pubkey)]; len(pending) > 0 {
+		check.topUp = true
+		log.WithFields(log.Fields{
+			"pubkey":        with0x(d.Pubkey),
+			"queuePosition": pending[0].Position,
+			"amountGwei":    pending[0].Deposit.Amount,
+		}).Info("Public key already has a deposit in the pending deposits queue")
+		if pending[0].Deposit.WithdrawalCredentials != hexutil.Encode(credentials) {
+			check.problems = append(check.problems, "withdrawal credentials differ from the credentials of the pending deposit, which are not changed by a top-up")
+		}
+		return check
+	}
+	if signatureErr != nil {
+		check.problems = append(check.problems, fmt.Sprintf("signature of the deposit is invalid, the deposit would be lost: %v", signatureErr))
+	}
+	if d.Amount < cfg.MinActivationBalance {
+		log.WithField("pubkey", with0x(d.Pubkey)).Warnf("Amount %d Gwei of a new validator is below the activation balance %d Gwei, "+
+			"the validator is not activated until it is topped up", d.Amount, cfg.MinActivationBalance)
+	}
+	return check
+}
+
+// with0x adds the 0x prefix to hex strings which are missing it, as the staking-deposit-cli tool writes them without.
+func with0x(s string) string {
+	if len(s) >= 2 && s[:2] == "0x" {
+		return s
+	}
+	return "0x" + s
+}
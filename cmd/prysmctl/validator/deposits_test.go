@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCheckDeposits(t *testing.T) {
+	keys := make([]bls.SecretKey, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = bls.RandKey()
+		require.NoError(t, err)
+	}
+	existing, pending, fresh := keys[0], keys[1], keys[2]
+	credentials := append([]byte{params.BeaconConfig().ETH1AddressWithdrawalPrefixByte}, bytesutil.PadTo([]byte{}, 31)...)
+	credentials[31] = 0xaa
+
+	electraEpoch := params.BeaconConfig().ElectraForkEpoch
+	if electraEpoch == params.BeaconConfig().FarFutureEpoch {
+		electraEpoch = 10
+	}
+	headEpoch := electraEpoch
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/eth/v1/config/spec":
+			require.NoError(t, json.NewEncoder(w).Encode(&structs.GetSpecResponse{
+				Data: map[string]string{
+					"GENESIS_FORK_VERSION": hexutil.Encode(params.BeaconConfig().GenesisForkVersion),
+					"ELECTRA_FORK_EPOCH":   strconv.FormatUint(uint64(electraEpoch), 10),
+				},
+			}))
+		case "/eth/v1/beacon/states/head/fork":
+			require.NoError(t, json.NewEncoder(w).Encode(&structs.GetStateForkResponse{
+				Data: &structs.Fork{
+					PreviousVersion: hexutil.Encode(params.BeaconConfig().GenesisForkVersion),
+					CurrentVersion:  hexutil.Encode(params.BeaconConfig().GenesisForkVersion),
+					Epoch:           strconv.FormatUint(uint64(headEpoch), 10),
+				},
+			}))
+		case "/eth/v1/beacon/states/head/validators":
+			require.Equal(t, http.MethodPost, r.Method)
+			require.NoError(t, json.NewEncoder(w).Encode(&structs.GetValidatorsResponse{
+				Data: []*structs.ValidatorContainer{{
+					Index:  "5",
+					Status: "active_ongoing",
+					Validator: &structs.Validator{
+						Pubkey:                hexutil.Encode(existing.PublicKey().Marshal()),
+						WithdrawalCredentials: hexutil.Encode(credentials),
+					},
+				}},
+			}))
+		case "/prysm/v1/beacon/states/head/pending_deposits":
+			if headEpoch < electraEpoch {
+				t.Error("pending deposits requested before Electra")
+				http.Error(w, "Pending queues are not available before Electra", http.StatusBadRequest)
+				return
+			}
+			resp := &structs.GetPendingDepositsResponse{}
+			if r.URL.Query().Get("pubkey") == hexutil.Encode(pending.PublicKey().Marshal()) {
+				resp.Data = []*structs.QueuedPendingDeposit{{
+					Position: "3",
+					Deposit: &structs.PendingDeposit{
+						Pubkey:                hexutil.Encode(pending.PublicKey().Marshal()),
+						WithdrawalCredentials: hexutil.Encode(credentials),
+						Amount:                "32000000000",
+					},
+				}}
+			}
+			require.NoError(t, json.NewEncoder(w).Encode(resp))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	}))
+	defer srv.Close()
+
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainDeposit, params.BeaconConfig().GenesisForkVersion, nil)
+	require.NoError(t, err)
+	deposit := func(key bls.SecretKey, modify func(d *depositData)) *depositData {
+		d := &depositData{
+			Pubkey:                hexutil.Encode(key.PublicKey().Marshal())[2:],
+			WithdrawalCredentials: hexutil.Encode(credentials)[2:],
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+			ForkVersion:           hexutil.Encode(params.BeaconConfig().GenesisForkVersion)[2:],
+		}
+		if modify != nil {
+			modify(d)
+		}
+		message := &ethpb.DepositMessage{PublicKey: key.PublicKey().Marshal(), Amount: d.Amount}
+		message.WithdrawalCredentials, err = hexutil.Decode(with0x(d.WithdrawalCredentials))
+		require.NoError(t, err)
+		messageRoot, err := message.HashTreeRoot()
+		require.NoError(t, err)
+		signingRoot, err := signing.ComputeSigningRoot(message, domain)
+		require.NoError(t, err)
+		data := &ethpb.Deposit_Data{
+			PublicKey:             message.PublicKey,
+			WithdrawalCredentials: message.WithdrawalCredentials,
+			Amount:                message.Amount,
+			Signature:             key.Sign(signingRoot[:]).Marshal(),
+		}
+		dataRoot, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		d.Signature = hexutil.Encode(data.Signature)[2:]
+		d.DepositMessageRoot = hexutil.Encode(messageRoot[:])[2:]
+		d.DepositDataRoot = hexutil.Encode(dataRoot[:])[2:]
+		return d
+	}
+	// withSignature replaces the signature of a deposit with the signature of another deposit, along with the deposit
+	// data root which covers it.
+	withSignature := func(d, other *depositData) *depositData {
+		data := &ethpb.Deposit_Data{Amount: d.Amount}
+		data.PublicKey, err = hexutil.Decode(with0x(d.Pubkey))
+		require.NoError(t, err)
+		data.WithdrawalCredentials, err = hexutil.Decode(with0x(d.WithdrawalCredentials))
+		require.NoError(t, err)
+		data.Signature, err = hexutil.Decode(with0x(other.Signature))
+		require.NoError(t, err)
+		root, err := data.HashTreeRoot()
+		require.NoError(t, err)
+		d.Signature = other.Signature
+		d.DepositDataRoot = hexutil.Encode(root[:])[2:]
+		return d
+	}
+	check := func(t *testing.T, allowTopUps bool, deposits ...*depositData) error {
+		path := filepath.Join(t.TempDir(), "deposit_data.json")
+		b, err := json.Marshal(deposits)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(path, b, 0600))
+		set := flag.NewFlagSet("test", 0)
+		set.String(BeaconHostFlag.Name, srv.URL, "")
+		set.String(DepositDataPathFlag.Name, "", "")
+		set.Bool(AllowTopUpsFlag.Name, allowTopUps, "")
+		require.NoError(t, set.Set(DepositDataPathFlag.Name, path))
+		return checkDeposits(cli.NewContext(&cli.App{}, set, nil))
+	}
+
+	t.Run("new validator", func(t *testing.T) {
+		require.NoError(t, check(t, false, deposit(fresh, nil)))
+	})
+	t.Run("already deposited", func(t *testing.T) {
+		require.ErrorContains(t, "1 of 2 deposits failed", check(t, false, deposit(fresh, nil), deposit(existing, nil)))
+	})
+	t.Run("pending deposit", func(t *testing.T) {
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, deposit(pending, nil)))
+	})
+	t.Run("top-ups allowed", func(t *testing.T) {
+		require.NoError(t, check(t, true, deposit(existing, nil), deposit(pending, nil)))
+	})
+	t.Run("top-up with other withdrawal credentials", func(t *testing.T) {
+		d := deposit(existing, func(d *depositData) { d.WithdrawalCredentials = "00" + d.WithdrawalCredentials[2:] })
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, true, d))
+	})
+	t.Run("unknown withdrawal credentials prefix", func(t *testing.T) {
+		d := deposit(fresh, func(d *depositData) { d.WithdrawalCredentials = "03" + d.WithdrawalCredentials[2:] })
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("execution address not padded", func(t *testing.T) {
+		d := deposit(fresh, func(d *depositData) { d.WithdrawalCredentials = "01ff" + d.WithdrawalCredentials[4:] })
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("other network", func(t *testing.T) {
+		d := deposit(fresh, func(d *depositData) { d.ForkVersion = "ffffffff" })
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("wrong deposit data root", func(t *testing.T) {
+		d := deposit(fresh, nil)
+		d.DepositDataRoot = deposit(existing, nil).DepositDataRoot
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("wrong deposit message root", func(t *testing.T) {
+		d := deposit(fresh, nil)
+		d.DepositMessageRoot = deposit(existing, nil).DepositMessageRoot
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		d := withSignature(deposit(fresh, nil), deposit(existing, nil))
+		require.ErrorContains(t, "1 of 1 deposits failed", check(t, false, d))
+	})
+	t.Run("top-up with invalid signature", func(t *testing.T) {
+		d := withSignature(deposit(existing, nil), deposit(fresh, nil))
+		require.NoError(t, check(t, true, d))
+	})
+	t.Run("duplicate deposit", func(t *testing.T) {
+		require.ErrorContains(t, "1 of 2 deposits failed", check(t, false, deposit(fresh, nil), deposit(fresh, nil)))
+	})
+	t.Run("before Electra", func(t *testing.T) {
+		headEpoch = electraEpoch - 1
+		defer func() { headEpoch = electraEpoch }()
+		require.NoError(t, check(t, false, deposit(fresh, nil), deposit(pending, nil)))
+	})
+}
@@ -0,0 +1,269 @@
+package validator
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// withdrawalRequestContract is the EIP-7002 system contract which accepts execution layer triggered withdrawals.
+	withdrawalRequestContract = common.HexToAddress("0x00000961Ef480Eb55e80D19ad83579A64c007002")
+	// consolidationRequestContract is the EIP-7251 system contract which accepts consolidation requests.
+	consolidationRequestContract = common.HexToAddress("0x0000BBdDc7CE488642fb579F8B00f3a590007251")
+)
+
+// withdrawalRequestCalldata encodes the input expected by the withdrawal request contract:
+// the 48 byte validator public key followed by the amount in Gwei as a big endian uint64.
+// An amount of zero requests a full exit of the validator.
+func withdrawalRequestCalldata(pubkey []byte, amount uint64) ([]byte, error) {
+	if len(pubkey) != fieldparams.BLSPubkeyLength {
+		return nil, fmt.Errorf("invalid validator public key length %d", len(pubkey))
+	}
+	data := make([]byte, fieldparams.BLSPubkeyLength+8)
+	copy(data, pubkey)
+	binary.BigEndian.PutUint64(data[fieldparams.BLSPubkeyLength:], amount)
+	return data, nil
+}
+
+// consolidationRequestCalldata encodes the input expected by the consolidation request contract:
+// the 48 byte source public key followed by the 48 byte target public key.
+func consolidationRequestCalldata(source, target []byte) ([]byte, error) {
+	if len(source) != fieldparams.BLSPubkeyLength {
+		return nil, fmt.Errorf("invalid source public key length %d", len(source))
+	}
+	if len(target) != fieldparams.BLSPubkeyLength {
+		return nil, fmt.Errorf("invalid target public key length %d", len(target))
+	}
+	return append(append(make([]byte, 0, 2*fieldparams.BLSPubkeyLength), source...), target...), nil
+}
+
+// executionRequestSubmitter sends requests to the system contracts from the validator's withdrawal address.
+type executionRequestSubmitter struct {
+	ec     *ethclient.Client
+	key    *ecdsa.PrivateKey
+	sender common.Address
+	maxFee *big.Int
+}
+
+func newExecutionRequestSubmitter(ctx context.Context, c *cli.Context) (*executionRequestSubmitter, error) {
+	if !c.IsSet(ExecutionKeyFileFlag.Name) {
+		return nil, errNoFlag(ExecutionKeyFileFlag.Name)
+	}
+	rawKey, err := os.ReadFile(filepath.Clean(c.String(ExecutionKeyFileFlag.Name)))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read execution private key file")
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(rawKey)), "0x"))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse execution private key")
+	}
+	maxFee, ok := new(big.Int).SetString(c.String(MaxRequestFeeFlag.Name), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid --%s value %s", MaxRequestFeeFlag.Name, c.String(MaxRequestFeeFlag.Name))
+	}
+	ec, err := ethclient.DialContext(ctx, c.String(ExecutionEndpointFlag.Name))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to execution endpoint")
+	}
+	return &executionRequestSubmitter{
+		ec:     ec,
+		key:    key,
+		sender: crypto.PubkeyToAddress(key.PublicKey),
+		maxFee: maxFee,
+	}, nil
+}
+
+// requestFee reads the current fee of the system contract, which is returned when calling it without input.
+func (s *executionRequestSubmitter) requestFee(ctx context.Context, contract common.Address) (*big.Int, error) {
+	res, err := s.ec.CallContract(ctx, ethereum.CallMsg{To: &contract}, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read request fee")
+	}
+	if len(res) != 32 {
+		return nil, fmt.Errorf("unexpected request fee response length %d", len(res))
+	}
+	return new(big.Int).SetBytes(res), nil
+}
+
+// submit sends a transaction carrying the request data to the system contract, paying the current request fee.
+func (s *executionRequestSubmitter) submit(ctx context.Context, contract common.Address, data []byte) (common.Hash, error) {
+	fee, err := s.requestFee(ctx, contract)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if fee.Cmp(s.maxFee) > 0 {
+		return common.Hash{}, fmt.Errorf("current request fee %s wei exceeds the maximum of %s wei, retry later or raise --%s", fee, s.maxFee, MaxRequestFeeFlag.Name)
+	}
+	chainID, err := s.ec.ChainID(ctx)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not get chain ID")
+	}
+	nonce, err := s.ec.PendingNonceAt(ctx, s.sender)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not get account nonce")
+	}
+	tip, err := s.ec.SuggestGasTipCap(ctx)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not get gas tip")
+	}
+	head, err := s.ec.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not get latest execution header")
+	}
+	gas, err := s.ec.EstimateGas(ctx, ethereum.CallMsg{From: s.sender, To: &contract, Value: fee, Data: data})
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not estimate gas")
+	}
+	tx, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       gas,
+		To:        &contract,
+		Value:     fee,
+		Data:      data,
+	}), types.LatestSignerForChainID(chainID), s.key)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not sign transaction")
+	}
+	if err := s.ec.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, errors.Wrap(err, "could not send transaction")
+	}
+	return tx.Hash(), nil
+}
+
+func pubkeyFromFlag(c *cli.Context, flag *cli.StringFlag) ([]byte, error) {
+	if !c.IsSet(flag.Name) {
+		return nil, errNoFlag(flag.Name)
+	}
+	pubkey, err := hexutil.Decode(c.String(flag.Name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s value", flag.Name)
+	}
+	return pubkey, nil
+}
+
+func submitWithdrawalRequest(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "executionRequests.submitWithdrawalRequest")
+	defer span.End()
+	pubkey, err := pubkeyFromFlag(c, ValidatorPubkeyFlag)
+	if err != nil {
+		return err
+	}
+	amount := c.Uint64(AmountGweiFlag.Name)
+	data, err := withdrawalRequestCalldata(pubkey, amount)
+	if err != nil {
+		return err
+	}
+	s, err := newExecutionRequestSubmitter(ctx, c)
+	if err != nil {
+		return err
+	}
+	txHash, err := s.submit(ctx, withdrawalRequestContract, data)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"pubkey":     hexutil.Encode(pubkey),
+		"amountGwei": amount,
+		"fullExit":   amount == 0,
+		"txHash":     txHash.Hex(),
+	}).Info("Submitted withdrawal request")
+	return nil
+}
+
+func submitConsolidationRequest(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "executionRequests.submitConsolidationRequest")
+	defer span.End()
+	source, err := pubkeyFromFlag(c, SourcePubkeyFlag)
+	if err != nil {
+		return err
+	}
+	target, err := pubkeyFromFlag(c, TargetPubkeyFlag)
+	if err != nil {
+		return err
+	}
+	data, err := consolidationRequestCalldata(source, target)
+	if err != nil {
+		return err
+	}
+	s, err := newExecutionRequestSubmitter(ctx, c)
+	if err != nil {
+		return err
+	}
+	txHash, err := s.submit(ctx, consolidationRequestContract, data)
+	if err != nil {
+		return err
+	}
+	log.WithFields(log.Fields{
+		"sourcePubkey": hexutil.Encode(source),
+		"targetPubkey": hexutil.Encode(target),
+		"txHash":       txHash.Hex(),
+	}).Info("Submitted consolidation request")
+	return nil
+}
+
+// showExecutionRequestStatus reports the position of a validator's requests in the Electra pending queues
+// of the beacon node's head state.
+func showExecutionRequestStatus(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "executionRequests.showExecutionRequestStatus")
+	defer span.End()
+	if !c.IsSet(ValidatorIndexFlag.Name) {
+		return errNoFlag(ValidatorIndexFlag.Name)
+	}
+	index := primitives.ValidatorIndex(c.Uint64(ValidatorIndexFlag.Name))
+	client, err := beacon.NewClient(c.String(BeaconHostFlag.Name))
+	if err != nil {
+		return err
+	}
+	withdrawals, err := client.GetPendingPartialWithdrawals(ctx, beacon.IdHead, index)
+	if err != nil {
+		return err
+	}
+	for _, w := range withdrawals.Data {
+		log.WithFields(log.Fields{
+			"validatorIndex":    index,
+			"queuePosition":     w.Position,
+			"amountGwei":        w.Withdrawal.Amount,
+			"withdrawableEpoch": w.Withdrawal.WithdrawableEpoch,
+		}).Info("Pending partial withdrawal")
+	}
+	consolidations, err := client.GetPendingConsolidations(ctx, beacon.IdHead, index)
+	if err != nil {
+		return err
+	}
+	for _, cons := range consolidations.Data {
+		log.WithFields(log.Fields{
+			"validatorIndex": index,
+			"queuePosition":  cons.Position,
+			"sourceIndex":    cons.Consolidation.SourceIndex,
+			"targetIndex":    cons.Consolidation.TargetIndex,
+		}).Info("Pending consolidation")
+	}
+	if len(withdrawals.Data) == 0 && len(consolidations.Data) == 0 {
+		log.WithField("validatorIndex", index).Info("No pending partial withdrawals or consolidations found. " +
+			"Requests which were already processed, or which were not sent from the validator's withdrawal address, are not listed.")
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package validator
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	logtest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/urfave/cli/v2"
+)
+
+func TestWithdrawalRequestCalldata(t *testing.T) {
+	pubkey := bytes.Repeat([]byte{0x01}, 48)
+	data, err := withdrawalRequestCalldata(pubkey, 1_000_000_000)
+	require.NoError(t, err)
+	require.Equal(t, 56, len(data))
+	assert.DeepEqual(t, pubkey, data[:48])
+	assert.DeepEqual(t, []byte{0, 0, 0, 0, 0x3b, 0x9a, 0xca, 0x00}, data[48:])
+
+	_, err = withdrawalRequestCalldata(pubkey[:47], 0)
+	require.ErrorContains(t, "invalid validator public key length", err)
+}
+
+func TestConsolidationRequestCalldata(t *testing.T) {
+	source := bytes.Repeat([]byte{0x01}, 48)
+	target := bytes.Repeat([]byte{0x02}, 48)
+	data, err := consolidationRequestCalldata(source, target)
+	require.NoError(t, err)
+	require.Equal(t, 96, len(data))
+	assert.DeepEqual(t, source, data[:48])
+	assert.DeepEqual(t, target, data[48:])
+
+	_, err = consolidationRequestCalldata(source, target[:1])
+	require.ErrorContains(t, "invalid target public key length", err)
+}
+
+func TestShowExecutionRequestStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "7", r.URL.Query().Get("validator_index"))
+		switch r.URL.Path {
+		case "/prysm/v1/beacon/states/head/pending_partial_withdrawals":
+			require.NoError(t, json.NewEncoder(w).Encode(&structs.GetPendingPartialWithdrawalsResponse{
+				Data: []*structs.QueuedPendingPartialWithdrawal{
+					{
+						Position: "12",
+						Withdrawal: &structs.PendingPartialWithdrawal{
+							Index:             "7",
+							Amount:            "1000000000",
+							WithdrawableEpoch: "300",
+						},
+					},
+				},
+				TotalSize: "1",
+			}))
+		case "/prysm/v1/beacon/states/head/pending_consolidations":
+			require.NoError(t, json.NewEncoder(w).Encode(&structs.GetPendingConsolidationsResponse{
+				Data:      []*structs.QueuedPendingConsolidation{},
+				TotalSize: "0",
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	hook := logtest.NewGlobal()
+
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(BeaconHostFlag.Name, srv.URL, "")
+	set.Uint64(ValidatorIndexFlag.Name, 7, "")
+	assert.NoError(t, set.Set(BeaconHostFlag.Name, srv.URL))
+	assert.NoError(t, set.Set(ValidatorIndexFlag.Name, "7"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	require.NoError(t, showExecutionRequestStatus(cliCtx))
+	assert.LogsContain(t, hook, "Pending partial withdrawal")
+	assert.LogsContain(t, hook, "queuePosition=12")
+	assert.LogsDoNotContain(t, hook, "Pending consolidation")
+}
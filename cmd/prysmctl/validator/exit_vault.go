@@ -0,0 +1,69 @@
+package validator
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// broadcastVaultExits decrypts the pre-signed voluntary exits of an exit vault and submits the selected ones to the
+// beacon node.
+func broadcastVaultExits(c *cli.Context) error {
+	ctx, span := trace.StartSpan(c.Context, "validator.broadcastVaultExits")
+	defer span.End()
+
+	if !c.IsSet(ExitVaultPathFlag.Name) {
+		return errors.Errorf("no --%s flag value was provided", ExitVaultPathFlag.Name)
+	}
+	password, err := prompt.InputPassword(c, flags.ExitVaultPasswordFileFlag, "Enter the password of the exit vault", "", false, prompt.NotEmpty)
+	if err != nil {
+		return errors.Wrap(err, "could not determine password of the exit vault")
+	}
+	exits, err := accounts.ReadExitVault(c.String(ExitVaultPathFlag.Name), password)
+	if err != nil {
+		return err
+	}
+	exits, err = selectVaultExits(exits, c.StringSlice(ValidatorIndicesFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	client, err := beacon.NewClient(c.String(BeaconHostFlag.Name))
+	if err != nil {
+		return err
+	}
+	for _, exit := range exits {
+		if err := client.SubmitVoluntaryExit(ctx, exit); err != nil {
+			return errors.Wrapf(err, "could not submit voluntary exit of validator %s", exit.Message.ValidatorIndex)
+		}
+		log.WithField("validatorIndex", exit.Message.ValidatorIndex).Info("Submitted voluntary exit")
+	}
+	return nil
+}
+
+// selectVaultExits returns the voluntary exits of the validator indices, or all of them when no validator index is
+// given.
+func selectVaultExits(exits []*structs.SignedVoluntaryExit, indices []string) ([]*structs.SignedVoluntaryExit, error) {
+	if len(indices) == 0 {
+		return exits, nil
+	}
+	byIndex := make(map[string]*structs.SignedVoluntaryExit, len(exits))
+	for _, exit := range exits {
+		byIndex[exit.Message.ValidatorIndex] = exit
+	}
+	selected := make([]*structs.SignedVoluntaryExit, 0, len(indices))
+	for _, index := range indices {
+		exit, ok := byIndex[index]
+		if !ok {
+			return nil, errors.Errorf("no voluntary exit of validator %s in the exit vault", index)
+		}
+		selected = append(selected, exit)
+	}
+	return selected, nil
+}
@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
+	"github.com/urfave/cli/v2"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func TestBroadcastVaultExits(t *testing.T) {
+	var submitted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/pool/voluntary_exits", r.URL.Path)
+		exit := &structs.SignedVoluntaryExit{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(exit))
+		submitted = append(submitted, exit.Message.ValidatorIndex)
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	exits, err := json.Marshal([]*structs.SignedVoluntaryExit{
+		{Message: &structs.VoluntaryExit{Epoch: "1000", ValidatorIndex: "1"}, Signature: "0x01"},
+		{Message: &structs.VoluntaryExit{Epoch: "1000", ValidatorIndex: "2"}, Signature: "0x02"},
+	})
+	require.NoError(t, err)
+	cryptoFields, err := keystorev4.New().Encrypt(exits, "vault password")
+	require.NoError(t, err)
+	vault, err := json.Marshal(&accounts.ExitVault{Crypto: cryptoFields})
+	require.NoError(t, err)
+	vaultPath := filepath.Join(dir, accounts.ExitVaultFileName)
+	require.NoError(t, os.WriteFile(vaultPath, vault, 0600))
+	passwordPath := filepath.Join(dir, "password.txt")
+	require.NoError(t, os.WriteFile(passwordPath, []byte("vault password\n"), 0600))
+
+	broadcast := func(t *testing.T, indices ...string) error {
+		set := flag.NewFlagSet("test", 0)
+		set.String(BeaconHostFlag.Name, srv.URL, "")
+		set.String(ExitVaultPathFlag.Name, "", "")
+		set.String("exit-vault-password-file", "", "")
+		indicesFlag := cli.NewStringSlice(indices...)
+		set.Var(indicesFlag, ValidatorIndicesFlag.Name, "")
+		require.NoError(t, set.Set(ExitVaultPathFlag.Name, vaultPath))
+		require.NoError(t, set.Set("exit-vault-password-file", passwordPath))
+		return broadcastVaultExits(cli.NewContext(&cli.App{}, set, nil))
+	}
+
+	t.Run("selected", func(t *testing.T) {
+		submitted = nil
+		require.NoError(t, broadcast(t, "2"))
+		assert.DeepEqual(t, []string{"2"}, submitted)
+	})
+	t.Run("all", func(t *testing.T) {
+		submitted = nil
+		require.NoError(t, broadcast(t))
+		assert.DeepEqual(t, []string{"1", "2"}, submitted)
+	})
+	t.Run("unknown validator", func(t *testing.T) {
+		submitted = nil
+		require.ErrorContains(t, "no voluntary exit of validator 3", broadcast(t, "3"))
+		assert.Equal(t, 0, len(submitted))
+	})
+}
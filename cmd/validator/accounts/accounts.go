@@ -55,7 +55,10 @@ var Commands = &cli.Command{
 				flags.WalletPasswordFileFlag,
 				flags.ShowPrivateKeysFlag,
 				flags.ListValidatorIndices,
+				flags.ListValidatorStatusFlag,
+				flags.ListJSONOutputFlag,
 				flags.BeaconRPCProviderFlag,
+				flags.BeaconRESTApiProviderFlag,
 				cmd.GrpcMaxCallRecvMsgSizeFlag,
 				flags.CertFlag,
 				flags.GRPCHeadersFlag,
@@ -166,6 +169,12 @@ var Commands = &cli.Command{
 				flags.ExitAllFlag,
 				flags.ForceExitFlag,
 				flags.VoluntaryExitJSONOutputPathFlag,
+				flags.VoluntaryExitEpochFlag,
+				flags.ExitVaultFlag,
+				flags.ExitVaultPasswordFileFlag,
+				flags.ExitsPerEpochFlag,
+				flags.BLSToExecutionChangesPathFlag,
+				flags.BeaconRESTApiProviderFlag,
 				features.Mainnet,
 				features.SepoliaTestnet,
 				features.HoleskyTestnet,
@@ -9,6 +9,8 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/cmd"
 	"github.com/prysmaticlabs/prysm/v5/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/prompt"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
@@ -84,6 +86,34 @@ func Exit(c *cli.Context, r io.Reader) error {
 		accounts.WithBeaconRESTApiProvider(c.String(flags.BeaconRESTApiProviderFlag.Name)),
 		accounts.WithGRPCHeaders(grpcHeaders),
 		accounts.WithExitJSONOutputPath(c.String(flags.VoluntaryExitJSONOutputPathFlag.Name)),
+		accounts.WithExitsPerEpoch(c.Uint64(flags.ExitsPerEpochFlag.Name)),
+		accounts.WithBLSToExecutionChangesPath(c.String(flags.BLSToExecutionChangesPathFlag.Name)),
+	}
+	if c.IsSet(flags.VoluntaryExitEpochFlag.Name) {
+		if !c.IsSet(flags.VoluntaryExitJSONOutputPathFlag.Name) {
+			return errors.Errorf("--%s requires --%s", flags.VoluntaryExitEpochFlag.Name, flags.VoluntaryExitJSONOutputPathFlag.Name)
+		}
+		opts = append(opts, accounts.WithExitEpoch(primitives.Epoch(c.Uint64(flags.VoluntaryExitEpochFlag.Name))))
+	}
+	if c.Bool(flags.ExitVaultFlag.Name) {
+		if !c.IsSet(flags.VoluntaryExitJSONOutputPathFlag.Name) {
+			return errors.Errorf("--%s requires --%s", flags.ExitVaultFlag.Name, flags.VoluntaryExitJSONOutputPathFlag.Name)
+		}
+		password, err := prompt.InputPassword(
+			c,
+			flags.ExitVaultPasswordFileFlag,
+			"Enter a new password for the exit vault",
+			"Confirm new password",
+			true,
+			prompt.ValidatePasswordInput,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not determine password of the exit vault")
+		}
+		opts = append(opts, accounts.WithExitVaultPassword(password))
+	}
+	if c.IsSet(flags.BLSToExecutionChangesPathFlag.Name) && c.IsSet(flags.VoluntaryExitJSONOutputPathFlag.Name) {
+		return errors.Errorf("--%s cannot be used with --%s, which does not broadcast", flags.BLSToExecutionChangesPathFlag.Name, flags.VoluntaryExitJSONOutputPathFlag.Name)
 	}
 	// Get full set of public keys from the keymanager.
 	validatingPublicKeys, err := km.FetchValidatingPublicKeys(c.Context)
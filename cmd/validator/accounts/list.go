@@ -37,6 +37,12 @@ func accountsList(c *cli.Context) error {
 	if c.IsSet(flags.ListValidatorIndices.Name) {
 		opts = append(opts, accounts.WithListValidatorIndices())
 	}
+	if c.IsSet(flags.ListValidatorStatusFlag.Name) {
+		opts = append(opts, accounts.WithListValidatorStatus())
+	}
+	if c.IsSet(flags.ListJSONOutputFlag.Name) {
+		opts = append(opts, accounts.WithListJSONOutput())
+	}
 	acc, err := accounts.NewCLIManager(opts...)
 	if err != nil {
 		return err
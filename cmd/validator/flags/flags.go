@@ -192,6 +192,19 @@ var (
 		Usage: "Lists validator indices.",
 		Value: false,
 	}
+	// ListValidatorStatusFlag for accounts.
+	ListValidatorStatusFlag = &cli.BoolFlag{
+		Name: "list-validator-status",
+		Usage: "Lists the on-chain status, index, balance and withdrawal credential type of each validator account, " +
+			"as seen by the beacon node.",
+		Value: false,
+	}
+	// ListJSONOutputFlag for accounts.
+	ListJSONOutputFlag = &cli.BoolFlag{
+		Name:  "list-json-output",
+		Usage: "Prints the validator statuses of --list-validator-status as JSON, for scripting.",
+		Value: false,
+	}
 	// NumAccountsFlag defines the amount of accounts to generate for derived wallets.
 	NumAccountsFlag = &cli.IntFlag{
 		Name:  "num-accounts",
@@ -238,6 +251,35 @@ var (
 			"files. If this flag is provided, voluntary exits will be written to the provided " +
 			"directory and will not be broadcasted.",
 	}
+	// VoluntaryExitEpochFlag for pre-signing voluntary exits valid from a future epoch.
+	VoluntaryExitEpochFlag = &cli.Uint64Flag{
+		Name: "exit-epoch",
+		Usage: "Epoch from which the voluntary exits written with --exit-json-output-dir are valid, to pre-sign " +
+			"voluntary exits for cold storage. Defaults to the current epoch.",
+	}
+	// ExitVaultFlag to encrypt the voluntary exits written as JSON into an exit vault.
+	ExitVaultFlag = &cli.BoolFlag{
+		Name: "exit-vault",
+		Usage: "Encrypts the voluntary exits written with --exit-json-output-dir into a single exit vault file, to be " +
+			"kept in cold storage and broadcast later with `prysmctl validator broadcast-exits`.",
+	}
+	// ExitVaultPasswordFileFlag for the password of the exit vault.
+	ExitVaultPasswordFileFlag = &cli.StringFlag{
+		Name:  "exit-vault-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password of the exit vault.",
+	}
+	// ExitsPerEpochFlag to spread the broadcast of voluntary exits over epochs.
+	ExitsPerEpochFlag = &cli.Uint64Flag{
+		Name: "exits-per-epoch",
+		Usage: "Number of voluntary exits broadcast per epoch, the remaining ones are broadcast in the following " +
+			"epochs. All voluntary exits are broadcast at once when 0.",
+	}
+	// BLSToExecutionChangesPathFlag for broadcasting the withdrawal credential changes of the exited validators.
+	BLSToExecutionChangesPathFlag = &cli.StringFlag{
+		Name: "bls-to-execution-changes-path",
+		Usage: "JSON file of signed BLS to execution changes, as written by the staking deposit CLI, which are " +
+			"broadcast through the beacon node REST API before the voluntary exits.",
+	}
 	// BackupPasswordFileFlag for encrypting accounts a user wishes to back up.
 	BackupPasswordFileFlag = &cli.StringFlag{
 		Name:  "backup-password-file",
@@ -309,6 +351,44 @@ var (
 		Aliases: []string{"remote-signer-keys-file"},
 	}
 
+	// PKCS11ModuleFlag defines the PKCS#11 library of the token holding the validator keys.
+	PKCS11ModuleFlag = &cli.StringFlag{
+		Name: "pkcs11-module",
+		Usage: "Path of the PKCS#11 library of a hardware security module or other token to sign with, instead of " +
+			"a wallet. The token either signs with its BLS keys, with --pkcs11-sign-mechanism, or decrypts the " +
+			"passwords of the keystores of --pkcs11-keystores-dir with --pkcs11-wrapping-key-label.",
+	}
+	// PKCS11TokenLabelFlag defines the label of the PKCS#11 token holding the validator keys.
+	PKCS11TokenLabelFlag = &cli.StringFlag{
+		Name:  "pkcs11-token-label",
+		Usage: "Label of the PKCS#11 token among the slots of --pkcs11-module.",
+	}
+	// PKCS11PINFileFlag defines the file of the user PIN of the PKCS#11 token.
+	PKCS11PINFileFlag = &cli.StringFlag{
+		Name:  "pkcs11-pin-file",
+		Usage: "File containing the user PIN of the PKCS#11 token.",
+	}
+	// PKCS11SignMechanismFlag defines the vendor mechanism with which the BLS keys of the PKCS#11 token sign.
+	PKCS11SignMechanismFlag = &cli.Uint64Flag{
+		Name: "pkcs11-sign-mechanism",
+		Usage: "Vendor defined PKCS#11 mechanism with which the BLS private keys of the token sign signing roots, " +
+			"for tokens supporting BLS12-381. The keys are identified by their CKA_ID, the 48 byte compressed " +
+			"public key, and each signature is verified before use.",
+	}
+	// PKCS11WrappingKeyLabelFlag defines the label of the PKCS#11 AES key decrypting the keystore passwords.
+	PKCS11WrappingKeyLabelFlag = &cli.StringFlag{
+		Name: "pkcs11-wrapping-key-label",
+		Usage: "Label of the AES key of the PKCS#11 token decrypting the passwords of the keystores of " +
+			"--pkcs11-keystores-dir, when the token does not sign.",
+	}
+	// PKCS11KeystoresDirFlag defines the directory of the keystores whose passwords the PKCS#11 token decrypts.
+	PKCS11KeystoresDirFlag = &cli.StringFlag{
+		Name: "pkcs11-keystores-dir",
+		Usage: "Directory of EIP-2335 keystores whose passwords are decrypted by the PKCS#11 token. The password of " +
+			"keystore.json is read from keystore.password.enc: a 16 byte initialization vector followed by the " +
+			"password encrypted with CKM_AES_CBC_PAD by the key of --pkcs11-wrapping-key-label.",
+	}
+
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
@@ -385,10 +465,201 @@ var (
 	}
 	// EnableDistributed enables the usage of prysm validator client in a Distributed Validator Cluster.
 	EnableDistributed = &cli.BoolFlag{
-		Name:  "distributed",
-		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster",
+		Name: "distributed",
+		Usage: "To enable the use of prysm validator client in Distributed Validator Cluster. Selection proofs are " +
+			"aggregated by the distributed validator middleware, which must be reached with --enable-beacon-rest-api, " +
+			"and the blocks built by the middleware are signed as provided",
 		Value: false,
 	}
+	// LightClientVerificationEndpointFlag defines the REST API endpoint of an independent beacon node used to
+	// verify the beacon node of the validator client with a light client.
+	LightClientVerificationEndpointFlag = &cli.StringFlag{
+		Name: "light-client-verification-endpoint",
+		Usage: "REST API endpoint of an independent beacon node serving light client data. When set, the validator client " +
+			"follows the chain with a light client synced from this endpoint and alerts when the head, finalized block " +
+			"or sync committee duties of its beacon node diverge from it.",
+	}
+	// LightClientVerificationCheckpointFlag defines the trusted block root the verification light client is bootstrapped from.
+	LightClientVerificationCheckpointFlag = &cli.StringFlag{
+		Name: "light-client-verification-checkpoint",
+		Usage: "Trusted block root, as a 0x-prefixed hex string, from which the verification light client is bootstrapped. " +
+			"Defaults to the finalized checkpoint of the light client verification endpoint.",
+	}
+	// ShutdownDutyWindowFlag defines the time ahead in which duties are performed before the validator client stops.
+	ShutdownDutyWindowFlag = &cli.DurationFlag{
+		Name: "shutdown-duty-window",
+		Usage: "When the validator client is stopped, it first performs the duties due within this duration, waiting " +
+			"for them to complete for up to the end of their slot, so that restarts do not miss imminent duties. " +
+			"Stops immediately when 0.",
+	}
+	// AttestationHeadMaxWaitFlag defines how long attestations wait for the head block of their slot.
+	AttestationHeadMaxWaitFlag = &cli.DurationFlag{
+		Name: "attestation-head-max-wait",
+		Usage: "Attests, and submits sync committee messages, as soon as the beacon node processes the head block of the " +
+			"slot, using its head event stream, waiting for it up to this duration after the start of the slot instead " +
+			"of the one-third mark. Votes for late blocks arriving after the one-third mark when longer than a third of " +
+			"a slot, and must not exceed two thirds of a slot. Disabled when 0.",
+	}
+	// MaxConcurrentDutiesFlag defines the number of duties submitting to the beacon node at once.
+	MaxConcurrentDutiesFlag = &cli.IntFlag{
+		Name: "max-concurrent-duties",
+		Usage: "The maximum number of attestation, aggregation and sync committee duties submitting to the beacon node " +
+			"at once, so that validator clients with thousands of keys don't overload it. The attestation data and " +
+			"sync committee block root of a slot are requested once for all the keys. Unlimited when 0.",
+		Value: 256,
+	}
+	// AuditLogDirFlag defines the directory of the audit log of the signed objects.
+	AuditLogDirFlag = &cli.StringFlag{
+		Name: "audit-log-dir",
+		Usage: "Directory of an append-only audit log of every object signed by the validator client, with its type, " +
+			"slot, signing root and public key, queryable from the validator API. Disabled when empty.",
+	}
+	// AuditLogMaxSizeFlag defines the size at which the audit log file is rotated.
+	AuditLogMaxSizeFlag = &cli.IntFlag{
+		Name:  "audit-log-max-size-mb",
+		Usage: "Size in megabytes at which the audit log file is rotated.",
+		Value: 100,
+	}
+	// AuditLogMaxFilesFlag defines the number of rotated audit log files kept.
+	AuditLogMaxFilesFlag = &cli.IntFlag{
+		Name:  "audit-log-max-files",
+		Usage: "Number of rotated audit log files kept, the oldest ones being removed. All are kept when 0.",
+		Value: 10,
+	}
+	// AuditLogHMACKeyFileFlag defines the file of the key chaining the audit log entries with HMACs.
+	AuditLogHMACKeyFileFlag = &cli.StringFlag{
+		Name: "audit-log-hmac-key-file",
+		Usage: "File containing a secret key with which each audit log entry is chained to the previous one with an " +
+			"HMAC, so that altered or removed entries are detected.",
+	}
+	// RemoteSlashingProtectionURLFlag defines the endpoint of a remote slashing protection consulted before signing.
+	RemoteSlashingProtectionURLFlag = &cli.StringFlag{
+		Name: "remote-slashing-protection-url",
+		Usage: "Endpoint of a remote slashing protection service consulted before signing each block and attestation, " +
+			"in addition to the local slashing protection. It is sent a JSON POST request with the type, slot, public " +
+			"key and signing root of the object, and the epochs of attestations, and must respond with " +
+			"{\"allowed\": true} for the object to be signed. Disabled when empty.",
+	}
+	// RemoteSlashingProtectionTimeoutFlag defines how long the remote slashing protection is waited for.
+	RemoteSlashingProtectionTimeoutFlag = &cli.DurationFlag{
+		Name:  "remote-slashing-protection-timeout",
+		Usage: "How long the decision of the remote slashing protection is waited for before it is considered unreachable.",
+		Value: time.Second,
+	}
+	// RemoteSlashingProtectionAllowOnErrorFlag defines whether objects are signed when the remote slashing protection
+	// is unreachable.
+	RemoteSlashingProtectionAllowOnErrorFlag = &cli.BoolFlag{
+		Name: "remote-slashing-protection-allow-on-error",
+		Usage: "Signs blocks and attestations, relying on the local slashing protection only, when the remote slashing " +
+			"protection can't be reached in time or fails to respond. Their signing is refused otherwise.",
+	}
+	// ProposalPreflightLeadFlag defines how long before a proposal the beacon node is checked for readiness.
+	ProposalPreflightLeadFlag = &cli.DurationFlag{
+		Name: "proposal-preflight-lead",
+		Usage: "How long before each scheduled proposal the beacon node is asked whether it is ready to produce the " +
+			"block: execution client synced, expected fee recipient, builder registration, blob retrieval and recent " +
+			"forkchoice updates. The failed checks are logged as warnings. Requires a Prysm beacon node. Disabled when 0.",
+		Value: 2 * time.Minute,
+	}
+	// LocalGasLimitsFlag enables sending the gas limits of the proposer settings to the beacon node.
+	LocalGasLimitsFlag = &cli.BoolFlag{
+		Name: "local-gas-limits",
+		Usage: "Sends the gas limits of the proposer settings to the beacon node when they change, including for the " +
+			"validators not registered with a builder, for the execution client to target them when it builds " +
+			"payloads locally. Requires --beacon-admin-token-file, and a Prysm beacon node started with " +
+			"--execution-gas-limit-method, --execution-gas-limit-endpoint and --admin-token-file.",
+	}
+	// BeaconAdminTokenFileFlag defines the file of the token authenticating the requests to the admin endpoints of the
+	// beacon node.
+	BeaconAdminTokenFileFlag = &cli.StringFlag{
+		Name: "beacon-admin-token-file",
+		Usage: "Path to a file containing the admin token of the beacon node, set on the beacon node with " +
+			"--admin-token-file, sent as bearer token with the requests to its admin endpoints.",
+	}
+	// DutyRoleFlag defines the duties performed by the validator client.
+	DutyRoleFlag = &cli.StringFlag{
+		Name: "duty-role",
+		Usage: "The duties performed by the validator client: 'all', 'proposer' for the block proposals only, or " +
+			"'attester' for the attestation, aggregation and sync committee duties only. Proposals can be isolated " +
+			"by running a validator client of each of the two split roles with the same keys. The split roles require " +
+			"a slashing protection shared by the two validator clients, a remote signer or --remote-slashing-protection-url, " +
+			"and only the attester role runs the doppelganger check.",
+		Value: "all",
+	}
+	// ExternalBlockSourceEndpointFlag defines the endpoint of a local API building the blocks to propose.
+	ExternalBlockSourceEndpointFlag = &cli.StringFlag{
+		Name: "external-block-source-endpoint",
+		Usage: "Endpoint of a local API serving the beacon API block production endpoint (/eth/v3/validator/blocks/{slot}), " +
+			"from which the blocks to propose are requested instead of the beacon node. A block which is not of the " +
+			"proposal slot, proposer and randao reveal, or not on top of the head of the beacon node, is discarded " +
+			"for a block of the beacon node. Cannot be used with --distributed.",
+	}
+	// BeaconRESTApiCacheFlag enables caching the idempotent beacon API responses in the validator client.
+	BeaconRESTApiCacheFlag = &cli.BoolFlag{
+		Name: "beacon-rest-api-cache",
+		Usage: "Caches the genesis, spec, fork schedule and deposit contract responses of the beacon REST API for an " +
+			"hour, and the proposer, attester and sync committee duties for a slot, and sends concurrent identical " +
+			"requests to the beacon node once. Reduces the requests of validator clients with many keys.",
+	}
+	// BeaconRESTApiHedgeDelayFlag defines after how long a beacon API request is also sent to the next beacon node.
+	BeaconRESTApiHedgeDelayFlag = &cli.DurationFlag{
+		Name: "beacon-rest-api-hedge-delay",
+		Usage: "Time after which a beacon REST API request without response is also sent to the next beacon node of " +
+			"--beacon-rest-api-provider, using the first successful response. A failed request is sent to the next " +
+			"beacon node right away. Disabled when 0 or with a single beacon node.",
+	}
+	// BeaconRESTApiRetryBudgetFlag defines how many times the beacon API requests of a duty are retried.
+	BeaconRESTApiRetryBudgetFlag = &cli.IntFlag{
+		Name: "beacon-rest-api-retry-budget",
+		Usage: "Number of times the failed beacon REST API requests of a duty are retried in total, when they failed " +
+			"because of the beacon node or the connection to it. Disabled when 0.",
+		Value: 2,
+	}
+	// SlashingProtectionBackupFlag defines where the slashing protection history is backed up as the finalized
+	// checkpoint advances.
+	SlashingProtectionBackupFlag = &cli.StringFlag{
+		Name: "slashing-protection-backup",
+		Usage: "Directory, or S3 bucket as s3://bucket/prefix, to which the slashing protection history is exported " +
+			"as EIP-3076 interchange files as the finalized checkpoint advances. A backup is a complete file, named " +
+			"with a -full suffix, followed by files which only contain what was signed since, and importing its " +
+			"files in order restores the slashing protection. A new backup is started when the validator client " +
+			"starts and every 64 exports. S3 requests are signed with the AWS_ACCESS_KEY_ID, " +
+			"AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables. Disabled when empty.",
+	}
+	// SlashingProtectionBackupIDFlag defines the identifier of the validator client in the names of its slashing
+	// protection history backup files.
+	SlashingProtectionBackupIDFlag = &cli.StringFlag{
+		Name: "slashing-protection-backup-id",
+		Usage: "Identifier of the validator client in the names of its slashing protection backup files, so that " +
+			"validator clients backing up to the same destination only prune their own backups. A random identifier " +
+			"is generated and stored in the data directory when empty.",
+	}
+	// SlashingProtectionBackupEpochsFlag defines the number of finalized epochs between two exports of the slashing
+	// protection history.
+	SlashingProtectionBackupEpochsFlag = &cli.Uint64Flag{
+		Name:  "slashing-protection-backup-epochs",
+		Usage: "Number of epochs the finalized checkpoint advances by between two exports of the slashing protection history.",
+		Value: 4,
+	}
+	// SlashingProtectionBackupKeepFlag defines the number of slashing protection history backups kept.
+	SlashingProtectionBackupKeepFlag = &cli.IntFlag{
+		Name:  "slashing-protection-backup-keep",
+		Usage: "Number of slashing protection history backups kept, the files of older backups are deleted. All are kept when 0.",
+		Value: 7,
+	}
+	// SlashingProtectionBackupS3EndpointFlag defines the S3 endpoint the slashing protection history is backed up to.
+	SlashingProtectionBackupS3EndpointFlag = &cli.StringFlag{
+		Name:  "slashing-protection-backup-s3-endpoint",
+		Usage: "Endpoint of the S3 compatible storage to which the slashing protection history is backed up.",
+		Value: "https://s3.amazonaws.com",
+	}
+	// SlashingProtectionBackupS3RegionFlag defines the region of the S3 bucket the slashing protection history is
+	// backed up to.
+	SlashingProtectionBackupS3RegionFlag = &cli.StringFlag{
+		Name:  "slashing-protection-backup-s3-region",
+		Usage: "Region of the S3 bucket to which the slashing protection history is backed up.",
+		Value: "us-east-1",
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
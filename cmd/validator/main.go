@@ -23,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/io/logs"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/journald"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	prefixed "github.com/prysmaticlabs/prysm/v5/runtime/logging/logrus-prefixed-formatter"
 	_ "github.com/prysmaticlabs/prysm/v5/runtime/maxprocs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/tos"
@@ -74,11 +75,44 @@ var appFlags = []cli.Flag{
 	flags.EnableWebFlag,
 	flags.GraffitiFileFlag,
 	flags.EnableDistributed,
+	flags.LightClientVerificationEndpointFlag,
+	flags.LightClientVerificationCheckpointFlag,
+	flags.ShutdownDutyWindowFlag,
+	flags.AttestationHeadMaxWaitFlag,
+	flags.MaxConcurrentDutiesFlag,
+	flags.AuditLogDirFlag,
+	flags.AuditLogMaxSizeFlag,
+	flags.AuditLogMaxFilesFlag,
+	flags.AuditLogHMACKeyFileFlag,
+	flags.RemoteSlashingProtectionURLFlag,
+	flags.RemoteSlashingProtectionTimeoutFlag,
+	flags.RemoteSlashingProtectionAllowOnErrorFlag,
+	flags.ProposalPreflightLeadFlag,
+	flags.LocalGasLimitsFlag,
+	flags.BeaconAdminTokenFileFlag,
+	flags.DutyRoleFlag,
+	flags.ExternalBlockSourceEndpointFlag,
+	flags.BeaconRESTApiCacheFlag,
+	flags.BeaconRESTApiHedgeDelayFlag,
+	flags.BeaconRESTApiRetryBudgetFlag,
+	flags.SlashingProtectionBackupFlag,
+	flags.SlashingProtectionBackupIDFlag,
+	flags.SlashingProtectionBackupEpochsFlag,
+	flags.SlashingProtectionBackupKeepFlag,
+	flags.SlashingProtectionBackupS3EndpointFlag,
+	flags.SlashingProtectionBackupS3RegionFlag,
 	flags.AuthTokenPathFlag,
 	// Consensys' Web3Signer flags
 	flags.Web3SignerURLFlag,
 	flags.Web3SignerPublicValidatorKeysFlag,
 	flags.Web3SignerKeyFileFlag,
+	// PKCS#11 token flags
+	flags.PKCS11ModuleFlag,
+	flags.PKCS11TokenLabelFlag,
+	flags.PKCS11PINFileFlag,
+	flags.PKCS11SignMechanismFlag,
+	flags.PKCS11WrappingKeyLabelFlag,
+	flags.PKCS11KeystoresDirFlag,
 	flags.SuggestedFeeRecipientFlag,
 	flags.ProposerSettingsURLFlag,
 	flags.ProposerSettingsFlag,
@@ -100,7 +134,12 @@ var appFlags = []cli.Flag{
 	cmd.TracingProcessNameFlag,
 	cmd.TracingEndpointFlag,
 	cmd.TraceSampleFractionFlag,
+	cmd.OTLPEndpointFlag,
+	cmd.OTLPHeadersFlag,
+	cmd.OTLPExportIntervalFlag,
+	cmd.OTLPInstanceIDFlag,
 	cmd.LogFormat,
+	cmd.LogModuleLevels,
 	cmd.LogFileName,
 	cmd.ConfigFileFlag,
 	cmd.ChainConfigFileFlag,
@@ -166,7 +205,7 @@ func main() {
 				}
 				logrus.SetFormatter(f)
 			case "json":
-				logrus.SetFormatter(&logrus.JSONFormatter{})
+				logrus.SetFormatter(&logging.JSONFormatter{})
 			case "journald":
 				if err := journald.Enable(); err != nil {
 					return err
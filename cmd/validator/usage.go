@@ -66,10 +66,15 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
 			cmd.TraceSampleFractionFlag,
+			cmd.OTLPEndpointFlag,
+			cmd.OTLPHeadersFlag,
+			cmd.OTLPExportIntervalFlag,
+			cmd.OTLPInstanceIDFlag,
 			cmd.MonitoringHostFlag,
 			flags.MonitoringPortFlag,
 			cmd.DisableMonitoringFlag,
 			cmd.LogFormat,
+			cmd.LogModuleLevels,
 			cmd.LogFileName,
 			cmd.ConfigFileFlag,
 			cmd.ChainConfigFileFlag,
@@ -127,6 +132,12 @@ var appHelpFlagGroups = []flagGroup{
 			flags.Web3SignerURLFlag,
 			flags.Web3SignerPublicValidatorKeysFlag,
 			flags.Web3SignerKeyFileFlag,
+			flags.PKCS11ModuleFlag,
+			flags.PKCS11TokenLabelFlag,
+			flags.PKCS11PINFileFlag,
+			flags.PKCS11SignMechanismFlag,
+			flags.PKCS11WrappingKeyLabelFlag,
+			flags.PKCS11KeystoresDirFlag,
 		},
 	},
 	{
@@ -143,6 +154,32 @@ var appHelpFlagGroups = []flagGroup{
 			flags.DisablePenaltyRewardLogFlag,
 			flags.DisableAccountMetricsFlag,
 			flags.EnableDistributed,
+			flags.LightClientVerificationEndpointFlag,
+			flags.LightClientVerificationCheckpointFlag,
+			flags.ShutdownDutyWindowFlag,
+			flags.AttestationHeadMaxWaitFlag,
+			flags.MaxConcurrentDutiesFlag,
+			flags.AuditLogDirFlag,
+			flags.AuditLogMaxSizeFlag,
+			flags.AuditLogMaxFilesFlag,
+			flags.AuditLogHMACKeyFileFlag,
+			flags.RemoteSlashingProtectionURLFlag,
+			flags.RemoteSlashingProtectionTimeoutFlag,
+			flags.RemoteSlashingProtectionAllowOnErrorFlag,
+			flags.ProposalPreflightLeadFlag,
+			flags.LocalGasLimitsFlag,
+			flags.BeaconAdminTokenFileFlag,
+			flags.DutyRoleFlag,
+			flags.ExternalBlockSourceEndpointFlag,
+			flags.BeaconRESTApiCacheFlag,
+			flags.BeaconRESTApiHedgeDelayFlag,
+			flags.BeaconRESTApiRetryBudgetFlag,
+			flags.SlashingProtectionBackupFlag,
+			flags.SlashingProtectionBackupIDFlag,
+			flags.SlashingProtectionBackupEpochsFlag,
+			flags.SlashingProtectionBackupKeepFlag,
+			flags.SlashingProtectionBackupS3EndpointFlag,
+			flags.SlashingProtectionBackupS3RegionFlag,
 			flags.AuthTokenPathFlag,
 		},
 	},
@@ -80,6 +80,8 @@ type Flags struct {
 
 	EnableDiscoveryReboot bool // EnableDiscoveryReboot allows the node to have its local listener to be rebooted in the event of discovery issues.
 
+	EnableParallelEpochProcessing bool // EnableParallelEpochProcessing processes the validators in parallel during the epoch transition.
+
 	// KeystoreImportDebounceInterval specifies the time duration the validator waits to reload new keys if they have
 	// changed on disk. This feature is for advanced use cases only.
 	KeystoreImportDebounceInterval time.Duration
@@ -266,6 +268,10 @@ func ConfigureBeaconChain(ctx *cli.Context) error {
 		logEnabled(EnableDiscoveryReboot)
 		cfg.EnableDiscoveryReboot = true
 	}
+	if ctx.IsSet(EnableParallelEpochProcessing.Name) {
+		logEnabled(EnableParallelEpochProcessing)
+		cfg.EnableParallelEpochProcessing = true
+	}
 
 	cfg.AggregateIntervals = [3]time.Duration{aggregateFirstInterval.Value, aggregateSecondInterval.Value, aggregateThirdInterval.Value}
 	Init(cfg)
@@ -174,6 +174,11 @@ var (
 		Name:  "enable-discovery-reboot",
 		Usage: "Experimental: Enables the discovery listener to rebooted in the event of connectivity issues.",
 	}
+	EnableParallelEpochProcessing = &cli.BoolFlag{
+		Name: "enable-parallel-epoch-processing",
+		Usage: "Experimental: Processes the rewards and penalties, the inactivity scores and the effective balance " +
+			"updates of the validators in parallel during the epoch transition, reducing its latency.",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -232,11 +237,13 @@ var BeaconChainFlags = append(deprecatedBeaconFlags, append(deprecatedFlags, []c
 	EnableQUIC,
 	DisableCommitteeAwarePacking,
 	EnableDiscoveryReboot,
+	EnableParallelEpochProcessing,
 }...)...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
 var E2EBeaconChainFlags = []string{
 	"--dev",
+	"--enable-parallel-epoch-processing",
 }
 
 // NetworkFlags contains a list of network flags.
@@ -4,8 +4,11 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"reflect"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/math"
@@ -78,12 +81,21 @@ func UnmarshalConfigFile(path string, conf *BeaconChainConfig) (*BeaconChainConf
 }
 
 // LoadChainConfigFile load, convert hex values into valid param yaml format,
-// unmarshal , and apply beacon chain config file.
+// unmarshal , and apply beacon chain config file. The path may also be a network
+// bundle, whose bootstrap nodes and deposit contract deployment block are applied
+// to the network config.
 func LoadChainConfigFile(path string, conf *BeaconChainConfig) error {
-	c, err := UnmarshalConfigFile(path, conf)
+	b, err := LoadNetworkBundle(path)
 	if err != nil {
 		return err
 	}
+	c, err := UnmarshalConfigFile(b.ConfigFile, conf)
+	if err != nil {
+		return err
+	}
+	if err := applyNetworkBundle(b, c); err != nil {
+		return err
+	}
 	return SetActive(c)
 }
 
@@ -238,3 +250,42 @@ func ConfigToYaml(cfg *BeaconChainConfig) []byte {
 	yamlFile := []byte(strings.Join(lines, "\n"))
 	return yamlFile
 }
+
+// SpecValues returns the values of the spec fields of the config by their upper case yaml name, formatted as
+// the beacon API returns them: hex strings for byte values and decimal strings for numbers.
+func SpecValues(cfg *BeaconChainConfig) (map[string]string, error) {
+	data := make(map[string]string)
+	config := *cfg
+	t := reflect.TypeOf(config)
+	v := reflect.ValueOf(config)
+
+	for i := 0; i < t.NumField(); i++ {
+		tField := t.Field(i)
+		_, isSpecField := tField.Tag.Lookup("spec")
+		if !isSpecField {
+			// Field should not be returned from API.
+			continue
+		}
+
+		tagValue := strings.ToUpper(tField.Tag.Get("yaml"))
+		vField := v.Field(i)
+		switch vField.Kind() {
+		case reflect.Int:
+			data[tagValue] = strconv.FormatInt(vField.Int(), 10)
+		case reflect.Uint64:
+			data[tagValue] = strconv.FormatUint(vField.Uint(), 10)
+		case reflect.Slice:
+			data[tagValue] = hexutil.Encode(vField.Bytes())
+		case reflect.Array:
+			data[tagValue] = hexutil.Encode(reflect.ValueOf(&config).Elem().Field(i).Slice(0, vField.Len()).Bytes())
+		case reflect.String:
+			data[tagValue] = vField.String()
+		case reflect.Uint8:
+			data[tagValue] = hexutil.Encode([]byte{uint8(vField.Uint())})
+		default:
+			return nil, fmt.Errorf("unsupported config field type: %s", vField.Kind().String())
+		}
+	}
+
+	return data, nil
+}
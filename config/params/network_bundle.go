@@ -0,0 +1,276 @@
+package params
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Files of a network bundle, as published for custom networks.
+const (
+	bundleConfigFile          = "config.yaml"
+	bundleGenesisStateFile    = "genesis.ssz"
+	bundleBootstrapNodesFile  = "bootstrap_nodes.txt"
+	bundleBootENRFile         = "boot_enr.yaml"
+	bundleDepositContractFile = "deposit_contract.txt"
+)
+
+// Depth of the subdirectories searched for the config.yaml of a network bundle.
+const bundleMaxDepth = 2
+
+// Files holding the block in which the deposit contract of a network bundle was deployed.
+var bundleDeploymentBlockFiles = []string{"deposit_contract_block.txt", "deploy_block.txt"}
+
+var (
+	loadedBundles     = make(map[string]*NetworkBundle)
+	loadedBundlesLock sync.Mutex
+)
+
+// NetworkBundle holds the components of a custom network: its chain config, and optionally its genesis state,
+// bootstrap nodes and deposit contract.
+type NetworkBundle struct {
+	ConfigFile                 string   // ConfigFile is the path of the chain config yaml file.
+	GenesisState               string   // GenesisState is the path of the ssz genesis state, if the bundle has one.
+	BootstrapNodes             []string // BootstrapNodes are the ENRs of the bootnodes of the network.
+	DepositContractAddress     string   // DepositContractAddress is the deposit contract of the bundle, if it has one.
+	ContractDeploymentBlock    uint64   // ContractDeploymentBlock is the eth1 block in which the deposit contract is deployed.
+	HasContractDeploymentBlock bool     // HasContractDeploymentBlock is whether the bundle has a deployment block.
+}
+
+// LoadNetworkBundle loads the network bundle at the path, which is either a chain config yaml file, or a directory
+// or zip archive with the config.yaml of the network together with its genesis.ssz, bootstrap_nodes.txt or
+// boot_enr.yaml, deposit_contract.txt and deposit_contract_block.txt or deploy_block.txt files. The files may be
+// in a metadata or other subdirectory. A zip archive is extracted to a temporary directory once per path.
+func LoadNetworkBundle(path string) (*NetworkBundle, error) {
+	info, err := os.Stat(path)
+	switch {
+	case err == nil && info.IsDir():
+		b, err := loadNetworkBundleDir(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load network bundle %s", path)
+		}
+		return b, nil
+	case err == nil && isZipFile(path):
+		loadedBundlesLock.Lock()
+		defer loadedBundlesLock.Unlock()
+		if b, ok := loadedBundles[path]; ok {
+			return b, nil
+		}
+		b, err := loadNetworkBundleZip(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not load network bundle %s", path)
+		}
+		loadedBundles[path] = b
+		return b, nil
+	default:
+		return &NetworkBundle{ConfigFile: path}, nil
+	}
+}
+
+func isZipFile(path string) bool {
+	f, err := os.Open(path) // #nosec G304
+	if err != nil {
+		return false
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	magic := make([]byte, 4)
+	if _, err := io.ReadFull(f, magic); err != nil {
+		return false
+	}
+	return bytes.Equal(magic, []byte("PK\x03\x04"))
+}
+
+func loadNetworkBundleZip(path string) (*NetworkBundle, error) {
+	r, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = r.Close()
+	}()
+	dir, err := os.MkdirTemp("", "network-bundle")
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range r.File {
+		dst := filepath.Join(dir, f.Name) // #nosec G305 -- The path is checked to stay within the directory below.
+		if !strings.HasPrefix(dst, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return nil, fmt.Errorf("invalid file path %s in archive", f.Name)
+		}
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		if err := extractZipFile(f, dst); err != nil {
+			return nil, err
+		}
+	}
+	return loadNetworkBundleDir(dir)
+}
+
+func extractZipFile(f *zip.File, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return err
+	}
+	src, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = src.Close()
+	}()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600) // #nosec G304
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, src); err != nil { // #nosec G110 -- The archive is provided by the node operator.
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func loadNetworkBundleDir(dir string) (*NetworkBundle, error) {
+	dir, err := networkBundleRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	b := &NetworkBundle{ConfigFile: filepath.Join(dir, bundleConfigFile)}
+	if fileExists(filepath.Join(dir, bundleGenesisStateFile)) {
+		b.GenesisState = filepath.Join(dir, bundleGenesisStateFile)
+	}
+
+	if enc, ok, err := readBundleFile(dir, bundleBootstrapNodesFile); err != nil {
+		return nil, err
+	} else if ok {
+		scanner := bufio.NewScanner(bytes.NewReader(enc))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				b.BootstrapNodes = append(b.BootstrapNodes, line)
+			}
+		}
+	}
+	if enc, ok, err := readBundleFile(dir, bundleBootENRFile); err != nil {
+		return nil, err
+	} else if ok {
+		var enrs []string
+		if err := yaml.Unmarshal(enc, &enrs); err != nil {
+			return nil, errors.Wrapf(err, "could not parse %s", bundleBootENRFile)
+		}
+		for _, enr := range enrs {
+			if !slices.Contains(b.BootstrapNodes, enr) {
+				b.BootstrapNodes = append(b.BootstrapNodes, enr)
+			}
+		}
+	}
+
+	if enc, ok, err := readBundleFile(dir, bundleDepositContractFile); err != nil {
+		return nil, err
+	} else if ok {
+		b.DepositContractAddress = strings.TrimSpace(string(enc))
+	}
+	for _, name := range bundleDeploymentBlockFiles {
+		enc, ok, err := readBundleFile(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		b.ContractDeploymentBlock, err = strconv.ParseUint(strings.TrimSpace(string(enc)), 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse %s", name)
+		}
+		b.HasContractDeploymentBlock = true
+		break
+	}
+	return b, nil
+}
+
+// networkBundleRoot returns the directory with the config.yaml of the bundle, which is either the directory itself
+// or its only subdirectory with one, such as the metadata directory of published network configs.
+func networkBundleRoot(dir string) (string, error) {
+	roots, err := networkBundleRoots(dir, 0)
+	if err != nil {
+		return "", err
+	}
+	if len(roots) != 1 {
+		return "", fmt.Errorf("expected one %s in network bundle, found %d", bundleConfigFile, len(roots))
+	}
+	return roots[0], nil
+}
+
+func networkBundleRoots(dir string, depth int) ([]string, error) {
+	if fileExists(filepath.Join(dir, bundleConfigFile)) {
+		return []string{dir}, nil
+	}
+	if depth == bundleMaxDepth {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var roots []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		sub, err := networkBundleRoots(filepath.Join(dir, e.Name()), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		roots = append(roots, sub...)
+	}
+	return roots, nil
+}
+
+func readBundleFile(dir, name string) ([]byte, bool, error) {
+	enc, err := os.ReadFile(filepath.Join(dir, name)) // #nosec G304
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return enc, true, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// applyNetworkBundle checks that the bundle is consistent with its chain config, and sets the bootstrap nodes and
+// deposit contract deployment block of the network config from the bundle.
+func applyNetworkBundle(b *NetworkBundle, c *BeaconChainConfig) error {
+	if b.DepositContractAddress != "" && !strings.EqualFold(b.DepositContractAddress, c.DepositContractAddress) {
+		return fmt.Errorf("deposit contract %s of the network bundle does not match %s of its chain config",
+			b.DepositContractAddress, c.DepositContractAddress)
+	}
+	if len(b.BootstrapNodes) == 0 && !b.HasContractDeploymentBlock {
+		return nil
+	}
+	nc := BeaconNetworkConfig().Copy()
+	if len(b.BootstrapNodes) > 0 {
+		nc.BootstrapNodes = b.BootstrapNodes
+	}
+	if b.HasContractDeploymentBlock {
+		nc.ContractDeploymentBlock = b.ContractDeploymentBlock
+	}
+	OverrideBeaconNetworkConfig(nc)
+	return nil
+}
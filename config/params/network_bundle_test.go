@@ -0,0 +1,98 @@
+package params_test
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func networkBundleFiles(t *testing.T) map[string][]byte {
+	cfg := params.E2ETestConfig().Copy()
+	return map[string][]byte{
+		"config.yaml":          params.ConfigToYaml(cfg),
+		"genesis.ssz":          {1, 2, 3},
+		"bootstrap_nodes.txt":  []byte("# bootnodes\nenr:-a\n\nenr:-b\n"),
+		"boot_enr.yaml":        []byte("- enr:-b\n- enr:-c\n"),
+		"deploy_block.txt":     []byte("123\n"),
+		"deposit_contract.txt": []byte(cfg.DepositContractAddress + "\n"),
+	}
+}
+
+func writeNetworkBundle(t *testing.T, dir string, files map[string][]byte) {
+	require.NoError(t, os.MkdirAll(dir, 0700))
+	for name, enc := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), enc, 0600))
+	}
+}
+
+func TestLoadChainConfigFile_NetworkBundleDirectory(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	dir := t.TempDir()
+	writeNetworkBundle(t, filepath.Join(dir, "metadata"), networkBundleFiles(t))
+
+	require.NoError(t, params.LoadChainConfigFile(dir, nil))
+	assert.Equal(t, params.E2ETestConfig().ConfigName, params.BeaconConfig().ConfigName)
+	assert.DeepEqual(t, []string{"enr:-a", "enr:-b", "enr:-c"}, params.BeaconNetworkConfig().BootstrapNodes)
+	assert.Equal(t, uint64(123), params.BeaconNetworkConfig().ContractDeploymentBlock)
+
+	b, err := params.LoadNetworkBundle(dir)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "metadata", "genesis.ssz"), b.GenesisState)
+}
+
+func TestLoadNetworkBundle_Zip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "devnet.zip")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	w := zip.NewWriter(f)
+	for name, enc := range networkBundleFiles(t) {
+		fw, err := w.Create("devnet/metadata/" + name)
+		require.NoError(t, err)
+		_, err = fw.Write(enc)
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	require.NoError(t, f.Close())
+
+	b, err := params.LoadNetworkBundle(path)
+	require.NoError(t, err)
+	genesis, err := os.ReadFile(b.GenesisState)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 3}, genesis)
+	assert.Equal(t, true, b.HasContractDeploymentBlock)
+	assert.Equal(t, 3, len(b.BootstrapNodes))
+
+	// The archive is only extracted once.
+	again, err := params.LoadNetworkBundle(path)
+	require.NoError(t, err)
+	assert.Equal(t, b, again)
+}
+
+func TestLoadNetworkBundle_YamlFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, params.ConfigToYaml(params.E2ETestConfig()), 0600))
+	b, err := params.LoadNetworkBundle(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &params.NetworkBundle{ConfigFile: path}, b)
+}
+
+func TestLoadChainConfigFile_NetworkBundleMismatch(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	files := networkBundleFiles(t)
+	files["deposit_contract.txt"] = []byte("0x000000000000000000000000000000000000dead")
+	dir := t.TempDir()
+	writeNetworkBundle(t, dir, files)
+	require.ErrorContains(t, "does not match", params.LoadChainConfigFile(dir, nil))
+
+	// Several configs are ambiguous.
+	dir = t.TempDir()
+	writeNetworkBundle(t, filepath.Join(dir, "a"), networkBundleFiles(t))
+	writeNetworkBundle(t, filepath.Join(dir, "b"), networkBundleFiles(t))
+	_, err := params.LoadNetworkBundle(dir)
+	require.ErrorContains(t, "expected one config.yaml in network bundle, found 2", err)
+}
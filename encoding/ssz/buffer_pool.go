@@ -0,0 +1,62 @@
+package ssz
+
+import (
+	"sync"
+)
+
+const (
+	// Initial capacity of a freshly allocated pooled buffer.
+	defaultPooledBufferSize = 4096
+	// Buffers which grew larger than this are dropped instead of being returned
+	// to the pool, so that encoding a single very large object such as a beacon
+	// state does not pin that memory for the lifetime of the process.
+	maxPooledBufferSize = 16 << 20 // 16 MiB.
+)
+
+// Marshaler describes an object which can be SSZ encoded into a caller provided buffer.
+type Marshaler interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+	SizeSSZ() int
+}
+
+var bufferPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, defaultPooledBufferSize)
+		return &b
+	},
+}
+
+// GetBuffer retrieves an empty buffer with a capacity of at least size bytes from the
+// shared pool. The buffer must be handed back with PutBuffer once it is no longer referenced.
+func GetBuffer(size int) *[]byte {
+	buf := bufferPool.Get().(*[]byte)
+	if cap(*buf) < size {
+		*buf = make([]byte, 0, size)
+	}
+	*buf = (*buf)[:0]
+	return buf
+}
+
+// PutBuffer returns a buffer obtained from GetBuffer to the shared pool.
+func PutBuffer(buf *[]byte) {
+	if buf == nil || cap(*buf) > maxPooledBufferSize {
+		return
+	}
+	*buf = (*buf)[:0]
+	bufferPool.Put(buf)
+}
+
+// MarshalWithPool encodes the object into a buffer taken from the shared pool. The returned
+// release function gives the buffer back to the pool and must be called once the encoded
+// bytes are no longer referenced by the caller.
+func MarshalWithPool(obj Marshaler) ([]byte, func(), error) {
+	buf := GetBuffer(obj.SizeSSZ())
+	enc, err := obj.MarshalSSZTo(*buf)
+	if err != nil {
+		PutBuffer(buf)
+		return nil, func() {}, err
+	}
+	// Retain the buffer in case the encoder had to grow it.
+	*buf = enc
+	return enc, func() { PutBuffer(buf) }, nil
+}
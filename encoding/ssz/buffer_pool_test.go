@@ -0,0 +1,60 @@
+package ssz_test
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func TestMarshalWithPool(t *testing.T) {
+	blk := util.NewBeaconBlockDeneb()
+	want, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		got, release, err := ssz.MarshalWithPool(blk)
+		require.NoError(t, err)
+		require.DeepEqual(t, want, got)
+		release()
+	}
+}
+
+func TestGetBuffer(t *testing.T) {
+	buf := ssz.GetBuffer(1 << 20)
+	require.Equal(t, 0, len(*buf))
+	require.Equal(t, true, cap(*buf) >= 1<<20)
+	*buf = append(*buf, 1, 2, 3)
+	ssz.PutBuffer(buf)
+
+	buf = ssz.GetBuffer(10)
+	require.Equal(t, 0, len(*buf))
+	ssz.PutBuffer(buf)
+	ssz.PutBuffer(nil)
+}
+
+func BenchmarkMarshalWithPool(b *testing.B) {
+	blk := util.NewBeaconBlockDeneb()
+	blk.Block.Body.Attestations = make([]*ethpb.Attestation, 128)
+	for i := range blk.Block.Body.Attestations {
+		blk.Block.Body.Attestations[i] = util.HydrateAttestation(&ethpb.Attestation{})
+	}
+
+	b.Run("MarshalSSZ", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, err := blk.MarshalSSZ()
+			require.NoError(b, err)
+		}
+	})
+	b.Run("MarshalWithPool", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, release, err := ssz.MarshalWithPool(blk)
+			require.NoError(b, err)
+			release()
+		}
+	})
+}
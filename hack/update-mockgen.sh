@@ -11,8 +11,9 @@ iface_mock_path="testing/validator-mock"
 # ------------------------------------------------------
 proto_mocks_v1alpha1=(
       "$mock_path/beacon_service_mock.go BeaconChainClient"
-      "$mock_path/beacon_validator_server_mock.go BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamSlotsServer"
-      "$mock_path/beacon_validator_client_mock.go BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamSlotsClient"
+      "$mock_path/beacon_chain_stream_server_mock.go BeaconChainStream_StreamBeaconBlocksServer,BeaconChainStream_StreamAttestationsServer,BeaconChainStream_StreamBalanceChangesServer,BeaconChainStream_StreamValidatorRegistryChangesServer"
+      "$mock_path/beacon_validator_server_mock.go BeaconNodeValidatorServer,BeaconNodeValidator_WaitForActivationServer,BeaconNodeValidator_WaitForChainStartServer,BeaconNodeValidator_StreamSlotsServer,BeaconNodeValidator_StreamDutiesServer"
+      "$mock_path/beacon_validator_client_mock.go BeaconNodeValidatorClient,BeaconNodeValidator_WaitForChainStartClient,BeaconNodeValidator_WaitForActivationClient,BeaconNodeValidator_StreamSlotsClient,BeaconNodeValidator_StreamDutiesClient"
       "$mock_path/node_service_mock.go NodeClient"
 )
 
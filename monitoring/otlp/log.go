@@ -0,0 +1,5 @@
+package otlp
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "otlp")
@@ -0,0 +1,184 @@
+// Package otlp exports the metrics and the traces of the node to an OpenTelemetry collector with the OpenTelemetry
+// protocol, so that operators running collectors need no Prometheus scraper. Metrics are read from the Prometheus
+// registry, so that they have the same names and labels whether scraped or exported.
+package otlp
+
+import (
+	"context"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	prysmTrace "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/sirupsen/logrus"
+	promBridge "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+)
+
+// shutdownTimeout bounds the time spent flushing the metrics and the traces when stopping.
+const shutdownTimeout = 5 * time.Second
+
+// NetworkKey is the resource attribute of the Ethereum network of the node.
+const NetworkKey = attribute.Key("eth.network")
+
+// Config of the OTLP export.
+type Config struct {
+	// Endpoint is the URL of the OTLP/HTTP receiver of the collector, such as http://127.0.0.1:4318.
+	Endpoint string
+	// Headers are sent with every export, to authenticate to the collector for instance.
+	Headers map[string]string
+	// Interval is the time between two exports of the metrics.
+	Interval time.Duration
+	// ServiceName identifies the kind of node, beacon-chain or validator.
+	ServiceName string
+	// InstanceID identifies the node among the nodes of the same service, the host name when empty.
+	InstanceID string
+	// Network is the name of the Ethereum network of the node.
+	Network string
+	// Traces enables the export of traces, sampled with SampleFraction.
+	Traces         bool
+	SampleFraction float64
+	// Gatherer is the Prometheus registry of the exported metrics, the default registry when nil.
+	Gatherer prometheus.Gatherer
+}
+
+// Service exports metrics and traces until stopped.
+type Service struct {
+	cfg            *Config
+	meterProvider  *metric.MeterProvider
+	tracerProvider *trace.TracerProvider
+}
+
+// NewService initializes the exporters of the OTLP export, and sets the global tracer provider when traces are
+// exported.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	u, err := url.Parse(cfg.Endpoint)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil, errors.Errorf("invalid OTLP endpoint %q, expected an http or https URL", cfg.Endpoint)
+	}
+	res, err := newResource(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create OTLP resource")
+	}
+
+	gatherer := cfg.Gatherer
+	if gatherer == nil {
+		gatherer = prometheus.DefaultGatherer
+	}
+	metricExporter, err := otlpmetrichttp.New(ctx, otlpmetrichttp.WithEndpointURL(metricsURL(u)), otlpmetrichttp.WithHeaders(cfg.Headers))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create OTLP metric exporter")
+	}
+	s := &Service{
+		cfg: cfg,
+		meterProvider: metric.NewMeterProvider(
+			metric.WithResource(res),
+			metric.WithReader(metric.NewPeriodicReader(
+				metricExporter,
+				metric.WithInterval(cfg.Interval),
+				metric.WithProducer(promBridge.NewMetricProducer(promBridge.WithGatherer(gatherer))),
+			)),
+		),
+	}
+
+	if cfg.Traces {
+		traceExporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(tracesURL(u)), otlptracehttp.WithHeaders(cfg.Headers))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not create OTLP trace exporter")
+		}
+		s.tracerProvider = trace.NewTracerProvider(
+			trace.WithSampler(trace.TraceIDRatioBased(cfg.SampleFraction)),
+			trace.WithBatcher(traceExporter),
+			trace.WithResource(res),
+		)
+		otel.SetTracerProvider(s.tracerProvider)
+		prysmTrace.TracingEnabled = true
+	}
+	return s, nil
+}
+
+// Start logs the OTLP export, which runs in the background from its creation.
+func (s *Service) Start() {
+	log.WithFields(logrus.Fields{
+		"endpoint": s.cfg.Endpoint,
+		"interval": s.cfg.Interval,
+		"traces":   s.cfg.Traces,
+	}).Info("Exporting metrics to OpenTelemetry collector")
+}
+
+// Stop flushes the metrics and the traces, and stops the exporters.
+func (s *Service) Stop() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	err := s.meterProvider.Shutdown(ctx)
+	if s.tracerProvider != nil {
+		if tErr := s.tracerProvider.Shutdown(ctx); tErr != nil && err == nil {
+			err = tErr
+		}
+	}
+	return err
+}
+
+// Status of the OTLP export, always nil as export failures are retried at the next interval.
+func (*Service) Status() error {
+	return nil
+}
+
+// newResource describes the node in the exported metrics and traces.
+func newResource(cfg *Config) (*resource.Resource, error) {
+	instanceID := cfg.InstanceID
+	if instanceID == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get host name")
+		}
+		instanceID = hostname
+	}
+	return resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(cfg.ServiceName),
+		semconv.ServiceVersionKey.String(version.SemanticVersion()),
+		semconv.ServiceInstanceIDKey.String(instanceID),
+		NetworkKey.String(cfg.Network),
+	), nil
+}
+
+func metricsURL(u *url.URL) string {
+	return signalURL(u, "v1/metrics")
+}
+
+func tracesURL(u *url.URL) string {
+	return signalURL(u, "v1/traces")
+}
+
+// signalURL appends the path of a signal to the endpoint, as the OTLP exporters use the path of the URL as is.
+func signalURL(u *url.URL, path string) string {
+	s := *u
+	s.Path = strings.TrimSuffix(s.Path, "/") + "/" + path
+	return s.String()
+}
+
+// ParseHeaders parses headers in the form <key>=<value>.
+func ParseHeaders(specs []string) (map[string]string, error) {
+	headers := make(map[string]string, len(specs))
+	for _, spec := range specs {
+		key, value, ok := strings.Cut(spec, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, errors.Errorf("invalid OTLP header %q, expected <key>=<value>", spec)
+		}
+		headers[key] = strings.TrimSpace(value)
+	}
+	return headers, nil
+}
@@ -0,0 +1,91 @@
+package otlp
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestService_ExportsPrometheusMetrics(t *testing.T) {
+	requests := make(chan *collectormetrics.ExportMetricsServiceRequest, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/otlp/v1/metrics", r.URL.Path)
+		assert.Equal(t, "secret", r.Header.Get("Authorization"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &collectormetrics.ExportMetricsServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, req))
+		requests <- req
+		w.Header().Set("Content-Type", "application/x-protobuf")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	registry := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "test_exported_total"})
+	registry.MustRegister(counter)
+	counter.Add(3)
+
+	s, err := NewService(context.Background(), &Config{
+		Endpoint:    srv.URL + "/otlp/",
+		Headers:     map[string]string{"Authorization": "secret"},
+		Interval:    time.Hour,
+		ServiceName: "beacon-chain",
+		InstanceID:  "node-1",
+		Network:     "holesky",
+		Gatherer:    registry,
+	})
+	require.NoError(t, err)
+	s.Start()
+	// Stopping flushes the metrics.
+	require.NoError(t, s.Stop())
+
+	var req *collectormetrics.ExportMetricsServiceRequest
+	select {
+	case req = <-requests:
+	default:
+		t.Fatal("no metrics exported")
+	}
+	require.Equal(t, 1, len(req.ResourceMetrics))
+	attrs := make(map[string]string)
+	for _, kv := range req.ResourceMetrics[0].Resource.Attributes {
+		attrs[kv.Key] = kv.Value.GetStringValue()
+	}
+	assert.Equal(t, "beacon-chain", attrs["service.name"])
+	assert.Equal(t, "node-1", attrs["service.instance.id"])
+	assert.Equal(t, "holesky", attrs["eth.network"])
+
+	var found bool
+	for _, sm := range req.ResourceMetrics[0].ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name == "test_exported_total" {
+				found = true
+				assert.Equal(t, 3.0, m.GetSum().DataPoints[0].GetAsDouble())
+			}
+		}
+	}
+	assert.Equal(t, true, found, "exported metric not found")
+}
+
+func TestNewService_InvalidEndpoint(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{Endpoint: "127.0.0.1:4318", Interval: time.Second})
+	require.ErrorContains(t, "invalid OTLP endpoint", err)
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders([]string{"Authorization=Basic abc=", " x-tenant = prysm "})
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string]string{"Authorization": "Basic abc=", "x-tenant": "prysm"}, headers)
+
+	_, err = ParseHeaders([]string{"no-value"})
+	require.ErrorContains(t, "invalid OTLP header", err)
+}
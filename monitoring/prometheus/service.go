@@ -44,12 +44,18 @@ func NewService(addr string, svcRegistry *runtime.ServiceRegistry, additionalHan
 		MaxRequestsInFlight: 5,
 		Timeout:             30 * time.Second,
 	}))
-	mux.HandleFunc("/healthz", s.healthzHandler)
 	mux.HandleFunc("/goroutinez", s.goroutinezHandler)
 
-	// Register additional handlers.
+	// Register additional handlers, which may replace the default /healthz handler.
+	healthz := true
 	for _, h := range additionalHandlers {
 		mux.HandleFunc(h.Path, h.Handler)
+		if h.Path == "/healthz" {
+			healthz = false
+		}
+	}
+	if healthz {
+		mux.HandleFunc("/healthz", s.healthzHandler)
 	}
 
 	s.server = &http.Server{Addr: addr, Handler: mux, ReadHeaderTimeout: time.Second}
@@ -98,6 +98,18 @@ func TestHealthz(t *testing.T) {
 
 }
 
+func TestHealthz_Replaced(t *testing.T) {
+	s := NewService("", runtime.NewServiceRegistry(), Handler{
+		Path: "/healthz",
+		Handler: func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		},
+	})
+	rr := httptest.NewRecorder()
+	s.server.Handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	assert.Equal(t, http.StatusTeapot, rr.Code)
+}
+
 func TestStatus(t *testing.T) {
 	failError := errors.New("failure")
 	s := &Service{failStatus: failError}
@@ -49,6 +49,34 @@ func WriteSsz(w http.ResponseWriter, respSsz []byte, fileName string) {
 	}
 }
 
+// WriteSszStream writes the response message in ssz format as it is encoded by write, without holding the whole
+// message in memory. When write fails before writing anything, the error is written instead.
+func WriteSszStream(w http.ResponseWriter, fileName string, write func(io.Writer) error) {
+	w.Header().Set("Content-Type", api.OctetStreamMediaType)
+	w.Header().Set("Content-Disposition", "attachment; filename="+fileName)
+	cw := &countingWriter{w: w}
+	if err := write(cw); err != nil {
+		if cw.n == 0 {
+			w.Header().Del("Content-Disposition")
+			HandleError(w, "Could not write response message: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		log.WithError(err).Error("could not write response message")
+	}
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += n
+	return n, err
+}
+
 // WriteError writes the error by manipulating headers and the body of the final response.
 func WriteError(w http.ResponseWriter, errJson HasStatusCode) {
 	j, err := json.Marshal(errJson)
@@ -0,0 +1,47 @@
+package httputil
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+)
+
+func TestWriteSszStream(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		WriteSszStream(w, "state.ssz", func(writer io.Writer) error {
+			_, err := writer.Write([]byte{1, 2, 3})
+			return err
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, api.OctetStreamMediaType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename=state.ssz", w.Header().Get("Content-Disposition"))
+		assert.DeepEqual(t, []byte{1, 2, 3}, w.Body.Bytes())
+	})
+	t.Run("error before writing", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		WriteSszStream(w, "state.ssz", func(io.Writer) error {
+			return errors.New("bad state")
+		})
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Equal(t, api.JsonMediaType, w.Header().Get("Content-Type"))
+		assert.Equal(t, "", w.Header().Get("Content-Disposition"))
+		assert.StringContains(t, "bad state", w.Body.String())
+	})
+	t.Run("error while writing", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		WriteSszStream(w, "state.ssz", func(writer io.Writer) error {
+			if _, err := writer.Write([]byte{1}); err != nil {
+				return err
+			}
+			return errors.New("connection closed")
+		})
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.DeepEqual(t, []byte{1}, w.Body.Bytes())
+	})
+}
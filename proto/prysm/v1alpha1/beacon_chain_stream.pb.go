@@ -0,0 +1,1626 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        v4.25.1
+// source: proto/prysm/v1alpha1/beacon_chain_stream.proto
+
+package eth
+
+import (
+	context "context"
+	reflect "reflect"
+	sync "sync"
+
+	github_com_prysmaticlabs_prysm_v5_consensus_types_primitives "github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	_ "github.com/prysmaticlabs/prysm/v5/proto/eth/ext"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StreamBeaconBlocksRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blinded bool `protobuf:"varint,1,opt,name=blinded,proto3" json:"blinded,omitempty"`
+}
+
+func (x *StreamBeaconBlocksRequest) Reset() {
+	*x = StreamBeaconBlocksRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBeaconBlocksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBeaconBlocksRequest) ProtoMessage() {}
+
+func (x *StreamBeaconBlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBeaconBlocksRequest.ProtoReflect.Descriptor instead.
+func (*StreamBeaconBlocksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamBeaconBlocksRequest) GetBlinded() bool {
+	if x != nil {
+		return x.Blinded
+	}
+	return false
+}
+
+type StreamBeaconBlocksResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockRoot  []byte `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Optimistic bool   `protobuf:"varint,2,opt,name=optimistic,proto3" json:"optimistic,omitempty"`
+	// Types that are assignable to Block:
+	//
+	//	*StreamBeaconBlocksResponse_Phase0Block
+	//	*StreamBeaconBlocksResponse_AltairBlock
+	//	*StreamBeaconBlocksResponse_BellatrixBlock
+	//	*StreamBeaconBlocksResponse_BlindedBellatrixBlock
+	//	*StreamBeaconBlocksResponse_CapellaBlock
+	//	*StreamBeaconBlocksResponse_BlindedCapellaBlock
+	//	*StreamBeaconBlocksResponse_DenebBlock
+	//	*StreamBeaconBlocksResponse_BlindedDenebBlock
+	//	*StreamBeaconBlocksResponse_ElectraBlock
+	//	*StreamBeaconBlocksResponse_BlindedElectraBlock
+	Block isStreamBeaconBlocksResponse_Block `protobuf_oneof:"block"`
+}
+
+func (x *StreamBeaconBlocksResponse) Reset() {
+	*x = StreamBeaconBlocksResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBeaconBlocksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBeaconBlocksResponse) ProtoMessage() {}
+
+func (x *StreamBeaconBlocksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBeaconBlocksResponse.ProtoReflect.Descriptor instead.
+func (*StreamBeaconBlocksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamBeaconBlocksResponse) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetOptimistic() bool {
+	if x != nil {
+		return x.Optimistic
+	}
+	return false
+}
+
+func (m *StreamBeaconBlocksResponse) GetBlock() isStreamBeaconBlocksResponse_Block {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetPhase0Block() *SignedBeaconBlock {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_Phase0Block); ok {
+		return x.Phase0Block
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetAltairBlock() *SignedBeaconBlockAltair {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_AltairBlock); ok {
+		return x.AltairBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetBellatrixBlock() *SignedBeaconBlockBellatrix {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_BellatrixBlock); ok {
+		return x.BellatrixBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetBlindedBellatrixBlock() *SignedBlindedBeaconBlockBellatrix {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_BlindedBellatrixBlock); ok {
+		return x.BlindedBellatrixBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetCapellaBlock() *SignedBeaconBlockCapella {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_CapellaBlock); ok {
+		return x.CapellaBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetBlindedCapellaBlock() *SignedBlindedBeaconBlockCapella {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_BlindedCapellaBlock); ok {
+		return x.BlindedCapellaBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetDenebBlock() *SignedBeaconBlockDeneb {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_DenebBlock); ok {
+		return x.DenebBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetBlindedDenebBlock() *SignedBlindedBeaconBlockDeneb {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_BlindedDenebBlock); ok {
+		return x.BlindedDenebBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetElectraBlock() *SignedBeaconBlockElectra {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_ElectraBlock); ok {
+		return x.ElectraBlock
+	}
+	return nil
+}
+
+func (x *StreamBeaconBlocksResponse) GetBlindedElectraBlock() *SignedBlindedBeaconBlockElectra {
+	if x, ok := x.GetBlock().(*StreamBeaconBlocksResponse_BlindedElectraBlock); ok {
+		return x.BlindedElectraBlock
+	}
+	return nil
+}
+
+type isStreamBeaconBlocksResponse_Block interface {
+	isStreamBeaconBlocksResponse_Block()
+}
+
+type StreamBeaconBlocksResponse_Phase0Block struct {
+	Phase0Block *SignedBeaconBlock `protobuf:"bytes,3,opt,name=phase0_block,json=phase0Block,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_AltairBlock struct {
+	AltairBlock *SignedBeaconBlockAltair `protobuf:"bytes,4,opt,name=altair_block,json=altairBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_BellatrixBlock struct {
+	BellatrixBlock *SignedBeaconBlockBellatrix `protobuf:"bytes,5,opt,name=bellatrix_block,json=bellatrixBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_BlindedBellatrixBlock struct {
+	BlindedBellatrixBlock *SignedBlindedBeaconBlockBellatrix `protobuf:"bytes,6,opt,name=blinded_bellatrix_block,json=blindedBellatrixBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_CapellaBlock struct {
+	CapellaBlock *SignedBeaconBlockCapella `protobuf:"bytes,7,opt,name=capella_block,json=capellaBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_BlindedCapellaBlock struct {
+	BlindedCapellaBlock *SignedBlindedBeaconBlockCapella `protobuf:"bytes,8,opt,name=blinded_capella_block,json=blindedCapellaBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_DenebBlock struct {
+	DenebBlock *SignedBeaconBlockDeneb `protobuf:"bytes,9,opt,name=deneb_block,json=denebBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_BlindedDenebBlock struct {
+	BlindedDenebBlock *SignedBlindedBeaconBlockDeneb `protobuf:"bytes,10,opt,name=blinded_deneb_block,json=blindedDenebBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_ElectraBlock struct {
+	ElectraBlock *SignedBeaconBlockElectra `protobuf:"bytes,11,opt,name=electra_block,json=electraBlock,proto3,oneof"`
+}
+
+type StreamBeaconBlocksResponse_BlindedElectraBlock struct {
+	BlindedElectraBlock *SignedBlindedBeaconBlockElectra `protobuf:"bytes,12,opt,name=blinded_electra_block,json=blindedElectraBlock,proto3,oneof"`
+}
+
+func (*StreamBeaconBlocksResponse_Phase0Block) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_AltairBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_BellatrixBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_BlindedBellatrixBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_CapellaBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_BlindedCapellaBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_DenebBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_BlindedDenebBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_ElectraBlock) isStreamBeaconBlocksResponse_Block() {}
+
+func (*StreamBeaconBlocksResponse_BlindedElectraBlock) isStreamBeaconBlocksResponse_Block() {}
+
+type StreamAttestationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommitteeIndices []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.CommitteeIndex `protobuf:"varint,1,rep,packed,name=committee_indices,json=committeeIndices,proto3" json:"committee_indices,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.CommitteeIndex"`
+	AggregatesOnly   bool                                                                          `protobuf:"varint,2,opt,name=aggregates_only,json=aggregatesOnly,proto3" json:"aggregates_only,omitempty"`
+}
+
+func (x *StreamAttestationsRequest) Reset() {
+	*x = StreamAttestationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAttestationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAttestationsRequest) ProtoMessage() {}
+
+func (x *StreamAttestationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAttestationsRequest.ProtoReflect.Descriptor instead.
+func (*StreamAttestationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamAttestationsRequest) GetCommitteeIndices() []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.CommitteeIndex {
+	if x != nil {
+		return x.CommitteeIndices
+	}
+	return []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.CommitteeIndex(nil)
+}
+
+func (x *StreamAttestationsRequest) GetAggregatesOnly() bool {
+	if x != nil {
+		return x.AggregatesOnly
+	}
+	return false
+}
+
+type StreamAttestationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Aggregate bool `protobuf:"varint,1,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	// Types that are assignable to Attestation:
+	//
+	//	*StreamAttestationsResponse_Phase0Attestation
+	//	*StreamAttestationsResponse_ElectraAttestation
+	Attestation isStreamAttestationsResponse_Attestation `protobuf_oneof:"attestation"`
+}
+
+func (x *StreamAttestationsResponse) Reset() {
+	*x = StreamAttestationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAttestationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAttestationsResponse) ProtoMessage() {}
+
+func (x *StreamAttestationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAttestationsResponse.ProtoReflect.Descriptor instead.
+func (*StreamAttestationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamAttestationsResponse) GetAggregate() bool {
+	if x != nil {
+		return x.Aggregate
+	}
+	return false
+}
+
+func (m *StreamAttestationsResponse) GetAttestation() isStreamAttestationsResponse_Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (x *StreamAttestationsResponse) GetPhase0Attestation() *Attestation {
+	if x, ok := x.GetAttestation().(*StreamAttestationsResponse_Phase0Attestation); ok {
+		return x.Phase0Attestation
+	}
+	return nil
+}
+
+func (x *StreamAttestationsResponse) GetElectraAttestation() *AttestationElectra {
+	if x, ok := x.GetAttestation().(*StreamAttestationsResponse_ElectraAttestation); ok {
+		return x.ElectraAttestation
+	}
+	return nil
+}
+
+type isStreamAttestationsResponse_Attestation interface {
+	isStreamAttestationsResponse_Attestation()
+}
+
+type StreamAttestationsResponse_Phase0Attestation struct {
+	Phase0Attestation *Attestation `protobuf:"bytes,2,opt,name=phase0_attestation,json=phase0Attestation,proto3,oneof"`
+}
+
+type StreamAttestationsResponse_ElectraAttestation struct {
+	ElectraAttestation *AttestationElectra `protobuf:"bytes,3,opt,name=electra_attestation,json=electraAttestation,proto3,oneof"`
+}
+
+func (*StreamAttestationsResponse_Phase0Attestation) isStreamAttestationsResponse_Attestation() {}
+
+func (*StreamAttestationsResponse_ElectraAttestation) isStreamAttestationsResponse_Attestation() {}
+
+type StreamBalanceChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices    []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.ValidatorIndex"`
+	PublicKeys [][]byte                                                                      `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+}
+
+func (x *StreamBalanceChangesRequest) Reset() {
+	*x = StreamBalanceChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBalanceChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBalanceChangesRequest) ProtoMessage() {}
+
+func (x *StreamBalanceChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBalanceChangesRequest.ProtoReflect.Descriptor instead.
+func (*StreamBalanceChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamBalanceChangesRequest) GetIndices() []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex {
+	if x != nil {
+		return x.Indices
+	}
+	return []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(nil)
+}
+
+func (x *StreamBalanceChangesRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type StreamBalanceChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot      github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.Slot"`
+	BlockRoot []byte                                                            `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Changes   []*BalanceChange                                                  `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *StreamBalanceChangesResponse) Reset() {
+	*x = StreamBalanceChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBalanceChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBalanceChangesResponse) ProtoMessage() {}
+
+func (x *StreamBalanceChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBalanceChangesResponse.ProtoReflect.Descriptor instead.
+func (*StreamBalanceChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *StreamBalanceChangesResponse) GetSlot() github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot {
+	if x != nil {
+		return x.Slot
+	}
+	return github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot(0)
+}
+
+func (x *StreamBalanceChangesResponse) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *StreamBalanceChangesResponse) GetChanges() []*BalanceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type BalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index           github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.ValidatorIndex"`
+	PublicKey       []byte                                                                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	PreviousBalance uint64                                                                      `protobuf:"varint,3,opt,name=previous_balance,json=previousBalance,proto3" json:"previous_balance,omitempty"`
+	Balance         uint64                                                                      `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *BalanceChange) GetIndex() github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex {
+	if x != nil {
+		return x.Index
+	}
+	return github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(0)
+}
+
+func (x *BalanceChange) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetPreviousBalance() uint64 {
+	if x != nil {
+		return x.PreviousBalance
+	}
+	return 0
+}
+
+func (x *BalanceChange) GetBalance() uint64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type StreamValidatorRegistryChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indices    []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex `protobuf:"varint,1,rep,packed,name=indices,proto3" json:"indices,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.ValidatorIndex"`
+	PublicKeys [][]byte                                                                      `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+}
+
+func (x *StreamValidatorRegistryChangesRequest) Reset() {
+	*x = StreamValidatorRegistryChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamValidatorRegistryChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamValidatorRegistryChangesRequest) ProtoMessage() {}
+
+func (x *StreamValidatorRegistryChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamValidatorRegistryChangesRequest.ProtoReflect.Descriptor instead.
+func (*StreamValidatorRegistryChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *StreamValidatorRegistryChangesRequest) GetIndices() []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex {
+	if x != nil {
+		return x.Indices
+	}
+	return []github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(nil)
+}
+
+func (x *StreamValidatorRegistryChangesRequest) GetPublicKeys() [][]byte {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+type StreamValidatorRegistryChangesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch     github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.Epoch"`
+	BlockRoot []byte                                                             `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty" ssz-size:"32"`
+	Changes   []*ValidatorRegistryChange                                         `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *StreamValidatorRegistryChangesResponse) Reset() {
+	*x = StreamValidatorRegistryChangesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamValidatorRegistryChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamValidatorRegistryChangesResponse) ProtoMessage() {}
+
+func (x *StreamValidatorRegistryChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamValidatorRegistryChangesResponse.ProtoReflect.Descriptor instead.
+func (*StreamValidatorRegistryChangesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamValidatorRegistryChangesResponse) GetEpoch() github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch {
+	if x != nil {
+		return x.Epoch
+	}
+	return github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(0)
+}
+
+func (x *StreamValidatorRegistryChangesResponse) GetBlockRoot() []byte {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+func (x *StreamValidatorRegistryChangesResponse) GetChanges() []*ValidatorRegistryChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ValidatorRegistryChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index                         github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty" cast-type:"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives.ValidatorIndex"`
+	PublicKey                     []byte                                                                      `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	PreviousStatus                string                                                                      `protobuf:"bytes,3,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	Status                        string                                                                      `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	PreviousEffectiveBalance      uint64                                                                      `protobuf:"varint,5,opt,name=previous_effective_balance,json=previousEffectiveBalance,proto3" json:"previous_effective_balance,omitempty"`
+	EffectiveBalance              uint64                                                                      `protobuf:"varint,6,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	PreviousWithdrawalCredentials []byte                                                                      `protobuf:"bytes,7,opt,name=previous_withdrawal_credentials,json=previousWithdrawalCredentials,proto3" json:"previous_withdrawal_credentials,omitempty" ssz-size:"32"`
+	WithdrawalCredentials         []byte                                                                      `protobuf:"bytes,8,opt,name=withdrawal_credentials,json=withdrawalCredentials,proto3" json:"withdrawal_credentials,omitempty" ssz-size:"32"`
+}
+
+func (x *ValidatorRegistryChange) Reset() {
+	*x = ValidatorRegistryChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidatorRegistryChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatorRegistryChange) ProtoMessage() {}
+
+func (x *ValidatorRegistryChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatorRegistryChange.ProtoReflect.Descriptor instead.
+func (*ValidatorRegistryChange) Descriptor() ([]byte, []int) {
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ValidatorRegistryChange) GetIndex() github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex {
+	if x != nil {
+		return x.Index
+	}
+	return github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.ValidatorIndex(0)
+}
+
+func (x *ValidatorRegistryChange) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ValidatorRegistryChange) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
+}
+
+func (x *ValidatorRegistryChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ValidatorRegistryChange) GetPreviousEffectiveBalance() uint64 {
+	if x != nil {
+		return x.PreviousEffectiveBalance
+	}
+	return 0
+}
+
+func (x *ValidatorRegistryChange) GetEffectiveBalance() uint64 {
+	if x != nil {
+		return x.EffectiveBalance
+	}
+	return 0
+}
+
+func (x *ValidatorRegistryChange) GetPreviousWithdrawalCredentials() []byte {
+	if x != nil {
+		return x.PreviousWithdrawalCredentials
+	}
+	return nil
+}
+
+func (x *ValidatorRegistryChange) GetWithdrawalCredentials() []byte {
+	if x != nil {
+		return x.WithdrawalCredentials
+	}
+	return nil
+}
+
+var File_proto_prysm_v1alpha1_beacon_chain_stream_proto protoreflect.FileDescriptor
+
+var file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x15, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x1a, 0x1b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
+	0x74, 0x68, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x26, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73,
+	0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x61, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x27, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2f, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x35, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x22, 0xa8, 0x08, 0x0a,
+	0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x12, 0x4d, 0x0a, 0x0c, 0x70, 0x68, 0x61, 0x73, 0x65, 0x30, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72,
+	0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x68, 0x61, 0x73, 0x65, 0x30, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x53, 0x0a, 0x0c, 0x61, 0x6c, 0x74, 0x61, 0x69, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65,
+	0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x41, 0x6c, 0x74, 0x61, 0x69, 0x72, 0x48, 0x00, 0x52, 0x0b, 0x61, 0x6c, 0x74, 0x61, 0x69,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x5c, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x72,
+	0x69, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x62, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x72, 0x69, 0x78, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x72, 0x0a, 0x17, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f,
+	0x62, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x72, 0x69, 0x78, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x65, 0x6c, 0x6c, 0x61, 0x74, 0x72, 0x69, 0x78, 0x48,
+	0x00, 0x52, 0x15, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x65, 0x6c, 0x6c, 0x61, 0x74,
+	0x72, 0x69, 0x78, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x56, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x65,
+	0x6c, 0x6c, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x61, 0x70, 0x65, 0x6c, 0x6c, 0x61,
+	0x48, 0x00, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x65, 0x6c, 0x6c, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x6c, 0x0a, 0x15, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x63, 0x61, 0x70, 0x65,
+	0x6c, 0x6c, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x61, 0x70, 0x65, 0x6c, 0x6c, 0x61, 0x48, 0x00, 0x52, 0x13, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x64, 0x43, 0x61, 0x70, 0x65, 0x6c, 0x6c, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x50,
+	0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x65, 0x62, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6e,
+	0x65, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x64, 0x65, 0x6e, 0x65, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x66, 0x0a, 0x13, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x65,
+	0x62, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x69, 0x6e,
+	0x64, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65,
+	0x6e, 0x65, 0x62, 0x48, 0x00, 0x52, 0x11, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x44, 0x65,
+	0x6e, 0x65, 0x62, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x56, 0x0a, 0x0d, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x61,
+	0x48, 0x00, 0x52, 0x0c, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x6c, 0x0a, 0x15, 0x62, 0x6c, 0x69, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x72, 0x61, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76,
+	0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c,
+	0x69, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x61, 0x48, 0x00, 0x52, 0x13, 0x62, 0x6c, 0x69, 0x6e, 0x64,
+	0x65, 0x64, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x61, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x07,
+	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xc2, 0x01, 0x0a, 0x19, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x7c, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04,
+	0x42, 0x4f, 0x82, 0xb5, 0x18, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x65, 0x49, 0x6e, 0x64, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0xfc, 0x01, 0x0a,
+	0x1a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x12, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x30, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x30, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x5c,
+	0x0a, 0x13, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72, 0x61, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x74,
+	0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70,
+	0x68, 0x61, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x72, 0x61, 0x48, 0x00, 0x52, 0x12, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x72,
+	0x61, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0d, 0x0a, 0x0b,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb3, 0x01, 0x0a, 0x1b,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x69, 0x0a, 0x07, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x4f, 0x82, 0xb5,
+	0x18, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x08, 0x8a, 0xb5, 0x18,
+	0x04, 0x3f, 0x2c, 0x34, 0x38, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x73, 0x22, 0xe0, 0x01, 0x0a, 0x1c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x59, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x45, 0x82, 0xb5, 0x18, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x73, 0x2e, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a,
+	0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x6f, 0x6f, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x65, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4f, 0x82, 0xb5, 0x18, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69,
+	0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a,
+	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x34, 0x38, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xbd, 0x01, 0x0a, 0x25, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x69, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x04, 0x42, 0x4f, 0x82, 0xb5, 0x18, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61,
+	0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x07, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x29,
+	0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x42, 0x08, 0x8a, 0xb5, 0x18, 0x04, 0x3f, 0x2c, 0x34, 0x38, 0x52, 0x0a, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x22, 0xf7, 0x01, 0x0a, 0x26, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x46, 0x82, 0xb5, 0x18, 0x42, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x2d, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x73, 0x2e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x48, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x22, 0xe2, 0x03, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x65, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x4f,
+	0x82, 0xb5, 0x18, 0x4b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x72, 0x79, 0x73, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79,
+	0x73, 0x6d, 0x2f, 0x76, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2d,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2f, 0x70, 0x72, 0x69, 0x6d, 0x69, 0x74, 0x69, 0x76, 0x65, 0x73,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02,
+	0x34, 0x38, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c,
+	0x0a, 0x1a, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x66, 0x66, 0x65, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x18, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x1f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
+	0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33, 0x32, 0x52, 0x1d, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x16, 0x77, 0x69, 0x74,
+	0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x61, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0x8a, 0xb5, 0x18, 0x02, 0x33,
+	0x32, 0x52, 0x15, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x32, 0xbb, 0x04, 0x0a, 0x11, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x7d,
+	0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e,
+	0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75,
+	0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x7d, 0x0a,
+	0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x83, 0x01, 0x0a,
+	0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x32, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x65, 0x74, 0x68, 0x65,
+	0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0xa1, 0x01, 0x0a, 0x1e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3c, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d,
+	0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x65, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65,
+	0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0xa1, 0x01, 0x0a, 0x19, 0x6f, 0x72, 0x67, 0x2e, 0x65,
+	0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x65, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x31, 0x42, 0x16, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x3a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76,
+	0x35, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x72, 0x79, 0x73, 0x6d, 0x2f, 0x76, 0x31,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x3b, 0x65, 0x74, 0x68, 0xaa, 0x02, 0x15, 0x45, 0x74, 0x68,
+	0x65, 0x72, 0x65, 0x75, 0x6d, 0x2e, 0x45, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68,
+	0x61, 0x31, 0xca, 0x02, 0x15, 0x45, 0x74, 0x68, 0x65, 0x72, 0x65, 0x75, 0x6d, 0x5c, 0x45, 0x74,
+	0x68, 0x5c, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescOnce sync.Once
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescData = file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDesc
+)
+
+func file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescGZIP() []byte {
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescOnce.Do(func() {
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescData)
+	})
+	return file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDescData
+}
+
+var file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_proto_prysm_v1alpha1_beacon_chain_stream_proto_goTypes = []interface{}{
+	(*StreamBeaconBlocksRequest)(nil),              // 0: ethereum.eth.v1alpha1.StreamBeaconBlocksRequest
+	(*StreamBeaconBlocksResponse)(nil),             // 1: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse
+	(*StreamAttestationsRequest)(nil),              // 2: ethereum.eth.v1alpha1.StreamAttestationsRequest
+	(*StreamAttestationsResponse)(nil),             // 3: ethereum.eth.v1alpha1.StreamAttestationsResponse
+	(*StreamBalanceChangesRequest)(nil),            // 4: ethereum.eth.v1alpha1.StreamBalanceChangesRequest
+	(*StreamBalanceChangesResponse)(nil),           // 5: ethereum.eth.v1alpha1.StreamBalanceChangesResponse
+	(*BalanceChange)(nil),                          // 6: ethereum.eth.v1alpha1.BalanceChange
+	(*StreamValidatorRegistryChangesRequest)(nil),  // 7: ethereum.eth.v1alpha1.StreamValidatorRegistryChangesRequest
+	(*StreamValidatorRegistryChangesResponse)(nil), // 8: ethereum.eth.v1alpha1.StreamValidatorRegistryChangesResponse
+	(*ValidatorRegistryChange)(nil),                // 9: ethereum.eth.v1alpha1.ValidatorRegistryChange
+	(*SignedBeaconBlock)(nil),                      // 10: ethereum.eth.v1alpha1.SignedBeaconBlock
+	(*SignedBeaconBlockAltair)(nil),                // 11: ethereum.eth.v1alpha1.SignedBeaconBlockAltair
+	(*SignedBeaconBlockBellatrix)(nil),             // 12: ethereum.eth.v1alpha1.SignedBeaconBlockBellatrix
+	(*SignedBlindedBeaconBlockBellatrix)(nil),      // 13: ethereum.eth.v1alpha1.SignedBlindedBeaconBlockBellatrix
+	(*SignedBeaconBlockCapella)(nil),               // 14: ethereum.eth.v1alpha1.SignedBeaconBlockCapella
+	(*SignedBlindedBeaconBlockCapella)(nil),        // 15: ethereum.eth.v1alpha1.SignedBlindedBeaconBlockCapella
+	(*SignedBeaconBlockDeneb)(nil),                 // 16: ethereum.eth.v1alpha1.SignedBeaconBlockDeneb
+	(*SignedBlindedBeaconBlockDeneb)(nil),          // 17: ethereum.eth.v1alpha1.SignedBlindedBeaconBlockDeneb
+	(*SignedBeaconBlockElectra)(nil),               // 18: ethereum.eth.v1alpha1.SignedBeaconBlockElectra
+	(*SignedBlindedBeaconBlockElectra)(nil),        // 19: ethereum.eth.v1alpha1.SignedBlindedBeaconBlockElectra
+	(*Attestation)(nil),                            // 20: ethereum.eth.v1alpha1.Attestation
+	(*AttestationElectra)(nil),                     // 21: ethereum.eth.v1alpha1.AttestationElectra
+}
+var file_proto_prysm_v1alpha1_beacon_chain_stream_proto_depIdxs = []int32{
+	10, // 0: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.phase0_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlock
+	11, // 1: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.altair_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlockAltair
+	12, // 2: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.bellatrix_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlockBellatrix
+	13, // 3: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.blinded_bellatrix_block:type_name -> ethereum.eth.v1alpha1.SignedBlindedBeaconBlockBellatrix
+	14, // 4: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.capella_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlockCapella
+	15, // 5: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.blinded_capella_block:type_name -> ethereum.eth.v1alpha1.SignedBlindedBeaconBlockCapella
+	16, // 6: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.deneb_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlockDeneb
+	17, // 7: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.blinded_deneb_block:type_name -> ethereum.eth.v1alpha1.SignedBlindedBeaconBlockDeneb
+	18, // 8: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.electra_block:type_name -> ethereum.eth.v1alpha1.SignedBeaconBlockElectra
+	19, // 9: ethereum.eth.v1alpha1.StreamBeaconBlocksResponse.blinded_electra_block:type_name -> ethereum.eth.v1alpha1.SignedBlindedBeaconBlockElectra
+	20, // 10: ethereum.eth.v1alpha1.StreamAttestationsResponse.phase0_attestation:type_name -> ethereum.eth.v1alpha1.Attestation
+	21, // 11: ethereum.eth.v1alpha1.StreamAttestationsResponse.electra_attestation:type_name -> ethereum.eth.v1alpha1.AttestationElectra
+	6,  // 12: ethereum.eth.v1alpha1.StreamBalanceChangesResponse.changes:type_name -> ethereum.eth.v1alpha1.BalanceChange
+	9,  // 13: ethereum.eth.v1alpha1.StreamValidatorRegistryChangesResponse.changes:type_name -> ethereum.eth.v1alpha1.ValidatorRegistryChange
+	0,  // 14: ethereum.eth.v1alpha1.BeaconChainStream.StreamBeaconBlocks:input_type -> ethereum.eth.v1alpha1.StreamBeaconBlocksRequest
+	2,  // 15: ethereum.eth.v1alpha1.BeaconChainStream.StreamAttestations:input_type -> ethereum.eth.v1alpha1.StreamAttestationsRequest
+	4,  // 16: ethereum.eth.v1alpha1.BeaconChainStream.StreamBalanceChanges:input_type -> ethereum.eth.v1alpha1.StreamBalanceChangesRequest
+	7,  // 17: ethereum.eth.v1alpha1.BeaconChainStream.StreamValidatorRegistryChanges:input_type -> ethereum.eth.v1alpha1.StreamValidatorRegistryChangesRequest
+	1,  // 18: ethereum.eth.v1alpha1.BeaconChainStream.StreamBeaconBlocks:output_type -> ethereum.eth.v1alpha1.StreamBeaconBlocksResponse
+	3,  // 19: ethereum.eth.v1alpha1.BeaconChainStream.StreamAttestations:output_type -> ethereum.eth.v1alpha1.StreamAttestationsResponse
+	5,  // 20: ethereum.eth.v1alpha1.BeaconChainStream.StreamBalanceChanges:output_type -> ethereum.eth.v1alpha1.StreamBalanceChangesResponse
+	8,  // 21: ethereum.eth.v1alpha1.BeaconChainStream.StreamValidatorRegistryChanges:output_type -> ethereum.eth.v1alpha1.StreamValidatorRegistryChangesResponse
+	18, // [18:22] is the sub-list for method output_type
+	14, // [14:18] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_proto_prysm_v1alpha1_beacon_chain_stream_proto_init() }
+func file_proto_prysm_v1alpha1_beacon_chain_stream_proto_init() {
+	if File_proto_prysm_v1alpha1_beacon_chain_stream_proto != nil {
+		return
+	}
+	file_proto_prysm_v1alpha1_attestation_proto_init()
+	file_proto_prysm_v1alpha1_beacon_block_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBeaconBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBeaconBlocksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAttestationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAttestationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBalanceChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBalanceChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamValidatorRegistryChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamValidatorRegistryChangesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidatorRegistryChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*StreamBeaconBlocksResponse_Phase0Block)(nil),
+		(*StreamBeaconBlocksResponse_AltairBlock)(nil),
+		(*StreamBeaconBlocksResponse_BellatrixBlock)(nil),
+		(*StreamBeaconBlocksResponse_BlindedBellatrixBlock)(nil),
+		(*StreamBeaconBlocksResponse_CapellaBlock)(nil),
+		(*StreamBeaconBlocksResponse_BlindedCapellaBlock)(nil),
+		(*StreamBeaconBlocksResponse_DenebBlock)(nil),
+		(*StreamBeaconBlocksResponse_BlindedDenebBlock)(nil),
+		(*StreamBeaconBlocksResponse_ElectraBlock)(nil),
+		(*StreamBeaconBlocksResponse_BlindedElectraBlock)(nil),
+	}
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*StreamAttestationsResponse_Phase0Attestation)(nil),
+		(*StreamAttestationsResponse_ElectraAttestation)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_prysm_v1alpha1_beacon_chain_stream_proto_goTypes,
+		DependencyIndexes: file_proto_prysm_v1alpha1_beacon_chain_stream_proto_depIdxs,
+		MessageInfos:      file_proto_prysm_v1alpha1_beacon_chain_stream_proto_msgTypes,
+	}.Build()
+	File_proto_prysm_v1alpha1_beacon_chain_stream_proto = out.File
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_rawDesc = nil
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_goTypes = nil
+	file_proto_prysm_v1alpha1_beacon_chain_stream_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BeaconChainStreamClient is the client API for BeaconChainStream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainStreamClient interface {
+	StreamBeaconBlocks(ctx context.Context, in *StreamBeaconBlocksRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamBeaconBlocksClient, error)
+	StreamAttestations(ctx context.Context, in *StreamAttestationsRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamAttestationsClient, error)
+	StreamBalanceChanges(ctx context.Context, in *StreamBalanceChangesRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamBalanceChangesClient, error)
+	StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamValidatorRegistryChangesClient, error)
+}
+
+type beaconChainStreamClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBeaconChainStreamClient(cc grpc.ClientConnInterface) BeaconChainStreamClient {
+	return &beaconChainStreamClient{cc}
+}
+
+func (c *beaconChainStreamClient) StreamBeaconBlocks(ctx context.Context, in *StreamBeaconBlocksRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamBeaconBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainStream_serviceDesc.Streams[0], "/ethereum.eth.v1alpha1.BeaconChainStream/StreamBeaconBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamStreamBeaconBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainStream_StreamBeaconBlocksClient interface {
+	Recv() (*StreamBeaconBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamStreamBeaconBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamStreamBeaconBlocksClient) Recv() (*StreamBeaconBlocksResponse, error) {
+	m := new(StreamBeaconBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainStreamClient) StreamAttestations(ctx context.Context, in *StreamAttestationsRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamAttestationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainStream_serviceDesc.Streams[1], "/ethereum.eth.v1alpha1.BeaconChainStream/StreamAttestations", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamStreamAttestationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainStream_StreamAttestationsClient interface {
+	Recv() (*StreamAttestationsResponse, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamStreamAttestationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamStreamAttestationsClient) Recv() (*StreamAttestationsResponse, error) {
+	m := new(StreamAttestationsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainStreamClient) StreamBalanceChanges(ctx context.Context, in *StreamBalanceChangesRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamBalanceChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainStream_serviceDesc.Streams[2], "/ethereum.eth.v1alpha1.BeaconChainStream/StreamBalanceChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamStreamBalanceChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainStream_StreamBalanceChangesClient interface {
+	Recv() (*StreamBalanceChangesResponse, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamStreamBalanceChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamStreamBalanceChangesClient) Recv() (*StreamBalanceChangesResponse, error) {
+	m := new(StreamBalanceChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconChainStreamClient) StreamValidatorRegistryChanges(ctx context.Context, in *StreamValidatorRegistryChangesRequest, opts ...grpc.CallOption) (BeaconChainStream_StreamValidatorRegistryChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconChainStream_serviceDesc.Streams[3], "/ethereum.eth.v1alpha1.BeaconChainStream/StreamValidatorRegistryChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconChainStreamStreamValidatorRegistryChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconChainStream_StreamValidatorRegistryChangesClient interface {
+	Recv() (*StreamValidatorRegistryChangesResponse, error)
+	grpc.ClientStream
+}
+
+type beaconChainStreamStreamValidatorRegistryChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconChainStreamStreamValidatorRegistryChangesClient) Recv() (*StreamValidatorRegistryChangesResponse, error) {
+	m := new(StreamValidatorRegistryChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BeaconChainStreamServer is the server API for BeaconChainStream service.
+type BeaconChainStreamServer interface {
+	StreamBeaconBlocks(*StreamBeaconBlocksRequest, BeaconChainStream_StreamBeaconBlocksServer) error
+	StreamAttestations(*StreamAttestationsRequest, BeaconChainStream_StreamAttestationsServer) error
+	StreamBalanceChanges(*StreamBalanceChangesRequest, BeaconChainStream_StreamBalanceChangesServer) error
+	StreamValidatorRegistryChanges(*StreamValidatorRegistryChangesRequest, BeaconChainStream_StreamValidatorRegistryChangesServer) error
+}
+
+// UnimplementedBeaconChainStreamServer can be embedded to have forward compatible implementations.
+type UnimplementedBeaconChainStreamServer struct {
+}
+
+func (*UnimplementedBeaconChainStreamServer) StreamBeaconBlocks(*StreamBeaconBlocksRequest, BeaconChainStream_StreamBeaconBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBeaconBlocks not implemented")
+}
+func (*UnimplementedBeaconChainStreamServer) StreamAttestations(*StreamAttestationsRequest, BeaconChainStream_StreamAttestationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAttestations not implemented")
+}
+func (*UnimplementedBeaconChainStreamServer) StreamBalanceChanges(*StreamBalanceChangesRequest, BeaconChainStream_StreamBalanceChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBalanceChanges not implemented")
+}
+func (*UnimplementedBeaconChainStreamServer) StreamValidatorRegistryChanges(*StreamValidatorRegistryChangesRequest, BeaconChainStream_StreamValidatorRegistryChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorRegistryChanges not implemented")
+}
+
+func RegisterBeaconChainStreamServer(s *grpc.Server, srv BeaconChainStreamServer) {
+	s.RegisterService(&_BeaconChainStream_serviceDesc, srv)
+}
+
+func _BeaconChainStream_StreamBeaconBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBeaconBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainStreamServer).StreamBeaconBlocks(m, &beaconChainStreamStreamBeaconBlocksServer{stream})
+}
+
+type BeaconChainStream_StreamBeaconBlocksServer interface {
+	Send(*StreamBeaconBlocksResponse) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamStreamBeaconBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamStreamBeaconBlocksServer) Send(m *StreamBeaconBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChainStream_StreamAttestations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAttestationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainStreamServer).StreamAttestations(m, &beaconChainStreamStreamAttestationsServer{stream})
+}
+
+type BeaconChainStream_StreamAttestationsServer interface {
+	Send(*StreamAttestationsResponse) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamStreamAttestationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamStreamAttestationsServer) Send(m *StreamAttestationsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChainStream_StreamBalanceChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBalanceChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainStreamServer).StreamBalanceChanges(m, &beaconChainStreamStreamBalanceChangesServer{stream})
+}
+
+type BeaconChainStream_StreamBalanceChangesServer interface {
+	Send(*StreamBalanceChangesResponse) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamStreamBalanceChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamStreamBalanceChangesServer) Send(m *StreamBalanceChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconChainStream_StreamValidatorRegistryChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamValidatorRegistryChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconChainStreamServer).StreamValidatorRegistryChanges(m, &beaconChainStreamStreamValidatorRegistryChangesServer{stream})
+}
+
+type BeaconChainStream_StreamValidatorRegistryChangesServer interface {
+	Send(*StreamValidatorRegistryChangesResponse) error
+	grpc.ServerStream
+}
+
+type beaconChainStreamStreamValidatorRegistryChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconChainStreamStreamValidatorRegistryChangesServer) Send(m *StreamValidatorRegistryChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BeaconChainStream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.BeaconChainStream",
+	HandlerType: (*BeaconChainStreamServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBeaconBlocks",
+			Handler:       _BeaconChainStream_StreamBeaconBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAttestations",
+			Handler:       _BeaconChainStream_StreamAttestations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamBalanceChanges",
+			Handler:       _BeaconChainStream_StreamBalanceChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamValidatorRegistryChanges",
+			Handler:       _BeaconChainStream_StreamValidatorRegistryChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/prysm/v1alpha1/beacon_chain_stream.proto",
+}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: bfd7d6b556134c3bd236b880245717aa01ae79573b33f2746a08c165ba5dcedb
+// Hash: 9f7e5aa89b568b93610ef13e6e4960c695bedefa8dba67749aa3d787f3ee9a37
 package eth
 
 import (
@@ -320,6 +320,394 @@ func (b *BuilderBid) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	return
 }
 
+// MarshalSSZ ssz marshals the CommitteeAssignmentsLookahead object
+func (c *CommitteeAssignmentsLookahead) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CommitteeAssignmentsLookahead object to a target array
+func (c *CommitteeAssignmentsLookahead) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(8)
+
+	// Offset (0) 'CurrentEpoch'
+	dst = ssz.WriteOffset(dst, offset)
+	if c.CurrentEpoch == nil {
+		c.CurrentEpoch = new(CommitteeAssignments)
+	}
+	offset += c.CurrentEpoch.SizeSSZ()
+
+	// Offset (1) 'NextEpoch'
+	dst = ssz.WriteOffset(dst, offset)
+	if c.NextEpoch == nil {
+		c.NextEpoch = new(CommitteeAssignments)
+	}
+	offset += c.NextEpoch.SizeSSZ()
+
+	// Field (0) 'CurrentEpoch'
+	if dst, err = c.CurrentEpoch.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (1) 'NextEpoch'
+	if dst, err = c.NextEpoch.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CommitteeAssignmentsLookahead object
+func (c *CommitteeAssignmentsLookahead) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 8 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1 uint64
+
+	// Offset (0) 'CurrentEpoch'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 != 8 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'NextEpoch'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'CurrentEpoch'
+	{
+		buf = tail[o0:o1]
+		if c.CurrentEpoch == nil {
+			c.CurrentEpoch = new(CommitteeAssignments)
+		}
+		if err = c.CurrentEpoch.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (1) 'NextEpoch'
+	{
+		buf = tail[o1:]
+		if c.NextEpoch == nil {
+			c.NextEpoch = new(CommitteeAssignments)
+		}
+		if err = c.NextEpoch.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CommitteeAssignmentsLookahead object
+func (c *CommitteeAssignmentsLookahead) SizeSSZ() (size int) {
+	size = 8
+
+	// Field (0) 'CurrentEpoch'
+	if c.CurrentEpoch == nil {
+		c.CurrentEpoch = new(CommitteeAssignments)
+	}
+	size += c.CurrentEpoch.SizeSSZ()
+
+	// Field (1) 'NextEpoch'
+	if c.NextEpoch == nil {
+		c.NextEpoch = new(CommitteeAssignments)
+	}
+	size += c.NextEpoch.SizeSSZ()
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CommitteeAssignmentsLookahead object
+func (c *CommitteeAssignmentsLookahead) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CommitteeAssignmentsLookahead object with a hasher
+func (c *CommitteeAssignmentsLookahead) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'CurrentEpoch'
+	if err = c.CurrentEpoch.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (1) 'NextEpoch'
+	if err = c.NextEpoch.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the CommitteeAssignments object
+func (c *CommitteeAssignments) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CommitteeAssignments object to a target array
+func (c *CommitteeAssignments) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(12)
+
+	// Field (0) 'Epoch'
+	dst = ssz.MarshalUint64(dst, uint64(c.Epoch))
+
+	// Offset (1) 'Committees'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(c.Committees); ii++ {
+		offset += 4
+		offset += c.Committees[ii].SizeSSZ()
+	}
+
+	// Field (1) 'Committees'
+	if size := len(c.Committees); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Committees", size, 2048)
+		return
+	}
+	{
+		offset = 4 * len(c.Committees)
+		for ii := 0; ii < len(c.Committees); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += c.Committees[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(c.Committees); ii++ {
+		if dst, err = c.Committees[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CommitteeAssignments object
+func (c *CommitteeAssignments) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 12 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o1 uint64
+
+	// Field (0) 'Epoch'
+	c.Epoch = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Epoch(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Offset (1) 'Committees'
+	if o1 = ssz.ReadOffset(buf[8:12]); o1 > size {
+		return ssz.ErrOffset
+	}
+
+	if o1 != 12 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (1) 'Committees'
+	{
+		buf = tail[o1:]
+		num, err := ssz.DecodeDynamicLength(buf, 2048)
+		if err != nil {
+			return err
+		}
+		c.Committees = make([]*CommitteeAssignment, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if c.Committees[indx] == nil {
+				c.Committees[indx] = new(CommitteeAssignment)
+			}
+			if err = c.Committees[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CommitteeAssignments object
+func (c *CommitteeAssignments) SizeSSZ() (size int) {
+	size = 12
+
+	// Field (1) 'Committees'
+	for ii := 0; ii < len(c.Committees); ii++ {
+		size += 4
+		size += c.Committees[ii].SizeSSZ()
+	}
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CommitteeAssignments object
+func (c *CommitteeAssignments) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CommitteeAssignments object with a hasher
+func (c *CommitteeAssignments) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Epoch'
+	hh.PutUint64(uint64(c.Epoch))
+
+	// Field (1) 'Committees'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(c.Committees))
+		if num > 2048 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range c.Committees {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 2048)
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the CommitteeAssignment object
+func (c *CommitteeAssignment) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(c)
+}
+
+// MarshalSSZTo ssz marshals the CommitteeAssignment object to a target array
+func (c *CommitteeAssignment) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(28)
+
+	// Field (0) 'Slot'
+	dst = ssz.MarshalUint64(dst, uint64(c.Slot))
+
+	// Field (1) 'CommitteeIndex'
+	dst = ssz.MarshalUint64(dst, uint64(c.CommitteeIndex))
+
+	// Field (2) 'Subnet'
+	dst = ssz.MarshalUint64(dst, c.Subnet)
+
+	// Offset (3) 'Validators'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(c.Validators) * 8
+
+	// Field (3) 'Validators'
+	if size := len(c.Validators); size > 2048 {
+		err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+		return
+	}
+	for ii := 0; ii < len(c.Validators); ii++ {
+		dst = ssz.MarshalUint64(dst, c.Validators[ii])
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the CommitteeAssignment object
+func (c *CommitteeAssignment) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 28 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o3 uint64
+
+	// Field (0) 'Slot'
+	c.Slot = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.Slot(ssz.UnmarshallUint64(buf[0:8]))
+
+	// Field (1) 'CommitteeIndex'
+	c.CommitteeIndex = github_com_prysmaticlabs_prysm_v5_consensus_types_primitives.CommitteeIndex(ssz.UnmarshallUint64(buf[8:16]))
+
+	// Field (2) 'Subnet'
+	c.Subnet = ssz.UnmarshallUint64(buf[16:24])
+
+	// Offset (3) 'Validators'
+	if o3 = ssz.ReadOffset(buf[24:28]); o3 > size {
+		return ssz.ErrOffset
+	}
+
+	if o3 != 28 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Field (3) 'Validators'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 8, 2048)
+		if err != nil {
+			return err
+		}
+		c.Validators = ssz.ExtendUint64(c.Validators, num)
+		for ii := 0; ii < num; ii++ {
+			c.Validators[ii] = ssz.UnmarshallUint64(buf[ii*8 : (ii+1)*8])
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the CommitteeAssignment object
+func (c *CommitteeAssignment) SizeSSZ() (size int) {
+	size = 28
+
+	// Field (3) 'Validators'
+	size += len(c.Validators) * 8
+
+	return
+}
+
+// HashTreeRoot ssz hashes the CommitteeAssignment object
+func (c *CommitteeAssignment) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(c)
+}
+
+// HashTreeRootWith ssz hashes the CommitteeAssignment object with a hasher
+func (c *CommitteeAssignment) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Slot'
+	hh.PutUint64(uint64(c.Slot))
+
+	// Field (1) 'CommitteeIndex'
+	hh.PutUint64(uint64(c.CommitteeIndex))
+
+	// Field (2) 'Subnet'
+	hh.PutUint64(c.Subnet)
+
+	// Field (3) 'Validators'
+	{
+		if size := len(c.Validators); size > 2048 {
+			err = ssz.ErrListTooBigFn("--.Validators", size, 2048)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range c.Validators {
+			hh.AppendUint64(i)
+		}
+		hh.FillUpTo32()
+
+		numItems := uint64(len(c.Validators))
+		hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(2048, numItems, 8))
+	}
+
+	hh.Merkleize(indx)
+	return
+}
+
 // MarshalSSZ ssz marshals the BeaconBlocksByRangeRequest object
 func (b *BeaconBlocksByRangeRequest) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(b)
@@ -0,0 +1,234 @@
+// Package diagnostics generates diagnostics bundles, single archives gathering the goroutine dump, a heap profile,
+// the recent logs and the state reported by the registered sources, such as the forkchoice store or the peers of the
+// node. A bundle is written on request of the operator, and is meant to be attached to bug reports. Crashes are
+// reported by the goroutine dump the Go runtime prints on fatal errors instead, as a panic cannot be recovered from
+// outside of the goroutine which panicked.
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+)
+
+// sourceTimeout bounds the time spent by a source, so that a stuck component cannot prevent writing the bundle.
+const sourceTimeout = 10 * time.Second
+
+var (
+	// ErrNoDirectory is returned when writing a bundle before its directory is set.
+	ErrNoDirectory = errors.New("diagnostics directory is not set")
+	// ErrBundleInProgress is returned when writing a bundle while another one is being written.
+	ErrBundleInProgress = errors.New("a diagnostics bundle is already being written")
+)
+
+// Source writes a file of the diagnostics bundle.
+type Source func(ctx context.Context, w io.Writer) error
+
+type namedSource struct {
+	name   string
+	source Source
+}
+
+// Bundler writes diagnostics bundles to a directory.
+type Bundler struct {
+	lock    sync.Mutex
+	writing sync.Mutex
+	dir     string
+	sources []namedSource
+	logs    *LogBuffer
+}
+
+// NewBundler returns a bundler writing the recent logs recorded in the log buffer, which may be nil.
+func NewBundler(logs *LogBuffer) *Bundler {
+	return &Bundler{logs: logs}
+}
+
+// SetDir sets the directory of the bundles.
+func (b *Bundler) SetDir(dir string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.dir = dir
+}
+
+// AddSource registers a source written to the file of the given name in every bundle.
+func (b *Bundler) AddSource(name string, source Source) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.sources = append(b.sources, namedSource{name: name, source: source})
+}
+
+// WriteBundle writes a diagnostics bundle explaining its reason, and returns its path. The failures of the sources
+// are reported in the errors.txt file of the bundle rather than failing it. Only one bundle is written at a time.
+func (b *Bundler) WriteBundle(ctx context.Context, reason string) (string, error) {
+	if !b.writing.TryLock() {
+		return "", ErrBundleInProgress
+	}
+	defer b.writing.Unlock()
+	b.lock.Lock()
+	dir := b.dir
+	sources := append([]namedSource{}, b.sources...)
+	b.lock.Unlock()
+	if dir == "" {
+		return "", ErrNoDirectory
+	}
+	if err := file.MkdirAll(dir); err != nil {
+		return "", errors.Wrap(err, "could not create diagnostics directory")
+	}
+
+	now := prysmTime.Now().UTC()
+	path := filepath.Join(dir, fmt.Sprintf("diagnostics-%s.tar.gz", now.Format("20060102-150405.000")))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions) // #nosec G304
+	if err != nil {
+		return "", errors.Wrap(err, "could not create diagnostics bundle")
+	}
+	if err := b.writeArchive(ctx, f, now, reason, sources); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return "", errors.Wrap(err, "could not write diagnostics bundle")
+	}
+	if err := f.Close(); err != nil {
+		return "", errors.Wrap(err, "could not close diagnostics bundle")
+	}
+	return path, nil
+}
+
+func (b *Bundler) writeArchive(ctx context.Context, w io.Writer, now time.Time, reason string, sources []namedSource) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	a := &archive{tw: tw, modTime: now}
+
+	a.add("reason.txt", func(_ context.Context, w io.Writer) error {
+		_, err := fmt.Fprintf(w, "reason: %s\ntime: %s\nversion: %s\n", reason, now.Format(time.RFC3339Nano), version.Version())
+		return err
+	})
+	a.add("goroutines.txt", func(_ context.Context, w io.Writer) error {
+		return pprof.Lookup("goroutine").WriteTo(w, 2)
+	})
+	a.add("heap.pprof", func(_ context.Context, w io.Writer) error {
+		return pprof.Lookup("heap").WriteTo(w, 0)
+	})
+	if b.logs != nil {
+		a.add("logs.txt", func(_ context.Context, w io.Writer) error {
+			_, err := b.logs.WriteTo(w)
+			return err
+		})
+	}
+	for _, s := range sources {
+		sourceCtx, cancel := context.WithTimeout(ctx, sourceTimeout)
+		a.addContext(sourceCtx, s.name, s.source)
+		cancel()
+	}
+	if len(a.errs) > 0 {
+		var errs bytes.Buffer
+		for _, err := range a.errs {
+			errs.WriteString(err.Error() + "\n")
+		}
+		if err := a.writeFile("errors.txt", errs.Bytes()); err != nil {
+			return err
+		}
+	}
+	if a.err != nil {
+		return a.err
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gw.Close()
+}
+
+// archive writes files to a tar archive, recording the failures of the sources and the first failure of the archive.
+type archive struct {
+	tw      *tar.Writer
+	modTime time.Time
+	errs    []error
+	err     error
+}
+
+func (a *archive) add(name string, source Source) {
+	a.addContext(context.Background(), name, source)
+}
+
+// addContext writes the file of a source, giving up on the source once the context is done even if the source does
+// not honor it, such as a source waiting for a lock.
+func (a *archive) addContext(ctx context.Context, name string, source Source) {
+	if a.err != nil {
+		return
+	}
+	type result struct {
+		data []byte
+		err  error
+	}
+	done := make(chan result, 1)
+	go func() {
+		var buf bytes.Buffer
+		err := source(ctx, &buf)
+		done <- result{data: buf.Bytes(), err: err}
+	}()
+	var r result
+	select {
+	case r = <-done:
+	case <-ctx.Done():
+		r.err = ctx.Err()
+	}
+	if r.err != nil {
+		a.errs = append(a.errs, errors.Wrapf(r.err, "%s", name))
+		return
+	}
+	a.err = a.writeFile(name, r.data)
+}
+
+func (a *archive) writeFile(name string, data []byte) error {
+	if err := a.tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: a.modTime,
+	}); err != nil {
+		return err
+	}
+	_, err := a.tw.Write(data)
+	return err
+}
+
+var (
+	defaultLogs    = NewLogBuffer(defaultLogLines)
+	defaultBundler = NewBundler(defaultLogs)
+	captureOnce    sync.Once
+)
+
+// CaptureLogs records the recent lines of the standard logger for the bundles of the default bundler. It is called
+// once the output of the standard logger is configured.
+func CaptureLogs() {
+	captureOnce.Do(func() {
+		captureLogs(defaultLogs)
+	})
+}
+
+// SetDir sets the directory of the bundles of the default bundler.
+func SetDir(dir string) {
+	defaultBundler.SetDir(dir)
+}
+
+// AddSource registers a source of the default bundler.
+func AddSource(name string, source Source) {
+	defaultBundler.AddSource(name, source)
+}
+
+// WriteBundle writes a bundle with the default bundler.
+func WriteBundle(ctx context.Context, reason string) (string, error) {
+	return defaultBundler.WriteBundle(ctx, reason)
+}
@@ -0,0 +1,121 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func readBundle(t *testing.T, path string) map[string]string {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	gr, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	tr := tar.NewReader(gr)
+	files := make(map[string]string)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return files
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[h.Name] = string(data)
+	}
+}
+
+func TestBundler_WriteBundle(t *testing.T) {
+	logs := NewLogBuffer(2)
+	_, err := logs.Write([]byte("first\nsecond\n"))
+	require.NoError(t, err)
+	_, err = logs.Write([]byte("third\n"))
+	require.NoError(t, err)
+
+	b := NewBundler(logs)
+	b.SetDir(t.TempDir())
+	b.AddSource("config.yaml", func(_ context.Context, w io.Writer) error {
+		_, err := w.Write([]byte("CONFIG_NAME: test"))
+		return err
+	})
+	b.AddSource("peers.json", func(context.Context, io.Writer) error {
+		return errors.New("p2p is down")
+	})
+	path, err := b.WriteBundle(context.Background(), "test")
+	require.NoError(t, err)
+
+	files := readBundle(t, path)
+	assert.StringContains(t, "reason: test", files["reason.txt"])
+	assert.StringContains(t, "goroutine", files["goroutines.txt"])
+	assert.NotEqual(t, "", files["heap.pprof"])
+	assert.Equal(t, "second\nthird\n", files["logs.txt"])
+	assert.Equal(t, "CONFIG_NAME: test", files["config.yaml"])
+	_, ok := files["peers.json"]
+	assert.Equal(t, false, ok)
+	assert.StringContains(t, "peers.json: p2p is down", files["errors.txt"])
+}
+
+func TestBundler_WriteBundle_StuckSource(t *testing.T) {
+	b := NewBundler(nil)
+	b.SetDir(t.TempDir())
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	b.AddSource("forkchoice.json", func(context.Context, io.Writer) error {
+		close(entered)
+		// A source which does not honor its context, like one waiting for a lock.
+		<-release
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	type result struct {
+		path string
+		err  error
+	}
+	done := make(chan result)
+	go func() {
+		path, err := b.WriteBundle(ctx, "test")
+		done <- result{path: path, err: err}
+	}()
+	<-entered
+	_, err := b.WriteBundle(context.Background(), "concurrent")
+	require.ErrorIs(t, err, ErrBundleInProgress)
+
+	r := <-done
+	require.NoError(t, r.err)
+	files := readBundle(t, r.path)
+	_, ok := files["forkchoice.json"]
+	assert.Equal(t, false, ok)
+	assert.StringContains(t, "forkchoice.json: context deadline exceeded", files["errors.txt"])
+}
+
+func TestBundler_WriteBundle_NoDirectory(t *testing.T) {
+	_, err := NewBundler(nil).WriteBundle(context.Background(), "test")
+	require.ErrorIs(t, err, ErrNoDirectory)
+}
+
+func TestLogBuffer_WriteTo(t *testing.T) {
+	logs := NewLogBuffer(3)
+	for _, line := range []string{"a\n", "b\n", "c\n", "d\n"} {
+		_, err := logs.Write([]byte(line))
+		require.NoError(t, err)
+	}
+	var buf bytes.Buffer
+	_, err := logs.WriteTo(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "b\nc\nd\n", buf.String())
+}
@@ -0,0 +1,69 @@
+package diagnostics
+
+import (
+	"bytes"
+	"io"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// defaultLogLines is the number of recent log lines kept for diagnostics bundles.
+const defaultLogLines = 1000
+
+// LogBuffer keeps the most recent log lines written to it.
+type LogBuffer struct {
+	lock  sync.Mutex
+	lines [][]byte
+	next  int
+	full  bool
+}
+
+// NewLogBuffer returns a log buffer keeping the given number of lines.
+func NewLogBuffer(size int) *LogBuffer {
+	return &LogBuffer{lines: make([][]byte, size)}
+}
+
+// Write records the lines of p, dropping the oldest lines once the buffer is full.
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if len(b.lines) == 0 {
+		return len(p), nil
+	}
+	for _, line := range bytes.SplitAfter(p, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		b.lines[b.next] = bytes.Clone(line)
+		b.next = (b.next + 1) % len(b.lines)
+		if b.next == 0 {
+			b.full = true
+		}
+	}
+	return len(p), nil
+}
+
+// WriteTo writes the recorded lines, oldest first.
+func (b *LogBuffer) WriteTo(w io.Writer) (int64, error) {
+	b.lock.Lock()
+	lines := append([][]byte{}, b.lines[:b.next]...)
+	if b.full {
+		lines = append(append([][]byte{}, b.lines[b.next:]...), lines...)
+	}
+	b.lock.Unlock()
+	var n int64
+	for _, line := range lines {
+		m, err := w.Write(line)
+		n += int64(m)
+		if err != nil {
+			return n, err
+		}
+	}
+	return n, nil
+}
+
+// captureLogs adds the log buffer to the outputs of the standard logger.
+func captureLogs(b *LogBuffer) {
+	logrus.SetOutput(io.MultiWriter(logrus.StandardLogger().Out, b))
+}
@@ -0,0 +1,167 @@
+// Package faultinjection injects faults into the networking and the Engine API calls of the beacon node, to reproduce
+// timing-dependent bugs in local test networks. Faults are only injected when they are explicitly configured with the
+// fault injection flags, and must never be configured on a production node.
+package faultinjection
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/rand"
+)
+
+// Config of the injected faults. A nil delay does not delay anything.
+type Config struct {
+	// GossipDelay delays the publication of gossip messages.
+	GossipDelay *Delay
+	// RPCDropPercent is the percentage of req/resp requests which are not responded to.
+	RPCDropPercent float64
+	// EngineDelay delays the Engine API calls to the execution client.
+	EngineDelay *Delay
+}
+
+var (
+	globalConfig *Config
+	randLock     sync.Mutex
+	randGen      = rand.NewDeterministicGenerator()
+)
+
+// Get retrieves the fault injection config.
+func Get() *Config {
+	if globalConfig == nil {
+		return &Config{}
+	}
+	return globalConfig
+}
+
+// Init sets the fault injection config.
+func Init(c *Config) {
+	globalConfig = c
+}
+
+// Enabled returns true if any fault is injected.
+func (c *Config) Enabled() bool {
+	return c.GossipDelay != nil || c.RPCDropPercent > 0 || c.EngineDelay != nil
+}
+
+// DelayGossip waits for the gossip delay before a gossip message is published.
+func DelayGossip(ctx context.Context) error {
+	return Get().GossipDelay.Wait(ctx)
+}
+
+// DelayEngineCall waits for the Engine API delay before an Engine API call.
+func DelayEngineCall(ctx context.Context) error {
+	return Get().EngineDelay.Wait(ctx)
+}
+
+// DropRPCResponse returns true if the response to a req/resp request is to be dropped.
+func DropRPCResponse() bool {
+	p := Get().RPCDropPercent
+	if p <= 0 {
+		return false
+	}
+	randLock.Lock()
+	defer randLock.Unlock()
+	return randGen.Float64()*100 < p
+}
+
+// Delay is a distribution of delays.
+type Delay struct {
+	kind string
+	a, b time.Duration
+}
+
+// ParseDelay parses a distribution of delays, which is one of:
+//   - fixed:<delay>, always the same delay
+//   - uniform:<min>,<max>, uniformly distributed delays between min and max
+//   - normal:<mean>,<stddev>, normally distributed delays, of at least zero
+//   - exponential:<mean>, exponentially distributed delays
+//
+// with durations in the Go duration format, e.g. uniform:100ms,2s.
+func ParseDelay(s string) (*Delay, error) {
+	kind, rawParams, ok := strings.Cut(s, ":")
+	if !ok {
+		return nil, fmt.Errorf("delay %q is not of the form <distribution>:<parameters>", s)
+	}
+	var params []time.Duration
+	for _, p := range strings.Split(rawParams, ",") {
+		d, err := time.ParseDuration(strings.TrimSpace(p))
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not parse delay %q", s)
+		}
+		if d < 0 {
+			return nil, fmt.Errorf("delay %q has a negative duration", s)
+		}
+		params = append(params, d)
+	}
+	want := map[string]int{"fixed": 1, "uniform": 2, "normal": 2, "exponential": 1}
+	n, ok := want[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown delay distribution %q, expected one of fixed, uniform, normal, exponential", kind)
+	}
+	if len(params) != n {
+		return nil, fmt.Errorf("delay distribution %s takes %d durations, got %d", kind, n, len(params))
+	}
+	d := &Delay{kind: kind, a: params[0]}
+	if n == 2 {
+		d.b = params[1]
+	}
+	if kind == "uniform" && d.b < d.a {
+		return nil, fmt.Errorf("delay %q has a maximum below its minimum", s)
+	}
+	return d, nil
+}
+
+// String returns the delay in the format of ParseDelay.
+func (d *Delay) String() string {
+	if d == nil {
+		return "none"
+	}
+	switch d.kind {
+	case "uniform", "normal":
+		return fmt.Sprintf("%s:%s,%s", d.kind, d.a, d.b)
+	default:
+		return fmt.Sprintf("%s:%s", d.kind, d.a)
+	}
+}
+
+// Sample draws a delay from the distribution.
+func (d *Delay) Sample() time.Duration {
+	if d == nil {
+		return 0
+	}
+	randLock.Lock()
+	defer randLock.Unlock()
+	var sample float64
+	switch d.kind {
+	case "uniform":
+		sample = float64(d.a) + randGen.Float64()*float64(d.b-d.a)
+	case "normal":
+		sample = float64(d.a) + randGen.NormFloat64()*float64(d.b)
+	case "exponential":
+		sample = randGen.ExpFloat64() * float64(d.a)
+	default:
+		sample = float64(d.a)
+	}
+	return time.Duration(math.Max(sample, 0))
+}
+
+// Wait waits for a delay drawn from the distribution, or until the context is done.
+func (d *Delay) Wait(ctx context.Context) error {
+	if d == nil {
+		return nil
+	}
+	t := time.NewTimer(d.Sample())
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
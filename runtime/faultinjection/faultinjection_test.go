@@ -0,0 +1,72 @@
+package faultinjection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestParseDelay(t *testing.T) {
+	for _, s := range []string{"fixed:100ms", "uniform:100ms,2s", "normal:500ms,100ms", "exponential:200ms"} {
+		d, err := ParseDelay(s)
+		require.NoError(t, err)
+		assert.Equal(t, s, d.String())
+	}
+	for s, want := range map[string]string{
+		"100ms":             "is not of the form",
+		"gamma:1s":          "unknown delay distribution",
+		"uniform:1s":        "takes 2 durations",
+		"fixed:1s,2s":       "takes 1 durations",
+		"uniform:2s,1s":     "maximum below its minimum",
+		"fixed:soon":        "could not parse delay",
+		"exponential:-10ms": "negative duration",
+	} {
+		_, err := ParseDelay(s)
+		assert.ErrorContains(t, want, err, s)
+	}
+}
+
+func TestDelay_Sample(t *testing.T) {
+	var none *Delay
+	assert.Equal(t, time.Duration(0), none.Sample())
+
+	d, err := ParseDelay("fixed:150ms")
+	require.NoError(t, err)
+	assert.Equal(t, 150*time.Millisecond, d.Sample())
+
+	d, err = ParseDelay("uniform:100ms,200ms")
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		s := d.Sample()
+		assert.Equal(t, true, s >= 100*time.Millisecond && s <= 200*time.Millisecond, s)
+	}
+
+	d, err = ParseDelay("normal:0s,1s")
+	require.NoError(t, err)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, true, d.Sample() >= 0)
+	}
+}
+
+func TestDelay_Wait(t *testing.T) {
+	var none *Delay
+	require.NoError(t, none.Wait(context.Background()))
+
+	d, err := ParseDelay("fixed:1h")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.ErrorIs(t, d.Wait(ctx), context.Canceled)
+}
+
+func TestDropRPCResponse(t *testing.T) {
+	defer Init(nil)
+
+	assert.Equal(t, false, DropRPCResponse())
+	Init(&Config{RPCDropPercent: 100})
+	assert.Equal(t, true, DropRPCResponse())
+	assert.Equal(t, true, Get().Enabled())
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/container/trie"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
@@ -586,6 +587,32 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 	}
 
 	gb := s.GB
+	// The genesis block may be an existing block of the execution chain, such as the fork block of a shadow fork, in
+	// which case the header commits to its transactions and withdrawals.
+	extra := gb.Extra()
+	if len(extra) > fieldparams.RootLength {
+		extra = extra[:fieldparams.RootLength]
+	}
+	txs := make([][]byte, 0, len(gb.Transactions()))
+	for _, tx := range gb.Transactions() {
+		enc, err := tx.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "could not marshal transaction of execution genesis block")
+		}
+		txs = append(txs, enc)
+	}
+	withdrawals := make([]*enginev1.Withdrawal, 0, len(gb.Withdrawals()))
+	for _, w := range gb.Withdrawals() {
+		withdrawals = append(withdrawals, &enginev1.Withdrawal{
+			Index:          w.Index,
+			ValidatorIndex: primitives.ValidatorIndex(w.Validator),
+			Address:        w.Address.Bytes(),
+			Amount:         w.Amount,
+		})
+	}
+	if s.Version >= version.Deneb && (gb.ExcessBlobGas() == nil || gb.BlobGasUsed() == nil) {
+		return errors.Errorf("execution genesis block %d has no blob gas fields", gb.NumberU64())
+	}
 
 	var ed interfaces.ExecutionData
 	switch s.Version {
@@ -601,10 +628,10 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extra,
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
-			Transactions:  make([][]byte, 0),
+			Transactions:  txs,
 		}
 		wep, err := blocks.WrappedExecutionPayload(payload)
 		if err != nil {
@@ -630,11 +657,11 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extra,
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
-			Transactions:  make([][]byte, 0),
-			Withdrawals:   make([]*enginev1.Withdrawal, 0),
+			Transactions:  txs,
+			Withdrawals:   withdrawals,
 		}
 		wep, err := blocks.WrappedExecutionPayloadCapella(payload)
 		if err != nil {
@@ -660,11 +687,11 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extra,
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
-			Transactions:  make([][]byte, 0),
-			Withdrawals:   make([]*enginev1.Withdrawal, 0),
+			Transactions:  txs,
+			Withdrawals:   withdrawals,
 			ExcessBlobGas: *gb.ExcessBlobGas(),
 			BlobGasUsed:   *gb.BlobGasUsed(),
 		}
@@ -692,11 +719,11 @@ func (s *PremineGenesisConfig) setExecutionPayload(g state.BeaconState) error {
 			GasLimit:      gb.GasLimit(),
 			GasUsed:       gb.GasUsed(),
 			Timestamp:     gb.Time(),
-			ExtraData:     gb.Extra()[:32],
+			ExtraData:     extra,
 			BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(gb.BaseFee().Bytes()), fieldparams.RootLength),
 			BlockHash:     gb.Hash().Bytes(),
-			Transactions:  make([][]byte, 0),
-			Withdrawals:   make([]*enginev1.Withdrawal, 0),
+			Transactions:  txs,
+			Withdrawals:   withdrawals,
 			ExcessBlobGas: *gb.ExcessBlobGas(),
 			BlobGasUsed:   *gb.BlobGasUsed(),
 		}
@@ -5,8 +5,13 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/time"
 )
@@ -24,3 +29,36 @@ func TestPremineGenesis_Electra(t *testing.T) {
 	_, err := NewPreminedGenesis(context.Background(), genesis.Time(), 10, 10, version.Electra, genesis)
 	require.NoError(t, err)
 }
+
+func TestPremineGenesis_ExistingExecutionBlock(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1), Gas: 21000, Value: big.NewInt(1)})
+	w := &types.Withdrawal{Index: 2, Validator: 3, Address: common.Address{'a'}, Amount: 4}
+	// A block of an existing execution chain with a short extra data, as a shadow fork starts from.
+	block := types.NewBlockWithHeader(&types.Header{
+		Number:  big.NewInt(100),
+		Time:    1000,
+		Extra:   []byte("geth"),
+		BaseFee: big.NewInt(1),
+	}).WithBody([]*types.Transaction{tx}, nil).WithWithdrawals([]*types.Withdrawal{w})
+
+	st, err := NewPreminedGenesis(context.Background(), 2000, 10, 0, version.Capella, block)
+	require.NoError(t, err)
+	header, err := st.LatestExecutionPayloadHeader()
+	require.NoError(t, err)
+	assert.DeepEqual(t, block.Hash().Bytes(), header.BlockHash())
+	assert.Equal(t, uint64(100), header.BlockNumber())
+	assert.DeepEqual(t, []byte("geth"), header.ExtraData())
+
+	enc, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	txRoot, err := ssz.TransactionsRoot([][]byte{enc})
+	require.NoError(t, err)
+	root, err := header.TransactionsRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, txRoot[:], root)
+	wRoot, err := ssz.WithdrawalSliceRoot([]*enginev1.Withdrawal{{Index: 2, ValidatorIndex: 3, Address: w.Address.Bytes(), Amount: 4}}, fieldparams.MaxWithdrawalsPerPayload)
+	require.NoError(t, err)
+	root, err = header.WithdrawalsRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, wRoot[:], root)
+}
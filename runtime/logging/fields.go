@@ -0,0 +1,33 @@
+package logging
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+// Root is a block or state root in log fields. It is truncated in text logs and written in full in JSON logs.
+type Root [32]byte
+
+// String returns the first bytes of the root in hex.
+func (r Root) String() string {
+	return fmt.Sprintf("%#x", bytesutil.Trunc(r[:]))
+}
+
+// MarshalText returns the full root in hex.
+func (r Root) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%#x", r[:])), nil
+}
+
+// BlockFields returns the standard fields of consensus events about a block: its slot, the epoch of the slot and
+// its root.
+func BlockFields(slot primitives.Slot, root [32]byte) logrus.Fields {
+	return logrus.Fields{
+		slotField:      slot,
+		epochField:     slots.ToEpoch(slot),
+		blockRootField: Root(root),
+	}
+}
@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// prefixField is the field holding the module of the loggers of the beacon node and validator client.
+	prefixField = "prefix"
+
+	timeField      = "time"
+	levelField     = "level"
+	moduleField    = "module"
+	msgField       = "msg"
+	slotField      = "slot"
+	epochField     = "epoch"
+	blockRootField = "blockRoot"
+)
+
+// JSONFormatter formats log entries as JSON objects of a stable schema, one per line. Every object has the keys
+// time, in RFC 3339 format with nanoseconds in UTC, level and msg, and module when the logger has a prefix. Entries
+// of consensus events have the slot and epoch as numbers, the epoch being derived from the slot when the entry has
+// none, and the blockRoot, written in full when logged as a Root. The other fields of the entry follow, fields named
+// after one of the keys above being renamed with a fields. prefix, and errors being written as their message.
+type JSONFormatter struct{}
+
+// Format formats the entry as a line of JSON.
+func (*JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	data := make(logrus.Fields, len(entry.Data)+4)
+	for k, v := range entry.Data {
+		switch k {
+		case prefixField:
+			continue
+		case timeField, levelField, moduleField, msgField:
+			k = "fields." + k
+		}
+		if err, ok := v.(error); ok {
+			v = err.Error()
+		}
+		data[k] = v
+	}
+	data[timeField] = entry.Time.UTC().Format(time.RFC3339Nano)
+	data[levelField] = entry.Level.String()
+	data[msgField] = entry.Message
+	if module, ok := entry.Data[prefixField].(string); ok {
+		data[moduleField] = module
+	}
+	if slot, ok := toUint64(entry.Data[slotField]); ok {
+		data[slotField] = slot
+		if epoch, ok := toUint64(entry.Data[epochField]); ok {
+			data[epochField] = epoch
+		} else if _, ok := entry.Data[epochField]; !ok {
+			data[epochField] = slots.ToEpoch(primitives.Slot(slot))
+		}
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal log entry")
+	}
+	return append(b, '\n'), nil
+}
+
+func toUint64(v interface{}) (uint64, bool) {
+	switch n := v.(type) {
+	case primitives.Slot:
+		return uint64(n), true
+	case primitives.Epoch:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	case uint:
+		return uint64(n), true
+	case int:
+		if n < 0 {
+			return 0, false
+		}
+		return uint64(n), true
+	case int64:
+		if n < 0 {
+			return 0, false
+		}
+		return uint64(n), true
+	default:
+		return 0, false
+	}
+}
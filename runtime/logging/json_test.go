@@ -0,0 +1,71 @@
+package logging
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestJSONFormatter(t *testing.T) {
+	root := [32]byte{0xaa, 0xbb}
+	entry := &logrus.Entry{
+		Logger:  logrus.New(),
+		Time:    time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+		Level:   logrus.InfoLevel,
+		Message: "Synced new block",
+		Data: logrus.Fields{
+			"prefix":    "blockchain",
+			"slot":      primitives.Slot(65),
+			"blockRoot": Root(root),
+			"msg":       "shadowed",
+			"error":     errors.New("boom"),
+			"peers":     3,
+		},
+	}
+	b, err := (&JSONFormatter{}).Format(entry)
+	require.NoError(t, err)
+	require.Equal(t, byte('\n'), b[len(b)-1])
+
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.DeepEqual(t, map[string]interface{}{
+		"time":       "2024-01-02T03:04:05.000000006Z",
+		"level":      "info",
+		"module":     "blockchain",
+		"msg":        "Synced new block",
+		"slot":       float64(65),
+		"epoch":      float64(2),
+		"blockRoot":  "0xaabb000000000000000000000000000000000000000000000000000000000000",
+		"fields.msg": "shadowed",
+		"error":      "boom",
+		"peers":      float64(3),
+	}, got)
+}
+
+func TestJSONFormatter_WithoutConsensusFields(t *testing.T) {
+	entry := &logrus.Entry{Logger: logrus.New(), Level: logrus.WarnLevel, Message: "No peers", Data: logrus.Fields{}}
+	b, err := (&JSONFormatter{}).Format(entry)
+	require.NoError(t, err)
+	var got map[string]interface{}
+	require.NoError(t, json.Unmarshal(b, &got))
+	_, ok := got["module"]
+	assert.Equal(t, false, ok)
+	_, ok = got["epoch"]
+	assert.Equal(t, false, ok)
+	assert.Equal(t, "No peers", got["msg"])
+}
+
+func TestRoot(t *testing.T) {
+	r := Root{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07}
+	assert.Equal(t, "0x010203040506", r.String())
+	fields := BlockFields(33, r)
+	assert.Equal(t, primitives.Slot(33), fields["slot"])
+	assert.Equal(t, primitives.Epoch(1), fields["epoch"])
+	assert.Equal(t, r, fields["blockRoot"])
+}
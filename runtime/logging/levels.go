@@ -0,0 +1,103 @@
+package logging
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ModuleLevels are log levels by module, the module of a log entry being the prefix field of its logger.
+type ModuleLevels map[string]logrus.Level
+
+// ParseModuleLevels parses module levels in the form <module>=<level>, several of which can be separated by
+// commas, such as blockchain=debug,p2p=warn.
+func ParseModuleLevels(specs []string) (ModuleLevels, error) {
+	levels := make(ModuleLevels)
+	for _, spec := range specs {
+		for _, s := range strings.Split(spec, ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			module, l, ok := strings.Cut(s, "=")
+			if !ok || module == "" {
+				return nil, errors.Errorf("invalid module log level %q, expected <module>=<level>", s)
+			}
+			level, err := logrus.ParseLevel(l)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid log level of module %s", module)
+			}
+			levels[module] = level
+		}
+	}
+	return levels, nil
+}
+
+// ModuleLevelFormatter drops the log entries above the level of their module, or above the default level for
+// modules without a level, before formatting the others with the wrapped formatter. The level of the logger must be
+// at least as verbose as the levels of all modules for their entries to reach the formatter.
+type ModuleLevelFormatter struct {
+	Formatter logrus.Formatter
+	Default   logrus.Level
+	Levels    ModuleLevels
+}
+
+// Format formats the entry with the wrapped formatter, or returns nothing when the entry is dropped.
+func (f *ModuleLevelFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if !f.Enabled(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+// Enabled returns whether the level of the module of the entry allows it.
+func (f *ModuleLevelFormatter) Enabled(entry *logrus.Entry) bool {
+	level := f.Default
+	if module, ok := entry.Data[prefixField].(string); ok {
+		if l, ok := f.Levels[module]; ok {
+			level = l
+		}
+	}
+	return entry.Level <= level
+}
+
+// ConfigureModuleLevels applies the module levels to the standard logger on top of the default level, and returns
+// the level to set on the standard logger, which is the most verbose of the default and module levels. The
+// formatter of the standard logger must be set beforehand.
+//
+// The modules share the standard logger, so its level can not differ by module, and entries are only dropped by the
+// formatter. A module level more verbose than the default level therefore has a cost for every module: the entries
+// of every module at that level are built with their fields and passed to the hooks before being dropped, and code
+// guarded by the level of the logger runs. Code building costly entries should be guarded with IsLevelEnabled, which
+// takes the module levels into account.
+func ConfigureModuleLevels(defaultLevel logrus.Level, levels ModuleLevels) logrus.Level {
+	if len(levels) == 0 {
+		return defaultLevel
+	}
+	logrus.SetFormatter(&ModuleLevelFormatter{
+		Formatter: logrus.StandardLogger().Formatter,
+		Default:   defaultLevel,
+		Levels:    levels,
+	})
+	level := defaultLevel
+	for _, l := range levels {
+		if l > level {
+			level = l
+		}
+	}
+	return level
+}
+
+// IsLevelEnabled returns whether entries of the given level logged with the logger entry are written, taking the
+// module levels into account.
+func IsLevelEnabled(logger *logrus.Entry, level logrus.Level) bool {
+	if !logger.Logger.IsLevelEnabled(level) {
+		return false
+	}
+	f, ok := logger.Logger.Formatter.(*ModuleLevelFormatter)
+	if !ok {
+		return true
+	}
+	return f.Enabled(&logrus.Entry{Logger: logger.Logger, Data: logger.Data, Level: level})
+}
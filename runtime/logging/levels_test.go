@@ -0,0 +1,56 @@
+package logging
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/sirupsen/logrus"
+)
+
+func TestParseModuleLevels(t *testing.T) {
+	levels, err := ParseModuleLevels([]string{"blockchain=debug,p2p=warn", " sync=trace "})
+	require.NoError(t, err)
+	assert.DeepEqual(t, ModuleLevels{
+		"blockchain": logrus.DebugLevel,
+		"p2p":        logrus.WarnLevel,
+		"sync":       logrus.TraceLevel,
+	}, levels)
+
+	_, err = ParseModuleLevels([]string{"blockchain"})
+	require.ErrorContains(t, "expected <module>=<level>", err)
+	_, err = ParseModuleLevels([]string{"blockchain=loud"})
+	require.ErrorContains(t, "invalid log level of module blockchain", err)
+}
+
+func TestModuleLevelFormatter(t *testing.T) {
+	var out bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&out)
+	logger.SetFormatter(&ModuleLevelFormatter{
+		Formatter: &logrus.TextFormatter{DisableTimestamp: true},
+		Default:   logrus.InfoLevel,
+		Levels:    ModuleLevels{"blockchain": logrus.DebugLevel, "p2p": logrus.WarnLevel},
+	})
+	logger.SetLevel(logrus.DebugLevel)
+
+	blockchain := logger.WithField("prefix", "blockchain")
+	p2p := logger.WithField("prefix", "p2p")
+	sync := logger.WithField("prefix", "sync")
+	blockchain.Debug("blockchain debug")
+	p2p.Info("p2p info")
+	p2p.Warn("p2p warn")
+	sync.Debug("sync debug")
+	sync.Info("sync info")
+
+	assert.StringContains(t, "blockchain debug", out.String())
+	assert.StringNotContains(t, "p2p info", out.String())
+	assert.StringContains(t, "p2p warn", out.String())
+	assert.StringNotContains(t, "sync debug", out.String())
+	assert.StringContains(t, "sync info", out.String())
+
+	assert.Equal(t, true, IsLevelEnabled(blockchain, logrus.DebugLevel))
+	assert.Equal(t, false, IsLevelEnabled(sync, logrus.DebugLevel))
+	assert.Equal(t, false, IsLevelEnabled(blockchain, logrus.TraceLevel))
+}
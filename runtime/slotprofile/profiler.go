@@ -0,0 +1,239 @@
+// Package slotprofile captures short CPU profiles and execution traces of the slow processing of the blocks of the
+// slots, and keeps them when the processing is slower than a threshold, making the tail latency of the node
+// diagnosable in production without profiling it continuously by hand.
+package slotprofile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"runtime/trace"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultMaxCaptures is the default number of captures kept in the directory.
+const DefaultMaxCaptures = 16
+
+// BlockImportPath is the value of the path profile label of the samples of the import of blocks.
+const BlockImportPath = "block_import"
+
+// minCaptureInterval is the minimum time between the starts of two captures, bounding the cost of profiling when
+// many slots are slow.
+const minCaptureInterval = 5 * time.Minute
+
+const (
+	cpuProfileSuffix = ".cpu.pprof"
+	traceSuffix      = ".trace"
+)
+
+var (
+	log = logrus.WithField("prefix", "slotprofile")
+
+	capturesWritten = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slot_profile_captures_total",
+		Help: "Number of profiles of slow slot processing written.",
+	})
+	capturesMissed = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slot_profile_missed_total",
+		Help: "Number of slow slot processing not profiled because another profile was being captured or profiling was rate limited.",
+	})
+)
+
+// Profiler profiles the processing of the blocks of the slots. The processing of a slot is only profiled once it
+// runs for half of the threshold, so that fast slots cost nothing, and the profile is kept when the processing
+// takes longer than the threshold. A single processing is profiled at a time, as the Go runtime runs a single CPU
+// profile and execution trace, and captures are started at most every minCaptureInterval. A nil profiler profiles
+// nothing.
+type Profiler struct {
+	dir         string
+	threshold   time.Duration
+	maxCaptures int
+	cpuProfile  bool
+	interval    time.Duration
+	capturing   atomic.Bool
+	lastCapture atomic.Int64
+}
+
+// NewProfiler returns a profiler writing to the directory the profiles of the processing which took longer than the
+// threshold, keeping the last maxCaptures of them. The CPU profile is only taken when cpuProfile is set, and should
+// be left to the pprof endpoint when it is enabled, as a single CPU profile runs at a time.
+func NewProfiler(dir string, threshold time.Duration, maxCaptures int, cpuProfile bool) *Profiler {
+	return &Profiler{
+		dir:         dir,
+		threshold:   threshold,
+		maxCaptures: maxCaptures,
+		cpuProfile:  cpuProfile,
+		interval:    minCaptureInterval,
+	}
+}
+
+// Start starts profiling the processing of the block of the slot along the given path, such as BlockImportPath. It
+// returns the context carrying the profile labels of the slot and the path, which are also set on the calling
+// goroutine, and the function ending the processing, to be called on the calling goroutine. Nothing is captured
+// while another processing is profiled, when profiling is rate limited, or when the CPU profile and the execution
+// trace are started elsewhere, such as with --pprof, --cpuprofile or --trace, but the samples are labeled still.
+func (p *Profiler) Start(ctx context.Context, slot primitives.Slot, path string) (context.Context, func()) {
+	if p == nil {
+		return ctx, func() {}
+	}
+	parent := ctx
+	ctx = pprof.WithLabels(ctx, pprof.Labels("slot", strconv.FormatUint(uint64(slot), 10), "path", path))
+	pprof.SetGoroutineLabels(ctx)
+
+	c := &capture{}
+	timer := time.AfterFunc(p.threshold/2, func() { c.start(p) })
+	start := time.Now()
+	return ctx, func() {
+		pprof.SetGoroutineLabels(parent)
+		latency := time.Since(start)
+		timer.Stop()
+		if !c.stop() {
+			if latency >= p.threshold {
+				capturesMissed.Inc()
+			}
+			return
+		}
+		p.capturing.Store(false)
+		if latency < p.threshold {
+			return
+		}
+		fields := logrus.Fields{
+			"slot":    slot,
+			"path":    path,
+			"latency": latency,
+		}
+		name, err := p.write(slot, path, latency, c)
+		if err != nil {
+			log.WithError(err).WithFields(fields).Error("Could not write the profile of slow processing")
+			return
+		}
+		capturesWritten.Inc()
+		log.WithFields(fields).WithField("profile", name).Info("Profiled slow processing")
+	}
+}
+
+// acquire reserves the runtime profilers for a capture, unless another capture runs or the last one started less
+// than the interval ago.
+func (p *Profiler) acquire() bool {
+	if !p.capturing.CompareAndSwap(false, true) {
+		return false
+	}
+	now := time.Now()
+	if last := p.lastCapture.Load(); last != 0 && now.Sub(time.Unix(0, last)) < p.interval {
+		p.capturing.Store(false)
+		return false
+	}
+	p.lastCapture.Store(now.UnixNano())
+	return true
+}
+
+// capture holds the CPU profile and the execution trace of a processing.
+type capture struct {
+	sync.Mutex
+	ended     bool
+	profiling bool
+	tracing   bool
+	cpu       bytes.Buffer
+	trace     bytes.Buffer
+}
+
+// start starts the CPU profile and the execution trace of a processing still running, unless the profiler cannot
+// capture it.
+func (c *capture) start(p *Profiler) {
+	c.Lock()
+	defer c.Unlock()
+	if c.ended || !p.acquire() {
+		return
+	}
+	if p.cpuProfile {
+		if err := pprof.StartCPUProfile(&c.cpu); err != nil {
+			log.WithError(err).Debug("Could not start the CPU profile")
+		} else {
+			c.profiling = true
+		}
+	}
+	c.tracing = trace.Start(&c.trace) == nil
+	if !c.profiling && !c.tracing {
+		p.capturing.Store(false)
+	}
+}
+
+// stop ends the processing, stopping its capture, and returns whether it was captured.
+func (c *capture) stop() bool {
+	c.Lock()
+	defer c.Unlock()
+	c.ended = true
+	if c.profiling {
+		pprof.StopCPUProfile()
+	}
+	if c.tracing {
+		trace.Stop()
+	}
+	return c.profiling || c.tracing
+}
+
+// write writes the files of the capture, and removes the oldest captures beyond the maximum. It returns the name of
+// the capture.
+func (p *Profiler) write(slot primitives.Slot, path string, latency time.Duration, c *capture) (string, error) {
+	if err := file.MkdirAll(p.dir); err != nil {
+		return "", errors.Wrap(err, "could not create profiles directory")
+	}
+	name := fmt.Sprintf("%s-slot-%d-%s-%dms", time.Now().UTC().Format("20060102-150405.000"), slot, path, latency.Milliseconds())
+	if c.profiling {
+		if err := file.WriteFile(filepath.Join(p.dir, name+cpuProfileSuffix), c.cpu.Bytes()); err != nil {
+			return "", err
+		}
+	}
+	if c.tracing {
+		if err := file.WriteFile(filepath.Join(p.dir, name+traceSuffix), c.trace.Bytes()); err != nil {
+			return "", err
+		}
+	}
+	p.prune()
+	return name, nil
+}
+
+// prune removes the oldest captures beyond the maximum. Captures sort by time, as their names start with it.
+func (p *Profiler) prune() {
+	entries, err := os.ReadDir(p.dir)
+	if err != nil {
+		log.WithError(err).Debug("Could not list the profiles directory")
+		return
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, e := range entries {
+		for _, suffix := range []string{cpuProfileSuffix, traceSuffix} {
+			if name, ok := strings.CutSuffix(e.Name(), suffix); ok && !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	if len(names) <= p.maxCaptures {
+		return
+	}
+	sort.Strings(names)
+	for _, name := range names[:len(names)-p.maxCaptures] {
+		for _, suffix := range []string{cpuProfileSuffix, traceSuffix} {
+			if err := os.Remove(filepath.Join(p.dir, name+suffix)); err != nil && !os.IsNotExist(err) {
+				log.WithError(err).Debug("Could not remove old profile")
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package slotprofile
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const testThreshold = 20 * time.Millisecond
+
+// process runs a processing of the slot for the given duration.
+func process(p *Profiler, slot primitives.Slot, d time.Duration) {
+	_, end := p.Start(context.Background(), slot, BlockImportPath)
+	time.Sleep(d)
+	end()
+}
+
+func TestProfiler(t *testing.T) {
+	dir := t.TempDir()
+	p := NewProfiler(dir, testThreshold, 2, true)
+	p.interval = 0
+
+	ctx, end := p.Start(context.Background(), 5, BlockImportPath)
+	slot, ok := pprof.Label(ctx, "slot")
+	require.Equal(t, true, ok)
+	assert.Equal(t, "5", slot)
+	path, ok := pprof.Label(ctx, "path")
+	require.Equal(t, true, ok)
+	assert.Equal(t, BlockImportPath, path)
+
+	// A single processing is profiled at a time.
+	_, endConcurrent := p.Start(context.Background(), 5, BlockImportPath)
+	time.Sleep(2 * testThreshold)
+	endConcurrent()
+	end()
+	assert.Equal(t, false, p.capturing.Load())
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, true, strings.HasSuffix(entries[0].Name(), cpuProfileSuffix))
+	assert.Equal(t, true, strings.Contains(entries[0].Name(), "-slot-5-block_import-"))
+	assert.Equal(t, true, strings.HasSuffix(entries[1].Name(), traceSuffix))
+
+	// The oldest captures are removed beyond the maximum.
+	for slot := primitives.Slot(6); slot <= 8; slot++ {
+		process(p, slot, 2*testThreshold)
+	}
+	cpuProfiles, err := filepath.Glob(filepath.Join(dir, "*"+cpuProfileSuffix))
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(cpuProfiles))
+}
+
+func TestProfiler_Threshold(t *testing.T) {
+	dir := t.TempDir()
+	p := NewProfiler(dir, time.Hour, DefaultMaxCaptures, true)
+	process(p, 5, 0)
+	assert.Equal(t, int64(0), p.lastCapture.Load())
+	_, err := os.Stat(dir)
+	require.NoError(t, err)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(entries))
+
+	var nilProfiler *Profiler
+	ctx := context.Background()
+	labeled, end := nilProfiler.Start(ctx, 5, BlockImportPath)
+	end()
+	assert.Equal(t, ctx, labeled)
+}
+
+func TestProfiler_RateLimited(t *testing.T) {
+	dir := t.TempDir()
+	p := NewProfiler(dir, testThreshold, DefaultMaxCaptures, true)
+	process(p, 5, 2*testThreshold)
+	process(p, 6, 2*testThreshold)
+	cpuProfiles, err := filepath.Glob(filepath.Join(dir, "*"+cpuProfileSuffix))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cpuProfiles))
+	assert.Equal(t, true, strings.Contains(cpuProfiles[0], "-slot-5-"))
+}
+
+func TestProfiler_WithoutCPUProfile(t *testing.T) {
+	dir := t.TempDir()
+	p := NewProfiler(dir, testThreshold, DefaultMaxCaptures, false)
+	process(p, 5, 2*testThreshold)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, true, strings.HasSuffix(entries[0].Name(), traceSuffix))
+}
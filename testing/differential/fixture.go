@@ -0,0 +1,191 @@
+// Package differential runs recorded batches of blocks through the state transition and compares the resulting state
+// roots against the roots recorded from another client, to catch consensus divergences before a release.
+//
+// A fixture is a directory with the following files:
+//
+//	meta.yaml              the config name, the client the roots were recorded from, the number of blocks and the roots
+//	pre.ssz_snappy         the snappy compressed SSZ encoding of the state the first block is applied to
+//	blocks_<i>.ssz_snappy  the snappy compressed SSZ encoding of the i-th signed block of the batch
+//
+// The post_state_roots of meta.yaml hold the root of the state after each block of the batch, in order.
+package differential
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ghodss/yaml"
+	"github.com/golang/snappy"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/encoding/ssz/detect"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+)
+
+const (
+	metaFileName     = "meta.yaml"
+	preStateFileName = "pre.ssz_snappy"
+)
+
+// Meta is the meta.yaml file of a fixture.
+type Meta struct {
+	// Config is the name of the chain config of the batch, e.g. mainnet.
+	Config string `json:"config"`
+	// Client is the client, and its version, the post state roots were recorded from.
+	Client string `json:"client"`
+	// BlocksCount is the number of blocks of the batch.
+	BlocksCount int `json:"blocks_count"`
+	// PostStateRoots are the 0x prefixed hex roots of the state after each block of the batch.
+	PostStateRoots []string `json:"post_state_roots"`
+}
+
+// Fixture is a recorded batch of consecutive blocks, with the state the batch is applied to and the roots of the
+// states after each block recorded from another client.
+type Fixture struct {
+	Name           string
+	Client         string
+	PreState       state.BeaconState
+	Blocks         []interfaces.ReadOnlySignedBeaconBlock
+	PostStateRoots [][32]byte
+}
+
+func blockFileName(i int) string {
+	return fmt.Sprintf("blocks_%d.ssz_snappy", i)
+}
+
+// ReadMeta reads the meta.yaml file of the fixture in the directory.
+func ReadMeta(dir string) (*Meta, error) {
+	enc, err := os.ReadFile(filepath.Join(dir, metaFileName)) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read fixture meta")
+	}
+	meta := &Meta{}
+	if err := yaml.Unmarshal(enc, meta); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal fixture meta")
+	}
+	if meta.BlocksCount != len(meta.PostStateRoots) {
+		return nil, fmt.Errorf("fixture has %d blocks but %d post state roots", meta.BlocksCount, len(meta.PostStateRoots))
+	}
+	return meta, nil
+}
+
+// LoadFixture loads the fixture in the directory. The blocks are decoded with the fork schedule of the active config,
+// which has to be the config of the fixture.
+func LoadFixture(dir string) (*Fixture, error) {
+	meta, err := ReadMeta(dir)
+	if err != nil {
+		return nil, err
+	}
+	f := &Fixture{
+		Name:           filepath.Base(dir),
+		Client:         meta.Client,
+		Blocks:         make([]interfaces.ReadOnlySignedBeaconBlock, meta.BlocksCount),
+		PostStateRoots: make([][32]byte, meta.BlocksCount),
+	}
+
+	enc, err := readSnappy(filepath.Join(dir, preStateFileName))
+	if err != nil {
+		return nil, err
+	}
+	cf, err := detect.FromState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not detect the fork of the pre state")
+	}
+	f.PreState, err = cf.UnmarshalBeaconState(enc)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal the pre state")
+	}
+
+	for i := 0; i < meta.BlocksCount; i++ {
+		enc, err := readSnappy(filepath.Join(dir, blockFileName(i)))
+		if err != nil {
+			return nil, err
+		}
+		cf, err := detect.FromBlock(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect the fork of block %d", i)
+		}
+		f.Blocks[i], err = cf.UnmarshalBeaconBlock(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not unmarshal block %d", i)
+		}
+		root, err := hexutil.Decode(meta.PostStateRoots[i])
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode post state root %d", i)
+		}
+		if len(root) != len(f.PostStateRoots[i]) {
+			return nil, fmt.Errorf("post state root %d has length %d", i, len(root))
+		}
+		copy(f.PostStateRoots[i][:], root)
+	}
+	return f, nil
+}
+
+// WriteFixture records the batch of blocks applied to the pre state, with the roots of the states after each block
+// recorded from the client, as a fixture in the directory.
+func WriteFixture(
+	dir, config, client string,
+	pre state.BeaconState,
+	blks []interfaces.ReadOnlySignedBeaconBlock,
+	postStateRoots [][32]byte,
+) error {
+	if len(blks) != len(postStateRoots) {
+		return fmt.Errorf("%d blocks but %d post state roots", len(blks), len(postStateRoots))
+	}
+	meta := &Meta{
+		Config:         config,
+		Client:         client,
+		BlocksCount:    len(blks),
+		PostStateRoots: make([]string, len(postStateRoots)),
+	}
+	for i, root := range postStateRoots {
+		meta.PostStateRoots[i] = hexutil.Encode(root[:])
+	}
+	enc, err := yaml.Marshal(meta)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal fixture meta")
+	}
+	if err := file.WriteFile(filepath.Join(dir, metaFileName), enc); err != nil {
+		return errors.Wrap(err, "could not write fixture meta")
+	}
+
+	enc, err = pre.MarshalSSZ()
+	if err != nil {
+		return errors.Wrap(err, "could not marshal the pre state")
+	}
+	if err := writeSnappy(filepath.Join(dir, preStateFileName), enc); err != nil {
+		return err
+	}
+	for i, b := range blks {
+		enc, err := b.MarshalSSZ()
+		if err != nil {
+			return errors.Wrapf(err, "could not marshal block %d", i)
+		}
+		if err := writeSnappy(filepath.Join(dir, blockFileName(i)), enc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readSnappy(path string) ([]byte, error) {
+	enc, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", filepath.Base(path))
+	}
+	dec, err := snappy.Decode(nil /* dst */, enc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not decompress %s", filepath.Base(path))
+	}
+	return dec, nil
+}
+
+func writeSnappy(path string, enc []byte) error {
+	if err := file.WriteFile(path, snappy.Encode(nil /* dst */, enc)); err != nil {
+		return errors.Wrapf(err, "could not write %s", filepath.Base(path))
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package differential
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// Divergence is the first block of a fixture after which the state transition disagrees with the recorded client.
+type Divergence struct {
+	Client    string
+	Index     int
+	Slot      primitives.Slot
+	BlockRoot [32]byte
+	// Want is the post state root recorded from the client.
+	Want [32]byte
+	// Got is the post state root of the state transition, zero when the transition failed.
+	Got [32]byte
+	// Err is the error of the state transition, when the block was rejected.
+	Err error
+}
+
+// Error describes the divergence.
+func (d *Divergence) Error() string {
+	if d.Err != nil {
+		return fmt.Sprintf("block %d at slot %d with root %#x was rejected, %s computed post state root %#x: %v",
+			d.Index, d.Slot, d.BlockRoot, d.Client, d.Want, d.Err)
+	}
+	return fmt.Sprintf("block %d at slot %d with root %#x has post state root %#x, %s computed %#x",
+		d.Index, d.Slot, d.BlockRoot, d.Got, d.Client, d.Want)
+}
+
+// Run applies the blocks of the fixture to a copy of its pre state in order, and returns the first block after which
+// the post state root differs from the recorded one, or nil when all the roots match. The error is only set when the
+// fixture could not be run.
+func Run(ctx context.Context, f *Fixture) (*Divergence, error) {
+	st := f.PreState.Copy()
+	for i, b := range f.Blocks {
+		blockRoot, err := b.Block().HashTreeRoot()
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute the root of block %d", i)
+		}
+		d := &Divergence{
+			Client:    f.Client,
+			Index:     i,
+			Slot:      b.Block().Slot(),
+			BlockRoot: blockRoot,
+			Want:      f.PostStateRoots[i],
+		}
+		st, err = transition.ExecuteStateTransition(ctx, st, b)
+		if err != nil {
+			d.Err = err
+			return d, nil
+		}
+		d.Got, err = st.HashTreeRoot(ctx)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not compute the post state root of block %d", i)
+		}
+		if d.Got != d.Want {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+// RunFixtures runs every fixture in the directory as a subtest, with the config of the fixture active, and fails the
+// subtests of the fixtures which diverge from their recorded client.
+func RunFixtures(t *testing.T, dir string) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		fixtureDir := filepath.Join(dir, e.Name())
+		t.Run(e.Name(), func(t *testing.T) {
+			meta, err := ReadMeta(fixtureDir)
+			require.NoError(t, err)
+			cfg, err := params.ByName(meta.Config)
+			require.NoError(t, err)
+			params.SetActiveTestCleanup(t, cfg)
+			helpers.ClearCache()
+			transition.SkipSlotCache.Disable()
+			t.Cleanup(transition.SkipSlotCache.Enable)
+
+			f, err := LoadFixture(fixtureDir)
+			require.NoError(t, err)
+			d, err := Run(context.Background(), f)
+			require.NoError(t, err)
+			if d != nil {
+				t.Fatal(d)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package differential
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/transition"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+var fixturesDir = flag.String("fixtures", "", "Directory of the recorded block batch fixtures to run")
+
+func TestRecordedFixtures(t *testing.T) {
+	if *fixturesDir == "" {
+		t.Skip("No fixtures directory given with -fixtures")
+	}
+	RunFixtures(t, *fixturesDir)
+}
+
+// writeTestFixture writes a fixture of the blocks of the next slots of a genesis state, with the post state roots of
+// the state transition.
+func writeTestFixture(t *testing.T, dir string, slots primitives.Slot) {
+	st, keys := util.DeterministicGenesisState(t, 64)
+	pre := st.Copy()
+	var blks []interfaces.ReadOnlySignedBeaconBlock
+	var roots [][32]byte
+	for slot := primitives.Slot(1); slot <= slots; slot++ {
+		b, err := util.GenerateFullBlock(st, keys, util.DefaultBlockGenConfig(), slot)
+		require.NoError(t, err)
+		wsb, err := blocks.NewSignedBeaconBlock(b)
+		require.NoError(t, err)
+		st, err = transition.ExecuteStateTransition(context.Background(), st, wsb)
+		require.NoError(t, err)
+		root, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		blks = append(blks, wsb)
+		roots = append(roots, root)
+	}
+	require.NoError(t, WriteFixture(dir, "mainnet", "reference", pre, blks, roots))
+}
+
+func TestRun(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFixture(t, dir, 3)
+
+	t.Run("matching roots", func(t *testing.T) {
+		f, err := LoadFixture(dir)
+		require.NoError(t, err)
+		require.Equal(t, 3, len(f.Blocks))
+		d, err := Run(context.Background(), f)
+		require.NoError(t, err)
+		assert.Equal(t, (*Divergence)(nil), d)
+	})
+	t.Run("diverging root", func(t *testing.T) {
+		f, err := LoadFixture(dir)
+		require.NoError(t, err)
+		f.PostStateRoots[1] = [32]byte{'a'}
+		d, err := Run(context.Background(), f)
+		require.NoError(t, err)
+		require.NotNil(t, d)
+		assert.Equal(t, 1, d.Index)
+		assert.Equal(t, primitives.Slot(2), d.Slot)
+		assert.Equal(t, "reference", d.Client)
+		assert.NoError(t, d.Err)
+		assert.NotEqual(t, d.Want, d.Got)
+	})
+	t.Run("rejected block", func(t *testing.T) {
+		f, err := LoadFixture(dir)
+		require.NoError(t, err)
+		f.Blocks = f.Blocks[1:]
+		f.PostStateRoots = f.PostStateRoots[1:]
+		d, err := Run(context.Background(), f)
+		require.NoError(t, err)
+		require.NotNil(t, d)
+		assert.Equal(t, 0, d.Index)
+		assert.NotNil(t, d.Err)
+	})
+}
+
+func TestReadMeta_RootsMismatch(t *testing.T) {
+	dir := t.TempDir()
+	writeTestFixture(t, dir, 1)
+	meta, err := ReadMeta(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "mainnet", meta.Config)
+	assert.Equal(t, 1, meta.BlocksCount)
+
+	st, _ := util.DeterministicGenesisState(t, 64)
+	err = WriteFixture(t.TempDir(), "mainnet", "reference", st, nil, [][32]byte{{}})
+	assert.ErrorContains(t, "0 blocks but 1 post state roots", err)
+}
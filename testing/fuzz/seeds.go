@@ -0,0 +1,43 @@
+// Package fuzz contains the shared helpers of the native go fuzz targets of the beacon chain, which seed their corpora
+// from the consensus spec tests and check that SSZ containers survive a round trip through their encoding.
+package fuzz
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// SpectestSeeds returns the serialized objects of the ssz_static spectests of the object, e.g. BeaconState, in the
+// fork, e.g. deneb, to seed the corpus of a fuzz target with. The spectest data is only available when the target runs
+// with the spectest data as a bazel dependency, otherwise no seeds are returned.
+func SpectestSeeds(tb testing.TB, config, fork, object string) [][]byte {
+	dir, err := bazel.Runfile(path.Join("tests", config, fork, "ssz_static", object))
+	if err != nil {
+		return nil
+	}
+	files, err := filepath.Glob(filepath.Join(dir, "*", "*", "serialized.ssz_snappy"))
+	require.NoError(tb, err)
+	seeds := make([][]byte, 0, len(files))
+	for _, f := range files {
+		enc, err := os.ReadFile(f) // #nosec G304
+		require.NoError(tb, err)
+		seed, err := snappy.Decode(nil /* dst */, enc)
+		require.NoError(tb, err)
+		seeds = append(seeds, seed)
+	}
+	return seeds
+}
+
+// AddSpectestSeeds adds the seeds of SpectestSeeds and the extra seeds to the corpus of the fuzz target. The extra
+// seeds keep the corpus useful when the spectest data is not available.
+func AddSpectestSeeds(f *testing.F, config, fork, object string, extra ...[]byte) {
+	for _, seed := range append(SpectestSeeds(f, config, fork, object), extra...) {
+		f.Add(seed)
+	}
+}
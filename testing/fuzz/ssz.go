@@ -0,0 +1,43 @@
+package fuzz
+
+import (
+	"bytes"
+	"testing"
+
+	fssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// SSZObject is an SSZ container with fastssz generated methods.
+type SSZObject interface {
+	fssz.Marshaler
+	fssz.Unmarshaler
+	fssz.HashRoot
+}
+
+// SSZRoundTrip unmarshals the data into a new object, and checks that the object survives a round trip through its
+// encoding: the encoding has the size of the object, and decodes to an object with the same encoding and hash tree
+// root. It returns false for data which is not the encoding of an object, as most fuzzed data is not.
+func SSZRoundTrip[T SSZObject](t *testing.T, data []byte, newObject func() T) (T, bool) {
+	obj := newObject()
+	if err := obj.UnmarshalSSZ(data); err != nil {
+		return obj, false
+	}
+	enc, err := obj.MarshalSSZ()
+	require.NoError(t, err)
+	require.Equal(t, obj.SizeSSZ(), len(enc))
+	root, err := obj.HashTreeRoot()
+	require.NoError(t, err)
+
+	decoded := newObject()
+	require.NoError(t, decoded.UnmarshalSSZ(enc))
+	reEnc, err := decoded.MarshalSSZ()
+	require.NoError(t, err)
+	if !bytes.Equal(enc, reEnc) {
+		t.Fatalf("Encoding changed in a round trip: %#x != %#x", enc, reEnc)
+	}
+	decodedRoot, err := decoded.HashTreeRoot()
+	require.NoError(t, err)
+	require.Equal(t, root, decodedRoot)
+	return obj, true
+}
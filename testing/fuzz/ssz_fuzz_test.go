@@ -0,0 +1,127 @@
+package fuzz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
+	state_native "github.com/prysmaticlabs/prysm/v5/beacon-chain/state/state-native"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/fuzz"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+const config = "mainnet"
+
+// fuzzSSZ fuzzes the round trip of the object through its encoding, with the seeds of the spectests of the object in
+// the fork. The check runs additional checks on the objects which decode.
+func fuzzSSZ[T fuzz.SSZObject](f *testing.F, fork, object string, seed interface{ MarshalSSZ() ([]byte, error) }, newObject func() T, check func(t *testing.T, obj T)) {
+	enc, err := seed.MarshalSSZ()
+	require.NoError(f, err)
+	fuzz.AddSpectestSeeds(f, config, fork, object, enc)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		obj, ok := fuzz.SSZRoundTrip(t, data, newObject)
+		if ok && check != nil {
+			check(t, obj)
+		}
+	})
+}
+
+// nativeStateRoot checks that the hash tree root of the native state of the state proto is the hash tree root of the
+// state proto.
+func nativeStateRoot[T fuzz.SSZObject](initialize func(T) (state.BeaconState, error)) func(t *testing.T, obj T) {
+	return func(t *testing.T, obj T) {
+		want, err := obj.HashTreeRoot()
+		require.NoError(t, err)
+		st, err := initialize(obj)
+		require.NoError(t, err)
+		got, err := st.HashTreeRoot(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
+
+func FuzzSignedBeaconBlockSSZ_Phase0(f *testing.F) {
+	fuzzSSZ(f, "phase0", "SignedBeaconBlock", util.NewBeaconBlock(), func() *ethpb.SignedBeaconBlock { return &ethpb.SignedBeaconBlock{} }, nil)
+}
+
+func FuzzSignedBeaconBlockSSZ_Altair(f *testing.F) {
+	fuzzSSZ(f, "altair", "SignedBeaconBlock", util.NewBeaconBlockAltair(), func() *ethpb.SignedBeaconBlockAltair { return &ethpb.SignedBeaconBlockAltair{} }, nil)
+}
+
+func FuzzSignedBeaconBlockSSZ_Bellatrix(f *testing.F) {
+	fuzzSSZ(f, "bellatrix", "SignedBeaconBlock", util.NewBeaconBlockBellatrix(), func() *ethpb.SignedBeaconBlockBellatrix { return &ethpb.SignedBeaconBlockBellatrix{} }, nil)
+}
+
+func FuzzSignedBeaconBlockSSZ_Capella(f *testing.F) {
+	fuzzSSZ(f, "capella", "SignedBeaconBlock", util.NewBeaconBlockCapella(), func() *ethpb.SignedBeaconBlockCapella { return &ethpb.SignedBeaconBlockCapella{} }, nil)
+}
+
+func FuzzSignedBeaconBlockSSZ_Deneb(f *testing.F) {
+	fuzzSSZ(f, "deneb", "SignedBeaconBlock", util.NewBeaconBlockDeneb(), func() *ethpb.SignedBeaconBlockDeneb { return &ethpb.SignedBeaconBlockDeneb{} }, nil)
+}
+
+func FuzzSignedBeaconBlockSSZ_Electra(f *testing.F) {
+	fuzzSSZ(f, "electra", "SignedBeaconBlock", util.NewBeaconBlockElectra(), func() *ethpb.SignedBeaconBlockElectra { return &ethpb.SignedBeaconBlockElectra{} }, nil)
+}
+
+func FuzzBeaconStateSSZ_Phase0(f *testing.F) {
+	seed, err := util.NewBeaconState()
+	require.NoError(f, err)
+	fuzzSSZ(f, "phase0", "BeaconState", seed, func() *ethpb.BeaconState { return &ethpb.BeaconState{} },
+		nativeStateRoot(state_native.InitializeFromProtoPhase0))
+}
+
+func FuzzBeaconStateSSZ_Altair(f *testing.F) {
+	seed, err := util.NewBeaconStateAltair()
+	require.NoError(f, err)
+	fuzzSSZ(f, "altair", "BeaconState", seed, func() *ethpb.BeaconStateAltair { return &ethpb.BeaconStateAltair{} },
+		nativeStateRoot(state_native.InitializeFromProtoAltair))
+}
+
+func FuzzBeaconStateSSZ_Bellatrix(f *testing.F) {
+	seed, err := util.NewBeaconStateBellatrix()
+	require.NoError(f, err)
+	fuzzSSZ(f, "bellatrix", "BeaconState", seed, func() *ethpb.BeaconStateBellatrix { return &ethpb.BeaconStateBellatrix{} },
+		nativeStateRoot(state_native.InitializeFromProtoBellatrix))
+}
+
+func FuzzBeaconStateSSZ_Capella(f *testing.F) {
+	seed, err := util.NewBeaconStateCapella()
+	require.NoError(f, err)
+	fuzzSSZ(f, "capella", "BeaconState", seed, func() *ethpb.BeaconStateCapella { return &ethpb.BeaconStateCapella{} },
+		nativeStateRoot(state_native.InitializeFromProtoCapella))
+}
+
+func FuzzBeaconStateSSZ_Deneb(f *testing.F) {
+	seed, err := util.NewBeaconStateDeneb()
+	require.NoError(f, err)
+	fuzzSSZ(f, "deneb", "BeaconState", seed, func() *ethpb.BeaconStateDeneb { return &ethpb.BeaconStateDeneb{} },
+		nativeStateRoot(state_native.InitializeFromProtoDeneb))
+}
+
+func FuzzBeaconStateSSZ_Electra(f *testing.F) {
+	seed, err := util.NewBeaconStateElectra()
+	require.NoError(f, err)
+	fuzzSSZ(f, "electra", "BeaconState", seed, func() *ethpb.BeaconStateElectra { return &ethpb.BeaconStateElectra{} },
+		nativeStateRoot(state_native.InitializeFromProtoElectra))
+}
+
+func FuzzBlobSidecarSSZ(f *testing.F) {
+	seed := &ethpb.BlobSidecar{
+		Blob:          make([]byte, fieldparams.BlobLength),
+		KzgCommitment: make([]byte, fieldparams.BLSPubkeyLength),
+		KzgProof:      make([]byte, fieldparams.BLSPubkeyLength),
+		SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header:    util.HydrateBeaconHeader(&ethpb.BeaconBlockHeader{}),
+			Signature: make([]byte, fieldparams.BLSSignatureLength),
+		},
+		CommitmentInclusionProof: make([][]byte, fieldparams.KzgCommitmentInclusionProofDepth),
+	}
+	for i := range seed.CommitmentInclusionProof {
+		seed.CommitmentInclusionProof[i] = make([]byte, fieldparams.RootLength)
+	}
+	fuzzSSZ(f, "deneb", "BlobSidecar", seed, func() *ethpb.BlobSidecar { return &ethpb.BlobSidecar{} }, nil)
+}
@@ -0,0 +1,495 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 (interfaces: BeaconChainStream_StreamBeaconBlocksServer,BeaconChainStream_StreamAttestationsServer,BeaconChainStream_StreamBalanceChangesServer,BeaconChainStream_StreamValidatorRegistryChangesServer)
+//
+// Generated by this command:
+//
+//	mockgen -package=mock -destination=testing/mock/beacon_chain_stream_server_mock.go github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1 BeaconChainStream_StreamBeaconBlocksServer,BeaconChainStream_StreamAttestationsServer,BeaconChainStream_StreamBalanceChangesServer,BeaconChainStream_StreamValidatorRegistryChangesServer
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	gomock "go.uber.org/mock/gomock"
+	metadata "google.golang.org/grpc/metadata"
+)
+
+// MockBeaconChainStream_StreamBeaconBlocksServer is a mock of BeaconChainStream_StreamBeaconBlocksServer interface.
+type MockBeaconChainStream_StreamBeaconBlocksServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder
+}
+
+// MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder is the mock recorder for MockBeaconChainStream_StreamBeaconBlocksServer.
+type MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder struct {
+	mock *MockBeaconChainStream_StreamBeaconBlocksServer
+}
+
+// NewMockBeaconChainStream_StreamBeaconBlocksServer creates a new mock instance.
+func NewMockBeaconChainStream_StreamBeaconBlocksServer(ctrl *gomock.Controller) *MockBeaconChainStream_StreamBeaconBlocksServer {
+	mock := &MockBeaconChainStream_StreamBeaconBlocksServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) EXPECT() *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) Send(arg0 *eth.StreamBeaconBlocksResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconChainStream_StreamBeaconBlocksServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconChainStream_StreamBeaconBlocksServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChainStream_StreamBeaconBlocksServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconChainStream_StreamAttestationsServer is a mock of BeaconChainStream_StreamAttestationsServer interface.
+type MockBeaconChainStream_StreamAttestationsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChainStream_StreamAttestationsServerMockRecorder
+}
+
+// MockBeaconChainStream_StreamAttestationsServerMockRecorder is the mock recorder for MockBeaconChainStream_StreamAttestationsServer.
+type MockBeaconChainStream_StreamAttestationsServerMockRecorder struct {
+	mock *MockBeaconChainStream_StreamAttestationsServer
+}
+
+// NewMockBeaconChainStream_StreamAttestationsServer creates a new mock instance.
+func NewMockBeaconChainStream_StreamAttestationsServer(ctrl *gomock.Controller) *MockBeaconChainStream_StreamAttestationsServer {
+	mock := &MockBeaconChainStream_StreamAttestationsServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChainStream_StreamAttestationsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChainStream_StreamAttestationsServer) EXPECT() *MockBeaconChainStream_StreamAttestationsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) Send(arg0 *eth.StreamAttestationsResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconChainStream_StreamAttestationsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconChainStream_StreamAttestationsServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChainStream_StreamAttestationsServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconChainStream_StreamBalanceChangesServer is a mock of BeaconChainStream_StreamBalanceChangesServer interface.
+type MockBeaconChainStream_StreamBalanceChangesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder
+}
+
+// MockBeaconChainStream_StreamBalanceChangesServerMockRecorder is the mock recorder for MockBeaconChainStream_StreamBalanceChangesServer.
+type MockBeaconChainStream_StreamBalanceChangesServerMockRecorder struct {
+	mock *MockBeaconChainStream_StreamBalanceChangesServer
+}
+
+// NewMockBeaconChainStream_StreamBalanceChangesServer creates a new mock instance.
+func NewMockBeaconChainStream_StreamBalanceChangesServer(ctrl *gomock.Controller) *MockBeaconChainStream_StreamBalanceChangesServer {
+	mock := &MockBeaconChainStream_StreamBalanceChangesServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChainStream_StreamBalanceChangesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) EXPECT() *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) Send(arg0 *eth.StreamBalanceChangesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconChainStream_StreamBalanceChangesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconChainStream_StreamBalanceChangesServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChainStream_StreamBalanceChangesServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconChainStream_StreamValidatorRegistryChangesServer is a mock of BeaconChainStream_StreamValidatorRegistryChangesServer interface.
+type MockBeaconChainStream_StreamValidatorRegistryChangesServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder
+}
+
+// MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder is the mock recorder for MockBeaconChainStream_StreamValidatorRegistryChangesServer.
+type MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder struct {
+	mock *MockBeaconChainStream_StreamValidatorRegistryChangesServer
+}
+
+// NewMockBeaconChainStream_StreamValidatorRegistryChangesServer creates a new mock instance.
+func NewMockBeaconChainStream_StreamValidatorRegistryChangesServer(ctrl *gomock.Controller) *MockBeaconChainStream_StreamValidatorRegistryChangesServer {
+	mock := &MockBeaconChainStream_StreamValidatorRegistryChangesServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) EXPECT() *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) RecvMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) RecvMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) Send(arg0 *eth.StreamValidatorRegistryChangesResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) SendMsg(arg0 any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) SendMsg(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockBeaconChainStream_StreamValidatorRegistryChangesServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockBeaconChainStream_StreamValidatorRegistryChangesServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconChainStream_StreamValidatorRegistryChangesServer)(nil).SetTrailer), arg0)
+}
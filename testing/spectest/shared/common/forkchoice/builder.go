@@ -9,6 +9,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/startup"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/state"
@@ -22,18 +24,21 @@ import (
 )
 
 type Builder struct {
-	service  *blockchain.Service
-	lastTick int64
-	execMock *engineMock
-	vwait    *verification.InitializerWaiter
+	service     *blockchain.Service
+	lastTick    int64
+	execMock    *engineMock
+	trackedVals *cache.TrackedValidatorsCache
+	vwait       *verification.InitializerWaiter
 }
 
 func NewBuilder(t testing.TB, initialState state.BeaconState, initialBlock interfaces.ReadOnlySignedBeaconBlock) *Builder {
 	execMock := &engineMock{
-		powBlocks: make(map[[32]byte]*ethpb.PowBlock),
+		powBlocks:       make(map[[32]byte]*ethpb.PowBlock),
+		payloadStatuses: make(map[[32]byte]payloadStatus),
 	}
+	trackedVals := cache.NewTrackedValidatorsCache()
 	cw := startup.NewClockSynchronizer()
-	service, sg, fc := startChainService(t, initialState, initialBlock, execMock, cw)
+	service, sg, fc := startChainService(t, initialState, initialBlock, execMock, trackedVals, cw)
 	// blob spectests use a weird Fork in the genesis beacon state that has different previous and current versions.
 	// This trips up the lite fork lookup code in the blob verifier that figures out the fork
 	// based on the slot of the block. So just for spectests we override that behavior and get the fork from the state
@@ -43,9 +48,10 @@ func NewBuilder(t testing.TB, initialState state.BeaconState, initialBlock inter
 	}
 	bvw := verification.NewInitializerWaiter(cw, fc, sg, verification.WithForkLookup(getFork))
 	return &Builder{
-		service:  service,
-		execMock: execMock,
-		vwait:    bvw,
+		service:     service,
+		execMock:    execMock,
+		trackedVals: trackedVals,
+		vwait:       bvw,
 	}
 }
 
@@ -65,28 +71,38 @@ func (bb *Builder) Tick(t testing.TB, tick int64) {
 	bb.lastTick = tick
 }
 
-// SetPayloadStatus sets the payload status that the engine will return
-func (bb *Builder) SetPayloadStatus(resp *MockEngineResp) error {
+// SetPayloadStatus sets the payload status that the engine will return for the payload with the block hash, as
+// in the on_payload_info step. Without a block hash, the status is returned for all the payloads without a status
+// of their own.
+func (bb *Builder) SetPayloadStatus(blockHash *string, resp *MockEngineResp) error {
 	if resp == nil {
 		return errors.New("invalid nil payload status")
 	}
+	var status payloadStatus
 	if resp.LatestValidHash == nil {
-		bb.execMock.latestValidHash = common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000000")
+		status.latestValidHash = common.FromHex("0x0000000000000000000000000000000000000000000000000000000000000000")
 	} else {
-		bb.execMock.latestValidHash = common.FromHex(*resp.LatestValidHash)
+		status.latestValidHash = common.FromHex(*resp.LatestValidHash)
 	}
 	if resp.Status == nil {
 		return errors.New("invalid nil status")
 	}
 	switch *resp.Status {
-	case "SYNCING":
-		bb.execMock.payloadStatus = execution.ErrAcceptedSyncingPayloadStatus
+	case "SYNCING", "ACCEPTED":
+		status.err = execution.ErrAcceptedSyncingPayloadStatus
 	case "VALID":
-		bb.execMock.payloadStatus = nil
+		status.err = nil
 	case "INVALID":
-		bb.execMock.payloadStatus = execution.ErrInvalidPayloadStatus
+		status.err = execution.ErrInvalidPayloadStatus
+	case "INVALID_BLOCK_HASH":
+		status.err = execution.ErrInvalidBlockHashPayloadStatus
 	default:
-		return errors.New("unknown payload status")
+		return fmt.Errorf("unknown payload status %s", *resp.Status)
+	}
+	if blockHash == nil {
+		bb.execMock.payloadStatus = status
+	} else {
+		bb.execMock.payloadStatuses[bytesutil.ToBytes32(common.FromHex(*blockHash))] = status
 	}
 	return nil
 }
@@ -169,9 +185,25 @@ func (bb *Builder) Check(t testing.TB, c *Check) {
 		got := fmt.Sprintf("%#x", bb.service.GetProposerHead())
 		require.DeepEqual(t, want, got)
 	}
-	/* TODO: We need to mock the entire proposer system to be able to test this.
 	if c.ShouldOverrideFCU != nil {
-		require.DeepEqual(t, c.ShouldOverrideFCU.Result, bb.service.ShouldOverrideFCU())
+		bb.connectProposer(t, c.ShouldOverrideFCU.ValidatorConnected)
+		got, err := bb.service.ShouldOverrideFCU(ctx)
+		require.NoError(t, err)
+		require.Equal(t, c.ShouldOverrideFCU.Result, got)
+	}
+}
+
+// connectProposer tracks the proposer of the next slot as a validator of the node if it is connected, the way the
+// validator client registers it via the prepare_beacon_proposer endpoint.
+func (bb *Builder) connectProposer(t testing.TB, connected bool) {
+	bb.trackedVals.Prune()
+	if !connected {
+		return
 	}
-	*/
+	ctx := context.TODO()
+	st, err := bb.service.HeadStateReadOnly(ctx)
+	require.NoError(t, err)
+	proposer, err := helpers.BeaconProposerIndexAtSlot(ctx, st, bb.service.CurrentSlot()+1)
+	require.NoError(t, err)
+	bb.trackedVals.Set(cache.TrackedValidator{Active: true, Index: proposer, Source: cache.PrepareProposerSource})
 }
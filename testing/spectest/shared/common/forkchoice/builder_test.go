@@ -3,6 +3,10 @@ package forkchoice
 import (
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/execution"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
@@ -42,3 +46,50 @@ func TestPoWBlock(t *testing.T) {
 
 	require.Equal(t, 1, len(builder.execMock.powBlocks))
 }
+
+func TestSetPayloadStatus(t *testing.T) {
+	st, err := util.NewBeaconState()
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	builder := NewBuilder(t, st, blk)
+
+	syncing, invalid, blockHash, lvh := "SYNCING", "INVALID", "0x01", "0x02"
+	require.NoError(t, builder.SetPayloadStatus(nil, &MockEngineResp{Status: &syncing}))
+	require.NoError(t, builder.SetPayloadStatus(&blockHash, &MockEngineResp{Status: &invalid, LatestValidHash: &lvh}))
+
+	got, err := builder.execMock.status(common.FromHex(blockHash))
+	require.ErrorIs(t, err, execution.ErrInvalidPayloadStatus)
+	require.DeepEqual(t, common.FromHex(lvh), got)
+	_, err = builder.execMock.status([]byte{0x03})
+	require.ErrorIs(t, err, execution.ErrAcceptedSyncingPayloadStatus)
+
+	unknown := "UNKNOWN"
+	require.ErrorContains(t, "unknown payload status", builder.SetPayloadStatus(nil, &MockEngineResp{Status: &unknown}))
+}
+
+func TestBuilderShouldOverrideFCU(t *testing.T) {
+	st, err := util.NewBeaconState(func(st *ethpb.BeaconState) error {
+		for i := 0; i < 64; i++ {
+			st.Validators = append(st.Validators, &ethpb.Validator{
+				PublicKey:             make([]byte, fieldparams.BLSPubkeyLength),
+				WithdrawalCredentials: make([]byte, 32),
+				EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+				ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+				WithdrawableEpoch:     params.BeaconConfig().FarFutureEpoch,
+			})
+			st.Balances = append(st.Balances, params.BeaconConfig().MaxEffectiveBalance)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	blk, err := blocks.NewSignedBeaconBlock(util.NewBeaconBlock())
+	require.NoError(t, err)
+	builder := NewBuilder(t, st, blk)
+	builder.Tick(t, 0)
+
+	builder.Check(t, &Check{ShouldOverrideFCU: &ShouldOverride{ValidatorConnected: true, Result: false}})
+	require.Equal(t, true, builder.trackedVals.Validating())
+	builder.Check(t, &Check{ShouldOverrideFCU: &ShouldOverride{ValidatorConnected: false, Result: false}})
+	require.Equal(t, false, builder.trackedVals.Validating())
+}
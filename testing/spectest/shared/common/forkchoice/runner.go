@@ -154,7 +154,7 @@ func runTest(t *testing.T, config string, fork int, basePath string) { // nolint
 						builder.Attestation(t, att)
 					}
 					if step.PayloadStatus != nil {
-						require.NoError(t, builder.SetPayloadStatus(step.PayloadStatus))
+						require.NoError(t, builder.SetPayloadStatus(step.BlockHash, step.PayloadStatus))
 					}
 					if step.PowBlock != nil {
 						powBlockFile, err := util.BazelFileBytes(testsFolderPath, folder.Name(), fmt.Sprint(*step.PowBlock, ".ssz_snappy"))
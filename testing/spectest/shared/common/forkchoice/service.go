@@ -36,6 +36,7 @@ func startChainService(t testing.TB,
 	st state.BeaconState,
 	block interfaces.ReadOnlySignedBeaconBlock,
 	engineMock *engineMock,
+	trackedVals *cache.TrackedValidatorsCache,
 	clockSync *startup.ClockSynchronizer,
 ) (*blockchain.Service, *stategen.State, forkchoice.ForkChoicer) {
 	ctx := context.Background()
@@ -72,7 +73,7 @@ func startChainService(t testing.TB,
 		blockchain.WithStateNotifier(&mock.MockStateNotifier{}),
 		blockchain.WithAttestationPool(attestations.NewPool()),
 		blockchain.WithDepositCache(depositCache),
-		blockchain.WithTrackedValidatorsCache(cache.NewTrackedValidatorsCache()),
+		blockchain.WithTrackedValidatorsCache(trackedVals),
 		blockchain.WithPayloadIDCache(cache.NewPayloadIDCache()),
 		blockchain.WithClockSynchronizer(clockSync),
 		blockchain.WithBlobStorage(filesystem.NewEphemeralBlobStorage(t)),
@@ -87,10 +88,26 @@ func startChainService(t testing.TB,
 	return service, sg, fc
 }
 
-type engineMock struct {
-	powBlocks       map[[32]byte]*ethpb.PowBlock
+// payloadStatus is the response of the engine to a payload.
+type payloadStatus struct {
 	latestValidHash []byte
-	payloadStatus   error
+	err             error
+}
+
+type engineMock struct {
+	powBlocks map[[32]byte]*ethpb.PowBlock
+	// payloadStatus is returned for the payloads without a status of their own in payloadStatuses.
+	payloadStatus   payloadStatus
+	payloadStatuses map[[32]byte]payloadStatus
+}
+
+// status returns the response of the engine to the payload with the block hash.
+func (m *engineMock) status(blockHash []byte) ([]byte, error) {
+	status, ok := m.payloadStatuses[bytesutil.ToBytes32(blockHash)]
+	if !ok {
+		status = m.payloadStatus
+	}
+	return status.latestValidHash, status.err
 }
 
 func (m *engineMock) GetPayload(context.Context, [8]byte, primitives.Slot) (*blocks.GetPayloadResponse, error) {
@@ -99,16 +116,22 @@ func (m *engineMock) GetPayload(context.Context, [8]byte, primitives.Slot) (*blo
 func (m *engineMock) GetPayloadV2(context.Context, [8]byte) (*pb.ExecutionPayloadCapella, error) {
 	return nil, nil
 }
-func (m *engineMock) ForkchoiceUpdated(context.Context, *pb.ForkchoiceState, payloadattribute.Attributer) (*pb.PayloadIDBytes, []byte, error) {
-	return nil, m.latestValidHash, m.payloadStatus
+func (m *engineMock) ForkchoiceUpdated(_ context.Context, state *pb.ForkchoiceState, _ payloadattribute.Attributer) (*pb.PayloadIDBytes, []byte, error) {
+	lvh, err := m.status(state.HeadBlockHash)
+	return nil, lvh, err
+}
+
+func (m *engineMock) SetGasLimitTarget(context.Context, uint64) error {
+	return nil
 }
 
-func (m *engineMock) NewPayload(context.Context, interfaces.ExecutionData, []common.Hash, *common.Hash, *pb.ExecutionRequests) ([]byte, error) {
-	return m.latestValidHash, m.payloadStatus
+func (m *engineMock) NewPayload(_ context.Context, payload interfaces.ExecutionData, _ []common.Hash, _ *common.Hash, _ *pb.ExecutionRequests) ([]byte, error) {
+	return m.status(payload.BlockHash())
 }
 
-func (m *engineMock) ForkchoiceUpdatedV2(context.Context, *pb.ForkchoiceState, payloadattribute.Attributer) (*pb.PayloadIDBytes, []byte, error) {
-	return nil, m.latestValidHash, m.payloadStatus
+func (m *engineMock) ForkchoiceUpdatedV2(_ context.Context, state *pb.ForkchoiceState, _ payloadattribute.Attributer) (*pb.PayloadIDBytes, []byte, error) {
+	lvh, err := m.status(state.HeadBlockHash)
+	return nil, lvh, err
 }
 
 func (m *engineMock) LatestExecutionBlock(context.Context) (*pb.ExecutionBlock, error) {
@@ -8,6 +8,7 @@ type Step struct {
 	Valid            *bool           `json:"valid"`
 	Attestation      *string         `json:"attestation"`
 	AttesterSlashing *string         `json:"attester_slashing"`
+	BlockHash        *string         `json:"block_hash"`
 	PayloadStatus    *MockEngineResp `json:"payload_status"`
 	PowBlock         *string         `json:"pow_block"`
 	Check            *Check          `json:"checks"`
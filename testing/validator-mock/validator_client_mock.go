@@ -306,6 +306,21 @@ func (mr *MockValidatorClientMockRecorder) StartEventStream(arg0, arg1, arg2 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartEventStream", reflect.TypeOf((*MockValidatorClient)(nil).StartEventStream), arg0, arg1, arg2)
 }
 
+// StreamDuties mocks base method.
+func (m *MockValidatorClient) StreamDuties(arg0 context.Context, arg1 *eth.DutiesRequest) (eth.BeaconNodeValidator_StreamDutiesClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamDuties", arg0, arg1)
+	ret0, _ := ret[0].(eth.BeaconNodeValidator_StreamDutiesClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamDuties indicates an expected call of StreamDuties.
+func (mr *MockValidatorClientMockRecorder) StreamDuties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamDuties", reflect.TypeOf((*MockValidatorClient)(nil).StreamDuties), arg0, arg1)
+}
+
 // SubmitAggregateSelectionProof mocks base method.
 func (m *MockValidatorClient) SubmitAggregateSelectionProof(arg0 context.Context, arg1 *eth.AggregateSelectionRequest, arg2 primitives.ValidatorIndex, arg3 uint64) (*eth.AggregateSelectionResponse, error) {
 	m.ctrl.T.Helper()
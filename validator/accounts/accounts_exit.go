@@ -7,20 +7,27 @@ import (
 	"fmt"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/client/beacon"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/blocks"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
 	beacon_api "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // PerformExitCfg for account voluntary exits.
@@ -31,6 +38,15 @@ type PerformExitCfg struct {
 	RawPubKeys       [][]byte
 	FormattedPubKeys []string
 	OutputDirectory  string
+	// ExitsPerEpoch is the number of voluntary exits broadcast per epoch, the remaining ones being broadcast in the
+	// following epochs. All voluntary exits are broadcast at once when 0.
+	ExitsPerEpoch uint64
+	// ExitEpoch is the epoch from which the voluntary exits written to OutputDirectory are valid, for exits
+	// pre-signed for cold storage. The current epoch is used when nil.
+	ExitEpoch *primitives.Epoch
+	// VaultPassword encrypts the voluntary exits written to OutputDirectory into an exit vault, instead of writing
+	// them as individual unencrypted files, when not empty.
+	VaultPassword string
 }
 
 // Exit performs a voluntary exit on one or more accounts.
@@ -59,13 +75,24 @@ func (acm *CLIManager) Exit(ctx context.Context) error {
 		return errors.New("could not perform exit: beacon node is syncing.")
 	}
 
+	// Withdrawal credentials are changed before the exits, so that the balances of the exited validators are
+	// withdrawn to the execution addresses.
+	if acm.blsToExecutionChangesPath != "" {
+		if err := submitBLSToExecutionChanges(ctx, acm.beaconApiEndpoint, acm.blsToExecutionChangesPath); err != nil {
+			return err
+		}
+	}
+
 	cfg := PerformExitCfg{
-		*validatorClient,
-		*nodeClient,
-		acm.keymanager,
-		acm.rawPubKeys,
-		acm.formattedPubKeys,
-		acm.exitJSONOutputPath,
+		ValidatorClient:  *validatorClient,
+		NodeClient:       *nodeClient,
+		Keymanager:       acm.keymanager,
+		RawPubKeys:       acm.rawPubKeys,
+		FormattedPubKeys: acm.formattedPubKeys,
+		OutputDirectory:  acm.exitJSONOutputPath,
+		ExitsPerEpoch:    acm.exitsPerEpoch,
+		ExitEpoch:        acm.exitEpoch,
+		VaultPassword:    acm.exitVaultPassword,
 	}
 	rawExitedKeys, trimmedExitedKeys, err := PerformVoluntaryExit(ctx, cfg)
 	if err != nil {
@@ -81,11 +108,17 @@ func PerformVoluntaryExit(
 	ctx context.Context, cfg PerformExitCfg,
 ) (rawExitedKeys [][]byte, formattedExitedKeys []string, err error) {
 	var rawNotExitedKeys [][]byte
+	var vaultExits []*eth.SignedVoluntaryExit
 	genesisResponse, err := cfg.NodeClient.Genesis(ctx, &emptypb.Empty{})
 	if err != nil {
 		log.WithError(err).Errorf("voluntary exit failed: %v", err)
 	}
 	for i, key := range cfg.RawPubKeys {
+		if len(cfg.OutputDirectory) == 0 && cfg.ExitsPerEpoch > 0 && i > 0 && uint64(i)%cfg.ExitsPerEpoch == 0 {
+			if err := waitForNextEpoch(ctx, genesisResponse.GenesisTime); err != nil {
+				return nil, nil, err
+			}
+		}
 		// When output directory is present, only create the signed exit, but do not propose it.
 		// Otherwise, propose the exit immediately.
 		epoch, err := client.CurrentEpoch(genesisResponse.GenesisTime)
@@ -93,6 +126,9 @@ func PerformVoluntaryExit(
 			log.WithError(err).Errorf("voluntary exit failed: %v", err)
 		}
 		if len(cfg.OutputDirectory) > 0 {
+			if cfg.ExitEpoch != nil {
+				epoch = *cfg.ExitEpoch
+			}
 			sve, err := client.CreateSignedVoluntaryExit(ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, key, epoch)
 			if err != nil {
 				rawNotExitedKeys = append(rawNotExitedKeys, key)
@@ -103,6 +139,8 @@ func PerformVoluntaryExit(
 				} else {
 					log.WithError(err).Errorf("voluntary exit failed for account %s", cfg.FormattedPubKeys[i])
 				}
+			} else if len(cfg.VaultPassword) > 0 {
+				vaultExits = append(vaultExits, sve)
 			} else if err := writeSignedVoluntaryExitJSON(sve, cfg.OutputDirectory); err != nil {
 				log.WithError(err).Error("failed to write voluntary exit")
 			}
@@ -119,6 +157,12 @@ func PerformVoluntaryExit(
 		}
 	}
 
+	if len(vaultExits) > 0 {
+		if err := writeExitVault(vaultExits, cfg.OutputDirectory, cfg.VaultPassword); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	rawExitedKeys = make([][]byte, 0)
 	formattedExitedKeys = make([]string, 0)
 	for i, key := range cfg.RawPubKeys {
@@ -138,6 +182,78 @@ func PerformVoluntaryExit(
 	return rawExitedKeys, formattedExitedKeys, nil
 }
 
+// waitForNextEpoch waits until the start of the epoch following the current one.
+func waitForNextEpoch(ctx context.Context, genesisTime *timestamppb.Timestamp) error {
+	epoch, err := client.CurrentEpoch(genesisTime)
+	if err != nil {
+		return err
+	}
+	startSlot, err := slots.EpochStart(epoch + 1)
+	if err != nil {
+		return err
+	}
+	startTime, err := slots.ToTime(uint64(genesisTime.Seconds), startSlot)
+	if err != nil {
+		return err
+	}
+	log.WithField("epoch", epoch+1).Info("Waiting for the next epoch to broadcast the next voluntary exits")
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(prysmTime.Until(startTime)):
+		return nil
+	}
+}
+
+// submitBLSToExecutionChanges broadcasts the signed BLS to execution changes of a JSON file, as written by the
+// staking deposit CLI, through the beacon node REST API.
+func submitBLSToExecutionChanges(ctx context.Context, beaconApiEndpoint, changesPath string) error {
+	changes, err := readBLSToExecutionChanges(changesPath)
+	if err != nil {
+		return err
+	}
+	c, err := beacon.NewClient(beaconApiEndpoint)
+	if err != nil {
+		return errors.Wrap(err, "could not create beacon API client")
+	}
+	if err := c.SubmitChangeBLStoExecution(ctx, changes); err != nil {
+		return errors.Wrap(err, "could not submit BLS to execution changes")
+	}
+	log.WithField("count", len(changes)).Info("Submitted BLS to execution changes")
+	return nil
+}
+
+func readBLSToExecutionChanges(changesPath string) ([]*structs.SignedBLSToExecutionChange, error) {
+	b, err := file.ReadFileAsBytes(changesPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read BLS to execution changes")
+	}
+	var changes []*structs.SignedBLSToExecutionChange
+	if err := json.Unmarshal(b, &changes); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal BLS to execution changes")
+	}
+	if len(changes) == 0 {
+		return nil, errors.New("no BLS to execution changes")
+	}
+	// The staking deposit CLI writes hex values without the 0x prefix.
+	for _, c := range changes {
+		if c.Message == nil {
+			return nil, errors.New("BLS to execution change without message")
+		}
+		c.Message.FromBLSPubkey = with0x(c.Message.FromBLSPubkey)
+		c.Message.ToExecutionAddress = with0x(c.Message.ToExecutionAddress)
+		c.Signature = with0x(c.Signature)
+	}
+	return changes, nil
+}
+
+func with0x(s string) string {
+	if strings.HasPrefix(s, "0x") {
+		return s
+	}
+	return "0x" + s
+}
+
 func prepareAllKeys(validatingKeys [][fieldparams.BLSPubkeyLength]byte) (raw [][]byte, formatted []string) {
 	raw = make([][]byte, len(validatingKeys))
 	formatted = make([]string, len(validatingKeys))
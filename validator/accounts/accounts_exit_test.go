@@ -1,21 +1,33 @@
 package accounts
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	"github.com/prysmaticlabs/prysm/v5/build/bazel"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	blsmock "github.com/prysmaticlabs/prysm/v5/crypto/bls/common/mock"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/testing/assert"
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/sirupsen/logrus/hooks/test"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestDisplayExitInfo(t *testing.T) {
@@ -73,3 +85,110 @@ func TestWriteSignedVoluntaryExitJSON(t *testing.T) {
 	require.Equal(t, fmt.Sprintf("%d", sve.Exit.ValidatorIndex), svej.Message.ValidatorIndex)
 	require.Equal(t, "0x0102", svej.Signature)
 }
+
+// exitSigner is a keymanager signing with a fixed signature.
+type exitSigner struct {
+	keymanager.IKeymanager
+	sig bls.Signature
+}
+
+func (s *exitSigner) Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error) {
+	return s.sig, nil
+}
+
+func TestPerformVoluntaryExit_ExitsPerEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+	sig := blsmock.NewMockSignature(ctrl)
+	sig.EXPECT().Marshal().Return(make([]byte, fieldparams.BLSSignatureLength)).AnyTimes()
+
+	// The current epoch ends in one to two seconds.
+	epochDuration := uint64(params.BeaconConfig().SlotsPerEpoch) * params.BeaconConfig().SecondsPerSlot
+	genesisTime := &timestamppb.Timestamp{Seconds: time.Now().Unix() - int64(epochDuration) + 2}
+	nodeClient.EXPECT().Genesis(gomock.Any(), gomock.Any()).Return(&eth.Genesis{GenesisTime: genesisTime}, nil)
+	validatorClient.EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).Return(&eth.ValidatorIndexResponse{Index: 1}, nil).Times(3)
+	validatorClient.EXPECT().DomainData(gomock.Any(), gomock.Any()).Return(&eth.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).Times(3)
+	var exitEpochs []primitives.Epoch
+	validatorClient.EXPECT().ProposeExit(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, exit *eth.SignedVoluntaryExit) (*eth.ProposeExitResponse, error) {
+			exitEpochs = append(exitEpochs, exit.Exit.Epoch)
+			return &eth.ProposeExitResponse{}, nil
+		}).Times(3)
+
+	keys := [][]byte{{1}, {2}, {3}}
+	rawExitedKeys, _, err := PerformVoluntaryExit(context.Background(), PerformExitCfg{
+		ValidatorClient:  validatorClient,
+		NodeClient:       nodeClient,
+		Keymanager:       &exitSigner{sig: sig},
+		RawPubKeys:       keys,
+		FormattedPubKeys: []string{"0x01", "0x02", "0x03"},
+		ExitsPerEpoch:    2,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, keys, rawExitedKeys)
+	assert.DeepEqual(t, []primitives.Epoch{0, 0, 1}, exitEpochs)
+}
+
+func TestPerformVoluntaryExit_ExitEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	nodeClient := validatormock.NewMockNodeClient(ctrl)
+	sig := blsmock.NewMockSignature(ctrl)
+	sig.EXPECT().Marshal().Return(make([]byte, fieldparams.BLSSignatureLength)).AnyTimes()
+
+	nodeClient.EXPECT().Genesis(gomock.Any(), gomock.Any()).Return(&eth.Genesis{GenesisTime: timestamppb.Now()}, nil)
+	validatorClient.EXPECT().ValidatorIndex(gomock.Any(), gomock.Any()).Return(&eth.ValidatorIndexResponse{Index: 2}, nil)
+	validatorClient.EXPECT().DomainData(gomock.Any(), &eth.DomainRequest{
+		Epoch:  1000,
+		Domain: params.BeaconConfig().DomainVoluntaryExit[:],
+	}).Return(&eth.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	output := t.TempDir()
+	exitEpoch := primitives.Epoch(1000)
+	_, _, err := PerformVoluntaryExit(context.Background(), PerformExitCfg{
+		ValidatorClient:  validatorClient,
+		NodeClient:       nodeClient,
+		Keymanager:       &exitSigner{sig: sig},
+		RawPubKeys:       [][]byte{{1}},
+		FormattedPubKeys: []string{"0x01"},
+		OutputDirectory:  output,
+		ExitEpoch:        &exitEpoch,
+	})
+	require.NoError(t, err)
+
+	b, err := file.ReadFileAsBytes(path.Join(output, "validator-exit-2.json"))
+	require.NoError(t, err)
+	svej := &structs.SignedVoluntaryExit{}
+	require.NoError(t, json.Unmarshal(b, svej))
+	assert.Equal(t, "1000", svej.Message.Epoch)
+}
+
+func TestSubmitBLSToExecutionChanges(t *testing.T) {
+	var submitted []*structs.SignedBLSToExecutionChange
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/eth/v1/beacon/pool/bls_to_execution_changes", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&submitted))
+	}))
+	defer srv.Close()
+
+	// The staking deposit CLI writes hex values without the 0x prefix.
+	changesPath := path.Join(t.TempDir(), "bls_to_execution_change.json")
+	require.NoError(t, file.WriteFile(changesPath, []byte(`[{
+		"message": {"validator_index": "1", "from_bls_pubkey": "a99a", "to_execution_address": "0x0102"},
+		"signature": "b00b",
+		"metadata": {"network_name": "mainnet"}
+	}]`)))
+
+	require.NoError(t, submitBLSToExecutionChanges(context.Background(), srv.URL, changesPath))
+	require.Equal(t, 1, len(submitted))
+	assert.Equal(t, "1", submitted[0].Message.ValidatorIndex)
+	assert.Equal(t, "0xa99a", submitted[0].Message.FromBLSPubkey)
+	assert.Equal(t, "0x0102", submitted[0].Message.ToExecutionAddress)
+	assert.Equal(t, "0xb00b", submitted[0].Signature)
+
+	require.NoError(t, file.WriteFile(changesPath, []byte(`[]`)))
+	require.ErrorContains(t, "no BLS to execution changes", submitBLSToExecutionChanges(context.Background(), srv.URL, changesPath))
+}
@@ -0,0 +1,90 @@
+package accounts
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	beacon_api "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// ExitVaultFileName is the name of the exit vault written to the voluntary exits output directory.
+const ExitVaultFileName = "exit-vault.json"
+
+// ExitVault holds pre-signed voluntary exits, encrypted with a password in the EIP-2335 keystore format, to be kept
+// in cold storage and broadcast in an emergency.
+type ExitVault struct {
+	Crypto  map[string]interface{} `json:"crypto"`
+	ID      string                 `json:"uuid"`
+	Version uint                   `json:"version"`
+	Name    string                 `json:"name"`
+}
+
+// writeExitVault encrypts the signed voluntary exits with the password into the exit vault of the output directory.
+// An existing exit vault is not overwritten, so that the exits it holds are not lost.
+func writeExitVault(exits []*eth.SignedVoluntaryExit, outputDirectory, password string) error {
+	vaultPath := filepath.Join(outputDirectory, ExitVaultFileName)
+	exists, err := file.Exists(vaultPath, file.Regular)
+	if err != nil {
+		return errors.Wrap(err, "could not check if exit vault exists")
+	}
+	if exists {
+		return errors.Errorf("exit vault %s already exists", vaultPath)
+	}
+	encoded, err := json.Marshal(beacon_api.JsonifySignedVoluntaryExits(exits))
+	if err != nil {
+		return errors.Wrap(err, "could not marshal signed voluntary exits")
+	}
+	encryptor := keystorev4.New()
+	cryptoFields, err := encryptor.Encrypt(encoded, password)
+	if err != nil {
+		return errors.Wrap(err, "could not encrypt signed voluntary exits")
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return err
+	}
+	vault, err := json.MarshalIndent(&ExitVault{
+		Crypto:  cryptoFields,
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	}, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not marshal exit vault")
+	}
+	if err := file.MkdirAll(outputDirectory); err != nil {
+		return err
+	}
+	if err := file.WriteFile(vaultPath, vault); err != nil {
+		return errors.Wrap(err, "could not write exit vault")
+	}
+	log.WithField("count", len(exits)).Infof("Wrote signed voluntary exits to exit vault %s", vaultPath)
+	return nil
+}
+
+// ReadExitVault decrypts the signed voluntary exits of an exit vault.
+func ReadExitVault(vaultPath, password string) ([]*structs.SignedVoluntaryExit, error) {
+	b, err := file.ReadFileAsBytes(vaultPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read exit vault")
+	}
+	vault := &ExitVault{}
+	if err := json.Unmarshal(b, vault); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal exit vault")
+	}
+	decrypted, err := keystorev4.New().Decrypt(vault.Crypto, password)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt exit vault")
+	}
+	var exits []*structs.SignedVoluntaryExit
+	if err := json.Unmarshal(decrypted, &exits); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal signed voluntary exits")
+	}
+	return exits, nil
+}
@@ -0,0 +1,33 @@
+package accounts
+
+import (
+	"path/filepath"
+	"testing"
+
+	eth "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestExitVault(t *testing.T) {
+	exits := []*eth.SignedVoluntaryExit{
+		{Exit: &eth.VoluntaryExit{Epoch: 1000, ValidatorIndex: 1}, Signature: []byte{0x01}},
+		{Exit: &eth.VoluntaryExit{Epoch: 1000, ValidatorIndex: 2}, Signature: []byte{0x02}},
+	}
+	output := t.TempDir()
+	require.NoError(t, writeExitVault(exits, output, "vault password"))
+	vaultPath := filepath.Join(output, ExitVaultFileName)
+
+	read, err := ReadExitVault(vaultPath, "vault password")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(read))
+	assert.Equal(t, "1000", read[0].Message.Epoch)
+	assert.Equal(t, "1", read[0].Message.ValidatorIndex)
+	assert.Equal(t, "0x01", read[0].Signature)
+	assert.Equal(t, "2", read[1].Message.ValidatorIndex)
+
+	_, err = ReadExitVault(vaultPath, "wrong password")
+	require.ErrorContains(t, "could not decrypt exit vault", err)
+
+	require.ErrorContains(t, "already exists", writeExitVault(exits, output, "vault password"))
+}
@@ -2,10 +2,16 @@ package accounts
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"math"
+	"os"
+	"text/tabwriter"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
@@ -14,6 +20,20 @@ import (
 
 // List pretty-prints accounts in the wallet.
 func (acm *CLIManager) List(ctx context.Context) error {
+	if acm.listValidatorStatus {
+		validatorClient, chainClient, err := acm.prepareStatusClients(ctx)
+		if err != nil {
+			return err
+		}
+		statuses, err := fetchValidatorStatuses(ctx, acm.keymanager, validatorClient, chainClient)
+		if err != nil {
+			return err
+		}
+		if acm.listJSONOutput {
+			return printValidatorStatusesJSON(os.Stdout, statuses)
+		}
+		return printValidatorStatuses(os.Stdout, statuses)
+	}
 	if acm.listValidatorIndices {
 		client, _, err := acm.prepareBeaconClients(ctx)
 		if err != nil {
@@ -51,3 +71,161 @@ func listValidatorIndices(ctx context.Context, km keymanager.IKeymanager, client
 	}
 	return nil
 }
+
+// validatorStatus is the on-chain state of a validator account of the wallet. Validators unknown to the beacon node
+// only have a public key and status.
+type validatorStatus struct {
+	PublicKey                string                     `json:"public_key"`
+	Status                   string                     `json:"status"`
+	Index                    *primitives.ValidatorIndex `json:"index,omitempty"`
+	BalanceGwei              *uint64                    `json:"balance_gwei,omitempty"`
+	WithdrawalCredentials    string                     `json:"withdrawal_credentials,omitempty"`
+	WithdrawalCredentialType string                     `json:"withdrawal_credential_type,omitempty"`
+}
+
+// fetchValidatorStatuses joins the validating public keys of the keymanager with their status, index, balance and
+// withdrawal credentials in the head state of the beacon node.
+func fetchValidatorStatuses(
+	ctx context.Context,
+	km keymanager.IKeymanager,
+	validatorClient iface.ValidatorClient,
+	chainClient iface.ChainClient,
+) ([]*validatorStatus, error) {
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validating public keys")
+	}
+	if len(pubKeys) == 0 {
+		return nil, nil
+	}
+	pks := make([][]byte, len(pubKeys))
+	for i := range pubKeys {
+		pks[i] = pubKeys[i][:]
+	}
+	resp, err := validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: pks})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not request validator statuses")
+	}
+	if len(resp.PublicKeys) != len(resp.Statuses) || len(resp.PublicKeys) != len(resp.Indices) {
+		return nil, errors.New("beacon node returned a malformed validator status response")
+	}
+
+	statuses := make([]*validatorStatus, len(pubKeys))
+	byPubKey := make(map[string]*validatorStatus, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		statuses[i] = &validatorStatus{
+			PublicKey: fmt.Sprintf("%#x", pubKey),
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS.String(),
+		}
+		byPubKey[statuses[i].PublicKey] = statuses[i]
+	}
+	byIndex := make(map[primitives.ValidatorIndex]*validatorStatus)
+	var indices []primitives.ValidatorIndex
+	for i, pubKey := range resp.PublicKeys {
+		s, ok := byPubKey[fmt.Sprintf("%#x", pubKey)]
+		if !ok || resp.Statuses[i] == nil {
+			continue
+		}
+		s.Status = resp.Statuses[i].Status.String()
+		if idx := resp.Indices[i]; idx != math.MaxUint64 {
+			s.Index = &idx
+			byIndex[idx] = s
+			indices = append(indices, idx)
+		}
+	}
+	if len(indices) == 0 {
+		return statuses, nil
+	}
+
+	req := &ethpb.ListValidatorsRequest{Indices: indices}
+	for {
+		validators, err := chainClient.Validators(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request validators")
+		}
+		for _, v := range validators.ValidatorList {
+			if s, ok := byIndex[v.Index]; ok && v.Validator != nil {
+				s.WithdrawalCredentials = fmt.Sprintf("%#x", v.Validator.WithdrawalCredentials)
+				s.WithdrawalCredentialType = withdrawalCredentialType(v.Validator.WithdrawalCredentials)
+			}
+		}
+		if validators.NextPageToken == "" {
+			break
+		}
+		req.PageToken = validators.NextPageToken
+	}
+
+	balancesReq := &ethpb.ListValidatorBalancesRequest{Indices: indices}
+	for {
+		balances, err := chainClient.ValidatorBalances(ctx, balancesReq)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not request validator balances")
+		}
+		for _, b := range balances.Balances {
+			if s, ok := byIndex[b.Index]; ok {
+				balance := b.Balance
+				s.BalanceGwei = &balance
+			}
+		}
+		if balances.NextPageToken == "" {
+			break
+		}
+		balancesReq.PageToken = balances.NextPageToken
+	}
+	return statuses, nil
+}
+
+// withdrawalCredentialType names the type of the withdrawal credentials after their prefix byte.
+func withdrawalCredentialType(withdrawalCredentials []byte) string {
+	if len(withdrawalCredentials) == 0 {
+		return "unknown"
+	}
+	cfg := params.BeaconConfig()
+	switch withdrawalCredentials[0] {
+	case cfg.BLSWithdrawalPrefixByte:
+		return "bls"
+	case cfg.ETH1AddressWithdrawalPrefixByte:
+		return "execution"
+	case cfg.CompoundingWithdrawalPrefixByte:
+		return "compounding"
+	default:
+		return "unknown"
+	}
+}
+
+func printValidatorStatuses(w io.Writer, statuses []*validatorStatus) error {
+	if len(statuses) == 0 {
+		_, err := fmt.Fprintln(w, "No accounts found")
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "PUBLIC KEY\tSTATUS\tINDEX\tBALANCE (ETH)\tWITHDRAWAL CREDENTIALS"); err != nil {
+		return err
+	}
+	for _, s := range statuses {
+		index, balance, withdrawalCredentials := "-", "-", "-"
+		if s.Index != nil {
+			index = fmt.Sprintf("%d", *s.Index)
+		}
+		if s.BalanceGwei != nil {
+			gweiPerEth := params.BeaconConfig().GweiPerEth
+			balance = fmt.Sprintf("%d.%09d", *s.BalanceGwei/gweiPerEth, *s.BalanceGwei%gweiPerEth)
+		}
+		if s.WithdrawalCredentialType != "" {
+			withdrawalCredentials = s.WithdrawalCredentialType
+		}
+		if _, err := fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", s.PublicKey, s.Status, index, balance, withdrawalCredentials); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+func printValidatorStatusesJSON(w io.Writer, statuses []*validatorStatus) error {
+	if statuses == nil {
+		statuses = []*validatorStatus{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(statuses)
+}
@@ -1,6 +1,8 @@
 package accounts
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -453,3 +455,65 @@ func TestListAccounts_DerivedKeymanager(t *testing.T) {
 		assert.Equal(t, true, keyFound, "Validating Private Key %s not found on line number %d", keyString, lineNumber)
 	}
 }
+
+func TestListValidatorStatuses(t *testing.T) {
+	ctx := context.Background()
+	km, err := local.NewInteropKeymanager(ctx, 0, 3)
+	require.NoError(t, err)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validatorClient := validatormock.NewMockValidatorClient(ctrl)
+	chainClient := validatormock.NewMockChainClient(ctrl)
+	validatorClient.EXPECT().MultipleValidatorStatus(gomock.Any(), gomock.Any()).Return(&ethpb.MultipleValidatorStatusResponse{
+		PublicKeys: [][]byte{pubKeys[2][:], pubKeys[0][:], pubKeys[1][:]},
+		Statuses: []*ethpb.ValidatorStatusResponse{
+			{Status: ethpb.ValidatorStatus_PENDING},
+			{Status: ethpb.ValidatorStatus_ACTIVE},
+			{Status: ethpb.ValidatorStatus_UNKNOWN_STATUS},
+		},
+		Indices: []types.ValidatorIndex{7, 3, math.MaxUint64},
+	}, nil)
+	withdrawalCredentials := make([]byte, 32)
+	withdrawalCredentials[0] = params.BeaconConfig().ETH1AddressWithdrawalPrefixByte
+	chainClient.EXPECT().Validators(gomock.Any(), &ethpb.ListValidatorsRequest{Indices: []types.ValidatorIndex{7, 3}}).Return(&ethpb.Validators{
+		ValidatorList: []*ethpb.Validators_ValidatorContainer{
+			{Index: 3, Validator: &ethpb.Validator{WithdrawalCredentials: withdrawalCredentials}},
+			{Index: 7, Validator: &ethpb.Validator{WithdrawalCredentials: make([]byte, 32)}},
+		},
+	}, nil)
+	chainClient.EXPECT().ValidatorBalances(gomock.Any(), &ethpb.ListValidatorBalancesRequest{Indices: []types.ValidatorIndex{7, 3}}).Return(&ethpb.ValidatorBalances{
+		Balances: []*ethpb.ValidatorBalances_Balance{
+			{Index: 3, Balance: 32001000000},
+			{Index: 7, Balance: 32000000000},
+		},
+	}, nil)
+
+	statuses, err := fetchValidatorStatuses(ctx, km, validatorClient, chainClient)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(statuses))
+
+	var out strings.Builder
+	require.NoError(t, printValidatorStatuses(&out, statuses))
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Equal(t, 4, len(lines))
+	assert.DeepEqual(t, []string{"PUBLIC", "KEY", "STATUS", "INDEX", "BALANCE", "(ETH)", "WITHDRAWAL", "CREDENTIALS"}, strings.Fields(lines[0]))
+	assert.DeepEqual(t, []string{fmt.Sprintf("%#x", pubKeys[0]), "ACTIVE", "3", "32.001000000", "execution"}, strings.Fields(lines[1]))
+	assert.DeepEqual(t, []string{fmt.Sprintf("%#x", pubKeys[1]), "UNKNOWN_STATUS", "-", "-", "-"}, strings.Fields(lines[2]))
+	assert.DeepEqual(t, []string{fmt.Sprintf("%#x", pubKeys[2]), "PENDING", "7", "32.000000000", "bls"}, strings.Fields(lines[3]))
+
+	out.Reset()
+	require.NoError(t, printValidatorStatusesJSON(&out, statuses))
+	var decoded []map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(out.String()), &decoded))
+	require.Equal(t, 3, len(decoded))
+	assert.Equal(t, "ACTIVE", decoded[0]["status"])
+	assert.Equal(t, float64(3), decoded[0]["index"])
+	assert.Equal(t, float64(32001000000), decoded[0]["balance_gwei"])
+	assert.Equal(t, "execution", decoded[0]["withdrawal_credential_type"])
+	assert.Equal(t, fmt.Sprintf("%#x", withdrawalCredentials), decoded[0]["withdrawal_credentials"])
+	_, ok := decoded[1]["index"]
+	assert.Equal(t, false, ok)
+}
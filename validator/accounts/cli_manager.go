@@ -9,9 +9,11 @@ import (
 
 	"github.com/pkg/errors"
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-chain-client-factory"
 	iface "github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	nodeClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/node-client-factory"
 	validatorClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/validator-client-factory"
@@ -38,40 +40,69 @@ func NewCLIManager(opts ...Option) (*CLIManager, error) {
 // CLIManager defines a struct capable of performing various validator
 // wallet & account operations via the command line.
 type CLIManager struct {
-	wallet               *wallet.Wallet
-	keymanager           keymanager.IKeymanager
-	keymanagerKind       keymanager.Kind
-	showPrivateKeys      bool
-	listValidatorIndices bool
-	deletePublicKeys     bool
-	importPrivateKeys    bool
-	readPasswordFile     bool
-	skipMnemonicConfirm  bool
-	dialOpts             []grpc.DialOption
-	grpcHeaders          []string
-	beaconRPCProvider    string
-	walletKeyCount       int
-	privateKeyFile       string
-	passwordFilePath     string
-	keysDir              string
-	mnemonicLanguage     string
-	backupsDir           string
-	backupsPassword      string
-	filteredPubKeys      []bls.PublicKey
-	rawPubKeys           [][]byte
-	formattedPubKeys     []string
-	exitJSONOutputPath   string
-	walletDir            string
-	walletPassword       string
-	mnemonic             string
-	numAccounts          int
-	mnemonic25thWord     string
-	beaconApiEndpoint    string
-	beaconApiTimeout     time.Duration
-	inputReader          io.Reader
+	wallet                    *wallet.Wallet
+	keymanager                keymanager.IKeymanager
+	keymanagerKind            keymanager.Kind
+	showPrivateKeys           bool
+	listValidatorIndices      bool
+	listValidatorStatus       bool
+	listJSONOutput            bool
+	deletePublicKeys          bool
+	importPrivateKeys         bool
+	readPasswordFile          bool
+	skipMnemonicConfirm       bool
+	dialOpts                  []grpc.DialOption
+	grpcHeaders               []string
+	beaconRPCProvider         string
+	walletKeyCount            int
+	privateKeyFile            string
+	passwordFilePath          string
+	keysDir                   string
+	mnemonicLanguage          string
+	backupsDir                string
+	backupsPassword           string
+	filteredPubKeys           []bls.PublicKey
+	rawPubKeys                [][]byte
+	formattedPubKeys          []string
+	exitJSONOutputPath        string
+	exitEpoch                 *primitives.Epoch
+	exitsPerEpoch             uint64
+	exitVaultPassword         string
+	blsToExecutionChangesPath string
+	walletDir                 string
+	walletPassword            string
+	mnemonic                  string
+	numAccounts               int
+	mnemonic25thWord          string
+	beaconApiEndpoint         string
+	beaconApiTimeout          time.Duration
+	inputReader               io.Reader
 }
 
 func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.ValidatorClient, *iface.NodeClient, error) {
+	conn, restHandler, err := acm.beaconConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorClient := validatorClientFactory.NewValidatorClient(conn, restHandler)
+	nodeClient := nodeClientFactory.NewNodeClient(conn, restHandler)
+
+	return &validatorClient, &nodeClient, nil
+}
+
+// prepareStatusClients returns the clients querying the on-chain state of the validators of the wallet.
+func (acm *CLIManager) prepareStatusClients(ctx context.Context) (iface.ValidatorClient, iface.ChainClient, error) {
+	conn, restHandler, err := acm.beaconConnection(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	validatorClient := validatorClientFactory.NewValidatorClient(conn, restHandler)
+	chainClient := beaconChainClientFactory.NewChainClient(conn, restHandler)
+
+	return validatorClient, chainClient, nil
+}
+
+func (acm *CLIManager) beaconConnection(ctx context.Context) (validatorHelpers.NodeConnection, beaconApi.JsonRestHandler, error) {
 	if acm.dialOpts == nil {
 		return nil, nil, errors.New("failed to construct dial options for beacon clients")
 	}
@@ -91,8 +122,5 @@ func (acm *CLIManager) prepareBeaconClients(ctx context.Context) (*iface.Validat
 		http.Client{Timeout: acm.beaconApiTimeout},
 		acm.beaconApiEndpoint,
 	)
-	validatorClient := validatorClientFactory.NewValidatorClient(conn, restHandler)
-	nodeClient := nodeClientFactory.NewNodeClient(conn, restHandler)
-
-	return &validatorClient, &nodeClient, nil
+	return conn, restHandler, nil
 }
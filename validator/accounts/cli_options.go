@@ -4,6 +4,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
@@ -53,6 +54,22 @@ func WithListValidatorIndices() Option {
 	}
 }
 
+// WithListValidatorStatus enables displaying the on-chain status of validators in the accounts cli manager.
+func WithListValidatorStatus() Option {
+	return func(acc *CLIManager) error {
+		acc.listValidatorStatus = true
+		return nil
+	}
+}
+
+// WithListJSONOutput prints the on-chain status of validators as JSON in the accounts cli manager.
+func WithListJSONOutput() Option {
+	return func(acc *CLIManager) error {
+		acc.listJSONOutput = true
+		return nil
+	}
+}
+
 // WithGRPCDialOpts adds grpc opts needed to connect to beacon nodes in the accounts cli manager.
 func WithGRPCDialOpts(opts []grpc.DialOption) Option {
 	return func(acc *CLIManager) error {
@@ -205,6 +222,40 @@ func WithExitJSONOutputPath(outputPath string) Option {
 	}
 }
 
+// WithExitEpoch specifies the epoch from which the voluntary exits written to the JSON output path are valid.
+func WithExitEpoch(epoch primitives.Epoch) Option {
+	return func(acc *CLIManager) error {
+		acc.exitEpoch = &epoch
+		return nil
+	}
+}
+
+// WithExitVaultPassword specifies the password encrypting the voluntary exits written to the JSON output path into
+// an exit vault.
+func WithExitVaultPassword(password string) Option {
+	return func(acc *CLIManager) error {
+		acc.exitVaultPassword = password
+		return nil
+	}
+}
+
+// WithExitsPerEpoch specifies the number of voluntary exits broadcast per epoch.
+func WithExitsPerEpoch(exitsPerEpoch uint64) Option {
+	return func(acc *CLIManager) error {
+		acc.exitsPerEpoch = exitsPerEpoch
+		return nil
+	}
+}
+
+// WithBLSToExecutionChangesPath specifies a JSON file of signed BLS to execution changes broadcast before the
+// voluntary exits.
+func WithBLSToExecutionChangesPath(changesPath string) Option {
+	return func(acc *CLIManager) error {
+		acc.blsToExecutionChangesPath = changesPath
+		return nil
+	}
+}
+
 // WithWalletDir specifies the password for backups.
 func WithWalletDir(walletDir string) Option {
 	return func(acc *CLIManager) error {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/pkcs11"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
 )
 
@@ -11,6 +12,7 @@ import (
 type InitKeymanagerConfig struct {
 	ListenForChanges bool
 	Web3SignerConfig *remoteweb3signer.SetupConfig
+	PKCS11Config     *pkcs11.SetupConfig
 }
 
 // Wallet defines a struct which has capabilities and knowledge of how
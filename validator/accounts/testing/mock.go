@@ -146,6 +146,10 @@ func (_ *Validator) UpdateDuties(_ context.Context, _ primitives.Slot) error {
 	panic("implement me")
 }
 
+func (_ *Validator) StreamDuties(_ context.Context) error {
+	panic("implement me")
+}
+
 func (_ *Validator) RolesAt(_ context.Context, _ primitives.Slot) (map[[48]byte][]iface2.ValidatorRole, error) {
 	panic("implement me")
 }
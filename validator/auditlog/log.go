@@ -0,0 +1,465 @@
+// Package auditlog defines an append-only log of the objects signed by the validator client, for compliance and
+// post-incident analysis. Entries are written as JSON lines to a file which is rotated once it exceeds a maximum
+// size, keeping a limited number of rotated files. When an HMAC key is set, each entry carries an HMAC of its content chained with the HMAC of the previous
+// entry, so that removing or altering entries breaks the chain.
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+)
+
+const (
+	// currentFileName is the name of the file entries are appended to.
+	currentFileName = "signed-objects.jsonl"
+	// rotatedFilePrefix is the prefix of the names of the rotated files, followed by the rotation time.
+	rotatedFilePrefix = "signed-objects-"
+	fileExtension     = ".jsonl"
+	// anchorFileName is the name of the file holding the HMAC of the last entry of the most recently pruned file,
+	// to which the first retained entry is chained.
+	anchorFileName = "signed-objects.anchor"
+)
+
+// ErrChainBroken is returned when verifying a log whose HMAC chain doesn't match its entries.
+var ErrChainBroken = errors.New("audit log HMAC chain is broken")
+
+// SigningFunc signs the object of a request.
+type SigningFunc = func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+
+// Entry is the record of a signed object.
+type Entry struct {
+	Time        time.Time       `json:"time"`
+	Type        string          `json:"type"`
+	Slot        primitives.Slot `json:"slot"`
+	PublicKey   string          `json:"public_key"`
+	SigningRoot string          `json:"signing_root"`
+	HMAC        string          `json:"hmac,omitempty"`
+}
+
+// Filter selects the entries returned by a query. Zero values match every entry.
+type Filter struct {
+	PublicKey string
+	Type      string
+	StartSlot primitives.Slot
+	EndSlot   primitives.Slot
+	// Limit is the maximum number of entries returned, the most recent ones.
+	Limit int
+}
+
+// Log is an append-only log of signed objects. A nil log records nothing.
+type Log struct {
+	sync.Mutex
+	dir      string
+	maxSize  int64
+	maxFiles int
+	hmacKey  []byte
+	file     *os.File
+	size     int64
+	prevHMAC []byte
+}
+
+// New opens the audit log in the directory, rotating its file once it exceeds maxSize bytes and keeping the
+// maxFiles most recent rotated files, or all of them when maxFiles is 0. Entries are chained with HMACs when hmacKey
+// is not empty.
+func New(dir string, maxSize int64, maxFiles int, hmacKey []byte) (*Log, error) {
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrap(err, "could not create audit log directory")
+	}
+	l := &Log{dir: dir, maxSize: maxSize, maxFiles: maxFiles, hmacKey: hmacKey}
+	if len(hmacKey) > 0 {
+		last, err := l.lastEntry()
+		if err != nil {
+			return nil, err
+		}
+		if last != nil {
+			if l.prevHMAC, err = hex.DecodeString(last.HMAC); err != nil {
+				return nil, errors.Wrap(err, "could not decode HMAC of the last audit log entry")
+			}
+		}
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Record appends the object signed by the request to the log.
+func (l *Log) Record(req *validatorpb.SignRequest) error {
+	if l == nil {
+		return nil
+	}
+	e := &Entry{
+		Time:        time.Now().UTC(),
+		Type:        objectType(req),
+		Slot:        req.SigningSlot,
+		PublicKey:   fmt.Sprintf("%#x", req.PublicKey),
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if l.maxSize > 0 && l.size >= l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+	mac, err := l.mac(e, l.prevHMAC)
+	if err != nil {
+		return err
+	}
+	if mac != nil {
+		e.HMAC = hex.EncodeToString(mac)
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal audit log entry")
+	}
+	n, err := l.file.Write(append(line, '\n'))
+	l.size += int64(n)
+	if err != nil {
+		return errors.Wrap(err, "could not write audit log entry")
+	}
+	l.prevHMAC = mac
+	return nil
+}
+
+// Signer returns a signing function recording the objects signed by the given function. A failure to record is
+// logged and doesn't fail the signing, so that duties are not missed.
+func (l *Log) Signer(sign SigningFunc) SigningFunc {
+	if l == nil {
+		return sign
+	}
+	return func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		sig, err := sign(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.Record(req); err != nil {
+			log.WithError(err).Error("Could not record signed object in audit log")
+		}
+		return sig, nil
+	}
+}
+
+// Query returns the entries of the log matching the filter, oldest first.
+func (l *Log) Query(f Filter) ([]*Entry, error) {
+	var entries []*Entry
+	err := l.walk(func(e *Entry) error {
+		if f.matches(e) {
+			entries = append(entries, e)
+			if f.Limit > 0 && len(entries) > f.Limit {
+				entries = entries[1:]
+			}
+		}
+		return nil
+	})
+	return entries, err
+}
+
+// Verify checks the HMAC chain of every entry of the log, and returns ErrChainBroken when an entry was altered or
+// removed. It returns nil when the log has no HMAC key.
+func (l *Log) Verify() error {
+	if l == nil || len(l.hmacKey) == 0 {
+		return nil
+	}
+	prev, err := l.anchor()
+	if err != nil {
+		return err
+	}
+	i := 0
+	return l.walk(func(e *Entry) error {
+		i++
+		got, err := hex.DecodeString(e.HMAC)
+		if err != nil {
+			return errors.Wrapf(ErrChainBroken, "entry %d", i)
+		}
+		want, err := l.mac(e, prev)
+		if err != nil {
+			return err
+		}
+		if !hmac.Equal(got, want) {
+			return errors.Wrapf(ErrChainBroken, "entry %d", i)
+		}
+		prev = got
+		return nil
+	})
+}
+
+// Close closes the file of the log.
+func (l *Log) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.Lock()
+	defer l.Unlock()
+	return l.file.Close()
+}
+
+func (f Filter) matches(e *Entry) bool {
+	if f.PublicKey != "" && !strings.EqualFold(f.PublicKey, e.PublicKey) {
+		return false
+	}
+	if f.Type != "" && f.Type != e.Type {
+		return false
+	}
+	if e.Slot < f.StartSlot {
+		return false
+	}
+	return f.EndSlot == 0 || e.Slot <= f.EndSlot
+}
+
+// mac returns the HMAC of the entry chained with the previous HMAC, or nil without an HMAC key.
+func (l *Log) mac(e *Entry, prev []byte) ([]byte, error) {
+	if len(l.hmacKey) == 0 {
+		return nil, nil
+	}
+	unsigned := *e
+	unsigned.HMAC = ""
+	content, err := json.Marshal(&unsigned)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal audit log entry")
+	}
+	h := hmac.New(sha256.New, l.hmacKey)
+	h.Write(prev)
+	h.Write(content)
+	return h.Sum(nil), nil
+}
+
+func (l *Log) open() error {
+	f, err := os.OpenFile(filepath.Join(l.dir, currentFileName), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrap(err, "could not open audit log")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat audit log")
+	}
+	l.file = f
+	l.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file after the rotation time and opens a new one. It assumes the caller holds the
+// lock.
+func (l *Log) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return errors.Wrap(err, "could not close audit log")
+	}
+	name := fmt.Sprintf("%s%s%s", rotatedFilePrefix, time.Now().UTC().Format("20060102T150405.000000000"), fileExtension)
+	if err := os.Rename(filepath.Join(l.dir, currentFileName), filepath.Join(l.dir, name)); err != nil {
+		return errors.Wrap(err, "could not rotate audit log")
+	}
+	if err := l.open(); err != nil {
+		return err
+	}
+	return l.prune()
+}
+
+// prune removes the oldest rotated files beyond the retention limit, recording the HMAC of the last removed entry
+// as the anchor of the chain. It assumes the caller holds the lock.
+func (l *Log) prune() error {
+	if l.maxFiles <= 0 {
+		return nil
+	}
+	rotated, err := l.rotatedFiles()
+	if err != nil {
+		return err
+	}
+	if len(rotated) <= l.maxFiles {
+		return nil
+	}
+	pruned := rotated[:len(rotated)-l.maxFiles]
+	if len(l.hmacKey) > 0 {
+		var last *Entry
+		if err := walkFile(pruned[len(pruned)-1], func(e *Entry) error {
+			last = e
+			return nil
+		}); err != nil {
+			return err
+		}
+		if last != nil {
+			if err := file.WriteFile(filepath.Join(l.dir, anchorFileName), []byte(last.HMAC)); err != nil {
+				return errors.Wrap(err, "could not write audit log anchor")
+			}
+		}
+	}
+	for _, path := range pruned {
+		if err := os.Remove(path); err != nil {
+			return errors.Wrap(err, "could not remove rotated audit log file")
+		}
+	}
+	return nil
+}
+
+// anchor returns the HMAC the first retained entry is chained to, which is nil until rotated files are pruned.
+func (l *Log) anchor() ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(l.dir, anchorFileName)) // #nosec G304
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read audit log anchor")
+	}
+	prev, err := hex.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, errors.Wrap(ErrChainBroken, "invalid anchor")
+	}
+	return prev, nil
+}
+
+// rotatedFiles returns the paths of the rotated files of the log, oldest first.
+func (l *Log) rotatedFiles() ([]string, error) {
+	rotated, err := filepath.Glob(filepath.Join(l.dir, rotatedFilePrefix+"*"+fileExtension))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list audit log files")
+	}
+	// The rotation times sort lexicographically.
+	sort.Strings(rotated)
+	return rotated, nil
+}
+
+// files returns the paths of the files of the log, oldest first.
+func (l *Log) files() ([]string, error) {
+	rotated, err := l.rotatedFiles()
+	if err != nil {
+		return nil, err
+	}
+	return append(rotated, filepath.Join(l.dir, currentFileName)), nil
+}
+
+// snapshot is the content of the log at a point in time: its files opened, so that they can be read after being
+// rotated or pruned, and the size of the current file, which is appended to.
+type snapshot struct {
+	files       []*os.File
+	currentSize int64
+}
+
+// snapshot opens the files of the log. It only holds the lock while listing and opening them, so that reading a long
+// history doesn't delay the recording of signed objects.
+func (l *Log) snapshot() (*snapshot, error) {
+	l.Lock()
+	defer l.Unlock()
+	paths, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+	snap := &snapshot{currentSize: l.size}
+	for _, path := range paths {
+		f, err := os.Open(path) // #nosec G304
+		if err != nil {
+			snap.close()
+			return nil, errors.Wrap(err, "could not open audit log file")
+		}
+		snap.files = append(snap.files, f)
+	}
+	return snap, nil
+}
+
+func (s *snapshot) close() {
+	for _, f := range s.files {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Debug("Could not close audit log file")
+		}
+	}
+}
+
+// walk calls f with every entry of the log, oldest first.
+func (l *Log) walk(f func(e *Entry) error) error {
+	if l == nil {
+		return nil
+	}
+	snap, err := l.snapshot()
+	if err != nil {
+		return err
+	}
+	defer snap.close()
+	for i, fd := range snap.files {
+		var r io.Reader = fd
+		// Entries appended to the current file after the snapshot are left out.
+		if i == len(snap.files)-1 {
+			r = io.LimitReader(fd, snap.currentSize)
+		}
+		if err := walkEntries(r, filepath.Base(fd.Name()), f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkFile(path string, f func(e *Entry) error) error {
+	fd, err := os.Open(path) // #nosec G304
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not read audit log file")
+	}
+	defer func() {
+		if err := fd.Close(); err != nil {
+			log.WithError(err).Debug("Could not close audit log file")
+		}
+	}()
+	return walkEntries(fd, filepath.Base(path), f)
+}
+
+func walkEntries(r io.Reader, name string, f func(e *Entry) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 4096), 1<<20)
+	for scanner.Scan() {
+		e := &Entry{}
+		if err := json.Unmarshal(scanner.Bytes(), e); err != nil {
+			return errors.Wrapf(err, "could not decode audit log entry in %s", name)
+		}
+		if err := f(e); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// lastEntry returns the most recent entry of the log, or nil when it is empty.
+func (l *Log) lastEntry() (*Entry, error) {
+	files, err := l.files()
+	if err != nil {
+		return nil, err
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		var last *Entry
+		if err := walkFile(files[i], func(e *Entry) error {
+			last = e
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if last != nil {
+			return last, nil
+		}
+	}
+	return nil, nil
+}
+
+// objectType returns the name of the type of the object signed by the request, such as AttestationData.
+func objectType(req *validatorpb.SignRequest) string {
+	if req.Object == nil {
+		return "Unknown"
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", req.Object), "*validatorpb.SignRequest_")
+}
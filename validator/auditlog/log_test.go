@@ -0,0 +1,140 @@
+package auditlog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func attestationRequest(pubKey byte, slot primitives.Slot) *validatorpb.SignRequest {
+	return &validatorpb.SignRequest{
+		PublicKey:   []byte{pubKey},
+		SigningRoot: []byte{byte(slot)},
+		SigningSlot: slot,
+		Object:      &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{Slot: slot}},
+	}
+}
+
+func TestLog_RecordAndQuery(t *testing.T) {
+	l, err := New(t.TempDir(), 0, 0, nil)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+
+	for slot := primitives.Slot(1); slot <= 4; slot++ {
+		require.NoError(t, l.Record(attestationRequest(1, slot)))
+	}
+	require.NoError(t, l.Record(&validatorpb.SignRequest{
+		PublicKey:   []byte{2},
+		SigningSlot: 3,
+		Object:      &validatorpb.SignRequest_Slot{Slot: 3},
+	}))
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Equal(t, 5, len(entries))
+	assert.Equal(t, "AttestationData", entries[0].Type)
+	assert.Equal(t, primitives.Slot(1), entries[0].Slot)
+	assert.Equal(t, "0x01", entries[0].PublicKey)
+	assert.Equal(t, "0x01", entries[0].SigningRoot)
+	assert.Equal(t, "", entries[0].HMAC)
+	assert.Equal(t, "Slot", entries[4].Type)
+
+	entries, err = l.Query(Filter{PublicKey: "0x01", StartSlot: 2, EndSlot: 3})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, primitives.Slot(2), entries[0].Slot)
+
+	entries, err = l.Query(Filter{Type: "AttestationData", Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, primitives.Slot(3), entries[0].Slot)
+	assert.Equal(t, primitives.Slot(4), entries[1].Slot)
+
+	var nilLog *Log
+	require.NoError(t, nilLog.Record(attestationRequest(1, 1)))
+}
+
+func TestLog_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	l, err := New(dir, 100, 0, []byte("key"))
+	require.NoError(t, err)
+	for slot := primitives.Slot(1); slot <= 5; slot++ {
+		require.NoError(t, l.Record(attestationRequest(1, slot)))
+	}
+	require.NoError(t, l.Close())
+
+	rotated, err := filepath.Glob(filepath.Join(dir, rotatedFilePrefix+"*"))
+	require.NoError(t, err)
+	require.Equal(t, 4, len(rotated))
+
+	// The chain continues when the log is reopened.
+	l, err = New(dir, 100, 0, []byte("key"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+	require.NoError(t, l.Record(attestationRequest(1, 6)))
+
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(entries))
+	for i, e := range entries {
+		assert.Equal(t, primitives.Slot(i+1), e.Slot)
+	}
+	require.NoError(t, l.Verify())
+}
+
+func TestLog_Retention(t *testing.T) {
+	dir := t.TempDir()
+	l, err := New(dir, 100, 2, []byte("key"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+	for slot := primitives.Slot(1); slot <= 5; slot++ {
+		require.NoError(t, l.Record(attestationRequest(1, slot)))
+	}
+
+	// The oldest rotated files are removed, and the chain of the retained entries still verifies.
+	rotated, err := filepath.Glob(filepath.Join(dir, rotatedFilePrefix+"*"))
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rotated))
+	entries, err := l.Query(Filter{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(entries))
+	assert.Equal(t, primitives.Slot(3), entries[0].Slot)
+	require.NoError(t, l.Verify())
+
+	// Removing the oldest retained file breaks the chain.
+	require.NoError(t, os.Remove(rotated[0]))
+	require.ErrorIs(t, l.Verify(), ErrChainBroken)
+}
+
+func TestLog_Verify(t *testing.T) {
+	dir := t.TempDir()
+	l, err := New(dir, 0, 0, []byte("key"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+	for slot := primitives.Slot(1); slot <= 3; slot++ {
+		require.NoError(t, l.Record(attestationRequest(1, slot)))
+	}
+	require.NoError(t, l.Verify())
+
+	// Removing an entry breaks the chain.
+	path := filepath.Join(dir, currentFileName)
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(content), "\n")
+	require.NoError(t, os.WriteFile(path, []byte(lines[0]+lines[2]), 0600))
+	require.ErrorIs(t, l.Verify(), ErrChainBroken)
+
+	// A log with a different key doesn't verify.
+	require.NoError(t, os.WriteFile(path, content, 0600))
+	other, err := New(dir, 0, 0, []byte("other"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, other.Close()) }()
+	require.ErrorIs(t, other.Verify(), ErrChainBroken)
+}
@@ -0,0 +1,5 @@
+package auditlog
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "auditlog")
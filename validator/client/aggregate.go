@@ -56,9 +56,9 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 
 	var slotSig []byte
 	if v.distributed {
-		slotSig, err = v.attSelection(attSelectionKey{slot: slot, index: duty.ValidatorIndex})
+		slotSig, err = v.aggregatedSelectionProof(ctx, pubKey, slot, duty.ValidatorIndex)
 		if err != nil {
-			log.WithError(err).Error("Could not find aggregated selection proof")
+			log.WithError(err).Error("Could not get aggregated selection proof")
 			if v.emitAccountMetrics {
 				ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
 			}
@@ -80,6 +80,15 @@ func (v *validator) SubmitAggregateAndProof(ctx context.Context, slot primitives
 	// https://github.com/ethereum/consensus-specs/blob/v0.9.3/specs/validator/0_beacon-chain-validator.md#broadcast-aggregate
 	v.waitToSlotTwoThirds(ctx, slot)
 
+	if err := v.dutyBatcher.acquire(ctx); err != nil {
+		log.WithError(err).Error("Could not wait to submit aggregate")
+		if v.emitAccountMetrics {
+			ValidatorAggFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return
+	}
+	defer v.dutyBatcher.release()
+
 	postElectra := slots.ToEpoch(slot) >= params.BeaconConfig().ElectraForkEpoch
 
 	aggSelectionRequest := &ethpb.AggregateSelectionRequest{
@@ -185,7 +194,7 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [fiel
 	if err != nil {
 		return nil, err
 	}
-	sig, err = v.km.Sign(ctx, &validatorpb.SignRequest{
+	sig, err = v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -262,7 +271,7 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [fieldparam
 		signRequest.Object = &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: aggregate}
 	}
 
-	sig, err := v.km.Sign(ctx, signRequest)
+	sig, err := v.sign(ctx, signRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -71,11 +71,21 @@ func (v *validator) SubmitAttestation(ctx context.Context, slot primitives.Slot,
 		return
 	}
 
+	if err := v.dutyBatcher.acquire(ctx); err != nil {
+		log.WithError(err).Error("Could not wait to submit attestation")
+		if v.emitAccountMetrics {
+			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		tracing.AnnotateError(span, err)
+		return
+	}
+	defer v.dutyBatcher.release()
+
 	req := &ethpb.AttestationDataRequest{
 		Slot:           slot,
 		CommitteeIndex: duty.CommitteeIndex,
 	}
-	data, err := v.validatorClient.AttestationData(ctx, req)
+	data, err := v.dutyBatcher.attestationData(ctx, v.validatorClient, req)
 	if err != nil {
 		log.WithError(err).Error("Could not request attestation to sign at slot")
 		if v.emitAccountMetrics {
@@ -239,7 +249,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [fieldparams.BLSPubkeyLe
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	sig, err := v.km.Sign(ctx, &validatorpb.SignRequest{
+	sig, err := v.sign(ctx, &validatorpb.SignRequest{
 		PublicKey:       pubKey[:],
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
@@ -286,6 +296,9 @@ func (v *validator) setHighestSlot(slot primitives.Slot) {
 //
 //	(a) the validator has received a valid block that is the same slot as input slot
 //	(b) one-third of the slot has transpired (SECONDS_PER_SLOT / 3 seconds after the start of slot)
+//
+// With an attestation head max wait, (b) is replaced by the max wait after the start of the slot, so that the
+// validator votes for a late block of the slot which becomes the head after the one-third mark.
 func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitives.Slot) {
 	ctx, span := trace.StartSpan(ctx, "validator.waitOneThirdOrValidBlock")
 	defer span.End()
@@ -296,6 +309,11 @@ func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitive
 	}
 
 	delay := slots.DivideSlotBy(3 /* a third of the slot duration */)
+	waitForHead := features.Get().AttestTimely
+	if v.attestationHeadMaxWait > 0 {
+		delay = v.attestationHeadMaxWait
+		waitForHead = true
+	}
 	startTime := slots.StartTime(v.genesisTime, slot)
 	finalTime := startTime.Add(delay)
 	wait := prysmTime.Until(finalTime)
@@ -312,10 +330,8 @@ func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitive
 	for {
 		select {
 		case s := <-ch:
-			if features.Get().AttestTimely {
-				if slot <= s {
-					return
-				}
+			if waitForHead && slot <= s {
+				return
 			}
 		case <-ctx.Done():
 			tracing.AnnotateError(span, ctx.Err())
@@ -324,6 +340,9 @@ func (v *validator) waitOneThirdOrValidBlock(ctx context.Context, slot primitive
 			log.Error("Subscriber closed, exiting goroutine")
 			return
 		case <-t.C:
+			if v.attestationHeadMaxWait > 0 {
+				log.WithField("slot", slot).Debug("Head of the slot was not processed within the max wait")
+			}
 			return
 		}
 	}
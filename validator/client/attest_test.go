@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/testing/require"
 	"github.com/prysmaticlabs/prysm/v5/testing/util"
 	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"go.uber.org/mock/gomock"
 	"gopkg.in/d4l3k/messagediff.v1"
@@ -667,6 +668,41 @@ func TestServer_WaitToSlotOneThird_ReceiveBlockSlot(t *testing.T) {
 	}
 }
 
+func TestServer_WaitForHead_MaxWait(t *testing.T) {
+	currentSlot := primitives.Slot(4)
+	genesisTime := uint64(time.Now().Unix()) - uint64(currentSlot.Mul(params.BeaconConfig().SecondsPerSlot))
+	slotStart := slots.StartTime(genesisTime, currentSlot)
+
+	t.Run("head of the slot", func(t *testing.T) {
+		v := &validator{
+			genesisTime:            genesisTime,
+			slotFeed:               new(event.Feed),
+			attestationHeadMaxWait: 8 * time.Second,
+		}
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			v.slotFeed.Send(currentSlot - 1)
+			time.Sleep(100 * time.Millisecond)
+			v.slotFeed.Send(currentSlot)
+		}()
+		v.waitOneThirdOrValidBlock(context.Background(), currentSlot)
+		assert.Equal(t, true, time.Since(slotStart) < 4*time.Second)
+	})
+	t.Run("falls back to max wait", func(t *testing.T) {
+		v := &validator{
+			genesisTime:            genesisTime,
+			slotFeed:               new(event.Feed),
+			attestationHeadMaxWait: time.Since(slotStart) + 200*time.Millisecond,
+		}
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			v.slotFeed.Send(currentSlot - 1)
+		}()
+		v.waitOneThirdOrValidBlock(context.Background(), currentSlot)
+		assert.Equal(t, true, time.Since(slotStart) >= v.attestationHeadMaxWait)
+	})
+}
+
 func Test_slashableAttestationCheck(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
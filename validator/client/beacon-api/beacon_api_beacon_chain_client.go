@@ -147,93 +147,84 @@ func (c beaconApiChainClient) ChainHead(ctx context.Context, _ *empty.Empty) (*e
 }
 
 func (c beaconApiChainClient) ValidatorBalances(ctx context.Context, in *ethpb.ListValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
-	if c.fallbackClient != nil {
-		return c.fallbackClient.ValidatorBalances(ctx, in)
-	}
-
-	// TODO: Implement me
-	panic("beaconApiChainClient.ValidatorBalances is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiChainClientWithFallback.")
-}
-
-func (c beaconApiChainClient) Validators(ctx context.Context, in *ethpb.ListValidatorsRequest) (*ethpb.Validators, error) {
-	pageSize := in.PageSize
-
-	// We follow the gRPC behavior here, which returns a maximum of 250 results when pageSize == 0
-	if pageSize == 0 {
-		pageSize = 250
+	pageToken, pageSize, err := parsePage(in.PageSize, in.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	var pageToken uint64
-	var err error
-
-	if in.PageToken != "" {
-		if pageToken, err = strconv.ParseUint(in.PageToken, 10, 64); err != nil {
-			return nil, errors.Wrapf(err, "failed to parse page token `%s`", in.PageToken)
-		}
+	var queryFilter interface{}
+	switch f := in.QueryFilter.(type) {
+	case *ethpb.ListValidatorBalancesRequest_Epoch:
+		queryFilter = &ethpb.ListValidatorsRequest_Epoch{Epoch: f.Epoch}
+	case *ethpb.ListValidatorBalancesRequest_Genesis:
+		queryFilter = &ethpb.ListValidatorsRequest_Genesis{Genesis: f.Genesis}
+	case nil:
+	default:
+		return nil, errors.Errorf("unsupported query filter type `%v`", reflect.TypeOf(f))
 	}
 
-	var statuses []string
-	if in.Active {
-		statuses = []string{"active"}
+	stateValidators, epoch, err := c.stateValidators(ctx, queryFilter, in.PublicKeys, in.Indices, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	pubkeys := make([]string, len(in.PublicKeys))
-	for idx, pubkey := range in.PublicKeys {
-		pubkeys[idx] = hexutil.Encode(pubkey)
-	}
+	start, end := pageRange(pageToken, pageSize, len(stateValidators.Data))
+	balances := make([]*ethpb.ValidatorBalances_Balance, end-start)
+	for idx := start; idx < end; idx++ {
+		stateValidator := stateValidators.Data[idx]
 
-	var stateValidators *structs.GetValidatorsResponse
-	var epoch primitives.Epoch
+		if stateValidator.Validator == nil {
+			return nil, errors.Errorf("state validator at index `%d` is nil", idx)
+		}
 
-	switch queryFilter := in.QueryFilter.(type) {
-	case *ethpb.ListValidatorsRequest_Epoch:
-		slot, err := slots.EpochStart(queryFilter.Epoch)
+		pubkey, err := hexutil.Decode(stateValidator.Validator.Pubkey)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get first slot for epoch `%d`", queryFilter.Epoch)
-		}
-		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForSlot(ctx, slot, pubkeys, in.Indices, statuses); err != nil {
-			return nil, errors.Wrapf(err, "failed to get state validators for slot `%d`", slot)
-		}
-		epoch = slots.ToEpoch(slot)
-	case *ethpb.ListValidatorsRequest_Genesis:
-		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForSlot(ctx, 0, pubkeys, in.Indices, statuses); err != nil {
-			return nil, errors.Wrapf(err, "failed to get genesis state validators")
-		}
-		epoch = 0
-	case nil:
-		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForHead(ctx, pubkeys, in.Indices, statuses); err != nil {
-			return nil, errors.Wrap(err, "failed to get head state validators")
+			return nil, errors.Wrapf(err, "failed to decode validator pubkey `%s`", stateValidator.Validator.Pubkey)
 		}
 
-		blockHeader, err := c.headBlockHeaders(ctx)
+		validatorIndex, err := strconv.ParseUint(stateValidator.Index, 10, 64)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get head block headers")
+			return nil, errors.Wrapf(err, "failed to parse validator index `%s`", stateValidator.Index)
 		}
 
-		slot, err := strconv.ParseUint(blockHeader.Data.Header.Message.Slot, 10, 64)
+		balance, err := strconv.ParseUint(stateValidator.Balance, 10, 64)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to parse header slot `%s`", blockHeader.Data.Header.Message.Slot)
+			return nil, errors.Wrapf(err, "failed to parse validator balance `%s`", stateValidator.Balance)
 		}
 
-		epoch = slots.ToEpoch(primitives.Slot(slot))
-	default:
-		return nil, errors.Errorf("unsupported query filter type `%v`", reflect.TypeOf(queryFilter))
+		balances[idx-start] = &ethpb.ValidatorBalances_Balance{
+			PublicKey: pubkey,
+			Index:     primitives.ValidatorIndex(validatorIndex),
+			Balance:   balance,
+			Status:    stateValidator.Status,
+		}
 	}
 
-	if stateValidators.Data == nil {
-		return nil, errors.New("state validators data is nil")
+	return &ethpb.ValidatorBalances{
+		Epoch:         epoch,
+		Balances:      balances,
+		NextPageToken: nextPageToken(pageToken, end, len(stateValidators.Data)),
+		TotalSize:     int32(len(stateValidators.Data)),
+	}, nil
+}
+
+func (c beaconApiChainClient) Validators(ctx context.Context, in *ethpb.ListValidatorsRequest) (*ethpb.Validators, error) {
+	pageToken, pageSize, err := parsePage(in.PageSize, in.PageToken)
+	if err != nil {
+		return nil, err
 	}
 
-	start := pageToken * uint64(pageSize)
-	if start > uint64(len(stateValidators.Data)) {
-		start = uint64(len(stateValidators.Data))
+	var statuses []string
+	if in.Active {
+		statuses = []string{"active"}
 	}
 
-	end := start + uint64(pageSize)
-	if end > uint64(len(stateValidators.Data)) {
-		end = uint64(len(stateValidators.Data))
+	stateValidators, epoch, err := c.stateValidators(ctx, in.QueryFilter, in.PublicKeys, in.Indices, statuses)
+	if err != nil {
+		return nil, err
 	}
 
+	start, end := pageRange(pageToken, pageSize, len(stateValidators.Data))
 	validators := make([]*ethpb.Validators_ValidatorContainer, end-start)
 	for idx := start; idx < end; idx++ {
 		stateValidator := stateValidators.Data[idx]
@@ -297,19 +288,112 @@ func (c beaconApiChainClient) Validators(ctx context.Context, in *ethpb.ListVali
 		}
 	}
 
-	var nextPageToken string
-	if end < uint64(len(stateValidators.Data)) {
-		nextPageToken = strconv.FormatUint(pageToken+1, 10)
-	}
-
 	return &ethpb.Validators{
 		TotalSize:     int32(len(stateValidators.Data)),
 		Epoch:         epoch,
 		ValidatorList: validators,
-		NextPageToken: nextPageToken,
+		NextPageToken: nextPageToken(pageToken, end, len(stateValidators.Data)),
 	}, nil
 }
 
+// stateValidators returns the validators of the state the query filter of a ListValidatorsRequest selects, the head
+// state without one, and the epoch of that state.
+func (c beaconApiChainClient) stateValidators(
+	ctx context.Context,
+	queryFilter interface{},
+	publicKeys [][]byte,
+	indices []primitives.ValidatorIndex,
+	statuses []string,
+) (*structs.GetValidatorsResponse, primitives.Epoch, error) {
+	pubkeys := make([]string, len(publicKeys))
+	for idx, pubkey := range publicKeys {
+		pubkeys[idx] = hexutil.Encode(pubkey)
+	}
+
+	var stateValidators *structs.GetValidatorsResponse
+	var epoch primitives.Epoch
+	var err error
+
+	switch queryFilter := queryFilter.(type) {
+	case *ethpb.ListValidatorsRequest_Epoch:
+		slot, err := slots.EpochStart(queryFilter.Epoch)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to get first slot for epoch `%d`", queryFilter.Epoch)
+		}
+		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForSlot(ctx, slot, pubkeys, indices, statuses); err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to get state validators for slot `%d`", slot)
+		}
+		epoch = slots.ToEpoch(slot)
+	case *ethpb.ListValidatorsRequest_Genesis:
+		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForSlot(ctx, 0, pubkeys, indices, statuses); err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to get genesis state validators")
+		}
+		epoch = 0
+	case nil:
+		if stateValidators, err = c.stateValidatorsProvider.StateValidatorsForHead(ctx, pubkeys, indices, statuses); err != nil {
+			return nil, 0, errors.Wrap(err, "failed to get head state validators")
+		}
+
+		blockHeader, err := c.headBlockHeaders(ctx)
+		if err != nil {
+			return nil, 0, errors.Wrap(err, "failed to get head block headers")
+		}
+
+		slot, err := strconv.ParseUint(blockHeader.Data.Header.Message.Slot, 10, 64)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to parse header slot `%s`", blockHeader.Data.Header.Message.Slot)
+		}
+
+		epoch = slots.ToEpoch(primitives.Slot(slot))
+	default:
+		return nil, 0, errors.Errorf("unsupported query filter type `%v`", reflect.TypeOf(queryFilter))
+	}
+
+	if stateValidators.Data == nil {
+		return nil, 0, errors.New("state validators data is nil")
+	}
+	return stateValidators, epoch, nil
+}
+
+// parsePage returns the requested page and its size. We follow the gRPC behavior here, which returns a maximum of 250
+// results when pageSize == 0.
+func parsePage(pageSize int32, token string) (uint64, uint64, error) {
+	if pageSize == 0 {
+		pageSize = 250
+	}
+
+	var pageToken uint64
+	if token != "" {
+		var err error
+		if pageToken, err = strconv.ParseUint(token, 10, 64); err != nil {
+			return 0, 0, errors.Wrapf(err, "failed to parse page token `%s`", token)
+		}
+	}
+	return pageToken, uint64(pageSize), nil
+}
+
+// pageRange returns the bounds of the page among the total results.
+func pageRange(pageToken, pageSize uint64, total int) (uint64, uint64) {
+	start := pageToken * pageSize
+	if start > uint64(total) {
+		start = uint64(total)
+	}
+
+	end := start + pageSize
+	if end > uint64(total) {
+		end = uint64(total)
+	}
+	return start, end
+}
+
+// nextPageToken returns the token of the page after the one ending at end, or nothing for the last page.
+func nextPageToken(pageToken, end uint64, total int) string {
+	if end < uint64(total) {
+		return strconv.FormatUint(pageToken+1, 10)
+	}
+	return ""
+}
+
 func (c beaconApiChainClient) ValidatorQueue(ctx context.Context, in *empty.Empty) (*ethpb.ValidatorQueue, error) {
 	if c.fallbackClient != nil {
 		return c.fallbackClient.ValidatorQueue(ctx, in)
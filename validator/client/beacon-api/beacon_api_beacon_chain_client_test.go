@@ -580,6 +580,94 @@ func TestListValidators(t *testing.T) {
 	})
 }
 
+func TestListValidatorBalances(t *testing.T) {
+	stateValidator := func(index, balance string) *structs.ValidatorContainer {
+		return &structs.ValidatorContainer{
+			Index:     index,
+			Balance:   balance,
+			Status:    "active_ongoing",
+			Validator: &structs.Validator{Pubkey: hexutil.Encode([]byte{1})},
+		}
+	}
+
+	t.Run("paginates balances for epoch filter", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		pubkeys := [][]byte{{1}}
+		stateValidatorsProvider := mock.NewMockStateValidatorsProvider(ctrl)
+		stateValidatorsProvider.EXPECT().StateValidatorsForSlot(
+			gomock.Any(),
+			primitives.Slot(64),
+			[]string{hexutil.Encode([]byte{1})},
+			[]primitives.ValidatorIndex{},
+			nil,
+		).Return(
+			&structs.GetValidatorsResponse{
+				Data: []*structs.ValidatorContainer{
+					stateValidator("1", "32000000000"),
+					stateValidator("2", "31000000000"),
+					stateValidator("3", "30000000000"),
+				},
+			},
+			nil,
+		).Times(2)
+
+		beaconChainClient := beaconApiChainClient{stateValidatorsProvider: stateValidatorsProvider}
+		balances, err := beaconChainClient.ValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+			QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 2},
+			PublicKeys:  pubkeys,
+			Indices:     []primitives.ValidatorIndex{},
+			PageSize:    2,
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.ValidatorBalances{
+			Epoch: 2,
+			Balances: []*ethpb.ValidatorBalances_Balance{
+				{PublicKey: []byte{1}, Index: 1, Balance: 32000000000, Status: "active_ongoing"},
+				{PublicKey: []byte{1}, Index: 2, Balance: 31000000000, Status: "active_ongoing"},
+			},
+			NextPageToken: "1",
+			TotalSize:     3,
+		}, balances)
+
+		balances, err = beaconChainClient.ValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+			QueryFilter: &ethpb.ListValidatorBalancesRequest_Epoch{Epoch: 2},
+			PublicKeys:  pubkeys,
+			Indices:     []primitives.ValidatorIndex{},
+			PageSize:    2,
+			PageToken:   "1",
+		})
+		require.NoError(t, err)
+		assert.DeepEqual(t, &ethpb.ValidatorBalances{
+			Epoch: 2,
+			Balances: []*ethpb.ValidatorBalances_Balance{
+				{PublicKey: []byte{1}, Index: 3, Balance: 30000000000, Status: "active_ongoing"},
+			},
+			TotalSize: 3,
+		}, balances)
+	})
+
+	t.Run("fails to parse balance", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		ctx := context.Background()
+
+		stateValidatorsProvider := mock.NewMockStateValidatorsProvider(ctrl)
+		stateValidatorsProvider.EXPECT().StateValidatorsForSlot(gomock.Any(), primitives.Slot(0), gomock.Any(), gomock.Any(), gomock.Any()).Return(
+			&structs.GetValidatorsResponse{Data: []*structs.ValidatorContainer{stateValidator("1", "foo")}},
+			nil,
+		)
+
+		beaconChainClient := beaconApiChainClient{stateValidatorsProvider: stateValidatorsProvider}
+		_, err := beaconChainClient.ValidatorBalances(ctx, &ethpb.ListValidatorBalancesRequest{
+			QueryFilter: &ethpb.ListValidatorBalancesRequest_Genesis{Genesis: true},
+		})
+		assert.ErrorContains(t, "failed to parse validator balance `foo`", err)
+	})
+}
+
 func TestGetChainHead(t *testing.T) {
 	const finalityCheckpointsEndpoint = "/eth/v1/beacon/states/head/finality_checkpoints"
 	const headBlockHeadersEndpoint = "/eth/v1/beacon/headers/head"
@@ -2,7 +2,9 @@ package beacon_api
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/golang/protobuf/ptypes/empty"
@@ -99,8 +101,30 @@ func (c *beaconApiNodeClient) Peers(ctx context.Context, in *empty.Empty) (*ethp
 		return c.fallbackClient.Peers(ctx, in)
 	}
 
-	// TODO: Implement me
-	panic("beaconApiNodeClient.Peers is not implemented. To use a fallback client, pass a fallback client as the last argument of NewBeaconApiNodeClientWithFallback.")
+	// Like the gRPC API, only the connected peers are returned, with their ENR not prefixed with "enr:".
+	var peersResponse structs.GetPeersResponse
+	if err := c.jsonRestHandler.Get(ctx, "/eth/v1/node/peers?state=connected", &peersResponse); err != nil {
+		return nil, err
+	}
+
+	peers := make([]*ethpb.Peer, 0, len(peersResponse.Data))
+	for _, p := range peersResponse.Data {
+		if p == nil {
+			return nil, errors.New("peer is nil")
+		}
+		address := p.LastSeenP2PAddress
+		if address == "" {
+			address = "unknown"
+		}
+		peers = append(peers, &ethpb.Peer{
+			Address:         fmt.Sprintf("%s/p2p/%s", address, p.PeerId),
+			Direction:       ethpb.PeerDirection(ethpb.PeerDirection_value[strings.ToUpper(p.Direction)]),
+			ConnectionState: ethpb.ConnectionState(ethpb.ConnectionState_value[strings.ToUpper(p.State)]),
+			PeerId:          p.PeerId,
+			Enr:             strings.TrimPrefix(p.Enr, "enr:"),
+		})
+	}
+	return &ethpb.Peers{Peers: peers}, nil
 }
 
 func (c *beaconApiNodeClient) IsHealthy(ctx context.Context) bool {
@@ -288,3 +288,58 @@ func TestGetVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPeers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	var peersResponse structs.GetPeersResponse
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().Get(
+		gomock.Any(),
+		"/eth/v1/node/peers?state=connected",
+		&peersResponse,
+	).Return(
+		nil,
+	).SetArg(
+		2,
+		structs.GetPeersResponse{
+			Data: []*structs.Peer{
+				{
+					PeerId:             "16Uiu2HAm",
+					Enr:                "enr:-IS4QHC",
+					LastSeenP2PAddress: "/ip4/127.0.0.1/tcp/13000",
+					State:              "connected",
+					Direction:          "outbound",
+				},
+				{
+					PeerId:    "16Uiu2HAn",
+					State:     "connected",
+					Direction: "inbound",
+				},
+			},
+		},
+	)
+
+	nodeClient := &beaconApiNodeClient{jsonRestHandler: jsonRestHandler}
+	peers, err := nodeClient.Peers(ctx, &emptypb.Empty{})
+	assert.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Peers{
+		Peers: []*ethpb.Peer{
+			{
+				Address:         "/ip4/127.0.0.1/tcp/13000/p2p/16Uiu2HAm",
+				Direction:       ethpb.PeerDirection_OUTBOUND,
+				ConnectionState: ethpb.ConnectionState_CONNECTED,
+				PeerId:          "16Uiu2HAm",
+				Enr:             "-IS4QHC",
+			},
+			{
+				Address:         "unknown/p2p/16Uiu2HAn",
+				Direction:       ethpb.PeerDirection_INBOUND,
+				ConnectionState: ethpb.ConnectionState_CONNECTED,
+				PeerId:          "16Uiu2HAn",
+			},
+		},
+	}, peers)
+}
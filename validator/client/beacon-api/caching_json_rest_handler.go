@@ -0,0 +1,170 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"golang.org/x/sync/singleflight"
+)
+
+// staticResponseTTL is how long the responses which only change when the beacon node restarts are cached.
+const staticResponseTTL = time.Hour
+
+// Endpoints whose responses only change when the beacon node restarts.
+var staticEndpoints = map[string]bool{
+	"/eth/v1/beacon/genesis":          true,
+	"/eth/v1/config/spec":             true,
+	"/eth/v1/config/fork_schedule":    true,
+	"/eth/v1/config/deposit_contract": true,
+}
+
+// Prefixes of the duties endpoints, whose responses are cached for a slot.
+var (
+	dutiesGetPrefixes  = []string{"/eth/v1/validator/duties/proposer/"}
+	dutiesPostPrefixes = []string{"/eth/v1/validator/duties/attester/", "/eth/v1/validator/duties/sync/"}
+)
+
+type cachedResponse struct {
+	body    json.RawMessage
+	expires time.Time
+}
+
+// cachingJsonRestHandler is a JsonRestHandler caching the responses of the idempotent beacon API calls of the
+// validator client, and sending concurrent identical calls to the beacon node once.
+type cachingJsonRestHandler struct {
+	JsonRestHandler
+	requests  singleflight.Group
+	lock      sync.Mutex
+	responses map[string]*cachedResponse
+}
+
+// NewCachingJsonRestHandler returns a JsonRestHandler caching the genesis, spec, fork schedule and deposit contract
+// responses of the handler for an hour, and the proposer, attester and sync committee duties for a slot. Concurrent
+// identical requests to these endpoints, such as the requests of the duties of every key, are sent once. The cache
+// is cleared when the host changes.
+func NewCachingJsonRestHandler(handler JsonRestHandler) JsonRestHandler {
+	return &cachingJsonRestHandler{
+		JsonRestHandler: handler,
+		responses:       make(map[string]*cachedResponse),
+	}
+}
+
+// Get returns the cached response of the endpoint, or sends the request to the beacon node.
+func (c *cachingJsonRestHandler) Get(ctx context.Context, endpoint string, resp interface{}) error {
+	ttl, ok := cacheTTL(http.MethodGet, endpoint)
+	if !ok {
+		return c.JsonRestHandler.Get(ctx, endpoint, resp)
+	}
+	return c.cached(http.MethodGet+" "+endpoint, ttl, resp, func(raw *json.RawMessage) error {
+		return c.JsonRestHandler.Get(ctx, endpoint, raw)
+	})
+}
+
+// Post returns the cached response of the endpoint for the same request body, or sends the request to the beacon
+// node.
+func (c *cachingJsonRestHandler) Post(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer, resp interface{}) error {
+	ttl, ok := cacheTTL(http.MethodPost, endpoint)
+	if !ok || len(headers) > 0 || data == nil {
+		return c.JsonRestHandler.Post(ctx, endpoint, headers, data, resp)
+	}
+	body := data.Bytes()
+	return c.cached(http.MethodPost+" "+endpoint+" "+string(body), ttl, resp, func(raw *json.RawMessage) error {
+		return c.JsonRestHandler.Post(ctx, endpoint, headers, bytes.NewBuffer(body), raw)
+	})
+}
+
+// SetHost clears the responses cached from the previous host.
+func (c *cachingJsonRestHandler) SetHost(host string) {
+	c.lock.Lock()
+	c.responses = make(map[string]*cachedResponse)
+	c.lock.Unlock()
+	c.JsonRestHandler.SetHost(host)
+}
+
+func (c *cachingJsonRestHandler) cached(key string, ttl time.Duration, resp interface{}, fetch func(raw *json.RawMessage) error) error {
+	c.lock.Lock()
+	r, ok := c.responses[key]
+	c.lock.Unlock()
+	if ok && time.Now().Before(r.expires) {
+		beaconApiCacheCount.WithLabelValues("hit").Inc()
+		return decodeRawResponse(r.body, resp)
+	}
+
+	v, err, shared := c.requests.Do(key, func() (interface{}, error) {
+		var raw json.RawMessage
+		if err := fetch(&raw); err != nil {
+			return nil, err
+		}
+		c.store(key, raw, ttl)
+		return raw, nil
+	})
+	if shared {
+		beaconApiCacheCount.WithLabelValues("coalesced").Inc()
+	} else {
+		beaconApiCacheCount.WithLabelValues("miss").Inc()
+	}
+	if err != nil {
+		return err
+	}
+	raw, ok := v.(json.RawMessage)
+	if !ok {
+		return errors.Errorf("unexpected cached response type %T", v)
+	}
+	return decodeRawResponse(raw, resp)
+}
+
+func (c *cachingJsonRestHandler) store(key string, raw json.RawMessage, ttl time.Duration) {
+	now := time.Now()
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for k, r := range c.responses {
+		if !now.Before(r.expires) {
+			delete(c.responses, k)
+		}
+	}
+	c.responses[key] = &cachedResponse{body: raw, expires: now.Add(ttl)}
+}
+
+func decodeRawResponse(raw json.RawMessage, resp interface{}) error {
+	// The response is empty for requests that do not return anything.
+	if resp == nil || len(raw) == 0 {
+		return nil
+	}
+	return errors.Wrap(json.Unmarshal(raw, resp), "failed to decode response")
+}
+
+// cacheTTL returns how long the response of the request is cached, and false if it is not cached.
+func cacheTTL(method, endpoint string) (time.Duration, bool) {
+	path, _, _ := strings.Cut(endpoint, "?")
+	dutiesTTL := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	switch method {
+	case http.MethodGet:
+		if staticEndpoints[path] {
+			return staticResponseTTL, true
+		}
+		if hasAnyPrefix(path, dutiesGetPrefixes) {
+			return dutiesTTL, true
+		}
+	case http.MethodPost:
+		if hasAnyPrefix(path, dutiesPostPrefixes) {
+			return dutiesTTL, true
+		}
+	}
+	return 0, false
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
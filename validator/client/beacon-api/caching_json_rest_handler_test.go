@@ -0,0 +1,167 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+type countingServer struct {
+	*httptest.Server
+	lock  sync.Mutex
+	count map[string]int
+}
+
+func newCountingServer(t *testing.T, handler func(w http.ResponseWriter, r *http.Request)) *countingServer {
+	s := &countingServer{count: make(map[string]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.lock.Lock()
+		s.count[r.URL.Path]++
+		s.lock.Unlock()
+		handler(w, r)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *countingServer) requests(path string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.count[path]
+}
+
+func writeJson(t *testing.T, w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	require.NoError(t, json.NewEncoder(w).Encode(v))
+}
+
+func TestCachingJsonRestHandler_Get(t *testing.T) {
+	ctx := context.Background()
+	genesis := &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: "123", GenesisValidatorsRoot: "0x456"}}
+	srv := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJson(t, w, genesis)
+	})
+	handler := NewCachingJsonRestHandler(NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL))
+
+	for i := 0; i < 3; i++ {
+		resp := &structs.GetGenesisResponse{}
+		require.NoError(t, handler.Get(ctx, "/eth/v1/beacon/genesis", resp))
+		assert.DeepEqual(t, genesis, resp)
+	}
+	assert.Equal(t, 1, srv.requests("/eth/v1/beacon/genesis"))
+
+	// Other endpoints are not cached.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, handler.Get(ctx, "/eth/v1/node/syncing", &structs.SyncStatusResponse{}))
+	}
+	assert.Equal(t, 2, srv.requests("/eth/v1/node/syncing"))
+
+	// Expired responses are requested again.
+	c, ok := handler.(*cachingJsonRestHandler)
+	require.Equal(t, true, ok)
+	c.lock.Lock()
+	c.responses[http.MethodGet+" /eth/v1/beacon/genesis"].expires = time.Now()
+	c.lock.Unlock()
+	require.NoError(t, handler.Get(ctx, "/eth/v1/beacon/genesis", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 2, srv.requests("/eth/v1/beacon/genesis"))
+
+	// The cache is cleared when the host changes.
+	handler.SetHost(srv.URL)
+	require.NoError(t, handler.Get(ctx, "/eth/v1/beacon/genesis", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 3, srv.requests("/eth/v1/beacon/genesis"))
+}
+
+func TestCachingJsonRestHandler_PostDuties(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/eth/v1/validator/duties/attester/1"
+	srv := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var indices []string
+		require.NoError(t, json.Unmarshal(body, &indices))
+		duties := &structs.GetAttesterDutiesResponse{DependentRoot: "0x01"}
+		for _, i := range indices {
+			duties.Data = append(duties.Data, &structs.AttesterDuty{ValidatorIndex: i})
+		}
+		writeJson(t, w, duties)
+	})
+	handler := NewCachingJsonRestHandler(NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL))
+
+	post := func(body string) *structs.GetAttesterDutiesResponse {
+		resp := &structs.GetAttesterDutiesResponse{}
+		require.NoError(t, handler.Post(ctx, endpoint, nil, bytes.NewBufferString(body), resp))
+		return resp
+	}
+	assert.Equal(t, "1", post(`["1"]`).Data[0].ValidatorIndex)
+	assert.Equal(t, "1", post(`["1"]`).Data[0].ValidatorIndex)
+	assert.Equal(t, 1, srv.requests(endpoint))
+	// The responses are cached by request body.
+	assert.Equal(t, "2", post(`["2"]`).Data[0].ValidatorIndex)
+	assert.Equal(t, 2, srv.requests(endpoint))
+}
+
+func TestCachingJsonRestHandler_Coalesce(t *testing.T) {
+	ctx := context.Background()
+	const endpoint = "/eth/v1/validator/duties/proposer/1"
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var first atomic.Bool
+	srv := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if first.CompareAndSwap(false, true) {
+			close(started)
+		}
+		<-release
+		writeJson(t, w, &structs.GetProposerDutiesResponse{DependentRoot: "0x01"})
+	})
+	handler := NewCachingJsonRestHandler(NewBeaconApiJsonRestHandler(http.Client{Timeout: 5 * time.Second}, srv.URL))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := &structs.GetProposerDutiesResponse{}
+			assert.NoError(t, handler.Get(ctx, endpoint, resp))
+			assert.Equal(t, "0x01", resp.DependentRoot)
+		}()
+	}
+	<-started
+	close(release)
+	wg.Wait()
+	assert.Equal(t, 1, srv.requests(endpoint))
+}
+
+func TestCachingJsonRestHandler_ErrorsNotCached(t *testing.T) {
+	ctx := context.Background()
+	var fail atomic.Bool
+	fail.Store(true)
+	srv := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.Header().Set("Content-Type", api.JsonMediaType)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, err := w.Write([]byte(`{"code": 503, "message": "syncing"}`))
+			require.NoError(t, err)
+			return
+		}
+		writeJson(t, w, &structs.GetSpecResponse{Data: map[string]string{"SECONDS_PER_SLOT": "12"}})
+	})
+	handler := NewCachingJsonRestHandler(NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL))
+
+	require.ErrorContains(t, "syncing", handler.Get(ctx, "/eth/v1/config/spec", &structs.GetSpecResponse{}))
+	fail.Store(false)
+	resp := &structs.GetSpecResponse{}
+	require.NoError(t, handler.Get(ctx, "/eth/v1/config/spec", resp))
+	assert.Equal(t, 2, srv.requests("/eth/v1/config/spec"))
+}
@@ -0,0 +1,189 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+)
+
+// retryBackoff is the time waited before retrying a failed request.
+var retryBackoff = 250 * time.Millisecond
+
+type retryBudgetKey struct{}
+
+// retryBudget counts the retries of the requests of a duty.
+type retryBudget struct {
+	used atomic.Int64
+}
+
+// WithRetryBudget returns a context whose requests share a budget of retries, so that the failed requests of a duty
+// are retried without flooding a struggling beacon node. The size of the budget is set by the handler.
+func WithRetryBudget(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryBudgetKey{}, &retryBudget{})
+}
+
+// takeRetry returns whether the request may be retried within the retry budget of its context.
+func takeRetry(ctx context.Context, retries int) bool {
+	b, ok := ctx.Value(retryBudgetKey{}).(*retryBudget)
+	if !ok {
+		return false
+	}
+	return b.used.Add(1) <= int64(retries)
+}
+
+// hedgingJsonRestHandler is a JsonRestHandler sending a request which is slower than the hedge delay to a secondary
+// beacon node too, and using the first successful response. Failed requests are retried within the retry budget of
+// their context.
+type hedgingJsonRestHandler struct {
+	JsonRestHandler
+	client     http.Client
+	hosts      []string
+	hedgeDelay time.Duration
+	retries    int
+}
+
+// NewHedgingJsonRestHandler returns a JsonRestHandler sending the requests to the host of the handler, and, when no
+// response arrived after hedgeDelay or the host failed, also to the host following it in hosts. Hedging is disabled
+// when hedgeDelay is 0 or there is a single host. Requests which failed because of the beacon node or the connection
+// to it are retried, at most retries times for all the requests of a context created with WithRetryBudget.
+func NewHedgingJsonRestHandler(handler JsonRestHandler, client http.Client, hosts []string, hedgeDelay time.Duration, retries int) JsonRestHandler {
+	return &hedgingJsonRestHandler{
+		JsonRestHandler: handler,
+		client:          client,
+		hosts:           hosts,
+		hedgeDelay:      hedgeDelay,
+		retries:         retries,
+	}
+}
+
+// Get sends a GET request, hedged and retried.
+func (c *hedgingJsonRestHandler) Get(ctx context.Context, endpoint string, resp interface{}) error {
+	return c.do(ctx, resp, func(ctx context.Context, h JsonRestHandler, raw *json.RawMessage) error {
+		return h.Get(ctx, endpoint, raw)
+	})
+}
+
+// Post sends a POST request, hedged and retried.
+func (c *hedgingJsonRestHandler) Post(ctx context.Context, endpoint string, headers map[string]string, data *bytes.Buffer, resp interface{}) error {
+	if data == nil {
+		return c.JsonRestHandler.Post(ctx, endpoint, headers, data, resp)
+	}
+	body := data.Bytes()
+	return c.do(ctx, resp, func(ctx context.Context, h JsonRestHandler, raw *json.RawMessage) error {
+		return h.Post(ctx, endpoint, headers, bytes.NewBuffer(body), raw)
+	})
+}
+
+func (c *hedgingJsonRestHandler) do(ctx context.Context, resp interface{}, call func(context.Context, JsonRestHandler, *json.RawMessage) error) error {
+	for {
+		raw, err := c.hedged(ctx, call)
+		if err == nil {
+			return decodeRawResponse(raw, resp)
+		}
+		if !isRetryable(ctx, err) || !takeRetry(ctx, c.retries) {
+			return err
+		}
+		retriedRequestsCount.Inc()
+		select {
+		case <-time.After(retryBackoff):
+		case <-ctx.Done():
+			return err
+		}
+	}
+}
+
+type hedgedResult struct {
+	raw       json.RawMessage
+	err       error
+	secondary bool
+}
+
+// hedged sends the request to the host of the handler, and to the secondary host if it is slower than the hedge
+// delay, returning the first successful response, or the last error if both fail.
+func (c *hedgingJsonRestHandler) hedged(ctx context.Context, call func(context.Context, JsonRestHandler, *json.RawMessage) error) (json.RawMessage, error) {
+	secondary := c.secondaryHost()
+	if c.hedgeDelay <= 0 || secondary == "" {
+		var raw json.RawMessage
+		err := call(ctx, c.JsonRestHandler, &raw)
+		return raw, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := make(chan hedgedResult, 2)
+	send := func(h JsonRestHandler, secondary bool) {
+		var raw json.RawMessage
+		err := call(ctx, h, &raw)
+		results <- hedgedResult{raw: raw, err: err, secondary: secondary}
+	}
+	go send(c.JsonRestHandler, false)
+	timer := time.NewTimer(c.hedgeDelay)
+	defer timer.Stop()
+
+	pending := 1
+	hedged := false
+	var err error
+	for pending > 0 {
+		select {
+		case <-timer.C:
+			if hedged {
+				continue
+			}
+			hedgedRequestsCount.WithLabelValues("sent").Inc()
+			hedged = true
+			pending++
+			go send(NewBeaconApiJsonRestHandler(c.client, secondary), true)
+		case r := <-results:
+			pending--
+			if r.err == nil {
+				if r.secondary {
+					hedgedRequestsCount.WithLabelValues("won").Inc()
+				}
+				return r.raw, nil
+			}
+			err = r.err
+			// Send the request to the secondary host right away when the primary host fails.
+			if !hedged && pending == 0 && isRetryable(ctx, r.err) {
+				hedgedRequestsCount.WithLabelValues("sent").Inc()
+				hedged = true
+				pending++
+				timer.Stop()
+				go send(NewBeaconApiJsonRestHandler(c.client, secondary), true)
+			}
+		}
+	}
+	return nil, err
+}
+
+// secondaryHost returns the host following the current host, or an empty string if there is a single host.
+func (c *hedgingJsonRestHandler) secondaryHost() string {
+	if len(c.hosts) < 2 {
+		return ""
+	}
+	current := c.JsonRestHandler.Host()
+	for i, h := range c.hosts {
+		if h == current {
+			return c.hosts[(i+1)%len(c.hosts)]
+		}
+	}
+	return c.hosts[0]
+}
+
+// isRetryable returns whether the request may succeed when sent again: the beacon node failed or could not be
+// reached, and the request did not time out.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	jsonErr := &httputil.DefaultJsonError{}
+	if errors.As(err, &jsonErr) {
+		return jsonErr.Code >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package beacon_api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func writeJsonError(t *testing.T, w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", api.JsonMediaType)
+	w.WriteHeader(code)
+	_, err := fmt.Fprintf(w, `{"code": %d, "message": "failed"}`, code)
+	require.NoError(t, err)
+}
+
+func TestHedgingJsonRestHandler_SlowPrimary(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	primary := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	secondary := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		writeJson(t, w, &structs.GetAttesterDutiesResponse{DependentRoot: string(body)})
+	})
+	client := http.Client{Timeout: 5 * time.Second}
+	handler := NewHedgingJsonRestHandler(NewBeaconApiJsonRestHandler(client, primary.URL), client, []string{primary.URL, secondary.URL}, 50*time.Millisecond, 0)
+
+	resp := &structs.GetAttesterDutiesResponse{}
+	require.NoError(t, handler.Post(context.Background(), "/eth/v1/validator/duties/attester/1", nil, bytes.NewBufferString(`["1"]`), resp))
+	assert.Equal(t, `["1"]`, resp.DependentRoot)
+	assert.Equal(t, 1, primary.requests("/eth/v1/validator/duties/attester/1"))
+	assert.Equal(t, 1, secondary.requests("/eth/v1/validator/duties/attester/1"))
+}
+
+func TestHedgingJsonRestHandler_FailedPrimary(t *testing.T) {
+	primary := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJsonError(t, w, http.StatusInternalServerError)
+	})
+	secondary := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		writeJson(t, w, &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: "123"}})
+	})
+	client := http.Client{Timeout: 5 * time.Second}
+	// The request is sent to the secondary host without waiting for the hedge delay.
+	handler := NewHedgingJsonRestHandler(NewBeaconApiJsonRestHandler(client, primary.URL), client, []string{primary.URL, secondary.URL}, time.Hour, 0)
+
+	resp := &structs.GetGenesisResponse{}
+	require.NoError(t, handler.Get(context.Background(), "/eth/v1/beacon/genesis", resp))
+	assert.Equal(t, "123", resp.Data.GenesisTime)
+
+	// After a failover the secondary host is the next host.
+	handler.SetHost(secondary.URL)
+	h, ok := handler.(*hedgingJsonRestHandler)
+	require.Equal(t, true, ok)
+	assert.Equal(t, primary.URL, h.secondaryHost())
+}
+
+func TestHedgingJsonRestHandler_RetryBudget(t *testing.T) {
+	defer func(b time.Duration) {
+		retryBackoff = b
+	}(retryBackoff)
+	retryBackoff = time.Millisecond
+
+	var failures atomic.Int64
+	srv := newCountingServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/bad" {
+			writeJsonError(t, w, http.StatusBadRequest)
+			return
+		}
+		if failures.Add(-1) >= 0 {
+			writeJsonError(t, w, http.StatusServiceUnavailable)
+			return
+		}
+		writeJson(t, w, &structs.GetGenesisResponse{Data: &structs.Genesis{GenesisTime: "123"}})
+	})
+	client := http.Client{Timeout: 5 * time.Second}
+	handler := NewHedgingJsonRestHandler(NewBeaconApiJsonRestHandler(client, srv.URL), client, []string{srv.URL}, 0, 2)
+
+	// The requests of a duty share its budget.
+	failures.Store(2)
+	ctx := WithRetryBudget(context.Background())
+	require.NoError(t, handler.Get(ctx, "/ok", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 3, srv.requests("/ok"))
+	failures.Store(1)
+	require.ErrorContains(t, "503", handler.Get(ctx, "/ok", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 4, srv.requests("/ok"))
+
+	// Requests outside of duties are not retried.
+	failures.Store(1)
+	require.ErrorContains(t, "503", handler.Get(context.Background(), "/ok", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 5, srv.requests("/ok"))
+
+	// Requests rejected by the beacon node are not retried.
+	require.ErrorContains(t, "400", handler.Get(WithRetryBudget(context.Background()), "/bad", &structs.GetGenesisResponse{}))
+	assert.Equal(t, 1, srv.requests("/bad"))
+}
@@ -31,4 +31,27 @@ var (
 		},
 		[]string{"action"},
 	)
+	beaconApiCacheCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_api_cache_count",
+			Help:      "Number of cacheable beacon API requests, by whether they were served from the cache, coalesced with a concurrent request or sent to the beacon node",
+		},
+		[]string{"result"},
+	)
+	hedgedRequestsCount = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_api_hedged_request_count",
+			Help:      "Number of beacon API requests sent to a secondary beacon node because the beacon node was slow or failed, and of their responses used",
+		},
+		[]string{"result"},
+	)
+	retriedRequestsCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "beacon_api_retried_request_count",
+			Help:      "Number of failed beacon API requests of duties retried within their retry budget",
+		},
+	)
 )
@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
 )
 
 func (c *beaconApiValidatorClient) proposeAttestation(ctx context.Context, attestation *ethpb.Attestation) (*ethpb.AttestResponse, error) {
@@ -14,18 +16,24 @@ func (c *beaconApiValidatorClient) proposeAttestation(ctx context.Context, attes
 		return nil, err
 	}
 
-	marshalledAttestation, err := json.Marshal(jsonifyAttestations([]*ethpb.Attestation{attestation}))
+	if err := c.attestations.submit(ctx, attestation, c.postAttestations); err != nil {
+		return nil, err
+	}
+
+	attestationDataRoot, err := attestation.Data.HashTreeRoot()
 	if err != nil {
+		return nil, errors.Wrap(err, "failed to compute attestation data root")
+	}
+
+	return &ethpb.AttestResponse{AttestationDataRoot: attestationDataRoot[:]}, nil
+}
+
+func (c *beaconApiValidatorClient) proposeAttestationElectra(ctx context.Context, attestation *ethpb.AttestationElectra) (*ethpb.AttestResponse, error) {
+	if err := checkNilAttestationElectra(attestation); err != nil {
 		return nil, err
 	}
 
-	if err = c.jsonRestHandler.Post(
-		ctx,
-		"/eth/v1/beacon/pool/attestations",
-		nil,
-		bytes.NewBuffer(marshalledAttestation),
-		nil,
-	); err != nil {
+	if err := c.electraAttestations.submit(ctx, attestation, c.postAttestationsElectra); err != nil {
 		return nil, err
 	}
 
@@ -37,25 +45,85 @@ func (c *beaconApiValidatorClient) proposeAttestation(ctx context.Context, attes
 	return &ethpb.AttestResponse{AttestationDataRoot: attestationDataRoot[:]}, nil
 }
 
+func (c *beaconApiValidatorClient) postAttestations(ctx context.Context, attestations []*ethpb.Attestation) error {
+	marshalledAttestations, err := json.Marshal(jsonifyAttestations(attestations))
+	if err != nil {
+		return err
+	}
+	return c.jsonRestHandler.Post(
+		ctx,
+		"/eth/v1/beacon/pool/attestations",
+		nil,
+		bytes.NewBuffer(marshalledAttestations),
+		nil,
+	)
+}
+
+// postAttestationsElectra posts the attestations of each fork in its own request, as the fork is given by a header.
+func (c *beaconApiValidatorClient) postAttestationsElectra(ctx context.Context, attestations []*ethpb.AttestationElectra) error {
+	byVersion := make(map[int][]*structs.AttestationElectra)
+	versions := make([]int, 0, 1)
+	for _, att := range attestations {
+		if _, ok := byVersion[att.Version()]; !ok {
+			versions = append(versions, att.Version())
+		}
+		byVersion[att.Version()] = append(byVersion[att.Version()], structs.AttElectraFromConsensus(att))
+	}
+	for _, v := range versions {
+		marshalledAttestations, err := json.Marshal(byVersion[v])
+		if err != nil {
+			return err
+		}
+		headers := map[string]string{"Eth-Consensus-Version": version.String(v)}
+		if err = c.jsonRestHandler.Post(
+			ctx,
+			"/eth/v2/beacon/pool/attestations",
+			headers,
+			bytes.NewBuffer(marshalledAttestations),
+			nil,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // checkNilAttestation returns error if attestation or any field of attestation is nil.
 func checkNilAttestation(attestation *ethpb.Attestation) error {
 	if attestation == nil {
 		return errors.New("attestation is nil")
 	}
+	return checkNilAttestationFields(attestation)
+}
+
+// checkNilAttestationElectra returns error if attestation or any field of attestation is nil.
+func checkNilAttestationElectra(attestation *ethpb.AttestationElectra) error {
+	if attestation == nil {
+		return errors.New("attestation is nil")
+	}
+	if err := checkNilAttestationFields(attestation); err != nil {
+		return err
+	}
+	if len(attestation.CommitteeBits) == 0 {
+		return errors.New("attestation committee bits is empty")
+	}
+	return nil
+}
 
-	if attestation.Data == nil {
+func checkNilAttestationFields(attestation ethpb.Att) error {
+	if attestation.GetData() == nil {
 		return errors.New("attestation data is nil")
 	}
 
-	if attestation.Data.Source == nil || attestation.Data.Target == nil {
+	if attestation.GetData().Source == nil || attestation.GetData().Target == nil {
 		return errors.New("source/target in attestation data is nil")
 	}
 
-	if len(attestation.AggregationBits) == 0 {
+	if len(attestation.GetAggregationBits()) == 0 {
 		return errors.New("attestation aggregation bits is empty")
 	}
 
-	if len(attestation.Signature) == 0 {
+	if len(attestation.GetSignature()) == 0 {
 		return errors.New("attestation signature is empty")
 	}
 
@@ -0,0 +1,166 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// restParity maps the calls of the validator client interfaces to the beacon API endpoints their beacon API
+// implementation sends requests to. Calls without endpoints do not need the beacon node.
+var restParity = map[string][]string{
+	"ValidatorClient.Duties": {
+		"POST /eth/v1/beacon/states/{state_id}/validators",
+		"POST /eth/v1/validator/duties/attester/{epoch}",
+		"GET /eth/v1/validator/duties/proposer/{epoch}",
+		"POST /eth/v1/validator/duties/sync/{epoch}",
+		"GET /eth/v1/beacon/states/{state_id}/committees",
+	},
+	"ValidatorClient.DomainData":              {"GET /eth/v1/beacon/genesis"},
+	"ValidatorClient.WaitForChainStart":       {"GET /eth/v1/beacon/genesis"},
+	"ValidatorClient.ValidatorIndex":          {"POST /eth/v1/beacon/states/{state_id}/validators"},
+	"ValidatorClient.ValidatorStatus":         {"POST /eth/v1/beacon/states/{state_id}/validators"},
+	"ValidatorClient.MultipleValidatorStatus": {"POST /eth/v1/beacon/states/{state_id}/validators"},
+	"ValidatorClient.BeaconBlock":             {"GET /eth/v3/validator/blocks/{slot}"},
+	"ValidatorClient.ProposeBeaconBlock":      {"POST /eth/v2/beacon/blocks", "POST /eth/v2/beacon/blinded_blocks"},
+	"ValidatorClient.PrepareBeaconProposer":   {"POST /eth/v1/validator/prepare_beacon_proposer"},
+	"ValidatorClient.AttestationData":         {"GET /eth/v1/validator/attestation_data"},
+	"ValidatorClient.ProposeAttestation":      {"POST /eth/v1/beacon/pool/attestations"},
+	"ValidatorClient.ProposeAttestationElectra": {
+		"POST /eth/v2/beacon/pool/attestations",
+	},
+	"ValidatorClient.SubmitAggregateSelectionProof": {
+		"GET /eth/v1/node/syncing",
+		"GET /eth/v1/validator/attestation_data",
+		"GET /eth/v1/validator/aggregate_attestation",
+	},
+	"ValidatorClient.SubmitAggregateSelectionProofElectra": {
+		"GET /eth/v1/node/syncing",
+		"GET /eth/v1/validator/attestation_data",
+		"GET /eth/v2/validator/aggregate_attestation",
+	},
+	"ValidatorClient.SubmitSignedAggregateSelectionProof":        {"POST /eth/v1/validator/aggregate_and_proofs"},
+	"ValidatorClient.SubmitSignedAggregateSelectionProofElectra": {"POST /eth/v2/validator/aggregate_and_proofs"},
+	"ValidatorClient.ProposeExit":                                {"POST /eth/v1/beacon/pool/voluntary_exits"},
+	"ValidatorClient.SubscribeCommitteeSubnets":                  {"POST /eth/v1/validator/beacon_committee_subscriptions"},
+	"ValidatorClient.CheckDoppelGanger": {
+		"GET /eth/v1/node/syncing",
+		"GET /eth/v1/beacon/states/{state_id}/fork",
+		"GET /eth/v1/beacon/headers",
+		"POST /eth/v1/beacon/states/{state_id}/validators",
+		"POST /eth/v1/validator/liveness/{epoch}",
+	},
+	"ValidatorClient.SyncMessageBlockRoot": {"GET /eth/v1/beacon/blocks/{block_id}/root"},
+	"ValidatorClient.SubmitSyncMessage":    {"POST /eth/v1/beacon/pool/sync_committees"},
+	"ValidatorClient.SyncSubcommitteeIndex": {
+		"POST /eth/v1/beacon/states/{state_id}/validators",
+		"POST /eth/v1/validator/duties/sync/{epoch}",
+	},
+	"ValidatorClient.SyncCommitteeContribution": {
+		"GET /eth/v1/beacon/blocks/{block_id}/root",
+		"GET /eth/v1/validator/sync_committee_contribution",
+	},
+	"ValidatorClient.SubmitSignedContributionAndProof": {"POST /eth/v1/validator/contribution_and_proofs"},
+	"ValidatorClient.SubmitValidatorRegistrations":     {"POST /eth/v1/validator/register_validator"},
+	"ValidatorClient.StartEventStream":                 {"GET /eth/v1/events"},
+	"ValidatorClient.EventStreamIsRunning":             nil,
+	"ValidatorClient.AggregatedSelections":             {"POST /eth/v1/validator/beacon_committee_selections"},
+	"ValidatorClient.AggregatedSyncSelections":         {"POST /eth/v1/validator/sync_committee_selections"},
+	"ValidatorClient.Host":                             nil,
+	"ValidatorClient.SetHost":                          nil,
+	"ChainClient.ChainHead": {
+		"GET /eth/v1/beacon/states/{state_id}/finality_checkpoints",
+		"GET /eth/v1/beacon/headers/{block_id}",
+	},
+	"ChainClient.ValidatorBalances": {
+		"POST /eth/v1/beacon/states/{state_id}/validators",
+		"GET /eth/v1/beacon/headers/{block_id}",
+	},
+	"ChainClient.Validators": {
+		"POST /eth/v1/beacon/states/{state_id}/validators",
+		"GET /eth/v1/beacon/headers/{block_id}",
+	},
+	"ChainClient.ValidatorPerformance": {"POST /prysm/validators/performance"},
+	"NodeClient.SyncStatus":            {"GET /eth/v1/node/syncing"},
+	"NodeClient.Genesis":               {"GET /eth/v1/beacon/genesis", "GET /eth/v1/config/deposit_contract"},
+	"NodeClient.Version":               {"GET /eth/v1/node/version"},
+	"NodeClient.Peers":                 {"GET /eth/v1/node/peers"},
+	"NodeClient.HealthTracker":         nil,
+	"PrysmChainClient.ValidatorCount":  {"GET /eth/v1/beacon/states/{state_id}/validator_count"},
+}
+
+// restGaps lists the calls of the validator client interfaces without a beacon API equivalent, and why.
+var restGaps = map[string]string{
+	"ValidatorClient.StreamDuties":         "the beacon API has no duties stream, duties are polled instead",
+	"ValidatorClient.FeeRecipientByPubKey": "not used by the validator client",
+	"ChainClient.ValidatorQueue":           "requires a gRPC fallback client",
+	"ChainClient.ValidatorParticipation":   "requires a gRPC fallback client",
+}
+
+// RESTGaps returns the calls of the validator client interfaces without a beacon API equivalent, and why.
+func RESTGaps() map[string]string {
+	gaps := make(map[string]string, len(restGaps))
+	for call, reason := range restGaps {
+		gaps[call] = reason
+	}
+	return gaps
+}
+
+// AuditRESTParity returns the calls of the validator client interfaces which the beacon node of the handler cannot
+// serve over the beacon API, with the paths of the endpoints it does not serve. A path is served unless the beacon node
+// responds to an OPTIONS request to it with 404 Not Found, so nothing is sent to the endpoints themselves.
+func AuditRESTParity(ctx context.Context, handler JsonRestHandler) (map[string][]string, error) {
+	served := make(map[string]bool)
+	missing := make(map[string][]string)
+	for _, call := range sortedCalls() {
+		for _, e := range restParity[call] {
+			_, template, _ := strings.Cut(e, " ")
+			path := restParityPath(template)
+			ok, checked := served[path]
+			if !checked {
+				var err error
+				if ok, err = servesPath(ctx, handler, path); err != nil {
+					return nil, err
+				}
+				served[path] = ok
+			}
+			if !ok {
+				missing[call] = append(missing[call], template)
+			}
+		}
+	}
+	return missing, nil
+}
+
+func servesPath(ctx context.Context, handler JsonRestHandler, path string) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodOptions, handler.Host()+path, nil)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create request for endpoint %s", path)
+	}
+	resp, err := handler.HttpClient().Do(req)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to perform request for endpoint %s", path)
+	}
+	if err := resp.Body.Close(); err != nil {
+		return false, errors.Wrap(err, "failed to close response body")
+	}
+	return resp.StatusCode != http.StatusNotFound, nil
+}
+
+// restParityPath fills the parameters of the path template with values every beacon node accepts.
+func restParityPath(template string) string {
+	r := strings.NewReplacer("{state_id}", "head", "{block_id}", "head", "{epoch}", "0", "{slot}", "0")
+	return r.Replace(template)
+}
+
+func sortedCalls() []string {
+	calls := make([]string, 0, len(restParity))
+	for call := range restParity {
+		calls = append(calls, call)
+	}
+	sort.Strings(calls)
+	return calls
+}
@@ -0,0 +1,81 @@
+package beacon_api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+func TestRESTParity_CoversInterfaces(t *testing.T) {
+	calls := make(map[string]bool)
+	for _, i := range []interface{}{
+		(*iface.ValidatorClient)(nil),
+		(*iface.ChainClient)(nil),
+		(*iface.NodeClient)(nil),
+		(*iface.PrysmChainClient)(nil),
+	} {
+		typ := reflect.TypeOf(i).Elem()
+		for j := 0; j < typ.NumMethod(); j++ {
+			call := typ.Name() + "." + typ.Method(j).Name
+			calls[call] = true
+			_, hasEndpoints := restParity[call]
+			_, isGap := restGaps[call]
+			assert.Equal(t, true, hasEndpoints != isGap, "%s must be listed either with its beacon API endpoints or as a gap", call)
+		}
+	}
+	for call := range restParity {
+		assert.Equal(t, true, calls[call], "%s is not a call of the validator client interfaces", call)
+	}
+	for call := range restGaps {
+		assert.Equal(t, true, calls[call], "%s is not a call of the validator client interfaces", call)
+	}
+}
+
+func TestRESTParity_EndpointsFormat(t *testing.T) {
+	for call, endpoints := range restParity {
+		for _, e := range endpoints {
+			method, path, ok := strings.Cut(e, " ")
+			require.Equal(t, true, ok, "endpoint %q of %s has no method", e, call)
+			assert.Equal(t, true, method == http.MethodGet || method == http.MethodPost, "endpoint %q of %s has an unexpected method", e, call)
+			assert.Equal(t, true, strings.HasPrefix(path, "/eth/") || strings.HasPrefix(path, "/prysm/"), "endpoint %q of %s has an unexpected path", e, call)
+			assert.Equal(t, false, strings.Contains(restParityPath(path), "{"), "endpoint %q of %s has an unknown parameter", e, call)
+		}
+	}
+}
+
+func TestAuditRESTParity(t *testing.T) {
+	// The beacon node serves every endpoint but the peers and sync committee duties endpoints.
+	mux := http.NewServeMux()
+	served := make(map[string]bool)
+	for _, endpoints := range restParity {
+		for _, e := range endpoints {
+			if served[e] || strings.HasPrefix(e, "GET /eth/v1/node/peers") || strings.HasPrefix(e, "POST /eth/v1/validator/duties/sync/") {
+				continue
+			}
+			served[e] = true
+			mux.HandleFunc(e, func(w http.ResponseWriter, r *http.Request) {})
+		}
+	}
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	missing, err := AuditRESTParity(context.Background(), NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL))
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[string][]string{
+		"NodeClient.Peers":                      {"/eth/v1/node/peers"},
+		"ValidatorClient.Duties":                {"/eth/v1/validator/duties/sync/{epoch}"},
+		"ValidatorClient.SyncSubcommitteeIndex": {"/eth/v1/validator/duties/sync/{epoch}"},
+	}, missing)
+
+	srv.Close()
+	_, err = AuditRESTParity(context.Background(), NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL))
+	assert.ErrorContains(t, "failed to perform request", err)
+}
@@ -0,0 +1,86 @@
+package beacon_api
+
+import (
+	"context"
+	"sync"
+)
+
+// submissionGroup groups the items submitted to an array endpoint of the beacon node pools, so that the duties of
+// thousands of keys don't each send a request. The first item is posted right away, and the items submitted while a
+// post is in flight are posted together once it completes. A nil group posts each item on its own.
+type submissionGroup[T any] struct {
+	lock    sync.Mutex
+	posting bool
+	pending []*groupedSubmission[T]
+}
+
+// groupedSubmission is an item waiting to be posted. done receives the result of its post.
+type groupedSubmission[T any] struct {
+	ctx  context.Context
+	item T
+	done chan error
+}
+
+func newSubmissionGroup[T any]() *submissionGroup[T] {
+	return &submissionGroup[T]{}
+}
+
+// submit posts the item with the items submitted at the same time, and returns once it is posted. As the beacon node
+// rejects the whole request when one item is invalid, the items of a failed request are posted again one by one, so
+// that each item gets its own result.
+func (g *submissionGroup[T]) submit(ctx context.Context, item T, post func(context.Context, []T) error) error {
+	if g == nil {
+		return post(ctx, []T{item})
+	}
+	s := &groupedSubmission[T]{ctx: ctx, item: item, done: make(chan error, 1)}
+	g.lock.Lock()
+	g.pending = append(g.pending, s)
+	if !g.posting {
+		g.posting = true
+		go g.flush(post)
+	}
+	g.lock.Unlock()
+
+	select {
+	case err := <-s.done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// flush posts the pending items until none is left. Each request is sent with the context of its first item.
+func (g *submissionGroup[T]) flush(post func(context.Context, []T) error) {
+	for {
+		g.lock.Lock()
+		batch := g.pending
+		g.pending = nil
+		if len(batch) == 0 {
+			g.posting = false
+			g.lock.Unlock()
+			return
+		}
+		g.lock.Unlock()
+
+		items := make([]T, len(batch))
+		for i, s := range batch {
+			items[i] = s.item
+		}
+		err := post(batch[0].ctx, items)
+		if err == nil || len(batch) == 1 {
+			for _, s := range batch {
+				s.done <- err
+			}
+			continue
+		}
+		var wg sync.WaitGroup
+		for _, s := range batch {
+			wg.Add(1)
+			go func(s *groupedSubmission[T]) {
+				defer wg.Done()
+				s.done <- post(s.ctx, []T{s.item})
+			}(s)
+		}
+		wg.Wait()
+	}
+}
@@ -0,0 +1,107 @@
+package beacon_api
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestSubmissionGroup_GroupsPendingItems(t *testing.T) {
+	g := newSubmissionGroup[int]()
+	first := make(chan struct{})
+	release := make(chan struct{})
+	var lock sync.Mutex
+	var posts [][]int
+	post := func(_ context.Context, items []int) error {
+		lock.Lock()
+		posts = append(posts, items)
+		n := len(posts)
+		lock.Unlock()
+		if n == 1 {
+			close(first)
+			<-release
+		}
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, g.submit(context.Background(), 0, post))
+	}()
+	<-first
+	// The items submitted while the first post is in flight are posted together.
+	for i := 1; i <= 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, g.submit(context.Background(), i, post))
+		}(i)
+	}
+	require.NoError(t, waitPending(g, 3))
+	close(release)
+	wg.Wait()
+
+	require.Equal(t, 2, len(posts))
+	assert.DeepEqual(t, []int{0}, posts[0])
+	assert.Equal(t, 3, len(posts[1]))
+}
+
+func TestSubmissionGroup_FailedGroupPostedOneByOne(t *testing.T) {
+	g := newSubmissionGroup[int]()
+	invalid := errors.New("invalid item")
+	post := func(_ context.Context, items []int) error {
+		for _, item := range items {
+			if item == 2 {
+				return invalid
+			}
+		}
+		return nil
+	}
+	g.lock.Lock()
+	g.posting = true
+	results := make([]chan error, 3)
+	for i := range results {
+		results[i] = make(chan error, 1)
+		g.pending = append(g.pending, &groupedSubmission[int]{ctx: context.Background(), item: i + 1, done: results[i]})
+	}
+	g.lock.Unlock()
+
+	g.flush(post)
+	assert.NoError(t, <-results[0])
+	require.ErrorIs(t, <-results[1], invalid)
+	assert.NoError(t, <-results[2])
+	assert.Equal(t, false, g.posting)
+}
+
+func TestSubmissionGroup_Nil(t *testing.T) {
+	var g *submissionGroup[int]
+	var posted []int
+	require.NoError(t, g.submit(context.Background(), 1, func(_ context.Context, items []int) error {
+		posted = items
+		return nil
+	}))
+	assert.DeepEqual(t, []int{1}, posted)
+}
+
+// waitPending waits until n items are pending in the group.
+func waitPending[T any](g *submissionGroup[T], n int) error {
+	for {
+		g.lock.Lock()
+		pending := len(g.pending)
+		g.lock.Unlock()
+		if pending == n {
+			return nil
+		}
+		if pending > n {
+			return errors.New("too many pending items")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -20,51 +20,66 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProof(
 	index primitives.ValidatorIndex,
 	committeeLength uint64,
 ) (*ethpb.AggregateSelectionResponse, error) {
-	isOptimistic, err := c.isOptimistic(ctx)
+	attestationDataRoot, err := c.aggregateAttestationDataRoot(ctx, in, committeeLength)
 	if err != nil {
 		return nil, err
 	}
 
-	// An optimistic validator MUST NOT participate in attestation. (i.e., sign across the DOMAIN_BEACON_ATTESTER, DOMAIN_SELECTION_PROOF or DOMAIN_AGGREGATE_AND_PROOF domains).
-	if isOptimistic {
-		return nil, errors.New("the node is currently optimistic and cannot serve validators")
-	}
-
-	isAggregator, err := helpers.IsAggregator(committeeLength, in.SlotSignature)
+	aggregateAttestationResponse, err := c.aggregateAttestation(ctx, in.Slot, attestationDataRoot[:])
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get aggregator status")
+		return nil, err
 	}
-	if !isAggregator {
-		return nil, errors.New("validator is not an aggregator")
+
+	var attData *structs.Attestation
+	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation data")
 	}
 
-	attestationData, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	aggregatedAttestation, err := convertAttestationToProto(attData)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to get attestation data for slot=%d and committee_index=%d", in.Slot, in.CommitteeIndex)
+		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
 	}
 
-	attestationDataRoot, err := attestationData.HashTreeRoot()
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: index,
+			Aggregate:       aggregatedAttestation,
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+func (c *beaconApiValidatorClient) submitAggregateSelectionProofElectra(
+	ctx context.Context,
+	in *ethpb.AggregateSelectionRequest,
+	index primitives.ValidatorIndex,
+	committeeLength uint64,
+) (*ethpb.AggregateSelectionElectraResponse, error) {
+	attestationDataRoot, err := c.aggregateAttestationDataRoot(ctx, in, committeeLength)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to calculate attestation data root")
+		return nil, err
 	}
 
-	aggregateAttestationResponse, err := c.aggregateAttestation(ctx, in.Slot, attestationDataRoot[:])
+	aggregateAttestationResponse, err := c.aggregateAttestationElectra(ctx, in.Slot, attestationDataRoot[:], in.CommitteeIndex)
 	if err != nil {
 		return nil, err
 	}
 
-	var attData *structs.Attestation
+	var attData *structs.AttestationElectra
 	if err := json.Unmarshal(aggregateAttestationResponse.Data, &attData); err != nil {
 		return nil, errors.Wrap(err, "failed to unmarshal aggregate attestation data")
 	}
+	if attData == nil {
+		return nil, errors.New("aggregate attestation is nil")
+	}
 
-	aggregatedAttestation, err := convertAttestationToProto(attData)
+	aggregatedAttestation, err := attData.ToConsensus()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert aggregate attestation json to proto")
 	}
 
-	return &ethpb.AggregateSelectionResponse{
-		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+	return &ethpb.AggregateSelectionElectraResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProofElectra{
 			AggregatorIndex: index,
 			Aggregate:       aggregatedAttestation,
 			SelectionProof:  in.SlotSignature,
@@ -72,6 +87,43 @@ func (c *beaconApiValidatorClient) submitAggregateSelectionProof(
 	}, nil
 }
 
+// aggregateAttestationDataRoot returns the root of the attestation data to aggregate, after checking that the node is
+// not optimistic and that the validator is an aggregator.
+func (c *beaconApiValidatorClient) aggregateAttestationDataRoot(
+	ctx context.Context,
+	in *ethpb.AggregateSelectionRequest,
+	committeeLength uint64,
+) ([32]byte, error) {
+	isOptimistic, err := c.isOptimistic(ctx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	// An optimistic validator MUST NOT participate in attestation. (i.e., sign across the DOMAIN_BEACON_ATTESTER, DOMAIN_SELECTION_PROOF or DOMAIN_AGGREGATE_AND_PROOF domains).
+	if isOptimistic {
+		return [32]byte{}, errors.New("the node is currently optimistic and cannot serve validators")
+	}
+
+	isAggregator, err := helpers.IsAggregator(committeeLength, in.SlotSignature)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to get aggregator status")
+	}
+	if !isAggregator {
+		return [32]byte{}, errors.New("validator is not an aggregator")
+	}
+
+	attestationData, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return [32]byte{}, errors.Wrapf(err, "failed to get attestation data for slot=%d and committee_index=%d", in.Slot, in.CommitteeIndex)
+	}
+
+	attestationDataRoot, err := attestationData.HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "failed to calculate attestation data root")
+	}
+	return attestationDataRoot, nil
+}
+
 func (c *beaconApiValidatorClient) aggregateAttestation(
 	ctx context.Context,
 	slot primitives.Slot,
@@ -89,3 +141,23 @@ func (c *beaconApiValidatorClient) aggregateAttestation(
 
 	return &aggregateAttestationResponse, nil
 }
+
+func (c *beaconApiValidatorClient) aggregateAttestationElectra(
+	ctx context.Context,
+	slot primitives.Slot,
+	attestationDataRoot []byte,
+	committeeIndex primitives.CommitteeIndex,
+) (*structs.AggregateAttestationResponse, error) {
+	params := url.Values{}
+	params.Add("slot", strconv.FormatUint(uint64(slot), 10))
+	params.Add("attestation_data_root", hexutil.Encode(attestationDataRoot))
+	params.Add("committee_index", strconv.FormatUint(uint64(committeeIndex), 10))
+	endpoint := buildURL("/eth/v2/validator/aggregate_attestation", params)
+
+	var aggregateAttestationResponse structs.AggregateAttestationResponse
+	if err := c.jsonRestHandler.Get(ctx, endpoint, &aggregateAttestationResponse); err != nil {
+		return nil, err
+	}
+
+	return &aggregateAttestationResponse, nil
+}
@@ -185,3 +185,80 @@ func TestSubmitAggregateSelectionProof(t *testing.T) {
 		})
 	}
 }
+
+func TestSubmitAggregateSelectionProofElectra(t *testing.T) {
+	const (
+		slotSignature  = "0x8776a37d6802c4797d113169c5fcfda50e68a32058eb6356a6f00d06d7da64c841a00c7c38b9b94a204751eca53707bd03523ce4797827d9bacff116a6e776a20bbccff4b683bf5201b610797ed0502557a58a65c8395f8a1649b976c3112d15"
+		validatorIndex = primitives.ValidatorIndex(55293)
+		slot           = primitives.Slot(123)
+		committeeIndex = primitives.CommitteeIndex(1)
+	)
+
+	attestationDataResponse := generateValidAttestation(uint64(slot), uint64(committeeIndex))
+	attestationDataProto, err := attestationDataResponse.Data.ToConsensus()
+	require.NoError(t, err)
+	attestationDataRootBytes, err := attestationDataProto.HashTreeRoot()
+	require.NoError(t, err)
+
+	aggregateAttestation := &ethpb.AttestationElectra{
+		AggregationBits: testhelpers.FillByteSlice(4, 74),
+		Data:            attestationDataProto,
+		Signature:       testhelpers.FillByteSlice(96, 82),
+		CommitteeBits:   testhelpers.FillByteSlice(8, 83),
+	}
+	attestationJSON, err := json.Marshal(structs.AttElectraFromConsensus(aggregateAttestation))
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+	jsonRestHandler := mock.NewMockJsonRestHandler(ctrl)
+	jsonRestHandler.EXPECT().Get(
+		gomock.Any(),
+		"/eth/v1/node/syncing",
+		&structs.SyncStatusResponse{},
+	).SetArg(
+		2,
+		structs.SyncStatusResponse{Data: &structs.SyncStatusResponseData{}},
+	).Return(
+		nil,
+	).Times(1)
+	jsonRestHandler.EXPECT().Get(
+		gomock.Any(),
+		fmt.Sprintf("/eth/v1/validator/attestation_data?committee_index=%d&slot=%d", committeeIndex, slot),
+		&structs.GetAttestationDataResponse{},
+	).SetArg(
+		2,
+		attestationDataResponse,
+	).Return(
+		nil,
+	).Times(1)
+	jsonRestHandler.EXPECT().Get(
+		gomock.Any(),
+		fmt.Sprintf("/eth/v2/validator/aggregate_attestation?attestation_data_root=%s&committee_index=%d&slot=%d", hexutil.Encode(attestationDataRootBytes[:]), committeeIndex, slot),
+		&structs.AggregateAttestationResponse{},
+	).SetArg(
+		2,
+		structs.AggregateAttestationResponse{Version: "electra", Data: attestationJSON},
+	).Return(
+		nil,
+	).Times(1)
+
+	slotSignatureBytes, err := hexutil.Decode(slotSignature)
+	require.NoError(t, err)
+
+	validatorClient := &beaconApiValidatorClient{jsonRestHandler: jsonRestHandler}
+	actualResponse, err := validatorClient.submitAggregateSelectionProofElectra(ctx, &ethpb.AggregateSelectionRequest{
+		Slot:           slot,
+		CommitteeIndex: committeeIndex,
+		SlotSignature:  slotSignatureBytes,
+	}, validatorIndex, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.AggregateSelectionElectraResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProofElectra{
+			AggregatorIndex: validatorIndex,
+			Aggregate:       aggregateAttestation,
+			SelectionProof:  slotSignatureBytes,
+		},
+	}, actualResponse)
+}
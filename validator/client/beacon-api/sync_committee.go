@@ -16,21 +16,28 @@ import (
 )
 
 func (c *beaconApiValidatorClient) submitSyncMessage(ctx context.Context, syncMessage *ethpb.SyncCommitteeMessage) error {
+	return c.syncMessages.submit(ctx, syncMessage, c.postSyncMessages)
+}
+
+func (c *beaconApiValidatorClient) postSyncMessages(ctx context.Context, syncMessages []*ethpb.SyncCommitteeMessage) error {
 	const endpoint = "/eth/v1/beacon/pool/sync_committees"
 
-	jsonSyncCommitteeMessage := &structs.SyncCommitteeMessage{
-		Slot:            strconv.FormatUint(uint64(syncMessage.Slot), 10),
-		BeaconBlockRoot: hexutil.Encode(syncMessage.BlockRoot),
-		ValidatorIndex:  strconv.FormatUint(uint64(syncMessage.ValidatorIndex), 10),
-		Signature:       hexutil.Encode(syncMessage.Signature),
+	jsonSyncCommitteeMessages := make([]*structs.SyncCommitteeMessage, len(syncMessages))
+	for i, syncMessage := range syncMessages {
+		jsonSyncCommitteeMessages[i] = &structs.SyncCommitteeMessage{
+			Slot:            strconv.FormatUint(uint64(syncMessage.Slot), 10),
+			BeaconBlockRoot: hexutil.Encode(syncMessage.BlockRoot),
+			ValidatorIndex:  strconv.FormatUint(uint64(syncMessage.ValidatorIndex), 10),
+			Signature:       hexutil.Encode(syncMessage.Signature),
+		}
 	}
 
-	marshalledJsonSyncCommitteeMessage, err := json.Marshal([]*structs.SyncCommitteeMessage{jsonSyncCommitteeMessage})
+	marshalledJsonSyncCommitteeMessages, err := json.Marshal(jsonSyncCommitteeMessages)
 	if err != nil {
-		return errors.Wrap(err, "failed to marshal sync committee message")
+		return errors.Wrap(err, "failed to marshal sync committee messages")
 	}
 
-	return c.jsonRestHandler.Post(ctx, endpoint, nil, bytes.NewBuffer(marshalledJsonSyncCommitteeMessage), nil)
+	return c.jsonRestHandler.Post(ctx, endpoint, nil, bytes.NewBuffer(marshalledJsonSyncCommitteeMessages), nil)
 }
 
 func (c *beaconApiValidatorClient) syncMessageBlockRoot(ctx context.Context) (*ethpb.SyncMessageBlockRootResponse, error) {
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var errDutiesKeysChanged = errors.New("validating keys changed")
+
+// dutiesStreamResubscribeDelay is how long the duties stream waits before subscribing again with changed keys.
+var dutiesStreamResubscribeDelay = time.Second
+
+// StreamDuties subscribes to the duties of the validating keys, and replaces the duties of the current epoch whenever
+// the beacon node pushes a change of them, until the stream ends or the validating keys change.
+func (v *validator) StreamDuties(ctx context.Context) error {
+	// The subnet subscriptions of the pushed duties run on the context of the validator, not of the stream.
+	runCtx := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	keys, err := v.dutiesStreamKeys(ctx)
+	if err != nil {
+		return err
+	}
+	stream, err := v.validatorClient.StreamDuties(ctx, &ethpb.DutiesRequest{PublicKeys: keys})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		slot := slots.CurrentSlot(v.genesisTime)
+		// Duties of a past epoch are superseded by the duties polled at the start of the current epoch.
+		if res.Duties == nil || res.Epoch != slots.ToEpoch(slot) {
+			continue
+		}
+
+		v.dutiesLock.Lock()
+		if v.duties != nil && !dutiesOfKeys(v.duties, keys) {
+			// The polled duties lag behind a key reload until the next poll, in which case the pushed duties of the
+			// subscribed keys replace them. Only a reload since the subscription requires subscribing again.
+			currentKeys, err := v.dutiesStreamKeys(ctx)
+			if err != nil {
+				v.dutiesLock.Unlock()
+				return err
+			}
+			if !keysEqual(currentKeys, keys) {
+				v.dutiesLock.Unlock()
+				return errDutiesKeysChanged
+			}
+		}
+		if proto.Equal(v.duties, res.Duties) {
+			v.dutiesLock.Unlock()
+			continue
+		}
+		v.duties = res.Duties
+		log.WithField("epoch", res.Epoch).Info("Beacon node pushed updated duties")
+		v.logDuties(slot, v.duties.CurrentEpochDuties, v.duties.NextEpochDuties)
+		v.dutiesLock.Unlock()
+
+		if err := v.handleDuties(runCtx, slot, res.Duties); err != nil {
+			handleAssignmentError(err, slot)
+		}
+	}
+}
+
+// dutiesStreamKeys returns the validating keys which are not slashable, to subscribe to the duties of.
+func (v *validator) dutiesStreamKeys(ctx context.Context) ([][]byte, error) {
+	validatingKeys, err := v.km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch validating keys")
+	}
+	return bytesutil.FromBytes48Array(v.filterSlashableKeys(validatingKeys)), nil
+}
+
+// keysEqual returns whether both lists hold the same public keys in the same order.
+func keysEqual(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// dutiesOfKeys returns whether the current epoch duties are those of the public keys.
+func dutiesOfKeys(duties *ethpb.DutiesResponse, keys [][]byte) bool {
+	if len(duties.CurrentEpochDuties) != len(keys) {
+		return false
+	}
+	for i, duty := range duties.CurrentEpochDuties {
+		if !bytes.Equal(duty.PublicKey, keys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// runDutiesStream keeps a duties stream open, so that the beacon node pushes the duties changed by reorgs and new
+// epochs in between the polls of the duties. It reconnects a slot after an interruption, shortly after a change of the
+// validating keys, and stops when the beacon node does not support the duties stream.
+func runDutiesStream(ctx context.Context, v iface.Validator) {
+	for {
+		err := v.StreamDuties(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		delay := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+		switch {
+		case errors.Is(err, iface.ErrNotSupported) || status.Code(err) == codes.Unimplemented:
+			log.Debug("Beacon node does not support the duties stream, polling for duties only")
+			return
+		case errors.Is(err, errDutiesKeysChanged):
+			// Subscribe again with the new keys shortly, as they are fetched again on subscription.
+			log.Debug("Validating keys changed, subscribing to their duties again")
+			delay = dutiesStreamResubscribeDelay
+		case err != nil:
+			log.WithError(err).Debug("Duties stream interrupted, reconnecting")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
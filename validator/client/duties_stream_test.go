@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/mock"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestStreamDuties_UpdatesDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	v := validator{
+		km:              &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}},
+		validatorClient: client,
+		genesisTime:     uint64(time.Now().Unix()),
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], AttesterSlot: 1}},
+		},
+	}
+	next := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], AttesterSlot: 40}},
+	}
+	pushed := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], AttesterSlot: 2}},
+	}
+
+	client.EXPECT().StreamDuties(gomock.Any(), &ethpb.DutiesRequest{PublicKeys: [][]byte{pubKey[:]}}).Return(stream, nil)
+	gomock.InOrder(
+		// The duties of another epoch are ignored.
+		stream.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Epoch: 1, Duties: next}, nil),
+		stream.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Epoch: 0, Duties: pushed}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	streamEnded := make(chan struct{})
+	var subscribeErr error
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(ctx context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest, _ []*ethpb.DutiesResponse_Duty) (*emptypb.Empty, error) {
+		// The subscriptions outlive the stream.
+		<-streamEnded
+		subscribeErr = ctx.Err()
+		wg.Done()
+		return nil, nil
+	})
+
+	require.ErrorIs(t, v.StreamDuties(context.Background()), io.EOF)
+	close(streamEnded)
+	util.WaitTimeout(&wg, 2*time.Second)
+	require.NoError(t, subscribeErr)
+
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	assert.DeepEqual(t, pushed, v.duties)
+}
+
+func TestStreamDuties_StaleDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherKey := [fieldparams.BLSPubkeyLength]byte{2}
+	v := validator{
+		km:              &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}},
+		validatorClient: client,
+		genesisTime:     uint64(time.Now().Unix()),
+		// The duties polled before the keys were reloaded.
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: otherKey[:]}},
+		},
+	}
+	pushed := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], Status: ethpb.ValidatorStatus_EXITED}},
+	}
+
+	client.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Duties: pushed}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+
+	require.ErrorIs(t, v.StreamDuties(context.Background()), io.EOF)
+	assert.DeepEqual(t, pushed, v.duties)
+}
+
+func TestStreamDuties_KeysChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherKey := [fieldparams.BLSPubkeyLength]byte{2}
+	km := &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}}
+	duties := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: otherKey[:]}},
+	}
+	v := validator{
+		km:              km,
+		validatorClient: client,
+		genesisTime:     uint64(time.Now().Unix()),
+		duties:          duties,
+	}
+
+	client.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream, nil)
+	stream.EXPECT().Recv().DoAndReturn(func() (*ethpb.StreamDutiesResponse, error) {
+		// The keys are reloaded after the subscription.
+		km.keys = [][fieldparams.BLSPubkeyLength]byte{otherKey}
+		return &ethpb.StreamDutiesResponse{
+			Duties: &ethpb.DutiesResponse{
+				CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:]}},
+			},
+		}, nil
+	})
+
+	require.ErrorIs(t, v.StreamDuties(context.Background()), errDutiesKeysChanged)
+	assert.Equal(t, duties, v.duties)
+}
+
+func TestRunDutiesStream_KeysChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+	resubscribed := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	defer func(delay time.Duration) { dutiesStreamResubscribeDelay = delay }(dutiesStreamResubscribeDelay)
+	dutiesStreamResubscribeDelay = 10 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	otherKey := [fieldparams.BLSPubkeyLength]byte{2}
+	km := &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}}
+	v := &validator{
+		km:              km,
+		validatorClient: client,
+		genesisTime:     uint64(time.Now().Unix()),
+		duties: &ethpb.DutiesResponse{
+			CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: otherKey[:]}},
+		},
+	}
+	pushed := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: otherKey[:], Status: ethpb.ValidatorStatus_EXITED}},
+	}
+
+	gomock.InOrder(
+		client.EXPECT().StreamDuties(gomock.Any(), &ethpb.DutiesRequest{PublicKeys: [][]byte{pubKey[:]}}).Return(stream, nil),
+		stream.EXPECT().Recv().DoAndReturn(func() (*ethpb.StreamDutiesResponse, error) {
+			km.keys = [][fieldparams.BLSPubkeyLength]byte{otherKey}
+			return &ethpb.StreamDutiesResponse{
+				Duties: &ethpb.DutiesResponse{
+					CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:]}},
+				},
+			}, nil
+		}),
+		// Subscribed again exactly once, with the reloaded keys.
+		client.EXPECT().StreamDuties(gomock.Any(), &ethpb.DutiesRequest{PublicKeys: [][]byte{otherKey[:]}}).Return(resubscribed, nil),
+		resubscribed.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Duties: pushed}, nil),
+		resubscribed.EXPECT().Recv().DoAndReturn(func() (*ethpb.StreamDutiesResponse, error) {
+			cancel()
+			return nil, io.EOF
+		}),
+	)
+
+	done := make(chan struct{})
+	go func() {
+		runDutiesStream(ctx, v)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Duties stream did not stop")
+	}
+	v.dutiesLock.RLock()
+	defer v.dutiesLock.RUnlock()
+	assert.DeepEqual(t, pushed, v.duties)
+}
+
+func TestStreamDuties_AllExited(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	v := validator{
+		km:              &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}},
+		validatorClient: client,
+		genesisTime:     uint64(time.Now().Unix()),
+	}
+	pushed := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], Status: ethpb.ValidatorStatus_EXITED}},
+	}
+
+	client.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Duties: pushed}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	// No subnet is subscribed to for exited validators.
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	require.ErrorIs(t, v.StreamDuties(context.Background()), io.EOF)
+	assert.DeepEqual(t, pushed, v.duties)
+}
+
+func TestStreamDuties_SchedulesProposalPreflight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	stream := mock.NewMockBeaconNodeValidator_StreamDutiesClient(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	v := validator{
+		km:                &mockKeymanager{keys: [][fieldparams.BLSPubkeyLength]byte{pubKey}},
+		validatorClient:   client,
+		genesisTime:       uint64(time.Now().Unix()),
+		proposalPreflight: newProposalPreflight(ctx, nil, 0),
+	}
+	pushed := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], ProposerSlots: []primitives.Slot{20}}},
+	}
+
+	client.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(stream, nil)
+	gomock.InOrder(
+		stream.EXPECT().Recv().Return(&ethpb.StreamDutiesResponse{Duties: pushed}, nil),
+		stream.EXPECT().Recv().Return(nil, io.EOF),
+	)
+	client.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+
+	require.ErrorIs(t, v.StreamDuties(ctx), io.EOF)
+	v.proposalPreflight.lock.Lock()
+	defer v.proposalPreflight.lock.Unlock()
+	assert.DeepEqual(t, map[primitives.Slot]bool{20: true}, v.proposalPreflight.scheduled)
+}
+
+func TestRunDutiesStream_Unimplemented(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	v := &validator{
+		km:              &mockKeymanager{},
+		validatorClient: client,
+	}
+	client.EXPECT().StreamDuties(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.Unimplemented, "unknown method StreamDuties"))
+
+	done := make(chan struct{})
+	go func() {
+		runDutiesStream(context.Background(), v)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Duties stream did not stop")
+	}
+}
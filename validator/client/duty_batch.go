@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	emptypb "github.com/golang/protobuf/ptypes/empty"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+)
+
+// dutyBatcher shares the per slot work of the duties of all the keys of the validator client, so that thousands of
+// keys don't each query the beacon node. The attestation data of a committee and the sync committee block root are
+// requested once per slot, and the number of duties submitting to the beacon node at once is limited. A nil batcher
+// requests once per duty and doesn't limit submissions.
+type dutyBatcher struct {
+	limit    chan struct{}
+	lock     sync.Mutex
+	slot     primitives.Slot
+	attData  map[primitives.CommitteeIndex]*batchedRequest[*ethpb.AttestationData]
+	syncRoot *batchedRequest[*ethpb.SyncMessageBlockRootResponse]
+}
+
+// batchedRequest is a request shared by the duties of a slot. done is closed once the response is set.
+type batchedRequest[T any] struct {
+	done chan struct{}
+	resp T
+	err  error
+}
+
+// newDutyBatcher creates a duty batcher allowing at most maxConcurrent duties to submit at once, or any number of
+// duties when maxConcurrent is 0.
+func newDutyBatcher(maxConcurrent int) *dutyBatcher {
+	b := &dutyBatcher{attData: make(map[primitives.CommitteeIndex]*batchedRequest[*ethpb.AttestationData])}
+	if maxConcurrent > 0 {
+		b.limit = make(chan struct{}, maxConcurrent)
+	}
+	return b
+}
+
+// acquire blocks until the duty is allowed to submit to the beacon node. Each successful call must be followed by a
+// call to release.
+func (b *dutyBatcher) acquire(ctx context.Context) error {
+	if b == nil || b.limit == nil {
+		return nil
+	}
+	select {
+	case b.limit <- struct{}{}:
+		dutiesInFlightGauge.Inc()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// release frees the slot taken by acquire.
+func (b *dutyBatcher) release() {
+	if b == nil || b.limit == nil {
+		return
+	}
+	<-b.limit
+	dutiesInFlightGauge.Dec()
+}
+
+// attestationData returns the attestation data of the committee at the slot, requesting it once for all the keys.
+// From Electra the attestation data doesn't depend on the committee, so it is requested once per slot.
+func (b *dutyBatcher) attestationData(ctx context.Context, c iface.ValidatorClient, req *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+	if b == nil {
+		return c.AttestationData(ctx, req)
+	}
+	key := req.CommitteeIndex
+	if slots.ToEpoch(req.Slot) >= params.BeaconConfig().ElectraForkEpoch {
+		key = 0
+	}
+	b.lock.Lock()
+	if !b.advanceNoLock(req.Slot) {
+		b.lock.Unlock()
+		return c.AttestationData(ctx, req)
+	}
+	r, ok := b.attData[key]
+	if !ok {
+		r = &batchedRequest[*ethpb.AttestationData]{done: make(chan struct{})}
+		b.attData[key] = r
+	}
+	b.lock.Unlock()
+
+	if ok {
+		batchedRequestsCounter.WithLabelValues("attestation_data").Inc()
+		return r.wait(ctx)
+	}
+	r.resp, r.err = c.AttestationData(ctx, req)
+	if r.err != nil {
+		b.lock.Lock()
+		if b.slot == req.Slot && b.attData[key] == r {
+			delete(b.attData, key)
+		}
+		b.lock.Unlock()
+	}
+	close(r.done)
+	return r.resp, r.err
+}
+
+// syncMessageBlockRoot returns the block root sync committee members sign at the slot, requesting it once for all
+// the keys.
+func (b *dutyBatcher) syncMessageBlockRoot(ctx context.Context, c iface.ValidatorClient, slot primitives.Slot) (*ethpb.SyncMessageBlockRootResponse, error) {
+	if b == nil {
+		return c.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
+	}
+	b.lock.Lock()
+	if !b.advanceNoLock(slot) {
+		b.lock.Unlock()
+		return c.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
+	}
+	r := b.syncRoot
+	ok := r != nil
+	if !ok {
+		r = &batchedRequest[*ethpb.SyncMessageBlockRootResponse]{done: make(chan struct{})}
+		b.syncRoot = r
+	}
+	b.lock.Unlock()
+
+	if ok {
+		batchedRequestsCounter.WithLabelValues("sync_message_block_root").Inc()
+		return r.wait(ctx)
+	}
+	r.resp, r.err = c.SyncMessageBlockRoot(ctx, &emptypb.Empty{})
+	if r.err != nil {
+		b.lock.Lock()
+		if b.slot == slot && b.syncRoot == r {
+			b.syncRoot = nil
+		}
+		b.lock.Unlock()
+	}
+	close(r.done)
+	return r.resp, r.err
+}
+
+// advanceNoLock moves the batcher to the slot, forgetting the responses of the previous slots. It returns false if
+// the slot is older than the current one, whose requests are not shared. It assumes the caller holds the lock.
+func (b *dutyBatcher) advanceNoLock(slot primitives.Slot) bool {
+	if slot < b.slot {
+		return false
+	}
+	if slot > b.slot {
+		b.slot = slot
+		b.attData = make(map[primitives.CommitteeIndex]*batchedRequest[*ethpb.AttestationData])
+		b.syncRoot = nil
+	}
+	return true
+}
+
+func (r *batchedRequest[T]) wait(ctx context.Context) (T, error) {
+	select {
+	case <-r.done:
+		return r.resp, r.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDutyBatcher_AttestationData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	b := newDutyBatcher(0)
+	ctx := context.Background()
+
+	client.EXPECT().AttestationData(gomock.Any(), &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2}).
+		DoAndReturn(func(context.Context, *ethpb.AttestationDataRequest) (*ethpb.AttestationData, error) {
+			time.Sleep(50 * time.Millisecond)
+			return &ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}, nil
+		})
+	client.EXPECT().AttestationData(gomock.Any(), &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 3}).
+		Return(&ethpb.AttestationData{Slot: 1, CommitteeIndex: 3}, nil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			data, err := b.attestationData(ctx, client, &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2})
+			require.NoError(t, err)
+			require.Equal(t, primitives.CommitteeIndex(2), data.CommitteeIndex)
+		}()
+	}
+	wg.Wait()
+	data, err := b.attestationData(ctx, client, &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 3})
+	require.NoError(t, err)
+	require.Equal(t, primitives.CommitteeIndex(3), data.CommitteeIndex)
+
+	// A failed request is retried.
+	client.EXPECT().AttestationData(gomock.Any(), &ethpb.AttestationDataRequest{Slot: 2, CommitteeIndex: 2}).
+		Return(nil, errors.New("bad"))
+	client.EXPECT().AttestationData(gomock.Any(), &ethpb.AttestationDataRequest{Slot: 2, CommitteeIndex: 2}).
+		Return(&ethpb.AttestationData{Slot: 2, CommitteeIndex: 2}, nil)
+	_, err = b.attestationData(ctx, client, &ethpb.AttestationDataRequest{Slot: 2, CommitteeIndex: 2})
+	require.ErrorContains(t, "bad", err)
+	data, err = b.attestationData(ctx, client, &ethpb.AttestationDataRequest{Slot: 2, CommitteeIndex: 2})
+	require.NoError(t, err)
+	require.Equal(t, primitives.Slot(2), data.Slot)
+
+	// The requests of an older slot are not shared.
+	client.EXPECT().AttestationData(gomock.Any(), &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2}).
+		Return(&ethpb.AttestationData{Slot: 1, CommitteeIndex: 2}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = b.attestationData(ctx, client, &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: 2})
+		require.NoError(t, err)
+	}
+}
+
+func TestDutyBatcher_AttestationData_Electra(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.ElectraForkEpoch = 0
+	params.OverrideBeaconConfig(cfg)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	b := newDutyBatcher(0)
+
+	client.EXPECT().AttestationData(gomock.Any(), gomock.Any()).Return(&ethpb.AttestationData{Slot: 1}, nil)
+	for i := 0; i < 3; i++ {
+		_, err := b.attestationData(context.Background(), client, &ethpb.AttestationDataRequest{Slot: 1, CommitteeIndex: primitives.CommitteeIndex(i)})
+		require.NoError(t, err)
+	}
+}
+
+func TestDutyBatcher_SyncMessageBlockRoot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+	b := newDutyBatcher(0)
+	ctx := context.Background()
+
+	client.EXPECT().SyncMessageBlockRoot(gomock.Any(), gomock.Any()).Return(&ethpb.SyncMessageBlockRootResponse{Root: []byte{1}}, nil)
+	client.EXPECT().SyncMessageBlockRoot(gomock.Any(), gomock.Any()).Return(&ethpb.SyncMessageBlockRootResponse{Root: []byte{2}}, nil)
+	for i := 0; i < 3; i++ {
+		res, err := b.syncMessageBlockRoot(ctx, client, 1)
+		require.NoError(t, err)
+		require.DeepEqual(t, []byte{1}, res.Root)
+	}
+	res, err := b.syncMessageBlockRoot(ctx, client, 2)
+	require.NoError(t, err)
+	require.DeepEqual(t, []byte{2}, res.Root)
+}
+
+func TestDutyBatcher_Limit(t *testing.T) {
+	b := newDutyBatcher(2)
+	require.NoError(t, b.acquire(context.Background()))
+	require.NoError(t, b.acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, b.acquire(ctx), context.DeadlineExceeded)
+
+	b.release()
+	require.NoError(t, b.acquire(context.Background()))
+
+	var nilBatcher *dutyBatcher
+	require.NoError(t, nilBatcher.acquire(context.Background()))
+	nilBatcher.release()
+}
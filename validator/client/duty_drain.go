@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/sirupsen/logrus"
+)
+
+// dutyTracker records the slots whose duties are complete, so that the validator client can wait for its imminent
+// duties when stopping. A nil tracker records nothing.
+type dutyTracker struct {
+	lock sync.Mutex
+	done map[primitives.Slot]chan struct{}
+}
+
+func newDutyTracker() *dutyTracker {
+	return &dutyTracker{done: make(map[primitives.Slot]chan struct{})}
+}
+
+// doneChan returns a channel closed once the duties of the slot are complete.
+func (t *dutyTracker) doneChan(slot primitives.Slot) <-chan struct{} {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	return t.doneChanNoLock(slot)
+}
+
+func (t *dutyTracker) doneChanNoLock(slot primitives.Slot) chan struct{} {
+	c, ok := t.done[slot]
+	if !ok {
+		c = make(chan struct{})
+		t.done[slot] = c
+	}
+	return c
+}
+
+// markDone records that the duties of the slot are complete, and forgets the slots before it.
+func (t *dutyTracker) markDone(slot primitives.Slot) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	c := t.doneChanNoLock(slot)
+	select {
+	case <-c:
+	default:
+		close(c)
+	}
+	for s := range t.done {
+		if s < slot {
+			delete(t.done, s)
+		}
+	}
+}
+
+// waitForImminentDuties waits until the duties of the validator which are due within the window are complete, so
+// that stopping the validator client does not miss them. Duties of a slot are complete at the latest at the slot
+// deadline, which bounds the wait.
+func waitForImminentDuties(ctx context.Context, v iface.Validator, duties *dutyTracker, window time.Duration) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	// The deadline of a slot is the end of the slot.
+	genesis := v.SlotDeadline(0).Add(-slotDuration)
+	now := prysmTime.Now()
+	if now.Before(genesis) {
+		return
+	}
+	current := primitives.Slot(now.Sub(genesis) / slotDuration)
+	last := primitives.Slot(now.Add(window).Sub(genesis) / slotDuration)
+
+	var due []primitives.Slot
+	for slot := current; slot <= last; slot++ {
+		roles, err := v.RolesAt(ctx, slot)
+		if err != nil {
+			log.WithError(err).WithField("slot", slot).Debug("Could not get validator roles")
+			continue
+		}
+		if hasDuty(roles) {
+			due = append(due, slot)
+		}
+	}
+	if len(due) == 0 {
+		return
+	}
+	lastDue := due[len(due)-1]
+	log.WithFields(logrus.Fields{
+		"slots":  due,
+		"window": window,
+	}).Info("Waiting for imminent duties before stopping")
+	timer := time.NewTimer(prysmTime.Until(v.SlotDeadline(lastDue)))
+	defer timer.Stop()
+	for _, slot := range due {
+		select {
+		case <-duties.doneChan(slot):
+		case <-timer.C:
+			log.WithField("slot", slot).Warn("Stopping before the duties of the slot are complete")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func hasDuty(roles map[[48]byte][]iface.ValidatorRole) bool {
+	for _, rs := range roles {
+		for _, r := range rs {
+			if r != iface.RoleUnknown {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	prysmTime "github.com/prysmaticlabs/prysm/v5/time"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// clockValidator is a fake validator whose slot deadlines follow its genesis time.
+type clockValidator struct {
+	*testutil.FakeValidator
+	genesis time.Time
+}
+
+func (v *clockValidator) SlotDeadline(slot primitives.Slot) time.Time {
+	return v.genesis.Add(time.Duration(slot+1) * time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+}
+
+func TestDutyTracker(t *testing.T) {
+	d := newDutyTracker()
+	c := d.doneChan(2)
+	d.markDone(2)
+	select {
+	case <-c:
+	default:
+		t.Fatal("duties of slot 2 are not done")
+	}
+	// Marking a slot twice does not panic.
+	d.markDone(2)
+	d.markDone(3)
+	_, ok := d.done[2]
+	assert.Equal(t, false, ok)
+
+	var nilTracker *dutyTracker
+	nilTracker.markDone(1)
+}
+
+func TestWaitForImminentDuties(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	c := params.BeaconConfig().Copy()
+	c.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(c)
+
+	t.Run("no duties", func(t *testing.T) {
+		v := &clockValidator{FakeValidator: &testutil.FakeValidator{}, genesis: prysmTime.Now().Add(-100 * time.Millisecond)}
+		start := prysmTime.Now()
+		waitForImminentDuties(context.Background(), v, newDutyTracker(), 2*time.Second)
+		assert.Equal(t, true, prysmTime.Since(start) < 100*time.Millisecond)
+	})
+	t.Run("waits for the duties", func(t *testing.T) {
+		v := &clockValidator{
+			FakeValidator: &testutil.FakeValidator{RolesAtRet: []iface.ValidatorRole{iface.RoleAttester}},
+			genesis:       prysmTime.Now().Add(-100 * time.Millisecond),
+		}
+		d := newDutyTracker()
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			d.markDone(0)
+		}()
+		start := prysmTime.Now()
+		waitForImminentDuties(context.Background(), v, d, 100*time.Millisecond)
+		elapsed := prysmTime.Since(start)
+		assert.Equal(t, true, elapsed >= 50*time.Millisecond && elapsed < 500*time.Millisecond, "waited %s", elapsed)
+	})
+	t.Run("stops at the slot deadline", func(t *testing.T) {
+		hook := logTest.NewGlobal()
+		v := &clockValidator{
+			FakeValidator: &testutil.FakeValidator{RolesAtRet: []iface.ValidatorRole{iface.RoleAttester}},
+			genesis:       prysmTime.Now().Add(-800 * time.Millisecond),
+		}
+		start := prysmTime.Now()
+		waitForImminentDuties(context.Background(), v, newDutyTracker(), 100*time.Millisecond)
+		elapsed := prysmTime.Since(start)
+		assert.Equal(t, true, elapsed < 500*time.Millisecond, "waited %s", elapsed)
+		require.LogsContain(t, hook, "Stopping before the duties of the slot are complete")
+	})
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"github.com/pkg/errors"
+)
+
+// DutyRole is the set of duties performed by a validator client. Operators isolating their block proposals run two
+// validator clients with the same keys: one with the proposer role and one with the attester role. The duties of the
+// two roles never sign the same kind of message, and the two validator clients must share a slashing protection
+// source, a remote signer or a remote slashing protection, so that a misconfigured pair of roles cannot sign
+// conflicting messages. Only the attester role runs the doppelganger check, which would find the keys of the proposer
+// role live from the attestations of its sibling.
+type DutyRole string
+
+const (
+	// DutyRoleAll performs all duties.
+	DutyRoleAll DutyRole = "all"
+	// DutyRoleProposer only proposes blocks.
+	DutyRoleProposer DutyRole = "proposer"
+	// DutyRoleAttester only attests, aggregates attestations and performs the sync committee duties.
+	DutyRoleAttester DutyRole = "attester"
+)
+
+// ParseDutyRole parses the duty role of a validator client. An empty string is the role performing all duties.
+func ParseDutyRole(s string) (DutyRole, error) {
+	switch DutyRole(s) {
+	case "", DutyRoleAll:
+		return DutyRoleAll, nil
+	case DutyRoleProposer, DutyRoleAttester:
+		return DutyRole(s), nil
+	default:
+		return "", errors.Errorf("unknown duty role %q, must be one of %q, %q or %q", s, DutyRoleAll, DutyRoleProposer, DutyRoleAttester)
+	}
+}
+
+// proposes returns whether the role performs the block proposals.
+func (r DutyRole) proposes() bool {
+	return r != DutyRoleAttester
+}
+
+// checksDoppelganger returns whether the role runs the doppelganger check, which finds the keys live when their
+// attestations are seen.
+func (r DutyRole) checksDoppelganger() bool {
+	return r.attests()
+}
+
+// attests returns whether the role performs the attestation, aggregation and sync committee duties.
+func (r DutyRole) attests() bool {
+	return r != DutyRoleProposer
+}
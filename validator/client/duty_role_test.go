@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/features"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseDutyRole(t *testing.T) {
+	for s, want := range map[string]DutyRole{
+		"":         DutyRoleAll,
+		"all":      DutyRoleAll,
+		"proposer": DutyRoleProposer,
+		"attester": DutyRoleAttester,
+	} {
+		role, err := ParseDutyRole(s)
+		require.NoError(t, err)
+		assert.Equal(t, want, role)
+	}
+	_, err := ParseDutyRole("aggregator")
+	require.ErrorContains(t, "unknown duty role", err)
+}
+
+func TestRolesAt_DutyRole(t *testing.T) {
+	slot := params.BeaconConfig().SlotsPerEpoch + 1
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	duties := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				PublicKey:       pubKey[:],
+				ValidatorIndex:  1,
+				ProposerSlots:   []primitives.Slot{slot},
+				IsSyncCommittee: true,
+			},
+		},
+	}
+
+	t.Run("proposer", func(t *testing.T) {
+		v := validator{dutyRole: DutyRoleProposer, duties: duties}
+		roles, err := v.RolesAt(context.Background(), slot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleProposer}, roles[pubKey])
+	})
+	t.Run("attester", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		client := validatormock.NewMockValidatorClient(ctrl)
+		client.EXPECT().SyncSubcommitteeIndex(gomock.Any(), &ethpb.SyncSubcommitteeIndexRequest{
+			PublicKey: pubKey[:],
+			Slot:      slot,
+		}).Return(&ethpb.SyncSubcommitteeIndexResponse{}, nil)
+
+		v := validator{dutyRole: DutyRoleAttester, duties: duties, validatorClient: client}
+		roles, err := v.RolesAt(context.Background(), slot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, []iface.ValidatorRole{iface.RoleSyncCommittee}, roles[pubKey])
+	})
+}
+
+func TestCheckDoppelGanger_ProposerRole(t *testing.T) {
+	flgs := features.Get()
+	flgs.EnableDoppelGanger = true
+	reset := features.InitWithReset(flgs)
+	defer reset()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+
+	// The attestations of the keys by the sibling attester would be found live.
+	client.EXPECT().CheckDoppelGanger(gomock.Any(), gomock.Any()).Times(0)
+	v := validator{dutyRole: DutyRoleProposer, validatorClient: client, km: genMockKeymanager(t, 1)}
+	require.NoError(t, v.CheckDoppelGanger(context.Background()))
+}
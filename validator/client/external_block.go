@@ -0,0 +1,66 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/blocks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+)
+
+// beaconBlock requests the block to propose. The block is requested from the external block source when one is
+// configured, and from the beacon node when there is none or when the external block source does not return a valid
+// block for the proposal. A distributed validator has no external block source: the middleware of its cluster serves
+// the blocks in place of the beacon node, and these are signed as provided.
+func (v *validator) beaconBlock(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	if v.externalBlockSource != nil {
+		b, err := v.externalBlock(ctx, pubKey, req)
+		if err == nil {
+			log.WithField("slot", req.Slot).Info("Proposing block of the external block source")
+			return b, nil
+		}
+		log.WithField("slot", req.Slot).WithError(err).Warn("Could not use block of the external block source, requesting block from beacon node")
+	}
+	return v.validatorClient.BeaconBlock(ctx, req)
+}
+
+// externalBlock requests the block to propose from the external block source and checks that it is a block of the
+// proposal: of the slot, of the proposer, with the randao reveal of the proposer and on top of the head of the beacon
+// node.
+func (v *validator) externalBlock(ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, req *ethpb.BlockRequest) (*ethpb.GenericBeaconBlock, error) {
+	b, err := v.externalBlockSource.BeaconBlock(ctx, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get block")
+	}
+	wb, err := blocks.NewBeaconBlock(b.Block)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not wrap block")
+	}
+	if wb.Slot() != req.Slot {
+		return nil, fmt.Errorf("block slot %d is not the proposal slot %d", wb.Slot(), req.Slot)
+	}
+	duty, err := v.duty(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if wb.ProposerIndex() != duty.ValidatorIndex {
+		return nil, fmt.Errorf("block proposer %d is not the validator %d", wb.ProposerIndex(), duty.ValidatorIndex)
+	}
+	randaoReveal := wb.Body().RandaoReveal()
+	if !bytes.Equal(randaoReveal[:], req.RandaoReveal) {
+		return nil, errors.New("block randao reveal is not the randao reveal of the validator")
+	}
+	head, err := v.chainClient.ChainHead(ctx, &empty.Empty{})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get chain head")
+	}
+	parentRoot := wb.ParentRoot()
+	if !bytes.Equal(parentRoot[:], head.HeadBlockRoot) {
+		return nil, fmt.Errorf("block parent root %#x is not the head block root %#x", parentRoot, head.HeadBlockRoot)
+	}
+	return b, nil
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+	validatormock "github.com/prysmaticlabs/prysm/v5/testing/validator-mock"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBeaconBlock_ExternalBlockSource(t *testing.T) {
+	slot := primitives.Slot(10)
+	pubKey := [fieldparams.BLSPubkeyLength]byte{1}
+	headRoot := bytesutil.PadTo([]byte("head"), 32)
+	req := &ethpb.BlockRequest{
+		Slot:         slot,
+		RandaoReveal: bytesutil.PadTo([]byte("randao"), fieldparams.BLSSignatureLength),
+	}
+	externalBlock := func() *ethpb.GenericBeaconBlock {
+		b := util.NewBeaconBlockCapella().Block
+		b.Slot = slot
+		b.ProposerIndex = 7
+		b.ParentRoot = headRoot
+		b.Body.RandaoReveal = req.RandaoReveal
+		b.Body.Graffiti = bytesutil.PadTo([]byte("external"), 32)
+		return &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: b}}
+	}
+	nodeBlock := &ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: util.NewBeaconBlockCapella().Block}}
+
+	tests := []struct {
+		name     string
+		block    func(b *ethpb.BeaconBlockCapella)
+		err      error
+		external bool
+	}{
+		{
+			name:     "valid block",
+			block:    func(*ethpb.BeaconBlockCapella) {},
+			external: true,
+		},
+		{
+			name: "unavailable",
+			err:  errors.New("connection refused"),
+		},
+		{
+			name:  "other slot",
+			block: func(b *ethpb.BeaconBlockCapella) { b.Slot = slot + 1 },
+		},
+		{
+			name:  "other proposer",
+			block: func(b *ethpb.BeaconBlockCapella) { b.ProposerIndex = 8 },
+		},
+		{
+			name:  "other randao reveal",
+			block: func(b *ethpb.BeaconBlockCapella) { b.Body.RandaoReveal = make([]byte, fieldparams.BLSSignatureLength) },
+		},
+		{
+			name:  "not on head",
+			block: func(b *ethpb.BeaconBlockCapella) { b.ParentRoot = make([]byte, 32) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			source := validatormock.NewMockValidatorClient(ctrl)
+			validatorClient := validatormock.NewMockValidatorClient(ctrl)
+			chainClient := validatormock.NewMockChainClient(ctrl)
+
+			if tt.err != nil {
+				source.EXPECT().BeaconBlock(gomock.Any(), req).Return(nil, tt.err)
+			} else {
+				b := externalBlock()
+				tt.block(b.GetCapella())
+				source.EXPECT().BeaconBlock(gomock.Any(), req).Return(b, nil)
+				chainClient.EXPECT().ChainHead(gomock.Any(), gomock.Any()).Return(&ethpb.ChainHead{HeadBlockRoot: headRoot}, nil).AnyTimes()
+			}
+			if !tt.external {
+				validatorClient.EXPECT().BeaconBlock(gomock.Any(), req).Return(nodeBlock, nil)
+			}
+
+			v := validator{
+				validatorClient:     validatorClient,
+				chainClient:         chainClient,
+				externalBlockSource: source,
+				duties: &ethpb.DutiesResponse{CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+					{PublicKey: pubKey[:], ValidatorIndex: 7},
+				}},
+			}
+			b, err := v.beaconBlock(context.Background(), pubKey, req)
+			require.NoError(t, err)
+			if tt.external {
+				assert.DeepEqual(t, bytesutil.PadTo([]byte("external"), 32), b.GetCapella().Body.Graffiti)
+			} else {
+				assert.Equal(t, nodeBlock, b)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	"golang.org/x/exp/maps"
+)
+
+const (
+	gasLimitsPath = "/prysm/v1/validators/gas_limits"
+	// gasLimitsResyncEpochs is the number of epochs after which all the gas limits are sent to the beacon node again.
+	gasLimitsResyncEpochs = 32
+)
+
+// gasLimit returns the gas limit the proposer settings set for the validator, and false when they set none.
+func (v *validator) gasLimit(k [fieldparams.BLSPubkeyLength]byte) (uint64, bool) {
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return 0, false
+	}
+	if settings.ProposeConfig != nil {
+		config, ok := settings.ProposeConfig[k]
+		if ok && config != nil && config.BuilderConfig != nil && config.BuilderConfig.GasLimit != 0 {
+			return uint64(config.BuilderConfig.GasLimit), true // Use file config for gas limit.
+		}
+	}
+	if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil && settings.DefaultConfig.BuilderConfig.GasLimit != 0 {
+		return uint64(settings.DefaultConfig.BuilderConfig.GasLimit), true // Use cli config for gas limit.
+	}
+	return 0, false
+}
+
+// gasLimitsPush tracks the gas limits last sent to the beacon node, so that only their changes are sent.
+type gasLimitsPush struct {
+	sync.Mutex
+	// pushed are the gas limits the beacon node knows, by validator index. All the gas limits are sent again when nil.
+	pushed map[primitives.ValidatorIndex]uint64
+	// fullPushEpoch is the epoch at which all the gas limits were last sent.
+	fullPushEpoch primitives.Epoch
+}
+
+// pushGasLimits sends the changes of the gas limits the proposer settings set for the active validators to the beacon
+// node, for the execution client to target them when it builds payloads locally, whether or not the validators are
+// registered with a builder. The preferences of the validators which no longer have a gas limit are removed with a
+// gas limit of 0. All the gas limits are sent when forced, and every gasLimitsResyncEpochs epochs for a beacon node
+// which restarted. Nothing is sent unless enabled with --local-gas-limits. Failures are only logged, and the changes
+// are sent again at the next call, as the proposals do not depend on it.
+func (v *validator) pushGasLimits(
+	ctx context.Context, activePubkeys [][fieldparams.BLSPubkeyLength]byte, slot primitives.Slot, forceFullPush bool,
+) {
+	if v.gasLimitNode == nil {
+		return
+	}
+	v.gasLimits.Lock()
+	defer v.gasLimits.Unlock()
+
+	epoch := slots.ToEpoch(slot)
+	full := forceFullPush || v.gasLimits.pushed == nil || epoch >= v.gasLimits.fullPushEpoch+gasLimitsResyncEpochs
+	current := make(map[primitives.ValidatorIndex]uint64, len(activePubkeys))
+	for _, k := range activePubkeys {
+		s, ok := v.pubkeyToStatus[k]
+		if !ok {
+			continue
+		}
+		if gasLimit, ok := v.gasLimit(k); ok {
+			current[s.index] = gasLimit
+		}
+	}
+	changed := make(map[primitives.ValidatorIndex]uint64)
+	for index, gasLimit := range current {
+		if pushed, ok := v.gasLimits.pushed[index]; full || !ok || pushed != gasLimit {
+			changed[index] = gasLimit
+		}
+	}
+	for index := range v.gasLimits.pushed {
+		if _, ok := current[index]; !ok {
+			changed[index] = 0
+		}
+	}
+	if len(changed) > 0 {
+		if err := v.postGasLimits(ctx, changed); err != nil {
+			log.WithError(err).Warn("Could not send the gas limits of the validators to the beacon node")
+			return
+		}
+		log.WithField("count", len(changed)).Debug("Sent the gas limits of the validators to the beacon node")
+	}
+	v.gasLimits.pushed = current
+	if full {
+		v.gasLimits.fullPushEpoch = epoch
+	}
+}
+
+func (v *validator) postGasLimits(ctx context.Context, changed map[primitives.ValidatorIndex]uint64) error {
+	indices := maps.Keys(changed)
+	slices.Sort(indices)
+	gasLimits := make([]structs.ValidatorGasLimit, len(indices))
+	for i, index := range indices {
+		gasLimits[i] = structs.ValidatorGasLimit{
+			ValidatorIndex: strconv.FormatUint(uint64(index), 10),
+			GasLimit:       strconv.FormatUint(changed[index], 10),
+		}
+	}
+	body, err := json.Marshal(gasLimits)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal the gas limits")
+	}
+	headers := map[string]string{"Authorization": "Bearer " + v.gasLimitToken}
+	return v.gasLimitNode.Post(ctx, gasLimitsPath, headers, bytes.NewBuffer(body), nil)
+}
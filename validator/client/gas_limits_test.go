@@ -0,0 +1,89 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/config/proposer"
+	validatorType "github.com/prysmaticlabs/prysm/v5/consensus-types/validator"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+)
+
+func TestValidator_PushGasLimits(t *testing.T) {
+	var pushed []structs.ValidatorGasLimit
+	var posts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, gasLimitsPath, r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		posts++
+		pushed = nil
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&pushed))
+	}))
+	defer srv.Close()
+
+	keys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}, {3}, {4}}
+	v := &validator{
+		pubkeyToStatus: map[[fieldparams.BLSPubkeyLength]byte]*validatorStatus{
+			keys[0]: {index: 10},
+			keys[1]: {index: 11},
+			keys[2]: {index: 12},
+		},
+		proposerSettings: &proposer.Settings{
+			ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+				keys[0]: {BuilderConfig: &proposer.BuilderConfig{GasLimit: validatorType.Uint64(36_000_000)}},
+				keys[1]: {BuilderConfig: &proposer.BuilderConfig{Enabled: true}},
+			},
+			DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{GasLimit: validatorType.Uint64(30_000_000)}},
+		},
+	}
+	ctx := context.Background()
+
+	// Nothing is sent unless enabled.
+	v.pushGasLimits(ctx, keys, 0, true)
+	assert.Equal(t, 0, posts)
+
+	v.gasLimitNode = beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL)
+	v.gasLimitToken = "token"
+	v.pushGasLimits(ctx, keys, 0, false)
+	assert.DeepEqual(t, []structs.ValidatorGasLimit{
+		{ValidatorIndex: "10", GasLimit: "36000000"},
+		{ValidatorIndex: "11", GasLimit: "30000000"},
+		{ValidatorIndex: "12", GasLimit: "30000000"},
+	}, pushed)
+
+	// Nothing is sent while the gas limits do not change.
+	v.pushGasLimits(ctx, keys, 1, false)
+	assert.Equal(t, 1, posts)
+
+	// Only the changes are sent, and removed gas limits are cleared.
+	v.proposerSettings = &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			keys[0]: {BuilderConfig: &proposer.BuilderConfig{GasLimit: validatorType.Uint64(40_000_000)}},
+			keys[1]: {BuilderConfig: &proposer.BuilderConfig{GasLimit: validatorType.Uint64(30_000_000)}},
+		},
+	}
+	v.pushGasLimits(ctx, keys, 2, false)
+	assert.DeepEqual(t, []structs.ValidatorGasLimit{
+		{ValidatorIndex: "10", GasLimit: "40000000"},
+		{ValidatorIndex: "12", GasLimit: "0"},
+	}, pushed)
+
+	// All the gas limits are sent again when forced, and periodically.
+	v.pushGasLimits(ctx, keys, 3, true)
+	assert.Equal(t, 3, posts)
+	assert.Equal(t, 2, len(pushed))
+	v.pushGasLimits(ctx, keys, 4, false)
+	assert.Equal(t, 3, posts)
+	v.pushGasLimits(ctx, keys, params.BeaconConfig().SlotsPerEpoch.Mul(gasLimitsResyncEpochs), false)
+	assert.Equal(t, 4, posts)
+	assert.Equal(t, 2, len(pushed))
+}
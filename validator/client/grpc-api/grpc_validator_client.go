@@ -27,6 +27,10 @@ func (c *grpcValidatorClient) Duties(ctx context.Context, in *ethpb.DutiesReques
 	return c.beaconNodeValidatorClient.GetDuties(ctx, in)
 }
 
+func (c *grpcValidatorClient) StreamDuties(ctx context.Context, in *ethpb.DutiesRequest) (ethpb.BeaconNodeValidator_StreamDutiesClient, error) {
+	return c.beaconNodeValidatorClient.StreamDuties(ctx, in)
+}
+
 func (c *grpcValidatorClient) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest) (*ethpb.DoppelGangerResponse, error) {
 	return c.beaconNodeValidatorClient.CheckDoppelGanger(ctx, in)
 }
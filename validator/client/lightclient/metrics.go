@@ -0,0 +1,21 @@
+package lightclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	divergenceGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_light_client_divergence",
+		Help: "1 if the beacon node diverges from the light client for the given check, 0 otherwise.",
+	}, []string{"check"})
+	finalizedSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validator_light_client_finalized_slot",
+		Help: "The slot of the finalized header of the light client.",
+	})
+	optimisticSlotGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "validator_light_client_optimistic_slot",
+		Help: "The slot of the optimistic header of the light client.",
+	})
+)
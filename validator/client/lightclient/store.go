@@ -0,0 +1,261 @@
+package lightclient
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/core/signing"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/container/trie"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/network/forks"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+)
+
+// Indices of the light client proofs within their subtree of the beacon state, as defined by the generalized
+// indices of the sync protocol. Electra adds one level to the state tree, the depth of the proofs grows but
+// the indices within the subtree stay the same.
+const (
+	finalizedRootIndex        = 41
+	currentSyncCommitteeIndex = 22
+	nextSyncCommitteeIndex    = 23
+)
+
+var (
+	errIrrelevantUpdate          = errors.New("update does not advance the light client store")
+	errInsufficientParticipation = errors.New("not enough sync committee participants")
+	errInvalidSignature          = errors.New("invalid sync committee signature")
+)
+
+// signatureVerifier verifies the sync committee signature of an attested header.
+type signatureVerifier func(pubkeys [][]byte, attested *ethpb.BeaconBlockHeader, sig []byte, signatureSlot primitives.Slot, genesisValidatorsRoot []byte) error
+
+// store is a light client store following the sync protocol of the consensus specs. It tracks the finalized
+// and optimistic headers of the chain along with the sync committees which sign them. Execution payload
+// headers are not verified, only beacon block headers are used by the verifier.
+type store struct {
+	genesisValidatorsRoot []byte
+	finalized             *ethpb.BeaconBlockHeader
+	optimistic            *ethpb.BeaconBlockHeader
+	current               *ethpb.SyncCommittee
+	next                  *ethpb.SyncCommittee
+	verifySignature       signatureVerifier
+}
+
+// newStore initializes a light client store from a bootstrap of the trusted block root.
+func newStore(trustedRoot [32]byte, b *bootstrap, genesisValidatorsRoot []byte) (*store, error) {
+	root, err := b.header.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if root != trustedRoot {
+		return nil, errors.Errorf("bootstrap header root %#x does not match trusted root %#x", root, trustedRoot)
+	}
+	committeeRoot, err := b.currentSyncCommittee.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	if !verifyBranch(b.header.StateRoot, committeeRoot, currentSyncCommitteeIndex, b.currentSyncCommitteeBranch, syncCommitteeBranchDepth(b.version)) {
+		return nil, errors.New("invalid current sync committee branch")
+	}
+	return &store{
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		finalized:             b.header,
+		optimistic:            b.header,
+		current:               b.currentSyncCommittee,
+		verifySignature:       verifySyncAggregate,
+	}, nil
+}
+
+// process validates an update and applies it to the store.
+func (s *store) process(u *update, currentSlot primitives.Slot) error {
+	if err := s.validate(u, currentSlot); err != nil {
+		return err
+	}
+	participants := len(u.participants())
+	committeeSize := len(s.current.Pubkeys)
+	if participants*2 > committeeSize && u.attestedHeader.Slot > s.optimistic.Slot {
+		s.optimistic = u.attestedHeader
+	}
+	hasFinalizedNextSyncCommittee := s.next == nil && u.isSyncCommitteeUpdate() && u.isFinalityUpdate() &&
+		period(u.finalizedHeader.Slot) == period(u.attestedHeader.Slot)
+	if participants*3 >= committeeSize*2 && u.isFinalityUpdate() &&
+		(u.finalizedHeader.Slot > s.finalized.Slot || hasFinalizedNextSyncCommittee) {
+		return s.apply(u)
+	}
+	return nil
+}
+
+// validate implements validate_light_client_update of the sync protocol.
+func (s *store) validate(u *update, currentSlot primitives.Slot) error {
+	participants := u.participants()
+	if uint64(len(participants)) < params.BeaconConfig().MinSyncCommitteeParticipants {
+		return errInsufficientParticipation
+	}
+	if u.signatureSlot > currentSlot || u.signatureSlot <= u.attestedHeader.Slot {
+		return errors.Errorf("invalid signature slot %d for attested slot %d", u.signatureSlot, u.attestedHeader.Slot)
+	}
+	if u.isFinalityUpdate() && u.attestedHeader.Slot < u.finalizedHeader.Slot {
+		return errors.New("finalized header is newer than attested header")
+	}
+	storePeriod := period(s.finalized.Slot)
+	signaturePeriod := period(u.signatureSlot)
+	if signaturePeriod != storePeriod && (s.next == nil || signaturePeriod != storePeriod+1) {
+		return errors.Errorf("signature period %d is not covered by the store at period %d", signaturePeriod, storePeriod)
+	}
+	attestedPeriod := period(u.attestedHeader.Slot)
+	hasNextSyncCommittee := s.next == nil && u.isSyncCommitteeUpdate() && attestedPeriod == storePeriod
+	if u.attestedHeader.Slot <= s.finalized.Slot && !hasNextSyncCommittee {
+		return errIrrelevantUpdate
+	}
+
+	if u.isFinalityUpdate() {
+		finalizedRoot, err := u.finalizedHeader.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if !verifyBranch(u.attestedHeader.StateRoot, finalizedRoot, finalizedRootIndex, u.finalityBranch, finalityBranchDepth(u.version)) {
+			return errors.New("invalid finality branch")
+		}
+	}
+	if u.isSyncCommitteeUpdate() {
+		if attestedPeriod == storePeriod && s.next != nil && !equalCommittees(u.nextSyncCommittee, s.next) {
+			return errors.New("next sync committee does not match the known next sync committee")
+		}
+		committeeRoot, err := u.nextSyncCommittee.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if !verifyBranch(u.attestedHeader.StateRoot, committeeRoot, nextSyncCommitteeIndex, u.nextSyncCommitteeBranch, syncCommitteeBranchDepth(u.version)) {
+			return errors.New("invalid next sync committee branch")
+		}
+	}
+
+	committee := s.current
+	if signaturePeriod != storePeriod {
+		committee = s.next
+	}
+	if len(u.syncCommitteeBits)*8 != len(committee.Pubkeys) {
+		return errors.Errorf("sync committee bits of length %d do not match committee size %d", len(u.syncCommitteeBits)*8, len(committee.Pubkeys))
+	}
+	pubkeys := make([][]byte, len(participants))
+	for i, p := range participants {
+		pubkeys[i] = committee.Pubkeys[p]
+	}
+	return s.verifySignature(pubkeys, u.attestedHeader, u.syncCommitteeSignature, u.signatureSlot, s.genesisValidatorsRoot)
+}
+
+// apply implements apply_light_client_update of the sync protocol.
+func (s *store) apply(u *update) error {
+	var next *ethpb.SyncCommittee
+	if u.isSyncCommitteeUpdate() {
+		next = u.nextSyncCommittee
+	}
+	storePeriod := period(s.finalized.Slot)
+	finalizedPeriod := period(u.finalizedHeader.Slot)
+	if s.next == nil {
+		if finalizedPeriod != storePeriod {
+			return errors.Errorf("finalized period %d does not match store period %d", finalizedPeriod, storePeriod)
+		}
+		s.next = next
+	} else if finalizedPeriod == storePeriod+1 {
+		s.current = s.next
+		s.next = next
+	}
+	if u.finalizedHeader.Slot > s.finalized.Slot {
+		s.finalized = u.finalizedHeader
+		if s.finalized.Slot > s.optimistic.Slot {
+			s.optimistic = s.finalized
+		}
+	}
+	return nil
+}
+
+// committeeAt returns the sync committee of the given period if it is known by the store.
+func (s *store) committeeAt(p uint64) *ethpb.SyncCommittee {
+	switch period(s.finalized.Slot) {
+	case p:
+		return s.current
+	case p - 1:
+		return s.next
+	default:
+		return nil
+	}
+}
+
+func verifySyncAggregate(pubkeys [][]byte, attested *ethpb.BeaconBlockHeader, sig []byte, signatureSlot primitives.Slot, genesisValidatorsRoot []byte) error {
+	keys := make([]bls.PublicKey, len(pubkeys))
+	for i, pk := range pubkeys {
+		key, err := bls.PublicKeyFromBytes(pk)
+		if err != nil {
+			return errors.Wrap(err, "could not decode sync committee public key")
+		}
+		keys[i] = key
+	}
+	signature, err := bls.SignatureFromBytes(sig)
+	if err != nil {
+		return errors.Wrap(err, "could not decode sync committee signature")
+	}
+	// The sync committee signs the attested header in the slot before the signature slot.
+	epoch := slots.ToEpoch(signatureSlot - 1)
+	if signatureSlot == 0 {
+		epoch = 0
+	}
+	forkVersion, err := forks.NewOrderedSchedule(params.BeaconConfig()).VersionForEpoch(epoch)
+	if err != nil {
+		return err
+	}
+	domain, err := signing.ComputeDomain(params.BeaconConfig().DomainSyncCommittee, forkVersion[:], genesisValidatorsRoot)
+	if err != nil {
+		return err
+	}
+	root, err := signing.ComputeSigningRoot(attested, domain)
+	if err != nil {
+		return err
+	}
+	if !signature.FastAggregateVerify(keys, root) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+func verifyBranch(root []byte, leaf [32]byte, index uint64, branch [][]byte, depth int) bool {
+	if len(branch) != depth {
+		return false
+	}
+	return trie.VerifyMerkleProof(root, leaf[:], index, branch)
+}
+
+func finalityBranchDepth(v int) int {
+	if v >= version.Electra {
+		return fieldparams.FinalityBranchDepth + 1
+	}
+	return fieldparams.FinalityBranchDepth
+}
+
+func syncCommitteeBranchDepth(v int) int {
+	if v >= version.Electra {
+		return fieldparams.SyncCommitteeBranchDepthElectra
+	}
+	return fieldparams.SyncCommitteeBranchDepth
+}
+
+func equalCommittees(a, b *ethpb.SyncCommittee) bool {
+	if len(a.Pubkeys) != len(b.Pubkeys) || !bytes.Equal(a.AggregatePubkey, b.AggregatePubkey) {
+		return false
+	}
+	for i := range a.Pubkeys {
+		if !bytes.Equal(a.Pubkeys[i], b.Pubkeys[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func period(slot primitives.Slot) uint64 {
+	return slots.SyncCommitteePeriod(slots.ToEpoch(slot))
+}
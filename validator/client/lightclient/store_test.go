@@ -0,0 +1,171 @@
+package lightclient
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/testing/util"
+)
+
+func testCommittee(seed byte) *ethpb.SyncCommittee {
+	pubkeys := make([][]byte, params.BeaconConfig().SyncCommitteeSize)
+	for i := range pubkeys {
+		pk := make([]byte, 48)
+		pk[0] = seed
+		binary.LittleEndian.PutUint16(pk[1:], uint16(i))
+		pubkeys[i] = pk
+	}
+	agg := make([]byte, 48)
+	agg[0] = seed
+	return &ethpb.SyncCommittee{Pubkeys: pubkeys, AggregatePubkey: agg}
+}
+
+// testBlock returns the header of a block at the given slot whose post state has the given sync committees and
+// finalized root, along with the proofs of the current sync committee, next sync committee and finalized root.
+func testBlock(t *testing.T, slot primitives.Slot, current, next *ethpb.SyncCommittee, finalizedRoot [32]byte) (*ethpb.BeaconBlockHeader, [][]byte, [][]byte, [][]byte) {
+	ctx := context.Background()
+	st, err := util.NewBeaconStateAltair()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetCurrentSyncCommittee(current))
+	require.NoError(t, st.SetNextSyncCommittee(next))
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	currentBranch, err := st.CurrentSyncCommitteeProof(ctx)
+	require.NoError(t, err)
+	nextBranch, err := st.NextSyncCommitteeProof(ctx)
+	require.NoError(t, err)
+	finalityBranch, err := st.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+	header := &ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: make([]byte, 32),
+		StateRoot:  stateRoot[:],
+		BodyRoot:   make([]byte, 32),
+	}
+	return header, currentBranch, nextBranch, finalityBranch
+}
+
+func fullBits() []byte {
+	bits := make([]byte, params.BeaconConfig().SyncCommitteeSize/8)
+	for i := range bits {
+		bits[i] = 0xff
+	}
+	return bits
+}
+
+func noSignatureCheck([][]byte, *ethpb.BeaconBlockHeader, []byte, primitives.Slot, []byte) error {
+	return nil
+}
+
+// testStore returns a store bootstrapped at slot 64 with committee A, and an update attested at slot 200 which
+// finalizes slot 96 and proves committee B as the next sync committee.
+func testStore(t *testing.T) (*store, *update) {
+	a, b := testCommittee(1), testCommittee(2)
+	header, currentBranch, _, _ := testBlock(t, 64, a, a, [32]byte{})
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	s, err := newStore(root, &bootstrap{
+		version:                    version.Altair,
+		header:                     header,
+		currentSyncCommittee:       a,
+		currentSyncCommitteeBranch: currentBranch,
+	}, make([]byte, 32))
+	require.NoError(t, err)
+	s.verifySignature = noSignatureCheck
+
+	finalized, _, _, _ := testBlock(t, 96, a, b, [32]byte{})
+	finalizedRoot, err := finalized.HashTreeRoot()
+	require.NoError(t, err)
+	attested, _, nextBranch, finalityBranch := testBlock(t, 200, a, b, finalizedRoot)
+	return s, &update{
+		version:                 version.Altair,
+		attestedHeader:          attested,
+		nextSyncCommittee:       b,
+		nextSyncCommitteeBranch: nextBranch,
+		finalizedHeader:         finalized,
+		finalityBranch:          finalityBranch,
+		syncCommitteeBits:       fullBits(),
+		syncCommitteeSignature:  make([]byte, 96),
+		signatureSlot:           201,
+	}
+}
+
+func TestNewStore(t *testing.T) {
+	a := testCommittee(1)
+	header, branch, _, _ := testBlock(t, 64, a, a, [32]byte{})
+	root, err := header.HashTreeRoot()
+	require.NoError(t, err)
+	b := &bootstrap{version: version.Altair, header: header, currentSyncCommittee: a, currentSyncCommitteeBranch: branch}
+
+	s, err := newStore(root, b, make([]byte, 32))
+	require.NoError(t, err)
+	assert.Equal(t, primitives.Slot(64), s.finalized.Slot)
+	assert.Equal(t, (*ethpb.SyncCommittee)(nil), s.next)
+
+	_, err = newStore([32]byte{'a'}, b, make([]byte, 32))
+	require.ErrorContains(t, "does not match trusted root", err)
+
+	b.currentSyncCommittee = testCommittee(2)
+	_, err = newStore(root, b, make([]byte, 32))
+	require.ErrorContains(t, "invalid current sync committee branch", err)
+}
+
+func TestStore_Process(t *testing.T) {
+	s, u := testStore(t)
+	require.NoError(t, s.process(u, 300))
+	assert.DeepEqual(t, u.finalizedHeader, s.finalized)
+	assert.DeepEqual(t, u.attestedHeader, s.optimistic)
+	assert.DeepEqual(t, u.nextSyncCommittee, s.next)
+	assert.DeepEqual(t, s.current, s.committeeAt(0))
+	assert.DeepEqual(t, u.nextSyncCommittee, s.committeeAt(1))
+	assert.Equal(t, (*ethpb.SyncCommittee)(nil), s.committeeAt(2))
+
+	// An update attesting to the finalized header does not advance the store.
+	old := *u
+	old.attestedHeader = u.finalizedHeader
+	require.ErrorIs(t, s.process(&old, 300), errIrrelevantUpdate)
+}
+
+func TestStore_Validate(t *testing.T) {
+	t.Run("insufficient participation", func(t *testing.T) {
+		s, u := testStore(t)
+		u.syncCommitteeBits = make([]byte, len(u.syncCommitteeBits))
+		require.ErrorIs(t, s.validate(u, 300), errInsufficientParticipation)
+	})
+	t.Run("signature slot in the future", func(t *testing.T) {
+		s, u := testStore(t)
+		require.ErrorContains(t, "invalid signature slot", s.validate(u, 200))
+	})
+	t.Run("invalid finality branch", func(t *testing.T) {
+		s, u := testStore(t)
+		u.finalizedHeader.Slot++
+		require.ErrorContains(t, "invalid finality branch", s.validate(u, 300))
+	})
+	t.Run("invalid next sync committee branch", func(t *testing.T) {
+		s, u := testStore(t)
+		u.nextSyncCommittee = testCommittee(3)
+		require.ErrorContains(t, "invalid next sync committee branch", s.validate(u, 300))
+	})
+	t.Run("invalid signature", func(t *testing.T) {
+		s, u := testStore(t)
+		s.verifySignature = func([][]byte, *ethpb.BeaconBlockHeader, []byte, primitives.Slot, []byte) error {
+			return errInvalidSignature
+		}
+		require.ErrorIs(t, s.validate(u, 300), errInvalidSignature)
+	})
+	t.Run("signature period not covered", func(t *testing.T) {
+		s, u := testStore(t)
+		slotsPerPeriod := primitives.Slot(uint64(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * uint64(params.BeaconConfig().SlotsPerEpoch))
+		u.signatureSlot = slotsPerPeriod + 1
+		require.ErrorContains(t, "is not covered by the store", s.validate(u, slotsPerPeriod+2))
+	})
+}
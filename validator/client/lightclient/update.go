@@ -0,0 +1,218 @@
+package lightclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+)
+
+// bootstrap is a light client bootstrap decoded from the beacon API.
+type bootstrap struct {
+	version                    int
+	header                     *ethpb.BeaconBlockHeader
+	currentSyncCommittee       *ethpb.SyncCommittee
+	currentSyncCommitteeBranch [][]byte
+}
+
+// update is a light client update, finality update or optimistic update decoded from the beacon API.
+// Fields which are absent from the update are nil.
+type update struct {
+	version                 int
+	attestedHeader          *ethpb.BeaconBlockHeader
+	nextSyncCommittee       *ethpb.SyncCommittee
+	nextSyncCommitteeBranch [][]byte
+	finalizedHeader         *ethpb.BeaconBlockHeader
+	finalityBranch          [][]byte
+	syncCommitteeBits       []byte
+	syncCommitteeSignature  []byte
+	signatureSlot           primitives.Slot
+}
+
+// isSyncCommitteeUpdate returns true if the update proves the next sync committee.
+func (u *update) isSyncCommitteeUpdate() bool {
+	return u.nextSyncCommittee != nil && !isZeroBranch(u.nextSyncCommitteeBranch)
+}
+
+// isFinalityUpdate returns true if the update proves a finalized header.
+func (u *update) isFinalityUpdate() bool {
+	return u.finalizedHeader != nil && !isZeroBranch(u.finalityBranch)
+}
+
+// participants returns the indices of the sync committee members which signed the update.
+func (u *update) participants() []int {
+	var indices []int
+	for i := 0; i < len(u.syncCommitteeBits)*8; i++ {
+		if u.syncCommitteeBits[i/8]>>(i%8)&1 == 1 {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+func bootstrapFromJSON(resp *structs.LightClientBootstrapResponse) (*bootstrap, error) {
+	if resp == nil || resp.Data == nil {
+		return nil, errors.New("empty bootstrap")
+	}
+	v, err := version.FromString(resp.Version)
+	if err != nil {
+		return nil, err
+	}
+	header, err := headerFromJSON(resp.Data.Header)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode header")
+	}
+	if resp.Data.CurrentSyncCommittee == nil {
+		return nil, errors.New("missing current sync committee")
+	}
+	committee, err := resp.Data.CurrentSyncCommittee.ToConsensus()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode current sync committee")
+	}
+	branch, err := branchFromJSON(resp.Data.CurrentSyncCommitteeBranch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode current sync committee branch")
+	}
+	return &bootstrap{
+		version:                    v,
+		header:                     header,
+		currentSyncCommittee:       committee,
+		currentSyncCommitteeBranch: branch,
+	}, nil
+}
+
+func updateFromJSON(resp *structs.LightClientUpdateResponse) (*update, error) {
+	if resp == nil || resp.Data == nil {
+		return nil, errors.New("empty update")
+	}
+	u, err := newUpdate(resp.Version, resp.Data.AttestedHeader, resp.Data.SyncAggregate, resp.Data.SignatureSlot)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.setFinality(resp.Data.FinalizedHeader, resp.Data.FinalityBranch); err != nil {
+		return nil, err
+	}
+	if resp.Data.NextSyncCommittee != nil {
+		u.nextSyncCommittee, err = resp.Data.NextSyncCommittee.ToConsensus()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode next sync committee")
+		}
+		u.nextSyncCommitteeBranch, err = branchFromJSON(resp.Data.NextSyncCommitteeBranch)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode next sync committee branch")
+		}
+	}
+	return u, nil
+}
+
+func finalityUpdateFromJSON(resp *structs.LightClientFinalityUpdateResponse) (*update, error) {
+	if resp == nil || resp.Data == nil {
+		return nil, errors.New("empty finality update")
+	}
+	u, err := newUpdate(resp.Version, resp.Data.AttestedHeader, resp.Data.SyncAggregate, resp.Data.SignatureSlot)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.setFinality(resp.Data.FinalizedHeader, resp.Data.FinalityBranch); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+func optimisticUpdateFromJSON(resp *structs.LightClientOptimisticUpdateResponse) (*update, error) {
+	if resp == nil || resp.Data == nil {
+		return nil, errors.New("empty optimistic update")
+	}
+	return newUpdate(resp.Version, resp.Data.AttestedHeader, resp.Data.SyncAggregate, resp.Data.SignatureSlot)
+}
+
+func newUpdate(v string, attested json.RawMessage, agg *structs.SyncAggregate, signatureSlot string) (*update, error) {
+	ver, err := version.FromString(v)
+	if err != nil {
+		return nil, err
+	}
+	header, err := headerFromJSON(attested)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode attested header")
+	}
+	if agg == nil {
+		return nil, errors.New("missing sync aggregate")
+	}
+	bits, err := hexutil.Decode(agg.SyncCommitteeBits)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee bits")
+	}
+	sig, err := hexutil.Decode(agg.SyncCommitteeSignature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee signature")
+	}
+	slot, err := strconv.ParseUint(signatureSlot, 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature slot")
+	}
+	return &update{
+		version:                ver,
+		attestedHeader:         header,
+		syncCommitteeBits:      bits,
+		syncCommitteeSignature: sig,
+		signatureSlot:          primitives.Slot(slot),
+	}, nil
+}
+
+func (u *update) setFinality(finalized json.RawMessage, branch []string) error {
+	if len(finalized) == 0 || bytes.Equal(finalized, []byte("null")) {
+		return nil
+	}
+	var err error
+	u.finalizedHeader, err = headerFromJSON(finalized)
+	if err != nil {
+		return errors.Wrap(err, "could not decode finalized header")
+	}
+	u.finalityBranch, err = branchFromJSON(branch)
+	if err != nil {
+		return errors.Wrap(err, "could not decode finality branch")
+	}
+	return nil
+}
+
+// headerFromJSON decodes the beacon block header of a light client header of any fork.
+func headerFromJSON(raw json.RawMessage) (*ethpb.BeaconBlockHeader, error) {
+	h := &structs.LightClientHeader{}
+	if err := json.Unmarshal(raw, h); err != nil {
+		return nil, err
+	}
+	if h.Beacon == nil {
+		return nil, errors.New("missing beacon header")
+	}
+	return h.Beacon.ToConsensus()
+}
+
+func branchFromJSON(branch []string) ([][]byte, error) {
+	decoded := make([][]byte, len(branch))
+	for i, b := range branch {
+		node, err := hexutil.Decode(b)
+		if err != nil {
+			return nil, err
+		}
+		if len(node) != 32 {
+			return nil, errors.Errorf("invalid branch node length %d", len(node))
+		}
+		decoded[i] = node
+	}
+	return decoded, nil
+}
+
+func isZeroBranch(branch [][]byte) bool {
+	for _, node := range branch {
+		if !bytes.Equal(node, make([]byte, 32)) {
+			return false
+		}
+	}
+	return true
+}
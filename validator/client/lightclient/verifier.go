@@ -0,0 +1,379 @@
+// Package lightclient defines a verifier which follows the chain with a light client synced from a beacon node
+// independent of the one used by the validator client. The head, finalized block and sync committee duties
+// served by the beacon node are compared with the view of the light client, and any divergence is reported
+// through logs and metrics so that a compromised or faulty beacon node is noticed before it harms the validators.
+package lightclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	"github.com/sirupsen/logrus"
+)
+
+// Checks performed by the verifier, used as the label of the divergence metric.
+const (
+	checkGenesis       = "genesis"
+	checkFinalized     = "finalized"
+	checkHead          = "head"
+	checkSyncCommittee = "sync_committee_duties"
+)
+
+// maxUpdatesPerRequest is MAX_REQUEST_LIGHT_CLIENT_UPDATES of the sync protocol.
+const maxUpdatesPerRequest = 128
+
+// bootstrapRetryInterval is the time to wait before bootstrapping the light client again after a failure.
+const bootstrapRetryInterval = 12 * time.Second
+
+// Verifier verifies the responses of the beacon node against a light client.
+type Verifier struct {
+	source      beaconApi.JsonRestHandler
+	node        beaconApi.JsonRestHandler
+	checkpoint  [32]byte
+	lock        sync.RWMutex
+	store       *store
+	genesisTime time.Time
+	diverged    map[string]bool
+}
+
+// NewVerifier creates a verifier of the beacon node served by the node handler, with a light client synced from
+// the source handler. The light client is bootstrapped from the given checkpoint block root, or from the
+// finalized checkpoint of the source when the checkpoint is empty.
+func NewVerifier(source, node beaconApi.JsonRestHandler, checkpoint [32]byte) *Verifier {
+	return &Verifier{
+		source:     source,
+		node:       node,
+		checkpoint: checkpoint,
+		diverged:   make(map[string]bool),
+	}
+}
+
+// Run bootstraps the light client and verifies the beacon node every slot until the context is canceled.
+func (v *Verifier) Run(ctx context.Context) {
+	for {
+		err := v.initialize(ctx)
+		if err == nil {
+			break
+		}
+		log.WithError(err).Error("Could not bootstrap light client")
+		select {
+		case <-time.After(bootstrapRetryInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+	log.WithField("endpoint", v.source.Host()).Info("Verifying beacon node with light client")
+	ticker := slots.NewSlotTicker(v.genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			if err := v.verify(ctx, slot); err != nil {
+				log.WithError(err).Error("Could not verify beacon node with light client")
+			}
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+// VerifySyncCommitteeDuties compares the sync committee membership of the duties of the given epoch with the
+// sync committee known by the light client. Duties of periods unknown to the light client are not verified.
+func (v *Verifier) VerifySyncCommitteeDuties(epoch primitives.Epoch, duties []*ethpb.DutiesResponse_Duty) {
+	v.lock.RLock()
+	if v.store == nil {
+		v.lock.RUnlock()
+		return
+	}
+	committee := v.store.committeeAt(slots.SyncCommitteePeriod(epoch))
+	v.lock.RUnlock()
+	if committee == nil {
+		return
+	}
+	members := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(committee.Pubkeys))
+	for _, pk := range committee.Pubkeys {
+		members[bytesutil.ToBytes48(pk)] = true
+	}
+	var mismatched []string
+	for _, d := range duties {
+		if d == nil || len(d.PublicKey) != fieldparams.BLSPubkeyLength {
+			continue
+		}
+		if members[bytesutil.ToBytes48(d.PublicKey)] != d.IsSyncCommittee {
+			mismatched = append(mismatched, fmt.Sprintf("%#x", bytesutil.Trunc(d.PublicKey)))
+		}
+	}
+	v.report(checkSyncCommittee, len(mismatched) > 0, logrus.Fields{
+		"epoch":   epoch,
+		"pubkeys": mismatched,
+	})
+}
+
+// initialize checks that the beacon node and the source are on the same chain, and bootstraps the light client.
+func (v *Verifier) initialize(ctx context.Context) error {
+	sourceGenesis, err := genesis(ctx, v.source)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis of light client endpoint")
+	}
+	nodeGenesis, err := genesis(ctx, v.node)
+	if err != nil {
+		return errors.Wrap(err, "could not get genesis of beacon node")
+	}
+	if sourceGenesis.GenesisValidatorsRoot != nodeGenesis.GenesisValidatorsRoot || sourceGenesis.GenesisTime != nodeGenesis.GenesisTime {
+		v.report(checkGenesis, true, logrus.Fields{
+			"lightClientGenesisValidatorsRoot": sourceGenesis.GenesisValidatorsRoot,
+			"beaconNodeGenesisValidatorsRoot":  nodeGenesis.GenesisValidatorsRoot,
+		})
+		return errors.New("beacon node and light client endpoint are not on the same chain")
+	}
+	v.report(checkGenesis, false, nil)
+	gvr, err := hexutil.Decode(sourceGenesis.GenesisValidatorsRoot)
+	if err != nil {
+		return errors.Wrap(err, "could not decode genesis validators root")
+	}
+	genesisTime, err := strconv.ParseInt(sourceGenesis.GenesisTime, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "could not decode genesis time")
+	}
+
+	root := v.checkpoint
+	if root == [32]byte{} {
+		cps := &structs.GetFinalityCheckpointsResponse{}
+		if err := v.source.Get(ctx, "/eth/v1/beacon/states/finalized/finality_checkpoints", cps); err != nil {
+			return errors.Wrap(err, "could not get finalized checkpoint of light client endpoint")
+		}
+		if cps.Data == nil || cps.Data.Finalized == nil {
+			return errors.New("empty finalized checkpoint")
+		}
+		r, err := hexutil.Decode(cps.Data.Finalized.Root)
+		if err != nil {
+			return errors.Wrap(err, "could not decode finalized checkpoint root")
+		}
+		root = bytesutil.ToBytes32(r)
+	}
+	resp := &structs.LightClientBootstrapResponse{}
+	if err := v.source.Get(ctx, fmt.Sprintf("/eth/v1/beacon/light_client/bootstrap/%#x", root), resp); err != nil {
+		return errors.Wrap(err, "could not get light client bootstrap")
+	}
+	b, err := bootstrapFromJSON(resp)
+	if err != nil {
+		return errors.Wrap(err, "could not decode light client bootstrap")
+	}
+	s, err := newStore(root, b, gvr)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize light client store")
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	v.store = s
+	v.genesisTime = time.Unix(genesisTime, 0)
+	return nil
+}
+
+// verify syncs the light client to the given slot, then compares its finalized and optimistic headers with
+// the blocks of the beacon node.
+func (v *Verifier) verify(ctx context.Context, slot primitives.Slot) error {
+	if err := v.sync(ctx, slot); err != nil {
+		return errors.Wrap(err, "could not sync light client")
+	}
+	v.lock.RLock()
+	finalized, optimistic := v.store.finalized, v.store.optimistic
+	v.lock.RUnlock()
+	finalizedSlotGauge.Set(float64(finalized.Slot))
+	optimisticSlotGauge.Set(float64(optimistic.Slot))
+
+	if err := v.verifyFinalized(ctx, finalized); err != nil {
+		return errors.Wrap(err, "could not verify finalized block")
+	}
+	return errors.Wrap(v.verifyHead(ctx, optimistic), "could not verify head block")
+}
+
+// sync applies the updates of the periods the light client is missing, then the latest finality and
+// optimistic updates of the source.
+func (v *Verifier) sync(ctx context.Context, slot primitives.Slot) error {
+	v.lock.RLock()
+	storePeriod := period(v.store.finalized.Slot)
+	nextKnown := v.store.next != nil
+	v.lock.RUnlock()
+
+	if current := period(slot); storePeriod < current || !nextKnown {
+		count := current - storePeriod + 1
+		if count > maxUpdatesPerRequest {
+			count = maxUpdatesPerRequest
+		}
+		var resp []*structs.LightClientUpdateResponse
+		endpoint := fmt.Sprintf("/eth/v1/beacon/light_client/updates?start_period=%d&count=%d", storePeriod, count)
+		if err := v.source.Get(ctx, endpoint, &resp); err != nil && !isNotFound(err) {
+			return errors.Wrap(err, "could not get light client updates")
+		}
+		for _, r := range resp {
+			u, err := updateFromJSON(r)
+			if err != nil {
+				return errors.Wrap(err, "could not decode light client update")
+			}
+			if err := v.process(u, slot); err != nil {
+				return errors.Wrap(err, "could not process light client update")
+			}
+		}
+	}
+
+	finality := &structs.LightClientFinalityUpdateResponse{}
+	if err := v.source.Get(ctx, "/eth/v1/beacon/light_client/finality_update", finality); err != nil {
+		return errors.Wrap(err, "could not get light client finality update")
+	}
+	u, err := finalityUpdateFromJSON(finality)
+	if err != nil {
+		return errors.Wrap(err, "could not decode light client finality update")
+	}
+	if err := v.process(u, slot); err != nil {
+		return errors.Wrap(err, "could not process light client finality update")
+	}
+
+	optimistic := &structs.LightClientOptimisticUpdateResponse{}
+	if err := v.source.Get(ctx, "/eth/v1/beacon/light_client/optimistic_update", optimistic); err != nil {
+		return errors.Wrap(err, "could not get light client optimistic update")
+	}
+	u, err = optimisticUpdateFromJSON(optimistic)
+	if err != nil {
+		return errors.Wrap(err, "could not decode light client optimistic update")
+	}
+	return errors.Wrap(v.process(u, slot), "could not process light client optimistic update")
+}
+
+// process applies an update to the store. Updates which do not advance the store are ignored.
+func (v *Verifier) process(u *update, slot primitives.Slot) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	err := v.store.process(u, slot)
+	if errors.Is(err, errIrrelevantUpdate) || errors.Is(err, errInsufficientParticipation) {
+		return nil
+	}
+	return err
+}
+
+// verifyFinalized reports a divergence when the beacon node does not have the finalized header of the light
+// client in its chain.
+func (v *Verifier) verifyFinalized(ctx context.Context, finalized *ethpb.BeaconBlockHeader) error {
+	root, err := finalized.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	nodeRoot, _, found, err := v.nodeHeader(ctx, strconv.FormatUint(uint64(finalized.Slot), 10))
+	if err != nil {
+		return err
+	}
+	v.report(checkFinalized, !found || nodeRoot != root, logrus.Fields{
+		"slot":            finalized.Slot,
+		"lightClientRoot": fmt.Sprintf("%#x", root),
+		"beaconNodeRoot":  fmt.Sprintf("%#x", nodeRoot),
+	})
+	return nil
+}
+
+// verifyHead reports a divergence when the beacon node has synced past the optimistic header of the light
+// client without having it in its chain. The divergence may be transient, as the light client can follow
+// a block which is then reorged out.
+func (v *Verifier) verifyHead(ctx context.Context, optimistic *ethpb.BeaconBlockHeader) error {
+	_, headSlot, found, err := v.nodeHeader(ctx, "head")
+	if err != nil {
+		return err
+	}
+	if !found || headSlot < optimistic.Slot {
+		// The beacon node is still syncing.
+		return nil
+	}
+	root, err := optimistic.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	nodeRoot, _, found, err := v.nodeHeader(ctx, strconv.FormatUint(uint64(optimistic.Slot), 10))
+	if err != nil {
+		return err
+	}
+	v.report(checkHead, !found || nodeRoot != root, logrus.Fields{
+		"slot":            optimistic.Slot,
+		"lightClientRoot": fmt.Sprintf("%#x", root),
+		"beaconNodeRoot":  fmt.Sprintf("%#x", nodeRoot),
+	})
+	return nil
+}
+
+// nodeHeader returns the root and slot of the block of the beacon node with the given block ID, and false if
+// there is no such block.
+func (v *Verifier) nodeHeader(ctx context.Context, blockID string) ([32]byte, primitives.Slot, bool, error) {
+	resp := &structs.GetBlockHeaderResponse{}
+	if err := v.node.Get(ctx, "/eth/v1/beacon/headers/"+blockID, resp); err != nil {
+		if isNotFound(err) {
+			return [32]byte{}, 0, false, nil
+		}
+		return [32]byte{}, 0, false, errors.Wrapf(err, "could not get block header %s", blockID)
+	}
+	if resp.Data == nil || resp.Data.Header == nil || resp.Data.Header.Message == nil {
+		return [32]byte{}, 0, false, errors.New("empty block header")
+	}
+	root, err := hexutil.Decode(resp.Data.Root)
+	if err != nil {
+		return [32]byte{}, 0, false, errors.Wrap(err, "could not decode block root")
+	}
+	slot, err := strconv.ParseUint(resp.Data.Header.Message.Slot, 10, 64)
+	if err != nil {
+		return [32]byte{}, 0, false, errors.Wrap(err, "could not decode block slot")
+	}
+	return bytesutil.ToBytes32(root), primitives.Slot(slot), true, nil
+}
+
+// report updates the divergence metric of a check, and logs divergences and recoveries.
+func (v *Verifier) report(check string, diverged bool, fields logrus.Fields) {
+	v.lock.Lock()
+	prev := v.diverged[check]
+	v.diverged[check] = diverged
+	v.lock.Unlock()
+
+	if diverged {
+		divergenceGauge.WithLabelValues(check).Set(1)
+	} else {
+		divergenceGauge.WithLabelValues(check).Set(0)
+	}
+	entry := log.WithFields(fields).WithField("check", check)
+	switch {
+	case diverged && check == checkHead:
+		entry.Warn("Beacon node head diverges from light client")
+	case diverged:
+		entry.Error("Beacon node diverges from light client, it may be compromised or faulty")
+	case prev:
+		entry.Info("Beacon node agrees with light client again")
+	}
+}
+
+func genesis(ctx context.Context, h beaconApi.JsonRestHandler) (*structs.Genesis, error) {
+	resp := &structs.GetGenesisResponse{}
+	if err := h.Get(ctx, "/eth/v1/beacon/genesis", resp); err != nil {
+		return nil, err
+	}
+	if resp.Data == nil {
+		return nil, errors.New("empty genesis")
+	}
+	return resp.Data, nil
+}
+
+func isNotFound(err error) bool {
+	jsonErr := &httputil.DefaultJsonError{}
+	return errors.As(err, &jsonErr) && jsonErr.Code == http.StatusNotFound
+}
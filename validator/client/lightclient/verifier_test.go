@@ -0,0 +1,185 @@
+package lightclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+// fakeHandler serves the JSON encoding of the responses registered for each endpoint, and 404 otherwise.
+type fakeHandler map[string]interface{}
+
+func (h fakeHandler) Get(_ context.Context, endpoint string, resp interface{}) error {
+	r, ok := h[endpoint]
+	if !ok {
+		return &httputil.DefaultJsonError{Code: http.StatusNotFound, Message: "not found"}
+	}
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, resp)
+}
+
+func (fakeHandler) Post(context.Context, string, map[string]string, *bytes.Buffer, interface{}) error {
+	return errors.New("not implemented")
+}
+
+func (fakeHandler) HttpClient() *http.Client {
+	return nil
+}
+
+func (fakeHandler) Host() string {
+	return "http://localhost:3500"
+}
+
+func (fakeHandler) SetHost(string) {}
+
+func lightClientHeaderJSON(t *testing.T, h *ethpb.BeaconBlockHeader) json.RawMessage {
+	b, err := json.Marshal(&structs.LightClientHeader{Beacon: structs.BeaconBlockHeaderFromConsensus(h)})
+	require.NoError(t, err)
+	return b
+}
+
+func branchJSON(branch [][]byte) []string {
+	s := make([]string, len(branch))
+	for i, b := range branch {
+		s[i] = hexutil.Encode(b)
+	}
+	return s
+}
+
+func headerResponse(t *testing.T, h *ethpb.BeaconBlockHeader) *structs.GetBlockHeaderResponse {
+	root, err := h.HashTreeRoot()
+	require.NoError(t, err)
+	return &structs.GetBlockHeaderResponse{
+		Data: &structs.SignedBeaconBlockHeaderContainer{
+			Root: hexutil.Encode(root[:]),
+			Header: &structs.SignedBeaconBlockHeader{
+				Message:   structs.BeaconBlockHeaderFromConsensus(h),
+				Signature: hexutil.Encode(make([]byte, 96)),
+			},
+		},
+	}
+}
+
+// testVerifier returns a verifier bootstrapped at slot 64, whose source serves the finality update of testStore,
+// and the beacon node handler.
+func testVerifier(t *testing.T) (*Verifier, fakeHandler, *update) {
+	s, u := testStore(t)
+	root, err := s.finalized.HashTreeRoot()
+	require.NoError(t, err)
+	_, branch, _, _ := testBlock(t, 64, s.current, s.current, [32]byte{})
+	gen := &structs.GetGenesisResponse{Data: &structs.Genesis{
+		GenesisTime:           "1606824023",
+		GenesisValidatorsRoot: hexutil.Encode(make([]byte, 32)),
+	}}
+	source := fakeHandler{
+		"/eth/v1/beacon/genesis": gen,
+		"/eth/v1/beacon/light_client/bootstrap/" + hexutil.Encode(root[:]): &structs.LightClientBootstrapResponse{
+			Version: "altair",
+			Data: &structs.LightClientBootstrap{
+				Header:                     lightClientHeaderJSON(t, s.finalized),
+				CurrentSyncCommittee:       structs.SyncCommitteeFromConsensus(s.current),
+				CurrentSyncCommitteeBranch: branchJSON(branch),
+			},
+		},
+		"/eth/v1/beacon/light_client/finality_update": &structs.LightClientFinalityUpdateResponse{
+			Version: "altair",
+			Data: &structs.LightClientFinalityUpdate{
+				AttestedHeader:  lightClientHeaderJSON(t, u.attestedHeader),
+				FinalizedHeader: lightClientHeaderJSON(t, u.finalizedHeader),
+				FinalityBranch:  branchJSON(u.finalityBranch),
+				SyncAggregate: &structs.SyncAggregate{
+					SyncCommitteeBits:      hexutil.Encode(u.syncCommitteeBits),
+					SyncCommitteeSignature: hexutil.Encode(u.syncCommitteeSignature),
+				},
+				SignatureSlot: "201",
+			},
+		},
+		"/eth/v1/beacon/light_client/optimistic_update": &structs.LightClientOptimisticUpdateResponse{
+			Version: "altair",
+			Data: &structs.LightClientOptimisticUpdate{
+				AttestedHeader: lightClientHeaderJSON(t, u.attestedHeader),
+				SyncAggregate: &structs.SyncAggregate{
+					SyncCommitteeBits:      hexutil.Encode(u.syncCommitteeBits),
+					SyncCommitteeSignature: hexutil.Encode(u.syncCommitteeSignature),
+				},
+				SignatureSlot: "201",
+			},
+		},
+	}
+	node := fakeHandler{"/eth/v1/beacon/genesis": gen}
+	v := NewVerifier(source, node, root)
+	require.NoError(t, v.initialize(context.Background()))
+	v.store.verifySignature = noSignatureCheck
+	return v, node, u
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	v, node, u := testVerifier(t)
+	other := &ethpb.BeaconBlockHeader{Slot: 200, ParentRoot: make([]byte, 32), StateRoot: make([]byte, 32), BodyRoot: make([]byte, 32)}
+	node["/eth/v1/beacon/headers/96"] = headerResponse(t, u.finalizedHeader)
+	node["/eth/v1/beacon/headers/200"] = headerResponse(t, other)
+	node["/eth/v1/beacon/headers/head"] = headerResponse(t, other)
+
+	require.NoError(t, v.verify(context.Background(), 300))
+	assert.DeepEqual(t, u.finalizedHeader, v.store.finalized)
+	assert.DeepEqual(t, u.attestedHeader, v.store.optimistic)
+	assert.Equal(t, false, v.diverged[checkFinalized])
+	assert.Equal(t, true, v.diverged[checkHead])
+
+	// The beacon node does not have the finalized block of the light client.
+	delete(node, "/eth/v1/beacon/headers/96")
+	node["/eth/v1/beacon/headers/200"] = headerResponse(t, u.attestedHeader)
+	require.NoError(t, v.verify(context.Background(), 300))
+	assert.Equal(t, true, v.diverged[checkFinalized])
+	assert.Equal(t, false, v.diverged[checkHead])
+}
+
+func TestVerifier_VerifySyncCommitteeDuties(t *testing.T) {
+	v, _, _ := testVerifier(t)
+	member := v.store.current.Pubkeys[3]
+	nonMember := make([]byte, 48)
+
+	v.VerifySyncCommitteeDuties(0, []*ethpb.DutiesResponse_Duty{
+		{PublicKey: member, IsSyncCommittee: true},
+		{PublicKey: nonMember, IsSyncCommittee: false},
+	})
+	assert.Equal(t, false, v.diverged[checkSyncCommittee])
+
+	v.VerifySyncCommitteeDuties(0, []*ethpb.DutiesResponse_Duty{
+		{PublicKey: member, IsSyncCommittee: false},
+	})
+	assert.Equal(t, true, v.diverged[checkSyncCommittee])
+
+	// The next sync committee is unknown, so the duties are not verified.
+	v.VerifySyncCommitteeDuties(256, []*ethpb.DutiesResponse_Duty{
+		{PublicKey: member, IsSyncCommittee: true},
+	})
+	assert.Equal(t, true, v.diverged[checkSyncCommittee])
+}
+
+func TestVerifier_GenesisMismatch(t *testing.T) {
+	source := fakeHandler{"/eth/v1/beacon/genesis": &structs.GetGenesisResponse{Data: &structs.Genesis{
+		GenesisTime:           "1606824023",
+		GenesisValidatorsRoot: hexutil.Encode(make([]byte, 32)),
+	}}}
+	node := fakeHandler{"/eth/v1/beacon/genesis": &structs.GetGenesisResponse{Data: &structs.Genesis{
+		GenesisTime:           "1606824023",
+		GenesisValidatorsRoot: hexutil.Encode(bytes.Repeat([]byte{1}, 32)),
+	}}}
+	v := NewVerifier(source, node, [32]byte{})
+	require.ErrorContains(t, "not on the same chain", v.initialize(context.Background()))
+	assert.Equal(t, true, v.diverged[checkGenesis])
+}
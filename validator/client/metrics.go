@@ -203,6 +203,36 @@ var (
 			"pubkey",
 		},
 	)
+	// dutiesInFlightGauge used to track the number of duties submitting to the beacon node at once.
+	dutiesInFlightGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "duties_in_flight",
+			Help:      "Number of duties submitting to the beacon node at once",
+		},
+	)
+	// batchedRequestsCounter used to count the beacon node requests saved by sharing them between the duties of a slot.
+	batchedRequestsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "batched_requests_total",
+			Help:      "Number of beacon node requests saved by sharing them between the duties of a slot",
+		},
+		[]string{
+			"request",
+		},
+	)
+	// proposalPreflightFailuresCounter used to count the failed checks of the beacon node ahead of proposals.
+	proposalPreflightFailuresCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "validator",
+			Name:      "proposal_preflight_failures_total",
+			Help:      "Number of failed readiness checks of the beacon node ahead of proposals",
+		},
+		[]string{
+			"check",
+		},
+	)
 )
 
 // LogValidatorGainsAndLosses logs important metrics related to this validator client's
@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	"github.com/sirupsen/logrus"
+)
+
+const proposalPreflightPath = "/prysm/v1/validator/proposal_preflight"
+
+// proposalPreflight asks the beacon node, a lead time before each proposal of the validators, whether it is ready to
+// produce the block, and warns about the failed checks so that they can be fixed before the proposal.
+type proposalPreflight struct {
+	ctx       context.Context
+	node      beaconApi.JsonRestHandler
+	lead      time.Duration
+	lock      sync.Mutex
+	scheduled map[primitives.Slot]bool
+}
+
+func newProposalPreflight(ctx context.Context, node beaconApi.JsonRestHandler, lead time.Duration) *proposalPreflight {
+	return &proposalPreflight{
+		ctx:       ctx,
+		node:      node,
+		lead:      lead,
+		scheduled: make(map[primitives.Slot]bool),
+	}
+}
+
+// schedule schedules the checks of the proposals of the duties which are not scheduled yet, at the lead time before
+// the proposal, or immediately when the proposal is closer than the lead time. settings returns the fee recipient
+// the validator of the duty expects to be paid, and whether its proposer settings enable the builder. A nil
+// preflight schedules nothing.
+func (p *proposalPreflight) schedule(
+	genesisTime uint64,
+	current primitives.Slot,
+	duties []*ethpb.DutiesResponse_Duty,
+	settings func(*ethpb.DutiesResponse_Duty) (feeRecipient []byte, builderEnabled bool),
+) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for slot := range p.scheduled {
+		if slot < current {
+			delete(p.scheduled, slot)
+		}
+	}
+	for _, duty := range duties {
+		for _, slot := range duty.ProposerSlots {
+			if slot <= current || p.scheduled[slot] {
+				continue
+			}
+			p.scheduled[slot] = true
+			delay := time.Until(slots.StartTime(genesisTime, slot).Add(-p.lead))
+			feeRecipient, builderEnabled := settings(duty)
+			go p.checkAt(delay, slot, duty.ValidatorIndex, feeRecipient, builderEnabled)
+		}
+	}
+}
+
+func (p *proposalPreflight) checkAt(
+	delay time.Duration,
+	slot primitives.Slot,
+	index primitives.ValidatorIndex,
+	feeRecipient []byte,
+	builderEnabled bool,
+) {
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+	oneSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	ctx, cancel := context.WithTimeout(p.ctx, oneSlot)
+	defer cancel()
+	p.check(ctx, slot, index, feeRecipient, builderEnabled)
+}
+
+// check asks the beacon node whether it is ready for the proposal, and logs the result. Failed informational checks
+// are logged without making the beacon node unready.
+func (p *proposalPreflight) check(
+	ctx context.Context,
+	slot primitives.Slot,
+	index primitives.ValidatorIndex,
+	feeRecipient []byte,
+	builderEnabled bool,
+) {
+	fields := logrus.Fields{
+		"slot":           slot,
+		"validatorIndex": index,
+	}
+	endpoint := fmt.Sprintf("%s?proposer_index=%d&builder_enabled=%t", proposalPreflightPath, index, builderEnabled)
+	if feeRecipient != nil {
+		endpoint += "&fee_recipient=" + hexutil.Encode(feeRecipient)
+	}
+	resp := &structs.GetProposalPreflightResponse{}
+	if err := p.node.Get(ctx, endpoint, resp); err != nil {
+		log.WithError(err).WithFields(fields).Warn("Could not check the readiness of the beacon node for the proposal")
+		return
+	}
+	if resp.Data == nil {
+		log.WithFields(fields).Warn("Beacon node returned no proposal readiness checks")
+		return
+	}
+	for _, c := range resp.Data.Checks {
+		switch {
+		case c.Ok:
+		case c.Informational:
+			log.WithFields(fields).WithField("check", c.Name).Info("Proposal readiness note: " + c.Message)
+		default:
+			proposalPreflightFailuresCounter.WithLabelValues(c.Name).Inc()
+			log.WithFields(fields).WithField("check", c.Name).Warn("Beacon node is not ready for the proposal: " + c.Message)
+		}
+	}
+	if resp.Data.Ready {
+		log.WithFields(fields).Info("Beacon node is ready for the proposal")
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/api"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestProposalPreflight(t *testing.T) {
+	hook := logTest.NewGlobal()
+	queries := make(chan string, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.Path + "?" + r.URL.RawQuery
+		w.Header().Set("Content-Type", api.JsonMediaType)
+		_ = json.NewEncoder(w).Encode(&structs.GetProposalPreflightResponse{Data: &structs.ProposalPreflight{
+			Checks: []*structs.ProposalPreflightCheck{
+				{Name: "execution_client", Ok: true},
+				{Name: "fee_recipient", Message: "Validator is not prepared for proposals"},
+				{Name: "blob_pool", Informational: true, Message: "Execution client does not support engine_getBlobsV1"},
+			},
+		}})
+	}))
+	defer srv.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := newProposalPreflight(ctx, beaconApi.NewBeaconApiJsonRestHandler(http.Client{Timeout: time.Second}, srv.URL), time.Hour)
+
+	// The proposal of slot 2 is closer than the lead time and checked at once, the past one of slot 1 is not.
+	genesis := uint64(time.Now().Unix())
+	duties := []*ethpb.DutiesResponse_Duty{{ValidatorIndex: 3, ProposerSlots: []primitives.Slot{1, 2}}}
+	settings := func(*ethpb.DutiesResponse_Duty) ([]byte, bool) { return []byte{1, 2}, true }
+	p.schedule(genesis, 1, duties, settings)
+	p.schedule(genesis, 1, duties, settings)
+	select {
+	case q := <-queries:
+		assert.Equal(t, proposalPreflightPath+"?proposer_index=3&builder_enabled=true&fee_recipient=0x0102", q)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Proposal was not checked")
+	}
+	p.lock.Lock()
+	assert.DeepEqual(t, map[primitives.Slot]bool{2: true}, p.scheduled)
+	p.lock.Unlock()
+
+	p.check(ctx, 2, 3, nil, false)
+	assert.Equal(t, proposalPreflightPath+"?proposer_index=3&builder_enabled=false", <-queries)
+	assert.LogsContain(t, hook, "Beacon node is not ready for the proposal: Validator is not prepared for proposals")
+	assert.LogsContain(t, hook, "Proposal readiness note: Execution client does not support engine_getBlobsV1")
+	assert.LogsDoNotContain(t, hook, "Beacon node is not ready for the proposal: Execution client")
+	assert.Equal(t, 0, len(queries))
+
+	// Checks of past proposals are forgotten.
+	p.schedule(genesis, 3, nil, settings)
+	p.lock.Lock()
+	assert.Equal(t, 0, len(p.scheduled))
+	p.lock.Unlock()
+
+	var nilPreflight *proposalPreflight
+	nilPreflight.schedule(genesis, 1, duties, settings)
+}
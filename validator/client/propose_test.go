@@ -719,6 +719,45 @@ func testProposeBlock(t *testing.T, graffiti []byte) {
 	}
 }
 
+func TestProposeBlock_Distributed_SignsMiddlewareBlock(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, m, validatorKey, finish := setup(t, false)
+	defer finish()
+	var pubKey [fieldparams.BLSPubkeyLength]byte
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	validator.distributed = true
+	validator.graffiti = []byte("local")
+
+	// The block of the middleware carries the randao reveal aggregated for the cluster and the graffiti of the
+	// middleware, which differ from the ones of the validator client.
+	middlewareBlock := util.NewBeaconBlockCapella().Block
+	middlewareBlock.Slot = 1
+	middlewareBlock.Body.RandaoReveal = bytesutil.PadTo([]byte("aggregated randao"), fieldparams.BLSSignatureLength)
+	middlewareBlock.Body.Graffiti = bytesutil.PadTo([]byte("middleware"), 32)
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/).Times(2)
+	m.validatorClient.EXPECT().BeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.BlockRequest{}),
+	).Return(&ethpb.GenericBeaconBlock{Block: &ethpb.GenericBeaconBlock_Capella{Capella: middlewareBlock}}, nil /*err*/)
+	var signed *ethpb.SignedBeaconBlockCapella
+	m.validatorClient.EXPECT().ProposeBeaconBlock(
+		gomock.Any(), // ctx
+		gomock.AssignableToTypeOf(&ethpb.GenericSignedBeaconBlock{}),
+	).DoAndReturn(func(_ context.Context, block *ethpb.GenericSignedBeaconBlock) (*ethpb.ProposeResponse, error) {
+		signed = block.GetCapella()
+		return &ethpb.ProposeResponse{BlockRoot: make([]byte, 32)}, nil
+	})
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Submitted new block")
+	require.NotNil(t, signed)
+	assert.DeepEqual(t, middlewareBlock, signed.Block)
+}
+
 func TestProposeExit_ValidatorIndexFailed(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
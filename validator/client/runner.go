@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	prysmTrace "github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/codes"
@@ -35,7 +36,9 @@ var backOffPeriod = 10 * time.Second
 // 4 - Update assignments
 // 5 - Determine role at current slot
 // 6 - Perform assigned role, if any
-func run(ctx context.Context, v iface.Validator) {
+//
+// The completion of the duties of each slot is recorded in the duty tracker.
+func run(ctx context.Context, v iface.Validator, duties *dutyTracker) {
 	cleanup := v.Done
 	defer cleanup()
 
@@ -49,6 +52,7 @@ func run(ctx context.Context, v iface.Validator) {
 	eventsChan := make(chan *event.Event, 1)
 	healthTracker := v.HealthTracker()
 	runHealthCheckRoutine(ctx, v, eventsChan)
+	go runDutiesStream(ctx, v)
 
 	accountsChangedChan := make(chan [][fieldparams.BLSPubkeyLength]byte, 1)
 	km, err := v.Keymanager()
@@ -115,7 +119,7 @@ func run(ctx context.Context, v iface.Validator) {
 				span.End()
 				continue
 			}
-			performRoles(slotCtx, allRoles, v, slot, &wg, span)
+			performRoles(slotCtx, allRoles, v, slot, &wg, span, duties)
 		case isHealthyAgain := <-healthTracker.HealthUpdates():
 			if isHealthyAgain {
 				headSlot, err = initializeValidatorAndGetHeadSlot(ctx, v)
@@ -226,23 +230,25 @@ func initializeValidatorAndGetHeadSlot(ctx context.Context, v iface.Validator) (
 	return headSlot, nil
 }
 
-func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.ValidatorRole, v iface.Validator, slot primitives.Slot, wg *sync.WaitGroup, span trace.Span) {
+func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.ValidatorRole, v iface.Validator, slot primitives.Slot, wg *sync.WaitGroup, span trace.Span, duties *dutyTracker) {
 	for pubKey, roles := range allRoles {
 		wg.Add(len(roles))
 		for _, role := range roles {
 			go func(role iface.ValidatorRole, pubKey [fieldparams.BLSPubkeyLength]byte) {
 				defer wg.Done()
+				// Every duty retries its failed beacon API requests within its own budget.
+				dutyCtx := beaconApi.WithRetryBudget(slotCtx)
 				switch role {
 				case iface.RoleAttester:
-					v.SubmitAttestation(slotCtx, slot, pubKey)
+					v.SubmitAttestation(dutyCtx, slot, pubKey)
 				case iface.RoleProposer:
-					v.ProposeBlock(slotCtx, slot, pubKey)
+					v.ProposeBlock(dutyCtx, slot, pubKey)
 				case iface.RoleAggregator:
-					v.SubmitAggregateAndProof(slotCtx, slot, pubKey)
+					v.SubmitAggregateAndProof(dutyCtx, slot, pubKey)
 				case iface.RoleSyncCommittee:
-					v.SubmitSyncCommitteeMessage(slotCtx, slot, pubKey)
+					v.SubmitSyncCommitteeMessage(dutyCtx, slot, pubKey)
 				case iface.RoleSyncCommitteeAggregator:
-					v.SubmitSignedContributionAndProof(slotCtx, slot, pubKey)
+					v.SubmitSignedContributionAndProof(dutyCtx, slot, pubKey)
 				case iface.RoleUnknown:
 					log.WithField("pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:]))).Trace("No active roles, doing nothing")
 				default:
@@ -255,6 +261,7 @@ func performRoles(slotCtx context.Context, allRoles map[[48]byte][]iface.Validat
 	// Wait for all processes to complete, then report span complete.
 	go func() {
 		wg.Wait()
+		duties.markDone(slot)
 		defer span.End()
 		defer func() {
 			if err := recover(); err != nil { // catch any panic in logging
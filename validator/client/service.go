@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -15,15 +16,18 @@ import (
 	grpcutil "github.com/prysmaticlabs/prysm/v5/api/grpc"
 	"github.com/prysmaticlabs/prysm/v5/async/event"
 	lruwrpr "github.com/prysmaticlabs/prysm/v5/cache/lru"
+	"github.com/prysmaticlabs/prysm/v5/config/features"
 	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/auditlog"
 	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	beaconChainClientFactory "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-chain-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/lightclient"
 	nodeclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/node-client-factory"
 	validatorclientfactory "github.com/prysmaticlabs/prysm/v5/validator/client/validator-client-factory"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
@@ -31,7 +35,11 @@ import (
 	validatorHelpers "github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/pkcs11"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashingbackup"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashinggate"
+	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -51,12 +59,29 @@ type ValidatorService struct {
 	graffitiStruct          *graffiti.Graffiti
 	interopKeysConfig       *local.InteropKeymanagerConfig
 	web3SignerConfig        *remoteweb3signer.SetupConfig
+	pkcs11Config            *pkcs11.SetupConfig
 	proposerSettings        *proposer.Settings
 	validatorsRegBatchSize  int
 	useWeb                  bool
 	emitAccountMetrics      bool
 	logValidatorPerformance bool
 	distributed             bool
+	dutyRole                DutyRole
+	externalBlockSource     string
+	lightClientEndpoint     string
+	lightClientCheckpoint   [32]byte
+	shutdownDutyWindow      time.Duration
+	attestationHeadMaxWait  time.Duration
+	maxConcurrentDuties     int
+	auditLog                *auditlog.Log
+	slashingGate            *slashinggate.Gate
+	slashingBackup          *slashingbackup.Backup
+	proposalPreflightLead   time.Duration
+	localGasLimitsToken     string
+	beaconApiCache          bool
+	beaconApiHedgeDelay     time.Duration
+	beaconApiRetryBudget    int
+	duties                  *dutyTracker
 }
 
 // Config for the validator service.
@@ -77,12 +102,52 @@ type Config struct {
 	GraffitiStruct          *graffiti.Graffiti
 	InteropKmConfig         *local.InteropKeymanagerConfig
 	Web3SignerConfig        *remoteweb3signer.SetupConfig
+	PKCS11Config            *pkcs11.SetupConfig
 	ProposerSettings        *proposer.Settings
 	ValidatorsRegBatchSize  int
 	UseWeb                  bool
 	LogValidatorPerformance bool
 	EmitAccountMetrics      bool
 	Distributed             bool
+	// DutyRole is the set of duties performed by the validator client.
+	DutyRole DutyRole
+	// ExternalBlockSourceEndpoint is the endpoint of a local API serving the beacon API block production endpoint,
+	// from which the blocks to propose are requested instead of the beacon node. Disabled when empty.
+	ExternalBlockSourceEndpoint string
+	// LightClientVerificationEndpoint is the beacon API endpoint of an independent beacon node from which
+	// a light client verifying the beacon node is synced. Verification is disabled when empty.
+	LightClientVerificationEndpoint   string
+	LightClientVerificationCheckpoint [32]byte
+	// ShutdownDutyWindow is the time ahead in which the duties due when the service stops are performed before
+	// stopping. The service stops immediately when 0.
+	ShutdownDutyWindow time.Duration
+	// AttestationHeadMaxWait is the time after the start of the slot until which attestations and sync committee
+	// messages wait for the head block of the slot. They wait until the one-third mark when 0.
+	AttestationHeadMaxWait time.Duration
+	// MaxConcurrentDuties is the maximum number of duties submitting to the beacon node at once. It is unlimited
+	// when 0.
+	MaxConcurrentDuties int
+	// AuditLog records the objects signed by the validator client. Nothing is recorded when nil.
+	AuditLog *auditlog.Log
+	// SlashingGate checks blocks and attestations with a remote slashing protection before signing them. Nothing
+	// is checked when nil.
+	SlashingGate *slashinggate.Gate
+	// SlashingBackup exports the slashing protection history as the finalized checkpoint advances. Nothing is
+	// exported when nil.
+	SlashingBackup *slashingbackup.Backup
+	// ProposalPreflightLead is the time before each proposal at which the beacon node is checked for readiness to
+	// produce the block. Nothing is checked when 0.
+	ProposalPreflightLead time.Duration
+	// LocalGasLimitsToken is the admin token of the beacon node with which the gas limits of the proposer settings are
+	// sent to it, for the execution client to target them when it builds payloads locally. Nothing is sent when empty.
+	LocalGasLimitsToken string
+	// BeaconApiCache caches the idempotent beacon API responses, and sends concurrent identical requests once.
+	BeaconApiCache bool
+	// BeaconApiHedgeDelay is the time after which a beacon API request without response is also sent to the next
+	// beacon node. Requests are not hedged when 0.
+	BeaconApiHedgeDelay time.Duration
+	// BeaconApiRetryBudget is the number of times the failed beacon API requests of a duty are retried in total.
+	BeaconApiRetryBudget int
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -100,12 +165,29 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		graffitiStruct:          cfg.GraffitiStruct,
 		interopKeysConfig:       cfg.InteropKmConfig,
 		web3SignerConfig:        cfg.Web3SignerConfig,
+		pkcs11Config:            cfg.PKCS11Config,
 		proposerSettings:        cfg.ProposerSettings,
 		validatorsRegBatchSize:  cfg.ValidatorsRegBatchSize,
 		useWeb:                  cfg.UseWeb,
 		emitAccountMetrics:      cfg.EmitAccountMetrics,
 		logValidatorPerformance: cfg.LogValidatorPerformance,
 		distributed:             cfg.Distributed,
+		dutyRole:                cfg.DutyRole,
+		externalBlockSource:     cfg.ExternalBlockSourceEndpoint,
+		lightClientEndpoint:     cfg.LightClientVerificationEndpoint,
+		lightClientCheckpoint:   cfg.LightClientVerificationCheckpoint,
+		shutdownDutyWindow:      cfg.ShutdownDutyWindow,
+		attestationHeadMaxWait:  cfg.AttestationHeadMaxWait,
+		maxConcurrentDuties:     cfg.MaxConcurrentDuties,
+		auditLog:                cfg.AuditLog,
+		slashingGate:            cfg.SlashingGate,
+		slashingBackup:          cfg.SlashingBackup,
+		proposalPreflightLead:   cfg.ProposalPreflightLead,
+		localGasLimitsToken:     cfg.LocalGasLimitsToken,
+		beaconApiCache:          cfg.BeaconApiCache,
+		beaconApiHedgeDelay:     cfg.BeaconApiHedgeDelay,
+		beaconApiRetryBudget:    cfg.BeaconApiRetryBudget,
+		duties:                  newDutyTracker(),
 	}
 
 	dialOpts := ConstructDialOptions(
@@ -176,8 +258,23 @@ func (v *ValidatorService) Start() {
 		http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
 		hosts[0],
 	)
+	if v.beaconApiHedgeDelay > 0 || v.beaconApiRetryBudget > 0 {
+		restHandler = beaconApi.NewHedgingJsonRestHandler(
+			restHandler,
+			http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
+			hosts,
+			v.beaconApiHedgeDelay,
+			v.beaconApiRetryBudget,
+		)
+	}
+	if v.beaconApiCache {
+		restHandler = beaconApi.NewCachingJsonRestHandler(restHandler)
+	}
 
 	validatorClient := validatorclientfactory.NewValidatorClient(v.conn, restHandler)
+	if features.Get().EnableBeaconRESTApi {
+		go auditRESTParity(v.ctx, restHandler)
+	}
 
 	valStruct := &validator{
 		slotFeed:                       new(event.Feed),
@@ -200,6 +297,7 @@ func (v *ValidatorService) Start() {
 		db:                             v.db,
 		km:                             nil,
 		web3SignerConfig:               v.web3SignerConfig,
+		pkcs11Config:                   v.pkcs11Config,
 		proposerSettings:               v.proposerSettings,
 		signedValidatorRegistrations:   make(map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1),
 		validatorsRegBatchSize:         v.validatorsRegBatchSize,
@@ -215,22 +313,92 @@ func (v *ValidatorService) Start() {
 		emitAccountMetrics:             v.emitAccountMetrics,
 		useWeb:                         v.useWeb,
 		distributed:                    v.distributed,
+		dutyRole:                       v.dutyRole,
+		attestationHeadMaxWait:         v.attestationHeadMaxWait,
+		dutyBatcher:                    newDutyBatcher(v.maxConcurrentDuties),
+		auditLog:                       v.auditLog,
+		slashingGate:                   v.slashingGate,
+	}
+
+	if v.lightClientEndpoint != "" {
+		source := beaconApi.NewBeaconApiJsonRestHandler(
+			http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
+			v.lightClientEndpoint,
+		)
+		valStruct.lightClientVerifier = lightclient.NewVerifier(source, restHandler, v.lightClientCheckpoint)
+		go valStruct.lightClientVerifier.Run(v.ctx)
+	}
+
+	if v.slashingBackup != nil {
+		go v.slashingBackup.Run(v.ctx, valStruct.chainClient)
+	}
+
+	if v.externalBlockSource != "" {
+		valStruct.externalBlockSource = beaconApi.NewBeaconApiValidatorClient(beaconApi.NewBeaconApiJsonRestHandler(
+			http.Client{Timeout: v.conn.GetBeaconApiTimeout()},
+			v.externalBlockSource,
+		))
+	}
+
+	if v.proposalPreflightLead > 0 {
+		valStruct.proposalPreflight = newProposalPreflight(v.ctx, restHandler, v.proposalPreflightLead)
+	}
+
+	if v.localGasLimitsToken != "" {
+		valStruct.gasLimitNode = restHandler
+		valStruct.gasLimitToken = v.localGasLimitsToken
 	}
 
 	v.validator = valStruct
-	go run(v.ctx, v.validator)
+	go run(v.ctx, v.validator, v.duties)
 }
 
-// Stop the validator service.
+// auditRESTParity warns about the calls of the validator client which the beacon node cannot serve over the beacon API.
+func auditRESTParity(ctx context.Context, handler beaconApi.JsonRestHandler) {
+	missing, err := beaconApi.AuditRESTParity(ctx, handler)
+	if err != nil {
+		log.WithError(err).Debug("Could not audit the beacon API endpoints of the beacon node")
+		return
+	}
+	for call, endpoints := range missing {
+		log.WithFields(logrus.Fields{
+			"call":      call,
+			"endpoints": endpoints,
+		}).Warn("Beacon node does not serve the beacon API endpoints of a validator client call")
+	}
+}
+
+// Stop the validator service, once the duties due within the shutdown duty window are performed.
 func (v *ValidatorService) Stop() error {
+	if v.shutdownDutyWindow > 0 && v.validator != nil {
+		waitForImminentDuties(v.ctx, v.validator, v.duties, v.shutdownDutyWindow)
+	}
 	v.cancel()
 	log.Info("Stopping service")
+	if err := v.auditLog.Close(); err != nil {
+		log.WithError(err).Error("Could not close audit log")
+	}
+	// Keymanagers holding a device, such as a PKCS#11 token, release it.
+	if v.validator != nil {
+		if km, err := v.validator.Keymanager(); err == nil {
+			if closer, ok := km.(io.Closer); ok {
+				if err := closer.Close(); err != nil {
+					log.WithError(err).Error("Could not close keymanager")
+				}
+			}
+		}
+	}
 	if v.conn != nil {
 		return v.conn.GetGrpcClientConn().Close()
 	}
 	return nil
 }
 
+// AuditLog returns the log of the objects signed by the validator client, or nil when it is disabled.
+func (v *ValidatorService) AuditLog() *auditlog.Log {
+	return v.auditLog
+}
+
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
 	if v.conn == nil {
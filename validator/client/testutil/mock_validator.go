@@ -143,6 +143,12 @@ func (fv *FakeValidator) UpdateDuties(_ context.Context, slot primitives.Slot) e
 	return fv.UpdateDutiesRet
 }
 
+// StreamDuties for mocking.
+func (*FakeValidator) StreamDuties(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
 // UpdateProtections for mocking.
 func (fv *FakeValidator) UpdateProtections(_ context.Context, _ uint64) error {
 	fv.UpdateProtectionsCalled = true
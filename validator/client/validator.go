@@ -32,20 +32,27 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
 	"github.com/prysmaticlabs/prysm/v5/crypto/hash"
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	accountsiface "github.com/prysmaticlabs/prysm/v5/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/auditlog"
+	beaconApi "github.com/prysmaticlabs/prysm/v5/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/v5/validator/client/iface"
+	"github.com/prysmaticlabs/prysm/v5/validator/client/lightclient"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	dbCommon "github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/pkcs11"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashinggate"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
@@ -89,6 +96,7 @@ type validator struct {
 	db                                 db.Database
 	km                                 keymanager.IKeymanager
 	web3SignerConfig                   *remoteweb3signer.SetupConfig
+	pkcs11Config                       *pkcs11.SetupConfig
 	proposerSettings                   *proposer.Settings
 	signedValidatorRegistrations       map[[fieldparams.BLSPubkeyLength]byte]*ethpb.SignedValidatorRegistrationV1
 	validatorsRegBatchSize             int
@@ -104,6 +112,17 @@ type validator struct {
 	emitAccountMetrics                 bool
 	useWeb                             bool
 	distributed                        bool
+	dutyRole                           DutyRole
+	attestationHeadMaxWait             time.Duration
+	dutyBatcher                        *dutyBatcher
+	auditLog                           *auditlog.Log
+	slashingGate                       *slashinggate.Gate
+	externalBlockSource                iface.ValidatorClient
+	proposalPreflight                  *proposalPreflight
+	gasLimitNode                       beaconApi.JsonRestHandler
+	gasLimitToken                      string
+	gasLimits                          gasLimitsPush
+	lightClientVerifier                *lightclient.Verifier
 	domainDataLock                     sync.RWMutex
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
@@ -161,7 +180,11 @@ func (v *validator) WaitForKeymanagerInitialization(ctx context.Context) error {
 			if v.web3SignerConfig != nil {
 				v.web3SignerConfig.GenesisValidatorsRoot = genesisRoot
 			}
-			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{ListenForChanges: true, Web3SignerConfig: v.web3SignerConfig})
+			keyManager, err := v.wallet.InitializeKeymanager(ctx, accountsiface.InitKeymanagerConfig{
+				ListenForChanges: true,
+				Web3SignerConfig: v.web3SignerConfig,
+				PKCS11Config:     v.pkcs11Config,
+			})
 			if err != nil {
 				return errors.Wrap(err, "could not initialize key manager")
 			}
@@ -421,6 +444,10 @@ func (v *validator) CheckDoppelGanger(ctx context.Context) error {
 	if !features.Get().EnableDoppelGanger {
 		return nil
 	}
+	if !v.dutyRole.checksDoppelganger() {
+		log.WithField("role", v.dutyRole).Info("Skipping doppelganger check, the attester role checks the keys")
+		return nil
+	}
 	pubkeys, err := v.km.FetchValidatingPublicKeys(ctx)
 	if err != nil {
 		return err
@@ -532,24 +559,9 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 		return err
 	}
 
-	// Filter out the slashable public keys from the duties request.
-	filteredKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(validatingKeys))
-	v.blacklistedPubkeysLock.RLock()
-	for _, pubKey := range validatingKeys {
-		if ok := v.blacklistedPubkeys[pubKey]; !ok {
-			filteredKeys = append(filteredKeys, pubKey)
-		} else {
-			log.WithField(
-				"pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
-			).Warn("Not including slashable public key from slashing protection import " +
-				"in request to update validator duties")
-		}
-	}
-	v.blacklistedPubkeysLock.RUnlock()
-
 	req := &ethpb.DutiesRequest{
 		Epoch:      primitives.Epoch(slot / params.BeaconConfig().SlotsPerEpoch),
-		PublicKeys: bytesutil.FromBytes48Array(filteredKeys),
+		PublicKeys: bytesutil.FromBytes48Array(v.filterSlashableKeys(validatingKeys)),
 	}
 
 	// If duties is nil it means we have had no prior duties and just started up.
@@ -567,25 +579,41 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 	v.logDuties(slot, v.duties.CurrentEpochDuties, v.duties.NextEpochDuties)
 	v.dutiesLock.Unlock()
 
+	// Make sure to copy metadata into a new context, the subnet subscriptions outlive the deadline of the update.
+	md, exists := metadata.FromOutgoingContext(ctx)
+	ctx = context.Background()
+	if exists {
+		ctx = metadata.NewOutgoingContext(ctx, md)
+	}
+	return v.handleDuties(ctx, slot, resp)
+}
+
+// handleDuties processes the duties of the epoch of the slot once they are updated, whether polled or pushed by the
+// beacon node: it verifies the sync committee duties, schedules the preflight checks of the proposals and starts the
+// subnet subscriptions with the context, unless all validators have exited.
+func (v *validator) handleDuties(ctx context.Context, slot primitives.Slot, duties *ethpb.DutiesResponse) error {
+	epoch := slots.ToEpoch(slot)
+	if v.lightClientVerifier != nil {
+		v.lightClientVerifier.VerifySyncCommitteeDuties(epoch, duties.CurrentEpochDuties)
+		v.lightClientVerifier.VerifySyncCommitteeDuties(epoch+1, duties.NextEpochDuties)
+	}
+
+	v.proposalPreflight.schedule(v.genesisTime, slot, duties.CurrentEpochDuties, v.dutyProposalSettings)
+	v.proposalPreflight.schedule(v.genesisTime, slot, duties.NextEpochDuties, v.dutyProposalSettings)
+
 	allExitedCounter := 0
-	for i := range resp.CurrentEpochDuties {
-		if resp.CurrentEpochDuties[i].Status == ethpb.ValidatorStatus_EXITED {
+	for i := range duties.CurrentEpochDuties {
+		if duties.CurrentEpochDuties[i].Status == ethpb.ValidatorStatus_EXITED {
 			allExitedCounter++
 		}
 	}
-	if allExitedCounter != 0 && allExitedCounter == len(resp.CurrentEpochDuties) {
+	if allExitedCounter != 0 && allExitedCounter == len(duties.CurrentEpochDuties) {
 		return ErrValidatorsAllExited
 	}
 
 	// Non-blocking call for beacon node to start subscriptions for aggregators.
-	// Make sure to copy metadata into a new context
-	md, exists := metadata.FromOutgoingContext(ctx)
-	ctx = context.Background()
-	if exists {
-		ctx = metadata.NewOutgoingContext(ctx, md)
-	}
 	go func() {
-		if err := v.subscribeToSubnets(ctx, resp); err != nil {
+		if err := v.subscribeToSubnets(ctx, duties); err != nil {
 			log.WithError(err).Error("Failed to subscribe to subnets")
 		}
 	}()
@@ -593,12 +621,35 @@ func (v *validator) UpdateDuties(ctx context.Context, slot primitives.Slot) erro
 	return nil
 }
 
+// filterSlashableKeys filters out the slashable public keys from a duties request.
+func (v *validator) filterSlashableKeys(validatingKeys [][fieldparams.BLSPubkeyLength]byte) [][fieldparams.BLSPubkeyLength]byte {
+	filteredKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(validatingKeys))
+	v.blacklistedPubkeysLock.RLock()
+	defer v.blacklistedPubkeysLock.RUnlock()
+	for _, pubKey := range validatingKeys {
+		if ok := v.blacklistedPubkeys[pubKey]; !ok {
+			filteredKeys = append(filteredKeys, pubKey)
+		} else {
+			log.WithField(
+				"pubkey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+			).Warn("Not including slashable public key from slashing protection import " +
+				"in request to update validator duties")
+		}
+	}
+	return filteredKeys
+}
+
 // subscribeToSubnets iterates through each validator duty, signs each slot, and asks beacon node
 // to eagerly subscribe to subnets so that the aggregator has attestations to aggregate.
 func (v *validator) subscribeToSubnets(ctx context.Context, duties *ethpb.DutiesResponse) error {
 	ctx, span := trace.StartSpan(ctx, "validator.subscribeToSubnets")
 	defer span.End()
 
+	if !v.dutyRole.attests() {
+		// The subnets are subscribed by the validator client with the attester role.
+		return nil
+	}
+
 	subscribeSlots := make([]primitives.Slot, 0, len(duties.CurrentEpochDuties)+len(duties.NextEpochDuties))
 	subscribeCommitteeIndices := make([]primitives.CommitteeIndex, 0, len(duties.CurrentEpochDuties)+len(duties.NextEpochDuties))
 	subscribeIsAggregator := make([]bool, 0, len(duties.CurrentEpochDuties)+len(duties.NextEpochDuties))
@@ -606,9 +657,10 @@ func (v *validator) subscribeToSubnets(ctx context.Context, duties *ethpb.Duties
 	alreadySubscribed := make(map[[64]byte]bool)
 
 	if v.distributed {
-		// Get aggregated selection proofs to calculate isAggregator.
+		// Get aggregated selection proofs to calculate isAggregator. The middleware may not have collected the
+		// partial selection proofs of the whole cluster yet, the missing ones are then requested per duty.
 		if err := v.aggregatedSelectionProofs(ctx, duties); err != nil {
-			return errors.Wrap(err, "could not get aggregated selection proofs")
+			log.WithError(err).Warn("Could not get aggregated selection proofs of the epochs, requesting them per duty")
 		}
 	}
 
@@ -701,7 +753,7 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		if duty == nil {
 			continue
 		}
-		if len(duty.ProposerSlots) > 0 {
+		if len(duty.ProposerSlots) > 0 && v.dutyRole.proposes() {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
 					roles = append(roles, iface.RoleProposer)
@@ -710,7 +762,7 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 			}
 		}
 
-		if duty.AttesterSlot == slot {
+		if duty.AttesterSlot == slot && v.dutyRole.attests() {
 			roles = append(roles, iface.RoleAttester)
 
 			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, bytesutil.ToBytes48(duty.PublicKey), duty.ValidatorIndex)
@@ -727,12 +779,12 @@ func (v *validator) RolesAt(ctx context.Context, slot primitives.Slot) (map[[fie
 		// broadcasts signatures for `slot - 1` for inclusion in `slot`. At the last slot of the epoch,
 		// the validator checks whether it's in the sync committee of following epoch.
 		inSyncCommittee := false
-		if slots.IsEpochEnd(slot) {
+		if v.dutyRole.attests() && slots.IsEpochEnd(slot) {
 			if v.duties.NextEpochDuties[validator].IsSyncCommittee {
 				roles = append(roles, iface.RoleSyncCommittee)
 				inSyncCommittee = true
 			}
-		} else {
+		} else if v.dutyRole.attests() {
 			if duty.IsSyncCommittee {
 				roles = append(roles, iface.RoleSyncCommittee)
 				inSyncCommittee = true
@@ -788,6 +840,12 @@ func (v *validator) Keymanager() (keymanager.IKeymanager, error) {
 	return v.km, nil
 }
 
+// sign signs the request with the keymanager once the remote slashing protection allows it, recording the signed
+// object in the audit log.
+func (v *validator) sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	return v.auditLog.Signer(v.slashingGate.Signer(v.km.Sign))(ctx, req)
+}
+
 // isAggregator checks if a validator is an aggregator of a given slot and committee,
 // it uses a modulo calculated by validator count in committee and samples randomness around it.
 func (v *validator) isAggregator(
@@ -810,7 +868,7 @@ func (v *validator) isAggregator(
 		err     error
 	)
 	if v.distributed {
-		slotSig, err = v.attSelection(attSelectionKey{slot: slot, index: validatorIndex})
+		slotSig, err = v.aggregatedSelectionProof(ctx, pubKey, slot, validatorIndex)
 		if err != nil {
 			return false, err
 		}
@@ -1121,7 +1179,8 @@ func (v *validator) PushProposerSettings(ctx context.Context, km keymanager.IKey
 	}); err != nil {
 		return err
 	}
-	signedRegReqs := v.buildSignedRegReqs(ctx, filteredKeys, km.Sign, slot, forceFullPush)
+	v.pushGasLimits(ctx, filteredKeys, slot, forceFullPush)
+	signedRegReqs := v.buildSignedRegReqs(ctx, filteredKeys, v.auditLog.Signer(km.Sign), slot, forceFullPush)
 	if len(signedRegReqs) > 0 {
 		go func() {
 			if err := SubmitValidatorRegistrations(ctx, v.validatorClient, signedRegReqs, v.validatorsRegBatchSize); err != nil {
@@ -1265,23 +1324,7 @@ func (v *validator) buildPrepProposerReqs(activePubkeys [][fieldparams.BLSPubkey
 			continue
 		}
 
-		// Default case: Define fee recipient to burn address
-		feeRecipient := common.HexToAddress(params.BeaconConfig().EthBurnAddressHex)
-
-		// If fee recipient is defined in default configuration, use it
-		if v.ProposerSettings() != nil && v.ProposerSettings().DefaultConfig != nil && v.ProposerSettings().DefaultConfig.FeeRecipientConfig != nil {
-			feeRecipient = v.ProposerSettings().DefaultConfig.FeeRecipientConfig.FeeRecipient // Use cli config for fee recipient.
-		}
-
-		// If fee recipient is defined for this specific pubkey in proposer configuration, use it
-		if v.ProposerSettings() != nil && v.ProposerSettings().ProposeConfig != nil {
-			config, ok := v.ProposerSettings().ProposeConfig[k]
-
-			if ok && config != nil && config.FeeRecipientConfig != nil {
-				feeRecipient = config.FeeRecipientConfig.FeeRecipient // Use file config for fee recipient.
-			}
-		}
-
+		feeRecipient := v.feeRecipient(k)
 		prepareProposerReqs = append(prepareProposerReqs, &ethpb.PrepareBeaconProposerRequest_FeeRecipientContainer{
 			ValidatorIndex: s.index,
 			FeeRecipient:   feeRecipient[:],
@@ -1290,6 +1333,53 @@ func (v *validator) buildPrepProposerReqs(activePubkeys [][fieldparams.BLSPubkey
 	return prepareProposerReqs, nil
 }
 
+// dutyFeeRecipient returns the fee recipient of the validator of the duty.
+func (v *validator) dutyProposalSettings(duty *ethpb.DutiesResponse_Duty) ([]byte, bool) {
+	k := bytesutil.ToBytes48(duty.PublicKey)
+	feeRecipient := v.feeRecipient(k)
+	return feeRecipient[:], v.builderEnabled(k)
+}
+
+// feeRecipient returns the fee recipient the beacon node is prepared to pay in the proposals of the validator.
+func (v *validator) feeRecipient(k [fieldparams.BLSPubkeyLength]byte) common.Address {
+	// Default case: Define fee recipient to burn address
+	feeRecipient := common.HexToAddress(params.BeaconConfig().EthBurnAddressHex)
+
+	// If fee recipient is defined in default configuration, use it
+	if v.ProposerSettings() != nil && v.ProposerSettings().DefaultConfig != nil && v.ProposerSettings().DefaultConfig.FeeRecipientConfig != nil {
+		feeRecipient = v.ProposerSettings().DefaultConfig.FeeRecipientConfig.FeeRecipient // Use cli config for fee recipient.
+	}
+
+	// If fee recipient is defined for this specific pubkey in proposer configuration, use it
+	if v.ProposerSettings() != nil && v.ProposerSettings().ProposeConfig != nil {
+		config, ok := v.ProposerSettings().ProposeConfig[k]
+
+		if ok && config != nil && config.FeeRecipientConfig != nil {
+			feeRecipient = config.FeeRecipientConfig.FeeRecipient // Use file config for fee recipient.
+		}
+	}
+	return feeRecipient
+}
+
+// builderEnabled returns whether the proposer settings enable the builder for the validator.
+func (v *validator) builderEnabled(k [fieldparams.BLSPubkeyLength]byte) bool {
+	settings := v.ProposerSettings()
+	if settings == nil {
+		return false
+	}
+	enabled := false
+	if settings.DefaultConfig != nil && settings.DefaultConfig.BuilderConfig != nil {
+		enabled = settings.DefaultConfig.BuilderConfig.Enabled
+	}
+	if settings.ProposeConfig != nil {
+		config, ok := settings.ProposeConfig[k]
+		if ok && config != nil && config.BuilderConfig != nil {
+			enabled = config.BuilderConfig.Enabled
+		}
+	}
+	return enabled
+}
+
 func (v *validator) buildSignedRegReqs(
 	ctx context.Context,
 	activePubkeys [][fieldparams.BLSPubkeyLength]byte,
@@ -1461,6 +1551,36 @@ func (v *validator) newAttSelections() {
 	v.attSelections = make(map[attSelectionKey]iface.BeaconCommitteeSelection)
 }
 
+// aggregatedSelectionProof returns the selection proof of the validator at the slot, aggregated by the distributed
+// validator middleware. A selection proof missing from the ones requested for the epochs is requested on its own.
+func (v *validator) aggregatedSelectionProof(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	slot primitives.Slot,
+	validatorIndex primitives.ValidatorIndex,
+) ([]byte, error) {
+	key := attSelectionKey{slot: slot, index: validatorIndex}
+	if slotSig, err := v.attSelection(key); err == nil {
+		return slotSig, nil
+	}
+
+	slotSig, err := v.signSlotWithSelectionProof(ctx, pubKey, slot)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := v.validatorClient.AggregatedSelections(ctx, []iface.BeaconCommitteeSelection{{
+		SelectionProof: slotSig,
+		Slot:           slot,
+		ValidatorIndex: validatorIndex,
+	}})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get aggregated selection proof")
+	}
+	v.addAttSelections(resp)
+
+	return v.attSelection(key)
+}
+
 func (v *validator) attSelection(key attSelectionKey) ([]byte, error) {
 	v.attSelectionLock.Lock()
 	defer v.attSelectionLock.Unlock()
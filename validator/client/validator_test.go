@@ -664,6 +664,83 @@ func TestUpdateDuties_Distributed(t *testing.T) {
 	require.Equal(t, 2, len(v.attSelections))
 }
 
+func TestUpdateDuties_Distributed_SelectionsRequestedPerDuty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := validatormock.NewMockValidatorClient(ctrl)
+
+	// Start of third epoch.
+	slot := 2 * params.BeaconConfig().SlotsPerEpoch
+	keys := randKeypair(t)
+	resp := &ethpb.DutiesResponse{
+		CurrentEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:   slot,
+				ValidatorIndex: 200,
+				CommitteeIndex: 100,
+				PublicKey:      keys.pub[:],
+				Status:         ethpb.ValidatorStatus_ACTIVE,
+			},
+		},
+		NextEpochDuties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:   slot + params.BeaconConfig().SlotsPerEpoch,
+				ValidatorIndex: 200,
+				CommitteeIndex: 100,
+				PublicKey:      keys.pub[:],
+				Status:         ethpb.ValidatorStatus_ACTIVE,
+			},
+		},
+	}
+
+	v := validator{
+		km:              newMockKeymanager(t, keys),
+		validatorClient: client,
+		distributed:     true,
+	}
+
+	client.EXPECT().Duties(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(resp, nil)
+
+	client.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), // epoch
+	).Return(
+		&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)},
+		nil, /*err*/
+	).Times(4)
+
+	// The middleware has not collected the partial selection proofs of the cluster when the duties are updated.
+	client.EXPECT().AggregatedSelections(
+		gomock.Any(),
+		gomock.Any(),
+	).Return(nil, errors.New("selection proofs of the cluster are not ready"))
+	client.EXPECT().AggregatedSelections(
+		gomock.Any(),
+		gomock.Len(1),
+	).DoAndReturn(func(_ context.Context, selections []iface.BeaconCommitteeSelection) ([]iface.BeaconCommitteeSelection, error) {
+		return selections, nil
+	}).Times(2)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	client.EXPECT().SubscribeCommitteeSubnets(
+		gomock.Any(),
+		gomock.Any(),
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, _ *ethpb.CommitteeSubnetsSubscribeRequest, _ []*ethpb.DutiesResponse_Duty) (*emptypb.Empty, error) {
+		wg.Done()
+		return nil, nil
+	})
+
+	require.NoError(t, v.UpdateDuties(context.Background(), slot), "Could not update assignments")
+	util.WaitTimeout(&wg, 2*time.Second)
+	require.Equal(t, 2, len(v.attSelections))
+}
+
 func TestRolesAt_OK(t *testing.T) {
 	for _, isSlashingProtectionMinimal := range [...]bool{false, true} {
 		t.Run(fmt.Sprintf("SlashingProtectionMinimal:%v", isSlashingProtectionMinimal), func(t *testing.T) {
@@ -2710,6 +2787,26 @@ func TestValidator_buildSignedRegReqs_DefaultConfigEnabled(t *testing.T) {
 	})
 }
 
+func TestValidator_builderEnabled(t *testing.T) {
+	pubkey1 := pubkeyFromString(t, "0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111")
+	pubkey2 := pubkeyFromString(t, "0x222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222222")
+	pubkey3 := pubkeyFromString(t, "0x333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333333")
+
+	v := validator{}
+	assert.Equal(t, false, v.builderEnabled(pubkey1))
+
+	v.proposerSettings = &proposer.Settings{
+		ProposeConfig: map[[fieldparams.BLSPubkeyLength]byte]*proposer.Option{
+			pubkey1: {BuilderConfig: &proposer.BuilderConfig{Enabled: false}},
+			pubkey2: {FeeRecipientConfig: &proposer.FeeRecipientConfig{}},
+		},
+		DefaultConfig: &proposer.Option{BuilderConfig: &proposer.BuilderConfig{Enabled: true}},
+	}
+	assert.Equal(t, false, v.builderEnabled(pubkey1))
+	assert.Equal(t, true, v.builderEnabled(pubkey2))
+	assert.Equal(t, true, v.builderEnabled(pubkey3))
+}
+
 func TestValidator_buildSignedRegReqs_SignerOnError(t *testing.T) {
 	// Public keys
 	pubkey1 := pubkeyFromString(t, "0x111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111111")
@@ -293,6 +293,23 @@ func (s *Store) AttestationHistoryForPubKey(
 	}, nil
 }
 
+// AttestationHistoryForPubKeySince returns the attestation history for a public key with a target epoch from the
+// given epoch on.
+func (s *Store) AttestationHistoryForPubKeySince(
+	ctx context.Context,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	target primitives.Epoch,
+) ([]*common.AttestationRecord, error) {
+	records, err := s.AttestationHistoryForPubKey(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) > 0 && records[0].Target < target {
+		return []*common.AttestationRecord{}, nil
+	}
+	return records, nil
+}
+
 // maxSourceTargetEpoch gets the maximum source and target epoch from atts.
 func maxSourceTargetEpoch(atts []*ethpb.IndexedAttestation) (primitives.Epoch, primitives.Epoch, error) {
 	maxSourceEpoch := primitives.Epoch(0)
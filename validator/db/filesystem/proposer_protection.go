@@ -43,6 +43,20 @@ func (s *Store) ProposalHistoryForPubKey(_ context.Context, publicKey [fieldpara
 	}, nil
 }
 
+// ProposalHistoryForPubKeySince returns the proposal history for a given public key from the given slot on.
+func (s *Store) ProposalHistoryForPubKeySince(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot,
+) ([]*common.Proposal, error) {
+	proposals, err := s.ProposalHistoryForPubKey(ctx, publicKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(proposals) > 0 && proposals[0].Slot < slot {
+		return []*common.Proposal{}, nil
+	}
+	return proposals, nil
+}
+
 // ProposalHistoryForSlot is implemented only to satisfy the interface.
 func (*Store) ProposalHistoryForSlot(_ context.Context, _ [fieldparams.BLSPubkeyLength]byte, _ primitives.Slot) ([fieldparams.RootLength]byte, bool, bool, error) {
 	panic("not implemented")
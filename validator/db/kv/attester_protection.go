@@ -90,6 +90,8 @@ var (
 	doubleVoteMessage           = "double vote found, existing attestation at target epoch %d with conflicting signing root %#x"
 	surroundingVoteMessage      = "attestation with (source %d, target %d) surrounds another with (source %d, target %d)"
 	surroundedVoteMessage       = "attestation with (source %d, target %d) is surrounded by another with (source %d, target %d)"
+	spanSurroundingVoteMessage  = "attestation with (source %d, target %d) surrounds another with target %d"
+	spanSurroundedVoteMessage   = "attestation with (source %d, target %d) is surrounded by another with target %d"
 	failedAttLocalProtectionErr = "attempted to make slashable attestation, rejected by local slashing protection"
 )
 
@@ -134,6 +136,48 @@ func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [fieldpa
 	return records, err
 }
 
+// AttestationHistoryForPubKeySince retrieves the attestation records stored in the database for the given
+// validator public key with a target epoch from the given epoch on, ordered by target epoch.
+func (s *Store) AttestationHistoryForPubKeySince(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch,
+) ([]*common.AttestationRecord, error) {
+	records := make([]*common.AttestationRecord, 0)
+	_, span := trace.StartSpan(ctx, "Validator.AttestationHistoryForPubKeySince")
+	defer span.End()
+	err := s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(pubKeysBucket)
+		pkBucket := bucket.Bucket(pubKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
+		targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
+		if targetEpochsBucket == nil {
+			return nil
+		}
+
+		c := targetEpochsBucket.Cursor()
+		for targetBytes, sourceEpochsList := c.Seek(bytesutil.EpochToBytesBigEndian(target)); targetBytes != nil; targetBytes, sourceEpochsList = c.Next() {
+			targetEpoch := bytesutil.BytesToEpochBigEndian(targetBytes)
+			var signingRoot []byte
+			if sr := signingRootsBucket.Get(targetBytes); len(sr) != 0 {
+				signingRoot = make([]byte, fieldparams.RootLength)
+				copy(signingRoot, sr)
+			}
+			for i := 0; i < len(sourceEpochsList); i += 8 {
+				records = append(records, &common.AttestationRecord{
+					PubKey:      pubKey,
+					Source:      bytesutil.BytesToEpochBigEndian(sourceEpochsList[i : i+8]),
+					Target:      targetEpoch,
+					SigningRoot: signingRoot,
+				})
+			}
+		}
+		return nil
+	})
+	return records, err
+}
+
 // SlashableAttestationCheck checks if an attestation is slashable by comparing it with the attesting
 // history for the given public key in our complete slashing protection database defined by EIP-3076.
 // If it is not, it updates the database.
@@ -184,7 +228,17 @@ func (s *Store) SlashableAttestationCheck(
 		)
 	}
 	fmtKey := "0x" + hex.EncodeToString(pubKey[:])
-	slashingKind, err := s.CheckSlashableAttestation(ctx, pubKey, signingRoot, indexedAtt)
+	spans, err := s.attesterSpansForPubKey(pubKey)
+	if err != nil {
+		return errors.Wrap(err, "could not load attester spans")
+	}
+	slashingKind, err := s.checkSlashableAttestation(ctx, spans, pubKey, signingRoot, indexedAtt)
+	if err == nil {
+		// Record the attestation before it is saved, so that attestations signed
+		// concurrently for the same public key are checked against it.
+		spans.record(indexedAtt.GetData().Source.Epoch, indexedAtt.GetData().Target.Epoch)
+	}
+	spans.Unlock()
 	if err != nil {
 		if emitAccountMetrics {
 			validatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -214,6 +268,55 @@ func (s *Store) CheckSlashableAttestation(
 ) (SlashingKind, error) {
 	ctx, span := trace.StartSpan(ctx, "Validator.CheckSlashableAttestation")
 	defer span.End()
+	spans, err := s.attesterSpansForPubKey(pubKey)
+	if err != nil {
+		tracing.AnnotateError(span, err)
+		return NotSlashable, errors.Wrap(err, "could not load attester spans")
+	}
+	defer spans.Unlock()
+	slashKind, err := s.checkSlashableAttestation(ctx, spans, pubKey, signingRoot, att)
+	tracing.AnnotateError(span, err)
+	return slashKind, err
+}
+
+// checkSlashableAttestation checks an attestation for surround votes against the min and max
+// spans of the public key, which must be locked, and for double votes in the database. Surround
+// votes are only searched in the database if the source epoch is older than the spans.
+func (s *Store) checkSlashableAttestation(
+	ctx context.Context,
+	spans *attesterSpans,
+	pubKey [fieldparams.BLSPubkeyLength]byte,
+	signingRoot []byte,
+	att ethpb.IndexedAtt,
+) (SlashingKind, error) {
+	source, target := att.GetData().Source.Epoch, att.GetData().Target.Epoch
+	surroundKind := NotSlashable
+	var existingTarget primitives.Epoch
+	checkSurroundInDB := true
+	if spans.covers(source) {
+		surroundKind, existingTarget = spans.surround(source, target)
+		// Surround votes are rare, so the database is only searched to report the
+		// attestation involved, which may not be saved yet if it was just signed.
+		checkSurroundInDB = surroundKind != NotSlashable
+	}
+	slashKind, err := s.checkSlashableAttestationInDB(ctx, pubKey, signingRoot, att, checkSurroundInDB)
+	if err != nil {
+		return slashKind, err
+	}
+	switch surroundKind {
+	case SurroundingVote:
+		return SurroundingVote, fmt.Errorf(spanSurroundingVoteMessage, source, target, existingTarget)
+	case SurroundedVote:
+		return SurroundedVote, fmt.Errorf(spanSurroundedVoteMessage, source, target, existingTarget)
+	}
+	return NotSlashable, nil
+}
+
+// checkSlashableAttestationInDB checks an attestation for double votes, and for surround
+// votes if checkSurround is set, against the attesting history in the database.
+func (s *Store) checkSlashableAttestationInDB(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, signingRoot []byte, att ethpb.IndexedAtt, checkSurround bool,
+) (SlashingKind, error) {
 	var slashKind SlashingKind
 	err := s.view(func(tx *bolt.Tx) error {
 		if ctx.Err() != nil {
@@ -239,6 +342,10 @@ func (s *Store) CheckSlashableAttestation(
 			}
 		}
 
+		if !checkSurround {
+			return nil
+		}
+
 		sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
 		targetEpochsBucket := pkBucket.Bucket(attestationTargetEpochsBucket)
 		if sourceEpochsBucket == nil {
@@ -262,8 +369,6 @@ func (s *Store) CheckSlashableAttestation(
 		}
 		return nil
 	})
-
-	tracing.AnnotateError(span, err)
 	return slashKind, err
 }
 
@@ -493,7 +598,7 @@ func (s *Store) flushAttestationRecords(ctx context.Context, records []*common.A
 func (s *Store) saveAttestationRecords(ctx context.Context, atts []*common.AttestationRecord) error {
 	_, span := trace.StartSpan(ctx, "Validator.saveAttestationRecords")
 	defer span.End()
-	return s.update(func(tx *bolt.Tx) error {
+	err := s.update(func(tx *bolt.Tx) error {
 		// Initialize buckets for the lowest target and source epochs.
 		lowestSourceBucket, err := tx.CreateBucketIfNotExists(lowestSignedSourceBucket)
 		if err != nil {
@@ -583,6 +688,13 @@ func (s *Store) saveAttestationRecords(ctx context.Context, atts []*common.Attes
 		}
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+	for _, att := range atts {
+		s.recordAttesterSpans(att.PubKey, att.Source, att.Target)
+	}
+	return nil
 }
 
 // AttestedPublicKeys retrieves all public keys that have attested.
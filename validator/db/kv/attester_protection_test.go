@@ -140,6 +140,29 @@ func TestStore_CheckSlashableAttestation_SurroundVote_MultipleTargetsPerSource(t
 	assert.Equal(t, SurroundingVote, slashable)
 }
 
+func TestStore_AttestationHistoryForPubKeySince(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 1)
+	validatorDB := setupDB(t, pubKeys)
+
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{2}, createAttestation(2, 3)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{3}, createAttestation(3, 300)))
+
+	records, err := validatorDB.AttestationHistoryForPubKeySince(ctx, pubKeys[0], 3)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(records))
+	assert.Equal(t, primitives.Epoch(2), records[0].Source)
+	assert.Equal(t, primitives.Epoch(3), records[0].Target)
+	assert.DeepEqual(t, []byte{2}, records[0].SigningRoot[:1])
+	assert.Equal(t, primitives.Epoch(3), records[1].Source)
+	assert.Equal(t, primitives.Epoch(300), records[1].Target)
+
+	records, err = validatorDB.AttestationHistoryForPubKeySince(ctx, pubKeys[0], 301)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(records))
+}
+
 func TestStore_CheckSlashableAttestation_SurroundVote_54kEpochs(t *testing.T) {
 	ctx := context.Background()
 	numValidators := 1
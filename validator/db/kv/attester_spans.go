@@ -0,0 +1,196 @@
+package kv
+
+import (
+	"math"
+	"sync"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// Number of epochs, up to the highest signed target epoch, for which the min and max spans
+	// of a public key are kept in memory. Attestations with a source epoch before this window,
+	// which honest validators do not sign, are checked against the database instead.
+	attesterSpansEpochs = 256
+	// Min span of an epoch with no attestation of a later source epoch.
+	noMinSpan = math.MaxUint16
+)
+
+// attesterSpans holds the min and max spans of the attestations signed by a public key over a
+// window of epochs, so that surround votes are detected in constant time however long the
+// attesting history is. For every epoch e of the window:
+//
+//	minSpans[e] = min(target - e) over the attestations with source > e
+//	maxSpans[e] = max(target - e) over the attestations with source < e < target
+//
+// so an attestation (source, target) surrounds a signed one if minSpans[source] < target - source,
+// and is surrounded by a signed one if maxSpans[source] > target - source.
+type attesterSpans struct {
+	sync.Mutex
+	loaded   bool
+	start    primitives.Epoch
+	minSpans []uint16
+	maxSpans []uint16
+}
+
+// covers returns whether the spans can tell if an attestation of the source epoch is a surround vote.
+func (a *attesterSpans) covers(source primitives.Epoch) bool {
+	return len(a.minSpans) == 0 || source >= a.start
+}
+
+// surround returns whether an attestation is a surround vote of the recorded attestations, together
+// with the target epoch of the attestation it surrounds or is surrounded by. The source epoch
+// must be covered by the spans.
+func (a *attesterSpans) surround(source, target primitives.Epoch) (SlashingKind, primitives.Epoch) {
+	if len(a.minSpans) == 0 || source >= a.start+primitives.Epoch(len(a.minSpans)) || target <= source {
+		return NotSlashable, 0
+	}
+	i := source - a.start
+	span := target - source
+	if minSpan := a.minSpans[i]; minSpan != noMinSpan && primitives.Epoch(minSpan) < span {
+		return SurroundingVote, source + primitives.Epoch(minSpan)
+	}
+	if maxSpan := a.maxSpans[i]; primitives.Epoch(maxSpan) > span {
+		return SurroundedVote, source + primitives.Epoch(maxSpan)
+	}
+	return NotSlashable, 0
+}
+
+// record updates the spans with a signed attestation, moving the window forward if the target
+// epoch is past it.
+func (a *attesterSpans) record(source, target primitives.Epoch) {
+	if target <= source {
+		return
+	}
+	a.slide(target)
+	end := a.start + primitives.Epoch(len(a.minSpans))
+
+	// The min spans only decrease towards earlier epochs, so we can stop at the first epoch
+	// whose min span is already lower.
+	for e := min(source, end); e > a.start; {
+		e--
+		span := target - e
+		if a.minSpans[e-a.start] != noMinSpan && primitives.Epoch(a.minSpans[e-a.start]) <= span {
+			break
+		}
+		a.minSpans[e-a.start] = uint16(span)
+	}
+	// Likewise, the max spans only increase towards later epochs.
+	for e := max(source+1, a.start); e < target; e++ {
+		span := target - e
+		if primitives.Epoch(a.maxSpans[e-a.start]) >= span {
+			break
+		}
+		a.maxSpans[e-a.start] = uint16(span)
+	}
+}
+
+// slide moves the window so that it ends with the target epoch if it is past the window. As no
+// recorded target epoch is past the window, the epochs it moves over have no spans.
+func (a *attesterSpans) slide(target primitives.Epoch) {
+	if len(a.minSpans) == 0 {
+		a.minSpans = make([]uint16, attesterSpansEpochs)
+		a.maxSpans = make([]uint16, attesterSpansEpochs)
+		for i := range a.minSpans {
+			a.minSpans[i] = noMinSpan
+		}
+		if target >= attesterSpansEpochs {
+			a.start = target + 1 - attesterSpansEpochs
+		}
+		return
+	}
+	if target < a.start+attesterSpansEpochs {
+		return
+	}
+	shift := target + 1 - attesterSpansEpochs - a.start
+	a.start += shift
+	kept := 0
+	if shift < attesterSpansEpochs {
+		kept = copy(a.minSpans, a.minSpans[shift:])
+		copy(a.maxSpans, a.maxSpans[shift:])
+	}
+	for i := kept; i < attesterSpansEpochs; i++ {
+		a.minSpans[i] = noMinSpan
+		a.maxSpans[i] = 0
+	}
+}
+
+// attesterSpansForPubKey returns the spans of the public key, loading them from the database
+// the first time. The spans are returned locked and must be unlocked by the caller.
+func (s *Store) attesterSpansForPubKey(pubKey [fieldparams.BLSPubkeyLength]byte) (*attesterSpans, error) {
+	s.attesterSpansLock.Lock()
+	spans, ok := s.attesterSpans[pubKey]
+	if !ok {
+		spans = &attesterSpans{}
+		s.attesterSpans[pubKey] = spans
+	}
+	s.attesterSpansLock.Unlock()
+
+	spans.Lock()
+	if spans.loaded {
+		return spans, nil
+	}
+	err := s.view(func(tx *bolt.Tx) error {
+		pkBucket := tx.Bucket(pubKeysBucket).Bucket(pubKey[:])
+		if pkBucket == nil {
+			return nil
+		}
+		sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+		if sourceEpochsBucket == nil {
+			return nil
+		}
+		// The highest target epoch is needed first, so that the window does not move over
+		// epochs with spans while loading.
+		var highestTarget primitives.Epoch
+		if err := forEachSourceTarget(sourceEpochsBucket, func(_, target primitives.Epoch) {
+			highestTarget = max(highestTarget, target)
+		}); err != nil {
+			return err
+		}
+		spans.slide(highestTarget)
+		return forEachSourceTarget(sourceEpochsBucket, spans.record)
+	})
+	if err != nil {
+		spans.Unlock()
+		return nil, err
+	}
+	spans.loaded = true
+	return spans, nil
+}
+
+// recordAttesterSpans updates the loaded spans with saved attestation records. Spans not loaded
+// yet will read the records from the database.
+func (s *Store) recordAttesterSpans(pubKey [fieldparams.BLSPubkeyLength]byte, source, target primitives.Epoch) {
+	s.attesterSpansLock.Lock()
+	spans, ok := s.attesterSpans[pubKey]
+	s.attesterSpansLock.Unlock()
+	if !ok {
+		return
+	}
+	spans.Lock()
+	defer spans.Unlock()
+	if spans.loaded {
+		spans.record(source, target)
+	}
+}
+
+// resetAttesterSpans drops the spans of all public keys, for them to be loaded again from the
+// database after the attesting history changed.
+func (s *Store) resetAttesterSpans() {
+	s.attesterSpansLock.Lock()
+	defer s.attesterSpansLock.Unlock()
+	s.attesterSpans = make(map[[fieldparams.BLSPubkeyLength]byte]*attesterSpans)
+}
+
+func forEachSourceTarget(sourceEpochsBucket *bolt.Bucket, f func(source, target primitives.Epoch)) error {
+	return sourceEpochsBucket.ForEach(func(sourceBytes, targetEpochsList []byte) error {
+		source := bytesutil.BytesToEpochBigEndian(sourceBytes)
+		for i := 0; i+8 <= len(targetEpochsList); i += 8 {
+			f(source, bytesutil.BytesToEpochBigEndian(targetEpochsList[i:i+8]))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,89 @@
+package kv
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func TestAttesterSpans_MatchesHistory(t *testing.T) {
+	type att struct{ source, target primitives.Epoch }
+	r := rand.New(rand.NewSource(1))
+	spans := &attesterSpans{}
+	var history []att
+	surroundKind := func(source, target primitives.Epoch) SlashingKind {
+		for _, a := range history {
+			if source < a.source && a.target < target {
+				return SurroundingVote
+			}
+			if a.source < source && target < a.target {
+				return SurroundedVote
+			}
+		}
+		return NotSlashable
+	}
+
+	// Attest with the target epoch moving forward past the window several times, with
+	// random source epochs, checking every attestation within the window against the history.
+	for target := primitives.Epoch(1); target < 4*attesterSpansEpochs; target += primitives.Epoch(r.Intn(3) + 1) {
+		source := target - 1 - primitives.Epoch(r.Intn(int(min(target, 20))))
+		if spans.covers(source) {
+			kind, _ := spans.surround(source, target)
+			// The spans find one of the surround votes, the history may find the other first.
+			want := surroundKind(source, target)
+			assert.Equal(t, want != NotSlashable, kind != NotSlashable, "attestation (%d, %d)", source, target)
+		}
+		spans.record(source, target)
+		history = append(history, att{source, target})
+	}
+	for source := spans.start; source < spans.start+attesterSpansEpochs; source++ {
+		for target := source + 1; target < source+30; target++ {
+			kind, _ := spans.surround(source, target)
+			assert.Equal(t, surroundKind(source, target) != NotSlashable, kind != NotSlashable, "attestation (%d, %d)", source, target)
+		}
+	}
+	assert.Equal(t, false, spans.covers(spans.start-1))
+}
+
+func TestStore_CheckSlashableAttestation_SpansLoadedFromDB(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 1)
+	validatorDB := setupDB(t, pubKeys)
+
+	for _, a := range [][2]primitives.Epoch{{1000, 1001}, {1001, 1010}, {1010, 1011}} {
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{byte(a[1])}, createAttestation(a[0], a[1])))
+	}
+	// Drop the spans recorded when saving, so that they are loaded from the database.
+	validatorDB.resetAttesterSpans()
+
+	kind, err := validatorDB.CheckSlashableAttestation(ctx, pubKeys[0], []byte{1}, createAttestation(1002, 1009))
+	require.ErrorContains(t, "is surrounded by another with (source 1001, target 1010)", err)
+	assert.Equal(t, SurroundedVote, kind)
+	kind, err = validatorDB.CheckSlashableAttestation(ctx, pubKeys[0], []byte{1}, createAttestation(999, 1002))
+	require.ErrorContains(t, "surrounds another with (source 1000, target 1001)", err)
+	assert.Equal(t, SurroundingVote, kind)
+	kind, err = validatorDB.CheckSlashableAttestation(ctx, pubKeys[0], []byte{1}, createAttestation(1011, 1012))
+	require.NoError(t, err)
+	assert.Equal(t, NotSlashable, kind)
+}
+
+func TestStore_SlashableAttestationCheck_RecordsBeforeSaving(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 1)
+	validatorDB := setupDB(t, pubKeys)
+
+	spans, err := validatorDB.attesterSpansForPubKey(pubKeys[0])
+	require.NoError(t, err)
+	spans.record(10, 20)
+	spans.Unlock()
+
+	// The attestation recorded in the spans is not in the database yet.
+	kind, err := validatorDB.CheckSlashableAttestation(ctx, pubKeys[0], []byte{1}, createAttestation(11, 19))
+	require.ErrorContains(t, "is surrounded by another with target 20", err)
+	assert.Equal(t, SurroundedVote, kind)
+}
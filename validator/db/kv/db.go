@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -69,6 +70,8 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecordSaveRequest
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	attesterSpans                      map[[fieldparams.BLSPubkeyLength]byte]*attesterSpans
+	attesterSpansLock                  sync.Mutex
 }
 
 // Close closes the underlying boltdb database.
@@ -143,6 +146,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		batchedAttestations:          NewQueuedAttestationRecords(),
 		batchedAttestationsChan:      make(chan *AttestationRecordSaveRequest, attestationBatchCapacity),
 		batchAttestationsFlushedFeed: new(event.Feed),
+		attesterSpans:                make(map[[fieldparams.BLSPubkeyLength]byte]*attesterSpans),
 	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
@@ -101,6 +101,34 @@ func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldpa
 	return proposals, err
 }
 
+// ProposalHistoryForPubKeySince returns the proposal history for a given public key from the given slot on.
+func (s *Store) ProposalHistoryForPubKeySince(
+	ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot,
+) ([]*common.Proposal, error) {
+	_, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForPubKeySince")
+	defer span.End()
+
+	proposals := make([]*common.Proposal, 0)
+	err := s.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(historicProposalsBucket)
+		valBucket := bucket.Bucket(publicKey[:])
+		if valBucket == nil {
+			return nil
+		}
+		c := valBucket.Cursor()
+		for slotKey, signingRootBytes := c.Seek(bytesutil.SlotToBytesBigEndian(slot)); slotKey != nil; slotKey, signingRootBytes = c.Next() {
+			sr := make([]byte, fieldparams.RootLength)
+			copy(sr, signingRootBytes)
+			proposals = append(proposals, &common.Proposal{
+				Slot:        bytesutil.BytesToSlotBigEndian(slotKey),
+				SigningRoot: sr,
+			})
+		}
+		return nil
+	})
+	return proposals, err
+}
+
 // SaveProposalHistoryForSlot saves the proposal history for the requested validator public key.
 // We also check if the incoming proposal slot is lower than the lowest signed proposal slot
 // for the validator and override its value on disk.
@@ -102,6 +102,29 @@ func TestSaveProposalHistoryForPubKey_OK(t *testing.T) {
 	require.DeepEqual(t, want[0], proposalHistory[0])
 }
 
+func TestProposalHistoryForPubKeySince(t *testing.T) {
+	ctx := context.Background()
+	pubkey := [fieldparams.BLSPubkeyLength]byte{3}
+	db := setupDB(t, [][fieldparams.BLSPubkeyLength]byte{pubkey})
+
+	for _, slot := range []primitives.Slot{1, 5, 300} {
+		require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubkey, slot, []byte{byte(slot)}))
+	}
+	proposalHistory, err := db.ProposalHistoryForPubKeySince(ctx, pubkey, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(proposalHistory))
+	assert.Equal(t, primitives.Slot(5), proposalHistory[0].Slot)
+	assert.Equal(t, primitives.Slot(300), proposalHistory[1].Slot)
+
+	proposalHistory, err = db.ProposalHistoryForPubKeySince(ctx, pubkey, 301)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposalHistory))
+
+	proposalHistory, err = db.ProposalHistoryForPubKeySince(ctx, [fieldparams.BLSPubkeyLength]byte{4}, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposalHistory))
+}
+
 func TestSaveProposalHistoryForSlot_Overwrites(t *testing.T) {
 	pubkey := [fieldparams.BLSPubkeyLength]byte{0}
 	tests := []struct {
@@ -49,6 +49,7 @@ func (s *Store) PruneAttestations(ctx context.Context) error {
 			return err
 		}
 	}
+	s.resetAttesterSpans()
 	return nil
 }
 
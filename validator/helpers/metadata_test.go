@@ -61,6 +61,9 @@ func (db *ValidatorDBMock) LowestSignedProposal(ctx context.Context, publicKey [
 func (db *ValidatorDBMock) ProposalHistoryForPubKey(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte) ([]*common.Proposal, error) {
 	panic("not implemented")
 }
+func (db *ValidatorDBMock) ProposalHistoryForPubKeySince(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([]*common.Proposal, error) {
+	panic("not implemented")
+}
 func (db *ValidatorDBMock) ProposalHistoryForSlot(ctx context.Context, publicKey [fieldparams.BLSPubkeyLength]byte, slot primitives.Slot) ([32]byte, bool, bool, error) {
 	panic("not implemented")
 }
@@ -131,6 +134,12 @@ func (db *ValidatorDBMock) AttestationHistoryForPubKey(
 	panic("not implemented")
 }
 
+func (db *ValidatorDBMock) AttestationHistoryForPubKeySince(
+	ctx context.Context, pubKey [fieldparams.BLSPubkeyLength]byte, target primitives.Epoch,
+) ([]*common.AttestationRecord, error) {
+	panic("not implemented")
+}
+
 // Graffiti ordered index related methods
 func (db *ValidatorDBMock) SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error {
 	panic("not implemented")
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/k0kubun/go-ansi"
 	"github.com/pkg/errors"
@@ -15,12 +17,32 @@ import (
 	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
 )
 
+// maxImportWorkers bounds the number of keystores decrypted at once, as each scrypt keystore takes 256MB of memory
+// while it is decrypted.
+const maxImportWorkers = 8
+
+// importCheckpointSize is the number of imported keys after which the accounts store is saved, so an interrupted
+// import does not lose them.
+var importCheckpointSize = 500
+
+// decryptedKeystore is the result of decrypting the keystore at an index of an import.
+type decryptedKeystore struct {
+	index   int
+	privKey []byte
+	pubKey  []byte
+	err     error
+}
+
 // ImportKeystores into the local keymanager from an external source.
 // 1) Copy the in memory keystore
-// 2) Update copied keystore with new keys
-// 3) Save the copy to disk
-// 4) Reinitialize account store and updating the keymanager
-// 5) Return Statuses
+// 2) Decrypt the keystores in parallel, skipping those of keys already imported
+// 3) Update copied keystore with new keys
+// 4) Save the copy to disk every importCheckpointSize keys and at the end
+// 5) Reinitialize account store and updating the keymanager
+// 6) Return Statuses
+//
+// Keystores with the public key of an imported key are not decrypted, so importing the same keystores again after
+// an interruption resumes from the keys saved at the last checkpoint.
 func (km *Keymanager) ImportKeystores(
 	ctx context.Context,
 	keystores []*keymanager.Keystore,
@@ -32,11 +54,8 @@ func (km *Keymanager) ImportKeystores(
 	if len(passwords) != len(keystores) {
 		return nil, ErrMismatchedNumPasswords
 	}
-	decryptor := keystorev4.New()
 	bar := initializeProgressBar(len(keystores), "Importing accounts...")
-	keys := map[string]string{}
 	statuses := make([]*keymanager.KeyStatus, len(keystores))
-	var err error
 	// 1) Copy the in memory keystore
 	storeCopy := km.accountsStore.Copy()
 	importedKeys := make([][]byte, 0)
@@ -44,60 +63,139 @@ func (km *Keymanager) ImportKeystores(
 	for i := 0; i < len(storeCopy.PrivateKeys); i++ {
 		existingPubKeys[string(storeCopy.PublicKeys[i])] = true
 	}
-	for i := 0; i < len(keystores); i++ {
-		var privKeyBytes []byte
-		var pubKeyBytes []byte
-		privKeyBytes, pubKeyBytes, _, err = km.attemptDecryptKeystore(decryptor, keystores[i], passwords[i])
-		if err != nil {
+	pending := make([]int, 0, len(keystores))
+	for i, keystore := range keystores {
+		pubKeyBytes, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err == nil && existingPubKeys[string(pubKeyBytes)] {
+			log.Warnf("Duplicate key in import will be ignored: %#x", pubKeyBytes)
 			statuses[i] = &keymanager.KeyStatus{
-				Status:  keymanager.StatusError,
-				Message: err.Error(),
+				Status: keymanager.StatusDuplicate,
+			}
+			if err := bar.Add(1); err != nil {
+				log.Error(err)
 			}
 			continue
 		}
+		pending = append(pending, i)
+	}
+
+	// 2) Decrypt the keystores in parallel
+	decryptCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	results := km.decryptKeystores(decryptCtx, keystores, passwords, pending)
+	unsaved := 0
+	var saveErr error
+	for res := range results {
 		if err := bar.Add(1); err != nil {
 			log.Error(err)
 		}
+		if saveErr != nil {
+			continue
+		}
+		if res.err != nil {
+			statuses[res.index] = &keymanager.KeyStatus{
+				Status:  keymanager.StatusError,
+				Message: res.err.Error(),
+			}
+			continue
+		}
 		// if key exists prior to being added then output log that duplicate key was found
-		_, isDuplicateInArray := keys[string(pubKeyBytes)]
-		_, isDuplicateInExisting := existingPubKeys[string(pubKeyBytes)]
-		if isDuplicateInArray || isDuplicateInExisting {
-			log.Warnf("Duplicate key in import will be ignored: %#x", pubKeyBytes)
-			statuses[i] = &keymanager.KeyStatus{
+		if existingPubKeys[string(res.pubKey)] {
+			log.Warnf("Duplicate key in import will be ignored: %#x", res.pubKey)
+			statuses[res.index] = &keymanager.KeyStatus{
 				Status: keymanager.StatusDuplicate,
 			}
 			continue
 		}
-
-		keys[string(pubKeyBytes)] = string(privKeyBytes)
-		importedKeys = append(importedKeys, pubKeyBytes)
-		statuses[i] = &keymanager.KeyStatus{
+		// 3) Update copied keystore with new keys
+		existingPubKeys[string(res.pubKey)] = true
+		storeCopy.PublicKeys = append(storeCopy.PublicKeys, res.pubKey)
+		storeCopy.PrivateKeys = append(storeCopy.PrivateKeys, res.privKey)
+		importedKeys = append(importedKeys, res.pubKey)
+		statuses[res.index] = &keymanager.KeyStatus{
 			Status: keymanager.StatusImported,
 		}
+		unsaved++
+		// 4) & 5) save a checkpoint to disk and re-initializes keystore
+		if unsaved == importCheckpointSize {
+			if saveErr = km.SaveStoreAndReInitialize(ctx, storeCopy); saveErr != nil {
+				cancel()
+				continue
+			}
+			storeCopy = km.accountsStore.Copy()
+			unsaved = 0
+		}
+	}
+	if saveErr != nil {
+		return nil, saveErr
+	}
+	for i, status := range statuses {
+		if status == nil {
+			statuses[i] = &keymanager.KeyStatus{
+				Status:  keymanager.StatusError,
+				Message: fmt.Sprintf("import interrupted: %v", ctx.Err()),
+			}
+		}
 	}
 	if len(importedKeys) == 0 {
 		log.Warn("no keys were imported")
 		return statuses, nil
 	}
-	// 2) Update copied keystore with new keys,clear duplicates in existing set
-	// duplicates,errored ones are already skipped
-	for pubKey, privKey := range keys {
-		storeCopy.PublicKeys = append(storeCopy.PublicKeys, []byte(pubKey))
-		storeCopy.PrivateKeys = append(storeCopy.PrivateKeys, []byte(privKey))
-	}
-	// 3) & 4) save to disk and re-initializes keystore
-	if err := km.SaveStoreAndReInitialize(ctx, storeCopy); err != nil {
-		return nil, err
+	// 4) & 5) save to disk and re-initializes keystore
+	if unsaved > 0 {
+		if err := km.SaveStoreAndReInitialize(ctx, storeCopy); err != nil {
+			return nil, err
+		}
 	}
 
 	log.WithFields(logrus.Fields{
 		"pubkeys": CreatePrintoutOfKeys(importedKeys),
 	}).Info("Successfully imported validator key(s)")
 
-	// 5) Return Statuses
+	// 6) Return Statuses
 	return statuses, nil
 }
 
+// decryptKeystores decrypts the keystores at the indices in a pool of workers, and returns their results in the
+// order they are decrypted. The channel is closed once the keystores are decrypted, or the context is done.
+func (km *Keymanager) decryptKeystores(
+	ctx context.Context,
+	keystores []*keymanager.Keystore,
+	passwords []string,
+	indices []int,
+) <-chan *decryptedKeystore {
+	jobs := make(chan int)
+	results := make(chan *decryptedKeystore)
+	go func() {
+		defer close(jobs)
+		for _, i := range indices {
+			select {
+			case jobs <- i:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	var wg sync.WaitGroup
+	workers := min(runtime.GOMAXPROCS(0), maxImportWorkers, len(indices))
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			decryptor := keystorev4.New()
+			for i := range jobs {
+				privKeyBytes, pubKeyBytes, _, err := km.attemptDecryptKeystore(decryptor, keystores[i], passwords[i])
+				results <- &decryptedKeystore{index: i, privKey: privKeyBytes, pubKey: pubKeyBytes, err: err}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+	return results
+}
+
 // ImportKeypairs directly into the keymanager.
 func (km *Keymanager) ImportKeypairs(ctx context.Context, privKeys, pubKeys [][]byte) error {
 	if len(privKeys) != len(pubKeys) {
@@ -261,3 +261,69 @@ func TestLocalKeymanager_ImportKeystores(t *testing.T) {
 		require.DeepEqual(t, dr.accountsStore, copyStore)
 	})
 }
+
+func TestLocalKeymanager_ImportKeystores_Resumes(t *testing.T) {
+	ctx := context.Background()
+	wallet := &mock.Wallet{
+		Files:          make(map[string]map[string][]byte),
+		WalletPassword: password,
+	}
+	dr := &Keymanager{
+		wallet:        wallet,
+		accountsStore: &accountStore{},
+	}
+
+	t.Run("keys saved at checkpoints", func(t *testing.T) {
+		defer func(size int) { importCheckpointSize = size }(importCheckpointSize)
+		importCheckpointSize = 2
+		numKeystores := 5
+		keystores := make([]*keymanager.Keystore, numKeystores)
+		passwords := make([]string, numKeystores)
+		for i := 0; i < numKeystores; i++ {
+			keystores[i] = createRandomKeystore(t, password)
+			passwords[i] = password
+		}
+		statuses, err := dr.ImportKeystores(ctx, keystores, passwords)
+		require.NoError(t, err)
+		for _, status := range statuses {
+			require.Equal(t, keymanager.StatusImported, status.Status)
+		}
+		require.Equal(t, numKeystores, len(dr.accountsStore.PublicKeys))
+		require.Equal(t, numKeystores, len(dr.accountsStore.PrivateKeys))
+	})
+	t.Run("imported keys are not decrypted again", func(t *testing.T) {
+		imported := createRandomKeystore(t, password)
+		_, err := dr.ImportKeystores(ctx, []*keymanager.Keystore{imported}, []string{password})
+		require.NoError(t, err)
+
+		// The wrong password of the imported keystore is not noticed, since it is not decrypted again.
+		statuses, err := dr.ImportKeystores(
+			ctx,
+			[]*keymanager.Keystore{imported, createRandomKeystore(t, password)},
+			[]string{"foobar", password},
+		)
+		require.NoError(t, err)
+		require.Equal(t, keymanager.StatusDuplicate, statuses[0].Status)
+		require.Equal(t, keymanager.StatusImported, statuses[1].Status)
+	})
+	t.Run("interrupted import", func(t *testing.T) {
+		cancelledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		numKeystores := 20
+		keystores := make([]*keymanager.Keystore, numKeystores)
+		passwords := make([]string, numKeystores)
+		for i := 0; i < numKeystores; i++ {
+			keystores[i] = createRandomKeystore(t, password)
+			passwords[i] = password
+		}
+		statuses, err := dr.ImportKeystores(cancelledCtx, keystores, passwords)
+		require.NoError(t, err)
+		require.Equal(t, numKeystores, len(statuses))
+		for _, status := range statuses {
+			if status.Status != keymanager.StatusImported {
+				require.Equal(t, keymanager.StatusError, status.Status)
+				require.Equal(t, "import interrupted: context canceled", status.Message)
+			}
+		}
+	})
+}
@@ -0,0 +1,273 @@
+// Package pkcs11 defines a keymanager backed by a PKCS#11 token, such as a hardware security module, for operators
+// whose custody requirements keep the validator keys, or the means to decrypt them, on such a device without running
+// a remote signer. The token either holds the BLS private keys and signs with a vendor defined mechanism, or holds an
+// AES key decrypting the passwords of EIP-2335 keystores kept on disk.
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/async/event"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/validator/accounts/petnames"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+// EncryptedPasswordSuffix replaces the .json extension of a keystore for the file of its encrypted password.
+const EncryptedPasswordSuffix = ".password.enc"
+
+// SetupConfig includes configuration values for initializing a PKCS#11 keymanager.
+type SetupConfig struct {
+	// ModulePath is the path of the PKCS#11 library of the token vendor.
+	ModulePath string
+	// TokenLabel is the label of the token among the slots of the library.
+	TokenLabel string
+	// PIN logs in to the token as the user.
+	PIN string
+	// SignMechanism is the vendor defined mechanism with which the BLS private keys of the token sign. The private
+	// keys are identified by their CKA_ID, the 48 byte compressed public key. The token signs when it is set, and
+	// decrypts keystore passwords otherwise.
+	SignMechanism uint
+	// WrappingKeyLabel is the label of the AES key of the token decrypting the passwords of the keystores.
+	WrappingKeyLabel string
+	// KeystoresDir holds the EIP-2335 keystores, each with the file of its password encrypted by the wrapping key
+	// with CKM_AES_CBC_PAD and prefixed by the initialization vector, named after the keystore with the
+	// EncryptedPasswordSuffix extension.
+	KeystoresDir string
+}
+
+// Keymanager signs with the keys of a PKCS#11 token, or with keystores decrypted with it.
+type Keymanager struct {
+	// token is only kept when it signs, keystore passwords are decrypted once.
+	token               token
+	signMechanism       uint
+	pubKeys             [][fieldparams.BLSPubkeyLength]byte
+	publicKeys          map[[fieldparams.BLSPubkeyLength]byte]bls.PublicKey
+	secretKeys          map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	accountsChangedFeed *event.Feed
+}
+
+// NewKeymanager logs in to the token and loads its keys, or the keystores it decrypts the passwords of.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	_, span := trace.StartSpan(ctx, "pkcs11-keymanager.NewKeymanager")
+	defer span.End()
+	if cfg.ModulePath == "" || cfg.TokenLabel == "" {
+		return nil, errors.New("invalid setup config, the PKCS#11 library and token label must be set")
+	}
+	if cfg.SignMechanism == 0 && (cfg.WrappingKeyLabel == "" || cfg.KeystoresDir == "") {
+		return nil, errors.New("invalid setup config, either a signing mechanism, or a wrapping key label and " +
+			"keystores directory must be set")
+	}
+	t, err := openToken(cfg.ModulePath, cfg.TokenLabel, cfg.PIN)
+	if err != nil {
+		return nil, err
+	}
+	km, err := newKeymanager(cfg, t)
+	if err != nil {
+		if closeErr := t.close(); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close PKCS#11 token")
+		}
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"token":      cfg.TokenLabel,
+		"signing":    cfg.SignMechanism != 0,
+		"publicKeys": len(km.pubKeys),
+	}).Info("Loaded validator keys with PKCS#11 token")
+	return km, nil
+}
+
+func newKeymanager(cfg *SetupConfig, t token) (*Keymanager, error) {
+	km := &Keymanager{
+		signMechanism:       cfg.SignMechanism,
+		publicKeys:          make(map[[fieldparams.BLSPubkeyLength]byte]bls.PublicKey),
+		secretKeys:          make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
+		accountsChangedFeed: new(event.Feed),
+	}
+	if cfg.SignMechanism != 0 {
+		if err := km.loadTokenKeys(t); err != nil {
+			return nil, err
+		}
+		km.token = t
+	} else {
+		if err := km.loadKeystores(t, cfg.WrappingKeyLabel, cfg.KeystoresDir); err != nil {
+			return nil, err
+		}
+		if err := t.close(); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(km.pubKeys, func(i, j int) bool {
+		return bytes.Compare(km.pubKeys[i][:], km.pubKeys[j][:]) < 0
+	})
+	return km, nil
+}
+
+func (km *Keymanager) loadTokenKeys(t token) error {
+	pubKeys, err := t.blsKeys()
+	if err != nil {
+		return errors.Wrap(err, "could not list BLS keys of PKCS#11 token")
+	}
+	for _, pubKey := range pubKeys {
+		publicKey, err := bls.PublicKeyFromBytes(pubKey[:])
+		if err != nil {
+			return errors.Wrapf(err, "PKCS#11 private key has an invalid public key ID %#x", pubKey)
+		}
+		km.addPublicKey(pubKey, publicKey)
+	}
+	return nil
+}
+
+func (km *Keymanager) loadKeystores(t token, wrappingKeyLabel, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrap(err, "could not read keystores directory")
+	}
+	enc := keystorev4.New()
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+		keystorePath := filepath.Join(dir, entry.Name())
+		keystoreJSON, err := os.ReadFile(keystorePath) // #nosec G304 -- the path is of the configured directory.
+		if err != nil {
+			return errors.Wrapf(err, "could not read keystore %s", keystorePath)
+		}
+		keystore := &keymanager.Keystore{}
+		if err := json.Unmarshal(keystoreJSON, keystore); err != nil {
+			return errors.Wrapf(err, "could not decode keystore %s", keystorePath)
+		}
+		passwordPath := strings.TrimSuffix(keystorePath, ".json") + EncryptedPasswordSuffix
+		encryptedPassword, err := os.ReadFile(passwordPath) // #nosec G304 -- the path is of the configured directory.
+		if err != nil {
+			return errors.Wrapf(err, "could not read encrypted password of keystore %s", keystorePath)
+		}
+		password, err := t.decrypt(wrappingKeyLabel, encryptedPassword)
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt password of keystore %s", keystorePath)
+		}
+		privKeyBytes, err := enc.Decrypt(keystore.Crypto, strings.TrimRight(string(password), "\r\n"))
+		if err != nil {
+			return errors.Wrapf(err, "could not decrypt keystore %s", keystorePath)
+		}
+		secretKey, err := bls.SecretKeyFromBytes(privKeyBytes)
+		if err != nil {
+			return errors.Wrapf(err, "keystore %s has an invalid private key", keystorePath)
+		}
+		pubKey := bytesutil.ToBytes48(secretKey.PublicKey().Marshal())
+		km.addPublicKey(pubKey, secretKey.PublicKey())
+		km.secretKeys[pubKey] = secretKey
+	}
+	return nil
+}
+
+func (km *Keymanager) addPublicKey(pubKey [fieldparams.BLSPubkeyLength]byte, publicKey bls.PublicKey) {
+	if _, ok := km.publicKeys[pubKey]; ok {
+		return
+	}
+	km.publicKeys[pubKey] = publicKey
+	km.pubKeys = append(km.pubKeys, pubKey)
+}
+
+// FetchValidatingPublicKeys returns the public keys of the keys of the token, or of the keystores it decrypted.
+func (km *Keymanager) FetchValidatingPublicKeys(_ context.Context) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, len(km.pubKeys))
+	copy(pubKeys, km.pubKeys)
+	return pubKeys, nil
+}
+
+// Sign signs the signing root of the request. Signatures of the token are verified, since a misconfigured mechanism
+// could return something else.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	_, span := trace.StartSpan(ctx, "pkcs11-keymanager.Sign")
+	defer span.End()
+	if req.PublicKey == nil {
+		return nil, errors.New("nil public key in request")
+	}
+	pubKey := bytesutil.ToBytes48(req.PublicKey)
+	if secretKey, ok := km.secretKeys[pubKey]; ok {
+		return secretKey.Sign(req.SigningRoot), nil
+	}
+	publicKey, ok := km.publicKeys[pubKey]
+	if !ok || km.token == nil {
+		return nil, errors.New("no signing key found in PKCS#11 token")
+	}
+	sigBytes, err := km.token.sign(pubKey, km.signMechanism, req.SigningRoot)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := bls.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "PKCS#11 token returned an invalid signature")
+	}
+	if !sig.Verify(publicKey, req.SigningRoot) {
+		return nil, errors.New("PKCS#11 token returned a signature which does not verify")
+	}
+	return sig, nil
+}
+
+// Close logs out of the token and unloads its PKCS#11 library when the token signs. Signing fails afterwards.
+func (km *Keymanager) Close() error {
+	if km.token == nil {
+		return nil
+	}
+	return km.token.close()
+}
+
+// SubscribeAccountChanges returns the event subscription for changes to public keys.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][fieldparams.BLSPubkeyLength]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// ExtractKeystores is not supported for the PKCS#11 keymanager type.
+func (*Keymanager) ExtractKeystores(
+	_ context.Context, _ []bls.PublicKey, _ string,
+) ([]*keymanager.Keystore, error) {
+	return nil, errors.New("extracting keys is not supported for a PKCS#11 keymanager")
+}
+
+// DeleteKeystores is not supported for the PKCS#11 keymanager type.
+func (*Keymanager) DeleteKeystores(context.Context, [][]byte) ([]*keymanager.KeyStatus, error) {
+	return nil, errors.New("Wrong wallet type: pkcs11. Only Imported or Derived wallets can delete accounts")
+}
+
+// ListKeymanagerAccounts prints the public keys of the keymanager.
+func (km *Keymanager) ListKeymanagerAccounts(ctx context.Context, _ keymanager.ListKeymanagerAccountConfig) error {
+	au := aurora.NewAurora(true)
+	fmt.Printf("(keymanager kind) %s\n", au.BrightGreen("pkcs11").Bold())
+	fmt.Println(" ")
+	validatingPubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch validating public keys")
+	}
+	if len(validatingPubKeys) == 1 {
+		fmt.Print("Showing 1 validator account\n")
+	} else if len(validatingPubKeys) == 0 {
+		fmt.Print("No accounts found\n")
+		return nil
+	} else {
+		fmt.Printf("Showing %d validator accounts\n", len(validatingPubKeys))
+	}
+	for _, pubKey := range validatingPubKeys {
+		fmt.Println("")
+		fmt.Printf("%s\n", au.BrightGreen(petnames.DeterministicName(pubKey[:], "-")).Bold())
+		fmt.Printf("%s %#x\n", au.BrightCyan("[validating public key]").Bold(), pubKey)
+		fmt.Println(" ")
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package pkcs11
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+const (
+	testMechanism  = 0x80000001
+	testKeyLabel   = "wrapping-key"
+	testPassword   = "keystore password"
+	testIVFillByte = 7
+)
+
+type fakeToken struct {
+	keys     map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey
+	aesKey   []byte
+	signWith bls.SecretKey
+	closed   bool
+}
+
+func (t *fakeToken) blsKeys() ([][fieldparams.BLSPubkeyLength]byte, error) {
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(t.keys))
+	for pubKey := range t.keys {
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return pubKeys, nil
+}
+
+func (t *fakeToken) sign(pubKey [fieldparams.BLSPubkeyLength]byte, mechanism uint, msg []byte) ([]byte, error) {
+	if mechanism != testMechanism {
+		return nil, errors.New("unsupported mechanism")
+	}
+	key := t.keys[pubKey]
+	if t.signWith != nil {
+		key = t.signWith
+	}
+	return key.Sign(msg).Marshal(), nil
+}
+
+func (t *fakeToken) decrypt(keyLabel string, ciphertext []byte) ([]byte, error) {
+	if keyLabel != testKeyLabel {
+		return nil, errors.New("no key")
+	}
+	block, err := aes.NewCipher(t.aesKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext)-aesBlockSize)
+	cipher.NewCBCDecrypter(block, ciphertext[:aesBlockSize]).CryptBlocks(plaintext, ciphertext[aesBlockSize:])
+	return plaintext[:len(plaintext)-int(plaintext[len(plaintext)-1])], nil
+}
+
+func (t *fakeToken) close() error {
+	t.closed = true
+	return nil
+}
+
+func newFakeToken(t *testing.T, numKeys int) *fakeToken {
+	tok := &fakeToken{
+		keys:   make(map[[fieldparams.BLSPubkeyLength]byte]bls.SecretKey),
+		aesKey: bytes.Repeat([]byte{1}, 32),
+	}
+	for i := 0; i < numKeys; i++ {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		tok.keys[bytesutil.ToBytes48(key.PublicKey().Marshal())] = key
+	}
+	return tok
+}
+
+// encryptPassword encrypts the password with CKM_AES_CBC_PAD, as the token would.
+func encryptPassword(t *testing.T, key []byte, password string) []byte {
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	padding := aesBlockSize - len(password)%aesBlockSize
+	plaintext := append([]byte(password), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	iv := bytes.Repeat([]byte{testIVFillByte}, aesBlockSize)
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
+	return append(iv, ciphertext...)
+}
+
+func writeKeystore(t *testing.T, dir, name string, key bls.SecretKey, encryptedPassword []byte) {
+	crypto, err := keystorev4.New().Encrypt(key.Marshal(), testPassword)
+	require.NoError(t, err)
+	keystoreJSON, err := json.Marshal(&keymanager.Keystore{Crypto: crypto, Version: 4})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".json"), keystoreJSON, 0600))
+	if encryptedPassword != nil {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name+EncryptedPasswordSuffix), encryptedPassword, 0600))
+	}
+}
+
+func TestKeymanager_TokenSigns(t *testing.T) {
+	ctx := context.Background()
+	tok := newFakeToken(t, 3)
+	km, err := newKeymanager(&SetupConfig{SignMechanism: testMechanism}, tok)
+	require.NoError(t, err)
+	assert.Equal(t, false, tok.closed)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys))
+	for i := 1; i < len(pubKeys); i++ {
+		assert.Equal(t, true, bytes.Compare(pubKeys[i-1][:], pubKeys[i][:]) < 0)
+	}
+
+	root := bytes.Repeat([]byte{2}, 32)
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKeys[0][:], SigningRoot: root})
+	require.NoError(t, err)
+	assert.DeepEqual(t, tok.keys[pubKeys[0]].Sign(root).Marshal(), sig.Marshal())
+
+	unknown := [fieldparams.BLSPubkeyLength]byte{1}
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: unknown[:], SigningRoot: root})
+	require.ErrorContains(t, "no signing key found", err)
+
+	// A signature of another key, as returned for a wrong mechanism, is refused.
+	tok.signWith = tok.keys[pubKeys[1]]
+	_, err = km.Sign(ctx, &validatorpb.SignRequest{PublicKey: pubKeys[0][:], SigningRoot: root})
+	require.ErrorContains(t, "does not verify", err)
+
+	require.NoError(t, km.Close())
+	assert.Equal(t, true, tok.closed)
+}
+
+func TestKeymanager_TokenDecryptsKeystorePasswords(t *testing.T) {
+	ctx := context.Background()
+	tok := newFakeToken(t, 0)
+	dir := t.TempDir()
+	keys := make([]bls.SecretKey, 2)
+	for i := range keys {
+		key, err := bls.RandKey()
+		require.NoError(t, err)
+		keys[i] = key
+		writeKeystore(t, dir, "keystore-"+string(rune('a'+i)), key, encryptPassword(t, tok.aesKey, testPassword+"\n"))
+	}
+
+	km, err := newKeymanager(&SetupConfig{WrappingKeyLabel: testKeyLabel, KeystoresDir: dir}, tok)
+	require.NoError(t, err)
+	assert.Equal(t, true, tok.closed)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+	root := bytes.Repeat([]byte{3}, 32)
+	for _, key := range keys {
+		sig, err := km.Sign(ctx, &validatorpb.SignRequest{PublicKey: key.PublicKey().Marshal(), SigningRoot: root})
+		require.NoError(t, err)
+		assert.DeepEqual(t, key.Sign(root).Marshal(), sig.Marshal())
+	}
+}
+
+func TestKeymanager_MissingEncryptedPassword(t *testing.T) {
+	tok := newFakeToken(t, 0)
+	dir := t.TempDir()
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	writeKeystore(t, dir, "keystore", key, nil)
+	_, err = newKeymanager(&SetupConfig{WrappingKeyLabel: testKeyLabel, KeystoresDir: dir}, tok)
+	require.ErrorContains(t, "could not read encrypted password of keystore", err)
+}
+
+func TestKeymanager_WrongPassword(t *testing.T) {
+	tok := newFakeToken(t, 0)
+	dir := t.TempDir()
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	writeKeystore(t, dir, "keystore", key, encryptPassword(t, tok.aesKey, "wrong password"))
+	_, err = newKeymanager(&SetupConfig{WrappingKeyLabel: testKeyLabel, KeystoresDir: dir}, tok)
+	require.ErrorContains(t, "could not decrypt keystore", err)
+}
+
+func TestNewKeymanager_InvalidConfig(t *testing.T) {
+	_, err := NewKeymanager(context.Background(), &SetupConfig{TokenLabel: "token"})
+	require.ErrorContains(t, "PKCS#11 library and token label must be set", err)
+	_, err = NewKeymanager(context.Background(), &SetupConfig{ModulePath: "lib.so", TokenLabel: "token"})
+	require.ErrorContains(t, "either a signing mechanism, or a wrapping key label", err)
+}
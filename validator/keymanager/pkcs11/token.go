@@ -0,0 +1,495 @@
+package pkcs11
+
+import (
+	"sync"
+
+	p11 "github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+)
+
+const (
+	// aesBlockSize is the size of the initialization vector prepended to the encrypted keystore passwords.
+	aesBlockSize = 16
+	// tokenSessions is the number of sessions opened with the token, and so the number of operations the token runs
+	// in parallel. Sessions are opened as they are needed.
+	tokenSessions = 8
+)
+
+var errTokenClosed = errors.New("PKCS#11 token is closed")
+
+// token is the PKCS#11 token holding the keys of the keymanager.
+type token interface {
+	// blsKeys returns the public keys of the BLS private keys of the token.
+	blsKeys() ([][fieldparams.BLSPubkeyLength]byte, error)
+	// sign signs the message with the BLS private key of the public key and the mechanism.
+	sign(pubKey [fieldparams.BLSPubkeyLength]byte, mechanism uint, msg []byte) ([]byte, error)
+	// decrypt decrypts the initialization vector prefixed ciphertext with the AES key of the label.
+	decrypt(keyLabel string, ciphertext []byte) ([]byte, error)
+	close() error
+}
+
+// pkcs11Ctx is the PKCS#11 library of the token vendor, implemented by p11.Ctx.
+type pkcs11Ctx interface {
+	GetSlotList(tokenPresent bool) ([]uint, error)
+	GetTokenInfo(slotID uint) (p11.TokenInfo, error)
+	OpenSession(slotID uint, flags uint) (p11.SessionHandle, error)
+	CloseSession(sh p11.SessionHandle) error
+	Login(sh p11.SessionHandle, userType uint, pin string) error
+	Logout(sh p11.SessionHandle) error
+	FindObjectsInit(sh p11.SessionHandle, temp []*p11.Attribute) error
+	FindObjects(sh p11.SessionHandle, max int) ([]p11.ObjectHandle, bool, error)
+	FindObjectsFinal(sh p11.SessionHandle) error
+	GetAttributeValue(sh p11.SessionHandle, o p11.ObjectHandle, a []*p11.Attribute) ([]*p11.Attribute, error)
+	SignInit(sh p11.SessionHandle, m []*p11.Mechanism, o p11.ObjectHandle) error
+	Sign(sh p11.SessionHandle, message []byte) ([]byte, error)
+	DecryptInit(sh p11.SessionHandle, m []*p11.Mechanism, o p11.ObjectHandle) error
+	Decrypt(sh p11.SessionHandle, cypher []byte) ([]byte, error)
+	Finalize() error
+	Destroy()
+}
+
+// cryptoki is a token accessed through the PKCS#11 library of its vendor. A session can only perform one operation
+// at a time, so operations are spread over a pool of sessions. The handles of the BLS private keys, which are valid
+// in every session, are cached. A session lost to the token is opened again with the label and PIN of the token, and
+// all the sessions and key handles are dropped when the token itself was lost, for instance after it was removed.
+type cryptoki struct {
+	ctx        pkcs11Ctx
+	tokenLabel string
+	pin        string
+	// sessions holds the sessions of the pool not in use, done is closed with the token.
+	sessions  chan *session
+	done      chan struct{}
+	closeOnce sync.Once
+	// lock guards the slot of the token, the generation of its sessions and the key handles.
+	lock       sync.Mutex
+	slot       uint
+	generation uint64
+	keys       map[[fieldparams.BLSPubkeyLength]byte]p11.ObjectHandle
+}
+
+// session is a session of the pool, with a handle when it is opened. Sessions of an earlier generation were opened
+// before the token was lost.
+type session struct {
+	handle     p11.SessionHandle
+	opened     bool
+	generation uint64
+}
+
+// openToken loads the PKCS#11 library, and logs in to the token of the label as the user.
+func openToken(modulePath, tokenLabel, pin string) (*cryptoki, error) {
+	ctx := p11.New(modulePath)
+	if ctx == nil {
+		return nil, errors.Errorf("could not load PKCS#11 library %s", modulePath)
+	}
+	if err := ctx.Initialize(); err != nil {
+		ctx.Destroy()
+		return nil, errors.Wrap(err, "could not initialize PKCS#11 library")
+	}
+	return newCryptoki(ctx, tokenLabel, pin)
+}
+
+// newCryptoki opens a first session with the token of the label and logs in to it, which checks the PIN. The library
+// is finalized when it fails.
+func newCryptoki(ctx pkcs11Ctx, tokenLabel, pin string) (*cryptoki, error) {
+	t := &cryptoki{
+		ctx:        ctx,
+		tokenLabel: tokenLabel,
+		pin:        pin,
+		sessions:   make(chan *session, tokenSessions),
+		done:       make(chan struct{}),
+		keys:       make(map[[fieldparams.BLSPubkeyLength]byte]p11.ObjectHandle),
+	}
+	slot, err := t.findSlot()
+	if err != nil {
+		t.finalize()
+		return nil, err
+	}
+	t.slot = slot
+	s := &session{}
+	if err := t.openSession(s); err != nil {
+		t.finalize()
+		return nil, err
+	}
+	t.sessions <- s
+	for i := 1; i < tokenSessions; i++ {
+		t.sessions <- &session{}
+	}
+	return t, nil
+}
+
+// findSlot returns the slot of the token of the label.
+func (t *cryptoki) findSlot() (uint, error) {
+	slots, err := t.ctx.GetSlotList(true)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not list PKCS#11 slots")
+	}
+	for _, slot := range slots {
+		info, err := t.ctx.GetTokenInfo(slot)
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not get token info of PKCS#11 slot %d", slot)
+		}
+		if info.Label == t.tokenLabel {
+			return slot, nil
+		}
+	}
+	return 0, errors.Errorf("no PKCS#11 token labeled %s", t.tokenLabel)
+}
+
+// openSession opens the session with the token, and looks up the slot of the token again when the token is no longer
+// in its slot.
+func (t *cryptoki) openSession(s *session) error {
+	t.lock.Lock()
+	generation := t.generation
+	t.lock.Unlock()
+	err := t.openSlotSession(s)
+	if !tokenLost(err) {
+		return err
+	}
+	t.reset(generation)
+	return t.openSlotSession(s)
+}
+
+// openSlotSession opens the session with the token in its slot, and logs in to it unless another session already
+// did: the login state is shared by all the sessions of the token.
+func (t *cryptoki) openSlotSession(s *session) error {
+	t.lock.Lock()
+	slot, generation := t.slot, t.generation
+	t.lock.Unlock()
+	handle, err := t.ctx.OpenSession(slot, p11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return errors.Wrapf(err, "could not open session with PKCS#11 token %s", t.tokenLabel)
+	}
+	if err := t.ctx.Login(handle, p11.CKU_USER, t.pin); err != nil && !isError(err, p11.CKR_USER_ALREADY_LOGGED_IN) {
+		if closeErr := t.ctx.CloseSession(handle); closeErr != nil {
+			log.WithError(closeErr).Debug("Could not close PKCS#11 session")
+		}
+		return errors.Wrapf(err, "could not log in to PKCS#11 token %s", t.tokenLabel)
+	}
+	s.handle, s.opened, s.generation = handle, true, generation
+	return nil
+}
+
+// closeSession closes the session, which is opened again when it is next used.
+func (t *cryptoki) closeSession(s *session) {
+	if !s.opened {
+		return
+	}
+	if err := t.ctx.CloseSession(s.handle); err != nil {
+		log.WithError(err).Debug("Could not close PKCS#11 session")
+	}
+	s.opened = false
+}
+
+// takeSession takes a session from the pool, waiting for one while they are all in use, and opens it when it is not
+// opened in the current generation. The session must be returned to the pool.
+func (t *cryptoki) takeSession() (*session, error) {
+	var s *session
+	select {
+	case <-t.done:
+		return nil, errTokenClosed
+	case s = <-t.sessions:
+	}
+	select {
+	case <-t.done:
+		t.sessions <- s
+		return nil, errTokenClosed
+	default:
+	}
+	t.lock.Lock()
+	stale := s.generation != t.generation
+	t.lock.Unlock()
+	if stale {
+		t.closeSession(s)
+	}
+	if !s.opened {
+		if err := t.openSession(s); err != nil {
+			t.sessions <- s
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// withSession runs the operation with a session of the pool, and runs it once more in a new session when the session
+// or the token was lost.
+func (t *cryptoki) withSession(op func(s *session) error) error {
+	s, err := t.takeSession()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		t.sessions <- s
+	}()
+	err = op(s)
+	switch {
+	case tokenLost(err):
+		log.WithError(err).Warn("Lost PKCS#11 token, opening new sessions")
+		t.reset(s.generation)
+	case sessionLost(err):
+		log.WithError(err).Warn("Lost session with PKCS#11 token, logging in again")
+	default:
+		return err
+	}
+	t.closeSession(s)
+	if err := t.openSession(s); err != nil {
+		return errors.Wrap(err, "could not open new session with PKCS#11 token")
+	}
+	return op(s)
+}
+
+// reset drops the sessions of the generation and the key handles after the token was lost, and looks up the slot of
+// the token again as it may have come back in another slot. Sessions of the generation are closed when next taken.
+func (t *cryptoki) reset(generation uint64) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if t.generation != generation {
+		// Another operation already reset the token.
+		return
+	}
+	t.generation++
+	t.keys = make(map[[fieldparams.BLSPubkeyLength]byte]p11.ObjectHandle)
+	slot, err := t.findSlot()
+	if err != nil {
+		log.WithError(err).Warn("Could not find PKCS#11 token")
+		return
+	}
+	t.slot = slot
+}
+
+// isError returns whether the error is caused by the PKCS#11 return value.
+func isError(err error, rv uint) bool {
+	var p11Err p11.Error
+	return errors.As(err, &p11Err) && uint(p11Err) == rv
+}
+
+// sessionLost returns whether the error is caused by a session the token no longer knows of or is no longer logged
+// in to.
+func sessionLost(err error) bool {
+	return isError(err, p11.CKR_SESSION_HANDLE_INVALID) ||
+		isError(err, p11.CKR_SESSION_CLOSED) ||
+		isError(err, p11.CKR_USER_NOT_LOGGED_IN)
+}
+
+// tokenLost returns whether the error is caused by the token being removed from its slot, which invalidates all its
+// sessions and object handles.
+func tokenLost(err error) bool {
+	return isError(err, p11.CKR_DEVICE_REMOVED) ||
+		isError(err, p11.CKR_TOKEN_NOT_PRESENT) ||
+		isError(err, p11.CKR_SLOT_ID_INVALID)
+}
+
+// keyHandleInvalid returns whether the error is caused by a key handle the token no longer knows of.
+func keyHandleInvalid(err error) bool {
+	return isError(err, p11.CKR_KEY_HANDLE_INVALID) || isError(err, p11.CKR_OBJECT_HANDLE_INVALID)
+}
+
+// blsKeys finds the private keys identified by a BLS public key, their CKA_ID, and caches their handles.
+func (t *cryptoki) blsKeys() ([][fieldparams.BLSPubkeyLength]byte, error) {
+	var pubKeys [][fieldparams.BLSPubkeyLength]byte
+	err := t.withSession(func(s *session) error {
+		objects, err := t.findObjects(s, []*p11.Attribute{
+			p11.NewAttribute(p11.CKA_CLASS, p11.CKO_PRIVATE_KEY),
+			p11.NewAttribute(p11.CKA_SIGN, true),
+		})
+		if err != nil {
+			return err
+		}
+		pubKeys = make([][fieldparams.BLSPubkeyLength]byte, 0, len(objects))
+		for _, o := range objects {
+			attrs, err := t.ctx.GetAttributeValue(s.handle, o, []*p11.Attribute{p11.NewAttribute(p11.CKA_ID, nil)})
+			if err != nil {
+				return errors.Wrap(err, "could not get ID of PKCS#11 private key")
+			}
+			if len(attrs) != 1 || len(attrs[0].Value) != fieldparams.BLSPubkeyLength {
+				continue
+			}
+			pubKey := bytesutil.ToBytes48(attrs[0].Value)
+			t.cacheKey(s, pubKey, o)
+			pubKeys = append(pubKeys, pubKey)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pubKeys, nil
+}
+
+func (t *cryptoki) sign(pubKey [fieldparams.BLSPubkeyLength]byte, mechanism uint, msg []byte) ([]byte, error) {
+	var sig []byte
+	err := t.withSession(func(s *session) error {
+		key, err := t.signingKey(s, pubKey)
+		if err != nil {
+			return err
+		}
+		sig, err = t.signWithKey(s, key, mechanism, msg)
+		if !keyHandleInvalid(err) {
+			return err
+		}
+		// The token no longer knows of the cached handle, look the key up again.
+		t.forgetKey(pubKey)
+		if key, err = t.signingKey(s, pubKey); err != nil {
+			return err
+		}
+		sig, err = t.signWithKey(s, key, mechanism, msg)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (t *cryptoki) signWithKey(s *session, key p11.ObjectHandle, mechanism uint, msg []byte) ([]byte, error) {
+	if err := t.ctx.SignInit(s.handle, []*p11.Mechanism{p11.NewMechanism(mechanism, nil)}, key); err != nil {
+		return nil, errors.Wrap(err, "could not initialize PKCS#11 signing")
+	}
+	sig, err := t.ctx.Sign(s.handle, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not sign with PKCS#11 token")
+	}
+	return sig, nil
+}
+
+// signingKey returns the handle of the BLS private key of the public key, from the cache or from the token.
+func (t *cryptoki) signingKey(s *session, pubKey [fieldparams.BLSPubkeyLength]byte) (p11.ObjectHandle, error) {
+	t.lock.Lock()
+	key, ok := t.keys[pubKey]
+	t.lock.Unlock()
+	if ok {
+		return key, nil
+	}
+	key, err := t.findObject(s, []*p11.Attribute{
+		p11.NewAttribute(p11.CKA_CLASS, p11.CKO_PRIVATE_KEY),
+		p11.NewAttribute(p11.CKA_ID, pubKey[:]),
+	})
+	if err != nil {
+		return 0, err
+	}
+	t.cacheKey(s, pubKey, key)
+	return key, nil
+}
+
+// cacheKey caches the handle of the key found with the session, unless the token was lost since the session opened.
+func (t *cryptoki) cacheKey(s *session, pubKey [fieldparams.BLSPubkeyLength]byte, key p11.ObjectHandle) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if s.generation == t.generation {
+		t.keys[pubKey] = key
+	}
+}
+
+func (t *cryptoki) forgetKey(pubKey [fieldparams.BLSPubkeyLength]byte) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.keys, pubKey)
+}
+
+// decrypt decrypts with the AES key of the label, which is looked up every time as keystore passwords are only
+// decrypted when loading the keystores.
+func (t *cryptoki) decrypt(keyLabel string, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) <= aesBlockSize {
+		return nil, errors.New("ciphertext is shorter than its initialization vector")
+	}
+	var plaintext []byte
+	err := t.withSession(func(s *session) error {
+		key, err := t.findObject(s, []*p11.Attribute{
+			p11.NewAttribute(p11.CKA_CLASS, p11.CKO_SECRET_KEY),
+			p11.NewAttribute(p11.CKA_LABEL, keyLabel),
+		})
+		if err != nil {
+			return err
+		}
+		mechanism := p11.NewMechanism(p11.CKM_AES_CBC_PAD, ciphertext[:aesBlockSize])
+		if err := t.ctx.DecryptInit(s.handle, []*p11.Mechanism{mechanism}, key); err != nil {
+			return errors.Wrap(err, "could not initialize PKCS#11 decryption")
+		}
+		if plaintext, err = t.ctx.Decrypt(s.handle, ciphertext[aesBlockSize:]); err != nil {
+			return errors.Wrap(err, "could not decrypt with PKCS#11 token")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plaintext, nil
+}
+
+// findObject finds the single object matching the template.
+func (t *cryptoki) findObject(s *session, template []*p11.Attribute) (p11.ObjectHandle, error) {
+	objects, err := t.findObjects(s, template)
+	if err != nil {
+		return 0, err
+	}
+	if len(objects) != 1 {
+		return 0, errors.Errorf("found %d PKCS#11 objects instead of 1", len(objects))
+	}
+	return objects[0], nil
+}
+
+func (t *cryptoki) findObjects(s *session, template []*p11.Attribute) ([]p11.ObjectHandle, error) {
+	if err := t.ctx.FindObjectsInit(s.handle, template); err != nil {
+		return nil, errors.Wrap(err, "could not initialize PKCS#11 object search")
+	}
+	var objects []p11.ObjectHandle
+	for {
+		found, _, err := t.ctx.FindObjects(s.handle, 100)
+		if err != nil {
+			if finalErr := t.ctx.FindObjectsFinal(s.handle); finalErr != nil {
+				log.WithError(finalErr).Debug("Could not finalize PKCS#11 object search")
+			}
+			return nil, errors.Wrap(err, "could not find PKCS#11 objects")
+		}
+		if len(found) == 0 {
+			break
+		}
+		objects = append(objects, found...)
+	}
+	if err := t.ctx.FindObjectsFinal(s.handle); err != nil {
+		return nil, errors.Wrap(err, "could not finalize PKCS#11 object search")
+	}
+	return objects, nil
+}
+
+// close waits for the operations in progress, logs out of the token and unloads the library. The operations of a
+// closed token fail.
+func (t *cryptoki) close() error {
+	var err error
+	t.closeOnce.Do(func() {
+		close(t.done)
+		defer t.finalize()
+		loggedOut := false
+		for i := 0; i < tokenSessions; i++ {
+			s := <-t.sessions
+			t.lock.Lock()
+			stale := s.generation != t.generation
+			t.lock.Unlock()
+			if stale {
+				// The token no longer knows of the session.
+				t.closeSession(s)
+			}
+			if !s.opened {
+				continue
+			}
+			if !loggedOut {
+				if logoutErr := t.ctx.Logout(s.handle); logoutErr != nil {
+					log.WithError(logoutErr).Debug("Could not log out of PKCS#11 token")
+				}
+				loggedOut = true
+			}
+			if closeErr := t.ctx.CloseSession(s.handle); closeErr != nil && err == nil {
+				err = errors.Wrap(closeErr, "could not close PKCS#11 session")
+			}
+		}
+	})
+	return err
+}
+
+func (t *cryptoki) finalize() {
+	if err := t.ctx.Finalize(); err != nil {
+		log.WithError(err).Debug("Could not finalize PKCS#11 library")
+	}
+	t.ctx.Destroy()
+}
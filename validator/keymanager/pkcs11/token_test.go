@@ -0,0 +1,331 @@
+package pkcs11
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+
+	p11 "github.com/miekg/pkcs11"
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+const testTokenLabel = "token"
+
+// fakeCtx is a PKCS#11 library with a token holding BLS private keys, which signs a message by appending the ID of
+// the key to it.
+type fakeCtx struct {
+	sync.Mutex
+	slot        uint
+	loggedIn    bool
+	nextHandle  uint
+	sessions    map[p11.SessionHandle]bool
+	keys        map[p11.ObjectHandle][]byte
+	found       map[p11.SessionHandle][]p11.ObjectHandle
+	signKeys    map[p11.SessionHandle]p11.ObjectHandle
+	searches    int
+	logins      int
+	signErr     error
+	signStarted chan struct{}
+	signRelease chan struct{}
+	finalized   bool
+}
+
+func newFakeCtx(ids ...[]byte) *fakeCtx {
+	f := &fakeCtx{
+		sessions: make(map[p11.SessionHandle]bool),
+		found:    make(map[p11.SessionHandle][]p11.ObjectHandle),
+		signKeys: make(map[p11.SessionHandle]p11.ObjectHandle),
+	}
+	f.setKeys(ids)
+	return f
+}
+
+func (f *fakeCtx) setKeys(ids [][]byte) {
+	f.keys = make(map[p11.ObjectHandle][]byte)
+	for _, id := range ids {
+		f.nextHandle++
+		f.keys[p11.ObjectHandle(f.nextHandle)] = id
+	}
+}
+
+// remove removes the token and inserts it back in another slot, with new object handles.
+func (f *fakeCtx) remove() {
+	f.Lock()
+	defer f.Unlock()
+	ids := make([][]byte, 0, len(f.keys))
+	for _, id := range f.keys {
+		ids = append(ids, id)
+	}
+	f.setKeys(ids)
+	f.slot++
+	f.loggedIn = false
+	f.sessions = make(map[p11.SessionHandle]bool)
+}
+
+func (f *fakeCtx) GetSlotList(bool) ([]uint, error) {
+	f.Lock()
+	defer f.Unlock()
+	return []uint{f.slot}, nil
+}
+
+func (f *fakeCtx) GetTokenInfo(slot uint) (p11.TokenInfo, error) {
+	f.Lock()
+	defer f.Unlock()
+	if slot != f.slot {
+		return p11.TokenInfo{}, p11.Error(p11.CKR_SLOT_ID_INVALID)
+	}
+	return p11.TokenInfo{Label: testTokenLabel}, nil
+}
+
+func (f *fakeCtx) OpenSession(slot uint, _ uint) (p11.SessionHandle, error) {
+	f.Lock()
+	defer f.Unlock()
+	if slot != f.slot {
+		return 0, p11.Error(p11.CKR_SLOT_ID_INVALID)
+	}
+	f.nextHandle++
+	sh := p11.SessionHandle(f.nextHandle)
+	f.sessions[sh] = true
+	return sh, nil
+}
+
+func (f *fakeCtx) CloseSession(sh p11.SessionHandle) error {
+	f.Lock()
+	defer f.Unlock()
+	if !f.sessions[sh] {
+		return p11.Error(p11.CKR_SESSION_HANDLE_INVALID)
+	}
+	delete(f.sessions, sh)
+	return nil
+}
+
+func (f *fakeCtx) Login(sh p11.SessionHandle, _ uint, _ string) error {
+	f.Lock()
+	defer f.Unlock()
+	if !f.sessions[sh] {
+		return p11.Error(p11.CKR_SESSION_HANDLE_INVALID)
+	}
+	if f.loggedIn {
+		return p11.Error(p11.CKR_USER_ALREADY_LOGGED_IN)
+	}
+	f.loggedIn = true
+	f.logins++
+	return nil
+}
+
+func (f *fakeCtx) Logout(p11.SessionHandle) error {
+	f.Lock()
+	defer f.Unlock()
+	f.loggedIn = false
+	return nil
+}
+
+func (f *fakeCtx) FindObjectsInit(sh p11.SessionHandle, template []*p11.Attribute) error {
+	f.Lock()
+	defer f.Unlock()
+	if !f.sessions[sh] {
+		return p11.Error(p11.CKR_DEVICE_REMOVED)
+	}
+	f.searches++
+	var found []p11.ObjectHandle
+	for o, id := range f.keys {
+		match := true
+		for _, a := range template {
+			if a.Type == p11.CKA_ID && !bytes.Equal(a.Value, id) {
+				match = false
+			}
+		}
+		if match {
+			found = append(found, o)
+		}
+	}
+	f.found[sh] = found
+	return nil
+}
+
+func (f *fakeCtx) FindObjects(sh p11.SessionHandle, _ int) ([]p11.ObjectHandle, bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	found := f.found[sh]
+	delete(f.found, sh)
+	return found, false, nil
+}
+
+func (*fakeCtx) FindObjectsFinal(p11.SessionHandle) error {
+	return nil
+}
+
+func (f *fakeCtx) GetAttributeValue(_ p11.SessionHandle, o p11.ObjectHandle, _ []*p11.Attribute) ([]*p11.Attribute, error) {
+	f.Lock()
+	defer f.Unlock()
+	return []*p11.Attribute{p11.NewAttribute(p11.CKA_ID, f.keys[o])}, nil
+}
+
+func (f *fakeCtx) SignInit(sh p11.SessionHandle, _ []*p11.Mechanism, o p11.ObjectHandle) error {
+	f.Lock()
+	defer f.Unlock()
+	if !f.sessions[sh] {
+		return p11.Error(p11.CKR_DEVICE_REMOVED)
+	}
+	if _, ok := f.keys[o]; !ok {
+		return p11.Error(p11.CKR_KEY_HANDLE_INVALID)
+	}
+	f.signKeys[sh] = o
+	return nil
+}
+
+func (f *fakeCtx) Sign(sh p11.SessionHandle, msg []byte) ([]byte, error) {
+	if f.signStarted != nil {
+		f.signStarted <- struct{}{}
+		<-f.signRelease
+	}
+	f.Lock()
+	defer f.Unlock()
+	if err := f.signErr; err != nil {
+		f.signErr = nil
+		return nil, err
+	}
+	return append(bytesutil.SafeCopyBytes(msg), f.keys[f.signKeys[sh]]...), nil
+}
+
+func (*fakeCtx) DecryptInit(p11.SessionHandle, []*p11.Mechanism, p11.ObjectHandle) error {
+	return errors.New("not supported")
+}
+
+func (*fakeCtx) Decrypt(p11.SessionHandle, []byte) ([]byte, error) {
+	return nil, errors.New("not supported")
+}
+
+func (f *fakeCtx) Finalize() error {
+	f.Lock()
+	defer f.Unlock()
+	f.finalized = true
+	return nil
+}
+
+func (*fakeCtx) Destroy() {}
+
+func testPubKey(b byte) [fieldparams.BLSPubkeyLength]byte {
+	return bytesutil.ToBytes48(bytes.Repeat([]byte{b}, fieldparams.BLSPubkeyLength))
+}
+
+func TestCryptoki_SignsInParallel(t *testing.T) {
+	pubKey := testPubKey(1)
+	ctx := newFakeCtx(pubKey[:])
+	tok, err := newCryptoki(ctx, testTokenLabel, "pin")
+	require.NoError(t, err)
+	pubKeys, err := tok.blsKeys()
+	require.NoError(t, err)
+	require.DeepEqual(t, [][fieldparams.BLSPubkeyLength]byte{pubKey}, pubKeys)
+
+	ctx.signStarted = make(chan struct{})
+	ctx.signRelease = make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < tokenSessions; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			sig, err := tok.sign(pubKey, testMechanism, []byte("msg"))
+			assert.NoError(t, err)
+			assert.DeepEqual(t, append([]byte("msg"), pubKey[:]...), sig)
+		}()
+	}
+	// Every session signs at the same time.
+	for i := 0; i < tokenSessions; i++ {
+		select {
+		case <-ctx.signStarted:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Only %d signatures in parallel", i)
+		}
+	}
+	close(ctx.signRelease)
+	wg.Wait()
+
+	// The key found when listing the keys is not searched for again, and the sessions opened after the first one
+	// are already logged in.
+	assert.Equal(t, 1, ctx.searches)
+	assert.Equal(t, 1, ctx.logins)
+	assert.Equal(t, tokenSessions, len(ctx.sessions))
+
+	require.NoError(t, tok.close())
+	assert.Equal(t, 0, len(ctx.sessions))
+	assert.Equal(t, true, ctx.finalized)
+	_, err = tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.ErrorIs(t, err, errTokenClosed)
+}
+
+func TestCryptoki_TokenRemoved(t *testing.T) {
+	pubKey := testPubKey(1)
+	ctx := newFakeCtx(pubKey[:])
+	tok, err := newCryptoki(ctx, testTokenLabel, "pin")
+	require.NoError(t, err)
+	_, err = tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.NoError(t, err)
+
+	// The token comes back in another slot, where the sessions are opened and the key is searched for again.
+	ctx.remove()
+	sig, err := tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.NoError(t, err)
+	assert.DeepEqual(t, append([]byte("msg"), pubKey[:]...), sig)
+	assert.Equal(t, 2, ctx.searches)
+	assert.Equal(t, 2, ctx.logins)
+	require.NoError(t, tok.close())
+}
+
+func TestCryptoki_SessionLost(t *testing.T) {
+	pubKey := testPubKey(1)
+	ctx := newFakeCtx(pubKey[:])
+	tok, err := newCryptoki(ctx, testTokenLabel, "pin")
+	require.NoError(t, err)
+
+	ctx.signErr = p11.Error(p11.CKR_USER_NOT_LOGGED_IN)
+	_, err = tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.NoError(t, err)
+	// The cached key handle is still valid in the new session.
+	assert.Equal(t, 1, ctx.searches)
+	require.NoError(t, tok.close())
+}
+
+func TestCryptoki_KeyHandleInvalid(t *testing.T) {
+	pubKey := testPubKey(1)
+	ctx := newFakeCtx(pubKey[:])
+	tok, err := newCryptoki(ctx, testTokenLabel, "pin")
+	require.NoError(t, err)
+	_, err = tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.NoError(t, err)
+
+	ctx.Lock()
+	ctx.setKeys([][]byte{pubKey[:]})
+	ctx.Unlock()
+	_, err = tok.sign(pubKey, testMechanism, []byte("msg"))
+	require.NoError(t, err)
+	assert.Equal(t, 2, ctx.searches)
+	require.NoError(t, tok.close())
+}
+
+func TestCryptoki_WrongTokenLabel(t *testing.T) {
+	ctx := newFakeCtx()
+	_, err := newCryptoki(ctx, "other", "pin")
+	require.ErrorContains(t, "no PKCS#11 token labeled other", err)
+	assert.Equal(t, true, ctx.finalized)
+}
+
+func TestSessionLost(t *testing.T) {
+	assert.Equal(t, true, sessionLost(errors.Wrap(p11.Error(p11.CKR_SESSION_HANDLE_INVALID), "could not sign")))
+	assert.Equal(t, true, sessionLost(p11.Error(p11.CKR_USER_NOT_LOGGED_IN)))
+	assert.Equal(t, false, sessionLost(p11.Error(p11.CKR_KEY_HANDLE_INVALID)))
+	assert.Equal(t, false, sessionLost(errors.New("other")))
+	assert.Equal(t, false, sessionLost(nil))
+}
+
+func TestTokenLost(t *testing.T) {
+	assert.Equal(t, true, tokenLost(errors.Wrap(p11.Error(p11.CKR_DEVICE_REMOVED), "could not sign")))
+	assert.Equal(t, true, tokenLost(p11.Error(p11.CKR_TOKEN_NOT_PRESENT)))
+	assert.Equal(t, false, tokenLost(p11.Error(p11.CKR_SESSION_HANDLE_INVALID)))
+	assert.Equal(t, false, tokenLost(nil))
+}
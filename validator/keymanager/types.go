@@ -120,6 +120,8 @@ const (
 	Derived
 	// Web3Signer keymanager capable of signing data using a remote signer called Web3Signer.
 	Web3Signer
+	// PKCS11 keymanager signing with the keys of a PKCS#11 token, or with keystores whose passwords it decrypts.
+	PKCS11
 )
 
 // IncorrectPasswordErrMsg defines a common error string representing an EIP-2335
@@ -139,6 +141,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Web3Signer:
 		return "web3signer"
+	case PKCS11:
+		return "pkcs11"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -153,6 +157,8 @@ func ParseKind(k string) (Kind, error) {
 		return Local, nil
 	case "web3signer":
 		return Web3Signer, nil
+	case "pkcs11":
+		return PKCS11, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
@@ -4,6 +4,7 @@
 package node
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"net/http"
@@ -27,15 +28,21 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/config/params"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer"
 	"github.com/prysmaticlabs/prysm/v5/config/proposer/loader"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/io/file"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/backup"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/otlp"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/prometheus"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing"
 	"github.com/prysmaticlabs/prysm/v5/runtime"
 	"github.com/prysmaticlabs/prysm/v5/runtime/debug"
+	"github.com/prysmaticlabs/prysm/v5/runtime/logging"
 	"github.com/prysmaticlabs/prysm/v5/runtime/prereqs"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"github.com/prysmaticlabs/prysm/v5/time/slots"
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/v5/validator/auditlog"
 	"github.com/prysmaticlabs/prysm/v5/validator/client"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/filesystem"
@@ -43,8 +50,11 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
 	g "github.com/prysmaticlabs/prysm/v5/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/local"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/pkcs11"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
 	"github.com/prysmaticlabs/prysm/v5/validator/rpc"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashingbackup"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashinggate"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -71,7 +81,8 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 		cliCtx.String(cmd.TracingProcessNameFlag.Name),
 		cliCtx.String(cmd.TracingEndpointFlag.Name),
 		cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
-		cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		// Traces are exported by the OTLP service when an OTLP endpoint is set.
+		cliCtx.Bool(cmd.EnableTracingFlag.Name) && cliCtx.String(cmd.OTLPEndpointFlag.Name) == "",
 	); err != nil {
 		return nil, err
 	}
@@ -81,7 +92,11 @@ func NewValidatorClient(cliCtx *cli.Context) (*ValidatorClient, error) {
 	if err != nil {
 		return nil, err
 	}
-	logrus.SetLevel(level)
+	moduleLevels, err := logging.ParseModuleLevels(cliCtx.StringSlice(cmd.LogModuleLevels.Name))
+	if err != nil {
+		return nil, err
+	}
+	logrus.SetLevel(logging.ConfigureModuleLevels(level, moduleLevels))
 
 	// Warn if user's platform is not supported
 	prereqs.WarnIfPlatformNotSupported(cliCtx.Context)
@@ -237,6 +252,8 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *http.Se
 		// Custom Check For Web3Signer
 		if isWeb3SignerURLFlagSet {
 			c.wallet = wallet.NewWalletForWeb3Signer(cliCtx)
+		} else if cliCtx.IsSet(flags.PKCS11ModuleFlag.Name) {
+			c.wallet = wallet.NewWalletForPKCS11(cliCtx)
 		} else {
 			w, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
 				return nil, wallet.ErrNoWalletFound
@@ -262,6 +279,9 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context, router *http.Se
 			return err
 		}
 	}
+	if err := c.registerOTLPService(cliCtx); err != nil {
+		return err
+	}
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
@@ -277,6 +297,8 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 	if cliCtx.IsSet(flags.Web3SignerURLFlag.Name) {
 		// Custom Check For Web3Signer
 		c.wallet = wallet.NewWalletForWeb3Signer(cliCtx)
+	} else if cliCtx.IsSet(flags.PKCS11ModuleFlag.Name) {
+		c.wallet = wallet.NewWalletForPKCS11(cliCtx)
 	} else {
 		// Read the wallet password file from the cli context.
 		if err := setWalletPasswordFilePath(cliCtx); err != nil {
@@ -302,6 +324,9 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context, router *http.Ser
 			return err
 		}
 	}
+	if err := c.registerOTLPService(cliCtx); err != nil {
+		return err
+	}
 	if err := c.registerValidatorService(cliCtx); err != nil {
 		return err
 	}
@@ -452,6 +477,31 @@ func (c *ValidatorClient) registerPrometheusService(cliCtx *cli.Context) error {
 	return c.services.RegisterService(service)
 }
 
+func (c *ValidatorClient) registerOTLPService(cliCtx *cli.Context) error {
+	endpoint := cliCtx.String(cmd.OTLPEndpointFlag.Name)
+	if endpoint == "" {
+		return nil
+	}
+	headers, err := otlp.ParseHeaders(cliCtx.StringSlice(cmd.OTLPHeadersFlag.Name))
+	if err != nil {
+		return err
+	}
+	service, err := otlp.NewService(c.ctx, &otlp.Config{
+		Endpoint:       endpoint,
+		Headers:        headers,
+		Interval:       cliCtx.Duration(cmd.OTLPExportIntervalFlag.Name),
+		ServiceName:    "validator",
+		InstanceID:     cliCtx.String(cmd.OTLPInstanceIDFlag.Name),
+		Network:        params.BeaconConfig().ConfigName,
+		Traces:         cliCtx.Bool(cmd.EnableTracingFlag.Name),
+		SampleFraction: cliCtx.Float64(cmd.TraceSampleFractionFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create OTLP service")
+	}
+	return c.services.RegisterService(service)
+}
+
 func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 	var (
 		interopKmConfig *local.InteropKeymanagerConfig
@@ -482,33 +532,130 @@ func (c *ValidatorClient) registerValidatorService(cliCtx *cli.Context) error {
 		return err
 	}
 
+	pkcs11Config, err := PKCS11Config(c.cliCtx)
+	if err != nil {
+		return err
+	}
+	if web3signerConfig != nil && pkcs11Config != nil {
+		return errors.Errorf("--%s and --%s are mutually exclusive", flags.Web3SignerURLFlag.Name, flags.PKCS11ModuleFlag.Name)
+	}
+
 	ps, err := proposerSettings(c.cliCtx, c.db)
 	if err != nil {
 		return err
 	}
 
+	var lightClientCheckpoint [32]byte
+	if c.cliCtx.IsSet(flags.LightClientVerificationCheckpointFlag.Name) {
+		root, err := bytesutil.DecodeHexWithLength(c.cliCtx.String(flags.LightClientVerificationCheckpointFlag.Name), 32)
+		if err != nil {
+			return errors.Wrap(err, "could not decode light client verification checkpoint")
+		}
+		lightClientCheckpoint = bytesutil.ToBytes32(root)
+	}
+
+	// The aggregated selection proofs of a distributed validator cluster are only exchanged over the beacon API.
+	if c.cliCtx.Bool(flags.EnableDistributed.Name) && !features.Get().EnableBeaconRESTApi {
+		return errors.New("a distributed validator must connect to its middleware over the beacon REST API, " +
+			"run the validator client with --enable-beacon-rest-api")
+	}
+	// The blocks of a distributed validator cluster are built by its middleware.
+	if c.cliCtx.Bool(flags.EnableDistributed.Name) && c.cliCtx.IsSet(flags.ExternalBlockSourceEndpointFlag.Name) {
+		return errors.Errorf("--%s and --%s are mutually exclusive", flags.EnableDistributed.Name, flags.ExternalBlockSourceEndpointFlag.Name)
+	}
+
+	dutyRole, err := client.ParseDutyRole(c.cliCtx.String(flags.DutyRoleFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	// Aggregators aggregate the attestations of the slot at the two-thirds mark.
+	headMaxWait := c.cliCtx.Duration(flags.AttestationHeadMaxWaitFlag.Name)
+	if twoThirds := 2 * slots.DivideSlotBy(3); headMaxWait > twoThirds {
+		return errors.Errorf("--%s of %s exceeds two thirds of a slot, %s", flags.AttestationHeadMaxWaitFlag.Name, headMaxWait, twoThirds)
+	}
+
+	maxConcurrentDuties := c.cliCtx.Int(flags.MaxConcurrentDutiesFlag.Name)
+	if maxConcurrentDuties < 0 {
+		return errors.Errorf("--%s must not be negative", flags.MaxConcurrentDutiesFlag.Name)
+	}
+
+	slashingGate, err := newSlashingGate(c.cliCtx)
+	if err != nil {
+		return err
+	}
+	if dutyRole != client.DutyRoleAll {
+		// The sibling validator clients of a split role sign with the same keys, so they must share a slashing
+		// protection source besides their own databases.
+		if web3signerConfig == nil && slashingGate == nil {
+			return errors.Errorf("--%s=%s requires a slashing protection shared with the validator client of the "+
+				"other role, either a remote signer with --%s or a remote slashing protection with --%s",
+				flags.DutyRoleFlag.Name, dutyRole, flags.Web3SignerURLFlag.Name, flags.RemoteSlashingProtectionURLFlag.Name)
+		}
+		log.WithField("role", dutyRole).Info("Validator client only performs the duties of its role")
+	}
+
+	preflightLead := c.cliCtx.Duration(flags.ProposalPreflightLeadFlag.Name)
+	if preflightLead < 0 {
+		return errors.Errorf("--%s must not be negative", flags.ProposalPreflightLeadFlag.Name)
+	}
+
+	var localGasLimitsToken string
+	if c.cliCtx.Bool(flags.LocalGasLimitsFlag.Name) {
+		localGasLimitsToken, err = readBeaconAdminToken(c.cliCtx.String(flags.BeaconAdminTokenFileFlag.Name))
+		if err != nil {
+			return errors.Wrapf(err, "--%s requires --%s", flags.LocalGasLimitsFlag.Name, flags.BeaconAdminTokenFileFlag.Name)
+		}
+	}
+
+	auditLog, err := openAuditLog(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
+	slashingBackup, err := newSlashingBackup(c.cliCtx, c.db)
+	if err != nil {
+		return err
+	}
+
 	validatorService, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
-		DB:                      c.db,
-		Wallet:                  c.wallet,
-		WalletInitializedFeed:   c.walletInitializedFeed,
-		GRPCMaxCallRecvMsgSize:  c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
-		GRPCRetries:             c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
-		GRPCRetryDelay:          c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
-		GRPCHeaders:             strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
-		BeaconNodeGRPCEndpoint:  c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
-		BeaconNodeCert:          c.cliCtx.String(flags.CertFlag.Name),
-		BeaconApiEndpoint:       c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
-		BeaconApiTimeout:        time.Second * 30,
-		Graffiti:                g.ParseHexGraffiti(c.cliCtx.String(flags.GraffitiFlag.Name)),
-		GraffitiStruct:          graffitiStruct,
-		InteropKmConfig:         interopKmConfig,
-		Web3SignerConfig:        web3signerConfig,
-		ProposerSettings:        ps,
-		ValidatorsRegBatchSize:  c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
-		UseWeb:                  c.cliCtx.Bool(flags.EnableWebFlag.Name),
-		LogValidatorPerformance: !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
-		EmitAccountMetrics:      !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
-		Distributed:             c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DB:                                c.db,
+		Wallet:                            c.wallet,
+		WalletInitializedFeed:             c.walletInitializedFeed,
+		GRPCMaxCallRecvMsgSize:            c.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
+		GRPCRetries:                       c.cliCtx.Uint(flags.GRPCRetriesFlag.Name),
+		GRPCRetryDelay:                    c.cliCtx.Duration(flags.GRPCRetryDelayFlag.Name),
+		GRPCHeaders:                       strings.Split(c.cliCtx.String(flags.GRPCHeadersFlag.Name), ","),
+		BeaconNodeGRPCEndpoint:            c.cliCtx.String(flags.BeaconRPCProviderFlag.Name),
+		BeaconNodeCert:                    c.cliCtx.String(flags.CertFlag.Name),
+		BeaconApiEndpoint:                 c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
+		BeaconApiTimeout:                  time.Second * 30,
+		Graffiti:                          g.ParseHexGraffiti(c.cliCtx.String(flags.GraffitiFlag.Name)),
+		GraffitiStruct:                    graffitiStruct,
+		InteropKmConfig:                   interopKmConfig,
+		Web3SignerConfig:                  web3signerConfig,
+		PKCS11Config:                      pkcs11Config,
+		ProposerSettings:                  ps,
+		ValidatorsRegBatchSize:            c.cliCtx.Int(flags.ValidatorsRegistrationBatchSizeFlag.Name),
+		UseWeb:                            c.cliCtx.Bool(flags.EnableWebFlag.Name),
+		LogValidatorPerformance:           !c.cliCtx.Bool(flags.DisablePenaltyRewardLogFlag.Name),
+		EmitAccountMetrics:                !c.cliCtx.Bool(flags.DisableAccountMetricsFlag.Name),
+		Distributed:                       c.cliCtx.Bool(flags.EnableDistributed.Name),
+		DutyRole:                          dutyRole,
+		ExternalBlockSourceEndpoint:       c.cliCtx.String(flags.ExternalBlockSourceEndpointFlag.Name),
+		LightClientVerificationEndpoint:   c.cliCtx.String(flags.LightClientVerificationEndpointFlag.Name),
+		LightClientVerificationCheckpoint: lightClientCheckpoint,
+		ShutdownDutyWindow:                c.cliCtx.Duration(flags.ShutdownDutyWindowFlag.Name),
+		AttestationHeadMaxWait:            headMaxWait,
+		MaxConcurrentDuties:               maxConcurrentDuties,
+		AuditLog:                          auditLog,
+		SlashingGate:                      slashingGate,
+		SlashingBackup:                    slashingBackup,
+		ProposalPreflightLead:             preflightLead,
+		LocalGasLimitsToken:               localGasLimitsToken,
+		BeaconApiCache:                    c.cliCtx.Bool(flags.BeaconRESTApiCacheFlag.Name),
+		BeaconApiHedgeDelay:               c.cliCtx.Duration(flags.BeaconRESTApiHedgeDelayFlag.Name),
+		BeaconApiRetryBudget:              c.cliCtx.Int(flags.BeaconRESTApiRetryBudgetFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -555,6 +702,135 @@ func Web3SignerConfig(cliCtx *cli.Context) (*remoteweb3signer.SetupConfig, error
 	return web3signerConfig, nil
 }
 
+// PKCS11Config returns the configuration of the PKCS#11 keymanager, or nil when it is not used.
+func PKCS11Config(cliCtx *cli.Context) (*pkcs11.SetupConfig, error) {
+	if !cliCtx.IsSet(flags.PKCS11ModuleFlag.Name) {
+		return nil, nil
+	}
+	cfg := &pkcs11.SetupConfig{
+		ModulePath:       cliCtx.String(flags.PKCS11ModuleFlag.Name),
+		TokenLabel:       cliCtx.String(flags.PKCS11TokenLabelFlag.Name),
+		SignMechanism:    uint(cliCtx.Uint64(flags.PKCS11SignMechanismFlag.Name)),
+		WrappingKeyLabel: cliCtx.String(flags.PKCS11WrappingKeyLabelFlag.Name),
+		KeystoresDir:     cliCtx.String(flags.PKCS11KeystoresDirFlag.Name),
+	}
+	if pinFile := cliCtx.String(flags.PKCS11PINFileFlag.Name); pinFile != "" {
+		pin, err := file.ReadFileAsBytes(pinFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read PKCS#11 PIN file")
+		}
+		cfg.PIN = strings.TrimSpace(string(pin))
+	}
+	if cfg.TokenLabel == "" {
+		return nil, errors.Errorf("--%s is required with --%s", flags.PKCS11TokenLabelFlag.Name, flags.PKCS11ModuleFlag.Name)
+	}
+	if cfg.SignMechanism == 0 && (cfg.WrappingKeyLabel == "" || cfg.KeystoresDir == "") {
+		return nil, errors.Errorf("--%s, or --%s and --%s, are required with --%s", flags.PKCS11SignMechanismFlag.Name,
+			flags.PKCS11WrappingKeyLabelFlag.Name, flags.PKCS11KeystoresDirFlag.Name, flags.PKCS11ModuleFlag.Name)
+	}
+	return cfg, nil
+}
+
+// openAuditLog opens the audit log of the signed objects, or returns nil when it is disabled.
+func openAuditLog(cliCtx *cli.Context) (*auditlog.Log, error) {
+	dir := cliCtx.String(flags.AuditLogDirFlag.Name)
+	if dir == "" {
+		return nil, nil
+	}
+	var hmacKey []byte
+	if keyFile := cliCtx.String(flags.AuditLogHMACKeyFileFlag.Name); keyFile != "" {
+		key, err := file.ReadFileAsBytes(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read audit log HMAC key file")
+		}
+		hmacKey = bytes.TrimSpace(key)
+		if len(hmacKey) == 0 {
+			return nil, errors.Errorf("audit log HMAC key file %s is empty", keyFile)
+		}
+	}
+	maxSize := int64(cliCtx.Int(flags.AuditLogMaxSizeFlag.Name)) * 1024 * 1024
+	l, err := auditlog.New(dir, maxSize, cliCtx.Int(flags.AuditLogMaxFilesFlag.Name), hmacKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open audit log")
+	}
+	log.WithFields(logrus.Fields{
+		"dir":  dir,
+		"hmac": len(hmacKey) > 0,
+	}).Info("Recording signed objects in audit log")
+	return l, nil
+}
+
+// newSlashingGate creates the gate checking blocks and attestations with a remote slashing protection, or returns
+// nil when it is disabled.
+func newSlashingGate(cliCtx *cli.Context) (*slashinggate.Gate, error) {
+	endpoint := cliCtx.String(flags.RemoteSlashingProtectionURLFlag.Name)
+	if endpoint == "" {
+		return nil, nil
+	}
+	timeout := cliCtx.Duration(flags.RemoteSlashingProtectionTimeoutFlag.Name)
+	if timeout <= 0 {
+		return nil, errors.Errorf("--%s must be positive", flags.RemoteSlashingProtectionTimeoutFlag.Name)
+	}
+	allowOnError := cliCtx.Bool(flags.RemoteSlashingProtectionAllowOnErrorFlag.Name)
+	g, err := slashinggate.New(endpoint, timeout, allowOnError)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(logrus.Fields{
+		"url":          endpoint,
+		"timeout":      timeout,
+		"allowOnError": allowOnError,
+	}).Info("Checking blocks and attestations with remote slashing protection before signing")
+	return g, nil
+}
+
+// readBeaconAdminToken reads the admin token of the beacon node from the file.
+func readBeaconAdminToken(path string) (string, error) {
+	if path == "" {
+		return "", errors.New("no admin token file")
+	}
+	enc, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return "", errors.Wrap(err, "could not read admin token file")
+	}
+	token := strings.TrimSpace(string(enc))
+	if token == "" {
+		return "", errors.New("admin token file is empty")
+	}
+	return token, nil
+}
+
+// newSlashingBackup creates the backup of the slashing protection history as the finalized checkpoint advances, or
+// returns nil when it is disabled.
+func newSlashingBackup(cliCtx *cli.Context, db iface.ValidatorDB) (*slashingbackup.Backup, error) {
+	target := cliCtx.String(flags.SlashingProtectionBackupFlag.Name)
+	if target == "" {
+		return nil, nil
+	}
+	dest, err := slashingbackup.NewDestination(
+		target,
+		cliCtx.String(flags.SlashingProtectionBackupS3EndpointFlag.Name),
+		cliCtx.String(flags.SlashingProtectionBackupS3RegionFlag.Name),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not set up slashing protection backup")
+	}
+	id := cliCtx.String(flags.SlashingProtectionBackupIDFlag.Name)
+	if id == "" {
+		id, err = slashingbackup.LoadOrCreateID(cliCtx.String(cmd.DataDirFlag.Name))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get slashing protection backup identifier")
+		}
+	}
+	return slashingbackup.New(
+		db,
+		dest,
+		id,
+		primitives.Epoch(cliCtx.Uint64(flags.SlashingProtectionBackupEpochsFlag.Name)),
+		cliCtx.Int(flags.SlashingProtectionBackupKeepFlag.Name),
+	), nil
+}
+
 func proposerSettings(cliCtx *cli.Context, db iface.ValidatorDB) (*proposer.Settings, error) {
 	l, err := loader.NewProposerSettingsLoader(
 		cliCtx,
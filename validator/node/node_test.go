@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/v5/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/v5/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/v5/validator/keymanager/pkcs11"
 	remoteweb3signer "github.com/prysmaticlabs/prysm/v5/validator/keymanager/remote-web3signer"
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli/v2"
@@ -309,3 +310,88 @@ func TestWeb3SignerConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestPKCS11Config(t *testing.T) {
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, file.WriteFile(pinFile, []byte("1234\n")))
+	tests := []struct {
+		name       string
+		flags      map[string]string
+		want       *pkcs11.SetupConfig
+		wantErrMsg string
+	}{
+		{
+			name:  "disabled",
+			flags: map[string]string{},
+		},
+		{
+			name: "token signs",
+			flags: map[string]string{
+				flags.PKCS11ModuleFlag.Name:        "/usr/lib/pkcs11.so",
+				flags.PKCS11TokenLabelFlag.Name:    "validator",
+				flags.PKCS11PINFileFlag.Name:       pinFile,
+				flags.PKCS11SignMechanismFlag.Name: "2147483649",
+			},
+			want: &pkcs11.SetupConfig{
+				ModulePath:    "/usr/lib/pkcs11.so",
+				TokenLabel:    "validator",
+				PIN:           "1234",
+				SignMechanism: 0x80000001,
+			},
+		},
+		{
+			name: "token decrypts keystore passwords",
+			flags: map[string]string{
+				flags.PKCS11ModuleFlag.Name:           "/usr/lib/pkcs11.so",
+				flags.PKCS11TokenLabelFlag.Name:       "validator",
+				flags.PKCS11WrappingKeyLabelFlag.Name: "wrapping-key",
+				flags.PKCS11KeystoresDirFlag.Name:     "/keystores",
+			},
+			want: &pkcs11.SetupConfig{
+				ModulePath:       "/usr/lib/pkcs11.so",
+				TokenLabel:       "validator",
+				WrappingKeyLabel: "wrapping-key",
+				KeystoresDir:     "/keystores",
+			},
+		},
+		{
+			name: "missing token label",
+			flags: map[string]string{
+				flags.PKCS11ModuleFlag.Name:        "/usr/lib/pkcs11.so",
+				flags.PKCS11SignMechanismFlag.Name: "1",
+			},
+			wantErrMsg: "--pkcs11-token-label is required",
+		},
+		{
+			name: "missing keys",
+			flags: map[string]string{
+				flags.PKCS11ModuleFlag.Name:           "/usr/lib/pkcs11.so",
+				flags.PKCS11TokenLabelFlag.Name:       "validator",
+				flags.PKCS11WrappingKeyLabelFlag.Name: "wrapping-key",
+			},
+			wantErrMsg: "--pkcs11-sign-mechanism, or --pkcs11-wrapping-key-label and --pkcs11-keystores-dir, are required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app := cli.App{}
+			set := flag.NewFlagSet(tt.name, 0)
+			set.String(flags.PKCS11ModuleFlag.Name, "", "")
+			set.String(flags.PKCS11TokenLabelFlag.Name, "", "")
+			set.String(flags.PKCS11PINFileFlag.Name, "", "")
+			set.Uint64(flags.PKCS11SignMechanismFlag.Name, 0, "")
+			set.String(flags.PKCS11WrappingKeyLabelFlag.Name, "", "")
+			set.String(flags.PKCS11KeystoresDirFlag.Name, "", "")
+			for name, value := range tt.flags {
+				require.NoError(t, set.Set(name, value))
+			}
+			got, err := PKCS11Config(cli.NewContext(&app, set, nil))
+			if tt.wantErrMsg != "" {
+				require.ErrorContains(t, tt.wantErrMsg, err)
+				return
+			}
+			require.NoError(t, err)
+			require.DeepEqual(t, tt.want, got)
+		})
+	}
+}
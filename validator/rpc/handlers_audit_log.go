@@ -0,0 +1,95 @@
+package rpc
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/beacon-chain/rpc/eth/shared"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/monitoring/tracing/trace"
+	"github.com/prysmaticlabs/prysm/v5/network/httputil"
+	"github.com/prysmaticlabs/prysm/v5/validator/auditlog"
+)
+
+const (
+	defaultAuditLogLimit = 100
+	maxAuditLogLimit     = 10000
+)
+
+// GetAuditLog returns the objects signed by the validator client recorded in its audit log, oldest first.
+// Entries can be filtered by public key, type and slot range, and the HMAC chain of the log is verified
+// when the verify query parameter is set.
+func (s *Server) GetAuditLog(w http.ResponseWriter, r *http.Request) {
+	_, span := trace.StartSpan(r.Context(), "validator.web.GetAuditLog")
+	defer span.End()
+
+	if s.validatorService == nil {
+		httputil.HandleError(w, "Validator service not ready.", http.StatusServiceUnavailable)
+		return
+	}
+	auditLog := s.validatorService.AuditLog()
+	if auditLog == nil {
+		httputil.HandleError(w, "Audit log is not enabled.", http.StatusServiceUnavailable)
+		return
+	}
+
+	filter := auditlog.Filter{
+		PublicKey: r.URL.Query().Get("public_key"),
+		Type:      r.URL.Query().Get("type"),
+		Limit:     defaultAuditLogLimit,
+	}
+	rawStart, startSlot, ok := shared.UintFromQuery(w, r, "start_slot", false)
+	if !ok {
+		return
+	}
+	if rawStart != "" {
+		filter.StartSlot = primitives.Slot(startSlot)
+	}
+	rawEnd, endSlot, ok := shared.UintFromQuery(w, r, "end_slot", false)
+	if !ok {
+		return
+	}
+	if rawEnd != "" {
+		filter.EndSlot = primitives.Slot(endSlot)
+	}
+	rawLimit, limit, ok := shared.UintFromQuery(w, r, "limit", false)
+	if !ok {
+		return
+	}
+	if rawLimit != "" {
+		if limit == 0 || limit > maxAuditLogLimit {
+			httputil.HandleError(w, fmt.Sprintf("limit must be between 1 and %d", maxAuditLogLimit), http.StatusBadRequest)
+			return
+		}
+		filter.Limit = int(limit)
+	}
+
+	entries, err := auditLog.Query(filter)
+	if err != nil {
+		httputil.HandleError(w, errors.Wrap(err, "Could not query audit log").Error(), http.StatusInternalServerError)
+		return
+	}
+	resp := &AuditLogResponse{Data: make([]*AuditLogEntry, len(entries))}
+	for i, e := range entries {
+		resp.Data[i] = &AuditLogEntry{
+			Time:        e.Time.Format("2006-01-02T15:04:05.000000000Z07:00"),
+			Type:        e.Type,
+			Slot:        strconv.FormatUint(uint64(e.Slot), 10),
+			PublicKey:   e.PublicKey,
+			SigningRoot: e.SigningRoot,
+			Hmac:        e.HMAC,
+		}
+	}
+	if verify, err := strconv.ParseBool(r.URL.Query().Get("verify")); err == nil && verify {
+		err := auditLog.Verify()
+		if err != nil && !errors.Is(err, auditlog.ErrChainBroken) {
+			httputil.HandleError(w, errors.Wrap(err, "Could not verify audit log").Error(), http.StatusInternalServerError)
+			return
+		}
+		valid := err == nil
+		resp.HmacChainValid = &valid
+	}
+	httputil.WriteJson(w, resp)
+}
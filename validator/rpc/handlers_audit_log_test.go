@@ -0,0 +1,69 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	"github.com/prysmaticlabs/prysm/v5/validator/auditlog"
+	"github.com/prysmaticlabs/prysm/v5/validator/client"
+)
+
+func TestServer_GetAuditLog(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("disabled", func(t *testing.T) {
+		vs, err := client.NewValidatorService(ctx, &client.Config{})
+		require.NoError(t, err)
+		s := &Server{validatorService: vs}
+		req := httptest.NewRequest(http.MethodGet, "/v2/validator/audit-log", nil)
+		w := httptest.NewRecorder()
+		s.GetAuditLog(w, req)
+		assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	})
+
+	l, err := auditlog.New(t.TempDir(), 0, 0, []byte("key"))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, l.Close()) }()
+	for slot := primitives.Slot(1); slot <= 3; slot++ {
+		require.NoError(t, l.Record(&validatorpb.SignRequest{
+			PublicKey:   []byte{1},
+			SigningRoot: []byte{2},
+			SigningSlot: slot,
+			Object:      &validatorpb.SignRequest_Slot{Slot: slot},
+		}))
+	}
+	vs, err := client.NewValidatorService(ctx, &client.Config{AuditLog: l})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+
+	t.Run("filtered", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v2/validator/audit-log?public_key=0x01&start_slot=2&limit=1&verify=true", nil)
+		w := httptest.NewRecorder()
+		s.GetAuditLog(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		resp := &AuditLogResponse{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+		require.Equal(t, 1, len(resp.Data))
+		assert.Equal(t, "3", resp.Data[0].Slot)
+		assert.Equal(t, "Slot", resp.Data[0].Type)
+		assert.Equal(t, "0x01", resp.Data[0].PublicKey)
+		assert.Equal(t, "0x02", resp.Data[0].SigningRoot)
+		assert.NotEqual(t, "", resp.Data[0].Hmac)
+		require.NotNil(t, resp.HmacChainValid)
+		assert.Equal(t, true, *resp.HmacChainValid)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/v2/validator/audit-log?limit=0", nil)
+		w := httptest.NewRecorder()
+		s.GetAuditLog(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -366,7 +366,7 @@ func (s *Server) SetVoluntaryExit(w http.ResponseWriter, r *http.Request) {
 	sve, err := client.CreateSignedVoluntaryExit(
 		ctx,
 		s.beaconNodeValidatorClient,
-		km.Sign,
+		s.validatorService.AuditLog().Signer(km.Sign),
 		pubkey,
 		epoch,
 	)
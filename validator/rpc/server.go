@@ -216,6 +216,8 @@ func (s *Server) InitializeRoutes() error {
 	// slashing protection endpoints
 	s.router.HandleFunc("GET "+api.WebUrlPrefix+"slashing-protection/export", s.ExportSlashingProtection)
 	s.router.HandleFunc("POST "+api.WebUrlPrefix+"slashing-protection/import", s.ImportSlashingProtection)
+	// audit log endpoints
+	s.router.HandleFunc("GET "+api.WebUrlPrefix+"audit-log", s.GetAuditLog)
 
 	log.Info("Initialized REST API routes")
 	return nil
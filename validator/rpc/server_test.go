@@ -30,6 +30,7 @@ func TestServer_InitializeRoutes(t *testing.T) {
 		"/v2/validator/wallet/recover":               {http.MethodPost},
 		"/v2/validator/slashing-protection/export":   {http.MethodGet},
 		"/v2/validator/slashing-protection/import":   {http.MethodPost},
+		"/v2/validator/audit-log":                    {http.MethodGet},
 		"/v2/validator/accounts":                     {http.MethodGet},
 		"/v2/validator/accounts/backup":              {http.MethodPost},
 		"/v2/validator/accounts/voluntary-exit":      {http.MethodPost},
@@ -454,3 +454,19 @@ func (m *ChainHead) ToConsensus() (*eth.ChainHead, error) {
 		OptimisticStatus:           m.OptimisticStatus,
 	}, nil
 }
+
+// AuditLogResponse is the response of the audit log endpoint.
+type AuditLogResponse struct {
+	Data []*AuditLogEntry `json:"data"`
+	// HmacChainValid is set when verification of the HMAC chain was requested.
+	HmacChainValid *bool `json:"hmac_chain_valid,omitempty"`
+}
+
+type AuditLogEntry struct {
+	Time        string `json:"time"`
+	Type        string `json:"type"`
+	Slot        string `json:"slot"`
+	PublicKey   string `json:"public_key"`
+	SigningRoot string `json:"signing_root"`
+	Hmac        string `json:"hmac,omitempty"`
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/prysmaticlabs/prysm/v5/encoding/bytesutil"
 	"github.com/prysmaticlabs/prysm/v5/monitoring/progress"
 	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/db/common"
 	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
 	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
 )
@@ -22,22 +23,10 @@ func ExportStandardProtectionJSON(
 	validatorDB db.Database,
 	filteredKeys ...[]byte,
 ) (*format.EIPSlashingProtectionFormat, error) {
-	interchangeJSON := &format.EIPSlashingProtectionFormat{}
-	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get genesis validators root from DB")
-	}
-	if genesisValidatorsRoot == nil || !bytesutil.IsValidRoot(genesisValidatorsRoot) {
-		return nil, errors.New(
-			"genesis validators root is empty, perhaps you are not connected to your beacon node",
-		)
-	}
-	genesisRootHex, err := helpers.RootToHexString(genesisValidatorsRoot)
+	interchangeJSON, err := newInterchange(ctx, validatorDB)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not convert genesis validators root to hex string")
+		return nil, err
 	}
-	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
-	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
 
 	// Allow for filtering data for the keys we wish to export.
 	filteredKeysMap := make(map[string]bool, len(filteredKeys))
@@ -127,6 +116,27 @@ func ExportStandardProtectionJSON(
 	return interchangeJSON, nil
 }
 
+// newInterchange returns an EIP-3076 interchange without data, with the metadata of the validator database.
+func newInterchange(ctx context.Context, validatorDB db.Database) (*format.EIPSlashingProtectionFormat, error) {
+	interchangeJSON := &format.EIPSlashingProtectionFormat{}
+	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root from DB")
+	}
+	if genesisValidatorsRoot == nil || !bytesutil.IsValidRoot(genesisValidatorsRoot) {
+		return nil, errors.New(
+			"genesis validators root is empty, perhaps you are not connected to your beacon node",
+		)
+	}
+	genesisRootHex, err := helpers.RootToHexString(genesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert genesis validators root to hex string")
+	}
+	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
+	interchangeJSON.Metadata.InterchangeFormatVersion = format.InterchangeFormatVersion
+	return interchangeJSON, nil
+}
+
 func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte) ([]*format.SignedAttestation, error) {
 	// If a key does not have an attestation history in our database, we return nil.
 	// This way, a user will be able to export their slashing protection history
@@ -138,22 +148,24 @@ func signedAttestationsByPubKey(ctx context.Context, validatorDB db.Database, pu
 	if history == nil {
 		return nil, nil
 	}
-	signedAttestations := make([]*format.SignedAttestation, 0)
-	for i := 0; i < len(history); i++ {
-		att := history[i]
-		// Special edge case due to a bug in Prysm's old slashing protection schema. The bug
-		// manifests itself as the first entry in attester slashing protection history
-		// having a target epoch greater than the next entry in the list. If this manifests,
-		// we skip it to protect users. This check is the best trade-off we can make at
-		// the moment without creating any false positive slashable attestation exports.
-		// More information on the bug can found in https://github.com/prysmaticlabs/prysm/issues/8893.
-		if i == 0 && len(history) > 1 {
-			nextEntryTargetEpoch := history[1].Target
-			if att.Target > nextEntryTargetEpoch && att.Source == 0 {
-				continue
-			}
-		}
+	// Special edge case due to a bug in Prysm's old slashing protection schema. The bug
+	// manifests itself as the first entry in attester slashing protection history
+	// having a target epoch greater than the next entry in the list. If this manifests,
+	// we skip it to protect users. This check is the best trade-off we can make at
+	// the moment without creating any false positive slashable attestation exports.
+	// More information on the bug can found in https://github.com/prysmaticlabs/prysm/issues/8893.
+	if len(history) > 1 && history[0].Target > history[1].Target && history[0].Source == 0 {
+		history = history[1:]
+	}
+	return formatSignedAttestations(history)
+}
+
+// formatSignedAttestations converts attestation records to their EIP-3076 representation.
+func formatSignedAttestations(history []*common.AttestationRecord) ([]*format.SignedAttestation, error) {
+	signedAttestations := make([]*format.SignedAttestation, 0, len(history))
+	for _, att := range history {
 		var root string
+		var err error
 		if len(att.SigningRoot) != 0 {
 			root, err = helpers.RootToHexString(att.SigningRoot)
 			if err != nil {
@@ -178,10 +190,15 @@ func signedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not get proposal history for public key: %#x", pubKey)
 	}
-	signedBlocks := make([]*format.SignedBlock, 0)
+	return formatSignedBlocks(ctx, proposalHistory)
+}
+
+// formatSignedBlocks converts proposals to their EIP-3076 representation.
+func formatSignedBlocks(ctx context.Context, proposalHistory []*common.Proposal) ([]*format.SignedBlock, error) {
+	signedBlocks := make([]*format.SignedBlock, 0, len(proposalHistory))
 	for _, proposal := range proposalHistory {
 		if ctx.Err() != nil {
-			return nil, errors.Wrap(err, "context canceled")
+			return nil, errors.Wrap(ctx.Err(), "context canceled")
 		}
 		signingRootHex, err := helpers.RootToHexString(proposal.SigningRoot)
 		if err != nil {
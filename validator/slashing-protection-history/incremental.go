@@ -0,0 +1,167 @@
+package history
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	"github.com/prysmaticlabs/prysm/v5/validator/helpers"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+// ExportMark is where an incremental export continues for a public key: signed blocks from the next slot and signed
+// attestations from the next target epoch.
+type ExportMark struct {
+	NextSlot        uint64
+	NextTargetEpoch uint64
+}
+
+// ExportMarks are the export marks by hex encoded public key.
+type ExportMarks map[string]ExportMark
+
+// ExportIncrementalProtectionJSON extracts the slashing protection data signed since the given marks from a validator
+// database, and returns it as an EIP-3076 interchange along with the marks to continue from. Only the records from
+// the marks on are read from the database. Public keys without marks are exported in full, and public keys without
+// new data are left out.
+func ExportIncrementalProtectionJSON(
+	ctx context.Context,
+	validatorDB db.Database,
+	marks ExportMarks,
+) (*format.EIPSlashingProtectionFormat, ExportMarks, error) {
+	interchangeJSON, err := newInterchange(ctx, validatorDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubKeys, err := signingPublicKeys(ctx, validatorDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	nextMarks := make(ExportMarks, len(marks))
+	for pubKey, mark := range marks {
+		nextMarks[pubKey] = mark
+	}
+	dataList := make([]*format.ProtectionData, 0)
+	for _, pubKey := range pubKeys {
+		pubKeyHex, err := helpers.PubKeyToHexString(pubKey[:])
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not convert public key to hex string")
+		}
+		mark, ok := nextMarks[pubKeyHex]
+		var signedBlocks []*format.SignedBlock
+		var signedAttestations []*format.SignedAttestation
+		if ok {
+			signedBlocks, signedAttestations, err = signedSince(ctx, validatorDB, pubKey, mark)
+		} else {
+			signedBlocks, signedAttestations, err = signedByPubKey(ctx, validatorDB, pubKey)
+		}
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not export public key %s", pubKeyHex)
+		}
+		if len(signedBlocks) == 0 && len(signedAttestations) == 0 {
+			continue
+		}
+		if mark, err = advanceMark(mark, signedBlocks, signedAttestations); err != nil {
+			return nil, nil, errors.Wrapf(err, "could not export public key %s", pubKeyHex)
+		}
+		nextMarks[pubKeyHex] = mark
+		dataList = append(dataList, &format.ProtectionData{
+			Pubkey:             pubKeyHex,
+			SignedBlocks:       signedBlocks,
+			SignedAttestations: signedAttestations,
+		})
+	}
+	interchangeJSON.Data = dataList
+	return interchangeJSON, nextMarks, nil
+}
+
+// signingPublicKeys returns the public keys which signed blocks or attestations, sorted.
+func signingPublicKeys(ctx context.Context, validatorDB db.Database) ([][fieldparams.BLSPubkeyLength]byte, error) {
+	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve proposer public keys from DB")
+	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attested public keys from DB")
+	}
+	seen := make(map[[fieldparams.BLSPubkeyLength]byte]bool, len(proposedPublicKeys))
+	pubKeys := make([][fieldparams.BLSPubkeyLength]byte, 0, len(proposedPublicKeys))
+	for _, pubKey := range append(proposedPublicKeys, attestedPublicKeys...) {
+		if !seen[pubKey] {
+			seen[pubKey] = true
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	sort.Slice(pubKeys, func(i, j int) bool {
+		return string(pubKeys[i][:]) < string(pubKeys[j][:])
+	})
+	return pubKeys, nil
+}
+
+func signedByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte,
+) ([]*format.SignedBlock, []*format.SignedAttestation, error) {
+	signedBlocks, err := signedBlocksByPubKey(ctx, validatorDB, pubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	signedAttestations, err := signedAttestationsByPubKey(ctx, validatorDB, pubKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if signedAttestations == nil {
+		signedAttestations = make([]*format.SignedAttestation, 0)
+	}
+	return signedBlocks, signedAttestations, nil
+}
+
+// signedSince returns the blocks and attestations signed by the public key from the mark on.
+func signedSince(
+	ctx context.Context, validatorDB db.Database, pubKey [fieldparams.BLSPubkeyLength]byte, mark ExportMark,
+) ([]*format.SignedBlock, []*format.SignedAttestation, error) {
+	proposals, err := validatorDB.ProposalHistoryForPubKeySince(ctx, pubKey, primitives.Slot(mark.NextSlot))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get proposal history")
+	}
+	signedBlocks, err := formatSignedBlocks(ctx, proposals)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, err := validatorDB.AttestationHistoryForPubKeySince(ctx, pubKey, primitives.Epoch(mark.NextTargetEpoch))
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not get attestation history")
+	}
+	signedAttestations, err := formatSignedAttestations(records)
+	if err != nil {
+		return nil, nil, err
+	}
+	return signedBlocks, signedAttestations, nil
+}
+
+// advanceMark moves the mark past the exported blocks and attestations.
+func advanceMark(
+	mark ExportMark, signedBlocks []*format.SignedBlock, signedAttestations []*format.SignedAttestation,
+) (ExportMark, error) {
+	for _, block := range signedBlocks {
+		slot, err := helpers.SlotFromString(block.Slot)
+		if err != nil {
+			return ExportMark{}, errors.Wrap(err, "could not parse slot of signed block")
+		}
+		if uint64(slot)+1 > mark.NextSlot {
+			mark.NextSlot = uint64(slot) + 1
+		}
+	}
+	for _, att := range signedAttestations {
+		target, err := helpers.EpochFromString(att.TargetEpoch)
+		if err != nil {
+			return ExportMark{}, errors.Wrap(err, "could not parse target epoch of signed attestation")
+		}
+		if uint64(target)+1 > mark.NextTargetEpoch {
+			mark.NextTargetEpoch = uint64(target) + 1
+		}
+	}
+	return mark, nil
+}
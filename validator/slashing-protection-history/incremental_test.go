@@ -0,0 +1,61 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+)
+
+func TestExportIncrementalProtectionJSON(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}, {2}}
+	validatorDB := dbtest.SetupDB(t, pubKeys, false)
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	pubKey1 := fmt.Sprintf("%#x", pubKeys[0])
+	pubKey2 := fmt.Sprintf("%#x", pubKeys[1])
+
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 1, []byte{1}))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{1}, createAttestation(0, 1)))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{2}, createAttestation(0, 1)))
+
+	// Without marks, everything is exported.
+	interchange, marks, err := ExportIncrementalProtectionJSON(ctx, validatorDB, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(interchange.Data))
+	assert.Equal(t, 1, len(interchange.Data[0].SignedBlocks))
+	assert.Equal(t, 1, len(interchange.Data[0].SignedAttestations))
+	assert.Equal(t, 1, len(interchange.Data[1].SignedAttestations))
+	assert.Equal(t, fmt.Sprintf("%#x", genesisValidatorsRoot), interchange.Metadata.GenesisValidatorsRoot)
+	assert.DeepEqual(t, ExportMarks{
+		pubKey1: {NextSlot: 2, NextTargetEpoch: 2},
+		pubKey2: {NextSlot: 0, NextTargetEpoch: 2},
+	}, marks)
+
+	// Nothing was signed since, so nothing is exported.
+	interchange, marks, err = ExportIncrementalProtectionJSON(ctx, validatorDB, marks)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(interchange.Data))
+
+	// Only what was signed since the marks is exported.
+	require.NoError(t, validatorDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 40, []byte{2}))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[1], [32]byte{3}, createAttestation(1, 2)))
+	interchange, marks, err = ExportIncrementalProtectionJSON(ctx, validatorDB, marks)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(interchange.Data))
+	require.Equal(t, 1, len(interchange.Data[0].SignedBlocks))
+	assert.Equal(t, "40", interchange.Data[0].SignedBlocks[0].Slot)
+	assert.Equal(t, 0, len(interchange.Data[0].SignedAttestations))
+	assert.Equal(t, 0, len(interchange.Data[1].SignedBlocks))
+	require.Equal(t, 1, len(interchange.Data[1].SignedAttestations))
+	assert.Equal(t, "2", interchange.Data[1].SignedAttestations[0].TargetEpoch)
+	assert.DeepEqual(t, ExportMarks{
+		pubKey1: {NextSlot: 41, NextTargetEpoch: 2},
+		pubKey2: {NextSlot: 0, NextTargetEpoch: 3},
+	}, marks)
+}
@@ -0,0 +1,335 @@
+// Package slashingbackup exports the slashing protection history of the validator client as EIP-3076 interchange
+// files to a directory or an S3 bucket as the finalized checkpoint advances, giving operators continuous off-host
+// backups of their slashing protection. A backup is a complete export followed by exports which only contain what
+// was signed since the previous export, and importing its files in order restores the slashing protection. A new
+// backup is started when the validator client starts and after a number of exports, and the oldest backups are
+// deleted. The files are named after the identifier of the validator client, so that validator clients backing up to
+// the same destination neither overwrite nor delete each other's backups.
+package slashingbackup
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/config/params"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v5/io/file"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/validator/db"
+	history "github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	s3Scheme   = "s3://"
+	filePrefix = "slashing-protection-"
+	fileSuffix = ".json"
+	fullSuffix = "-full" + fileSuffix
+	// defaultExportsPerBackup is the number of exports of a backup, after which a new backup is started with a
+	// complete export.
+	defaultExportsPerBackup = 64
+	// idFileName is the file of the data directory storing the generated identifier of the validator client.
+	idFileName = "slashing-protection-backup-id"
+	// idLength is the number of random bytes of a generated identifier.
+	idLength = 8
+)
+
+// Destination stores the exported files.
+type Destination interface {
+	Write(ctx context.Context, name string, data []byte) error
+	// List returns the names of the exported files.
+	List(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, name string) error
+	String() string
+}
+
+// ChainHeadFetcher fetches the chain head, from which the finalized epoch is read.
+type ChainHeadFetcher interface {
+	ChainHead(ctx context.Context, in *empty.Empty) (*ethpb.ChainHead, error)
+}
+
+// NewDestination returns the destination of the target, an S3 bucket for s3://bucket/prefix targets and a directory
+// otherwise. S3 requests are sent to the endpoint and signed for the region with the credentials of the
+// AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables.
+func NewDestination(target, s3Endpoint, s3Region string) (Destination, error) {
+	if strings.HasPrefix(target, s3Scheme) {
+		return NewS3(target, s3Endpoint, s3Region, credentialsFromEnv())
+	}
+	return NewDirectory(target)
+}
+
+// Directory stores the exported files in a local directory, typically a mounted remote file system.
+type Directory struct {
+	dir string
+}
+
+// NewDirectory creates the directory if needed and returns it as a destination.
+func NewDirectory(dir string) (*Directory, error) {
+	if err := file.MkdirAll(dir); err != nil {
+		return nil, errors.Wrap(err, "could not create slashing protection backup directory")
+	}
+	return &Directory{dir: dir}, nil
+}
+
+// Write writes the file to a temporary file first, so a partially written file is never left under its name.
+func (d *Directory) Write(_ context.Context, name string, data []byte) error {
+	path := filepath.Join(d.dir, name)
+	tmp := path + ".tmp"
+	if err := file.WriteFile(tmp, data); err != nil {
+		return errors.Wrapf(err, "could not write %s", tmp)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return errors.Wrapf(err, "could not rename %s", tmp)
+	}
+	return nil
+}
+
+// List returns the names of the exported files of the directory.
+func (d *Directory) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(d.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read %s", d.dir)
+	}
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() && isExportFile(entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, nil
+}
+
+func (d *Directory) Delete(_ context.Context, name string) error {
+	if err := os.Remove(filepath.Join(d.dir, name)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "could not delete %s", name)
+	}
+	return nil
+}
+
+func (d *Directory) String() string {
+	return d.dir
+}
+
+// Backup exports the slashing protection history as the finalized checkpoint advances.
+type Backup struct {
+	db   db.Database
+	dest Destination
+	// id identifies the validator client in the names of its files.
+	id           string
+	pollInterval time.Duration
+	epochs       primitives.Epoch
+	keep         int
+	// exportsPerBackup is the number of exports of a backup.
+	exportsPerBackup int
+	marks            history.ExportMarks
+	// lastEpoch is the finalized epoch of the last export, and exported whether there was one.
+	lastEpoch primitives.Epoch
+	exported  bool
+	// backupExports is the number of exports of the current backup, its complete export included.
+	backupExports int
+}
+
+// New returns a backup of the slashing protection history of the database to the destination, in files named after
+// the identifier of the validator client, exporting every given number of finalized epochs, and keeping the given
+// number of backups or all of them when 0.
+func New(validatorDB db.Database, dest Destination, id string, epochs primitives.Epoch, keep int) *Backup {
+	if epochs == 0 {
+		epochs = 1
+	}
+	return &Backup{
+		db:               validatorDB,
+		dest:             dest,
+		id:               id,
+		pollInterval:     time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		epochs:           epochs,
+		keep:             keep,
+		exportsPerBackup: defaultExportsPerBackup,
+	}
+}
+
+// Run polls the finalized epoch every slot and exports the slashing protection history whenever it advanced by the
+// epochs of the backup, until the context is done. Failed exports are retried at the next poll.
+func (b *Backup) Run(ctx context.Context, chain ChainHeadFetcher) {
+	log.WithFields(logrus.Fields{
+		"destination": b.dest.String(),
+		"id":          b.id,
+		"epochs":      b.epochs,
+	}).Info("Backing up slashing protection history as the finalized checkpoint advances")
+	ticker := time.NewTicker(b.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := b.poll(ctx, chain); err != nil {
+			exportsCounter.WithLabelValues("failure").Inc()
+			log.WithError(err).Error("Could not back up slashing protection history")
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Debug("Context closed, exiting routine")
+			return
+		}
+	}
+}
+
+func (b *Backup) poll(ctx context.Context, chain ChainHeadFetcher) error {
+	head, err := chain.ChainHead(ctx, &empty.Empty{})
+	if err != nil {
+		return errors.Wrap(err, "could not get finalized epoch")
+	}
+	if b.exported && head.FinalizedEpoch < b.lastEpoch+b.epochs {
+		return nil
+	}
+	return b.export(ctx, head.FinalizedEpoch)
+}
+
+// export writes the slashing protection history signed since the previous export, named after the finalized epoch,
+// or the complete history when a new backup starts. Nothing is written when nothing was signed since.
+func (b *Backup) export(ctx context.Context, epoch primitives.Epoch) error {
+	full := !b.exported || b.backupExports >= b.exportsPerBackup
+	marks := b.marks
+	if full {
+		marks = nil
+	}
+	interchange, marks, err := history.ExportIncrementalProtectionJSON(ctx, b.db, marks)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	if len(interchange.Data) > 0 || full {
+		data, err := json.MarshalIndent(interchange, "", "\t")
+		if err != nil {
+			return errors.Wrap(err, "could not encode slashing protection history")
+		}
+		name := fileName(b.id, epoch, full)
+		if err := b.dest.Write(ctx, name, data); err != nil {
+			return errors.Wrapf(err, "could not write %s to %s", name, b.dest)
+		}
+		exportsCounter.WithLabelValues("success").Inc()
+		log.WithFields(logrus.Fields{
+			"file":       name,
+			"publicKeys": len(interchange.Data),
+		}).Debug("Backed up slashing protection history")
+	}
+	b.marks = marks
+	b.lastEpoch = epoch
+	b.exported = true
+	b.backupExports++
+	if full {
+		b.backupExports = 1
+	}
+	lastExportedEpoch.Set(float64(epoch))
+	if full {
+		if err := b.prune(ctx); err != nil {
+			log.WithError(err).Error("Could not delete old slashing protection backups")
+		}
+	}
+	return nil
+}
+
+// prune deletes the files of the backups of the validator client older than the backups to keep. The files of a
+// backup are its complete export and the exports following it.
+func (b *Backup) prune(ctx context.Context) error {
+	if b.keep <= 0 {
+		return nil
+	}
+	all, err := b.dest.List(ctx)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(all))
+	for _, name := range all {
+		if isOwnFile(b.id, name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	var fulls []int
+	for i, name := range names {
+		if strings.HasSuffix(name, fullSuffix) {
+			fulls = append(fulls, i)
+		}
+	}
+	if len(fulls) <= b.keep {
+		return nil
+	}
+	oldest := fulls[len(fulls)-b.keep]
+	for _, name := range names[:oldest] {
+		if err := b.dest.Delete(ctx, name); err != nil {
+			return err
+		}
+		log.WithField("file", name).Debug("Deleted old slashing protection backup")
+	}
+	return nil
+}
+
+// fileName names the files of the validator client so they sort in the order they are to be imported, the complete
+// export of a backup first.
+func fileName(id string, epoch primitives.Epoch, full bool) string {
+	if full {
+		return fmt.Sprintf("%s%s-%010d%s", filePrefix, id, epoch, fullSuffix)
+	}
+	return fmt.Sprintf("%s%s-%010d%s", filePrefix, id, epoch, fileSuffix)
+}
+
+func isExportFile(name string) bool {
+	return strings.HasPrefix(name, filePrefix) && strings.HasSuffix(name, fileSuffix)
+}
+
+// isOwnFile returns whether the file is named after the identifier of the validator client, followed by an epoch.
+// The epoch is checked so that an identifier extending the identifier of the validator client does not match.
+func isOwnFile(id, name string) bool {
+	rest, ok := strings.CutPrefix(name, filePrefix+id+"-")
+	if !ok {
+		return false
+	}
+	epoch, ok := strings.CutSuffix(rest, fullSuffix)
+	if !ok {
+		epoch, ok = strings.CutSuffix(rest, fileSuffix)
+	}
+	if !ok || len(epoch) != 10 {
+		return false
+	}
+	for _, c := range epoch {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// LoadOrCreateID returns the identifier of the validator client stored in the data directory, generating a random one
+// and storing it when there is none yet.
+func LoadOrCreateID(dataDir string) (string, error) {
+	path := filepath.Join(dataDir, idFileName)
+	exists, err := file.Exists(path, file.Regular)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		data, err := file.ReadFileAsBytes(path)
+		if err != nil {
+			return "", errors.Wrapf(err, "could not read %s", path)
+		}
+		if id := strings.TrimSpace(string(data)); id != "" {
+			return id, nil
+		}
+	}
+	b := make([]byte, idLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrap(err, "could not generate slashing protection backup identifier")
+	}
+	id := hex.EncodeToString(b)
+	if err := file.WriteFile(path, []byte(id)); err != nil {
+		return "", errors.Wrapf(err, "could not write %s", path)
+	}
+	return id, nil
+}
@@ -0,0 +1,177 @@
+package slashingbackup
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	fieldparams "github.com/prysmaticlabs/prysm/v5/config/fieldparams"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+	dbtest "github.com/prysmaticlabs/prysm/v5/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/v5/validator/slashing-protection-history/format"
+)
+
+type chainHead struct {
+	finalizedEpoch primitives.Epoch
+}
+
+func (c *chainHead) ChainHead(context.Context, *empty.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{FinalizedEpoch: c.finalizedEpoch}, nil
+}
+
+func attestation(source, target primitives.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+func readInterchange(t *testing.T, path string) *format.EIPSlashingProtectionFormat {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	interchange := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal(data, interchange))
+	return interchange
+}
+
+func TestBackup_ExportsAtFinalizedCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}}
+	validatorDB := dbtest.SetupDB(t, pubKeys, false)
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{1}, attestation(0, 1)))
+
+	dir := t.TempDir()
+	dest, err := NewDestination(dir, "", "")
+	require.NoError(t, err)
+	b := New(validatorDB, dest, "vc1", 1, 0)
+	chain := &chainHead{finalizedEpoch: 3}
+
+	// The first export is complete.
+	require.NoError(t, b.poll(ctx, chain))
+	interchange := readInterchange(t, filepath.Join(dir, "slashing-protection-vc1-0000000003-full.json"))
+	require.Equal(t, 1, len(interchange.Data))
+	assert.Equal(t, 1, len(interchange.Data[0].SignedAttestations))
+
+	// Nothing is exported until the finalized epoch advances.
+	require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{2}, attestation(1, 2)))
+	require.NoError(t, b.poll(ctx, chain))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+
+	// The next exports only contain what was signed since.
+	chain.finalizedEpoch = 4
+	require.NoError(t, b.poll(ctx, chain))
+	interchange = readInterchange(t, filepath.Join(dir, "slashing-protection-vc1-0000000004.json"))
+	require.Equal(t, 1, len(interchange.Data))
+	require.Equal(t, 1, len(interchange.Data[0].SignedAttestations))
+	assert.Equal(t, "2", interchange.Data[0].SignedAttestations[0].TargetEpoch)
+
+	// Nothing is written when nothing was signed since.
+	chain.finalizedEpoch = 5
+	require.NoError(t, b.poll(ctx, chain))
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(files))
+}
+
+func TestBackup_RetriesFailedExports(t *testing.T) {
+	ctx := context.Background()
+	validatorDB := dbtest.SetupDB(t, [][fieldparams.BLSPubkeyLength]byte{{1}}, false)
+	dir := t.TempDir()
+	dest, err := NewDirectory(dir)
+	require.NoError(t, err)
+	b := New(validatorDB, dest, "vc1", 1, 0)
+	chain := &chainHead{finalizedEpoch: 3}
+
+	// The export fails without a genesis validators root, and is retried at the next poll.
+	require.ErrorContains(t, "genesis validators root is empty", b.poll(ctx, chain))
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+	require.NoError(t, b.poll(ctx, chain))
+	_, err = os.Stat(filepath.Join(dir, "slashing-protection-vc1-0000000003-full.json"))
+	require.NoError(t, err)
+}
+
+func TestBackup_EpochsAndRetention(t *testing.T) {
+	ctx := context.Background()
+	pubKeys := [][fieldparams.BLSPubkeyLength]byte{{1}}
+	validatorDB := dbtest.SetupDB(t, pubKeys, false)
+	genesisValidatorsRoot := [32]byte{1}
+	require.NoError(t, validatorDB.SaveGenesisValidatorsRoot(ctx, genesisValidatorsRoot[:]))
+
+	dir := t.TempDir()
+	dest, err := NewDirectory(dir)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other.json"), []byte("{}"), 0600))
+	b := New(validatorDB, dest, "vc1", 2, 2)
+	const exportsPerBackup = 4
+	b.exportsPerBackup = exportsPerBackup
+	chain := &chainHead{finalizedEpoch: 1}
+
+	target := primitives.Epoch(0)
+	sign := func() {
+		target++
+		require.NoError(t, validatorDB.SaveAttestationForPubKey(ctx, pubKeys[0], [32]byte{byte(target)}, attestation(target-1, target)))
+	}
+	// Exports are written every 2 finalized epochs.
+	sign()
+	require.NoError(t, b.poll(ctx, chain))
+	sign()
+	chain.finalizedEpoch = 2
+	require.NoError(t, b.poll(ctx, chain))
+	chain.finalizedEpoch = 3
+	require.NoError(t, b.poll(ctx, chain))
+	names, err := dest.List(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"slashing-protection-vc1-0000000001-full.json", "slashing-protection-vc1-0000000003.json"}, names)
+
+	// New backups start after the exports of a backup, and only the last 2 backups are kept, leaving the backups of
+	// other validator clients alone.
+	other := []string{"slashing-protection-vc1-a-0000000001-full.json", "slashing-protection-vc2-0000000001-full.json"}
+	for _, name := range other {
+		require.NoError(t, dest.Write(ctx, name, []byte("{}")))
+	}
+	for i := 0; i < 2*exportsPerBackup; i++ {
+		sign()
+		chain.finalizedEpoch += 2
+		require.NoError(t, b.poll(ctx, chain))
+	}
+	names, err = dest.List(ctx)
+	require.NoError(t, err)
+	require.Equal(t, exportsPerBackup+2+len(other), len(names))
+	assert.Equal(t, fileName("vc1", 2*exportsPerBackup+1, true), names[0])
+	assert.Equal(t, fileName("vc1", 4*exportsPerBackup+1, true), names[exportsPerBackup])
+	assert.DeepEqual(t, other, names[len(names)-len(other):])
+	interchange := readInterchange(t, filepath.Join(dir, names[exportsPerBackup]))
+	require.Equal(t, 1, len(interchange.Data))
+	assert.Equal(t, 2*exportsPerBackup+1, len(interchange.Data[0].SignedAttestations))
+	_, err = os.Stat(filepath.Join(dir, "other.json"))
+	require.NoError(t, err)
+}
+
+func TestLoadOrCreateID(t *testing.T) {
+	dir := t.TempDir()
+	id, err := LoadOrCreateID(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2*idLength, len(id))
+
+	// The identifier is kept across restarts.
+	again, err := LoadOrCreateID(dir)
+	require.NoError(t, err)
+	assert.Equal(t, id, again)
+
+	other, err := LoadOrCreateID(t.TempDir())
+	require.NoError(t, err)
+	assert.NotEqual(t, id, other)
+}
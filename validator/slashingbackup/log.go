@@ -0,0 +1,5 @@
+package slashingbackup
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "slashingbackup")
@@ -0,0 +1,22 @@
+package slashingbackup
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	exportsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_slashing_protection_backups_total",
+			Help: "Number of slashing protection history backups written, by result.",
+		},
+		[]string{"result"},
+	)
+	lastExportedEpoch = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "validator_slashing_protection_backup_finalized_epoch",
+			Help: "Finalized epoch up to which the slashing protection history is backed up.",
+		},
+	)
+)
@@ -0,0 +1,252 @@
+package slashingbackup
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	amzDateFormat  = "20060102T150405Z"
+	amzDayFormat   = "20060102"
+	s3Service      = "s3"
+	signingAlgo    = "AWS4-HMAC-SHA256"
+	maxErrorLength = 1 << 10
+)
+
+// Credentials sign the requests sent to S3.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+}
+
+func credentialsFromEnv() Credentials {
+	return Credentials{
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+// S3 stores the exported files in an S3 bucket, or any storage with an S3 compatible API, with path-style PUT Object,
+// ListObjectsV2 and DELETE Object requests signed with AWS Signature Version 4.
+type S3 struct {
+	endpoint *url.URL
+	region   string
+	bucket   string
+	prefix   string
+	creds    Credentials
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3 returns the bucket and key prefix of an s3://bucket/prefix target as a destination.
+func NewS3(target, endpoint, region string, creds Credentials) (*S3, error) {
+	bucket, prefix, _ := strings.Cut(strings.TrimPrefix(target, s3Scheme), "/")
+	if bucket == "" {
+		return nil, errors.Errorf("no bucket in slashing protection backup target %s", target)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid S3 endpoint %s", endpoint)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, errors.Errorf("S3 endpoint must be in the format of http(s)://host, got %s", endpoint)
+	}
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return nil, errors.New("AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY must be set to back up slashing protection history to S3")
+	}
+	if prefix != "" && !strings.HasSuffix(prefix, "/") {
+		prefix += "/"
+	}
+	return &S3{
+		endpoint: u,
+		region:   region,
+		bucket:   bucket,
+		prefix:   prefix,
+		creds:    creds,
+		client:   &http.Client{Timeout: time.Minute},
+		now:      time.Now,
+	}, nil
+}
+
+// listObjectsResult is the part of the ListObjectsV2 response listing the keys.
+type listObjectsResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// Write puts the file in the bucket under the key prefix.
+func (s *S3) Write(ctx context.Context, name string, data []byte) error {
+	resp, err := s.do(ctx, http.MethodPut, s.objectPath(name), nil, data)
+	if err != nil {
+		return err
+	}
+	closeBody(resp)
+	return nil
+}
+
+// List returns the names of the exported files under the key prefix.
+func (s *S3) List(ctx context.Context) ([]string, error) {
+	var names []string
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {s.prefix + filePrefix}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.do(ctx, http.MethodGet, s.bucketPath(), query, nil)
+		if err != nil {
+			return nil, err
+		}
+		result := &listObjectsResult{}
+		err = xml.NewDecoder(resp.Body).Decode(result)
+		closeBody(resp)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode S3 object list")
+		}
+		for _, c := range result.Contents {
+			name := strings.TrimPrefix(c.Key, s.prefix)
+			if !strings.Contains(name, "/") && isExportFile(name) {
+				names = append(names, name)
+			}
+		}
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			return names, nil
+		}
+		token = result.NextContinuationToken
+	}
+}
+
+// Delete deletes the file from the bucket.
+func (s *S3) Delete(ctx context.Context, name string) error {
+	resp, err := s.do(ctx, http.MethodDelete, s.objectPath(name), nil, nil)
+	if err != nil {
+		return err
+	}
+	closeBody(resp)
+	return nil
+}
+
+func (s *S3) bucketPath() string {
+	return strings.TrimSuffix(s.endpoint.Path, "/") + "/" + s.bucket + "/"
+}
+
+func (s *S3) objectPath(name string) string {
+	return s.bucketPath() + s.prefix + name
+}
+
+// do sends the signed request, and returns the response when it is successful.
+func (s *S3) do(ctx context.Context, method, path string, query url.Values, data []byte) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = path
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	if data != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	payloadHash := sha256.Sum256(data)
+	s.sign(req, hex.EncodeToString(payloadHash[:]), s.now().UTC())
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not send request")
+	}
+	if resp.StatusCode/100 != 2 {
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxErrorLength))
+		closeBody(resp)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read response body of status %d", resp.StatusCode)
+		}
+		return nil, errors.Errorf("S3 responded with status %d: %s", resp.StatusCode, body)
+	}
+	return resp, nil
+}
+
+// closeBody drains the response body, so that the connection is reused, and closes it.
+func closeBody(resp *http.Response) {
+	if _, err := io.Copy(io.Discard, resp.Body); err != nil {
+		log.WithError(err).Debug("Could not drain response body")
+	}
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
+
+func (s *S3) String() string {
+	return s3Scheme + s.bucket + "/" + s.prefix
+}
+
+// sign adds the AWS Signature Version 4 authorization header to the request, signing the host and every header
+// already set.
+func (s *S3) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if s.creds.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.creds.SessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(amzDayFormat), s.region, s3Service, "aws4_request"}, "/")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{signingAlgo, amzDate, scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.creds.SecretAccessKey), now.Format(amzDayFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgo, s.creds.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
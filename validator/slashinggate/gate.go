@@ -0,0 +1,191 @@
+// Package slashinggate consults an external anti-slashing service before the validator client signs a block or an
+// attestation, for operators running a centralized slashing protection alongside the local one. The service is sent
+// the signing root, slot and public key of the object, and the signing is refused when it denies it. Whether the
+// signing is refused when the service can't be reached in time is configurable.
+package slashinggate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	blockType       = "block"
+	attestationType = "attestation"
+	// maxResponseSize bounds the size of the responses read from the service.
+	maxResponseSize = 1 << 16
+)
+
+// ErrDenied is returned when the service refuses the signing of an object.
+var ErrDenied = errors.New("signing denied by remote slashing protection")
+
+// SigningFunc signs the object of a request.
+type SigningFunc = func(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
+
+// Request is the body of the requests sent to the service.
+type Request struct {
+	Type        string `json:"type"`
+	Slot        string `json:"slot"`
+	PublicKey   string `json:"public_key"`
+	SigningRoot string `json:"signing_root"`
+	// SourceEpoch and TargetEpoch are only set for attestations.
+	SourceEpoch string `json:"source_epoch,omitempty"`
+	TargetEpoch string `json:"target_epoch,omitempty"`
+}
+
+// Response is the body of the responses of the service.
+type Response struct {
+	Allowed bool   `json:"allowed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// Gate consults the service before signing. A nil gate allows every signing.
+type Gate struct {
+	url          string
+	client       *http.Client
+	allowOnError bool
+}
+
+// New creates a gate consulting the service at the endpoint, waiting up to timeout for its decision. When
+// allowOnError is set, objects are signed when the service can't be reached in time or fails to respond, otherwise
+// their signing is refused.
+func New(endpoint string, timeout time.Duration, allowOnError bool) (*Gate, error) {
+	u, err := url.ParseRequestURI(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid remote slashing protection url")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("remote slashing protection url must be in the format of http(s)://host:port, got %s", endpoint)
+	}
+	return &Gate{
+		url:          u.String(),
+		client:       &http.Client{Timeout: timeout},
+		allowOnError: allowOnError,
+	}, nil
+}
+
+// Check returns nil when the object of the request may be signed. Only blocks and attestations, the slashable
+// objects, are checked with the service.
+func (g *Gate) Check(ctx context.Context, req *validatorpb.SignRequest) error {
+	if g == nil {
+		return nil
+	}
+	body := newRequest(req)
+	if body == nil {
+		return nil
+	}
+	resp, err := g.consult(ctx, body)
+	if err != nil {
+		if g.allowOnError {
+			decisionsCounter.WithLabelValues("error_allowed").Inc()
+			log.WithError(err).WithFields(logrus.Fields{
+				"type":      body.Type,
+				"slot":      body.Slot,
+				"publicKey": body.PublicKey,
+			}).Warn("Could not consult remote slashing protection, signing anyway")
+			return nil
+		}
+		decisionsCounter.WithLabelValues("error_denied").Inc()
+		return errors.Wrap(err, "could not consult remote slashing protection")
+	}
+	if !resp.Allowed {
+		decisionsCounter.WithLabelValues("denied").Inc()
+		return errors.Wrapf(ErrDenied, "%s at slot %s: %s", body.Type, body.Slot, resp.Reason)
+	}
+	decisionsCounter.WithLabelValues("allowed").Inc()
+	return nil
+}
+
+// Signer returns a signing function which checks the objects with the service before signing them with the given
+// function.
+func (g *Gate) Signer(sign SigningFunc) SigningFunc {
+	if g == nil {
+		return sign
+	}
+	return func(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+		if err := g.Check(ctx, req); err != nil {
+			return nil, err
+		}
+		return sign(ctx, req)
+	}
+}
+
+func (g *Gate) consult(ctx context.Context, body *Request) (*Response, error) {
+	start := time.Now()
+	defer func() {
+		requestDurationSeconds.Observe(time.Since(start).Seconds())
+	}()
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal request")
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, g.url, bytes.NewReader(enc))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpResp, err := g.client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := httpResp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	content, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseSize))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read response")
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d: %s", httpResp.StatusCode, string(content))
+	}
+	resp := &Response{}
+	if err := json.Unmarshal(content, resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode response")
+	}
+	return resp, nil
+}
+
+// newRequest returns the request checking the object signed by the request, or nil when it is not slashable.
+func newRequest(req *validatorpb.SignRequest) *Request {
+	r := &Request{
+		Slot:        strconv.FormatUint(uint64(req.SigningSlot), 10),
+		PublicKey:   fmt.Sprintf("%#x", req.PublicKey),
+		SigningRoot: fmt.Sprintf("%#x", req.SigningRoot),
+	}
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_AttestationData:
+		r.Type = attestationType
+		if data := o.AttestationData; data != nil && data.Source != nil && data.Target != nil {
+			r.SourceEpoch = strconv.FormatUint(uint64(data.Source.Epoch), 10)
+			r.TargetEpoch = strconv.FormatUint(uint64(data.Target.Epoch), 10)
+		}
+	case *validatorpb.SignRequest_Block,
+		*validatorpb.SignRequest_BlockAltair,
+		*validatorpb.SignRequest_BlockBellatrix,
+		*validatorpb.SignRequest_BlindedBlockBellatrix,
+		*validatorpb.SignRequest_BlockCapella,
+		*validatorpb.SignRequest_BlindedBlockCapella,
+		*validatorpb.SignRequest_BlockDeneb,
+		*validatorpb.SignRequest_BlindedBlockDeneb,
+		*validatorpb.SignRequest_BlockElectra,
+		*validatorpb.SignRequest_BlindedBlockElectra:
+		r.Type = blockType
+	default:
+		return nil
+	}
+	return r
+}
@@ -0,0 +1,100 @@
+package slashinggate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/v5/crypto/bls"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/v5/testing/assert"
+	"github.com/prysmaticlabs/prysm/v5/testing/require"
+)
+
+func attestationRequest() *validatorpb.SignRequest {
+	return &validatorpb.SignRequest{
+		PublicKey:   []byte{1},
+		SigningRoot: []byte{2},
+		SigningSlot: 32,
+		Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{
+			Slot:   32,
+			Source: &ethpb.Checkpoint{Epoch: 0},
+			Target: &ethpb.Checkpoint{Epoch: 1},
+		}},
+	}
+}
+
+func TestGate_Check(t *testing.T) {
+	var got *Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = &Request{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(got))
+		require.NoError(t, json.NewEncoder(w).Encode(&Response{Allowed: got.Slot != "64", Reason: "double vote"}))
+	}))
+	defer srv.Close()
+	g, err := New(srv.URL, time.Second, false)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, g.Check(ctx, attestationRequest()))
+	assert.DeepEqual(t, &Request{
+		Type:        attestationType,
+		Slot:        "32",
+		PublicKey:   "0x01",
+		SigningRoot: "0x02",
+		SourceEpoch: "0",
+		TargetEpoch: "1",
+	}, got)
+
+	err = g.Check(ctx, &validatorpb.SignRequest{
+		SigningSlot: 64,
+		Object:      &validatorpb.SignRequest_BlockDeneb{BlockDeneb: &ethpb.BeaconBlockDeneb{Slot: 64}},
+	})
+	require.ErrorIs(t, err, ErrDenied)
+	assert.ErrorContains(t, "double vote", err)
+	assert.Equal(t, blockType, got.Type)
+
+	// Objects which are not slashable are not checked.
+	got = nil
+	require.NoError(t, g.Check(ctx, &validatorpb.SignRequest{SigningSlot: 64, Object: &validatorpb.SignRequest_Slot{Slot: 64}}))
+	assert.Equal(t, (*Request)(nil), got)
+
+	var nilGate *Gate
+	require.NoError(t, nilGate.Check(ctx, attestationRequest()))
+}
+
+func TestGate_Timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(&Response{Allowed: true})
+	}))
+	defer srv.Close()
+	signed := false
+	sign := func(context.Context, *validatorpb.SignRequest) (bls.Signature, error) {
+		signed = true
+		return nil, nil
+	}
+
+	g, err := New(srv.URL, 10*time.Millisecond, false)
+	require.NoError(t, err)
+	_, err = g.Signer(sign)(context.Background(), attestationRequest())
+	require.ErrorContains(t, "could not consult remote slashing protection", err)
+	assert.Equal(t, false, signed)
+
+	g, err = New(srv.URL, 10*time.Millisecond, true)
+	require.NoError(t, err)
+	_, err = g.Signer(sign)(context.Background(), attestationRequest())
+	require.NoError(t, err)
+	assert.Equal(t, true, signed)
+}
+
+func TestNew_InvalidURL(t *testing.T) {
+	_, err := New("localhost:8080", time.Second, false)
+	require.NotNil(t, err)
+	_, err = New("ftp://localhost:8080", time.Second, false)
+	require.NotNil(t, err)
+}
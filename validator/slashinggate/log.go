@@ -0,0 +1,5 @@
+package slashinggate
+
+import "github.com/sirupsen/logrus"
+
+var log = logrus.WithField("prefix", "slashinggate")
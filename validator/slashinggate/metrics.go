@@ -0,0 +1,23 @@
+package slashinggate
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	decisionsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "validator_slashing_gate_decisions_total",
+			Help: "Number of blocks and attestations checked with the remote slashing protection, by decision.",
+		},
+		[]string{"decision"},
+	)
+	requestDurationSeconds = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "validator_slashing_gate_request_duration_seconds",
+			Help:    "Time (in seconds) spent consulting the remote slashing protection.",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+)